@@ -51,7 +51,7 @@ func (f *genericInformer) Lister() cache.GenericLister {
 // TODO extend this to unknown resources with a client pool
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
-	// Group=operator, Version=v1beta1
+	// Group=operator.victoriametrics.com, Version=v1beta1
 	case v1beta1.SchemeGroupVersion.WithResource("vlogs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VLogs().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("vmagents"):
@@ -62,20 +62,36 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMAlertmanagers().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("vmalertmanagerconfigs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMAlertmanagerConfigs().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("vmalertmanagertemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMAlertmanagerTemplates().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("vmauths"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMAuths().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("vmclusters"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMClusters().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("vmdatamigrations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMDataMigrations().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("vmmaintenancetasks"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMMaintenanceTasks().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("vmnodescrapes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMNodeScrapes().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("vmoperatorsettings"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMOperatorSettings().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("vmpodscrapes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMPodScrapes().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("vmprobes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMProbes().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("vmrelabelconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMRelabelConfigs().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("vmremotewritetargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMRemoteWriteTargets().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("vmrules"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMRules().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("vmruletests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMRuleTests().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("vmscrapeconfigs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMScrapeConfigs().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("vmscrapeglobalconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMScrapeGlobalConfigs().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("vmservicescrapes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().VMServiceScrapes().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("vmsingles"):
@@ -33,20 +33,36 @@ type Interface interface {
 	VMAlertmanagers() VMAlertmanagerInformer
 	// VMAlertmanagerConfigs returns a VMAlertmanagerConfigInformer.
 	VMAlertmanagerConfigs() VMAlertmanagerConfigInformer
+	// VMAlertmanagerTemplates returns a VMAlertmanagerTemplateInformer.
+	VMAlertmanagerTemplates() VMAlertmanagerTemplateInformer
 	// VMAuths returns a VMAuthInformer.
 	VMAuths() VMAuthInformer
 	// VMClusters returns a VMClusterInformer.
 	VMClusters() VMClusterInformer
+	// VMDataMigrations returns a VMDataMigrationInformer.
+	VMDataMigrations() VMDataMigrationInformer
+	// VMMaintenanceTasks returns a VMMaintenanceTaskInformer.
+	VMMaintenanceTasks() VMMaintenanceTaskInformer
 	// VMNodeScrapes returns a VMNodeScrapeInformer.
 	VMNodeScrapes() VMNodeScrapeInformer
+	// VMOperatorSettings returns a VMOperatorSettingsInformer.
+	VMOperatorSettings() VMOperatorSettingsInformer
 	// VMPodScrapes returns a VMPodScrapeInformer.
 	VMPodScrapes() VMPodScrapeInformer
 	// VMProbes returns a VMProbeInformer.
 	VMProbes() VMProbeInformer
+	// VMRelabelConfigs returns a VMRelabelConfigInformer.
+	VMRelabelConfigs() VMRelabelConfigInformer
+	// VMRemoteWriteTargets returns a VMRemoteWriteTargetInformer.
+	VMRemoteWriteTargets() VMRemoteWriteTargetInformer
 	// VMRules returns a VMRuleInformer.
 	VMRules() VMRuleInformer
+	// VMRuleTests returns a VMRuleTestInformer.
+	VMRuleTests() VMRuleTestInformer
 	// VMScrapeConfigs returns a VMScrapeConfigInformer.
 	VMScrapeConfigs() VMScrapeConfigInformer
+	// VMScrapeGlobalConfigs returns a VMScrapeGlobalConfigInformer.
+	VMScrapeGlobalConfigs() VMScrapeGlobalConfigInformer
 	// VMServiceScrapes returns a VMServiceScrapeInformer.
 	VMServiceScrapes() VMServiceScrapeInformer
 	// VMSingles returns a VMSingleInformer.
@@ -93,6 +109,11 @@ func (v *version) VMAlertmanagerConfigs() VMAlertmanagerConfigInformer {
 	return &vMAlertmanagerConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// VMAlertmanagerTemplates returns a VMAlertmanagerTemplateInformer.
+func (v *version) VMAlertmanagerTemplates() VMAlertmanagerTemplateInformer {
+	return &vMAlertmanagerTemplateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // VMAuths returns a VMAuthInformer.
 func (v *version) VMAuths() VMAuthInformer {
 	return &vMAuthInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -103,11 +124,26 @@ func (v *version) VMClusters() VMClusterInformer {
 	return &vMClusterInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// VMDataMigrations returns a VMDataMigrationInformer.
+func (v *version) VMDataMigrations() VMDataMigrationInformer {
+	return &vMDataMigrationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// VMMaintenanceTasks returns a VMMaintenanceTaskInformer.
+func (v *version) VMMaintenanceTasks() VMMaintenanceTaskInformer {
+	return &vMMaintenanceTaskInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // VMNodeScrapes returns a VMNodeScrapeInformer.
 func (v *version) VMNodeScrapes() VMNodeScrapeInformer {
 	return &vMNodeScrapeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// VMOperatorSettings returns a VMOperatorSettingsInformer.
+func (v *version) VMOperatorSettings() VMOperatorSettingsInformer {
+	return &vMOperatorSettingsInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // VMPodScrapes returns a VMPodScrapeInformer.
 func (v *version) VMPodScrapes() VMPodScrapeInformer {
 	return &vMPodScrapeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -118,16 +154,36 @@ func (v *version) VMProbes() VMProbeInformer {
 	return &vMProbeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// VMRelabelConfigs returns a VMRelabelConfigInformer.
+func (v *version) VMRelabelConfigs() VMRelabelConfigInformer {
+	return &vMRelabelConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// VMRemoteWriteTargets returns a VMRemoteWriteTargetInformer.
+func (v *version) VMRemoteWriteTargets() VMRemoteWriteTargetInformer {
+	return &vMRemoteWriteTargetInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // VMRules returns a VMRuleInformer.
 func (v *version) VMRules() VMRuleInformer {
 	return &vMRuleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// VMRuleTests returns a VMRuleTestInformer.
+func (v *version) VMRuleTests() VMRuleTestInformer {
+	return &vMRuleTestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // VMScrapeConfigs returns a VMScrapeConfigInformer.
 func (v *version) VMScrapeConfigs() VMScrapeConfigInformer {
 	return &vMScrapeConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// VMScrapeGlobalConfigs returns a VMScrapeGlobalConfigInformer.
+func (v *version) VMScrapeGlobalConfigs() VMScrapeGlobalConfigInformer {
+	return &vMScrapeGlobalConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // VMServiceScrapes returns a VMServiceScrapeInformer.
 func (v *version) VMServiceScrapes() VMServiceScrapeInformer {
 	return &vMServiceScrapeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -57,6 +57,14 @@ type VMAlertmanagerConfigListerExpansion interface{}
 // VMAlertmanagerConfigNamespaceLister.
 type VMAlertmanagerConfigNamespaceListerExpansion interface{}
 
+// VMAlertmanagerTemplateListerExpansion allows custom methods to be added to
+// VMAlertmanagerTemplateLister.
+type VMAlertmanagerTemplateListerExpansion interface{}
+
+// VMAlertmanagerTemplateNamespaceListerExpansion allows custom methods to be added to
+// VMAlertmanagerTemplateNamespaceLister.
+type VMAlertmanagerTemplateNamespaceListerExpansion interface{}
+
 // VMAuthListerExpansion allows custom methods to be added to
 // VMAuthLister.
 type VMAuthListerExpansion interface{}
@@ -73,6 +81,22 @@ type VMClusterListerExpansion interface{}
 // VMClusterNamespaceLister.
 type VMClusterNamespaceListerExpansion interface{}
 
+// VMDataMigrationListerExpansion allows custom methods to be added to
+// VMDataMigrationLister.
+type VMDataMigrationListerExpansion interface{}
+
+// VMDataMigrationNamespaceListerExpansion allows custom methods to be added to
+// VMDataMigrationNamespaceLister.
+type VMDataMigrationNamespaceListerExpansion interface{}
+
+// VMMaintenanceTaskListerExpansion allows custom methods to be added to
+// VMMaintenanceTaskLister.
+type VMMaintenanceTaskListerExpansion interface{}
+
+// VMMaintenanceTaskNamespaceListerExpansion allows custom methods to be added to
+// VMMaintenanceTaskNamespaceLister.
+type VMMaintenanceTaskNamespaceListerExpansion interface{}
+
 // VMNodeScrapeListerExpansion allows custom methods to be added to
 // VMNodeScrapeLister.
 type VMNodeScrapeListerExpansion interface{}
@@ -81,6 +105,14 @@ type VMNodeScrapeListerExpansion interface{}
 // VMNodeScrapeNamespaceLister.
 type VMNodeScrapeNamespaceListerExpansion interface{}
 
+// VMOperatorSettingsListerExpansion allows custom methods to be added to
+// VMOperatorSettingsLister.
+type VMOperatorSettingsListerExpansion interface{}
+
+// VMOperatorSettingsNamespaceListerExpansion allows custom methods to be added to
+// VMOperatorSettingsNamespaceLister.
+type VMOperatorSettingsNamespaceListerExpansion interface{}
+
 // VMPodScrapeListerExpansion allows custom methods to be added to
 // VMPodScrapeLister.
 type VMPodScrapeListerExpansion interface{}
@@ -97,6 +129,14 @@ type VMProbeListerExpansion interface{}
 // VMProbeNamespaceLister.
 type VMProbeNamespaceListerExpansion interface{}
 
+// VMRelabelConfigListerExpansion allows custom methods to be added to
+// VMRelabelConfigLister.
+type VMRelabelConfigListerExpansion interface{}
+
+// VMRemoteWriteTargetListerExpansion allows custom methods to be added to
+// VMRemoteWriteTargetLister.
+type VMRemoteWriteTargetListerExpansion interface{}
+
 // VMRuleListerExpansion allows custom methods to be added to
 // VMRuleLister.
 type VMRuleListerExpansion interface{}
@@ -105,6 +145,14 @@ type VMRuleListerExpansion interface{}
 // VMRuleNamespaceLister.
 type VMRuleNamespaceListerExpansion interface{}
 
+// VMRuleTestListerExpansion allows custom methods to be added to
+// VMRuleTestLister.
+type VMRuleTestListerExpansion interface{}
+
+// VMRuleTestNamespaceListerExpansion allows custom methods to be added to
+// VMRuleTestNamespaceLister.
+type VMRuleTestNamespaceListerExpansion interface{}
+
 // VMScrapeConfigListerExpansion allows custom methods to be added to
 // VMScrapeConfigLister.
 type VMScrapeConfigListerExpansion interface{}
@@ -113,6 +161,10 @@ type VMScrapeConfigListerExpansion interface{}
 // VMScrapeConfigNamespaceLister.
 type VMScrapeConfigNamespaceListerExpansion interface{}
 
+// VMScrapeGlobalConfigListerExpansion allows custom methods to be added to
+// VMScrapeGlobalConfigLister.
+type VMScrapeGlobalConfigListerExpansion interface{}
+
 // VMServiceScrapeListerExpansion allows custom methods to be added to
 // VMServiceScrapeLister.
 type VMServiceScrapeListerExpansion interface{}
@@ -0,0 +1,106 @@
+// Package reconcile provides client for operator reconcile trigger API.
+//
+// Operator must be started with -reconcileTrigger.enable flag.
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Path is an operator HTTP path for reconcile trigger endpoint
+const Path = "/api/v1/reconcile"
+
+// ObjectRef identifies object scheduled for reconcile
+type ObjectRef struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// Response is returned by reconcile trigger endpoint
+type Response struct {
+	// Kind of reconciled objects
+	Kind string `json:"kind"`
+	// RequestedBy is a name of authenticated principal
+	RequestedBy string `json:"requestedBy"`
+	// Objects scheduled for reconcile
+	Objects []ObjectRef `json:"objects"`
+}
+
+// Client requests reconcile of operator objects
+type Client struct {
+	baseURL string
+	token   string
+	hc      *http.Client
+}
+
+// NewClient returns client for operator available at baseURL.
+// Given bearer token is used for authentication and must have patch permission for requested objects.
+// http.DefaultClient is used if hc is nil.
+func NewClient(baseURL, token string, hc *http.Client) *Client {
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	return &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+		hc:      hc,
+	}
+}
+
+// Reconcile forces reconcile of object with given kind, namespace and name
+func (c *Client) Reconcile(ctx context.Context, kind, namespace, name string) (*Response, error) {
+	if namespace == "" || name == "" {
+		return nil, fmt.Errorf("namespace and name must be set")
+	}
+	return c.do(ctx, kind, namespace, name)
+}
+
+// ReconcileAll forces reconcile of all objects with given kind at namespace.
+// Empty namespace means all namespaces.
+func (c *Client) ReconcileAll(ctx context.Context, kind, namespace string) (*Response, error) {
+	return c.do(ctx, kind, namespace, "")
+}
+
+func (c *Client) do(ctx context.Context, kind, namespace, name string) (*Response, error) {
+	if kind == "" {
+		return nil, fmt.Errorf("kind must be set")
+	}
+	params := url.Values{}
+	params.Set("kind", kind)
+	if namespace != "" {
+		params.Set("namespace", namespace)
+	}
+	if name != "" {
+		params.Set("name", name)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+Path+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot build request: %w", err)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("cannot execute request: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response code=%d, body=%q", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	var r Response
+	if err := json.Unmarshal(body, &r); err != nil {
+		return nil, fmt.Errorf("cannot parse response: %w", err)
+	}
+	return &r, nil
+}
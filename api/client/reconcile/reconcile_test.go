@@ -0,0 +1,64 @@
+package reconcile
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClientReconcile(t *testing.T) {
+	var gotMethod, gotQuery, gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotQuery = r.URL.Query().Encode()
+		gotAuth = r.Header.Get("Authorization")
+		if r.URL.Path != Path {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("name") == "denied" {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		w.Write([]byte(`{"kind":"VMAgent","requestedBy":"admin","objects":[{"namespace":"default","name":"main"}]}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL+"/", "secret", nil)
+	resp, err := c.Reconcile(context.Background(), "vmagent", "default", "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if gotMethod != http.MethodPost {
+		t.Fatalf("unexpected method: %s", gotMethod)
+	}
+	if gotQuery != "kind=vmagent&name=main&namespace=default" {
+		t.Fatalf("unexpected query: %s", gotQuery)
+	}
+	if gotAuth != "Bearer secret" {
+		t.Fatalf("unexpected authorization header: %s", gotAuth)
+	}
+	want := &Response{Kind: "VMAgent", RequestedBy: "admin", Objects: []ObjectRef{{Namespace: "default", Name: "main"}}}
+	if !reflect.DeepEqual(resp, want) {
+		t.Fatalf("unexpected response\ngot:  %v\nwant: %v", resp, want)
+	}
+
+	if _, err := c.ReconcileAll(context.Background(), "vmagent", ""); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if gotQuery != "kind=vmagent" {
+		t.Fatalf("unexpected query: %s", gotQuery)
+	}
+
+	if _, err := c.Reconcile(context.Background(), "vmagent", "default", "denied"); err == nil {
+		t.Fatalf("expected error for forbidden response")
+	}
+	if _, err := c.Reconcile(context.Background(), "vmagent", "", "main"); err == nil {
+		t.Fatalf("expected error for missing namespace")
+	}
+	if _, err := c.ReconcileAll(context.Background(), "", "default"); err == nil {
+		t.Fatalf("expected error for missing kind")
+	}
+}
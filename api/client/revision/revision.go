@@ -0,0 +1,138 @@
+// Package revision provides access to revision history of Deployments and StatefulSets managed by operator.
+//
+// Operator records pod template of each applied revision into ControllerRevision object
+// owned by the workload, if -controller.revisionHistoryLimit flag is greater than zero.
+package revision
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+// Kind returns kind of supported workload or error
+func Kind(obj client.Object) (string, error) {
+	switch obj.(type) {
+	case *appsv1.Deployment:
+		return "Deployment", nil
+	case *appsv1.StatefulSet:
+		return "StatefulSet", nil
+	default:
+		return "", fmt.Errorf("unsupported object type=%T, only Deployment and StatefulSet are supported", obj)
+	}
+}
+
+// Name returns name of ControllerRevision for the given workload revision
+func Name(kind, name string, revision int64) string {
+	return fmt.Sprintf("%s-%s-%d", name, kindShortName(kind), revision)
+}
+
+func kindShortName(kind string) string {
+	switch kind {
+	case "Deployment":
+		return "deploy"
+	case "StatefulSet":
+		return "sts"
+	default:
+		return "rev"
+	}
+}
+
+// Current returns revision number of workload applied by operator
+// zero means that revision isn't tracked
+func Current(obj client.Object) int64 {
+	rev, _ := strconv.ParseInt(obj.GetAnnotations()[vmv1beta1.RevisionAnnotation], 10, 64)
+	return rev
+}
+
+// History returns ControllerRevisions of the given workload sorted by revision number.
+func History(ctx context.Context, c client.Reader, obj client.Object) ([]appsv1.ControllerRevision, error) {
+	kind, err := Kind(obj)
+	if err != nil {
+		return nil, err
+	}
+	var l appsv1.ControllerRevisionList
+	if err := c.List(ctx, &l, client.InNamespace(obj.GetNamespace()), client.MatchingLabels{vmv1beta1.RevisionKindLabel: kind}); err != nil {
+		return nil, fmt.Errorf("cannot list revisions of %s=%s: %w", kind, obj.GetName(), err)
+	}
+	var history []appsv1.ControllerRevision
+	for _, cr := range l.Items {
+		if cr.Annotations[vmv1beta1.RevisionOfAnnotation] == obj.GetName() {
+			history = append(history, cr)
+		}
+	}
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].Revision < history[j].Revision
+	})
+	return history, nil
+}
+
+// PodTemplate returns pod template stored at ControllerRevision
+func PodTemplate(cr *appsv1.ControllerRevision) (*corev1.PodTemplateSpec, error) {
+	var tpl corev1.PodTemplateSpec
+	if err := json.Unmarshal(cr.Data.Raw, &tpl); err != nil {
+		return nil, fmt.Errorf("cannot parse pod template of revision=%d: %w", cr.Revision, err)
+	}
+	return &tpl, nil
+}
+
+// Rollback restores pod template of Deployment or StatefulSet with the given name and namespace from revision history.
+// Zero revision means the previous one.
+// Operator keeps rolled back pod template until the next change of parent object.
+func Rollback(ctx context.Context, c client.Client, obj client.Object, revision int64) error {
+	kind, err := Kind(obj)
+	if err != nil {
+		return err
+	}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, obj); err != nil {
+		return fmt.Errorf("cannot get %s=%s: %w", kind, obj.GetName(), err)
+	}
+	history, err := History(ctx, c, obj)
+	if err != nil {
+		return err
+	}
+	current := Current(obj)
+	var target *appsv1.ControllerRevision
+	for i := len(history) - 1; i >= 0; i-- {
+		h := &history[i]
+		if (revision == 0 && h.Revision < current) || (revision != 0 && h.Revision == revision) {
+			target = h
+			break
+		}
+	}
+	if target == nil {
+		if revision == 0 {
+			return fmt.Errorf("cannot find previous revision of %s=%s, current revision=%d", kind, obj.GetName(), current)
+		}
+		return fmt.Errorf("cannot find revision=%d of %s=%s", revision, kind, obj.GetName())
+	}
+	tpl, err := PodTemplate(target)
+	if err != nil {
+		return err
+	}
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		o.Spec.Template = *tpl
+	case *appsv1.StatefulSet:
+		o.Spec.Template = *tpl
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[vmv1beta1.RollbackRevisionAnnotation] = strconv.FormatInt(target.Revision, 10)
+	obj.SetAnnotations(annotations)
+	if err := c.Update(ctx, obj); err != nil {
+		return fmt.Errorf("cannot rollback %s=%s to revision=%d: %w", kind, obj.GetName(), target.Revision, err)
+	}
+	return nil
+}
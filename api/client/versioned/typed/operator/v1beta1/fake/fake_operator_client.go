@@ -47,6 +47,10 @@ func (c *FakeOperatorV1beta1) VMAlertmanagerConfigs(namespace string) v1beta1.VM
 	return &FakeVMAlertmanagerConfigs{c, namespace}
 }
 
+func (c *FakeOperatorV1beta1) VMAlertmanagerTemplates(namespace string) v1beta1.VMAlertmanagerTemplateInterface {
+	return &FakeVMAlertmanagerTemplates{c, namespace}
+}
+
 func (c *FakeOperatorV1beta1) VMAuths(namespace string) v1beta1.VMAuthInterface {
 	return &FakeVMAuths{c, namespace}
 }
@@ -55,10 +59,22 @@ func (c *FakeOperatorV1beta1) VMClusters(namespace string) v1beta1.VMClusterInte
 	return &FakeVMClusters{c, namespace}
 }
 
+func (c *FakeOperatorV1beta1) VMDataMigrations(namespace string) v1beta1.VMDataMigrationInterface {
+	return &FakeVMDataMigrations{c, namespace}
+}
+
+func (c *FakeOperatorV1beta1) VMMaintenanceTasks(namespace string) v1beta1.VMMaintenanceTaskInterface {
+	return &FakeVMMaintenanceTasks{c, namespace}
+}
+
 func (c *FakeOperatorV1beta1) VMNodeScrapes(namespace string) v1beta1.VMNodeScrapeInterface {
 	return &FakeVMNodeScrapes{c, namespace}
 }
 
+func (c *FakeOperatorV1beta1) VMOperatorSettings(namespace string) v1beta1.VMOperatorSettingsInterface {
+	return &FakeVMOperatorSettings{c, namespace}
+}
+
 func (c *FakeOperatorV1beta1) VMPodScrapes(namespace string) v1beta1.VMPodScrapeInterface {
 	return &FakeVMPodScrapes{c, namespace}
 }
@@ -67,14 +83,30 @@ func (c *FakeOperatorV1beta1) VMProbes(namespace string) v1beta1.VMProbeInterfac
 	return &FakeVMProbes{c, namespace}
 }
 
+func (c *FakeOperatorV1beta1) VMRelabelConfigs() v1beta1.VMRelabelConfigInterface {
+	return &FakeVMRelabelConfigs{c}
+}
+
+func (c *FakeOperatorV1beta1) VMRemoteWriteTargets() v1beta1.VMRemoteWriteTargetInterface {
+	return &FakeVMRemoteWriteTargets{c}
+}
+
 func (c *FakeOperatorV1beta1) VMRules(namespace string) v1beta1.VMRuleInterface {
 	return &FakeVMRules{c, namespace}
 }
 
+func (c *FakeOperatorV1beta1) VMRuleTests(namespace string) v1beta1.VMRuleTestInterface {
+	return &FakeVMRuleTests{c, namespace}
+}
+
 func (c *FakeOperatorV1beta1) VMScrapeConfigs(namespace string) v1beta1.VMScrapeConfigInterface {
 	return &FakeVMScrapeConfigs{c, namespace}
 }
 
+func (c *FakeOperatorV1beta1) VMScrapeGlobalConfigs() v1beta1.VMScrapeGlobalConfigInterface {
+	return &FakeVMScrapeGlobalConfigs{c}
+}
+
 func (c *FakeOperatorV1beta1) VMServiceScrapes(namespace string) v1beta1.VMServiceScrapeInterface {
 	return &FakeVMServiceScrapes{c, namespace}
 }
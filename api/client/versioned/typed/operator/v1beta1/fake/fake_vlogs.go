@@ -19,7 +19,10 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -138,3 +141,48 @@ func (c *FakeVLogs) Patch(ctx context.Context, name string, pt types.PatchType,
 	}
 	return obj.(*v1beta1.VLogs), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vLogs.
+func (c *FakeVLogs) Apply(ctx context.Context, vLogs *operatorv1beta1.VLogsApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VLogs, err error) {
+	if vLogs == nil {
+		return nil, fmt.Errorf("vLogs provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vLogs)
+	if err != nil {
+		return nil, err
+	}
+	name := vLogs.Name
+	if name == nil {
+		return nil, fmt.Errorf("vLogs.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vlogsResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VLogs{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VLogs), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVLogs) ApplyStatus(ctx context.Context, vLogs *operatorv1beta1.VLogsApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VLogs, err error) {
+	if vLogs == nil {
+		return nil, fmt.Errorf("vLogs provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vLogs)
+	if err != nil {
+		return nil, err
+	}
+	name := vLogs.Name
+	if name == nil {
+		return nil, fmt.Errorf("vLogs.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vlogsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VLogs{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VLogs), err
+}
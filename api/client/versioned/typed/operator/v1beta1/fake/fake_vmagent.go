@@ -19,7 +19,10 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -138,3 +141,48 @@ func (c *FakeVMAgents) Patch(ctx context.Context, name string, pt types.PatchTyp
 	}
 	return obj.(*v1beta1.VMAgent), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMAgent.
+func (c *FakeVMAgents) Apply(ctx context.Context, vMAgent *operatorv1beta1.VMAgentApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAgent, err error) {
+	if vMAgent == nil {
+		return nil, fmt.Errorf("vMAgent provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMAgent)
+	if err != nil {
+		return nil, err
+	}
+	name := vMAgent.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMAgent.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmagentsResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VMAgent{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMAgent), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVMAgents) ApplyStatus(ctx context.Context, vMAgent *operatorv1beta1.VMAgentApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAgent, err error) {
+	if vMAgent == nil {
+		return nil, fmt.Errorf("vMAgent provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMAgent)
+	if err != nil {
+		return nil, err
+	}
+	name := vMAgent.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMAgent.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmagentsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VMAgent{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMAgent), err
+}
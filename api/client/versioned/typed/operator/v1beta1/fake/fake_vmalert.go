@@ -19,7 +19,10 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -138,3 +141,48 @@ func (c *FakeVMAlerts) Patch(ctx context.Context, name string, pt types.PatchTyp
 	}
 	return obj.(*v1beta1.VMAlert), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMAlert.
+func (c *FakeVMAlerts) Apply(ctx context.Context, vMAlert *operatorv1beta1.VMAlertApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAlert, err error) {
+	if vMAlert == nil {
+		return nil, fmt.Errorf("vMAlert provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMAlert)
+	if err != nil {
+		return nil, err
+	}
+	name := vMAlert.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMAlert.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmalertsResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VMAlert{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMAlert), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVMAlerts) ApplyStatus(ctx context.Context, vMAlert *operatorv1beta1.VMAlertApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAlert, err error) {
+	if vMAlert == nil {
+		return nil, fmt.Errorf("vMAlert provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMAlert)
+	if err != nil {
+		return nil, err
+	}
+	name := vMAlert.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMAlert.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmalertsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VMAlert{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMAlert), err
+}
@@ -19,7 +19,10 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -138,3 +141,48 @@ func (c *FakeVMAlertmanagers) Patch(ctx context.Context, name string, pt types.P
 	}
 	return obj.(*v1beta1.VMAlertmanager), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMAlertmanager.
+func (c *FakeVMAlertmanagers) Apply(ctx context.Context, vMAlertmanager *operatorv1beta1.VMAlertmanagerApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAlertmanager, err error) {
+	if vMAlertmanager == nil {
+		return nil, fmt.Errorf("vMAlertmanager provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMAlertmanager)
+	if err != nil {
+		return nil, err
+	}
+	name := vMAlertmanager.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMAlertmanager.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmalertmanagersResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VMAlertmanager{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMAlertmanager), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVMAlertmanagers) ApplyStatus(ctx context.Context, vMAlertmanager *operatorv1beta1.VMAlertmanagerApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAlertmanager, err error) {
+	if vMAlertmanager == nil {
+		return nil, fmt.Errorf("vMAlertmanager provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMAlertmanager)
+	if err != nil {
+		return nil, err
+	}
+	name := vMAlertmanager.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMAlertmanager.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmalertmanagersResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VMAlertmanager{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMAlertmanager), err
+}
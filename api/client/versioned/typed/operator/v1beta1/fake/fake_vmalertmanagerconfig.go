@@ -19,7 +19,10 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -138,3 +141,48 @@ func (c *FakeVMAlertmanagerConfigs) Patch(ctx context.Context, name string, pt t
 	}
 	return obj.(*v1beta1.VMAlertmanagerConfig), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMAlertmanagerConfig.
+func (c *FakeVMAlertmanagerConfigs) Apply(ctx context.Context, vMAlertmanagerConfig *operatorv1beta1.VMAlertmanagerConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAlertmanagerConfig, err error) {
+	if vMAlertmanagerConfig == nil {
+		return nil, fmt.Errorf("vMAlertmanagerConfig provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMAlertmanagerConfig)
+	if err != nil {
+		return nil, err
+	}
+	name := vMAlertmanagerConfig.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMAlertmanagerConfig.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmalertmanagerconfigsResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VMAlertmanagerConfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMAlertmanagerConfig), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVMAlertmanagerConfigs) ApplyStatus(ctx context.Context, vMAlertmanagerConfig *operatorv1beta1.VMAlertmanagerConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAlertmanagerConfig, err error) {
+	if vMAlertmanagerConfig == nil {
+		return nil, fmt.Errorf("vMAlertmanagerConfig provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMAlertmanagerConfig)
+	if err != nil {
+		return nil, err
+	}
+	name := vMAlertmanagerConfig.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMAlertmanagerConfig.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmalertmanagerconfigsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VMAlertmanagerConfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMAlertmanagerConfig), err
+}
@@ -19,7 +19,10 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -138,3 +141,48 @@ func (c *FakeVMAuths) Patch(ctx context.Context, name string, pt types.PatchType
 	}
 	return obj.(*v1beta1.VMAuth), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMAuth.
+func (c *FakeVMAuths) Apply(ctx context.Context, vMAuth *operatorv1beta1.VMAuthApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAuth, err error) {
+	if vMAuth == nil {
+		return nil, fmt.Errorf("vMAuth provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMAuth)
+	if err != nil {
+		return nil, err
+	}
+	name := vMAuth.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMAuth.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmauthsResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VMAuth{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMAuth), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVMAuths) ApplyStatus(ctx context.Context, vMAuth *operatorv1beta1.VMAuthApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAuth, err error) {
+	if vMAuth == nil {
+		return nil, fmt.Errorf("vMAuth provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMAuth)
+	if err != nil {
+		return nil, err
+	}
+	name := vMAuth.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMAuth.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmauthsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VMAuth{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMAuth), err
+}
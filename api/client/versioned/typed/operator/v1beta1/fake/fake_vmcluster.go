@@ -19,7 +19,10 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -138,3 +141,48 @@ func (c *FakeVMClusters) Patch(ctx context.Context, name string, pt types.PatchT
 	}
 	return obj.(*v1beta1.VMCluster), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMCluster.
+func (c *FakeVMClusters) Apply(ctx context.Context, vMCluster *operatorv1beta1.VMClusterApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMCluster, err error) {
+	if vMCluster == nil {
+		return nil, fmt.Errorf("vMCluster provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMCluster)
+	if err != nil {
+		return nil, err
+	}
+	name := vMCluster.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMCluster.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmclustersResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VMCluster{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMCluster), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVMClusters) ApplyStatus(ctx context.Context, vMCluster *operatorv1beta1.VMClusterApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMCluster, err error) {
+	if vMCluster == nil {
+		return nil, fmt.Errorf("vMCluster provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMCluster)
+	if err != nil {
+		return nil, err
+	}
+	name := vMCluster.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMCluster.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmclustersResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VMCluster{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMCluster), err
+}
@@ -19,7 +19,10 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -138,3 +141,48 @@ func (c *FakeVMNodeScrapes) Patch(ctx context.Context, name string, pt types.Pat
 	}
 	return obj.(*v1beta1.VMNodeScrape), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMNodeScrape.
+func (c *FakeVMNodeScrapes) Apply(ctx context.Context, vMNodeScrape *operatorv1beta1.VMNodeScrapeApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMNodeScrape, err error) {
+	if vMNodeScrape == nil {
+		return nil, fmt.Errorf("vMNodeScrape provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMNodeScrape)
+	if err != nil {
+		return nil, err
+	}
+	name := vMNodeScrape.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMNodeScrape.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmnodescrapesResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VMNodeScrape{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMNodeScrape), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVMNodeScrapes) ApplyStatus(ctx context.Context, vMNodeScrape *operatorv1beta1.VMNodeScrapeApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMNodeScrape, err error) {
+	if vMNodeScrape == nil {
+		return nil, fmt.Errorf("vMNodeScrape provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMNodeScrape)
+	if err != nil {
+		return nil, err
+	}
+	name := vMNodeScrape.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMNodeScrape.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmnodescrapesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VMNodeScrape{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMNodeScrape), err
+}
@@ -19,7 +19,10 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -138,3 +141,48 @@ func (c *FakeVMPodScrapes) Patch(ctx context.Context, name string, pt types.Patc
 	}
 	return obj.(*v1beta1.VMPodScrape), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMPodScrape.
+func (c *FakeVMPodScrapes) Apply(ctx context.Context, vMPodScrape *operatorv1beta1.VMPodScrapeApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMPodScrape, err error) {
+	if vMPodScrape == nil {
+		return nil, fmt.Errorf("vMPodScrape provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMPodScrape)
+	if err != nil {
+		return nil, err
+	}
+	name := vMPodScrape.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMPodScrape.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmpodscrapesResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VMPodScrape{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMPodScrape), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVMPodScrapes) ApplyStatus(ctx context.Context, vMPodScrape *operatorv1beta1.VMPodScrapeApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMPodScrape, err error) {
+	if vMPodScrape == nil {
+		return nil, fmt.Errorf("vMPodScrape provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMPodScrape)
+	if err != nil {
+		return nil, err
+	}
+	name := vMPodScrape.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMPodScrape.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmpodscrapesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VMPodScrape{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMPodScrape), err
+}
@@ -19,7 +19,10 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -138,3 +141,48 @@ func (c *FakeVMProbes) Patch(ctx context.Context, name string, pt types.PatchTyp
 	}
 	return obj.(*v1beta1.VMProbe), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMProbe.
+func (c *FakeVMProbes) Apply(ctx context.Context, vMProbe *operatorv1beta1.VMProbeApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMProbe, err error) {
+	if vMProbe == nil {
+		return nil, fmt.Errorf("vMProbe provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMProbe)
+	if err != nil {
+		return nil, err
+	}
+	name := vMProbe.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMProbe.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmprobesResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VMProbe{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMProbe), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVMProbes) ApplyStatus(ctx context.Context, vMProbe *operatorv1beta1.VMProbeApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMProbe, err error) {
+	if vMProbe == nil {
+		return nil, fmt.Errorf("vMProbe provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMProbe)
+	if err != nil {
+		return nil, err
+	}
+	name := vMProbe.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMProbe.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmprobesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VMProbe{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMProbe), err
+}
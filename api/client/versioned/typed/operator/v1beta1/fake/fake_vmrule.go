@@ -19,7 +19,10 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -138,3 +141,48 @@ func (c *FakeVMRules) Patch(ctx context.Context, name string, pt types.PatchType
 	}
 	return obj.(*v1beta1.VMRule), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMRule.
+func (c *FakeVMRules) Apply(ctx context.Context, vMRule *operatorv1beta1.VMRuleApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMRule, err error) {
+	if vMRule == nil {
+		return nil, fmt.Errorf("vMRule provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMRule)
+	if err != nil {
+		return nil, err
+	}
+	name := vMRule.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMRule.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmrulesResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VMRule{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMRule), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVMRules) ApplyStatus(ctx context.Context, vMRule *operatorv1beta1.VMRuleApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMRule, err error) {
+	if vMRule == nil {
+		return nil, fmt.Errorf("vMRule provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMRule)
+	if err != nil {
+		return nil, err
+	}
+	name := vMRule.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMRule.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmrulesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VMRule{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMRule), err
+}
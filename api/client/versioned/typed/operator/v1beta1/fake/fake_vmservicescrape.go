@@ -19,7 +19,10 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -138,3 +141,48 @@ func (c *FakeVMServiceScrapes) Patch(ctx context.Context, name string, pt types.
 	}
 	return obj.(*v1beta1.VMServiceScrape), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMServiceScrape.
+func (c *FakeVMServiceScrapes) Apply(ctx context.Context, vMServiceScrape *operatorv1beta1.VMServiceScrapeApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMServiceScrape, err error) {
+	if vMServiceScrape == nil {
+		return nil, fmt.Errorf("vMServiceScrape provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMServiceScrape)
+	if err != nil {
+		return nil, err
+	}
+	name := vMServiceScrape.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMServiceScrape.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmservicescrapesResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VMServiceScrape{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMServiceScrape), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVMServiceScrapes) ApplyStatus(ctx context.Context, vMServiceScrape *operatorv1beta1.VMServiceScrapeApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMServiceScrape, err error) {
+	if vMServiceScrape == nil {
+		return nil, fmt.Errorf("vMServiceScrape provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMServiceScrape)
+	if err != nil {
+		return nil, err
+	}
+	name := vMServiceScrape.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMServiceScrape.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmservicescrapesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VMServiceScrape{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMServiceScrape), err
+}
@@ -19,7 +19,10 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -138,3 +141,48 @@ func (c *FakeVMSingles) Patch(ctx context.Context, name string, pt types.PatchTy
 	}
 	return obj.(*v1beta1.VMSingle), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMSingle.
+func (c *FakeVMSingles) Apply(ctx context.Context, vMSingle *operatorv1beta1.VMSingleApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMSingle, err error) {
+	if vMSingle == nil {
+		return nil, fmt.Errorf("vMSingle provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMSingle)
+	if err != nil {
+		return nil, err
+	}
+	name := vMSingle.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMSingle.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmsinglesResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VMSingle{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMSingle), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVMSingles) ApplyStatus(ctx context.Context, vMSingle *operatorv1beta1.VMSingleApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMSingle, err error) {
+	if vMSingle == nil {
+		return nil, fmt.Errorf("vMSingle provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMSingle)
+	if err != nil {
+		return nil, err
+	}
+	name := vMSingle.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMSingle.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmsinglesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VMSingle{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMSingle), err
+}
@@ -19,7 +19,10 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -138,3 +141,48 @@ func (c *FakeVMStaticScrapes) Patch(ctx context.Context, name string, pt types.P
 	}
 	return obj.(*v1beta1.VMStaticScrape), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMStaticScrape.
+func (c *FakeVMStaticScrapes) Apply(ctx context.Context, vMStaticScrape *operatorv1beta1.VMStaticScrapeApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMStaticScrape, err error) {
+	if vMStaticScrape == nil {
+		return nil, fmt.Errorf("vMStaticScrape provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMStaticScrape)
+	if err != nil {
+		return nil, err
+	}
+	name := vMStaticScrape.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMStaticScrape.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmstaticscrapesResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.VMStaticScrape{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMStaticScrape), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVMStaticScrapes) ApplyStatus(ctx context.Context, vMStaticScrape *operatorv1beta1.VMStaticScrapeApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMStaticScrape, err error) {
+	if vMStaticScrape == nil {
+		return nil, fmt.Errorf("vMStaticScrape provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(vMStaticScrape)
+	if err != nil {
+		return nil, err
+	}
+	name := vMStaticScrape.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMStaticScrape.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmstaticscrapesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.VMStaticScrape{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.VMStaticScrape), err
+}
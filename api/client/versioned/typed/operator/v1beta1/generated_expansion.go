@@ -27,20 +27,36 @@ type VMAlertmanagerExpansion interface{}
 
 type VMAlertmanagerConfigExpansion interface{}
 
+type VMAlertmanagerTemplateExpansion interface{}
+
 type VMAuthExpansion interface{}
 
 type VMClusterExpansion interface{}
 
+type VMDataMigrationExpansion interface{}
+
+type VMMaintenanceTaskExpansion interface{}
+
 type VMNodeScrapeExpansion interface{}
 
+type VMOperatorSettingsExpansion interface{}
+
 type VMPodScrapeExpansion interface{}
 
 type VMProbeExpansion interface{}
 
+type VMRelabelConfigExpansion interface{}
+
+type VMRemoteWriteTargetExpansion interface{}
+
 type VMRuleExpansion interface{}
 
+type VMRuleTestExpansion interface{}
+
 type VMScrapeConfigExpansion interface{}
 
+type VMScrapeGlobalConfigExpansion interface{}
+
 type VMServiceScrapeExpansion interface{}
 
 type VMSingleExpansion interface{}
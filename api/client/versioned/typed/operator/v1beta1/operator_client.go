@@ -32,20 +32,28 @@ type OperatorV1beta1Interface interface {
 	VMAlertsGetter
 	VMAlertmanagersGetter
 	VMAlertmanagerConfigsGetter
+	VMAlertmanagerTemplatesGetter
 	VMAuthsGetter
 	VMClustersGetter
+	VMDataMigrationsGetter
+	VMMaintenanceTasksGetter
 	VMNodeScrapesGetter
+	VMOperatorSettingsGetter
 	VMPodScrapesGetter
 	VMProbesGetter
+	VMRelabelConfigsGetter
+	VMRemoteWriteTargetsGetter
 	VMRulesGetter
+	VMRuleTestsGetter
 	VMScrapeConfigsGetter
+	VMScrapeGlobalConfigsGetter
 	VMServiceScrapesGetter
 	VMSinglesGetter
 	VMStaticScrapesGetter
 	VMUsersGetter
 }
 
-// OperatorV1beta1Client is used to interact with features provided by the operator group.
+// OperatorV1beta1Client is used to interact with features provided by the operator.victoriametrics.com group.
 type OperatorV1beta1Client struct {
 	restClient rest.Interface
 }
@@ -70,6 +78,10 @@ func (c *OperatorV1beta1Client) VMAlertmanagerConfigs(namespace string) VMAlertm
 	return newVMAlertmanagerConfigs(c, namespace)
 }
 
+func (c *OperatorV1beta1Client) VMAlertmanagerTemplates(namespace string) VMAlertmanagerTemplateInterface {
+	return newVMAlertmanagerTemplates(c, namespace)
+}
+
 func (c *OperatorV1beta1Client) VMAuths(namespace string) VMAuthInterface {
 	return newVMAuths(c, namespace)
 }
@@ -78,10 +90,22 @@ func (c *OperatorV1beta1Client) VMClusters(namespace string) VMClusterInterface
 	return newVMClusters(c, namespace)
 }
 
+func (c *OperatorV1beta1Client) VMDataMigrations(namespace string) VMDataMigrationInterface {
+	return newVMDataMigrations(c, namespace)
+}
+
+func (c *OperatorV1beta1Client) VMMaintenanceTasks(namespace string) VMMaintenanceTaskInterface {
+	return newVMMaintenanceTasks(c, namespace)
+}
+
 func (c *OperatorV1beta1Client) VMNodeScrapes(namespace string) VMNodeScrapeInterface {
 	return newVMNodeScrapes(c, namespace)
 }
 
+func (c *OperatorV1beta1Client) VMOperatorSettings(namespace string) VMOperatorSettingsInterface {
+	return newVMOperatorSettings(c, namespace)
+}
+
 func (c *OperatorV1beta1Client) VMPodScrapes(namespace string) VMPodScrapeInterface {
 	return newVMPodScrapes(c, namespace)
 }
@@ -90,14 +114,30 @@ func (c *OperatorV1beta1Client) VMProbes(namespace string) VMProbeInterface {
 	return newVMProbes(c, namespace)
 }
 
+func (c *OperatorV1beta1Client) VMRelabelConfigs() VMRelabelConfigInterface {
+	return newVMRelabelConfigs(c)
+}
+
+func (c *OperatorV1beta1Client) VMRemoteWriteTargets() VMRemoteWriteTargetInterface {
+	return newVMRemoteWriteTargets(c)
+}
+
 func (c *OperatorV1beta1Client) VMRules(namespace string) VMRuleInterface {
 	return newVMRules(c, namespace)
 }
 
+func (c *OperatorV1beta1Client) VMRuleTests(namespace string) VMRuleTestInterface {
+	return newVMRuleTests(c, namespace)
+}
+
 func (c *OperatorV1beta1Client) VMScrapeConfigs(namespace string) VMScrapeConfigInterface {
 	return newVMScrapeConfigs(c, namespace)
 }
 
+func (c *OperatorV1beta1Client) VMScrapeGlobalConfigs() VMScrapeGlobalConfigInterface {
+	return newVMScrapeGlobalConfigs(c)
+}
+
 func (c *OperatorV1beta1Client) VMServiceScrapes(namespace string) VMServiceScrapeInterface {
 	return newVMServiceScrapes(c, namespace)
 }
@@ -19,8 +19,11 @@ package v1beta1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	scheme "github.com/VictoriaMetrics/operator/api/client/versioned/scheme"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -46,6 +49,8 @@ type VLogsInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.VLogsList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VLogs, err error)
+	Apply(ctx context.Context, vLogs *operatorv1beta1.VLogsApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VLogs, err error)
+	ApplyStatus(ctx context.Context, vLogs *operatorv1beta1.VLogsApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VLogs, err error)
 	VLogsExpansion
 }
 
@@ -192,3 +197,59 @@ func (c *vLogs) Patch(ctx context.Context, name string, pt types.PatchType, data
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vLogs.
+func (c *vLogs) Apply(ctx context.Context, vLogs *operatorv1beta1.VLogsApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VLogs, err error) {
+	if vLogs == nil {
+		return nil, fmt.Errorf("vLogs provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(vLogs)
+	if err != nil {
+		return nil, err
+	}
+	name := vLogs.Name
+	if name == nil {
+		return nil, fmt.Errorf("vLogs.Name must be provided to Apply")
+	}
+	result = &v1beta1.VLogs{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("vlogs").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *vLogs) ApplyStatus(ctx context.Context, vLogs *operatorv1beta1.VLogsApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VLogs, err error) {
+	if vLogs == nil {
+		return nil, fmt.Errorf("vLogs provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(vLogs)
+	if err != nil {
+		return nil, err
+	}
+
+	name := vLogs.Name
+	if name == nil {
+		return nil, fmt.Errorf("vLogs.Name must be provided to Apply")
+	}
+
+	result = &v1beta1.VLogs{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("vlogs").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -19,8 +19,11 @@ package v1beta1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	scheme "github.com/VictoriaMetrics/operator/api/client/versioned/scheme"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -46,6 +49,8 @@ type VMAgentInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.VMAgentList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VMAgent, err error)
+	Apply(ctx context.Context, vMAgent *operatorv1beta1.VMAgentApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAgent, err error)
+	ApplyStatus(ctx context.Context, vMAgent *operatorv1beta1.VMAgentApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAgent, err error)
 	VMAgentExpansion
 }
 
@@ -192,3 +197,59 @@ func (c *vMAgents) Patch(ctx context.Context, name string, pt types.PatchType, d
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMAgent.
+func (c *vMAgents) Apply(ctx context.Context, vMAgent *operatorv1beta1.VMAgentApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAgent, err error) {
+	if vMAgent == nil {
+		return nil, fmt.Errorf("vMAgent provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(vMAgent)
+	if err != nil {
+		return nil, err
+	}
+	name := vMAgent.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMAgent.Name must be provided to Apply")
+	}
+	result = &v1beta1.VMAgent{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("vmagents").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *vMAgents) ApplyStatus(ctx context.Context, vMAgent *operatorv1beta1.VMAgentApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAgent, err error) {
+	if vMAgent == nil {
+		return nil, fmt.Errorf("vMAgent provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(vMAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	name := vMAgent.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMAgent.Name must be provided to Apply")
+	}
+
+	result = &v1beta1.VMAgent{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("vmagents").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
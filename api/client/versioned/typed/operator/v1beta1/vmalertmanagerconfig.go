@@ -19,8 +19,11 @@ package v1beta1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	scheme "github.com/VictoriaMetrics/operator/api/client/versioned/scheme"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -46,6 +49,8 @@ type VMAlertmanagerConfigInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.VMAlertmanagerConfigList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VMAlertmanagerConfig, err error)
+	Apply(ctx context.Context, vMAlertmanagerConfig *operatorv1beta1.VMAlertmanagerConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAlertmanagerConfig, err error)
+	ApplyStatus(ctx context.Context, vMAlertmanagerConfig *operatorv1beta1.VMAlertmanagerConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAlertmanagerConfig, err error)
 	VMAlertmanagerConfigExpansion
 }
 
@@ -192,3 +197,59 @@ func (c *vMAlertmanagerConfigs) Patch(ctx context.Context, name string, pt types
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMAlertmanagerConfig.
+func (c *vMAlertmanagerConfigs) Apply(ctx context.Context, vMAlertmanagerConfig *operatorv1beta1.VMAlertmanagerConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAlertmanagerConfig, err error) {
+	if vMAlertmanagerConfig == nil {
+		return nil, fmt.Errorf("vMAlertmanagerConfig provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(vMAlertmanagerConfig)
+	if err != nil {
+		return nil, err
+	}
+	name := vMAlertmanagerConfig.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMAlertmanagerConfig.Name must be provided to Apply")
+	}
+	result = &v1beta1.VMAlertmanagerConfig{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("vmalertmanagerconfigs").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *vMAlertmanagerConfigs) ApplyStatus(ctx context.Context, vMAlertmanagerConfig *operatorv1beta1.VMAlertmanagerConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAlertmanagerConfig, err error) {
+	if vMAlertmanagerConfig == nil {
+		return nil, fmt.Errorf("vMAlertmanagerConfig provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(vMAlertmanagerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	name := vMAlertmanagerConfig.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMAlertmanagerConfig.Name must be provided to Apply")
+	}
+
+	result = &v1beta1.VMAlertmanagerConfig{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("vmalertmanagerconfigs").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -19,8 +19,11 @@ package v1beta1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	scheme "github.com/VictoriaMetrics/operator/api/client/versioned/scheme"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -46,6 +49,8 @@ type VMAuthInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.VMAuthList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VMAuth, err error)
+	Apply(ctx context.Context, vMAuth *operatorv1beta1.VMAuthApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAuth, err error)
+	ApplyStatus(ctx context.Context, vMAuth *operatorv1beta1.VMAuthApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAuth, err error)
 	VMAuthExpansion
 }
 
@@ -192,3 +197,59 @@ func (c *vMAuths) Patch(ctx context.Context, name string, pt types.PatchType, da
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMAuth.
+func (c *vMAuths) Apply(ctx context.Context, vMAuth *operatorv1beta1.VMAuthApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAuth, err error) {
+	if vMAuth == nil {
+		return nil, fmt.Errorf("vMAuth provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(vMAuth)
+	if err != nil {
+		return nil, err
+	}
+	name := vMAuth.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMAuth.Name must be provided to Apply")
+	}
+	result = &v1beta1.VMAuth{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("vmauths").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *vMAuths) ApplyStatus(ctx context.Context, vMAuth *operatorv1beta1.VMAuthApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMAuth, err error) {
+	if vMAuth == nil {
+		return nil, fmt.Errorf("vMAuth provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(vMAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	name := vMAuth.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMAuth.Name must be provided to Apply")
+	}
+
+	result = &v1beta1.VMAuth{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("vmauths").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
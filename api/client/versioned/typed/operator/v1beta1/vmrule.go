@@ -19,8 +19,11 @@ package v1beta1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	scheme "github.com/VictoriaMetrics/operator/api/client/versioned/scheme"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -46,6 +49,8 @@ type VMRuleInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.VMRuleList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VMRule, err error)
+	Apply(ctx context.Context, vMRule *operatorv1beta1.VMRuleApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMRule, err error)
+	ApplyStatus(ctx context.Context, vMRule *operatorv1beta1.VMRuleApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMRule, err error)
 	VMRuleExpansion
 }
 
@@ -192,3 +197,59 @@ func (c *vMRules) Patch(ctx context.Context, name string, pt types.PatchType, da
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMRule.
+func (c *vMRules) Apply(ctx context.Context, vMRule *operatorv1beta1.VMRuleApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMRule, err error) {
+	if vMRule == nil {
+		return nil, fmt.Errorf("vMRule provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(vMRule)
+	if err != nil {
+		return nil, err
+	}
+	name := vMRule.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMRule.Name must be provided to Apply")
+	}
+	result = &v1beta1.VMRule{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("vmrules").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *vMRules) ApplyStatus(ctx context.Context, vMRule *operatorv1beta1.VMRuleApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMRule, err error) {
+	if vMRule == nil {
+		return nil, fmt.Errorf("vMRule provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(vMRule)
+	if err != nil {
+		return nil, err
+	}
+
+	name := vMRule.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMRule.Name must be provided to Apply")
+	}
+
+	result = &v1beta1.VMRule{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("vmrules").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -19,8 +19,11 @@ package v1beta1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	scheme "github.com/VictoriaMetrics/operator/api/client/versioned/scheme"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -46,6 +49,8 @@ type VMScrapeConfigInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.VMScrapeConfigList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VMScrapeConfig, err error)
+	Apply(ctx context.Context, vMScrapeConfig *operatorv1beta1.VMScrapeConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMScrapeConfig, err error)
+	ApplyStatus(ctx context.Context, vMScrapeConfig *operatorv1beta1.VMScrapeConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMScrapeConfig, err error)
 	VMScrapeConfigExpansion
 }
 
@@ -192,3 +197,59 @@ func (c *vMScrapeConfigs) Patch(ctx context.Context, name string, pt types.Patch
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMScrapeConfig.
+func (c *vMScrapeConfigs) Apply(ctx context.Context, vMScrapeConfig *operatorv1beta1.VMScrapeConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMScrapeConfig, err error) {
+	if vMScrapeConfig == nil {
+		return nil, fmt.Errorf("vMScrapeConfig provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(vMScrapeConfig)
+	if err != nil {
+		return nil, err
+	}
+	name := vMScrapeConfig.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMScrapeConfig.Name must be provided to Apply")
+	}
+	result = &v1beta1.VMScrapeConfig{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("vmscrapeconfigs").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *vMScrapeConfigs) ApplyStatus(ctx context.Context, vMScrapeConfig *operatorv1beta1.VMScrapeConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMScrapeConfig, err error) {
+	if vMScrapeConfig == nil {
+		return nil, fmt.Errorf("vMScrapeConfig provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(vMScrapeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	name := vMScrapeConfig.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMScrapeConfig.Name must be provided to Apply")
+	}
+
+	result = &v1beta1.VMScrapeConfig{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("vmscrapeconfigs").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
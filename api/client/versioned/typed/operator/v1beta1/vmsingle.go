@@ -19,8 +19,11 @@ package v1beta1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
+	operatorv1beta1 "github.com/VictoriaMetrics/operator/api/client/applyconfiguration/operator/v1beta1"
 	scheme "github.com/VictoriaMetrics/operator/api/client/versioned/scheme"
 	v1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -46,6 +49,8 @@ type VMSingleInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.VMSingleList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VMSingle, err error)
+	Apply(ctx context.Context, vMSingle *operatorv1beta1.VMSingleApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMSingle, err error)
+	ApplyStatus(ctx context.Context, vMSingle *operatorv1beta1.VMSingleApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMSingle, err error)
 	VMSingleExpansion
 }
 
@@ -192,3 +197,59 @@ func (c *vMSingles) Patch(ctx context.Context, name string, pt types.PatchType,
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied vMSingle.
+func (c *vMSingles) Apply(ctx context.Context, vMSingle *operatorv1beta1.VMSingleApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMSingle, err error) {
+	if vMSingle == nil {
+		return nil, fmt.Errorf("vMSingle provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(vMSingle)
+	if err != nil {
+		return nil, err
+	}
+	name := vMSingle.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMSingle.Name must be provided to Apply")
+	}
+	result = &v1beta1.VMSingle{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("vmsingles").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *vMSingles) ApplyStatus(ctx context.Context, vMSingle *operatorv1beta1.VMSingleApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.VMSingle, err error) {
+	if vMSingle == nil {
+		return nil, fmt.Errorf("vMSingle provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(vMSingle)
+	if err != nil {
+		return nil, err
+	}
+
+	name := vMSingle.Name
+	if name == nil {
+		return nil, fmt.Errorf("vMSingle.Name must be provided to Apply")
+	}
+
+	result = &v1beta1.VMSingle{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("vmsingles").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -292,6 +292,22 @@ type EndpointAuth struct {
 	Authorization *Authorization `json:"authorization,omitempty"`
 }
 
+func (ea *EndpointAuth) validate() error {
+	return validateTLSAndOAuth2(ea.TLSConfig, ea.OAuth2)
+}
+
+func validateTLSAndOAuth2(tlsConfig *TLSConfig, oauth2 *OAuth2) error {
+	if tlsConfig != nil {
+		if err := tlsConfig.Validate(); err != nil {
+			return fmt.Errorf("bad tlsConfig: %w", err)
+		}
+	}
+	if err := oauth2.validate(); err != nil {
+		return fmt.Errorf("bad oauth2: %w", err)
+	}
+	return nil
+}
+
 // EndpointRelabelings defines service discovery and metrics relabeling configuration for endpoints
 type EndpointRelabelings struct {
 	// MetricRelabelConfigs to apply to samples after scrapping.
@@ -300,4 +316,41 @@ type EndpointRelabelings struct {
 	// RelabelConfigs to apply to samples during service discovery.
 	// +optional
 	RelabelConfigs []*RelabelConfig `json:"relabelConfigs,omitempty"`
+	// MetricRelabelConfigRefs defines names of cluster-wide VMRelabelConfig objects,
+	// which relabeling rules are prepended to metricRelabelConfigs.
+	// +optional
+	MetricRelabelConfigRefs []string `json:"metricRelabelConfigRefs,omitempty"`
+	// RelabelConfigRefs defines names of cluster-wide VMRelabelConfig objects,
+	// which relabeling rules are prepended to relabelConfigs.
+	// +optional
+	RelabelConfigRefs []string `json:"relabelConfigRefs,omitempty"`
+}
+
+// HasRelabelConfigRefs checks if endpoint references VMRelabelConfig objects
+func (er *EndpointRelabelings) HasRelabelConfigRefs() bool {
+	return len(er.RelabelConfigRefs) > 0 || len(er.MetricRelabelConfigRefs) > 0
+}
+
+func (er *EndpointRelabelings) validate() error {
+	if err := checkRelabelConfigPtrs(er.RelabelConfigs); err != nil {
+		return fmt.Errorf("bad relabelConfigs: %w", err)
+	}
+	if err := checkRelabelConfigPtrs(er.MetricRelabelConfigs); err != nil {
+		return fmt.Errorf("bad metricRelabelConfigs: %w", err)
+	}
+	return nil
+}
+
+// checkRelabelConfigPtrs validates copy of given relabel configs
+func checkRelabelConfigPtrs(src []*RelabelConfig) error {
+	if len(src) == 0 {
+		return nil
+	}
+	rcs := make([]RelabelConfig, 0, len(src))
+	for _, rc := range src {
+		if rc != nil {
+			rcs = append(rcs, *rc)
+		}
+	}
+	return checkRelabelConfigs(rcs)
 }
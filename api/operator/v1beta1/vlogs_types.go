@@ -72,6 +72,11 @@ type VLogsSpec struct {
 	// by controller manager
 	// +optional
 	RemovePvcAfterDelete bool `json:"removePvcAfterDelete,omitempty"`
+	// DisruptionProtection adds karpenter.sh/do-not-disrupt and cluster-autoscaler.kubernetes.io/safe-to-evict=false annotations to vlogs pods.
+	// It prevents eviction of pods by node consolidation of karpenter and scale down of cluster-autoscaler,
+	// for instance during background merges of stored data. Annotations defined at podMetadata take precedence
+	// +optional
+	DisruptionProtection bool `json:"disruptionProtection,omitempty"`
 	// RetentionPeriod for the stored logs
 	RetentionPeriod string `json:"retentionPeriod"`
 	// FutureRetention for the stored logs
@@ -93,6 +98,12 @@ type VLogsSpec struct {
 	// ServiceAccountName is the name of the ServiceAccount to use to run the pods
 	// +optional
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// ServiceAccountImagePullSecrets is an optional list of references to secrets in the same namespace,
+	// which are attached to the ServiceAccount managed by operator.
+	// Secrets are merged with global operator configuration VM_SERVICEACCOUNTIMAGEPULLSECRETS.
+	// Has no effect if serviceAccountName is set
+	// +optional
+	ServiceAccountImagePullSecrets []v1.LocalObjectReference `json:"serviceAccountImagePullSecrets,omitempty"`
 }
 
 // VLogsStatus defines the observed state of VLogs
@@ -110,6 +121,13 @@ type VLogsStatus struct {
 	UpdateStatus UpdateStatus `json:"status,omitempty"`
 	// Reason defines a reason in case of update failure
 	Reason string `json:"reason,omitempty"`
+	// Children lists objects generated by operator for this object
+	// +optional
+	Children []ChildObject `json:"children,omitempty"`
+	// Warnings contains problems found at object spec, which don't block reconciliation,
+	// such as image tags with unknown version
+	// +optional
+	Warnings []string `json:"warnings,omitempty"`
 }
 
 // VLogs is fast, cost-effective and scalable logs database.
@@ -153,6 +171,9 @@ func (r VLogs) PodAnnotations() map[string]string {
 			annotations[annotation] = value
 		}
 	}
+	if r.Spec.DisruptionProtection {
+		return withDisruptionProtection(annotations)
+	}
 	return annotations
 }
 
@@ -214,7 +235,7 @@ func (r *VLogs) ProbeNeedLiveness() bool {
 }
 
 func (r VLogs) AnnotationsFiltered() map[string]string {
-	return filterMapKeysByPrefixes(r.ObjectMeta.Annotations, annotationFilterPrefixes)
+	return filterMapKeysByPrefixes(r.ObjectMeta.Annotations, childAnnotationFilterPrefixes(r.Namespace))
 }
 
 func (r VLogs) SelectorLabels() map[string]string {
@@ -240,7 +261,7 @@ func (r VLogs) AllLabels() map[string]string {
 	if r.ObjectMeta.Labels == nil {
 		return selectorLabels
 	}
-	rLabels := filterMapKeysByPrefixes(r.ObjectMeta.Labels, labelFilterPrefixes)
+	rLabels := filterMapKeysByPrefixes(r.ObjectMeta.Labels, childLabelFilterPrefixes(r.Namespace))
 	return labels.Merge(rLabels, selectorLabels)
 }
 
@@ -270,6 +291,11 @@ func (r VLogs) GetServiceAccountName() string {
 	return r.Spec.ServiceAccountName
 }
 
+// GetServiceAccountImagePullSecrets returns image pull secrets for managed ServiceAccount
+func (r VLogs) GetServiceAccountImagePullSecrets() []v1.LocalObjectReference {
+	return r.Spec.ServiceAccountImagePullSecrets
+}
+
 func (r VLogs) IsOwnsServiceAccount() bool {
 	return r.Spec.ServiceAccountName == ""
 }
@@ -318,7 +344,7 @@ func (r *VLogs) HasSpecChanges() (bool, error) {
 }
 
 func (r *VLogs) Paused() bool {
-	return r.Spec.Paused
+	return r.Spec.Paused || IsReconcilePaused(r)
 }
 
 // SetStatusTo changes update status with optional reason of fail
@@ -331,7 +357,7 @@ func (r *VLogs) SetUpdateStatusTo(ctx context.Context, c client.Client, status U
 		if currentStatus == UpdateStatusFailed {
 			return nil
 		}
-	case UpdateStatusFailed:
+	case UpdateStatusFailed, UpdateStatusDegraded, UpdateStatusBlocked:
 		if maybeErr != nil {
 			r.Status.Reason = maybeErr.Error()
 		}
@@ -352,6 +378,21 @@ func (r *VLogs) SetUpdateStatusTo(ctx context.Context, c client.Client, status U
 	return statusPatch(ctx, c, r.DeepCopy(), r.Status)
 }
 
+// GetStatusChildren returns objects generated by operator for VLogs
+func (r *VLogs) GetStatusChildren() []ChildObject {
+	return r.Status.Children
+}
+
+// GetStatusWarnings returns problems found at VLogs spec
+func (r *VLogs) GetStatusWarnings() []string {
+	return r.Status.Warnings
+}
+
+// SetStatusWarnings sets problems found at VLogs spec
+func (r *VLogs) SetStatusWarnings(warnings []string) {
+	r.Status.Warnings = warnings
+}
+
 // GetAdditionalService returns AdditionalServiceSpec settings
 func (r *VLogs) GetAdditionalService() *AdditionalServiceSpec {
 	return r.Spec.ServiceSpec
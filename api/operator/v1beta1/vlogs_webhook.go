@@ -40,6 +40,9 @@ func (r *VLogs) SetupWebhookWithManager(mgr ctrl.Manager) error {
 var _ webhook.Validator = &VLogs{}
 
 func (r *VLogs) sanityCheck() error {
+	if err := sanityCheckOverridePatches(r.Spec.OverridePatches); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5,7 +5,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
@@ -41,6 +43,64 @@ type VMAgentSecurityEnforcements struct {
 	// of the VMAgent container e.g. bearer token files, basic auth, tls certs
 	// +optional
 	ArbitraryFSAccessThroughSMs ArbitraryFSAccessThroughSMsConfig `json:"arbitraryFSAccessThroughSMs,omitempty"`
+	// MountScrapeSecrets enables mount-and-reference mode for basicAuth and bearerTokenSecret of scrape objects.
+	// Referenced secrets are mounted into vmagent pod with projected volume
+	// and generated configuration uses username_file, password_file and bearer_token_file instead of secret values.
+	// Secret rotation doesn't require configuration regeneration and secret values are not copied into generated config.
+	// Kubernetes can mount secrets only from the pod namespace, so scrape objects with credentials
+	// from other namespaces are excluded from configuration.
+	// +optional
+	MountScrapeSecrets bool `json:"mountScrapeSecrets,omitempty"`
+	// ScrapeObjectLimits defines hard limits for scrape objects.
+	// Scrape objects exceeding limits are excluded from configuration
+	// and get failed status with the reason at lastSyncError.
+	// +optional
+	ScrapeObjectLimits *ScrapeObjectLimits `json:"scrapeObjectLimits,omitempty"`
+}
+
+// ScrapeObjectLimits defines global and per-namespace limits for scrape objects
+type ScrapeObjectLimits struct {
+	ScrapeLimits `json:",inline"`
+	// Namespaces overrides global limits for scrape objects from the given namespaces.
+	// Limits, which are not set for namespace, are inherited from global limits.
+	// +optional
+	Namespaces map[string]ScrapeLimits `json:"namespaces,omitempty"`
+}
+
+// ScrapeLimits defines limits for a single scrape object
+type ScrapeLimits struct {
+	// MaxTargets defines maximum number of targets defined by a single scrape object.
+	// Endpoints of VMServiceScrape and VMPodScrape, static targets of VMStaticScrape, VMProbe and VMScrapeConfig
+	// and service discovery configs of VMScrapeConfig are counted as targets.
+	// +optional
+	MaxTargets *int32 `json:"maxTargets,omitempty"`
+	// MaxSeriesPerScrape defines maximum sampleLimit of scrape object endpoints.
+	// It's used as sampleLimit for endpoints without sampleLimit.
+	// +optional
+	MaxSeriesPerScrape *uint64 `json:"maxSeriesPerScrape,omitempty"`
+	// MinScrapeInterval defines the lowest allowed scrape interval of scrape object endpoints.
+	// In contrast to spec.minScrapeInterval, which raises lower intervals, scrape objects with lower interval are rejected.
+	// +optional
+	MinScrapeInterval string `json:"minScrapeInterval,omitempty"`
+}
+
+// ForNamespace returns limits for scrape objects from the given namespace
+func (sol *ScrapeObjectLimits) ForNamespace(namespace string) ScrapeLimits {
+	limits := sol.ScrapeLimits
+	nsLimits, ok := sol.Namespaces[namespace]
+	if !ok {
+		return limits
+	}
+	if nsLimits.MaxTargets != nil {
+		limits.MaxTargets = nsLimits.MaxTargets
+	}
+	if nsLimits.MaxSeriesPerScrape != nil {
+		limits.MaxSeriesPerScrape = nsLimits.MaxSeriesPerScrape
+	}
+	if nsLimits.MinScrapeInterval != "" {
+		limits.MinScrapeInterval = nsLimits.MinScrapeInterval
+	}
+	return limits
 }
 
 // VMAgentSpec defines the desired state of VMAgent
@@ -97,6 +157,11 @@ type VMAgentSpec struct {
 	// RemoteWriteSettings defines global settings for all remoteWrite urls.
 	// +optional
 	RemoteWriteSettings *VMAgentRemoteWriteSettings `json:"remoteWriteSettings,omitempty"`
+	// RemoteWriteMirror defines additional remoteWrite target, which receives a copy of data during the given time window.
+	// It helps to migrate data between storages with double-writing.
+	// Target is added to vmagent configuration at startTime and removed at stopTime automatically.
+	// +optional
+	RemoteWriteMirror *VMAgentRemoteWriteMirror `json:"remoteWriteMirror,omitempty"`
 	// RelabelConfig ConfigMap with global relabel config -remoteWrite.relabelConfig
 	// This relabeling is applied to all the collected metrics before sending them to remote storage.
 	// +optional
@@ -214,6 +279,13 @@ type VMAgentSpec struct {
 	// VMAgent after the upgrade.
 	// +optional
 	AdditionalScrapeConfigs *v1.SecretKeySelector `json:"additionalScrapeConfigs,omitempty"`
+	// GeneratedConfigStorage defines kind of object for generated scrape configuration storage.
+	// With configmap, generated configuration is stored in plain text at ConfigMap with the same name as VMAgent config Secret.
+	// It allows cluster users without secrets read permissions to inspect and diff generated configuration.
+	// Generated configuration must not contain inline credentials in this case, so mountScrapeSecrets must be enabled.
+	// +kubebuilder:validation:Enum=secret;configmap
+	// +optional
+	GeneratedConfigStorage string `json:"generatedConfigStorage,omitempty"`
 	// InsertPorts - additional listen ports for data ingestion.
 	InsertPorts *InsertPorts `json:"insertPorts,omitempty"`
 
@@ -231,6 +303,14 @@ type VMAgentSpec struct {
 	// +optional
 	ShardCount *int `json:"shardCount,omitempty"`
 
+	// ClusterMode enables native cluster mode of vmagent,
+	// see [here](https://docs.victoriametrics.com/vmagent/#scraping-big-number-of-targets)
+	// in this case operator uses single statefulset with membersCount replicas
+	// and each replica scrapes only its own part of targets.
+	// It cannot be used with shardCount and hpa
+	// +optional
+	ClusterMode *VMAgentClusterMode `json:"clusterMode,omitempty"`
+
 	// UpdateStrategy - overrides default update strategy.
 	// works only for deployments, statefulset always use OnDelete.
 	// +kubebuilder:validation:Enum=Recreate;RollingUpdate
@@ -243,6 +323,12 @@ type VMAgentSpec struct {
 	// +optional
 	PodDisruptionBudget *EmbeddedPodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
 	*EmbeddedProbes     `json:",inline"`
+	// HPA defines kubernetes PodAutoScaling configuration version 2.
+	// Operator doesn't change replicas count of vmagent deployment or statefulset
+	// if it's set and allows HPA to manage it.
+	// It cannot be used with shardCount greater than 1.
+	// +optional
+	HPA *EmbeddedHPA `json:"hpa,omitempty"`
 	// ServiceScrapeRelabelTemplate defines relabel config, that will be added to each VMServiceScrape.
 	// it's useful for adding specific labels to all targets
 	// +optional
@@ -267,6 +353,11 @@ type VMAgentSpec struct {
 	// it's useful for adding specific labels to all targets
 	// +optional
 	ScrapeConfigRelabelTemplate []*RelabelConfig `json:"scrapeConfigRelabelTemplate,omitempty"`
+	// ScrapeClasses defines named sets of scrape settings,
+	// which VMServiceScrape and VMPodScrape objects can reference with spec.scrapeClass.
+	// Scrape class marked as default is applied to objects without spec.scrapeClass
+	// +optional
+	ScrapeClasses []ScrapeClass `json:"scrapeClasses,omitempty"`
 	// MinScrapeInterval allows limiting minimal scrape interval for VMServiceScrape, VMPodScrape and other scrapes
 	// If interval is lower than defined limit, `minScrapeInterval` will be used.
 	MinScrapeInterval *string `json:"minScrapeInterval,omitempty"`
@@ -277,6 +368,12 @@ type VMAgentSpec struct {
 	// it allows using persistent storage for vmagent's persistentQueue
 	// +optional
 	StatefulMode bool `json:"statefulMode,omitempty"`
+	// DaemonSetMode enables DaemonSet for `VMAgent` instead of Deployment
+	// each vmagent pod discovers and scrapes only targets located at its node.
+	// Only VMPodScrape, VMServiceScrape and VMNodeScrape objects are supported in this mode
+	// It cannot be used together with statefulMode, clusterMode, shardCount, hpa and podDisruptionBudget
+	// +optional
+	DaemonSetMode bool `json:"daemonSetMode,omitempty"`
 	// StatefulStorage configures storage for StatefulSet
 	// +optional
 	StatefulStorage *StorageSpec `json:"statefulStorage,omitempty"`
@@ -284,6 +381,10 @@ type VMAgentSpec struct {
 	// set it to RollingUpdate for disabling operator statefulSet rollingUpdate
 	// +optional
 	StatefulRollingUpdateStrategy appsv1.StatefulSetUpdateStrategyType `json:"statefulRollingUpdateStrategy,omitempty"`
+	// StatefulUpdateStrategy defines update strategy for StatefulSet in StatefulMode
+	// it has priority over StatefulRollingUpdateStrategy and allows partition-based canary rollout
+	// +optional
+	StatefulUpdateStrategy *StatefulSetUpdateStrategy `json:"statefulUpdateStrategy,omitempty"`
 
 	// ClaimTemplates allows adding additional VolumeClaimTemplates for VMAgent in StatefulMode
 	ClaimTemplates []v1.PersistentVolumeClaim `json:"claimTemplates,omitempty"`
@@ -292,6 +393,19 @@ type VMAgentSpec struct {
 	// Currently it prevents vmagent from managing tls and auth options for remote write
 	// +optional
 	IngestOnlyMode bool `json:"ingestOnlyMode,omitempty"`
+	// ScrapeClientCertManager issues client certificate for scraping targets with mTLS with cert-manager Certificate.
+	// Certificate is mounted into vmagent pod at /etc/vm/secrets/vmagent-scrape-tls-<name>/ directory
+	// and could be referenced with tlsConfig.certFile and tlsConfig.keyFile of scrape objects.
+	// It requires -certmanager.enable operator flag
+	// +optional
+	ScrapeClientCertManager *CertManagerCertificate `json:"scrapeClientCertManager,omitempty"`
+	// CardinalityLimits limits the number of unique series sent to remote storage
+	// with -remoteWrite.maxHourlySeries and -remoteWrite.maxDailySeries flags
+	// +optional
+	CardinalityLimits *CardinalityLimits `json:"cardinalityLimits,omitempty"`
+	// DefaultRules configures VMRule with default rules for vmagent generated by operator
+	// +optional
+	DefaultRules *DefaultRules `json:"defaultRules,omitempty"`
 
 	// License allows to configure license key to be used for enterprise features.
 	// Using license key is supported starting from VictoriaMetrics v1.94.0.
@@ -302,6 +416,12 @@ type VMAgentSpec struct {
 	// ServiceAccountName is the name of the ServiceAccount to use to run the pods
 	// +optional
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// ServiceAccountImagePullSecrets is an optional list of references to secrets in the same namespace,
+	// which are attached to the ServiceAccount managed by operator.
+	// Secrets are merged with global operator configuration VM_SERVICEACCOUNTIMAGEPULLSECRETS.
+	// Has no effect if serviceAccountName is set
+	// +optional
+	ServiceAccountImagePullSecrets []v1.LocalObjectReference `json:"serviceAccountImagePullSecrets,omitempty"`
 
 	VMAgentSecurityEnforcements       `json:",inline"`
 	CommonDefaultableParams           `json:",inline,omitempty"`
@@ -333,6 +453,81 @@ func (cr *VMAgentSpec) UnmarshalJSON(src []byte) error {
 	return nil
 }
 
+// ScrapeClass defines scrape settings shared by scrape objects, which reference it
+type ScrapeClass struct {
+	// Name of the scrape class
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Default defines if scrape class is applied to scrape objects without spec.scrapeClass
+	// Only one scrape class can be marked as default
+	// +optional
+	Default *bool `json:"default,omitempty"`
+	// TLSConfig defines TLS configuration used by endpoints, which don't define it.
+	// Only caFile, certFile, keyFile, serverName and insecureSkipVerify are supported
+	// and applied to endpoint tlsConfig, if it doesn't set them.
+	// +optional
+	TLSConfig *TLSConfig `json:"tlsConfig,omitempty"`
+	// RelabelConfigs are applied to targets before relabelConfigs of the endpoint
+	// +optional
+	RelabelConfigs []*RelabelConfig `json:"relabelConfigs,omitempty"`
+	// MetricRelabelConfigs are applied to samples before metricRelabelConfigs of the endpoint
+	// +optional
+	MetricRelabelConfigs []*RelabelConfig `json:"metricRelabelConfigs,omitempty"`
+	// AttachMetadata is used by scrape objects, which don't define attach_metadata
+	// +optional
+	AttachMetadata *AttachMetadata `json:"attachMetadata,omitempty"`
+}
+
+// IsDefault checks if scrape class is applied to scrape objects without spec.scrapeClass
+func (sc *ScrapeClass) IsDefault() bool {
+	return sc.Default != nil && *sc.Default
+}
+
+func (sc *ScrapeClass) validate() error {
+	if sc.Name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if tc := sc.TLSConfig; tc != nil {
+		if tc.CA.PrefixedName() != "" || tc.Cert.PrefixedName() != "" || tc.KeySecret != nil {
+			return fmt.Errorf("tlsConfig supports only caFile, certFile and keyFile for referencing certificates")
+		}
+	}
+	if err := checkRelabelConfigPtrs(sc.RelabelConfigs); err != nil {
+		return fmt.Errorf("bad relabelConfigs: %w", err)
+	}
+	if err := checkRelabelConfigPtrs(sc.MetricRelabelConfigs); err != nil {
+		return fmt.Errorf("bad metricRelabelConfigs: %w", err)
+	}
+	return nil
+}
+
+// ScrapeClass returns scrape class with the given name
+// or the default scrape class if name is nil.
+// Second value is false if scrape class with the given name isn't defined
+func (cr *VMAgent) ScrapeClass(name *string) (*ScrapeClass, bool) {
+	for i := range cr.Spec.ScrapeClasses {
+		sc := &cr.Spec.ScrapeClasses[i]
+		if name == nil && sc.IsDefault() {
+			return sc, true
+		}
+		if name != nil && sc.Name == *name {
+			return sc, true
+		}
+	}
+	return nil, name == nil
+}
+
+// VMAgentClusterMode defines settings for vmagent cluster mode
+type VMAgentClusterMode struct {
+	// MembersCount defines number of vmagent members in the cluster
+	// +kubebuilder:validation:Minimum=1
+	MembersCount int32 `json:"membersCount"`
+	// ReplicationFactor defines number of members, which scrape the same target.
+	// Remote storage must have deduplication enabled if it's greater than 1
+	// +optional
+	ReplicationFactor *int32 `json:"replicationFactor,omitempty"`
+}
+
 // VMAgentRemoteWriteSettings - defines global settings for all remoteWrite urls.
 type VMAgentRemoteWriteSettings struct {
 	// The maximum size in bytes of unpacked request to send to remote storage
@@ -369,7 +564,14 @@ type VMAgentRemoteWriteSettings struct {
 // +k8s:openapi-gen=true
 type VMAgentRemoteWriteSpec struct {
 	// URL of the endpoint to send samples to.
-	URL string `json:"url"`
+	// Must be empty if TargetRef is set
+	// +optional
+	URL string `json:"url,omitempty"`
+	// TargetRef defines name of cluster-wide VMRemoteWriteTarget, which provides url, auth and tls settings.
+	// url, basicAuth, bearerTokenSecret, oauth2, tlsConfig and tenantLabelRouting cannot be set together with it.
+	// sendTimeout, headers and rateLimit have priority over target settings
+	// +optional
+	TargetRef string `json:"targetRef,omitempty"`
 	// BasicAuth allow an endpoint to authenticate over basic authentication
 	// +optional
 	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
@@ -384,6 +586,10 @@ type VMAgentRemoteWriteSpec struct {
 	// InlineUrlRelabelConfig defines relabeling config for remoteWriteURL, it can be defined at crd spec.
 	// +optional
 	InlineUrlRelabelConfig []RelabelConfig `json:"inlineUrlRelabelConfig,omitempty"`
+	// UrlRelabelConfigRefs defines names of cluster-wide VMRelabelConfig objects,
+	// which relabeling rules are prepended to inlineUrlRelabelConfig
+	// +optional
+	UrlRelabelConfigRefs []string `json:"urlRelabelConfigRefs,omitempty"`
 	// OAuth2 defines auth configuration
 	// +optional
 	OAuth2 *OAuth2 `json:"oauth2,omitempty"`
@@ -404,6 +610,75 @@ type VMAgentRemoteWriteSpec struct {
 	// StreamAggrConfig defines stream aggregation configuration for VMAgent for -remoteWrite.url
 	// +optional
 	StreamAggrConfig *StreamAggrConfig `json:"streamAggrConfig,omitempty"`
+	// TenantLabelRouting routes series to VMCluster tenants based on label value.
+	// url must point to vminsert /insert/<tenant>/prometheus endpoint
+	// +optional
+	TenantLabelRouting *TenantLabelRouting `json:"tenantLabelRouting,omitempty"`
+	// RateLimit defines limit in bytes per second for data sent to -remoteWrite.url
+	// +optional
+	RateLimit *int64 `json:"rateLimit,omitempty"`
+	// ThroughputHint defines expected number of samples per second sent to -remoteWrite.url.
+	// Operator derives -remoteWrite.queues, -remoteWrite.maxDiskUsagePerURL and default memory limit from it,
+	// if these settings aren't defined explicitly.
+	// Status reports a warning, if observed throughput exceeds the hint
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ThroughputHint *int64 `json:"throughputHint,omitempty"`
+	// SecretsNamespace is a namespace of Secrets and ConfigMaps resolved from VMRemoteWriteTarget
+	SecretsNamespace string `json:"-" yaml:"-"`
+}
+
+// TenantLabelRouting defines routing of series to VMCluster tenants by label value.
+// Operator replaces tenant of remoteWrite url with vminsert multitenant endpoint
+// and adds urlRelabelConfig rules, which set vm_account_id and vm_project_id labels.
+// See https://docs.victoriametrics.com/cluster-victoriametrics/#multitenancy-via-labels
+type TenantLabelRouting struct {
+	// Label defines name of label, which value is used for routing, e.g. namespace
+	Label string `json:"label"`
+	// Tenants maps label value into tenant in form of accountID or accountID:projectID
+	Tenants map[string]string `json:"tenants"`
+	// DefaultTenant is used for series with not mapped label value,
+	// in form of accountID or accountID:projectID.
+	// vminsert writes such series into 0:0 tenant if not set
+	// +optional
+	DefaultTenant string `json:"defaultTenant,omitempty"`
+}
+
+var (
+	remoteWriteTenantRe = regexp.MustCompile(`/insert/[^/]+/prometheus`)
+	labelNameRe         = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// RoutedURL returns url for -remoteWrite.url flag
+// tenant of url is replaced with vminsert multitenant endpoint if tenantLabelRouting is set
+func (rw *VMAgentRemoteWriteSpec) RoutedURL() string {
+	if rw.TenantLabelRouting == nil {
+		return rw.URL
+	}
+	return remoteWriteTenantRe.ReplaceAllLiteralString(rw.URL, "/insert/multitenant/prometheus")
+}
+
+func (tlr *TenantLabelRouting) validate(url string) error {
+	if !remoteWriteTenantRe.MatchString(url) {
+		return fmt.Errorf("url=%q must point to vminsert /insert/<tenant>/prometheus endpoint", url)
+	}
+	if !labelNameRe.MatchString(tlr.Label) {
+		return fmt.Errorf("label=%q must be valid prometheus label name", tlr.Label)
+	}
+	if len(tlr.Tenants) == 0 {
+		return fmt.Errorf("tenants cannot be empty")
+	}
+	for value, tenant := range tlr.Tenants {
+		if !tenantIDRe.MatchString(tenant) {
+			return fmt.Errorf("tenant=%q for label value=%q must have accountID or accountID:projectID format", tenant, value)
+		}
+	}
+	if tlr.DefaultTenant != "" {
+		if !tenantIDRe.MatchString(tlr.DefaultTenant) {
+			return fmt.Errorf("defaultTenant=%q must have accountID or accountID:projectID format", tlr.DefaultTenant)
+		}
+	}
+	return nil
 }
 
 // AsMapKey key for internal cache map
@@ -442,6 +717,122 @@ type VMAgentStatus struct {
 	UpdateStatus UpdateStatus `json:"updateStatus,omitempty"`
 	// Reason defines fail reason for update process, effective only for statefulMode
 	Reason string `json:"reason,omitempty"`
+	// RemoteWriteMirror defines state of remoteWrite mirroring
+	RemoteWriteMirror *VMAgentRemoteWriteMirrorStatus `json:"remoteWriteMirror,omitempty"`
+	// AdditionalScrapeConfigsErrors contains problems found at additionalScrapeConfigs secret content
+	// invalid scrape configs are excluded from vmagent configuration
+	AdditionalScrapeConfigsErrors []string `json:"additionalScrapeConfigsErrors,omitempty"`
+	// ScrapeGlobalConfig defines VMScrapeGlobalConfig merged into generated scrape configuration
+	ScrapeGlobalConfig *VMAgentScrapeGlobalConfigStatus `json:"scrapeGlobalConfig,omitempty"`
+	// RemoteWriteThroughput defines observed throughput of remoteWrite targets with throughputHint
+	RemoteWriteThroughput []VMAgentRemoteWriteThroughputStatus `json:"remoteWriteThroughput,omitempty"`
+	// Children lists objects generated by operator for this object
+	// +optional
+	Children []ChildObject `json:"children,omitempty"`
+	// Warnings contains problems found at object spec, which don't block reconciliation,
+	// such as image tags with unknown version
+	// +optional
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// VMAgentScrapeGlobalConfigStatus defines observed state of VMScrapeGlobalConfig usage
+type VMAgentScrapeGlobalConfigStatus struct {
+	// Name of applied VMScrapeGlobalConfig
+	Name string `json:"name"`
+	// Overridden lists settings of VMScrapeGlobalConfig, which have lower priority than VMAgent spec fields
+	Overridden []string `json:"overridden,omitempty"`
+	// Ignored lists VMScrapeGlobalConfigs, which match VMAgent, but aren't applied,
+	// since only the first config ordered by name is used
+	Ignored []string `json:"ignored,omitempty"`
+}
+
+// VMAgentRemoteWriteThroughputStatus defines observed throughput of remoteWrite target
+type VMAgentRemoteWriteThroughputStatus struct {
+	// URL of remoteWrite target
+	URL string `json:"url"`
+	// ThroughputHint defines expected number of samples per second
+	ThroughputHint int64 `json:"throughputHint"`
+	// Observed number of samples per second sent to remoteWrite target by all vmagent pods.
+	// It's calculated between subsequent reconciles and is missing after operator restart
+	// +optional
+	Observed int64 `json:"observed,omitempty"`
+	// Warning is set if observed throughput exceeds throughputHint
+	// +optional
+	Warning string `json:"warning,omitempty"`
+}
+
+const (
+	// RemoteWriteMirrorPending means, that mirroring start time is not reached yet
+	RemoteWriteMirrorPending = "Pending"
+	// RemoteWriteMirrorActive means, that mirror target is added to vmagent configuration
+	RemoteWriteMirrorActive = "Active"
+	// RemoteWriteMirrorCompleted means, that mirror target is removed from vmagent configuration after stop time
+	RemoteWriteMirrorCompleted = "Completed"
+)
+
+// VMAgentRemoteWriteMirror defines remoteWrite target with limited lifetime
+type VMAgentRemoteWriteMirror struct {
+	VMAgentRemoteWriteSpec `json:",inline"`
+	// StartTime defines time, when target is added to vmagent configuration.
+	// Mirroring starts immediately if it's not set
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// StopTime defines time, when target is removed from vmagent configuration
+	StopTime metav1.Time `json:"stopTime"`
+}
+
+// VMAgentRemoteWriteMirrorStatus defines observed state of remoteWrite mirroring
+type VMAgentRemoteWriteMirrorStatus struct {
+	// URL of mirror target
+	URL string `json:"url"`
+	// Phase of mirroring, one of Pending, Active or Completed
+	Phase string `json:"phase"`
+	// LastTransitionTime defines time, when operator applied the current phase
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+// Phase returns mirroring phase at the given time and time of the next phase transition.
+// Next transition time is zero for the Completed phase
+func (m *VMAgentRemoteWriteMirror) Phase(now time.Time) (string, time.Time) {
+	switch {
+	case !now.Before(m.StopTime.Time):
+		return RemoteWriteMirrorCompleted, time.Time{}
+	case m.StartTime != nil && now.Before(m.StartTime.Time):
+		return RemoteWriteMirrorPending, m.StartTime.Time
+	default:
+		return RemoteWriteMirrorActive, m.StopTime.Time
+	}
+}
+
+// RemoteWriteTargets returns remoteWrite targets of vmagent at the given time
+// it includes remoteWriteMirror target during mirroring window
+func (cr *VMAgent) RemoteWriteTargets(now time.Time) []VMAgentRemoteWriteSpec {
+	if cr.Spec.RemoteWriteMirror == nil {
+		return cr.Spec.RemoteWrite
+	}
+	if phase, _ := cr.Spec.RemoteWriteMirror.Phase(now); phase != RemoteWriteMirrorActive {
+		return cr.Spec.RemoteWrite
+	}
+	targets := make([]VMAgentRemoteWriteSpec, 0, len(cr.Spec.RemoteWrite)+1)
+	targets = append(targets, cr.Spec.RemoteWrite...)
+	return append(targets, cr.Spec.RemoteWriteMirror.VMAgentRemoteWriteSpec)
+}
+
+func (cr *VMAgent) updateRemoteWriteMirrorStatus(now time.Time) {
+	if cr.Spec.RemoteWriteMirror == nil {
+		cr.Status.RemoteWriteMirror = nil
+		return
+	}
+	phase, _ := cr.Spec.RemoteWriteMirror.Phase(now)
+	prev := cr.Status.RemoteWriteMirror
+	if prev != nil && prev.Phase == phase && prev.URL == cr.Spec.RemoteWriteMirror.URL {
+		return
+	}
+	cr.Status.RemoteWriteMirror = &VMAgentRemoteWriteMirrorStatus{
+		URL:                cr.Spec.RemoteWriteMirror.URL,
+		Phase:              phase,
+		LastTransitionTime: metav1.NewTime(now.Truncate(time.Second)),
+	}
 }
 
 // +genclient
@@ -505,7 +896,7 @@ func (cr VMAgent) PodAnnotations() map[string]string {
 }
 
 func (cr VMAgent) AnnotationsFiltered() map[string]string {
-	return filterMapKeysByPrefixes(cr.ObjectMeta.Annotations, annotationFilterPrefixes)
+	return filterMapKeysByPrefixes(cr.ObjectMeta.Annotations, childAnnotationFilterPrefixes(cr.Namespace))
 }
 
 func (cr VMAgent) SelectorLabels() map[string]string {
@@ -532,7 +923,7 @@ func (cr VMAgent) AllLabels() map[string]string {
 	if cr.ObjectMeta.Labels == nil {
 		return selectorLabels
 	}
-	crLabels := filterMapKeysByPrefixes(cr.ObjectMeta.Labels, labelFilterPrefixes)
+	crLabels := filterMapKeysByPrefixes(cr.ObjectMeta.Labels, childLabelFilterPrefixes(cr.Namespace))
 	return labels.Merge(crLabels, selectorLabels)
 }
 
@@ -544,6 +935,11 @@ func (cr VMAgent) TLSAssetName() string {
 	return fmt.Sprintf("tls-assets-vmagent-%s", cr.Name)
 }
 
+// ScrapeClientCertSecretName returns name of secret with scrape client certificate issued by cert-manager
+func (cr VMAgent) ScrapeClientCertSecretName() string {
+	return fmt.Sprintf("vmagent-scrape-tls-%s", cr.Name)
+}
+
 func (cr VMAgent) RelabelingAssetName() string {
 	return fmt.Sprintf("relabelings-assets-vmagent-%s", cr.Name)
 }
@@ -561,6 +957,11 @@ func (cr VMAgent) GetMetricPath() string {
 	return buildPathWithPrefixFlag(cr.Spec.ExtraArgs, metricPath)
 }
 
+// TargetsPath returns prefixed path for scrape targets requests
+func (cr VMAgent) TargetsPath() string {
+	return buildPathWithPrefixFlag(cr.Spec.ExtraArgs, targetsPath)
+}
+
 // ExtraArgs returns additionally configured command-line arguments
 func (cr VMAgent) GetExtraArgs() map[string]string {
 	return cr.Spec.ExtraArgs
@@ -578,7 +979,18 @@ func (cr VMAgent) GetServiceAccountName() string {
 	return cr.Spec.ServiceAccountName
 }
 
+// IsStatefulMode checks if vmagent uses statefulset
+// it's always true for cluster mode, since members require stable pod names
+func (cr *VMAgent) IsStatefulMode() bool {
+	return cr.Spec.StatefulMode || cr.Spec.ClusterMode != nil
+}
+
 // IsOwnsServiceAccount checks if service account owned by CR
+// GetServiceAccountImagePullSecrets returns image pull secrets for managed ServiceAccount
+func (cr VMAgent) GetServiceAccountImagePullSecrets() []v1.LocalObjectReference {
+	return cr.Spec.ServiceAccountImagePullSecrets
+}
+
 func (cr VMAgent) IsOwnsServiceAccount() bool {
 	return cr.Spec.ServiceAccountName == ""
 }
@@ -672,16 +1084,24 @@ func (cr *VMAgent) HasSpecChanges() (bool, error) {
 }
 
 func (cr *VMAgent) Paused() bool {
-	return cr.Spec.Paused
+	return cr.Spec.Paused || IsReconcilePaused(cr)
 }
 
 // HasAnyRelabellingConfigs checks if vmagent has any defined relabeling rules
+// GeneratedConfigStorageConfigMap stores generated scrape configuration at ConfigMap
+const GeneratedConfigStorageConfigMap = "configmap"
+
+// HasGeneratedConfigAtConfigMap checks if generated scrape configuration must be stored at ConfigMap
+func (cr *VMAgent) HasGeneratedConfigAtConfigMap() bool {
+	return !cr.Spec.IngestOnlyMode && cr.Spec.GeneratedConfigStorage == GeneratedConfigStorageConfigMap
+}
+
 func (cr *VMAgent) HasAnyRelabellingConfigs() bool {
 	if cr.Spec.RelabelConfig != nil || len(cr.Spec.InlineRelabelConfig) > 0 {
 		return true
 	}
 	for _, rw := range cr.Spec.RemoteWrite {
-		if rw.UrlRelabelConfig != nil || len(rw.InlineUrlRelabelConfig) > 0 {
+		if rw.UrlRelabelConfig != nil || len(rw.InlineUrlRelabelConfig) > 0 || rw.TenantLabelRouting != nil {
 			return true
 		}
 	}
@@ -703,18 +1123,59 @@ func (cr *VMAgent) HasAnyStreamAggrRule() bool {
 	return false
 }
 
+// HasRemoteWriteTargetRefs checks if vmagent has remoteWrite with VMRemoteWriteTarget reference
+func (cr *VMAgent) HasRemoteWriteTargetRefs() bool {
+	for _, rw := range cr.Spec.RemoteWrite {
+		if rw.TargetRef != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoteWriteThroughputHint returns total throughputHint of remoteWrite targets
+// or zero if none of targets has it
+func (cr *VMAgent) RemoteWriteThroughputHint() int64 {
+	var total int64
+	for _, rw := range cr.Spec.RemoteWrite {
+		total += ptr.Deref(rw.ThroughputHint, 0)
+	}
+	return total
+}
+
+// ReferencesRemoteWriteTarget checks if vmagent has remoteWrite with reference to VMRemoteWriteTarget with the given name
+func (cr *VMAgent) ReferencesRemoteWriteTarget(name string) bool {
+	for _, rw := range cr.Spec.RemoteWrite {
+		if rw.TargetRef == name {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRelabelConfigRefs checks if vmagent has remoteWrite with reference to VMRelabelConfig
+func (cr *VMAgent) HasRelabelConfigRefs() bool {
+	for _, rw := range cr.Spec.RemoteWrite {
+		if len(rw.UrlRelabelConfigRefs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // SetStatusTo changes update status with optional reason of fail
 func (cr *VMAgent) SetUpdateStatusTo(ctx context.Context, r client.Client, status UpdateStatus, maybeErr error) error {
 	currentStatus := cr.Status.UpdateStatus
 	prevStatus := cr.Status.DeepCopy()
 	switch status {
 	case UpdateStatusExpanding:
-	case UpdateStatusFailed:
+	case UpdateStatusFailed, UpdateStatusDegraded, UpdateStatusBlocked:
 		if maybeErr != nil {
 			cr.Status.Reason = maybeErr.Error()
 		}
 	case UpdateStatusOperational:
 		cr.Status.Reason = ""
+		cr.updateRemoteWriteMirrorStatus(time.Now())
 	case UpdateStatusPaused:
 		if currentStatus == status {
 			return nil
@@ -742,6 +1203,21 @@ func (cr *VMAgent) SetUpdateStatusTo(ctx context.Context, r client.Client, statu
 	return statusPatch(ctx, r, cr.DeepCopy(), cr.Status)
 }
 
+// GetStatusChildren returns objects generated by operator for VMAgent
+func (cr *VMAgent) GetStatusChildren() []ChildObject {
+	return cr.Status.Children
+}
+
+// GetStatusWarnings returns problems found at VMAgent spec
+func (cr *VMAgent) GetStatusWarnings() []string {
+	return cr.Status.Warnings
+}
+
+// SetStatusWarnings sets problems found at VMAgent spec
+func (cr *VMAgent) SetStatusWarnings(warnings []string) {
+	cr.Status.Warnings = warnings
+}
+
 // GetAdditionalService returns AdditionalServiceSpec settings
 func (cr *VMAgent) GetAdditionalService() *AdditionalServiceSpec {
 	return cr.Spec.ServiceSpec
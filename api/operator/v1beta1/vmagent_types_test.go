@@ -0,0 +1,72 @@
+package v1beta1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestVMAgent_RemoteWriteTargets(t *testing.T) {
+	start := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	stop := start.Add(24 * time.Hour)
+	cr := &VMAgent{
+		Spec: VMAgentSpec{
+			RemoteWrite: []VMAgentRemoteWriteSpec{{URL: "http://old-storage"}},
+			RemoteWriteMirror: &VMAgentRemoteWriteMirror{
+				VMAgentRemoteWriteSpec: VMAgentRemoteWriteSpec{URL: "http://new-storage"},
+				StartTime:              &metav1.Time{Time: start},
+				StopTime:               metav1.Time{Time: stop},
+			},
+		},
+	}
+	f := func(now time.Time, wantPhase string, wantNext time.Time, wantTargets int) {
+		t.Helper()
+		phase, next := cr.Spec.RemoteWriteMirror.Phase(now)
+		if phase != wantPhase {
+			t.Fatalf("unexpected phase, got: %s, want: %s", phase, wantPhase)
+		}
+		if !next.Equal(wantNext) {
+			t.Fatalf("unexpected next transition, got: %s, want: %s", next, wantNext)
+		}
+		if got := len(cr.RemoteWriteTargets(now)); got != wantTargets {
+			t.Fatalf("unexpected remoteWrite targets count, got: %d, want: %d", got, wantTargets)
+		}
+		cr.updateRemoteWriteMirrorStatus(now)
+		if cr.Status.RemoteWriteMirror.Phase != wantPhase {
+			t.Fatalf("unexpected status phase, got: %s, want: %s", cr.Status.RemoteWriteMirror.Phase, wantPhase)
+		}
+	}
+	f(start.Add(-time.Hour), RemoteWriteMirrorPending, start, 1)
+	f(start, RemoteWriteMirrorActive, stop, 2)
+	f(stop.Add(-time.Second), RemoteWriteMirrorActive, stop, 2)
+	f(stop, RemoteWriteMirrorCompleted, time.Time{}, 1)
+
+	// transition time isn't changed without phase change
+	transitionTime := cr.Status.RemoteWriteMirror.LastTransitionTime
+	cr.updateRemoteWriteMirrorStatus(stop.Add(time.Hour))
+	if !cr.Status.RemoteWriteMirror.LastTransitionTime.Equal(&transitionTime) {
+		t.Fatalf("unexpected transition time change: %s", cr.Status.RemoteWriteMirror.LastTransitionTime)
+	}
+
+	// status is removed with mirror
+	cr.Spec.RemoteWriteMirror = nil
+	cr.updateRemoteWriteMirrorStatus(stop)
+	if cr.Status.RemoteWriteMirror != nil {
+		t.Fatalf("unexpected mirror status: %v", cr.Status.RemoteWriteMirror)
+	}
+}
+
+func TestVMAgentRemoteWriteSpec_RoutedURL(t *testing.T) {
+	f := func(url string, routing *TenantLabelRouting, want string) {
+		t.Helper()
+		rw := &VMAgentRemoteWriteSpec{URL: url, TenantLabelRouting: routing}
+		if got := rw.RoutedURL(); got != want {
+			t.Fatalf("unexpected url, got: %q, want: %q", got, want)
+		}
+	}
+	routing := &TenantLabelRouting{Label: "namespace", Tenants: map[string]string{"team-a": "1"}}
+	f("http://vminsert:8480/insert/0/prometheus/api/v1/write", nil, "http://vminsert:8480/insert/0/prometheus/api/v1/write")
+	f("http://vminsert:8480/insert/0/prometheus/api/v1/write", routing, "http://vminsert:8480/insert/multitenant/prometheus/api/v1/write")
+	f("http://vminsert:8480/insert/1:5/prometheus", routing, "http://vminsert:8480/insert/multitenant/prometheus")
+}
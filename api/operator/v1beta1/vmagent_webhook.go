@@ -18,11 +18,14 @@ package v1beta1
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/VictoriaMetrics/VictoriaMetrics/lib/envtemplate"
 	"github.com/VictoriaMetrics/VictoriaMetrics/lib/promrelabel"
+	"github.com/VictoriaMetrics/metricsql"
 	"gopkg.in/yaml.v2"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -64,9 +67,17 @@ func checkRelabelConfigs(src []RelabelConfig) error {
 }
 
 func (r *VMAgent) sanityCheck() error {
+	if err := r.Spec.DefaultRules.validate(); err != nil {
+		return err
+	}
 	if len(r.Spec.RemoteWrite) == 0 {
 		return fmt.Errorf("spec.remoteWrite cannot be empty array, provide at least one remoteWrite")
 	}
+	if r.HasGeneratedConfigAtConfigMap() {
+		if err := r.validateConfigMapStorage(); err != nil {
+			return err
+		}
+	}
 	if r.Spec.InlineScrapeConfig != "" {
 		var inlineCfg yaml.MapSlice
 		if err := yaml.Unmarshal([]byte(r.Spec.InlineScrapeConfig), &inlineCfg); err != nil {
@@ -79,7 +90,11 @@ func (r *VMAgent) sanityCheck() error {
 		}
 	}
 	for idx, rw := range r.Spec.RemoteWrite {
-		if rw.URL == "" {
+		if rw.TargetRef != "" {
+			if rw.URL != "" || rw.BasicAuth != nil || rw.BearerTokenSecret != nil || rw.OAuth2 != nil || rw.TLSConfig != nil || rw.TenantLabelRouting != nil {
+				return fmt.Errorf("remoteWrite url, basicAuth, bearerTokenSecret, oauth2, tlsConfig and tenantLabelRouting cannot be set together with targetRef at idx: %d", idx)
+			}
+		} else if rw.URL == "" {
 			return fmt.Errorf("remoteWrite.url cannot be empty at idx: %d", idx)
 		}
 		if len(rw.InlineUrlRelabelConfig) > 0 {
@@ -87,8 +102,147 @@ func (r *VMAgent) sanityCheck() error {
 				return fmt.Errorf("bad urlRelabelingConfig at idx: %d, err: %w", idx, err)
 			}
 		}
+		if rw.TenantLabelRouting != nil {
+			if err := rw.TenantLabelRouting.validate(rw.URL); err != nil {
+				return fmt.Errorf("bad tenantLabelRouting at idx: %d, err: %w", idx, err)
+			}
+		}
+	}
+	if m := r.Spec.RemoteWriteMirror; m != nil {
+		if m.TargetRef != "" {
+			return fmt.Errorf("spec.remoteWriteMirror.targetRef is not supported, url must be set instead")
+		}
+		if m.URL == "" {
+			return fmt.Errorf("spec.remoteWriteMirror.url cannot be empty")
+		}
+		if m.StopTime.IsZero() {
+			return fmt.Errorf("spec.remoteWriteMirror.stopTime cannot be empty")
+		}
+		if m.StartTime != nil && !m.StartTime.Before(&m.StopTime) {
+			return fmt.Errorf("spec.remoteWriteMirror.startTime=%s must be before stopTime=%s", m.StartTime.UTC().Format(time.RFC3339), m.StopTime.UTC().Format(time.RFC3339))
+		}
+		if len(m.InlineUrlRelabelConfig) > 0 {
+			if err := checkRelabelConfigs(m.InlineUrlRelabelConfig); err != nil {
+				return fmt.Errorf("bad spec.remoteWriteMirror.inlineUrlRelabelConfig: %w", err)
+			}
+		}
+		if m.TenantLabelRouting != nil {
+			if err := m.TenantLabelRouting.validate(m.URL); err != nil {
+				return fmt.Errorf("bad spec.remoteWriteMirror.tenantLabelRouting: %w", err)
+			}
+		}
+	}
+	if cm := r.Spec.ClusterMode; cm != nil {
+		if r.Spec.ShardCount != nil && *r.Spec.ShardCount > 1 {
+			return fmt.Errorf("spec.clusterMode cannot be used with spec.shardCount > 1")
+		}
+		if r.Spec.HPA != nil {
+			return fmt.Errorf("spec.clusterMode cannot be used with spec.hpa")
+		}
+		if cm.MembersCount < 1 {
+			return fmt.Errorf("spec.clusterMode.membersCount must be greater than 0, got: %d", cm.MembersCount)
+		}
+		if cm.ReplicationFactor != nil && (*cm.ReplicationFactor < 1 || *cm.ReplicationFactor > cm.MembersCount) {
+			return fmt.Errorf("spec.clusterMode.replicationFactor must be in range [1...%d], got: %d", cm.MembersCount, *cm.ReplicationFactor)
+		}
+	}
+	if r.Spec.DaemonSetMode {
+		switch {
+		case r.IsStatefulMode():
+			return fmt.Errorf("spec.daemonSetMode cannot be used with spec.statefulMode or spec.clusterMode")
+		case r.Spec.ShardCount != nil && *r.Spec.ShardCount > 1:
+			return fmt.Errorf("spec.daemonSetMode cannot be used with spec.shardCount > 1")
+		case r.Spec.HPA != nil:
+			return fmt.Errorf("spec.daemonSetMode cannot be used with spec.hpa")
+		case r.Spec.PodDisruptionBudget != nil:
+			return fmt.Errorf("spec.daemonSetMode cannot be used with spec.podDisruptionBudget")
+		}
+	}
+	if r.Spec.HPA != nil {
+		if r.Spec.ShardCount != nil && *r.Spec.ShardCount > 1 {
+			return fmt.Errorf("spec.hpa cannot be used with spec.shardCount > 1")
+		}
+		if err := r.Spec.HPA.sanityCheck(); err != nil {
+			return err
+		}
+	}
+	if err := r.Spec.StatefulUpdateStrategy.sanityCheck(); err != nil {
+		return fmt.Errorf("spec.statefulUpdateStrategy: %w", err)
+	}
+	if err := r.Spec.License.sanityCheck(); err != nil {
+		return err
 	}
+	if err := sanityCheckOverridePatches(r.Spec.OverridePatches); err != nil {
+		return err
+	}
+	if sol := r.Spec.ScrapeObjectLimits; sol != nil {
+		if err := sol.ScrapeLimits.sanityCheck(); err != nil {
+			return fmt.Errorf("bad spec.scrapeObjectLimits: %w", err)
+		}
+		for ns, limits := range sol.Namespaces {
+			if err := limits.sanityCheck(); err != nil {
+				return fmt.Errorf("bad spec.scrapeObjectLimits.namespaces[%q]: %w", ns, err)
+			}
+		}
+	}
+	if err := validateScrapeClasses(r.Spec.ScrapeClasses); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateScrapeClasses(scs []ScrapeClass) error {
+	names := make(map[string]struct{}, len(scs))
+	var defaultName string
+	for idx := range scs {
+		sc := &scs[idx]
+		if err := sc.validate(); err != nil {
+			return fmt.Errorf("bad spec.scrapeClasses at idx: %d, err: %w", idx, err)
+		}
+		if _, ok := names[sc.Name]; ok {
+			return fmt.Errorf("spec.scrapeClasses name=%q is duplicated", sc.Name)
+		}
+		names[sc.Name] = struct{}{}
+		if sc.IsDefault() {
+			if defaultName != "" {
+				return fmt.Errorf("spec.scrapeClasses can have only one default class, got: %q and %q", defaultName, sc.Name)
+			}
+			defaultName = sc.Name
+		}
+	}
+	return nil
+}
+
+func (sl *ScrapeLimits) sanityCheck() error {
+	if sl.MaxTargets != nil && *sl.MaxTargets < 1 {
+		return fmt.Errorf("maxTargets must be greater than 0, got: %d", *sl.MaxTargets)
+	}
+	if sl.MaxSeriesPerScrape != nil && *sl.MaxSeriesPerScrape < 1 {
+		return fmt.Errorf("maxSeriesPerScrape must be greater than 0")
+	}
+	if sl.MinScrapeInterval != "" {
+		if _, err := metricsql.DurationValue(sl.MinScrapeInterval, 0); err != nil {
+			return fmt.Errorf("cannot parse minScrapeInterval=%q: %w", sl.MinScrapeInterval, err)
+		}
+	}
+	return nil
+}
 
+// validateConfigMapStorage checks that generated configuration has no inline credentials from VMAgent spec
+// credentials of scrape objects are checked at config generation
+func (r *VMAgent) validateConfigMapStorage() error {
+	if !r.Spec.MountScrapeSecrets {
+		return fmt.Errorf("spec.generatedConfigStorage=configmap requires spec.mountScrapeSecrets to be enabled, scrape credentials cannot be stored at ConfigMap")
+	}
+	if ptr.Deref(r.Spec.UseVMConfigReloader, false) {
+		return fmt.Errorf("spec.generatedConfigStorage=configmap cannot be used with spec.useVMConfigReloader")
+	}
+	if as := r.Spec.APIServerConfig; as != nil {
+		if as.BasicAuth != nil || as.BearerToken != "" || (as.Authorization != nil && as.Authorization.Credentials != nil) {
+			return fmt.Errorf("spec.generatedConfigStorage=configmap cannot be used with credentials at spec.apiServerConfig, use bearerTokenFile instead")
+		}
+	}
 	return nil
 }
 
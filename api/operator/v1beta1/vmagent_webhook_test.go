@@ -2,6 +2,12 @@ package v1beta1
 
 import (
 	"testing"
+	"time"
+
+	"k8s.io/api/autoscaling/v2beta2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestVMAgent_sanityCheck(t *testing.T) {
@@ -75,6 +81,265 @@ func TestVMAgent_sanityCheck(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "hpa with shards",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				ShardCount:  ptr.To(2),
+				HPA: &EmbeddedHPA{
+					MaxReplicas: 3,
+					Behaviour:   &v2beta2.HorizontalPodAutoscalerBehavior{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid hpa",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				HPA: &EmbeddedHPA{
+					MaxReplicas: 3,
+					Behaviour:   &v2beta2.HorizontalPodAutoscalerBehavior{},
+				},
+			},
+		},
+		{
+			name: "cluster mode with shards",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				ShardCount:  ptr.To(2),
+				ClusterMode: &VMAgentClusterMode{MembersCount: 2},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cluster mode with bad replicationFactor",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				ClusterMode: &VMAgentClusterMode{MembersCount: 2, ReplicationFactor: ptr.To[int32](3)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid cluster mode",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				ClusterMode: &VMAgentClusterMode{MembersCount: 3, ReplicationFactor: ptr.To[int32](2)},
+			},
+		},
+		{
+			name: "daemonset mode with statefulMode",
+			spec: VMAgentSpec{
+				RemoteWrite:   []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				DaemonSetMode: true,
+				StatefulMode:  true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "daemonset mode with shards",
+			spec: VMAgentSpec{
+				RemoteWrite:   []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				DaemonSetMode: true,
+				ShardCount:    ptr.To(2),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid daemonset mode",
+			spec: VMAgentSpec{
+				RemoteWrite:   []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				DaemonSetMode: true,
+			},
+		},
+		{
+			name: "valid remoteWrite mirror",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				RemoteWriteMirror: &VMAgentRemoteWriteMirror{
+					VMAgentRemoteWriteSpec: VMAgentRemoteWriteSpec{URL: "http://new-storage"},
+					StartTime:              &metav1.Time{Time: time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)},
+					StopTime:               metav1.Time{Time: time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+		},
+		{
+			name: "remoteWrite mirror without stopTime",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				RemoteWriteMirror: &VMAgentRemoteWriteMirror{
+					VMAgentRemoteWriteSpec: VMAgentRemoteWriteSpec{URL: "http://new-storage"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "remoteWrite mirror with stopTime before startTime",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				RemoteWriteMirror: &VMAgentRemoteWriteMirror{
+					VMAgentRemoteWriteSpec: VMAgentRemoteWriteSpec{URL: "http://new-storage"},
+					StartTime:              &metav1.Time{Time: time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)},
+					StopTime:               metav1.Time{Time: time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "tenant label routing",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{
+					URL: "http://vminsert:8480/insert/0/prometheus/api/v1/write",
+					TenantLabelRouting: &TenantLabelRouting{
+						Label:         "namespace",
+						Tenants:       map[string]string{"team-a": "1", "team-b": "2:5"},
+						DefaultTenant: "100",
+					},
+				}},
+			},
+		},
+		{
+			name: "tenant label routing with single-node url",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{
+					URL: "http://vmsingle:8429/api/v1/write",
+					TenantLabelRouting: &TenantLabelRouting{
+						Label:   "namespace",
+						Tenants: map[string]string{"team-a": "1"},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "tenant label routing with bad tenant",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{
+					URL: "http://vminsert:8480/insert/0/prometheus/api/v1/write",
+					TenantLabelRouting: &TenantLabelRouting{
+						Label:   "namespace",
+						Tenants: map[string]string{"team-a": "team-a"},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "tenant label routing with bad label",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{
+					URL: "http://vminsert:8480/insert/0/prometheus/api/v1/write",
+					TenantLabelRouting: &TenantLabelRouting{
+						Label:   "app.kubernetes.io/name",
+						Tenants: map[string]string{"team-a": "1"},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "scrape object limits",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				VMAgentSecurityEnforcements: VMAgentSecurityEnforcements{
+					ScrapeObjectLimits: &ScrapeObjectLimits{
+						ScrapeLimits: ScrapeLimits{MaxTargets: ptr.To[int32](10), MinScrapeInterval: "15s"},
+						Namespaces: map[string]ScrapeLimits{
+							"team-a": {MaxSeriesPerScrape: ptr.To[uint64](1000)},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "scrape object limits with bad namespace interval",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				VMAgentSecurityEnforcements: VMAgentSecurityEnforcements{
+					ScrapeObjectLimits: &ScrapeObjectLimits{
+						Namespaces: map[string]ScrapeLimits{
+							"team-a": {MinScrapeInterval: "fast"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "remote write target ref",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{TargetRef: "main", SendTimeout: ptr.To("10s")}},
+			},
+		},
+		{
+			name: "remote write target ref with url",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{TargetRef: "main", URL: "http://some-rw"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "scrape classes",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				ScrapeClasses: []ScrapeClass{
+					{Name: "default", Default: ptr.To(true), TLSConfig: &TLSConfig{CAFile: "/etc/ca.crt"}},
+					{Name: "node-metadata", AttachMetadata: &AttachMetadata{Node: ptr.To(true)}},
+				},
+			},
+		},
+		{
+			name: "scrape classes with duplicated name",
+			spec: VMAgentSpec{
+				RemoteWrite:   []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				ScrapeClasses: []ScrapeClass{{Name: "default"}, {Name: "default"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "scrape classes with multiple defaults",
+			spec: VMAgentSpec{
+				RemoteWrite:   []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				ScrapeClasses: []ScrapeClass{{Name: "a", Default: ptr.To(true)}, {Name: "b", Default: ptr.To(true)}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "scrape class with tls secret",
+			spec: VMAgentSpec{
+				RemoteWrite: []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				ScrapeClasses: []ScrapeClass{{Name: "tls", TLSConfig: &TLSConfig{
+					KeySecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "tls"}, Key: "key"},
+				}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "configmap storage without mounted scrape secrets",
+			spec: VMAgentSpec{
+				RemoteWrite:            []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				GeneratedConfigStorage: GeneratedConfigStorageConfigMap,
+			},
+			wantErr: true,
+		},
+		{
+			name: "configmap storage with apiserver credentials",
+			spec: VMAgentSpec{
+				RemoteWrite:                 []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				GeneratedConfigStorage:      GeneratedConfigStorageConfigMap,
+				VMAgentSecurityEnforcements: VMAgentSecurityEnforcements{MountScrapeSecrets: true},
+				APIServerConfig:             &APIServerConfig{Host: "https://kubernetes", BearerToken: "token"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "configmap storage with mounted scrape secrets",
+			spec: VMAgentSpec{
+				RemoteWrite:                 []VMAgentRemoteWriteSpec{{URL: "http://some-rw"}},
+				GeneratedConfigStorage:      GeneratedConfigStorageConfigMap,
+				VMAgentSecurityEnforcements: VMAgentSecurityEnforcements{MountScrapeSecrets: true},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -106,6 +106,13 @@ type VMAlertSpec struct {
 	// +optional
 	RemoteRead *VMAlertRemoteReadSpec `json:"remoteRead,omitempty"`
 
+	// StatePersistence configures persistence of alerts state (ALERTS and ALERTS_FOR_STATE series)
+	// at the referenced VMSingle or VMCluster, so firing alerts survive vmalert restarts.
+	// Operator sets remoteWrite and remoteRead urls of vmalert automatically.
+	// It cannot be combined with remoteWrite and remoteRead
+	// +optional
+	StatePersistence *VMAlertStatePersistence `json:"statePersistence,omitempty"`
+
 	// RulePath to the file with alert rules.
 	// Supports patterns. Flag can be specified multiple times.
 	// Examples:
@@ -115,6 +122,9 @@ type VMAlertSpec struct {
 	// by default operator adds /etc/vmalert/configs/base/vmalert.yaml
 	// +optional
 	RulePath []string `json:"rulePath,omitempty"`
+	// ConfigValidation runs changed rules through vmalert -dryRun Job before update of rules configmaps
+	// +optional
+	ConfigValidation *ConfigValidation `json:"configValidation,omitempty"`
 	// Datasource Victoria Metrics or VMSelect url. Required parameter. e.g. http://127.0.0.1:8428
 	Datasource VMAlertDatasourceSpec `json:"datasource"`
 
@@ -129,6 +139,13 @@ type VMAlertSpec struct {
 	// +optional
 	ServiceScrapeSpec *VMServiceScrapeSpec `json:"serviceScrapeSpec,omitempty"`
 
+	// ShardCount - numbers of shards of VMAlert
+	// rule groups are distributed across shards and stored at separate configmaps per shard,
+	// operator uses 1 deployment per shard with replicas count according to spec.replicaCount.
+	// It's useful for rule sets, which exceed capacity of a single vmalert
+	// +optional
+	ShardCount *int `json:"shardCount,omitempty"`
+
 	// UpdateStrategy - overrides default update strategy.
 	// +kubebuilder:validation:Enum=Recreate;RollingUpdate
 	// +optional
@@ -149,6 +166,12 @@ type VMAlertSpec struct {
 	// ServiceAccountName is the name of the ServiceAccount to use to run the pods
 	// +optional
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// ServiceAccountImagePullSecrets is an optional list of references to secrets in the same namespace,
+	// which are attached to the ServiceAccount managed by operator.
+	// Secrets are merged with global operator configuration VM_SERVICEACCOUNTIMAGEPULLSECRETS.
+	// Has no effect if serviceAccountName is set
+	// +optional
+	ServiceAccountImagePullSecrets []v1.LocalObjectReference `json:"serviceAccountImagePullSecrets,omitempty"`
 
 	CommonDefaultableParams           `json:",inline,omitempty"`
 	CommonConfigReloaderParams        `json:",inline,omitempty"`
@@ -197,6 +220,7 @@ type VMAlertNotifierSpec struct {
 	// Selector allows service discovery for alertmanager
 	// in this case all matched vmalertmanager replicas will be added into vmalert notifier.url
 	// as statefulset pod.fqdn
+	// Auth and TLS settings of notifier are applied to each discovered replica
 	// +optional
 	Selector *DiscoverySelector `json:"selector,omitempty"`
 
@@ -221,6 +245,24 @@ type VMAlertRemoteReadSpec struct {
 	HTTPAuth `json:",inline,omitempty"`
 }
 
+// VMAlertStatePersistence defines storage object for persistence of vmalert state
+// +k8s:openapi-gen=true
+type VMAlertStatePersistence struct {
+	// Kind of storage object
+	// +kubebuilder:validation:Enum=VMSingle;VMCluster
+	Kind string `json:"kind"`
+	// Name of storage object at the VMAlert namespace
+	Name string `json:"name"`
+	// Tenant defines VMCluster tenant in the format accountID[:projectID]
+	// 0 is used by default
+	// +kubebuilder:validation:Pattern:="^[0-9]+(:[0-9]+)?$"
+	// +optional
+	Tenant string `json:"tenant,omitempty"`
+	// Lookback defines how far to look into past for alerts timeseries at restore. (default 1h0m0s)
+	// +optional
+	Lookback *string `json:"lookback,omitempty"`
+}
+
 // VMAlertRemoteWriteSpec defines the remote storage configuration for VmAlert
 // +k8s:openapi-gen=true
 type VMAlertRemoteWriteSpec struct {
@@ -261,6 +303,13 @@ type VMAlertStatus struct {
 	UpdateStatus UpdateStatus `json:"updateStatus,omitempty"`
 	// Reason defines fail reason for update process, effective only for statefulMode
 	Reason string `json:"reason,omitempty"`
+	// Children lists objects generated by operator for this object
+	// +optional
+	Children []ChildObject `json:"children,omitempty"`
+	// Warnings contains problems found at object spec, which don't block reconciliation,
+	// such as image tags with unknown version
+	// +optional
+	Warnings []string `json:"warnings,omitempty"`
 }
 
 // VMAlert  executes a list of given alerting or recording rules against configured address.
@@ -339,7 +388,7 @@ func (cr VMAlert) PodAnnotations() map[string]string {
 }
 
 func (cr VMAlert) AnnotationsFiltered() map[string]string {
-	return filterMapKeysByPrefixes(cr.ObjectMeta.Annotations, annotationFilterPrefixes)
+	return filterMapKeysByPrefixes(cr.ObjectMeta.Annotations, childAnnotationFilterPrefixes(cr.Namespace))
 }
 
 func (cr VMAlert) SelectorLabels() map[string]string {
@@ -365,7 +414,7 @@ func (cr VMAlert) AllLabels() map[string]string {
 	if cr.ObjectMeta.Labels == nil {
 		return selectorLabels
 	}
-	crLabels := filterMapKeysByPrefixes(cr.ObjectMeta.Labels, labelFilterPrefixes)
+	crLabels := filterMapKeysByPrefixes(cr.ObjectMeta.Labels, childLabelFilterPrefixes(cr.Namespace))
 	return labels.Merge(crLabels, selectorLabels)
 }
 
@@ -403,6 +452,11 @@ func (cr VMAlert) GetServiceAccountName() string {
 	return cr.Spec.ServiceAccountName
 }
 
+// GetServiceAccountImagePullSecrets returns image pull secrets for managed ServiceAccount
+func (cr VMAlert) GetServiceAccountImagePullSecrets() []v1.LocalObjectReference {
+	return cr.Spec.ServiceAccountImagePullSecrets
+}
+
 func (cr VMAlert) IsOwnsServiceAccount() bool {
 	return cr.Spec.ServiceAccountName == ""
 }
@@ -482,7 +536,7 @@ func (cr *VMAlert) HasSpecChanges() (bool, error) {
 }
 
 func (cr *VMAlert) Paused() bool {
-	return cr.Spec.Paused
+	return cr.Spec.Paused || IsReconcilePaused(cr)
 }
 
 // SetStatusTo changes update status with optional reason of fail
@@ -491,7 +545,7 @@ func (cr *VMAlert) SetUpdateStatusTo(ctx context.Context, r client.Client, statu
 	prevStatus := cr.Status.DeepCopy()
 	switch status {
 	case UpdateStatusExpanding:
-	case UpdateStatusFailed:
+	case UpdateStatusFailed, UpdateStatusDegraded, UpdateStatusBlocked:
 		if maybeErr != nil {
 			cr.Status.Reason = maybeErr.Error()
 		}
@@ -511,6 +565,21 @@ func (cr *VMAlert) SetUpdateStatusTo(ctx context.Context, r client.Client, statu
 	return statusPatch(ctx, r, cr.DeepCopy(), cr.Status)
 }
 
+// GetStatusChildren returns objects generated by operator for VMAlert
+func (cr *VMAlert) GetStatusChildren() []ChildObject {
+	return cr.Status.Children
+}
+
+// GetStatusWarnings returns problems found at VMAlert spec
+func (cr *VMAlert) GetStatusWarnings() []string {
+	return cr.Status.Warnings
+}
+
+// SetStatusWarnings sets problems found at VMAlert spec
+func (cr *VMAlert) SetStatusWarnings(warnings []string) {
+	cr.Status.Warnings = warnings
+}
+
 // GetAdditionalService returns AdditionalServiceSpec settings
 func (cr *VMAlert) GetAdditionalService() *AdditionalServiceSpec {
 	return cr.Spec.ServiceSpec
@@ -56,6 +56,15 @@ func (r *VMAlert) sanityCheck() error {
 			return fmt.Errorf("vmalert should have at least one notifier.url or enable `-notifier.blackhole`")
 		}
 	}
+	if r.Spec.StatePersistence != nil && (r.Spec.RemoteWrite != nil || r.Spec.RemoteRead != nil) {
+		return fmt.Errorf("spec.statePersistence cannot be combined with spec.remoteWrite and spec.remoteRead")
+	}
+	if err := r.Spec.License.sanityCheck(); err != nil {
+		return err
+	}
+	if err := sanityCheckOverridePatches(r.Spec.OverridePatches); err != nil {
+		return err
+	}
 
 	return nil
 }
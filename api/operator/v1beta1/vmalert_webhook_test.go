@@ -37,6 +37,25 @@ func TestVMAlert_sanityCheck(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "state persistence with remote write",
+			spec: VMAlertSpec{
+				Datasource:       VMAlertDatasourceSpec{URL: "http://some-url"},
+				Notifier:         &VMAlertNotifierSpec{URL: "http://some-url"},
+				StatePersistence: &VMAlertStatePersistence{Kind: "VMSingle", Name: "storage"},
+				RemoteWrite:      &VMAlertRemoteWriteSpec{URL: "http://some-url"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "with state persistence",
+			spec: VMAlertSpec{
+				Datasource:       VMAlertDatasourceSpec{URL: "http://some-url"},
+				Notifier:         &VMAlertNotifierSpec{URL: "http://some-url"},
+				StatePersistence: &VMAlertStatePersistence{Kind: "VMCluster", Name: "storage", Tenant: "1"},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -121,6 +122,8 @@ type VMAlertmanagerSpec struct {
 	// +optional
 	ListenLocal bool `json:"listenLocal,omitempty"`
 	// AdditionalPeers allows injecting a set of additional Alertmanagers to peer with to form a highly available cluster.
+	// Peers must be defined in host:port form, host could be either DNS name or static IP address.
+	// It allows forming a cluster with alertmanagers from the other kubernetes clusters, see GossipService.
 	AdditionalPeers []string `json:"additionalPeers,omitempty"`
 	// ClusterAdvertiseAddress is the explicit address to advertise in cluster.
 	// Needs to be provided for non RFC1918 [1] (public) addresses.
@@ -161,6 +164,18 @@ type VMAlertmanagerSpec struct {
 	// If both nil - behaviour controlled by selectAllByDefault
 	// +optional
 	ConfigNamespaceSelector *metav1.LabelSelector `json:"configNamespaceSelector,omitempty"`
+	// TemplateSelector defines selector for VMAlertmanagerTemplate, selected templates are added into config secret
+	// and registered at templates section of alertmanager config.
+	// Works in combination with TemplateNamespaceSelector.
+	// TemplateNamespaceSelector nil - only objects at VMAlertmanager namespace.
+	// TemplateSelector nil - only objects at TemplateNamespaceSelector namespaces.
+	// If both nil - behaviour controlled by selectAllByDefault
+	// +optional
+	TemplateSelector *metav1.LabelSelector `json:"templateSelector,omitempty"`
+	// TemplateNamespaceSelector defines namespace selector for VMAlertmanagerTemplate.
+	// Works in combination with TemplateSelector.
+	// +optional
+	TemplateNamespaceSelector *metav1.LabelSelector `json:"templateNamespaceSelector,omitempty"`
 
 	// DisableNamespaceMatcher disables top route namespace label matcher for VMAlertmanagerConfig
 	// It may be useful if alert doesn't have namespace label for some reason
@@ -182,6 +197,10 @@ type VMAlertmanagerSpec struct {
 	// Can be changed for RollingUpdate
 	// +optional
 	RollingUpdateStrategy appsv1.StatefulSetUpdateStrategyType `json:"rollingUpdateStrategy,omitempty"`
+	// UpdateStrategy defines update strategy for StatefulSet
+	// it has priority over RollingUpdateStrategy and allows partition-based canary rollout
+	// +optional
+	UpdateStrategy *StatefulSetUpdateStrategy `json:"updateStrategy,omitempty"`
 	// ClaimTemplates allows adding additional VolumeClaimTemplates for StatefulSet
 	ClaimTemplates []v1.PersistentVolumeClaim `json:"claimTemplates,omitempty"`
 	// UseStrictSecurity enables strict security mode for component
@@ -199,9 +218,25 @@ type VMAlertmanagerSpec struct {
 	// GossipConfig defines gossip TLS configuration for Alertmanager cluster
 	// +optional
 	GossipConfig *AlertmanagerGossipConfig `json:"gossipConfig,omitempty"`
+	// GossipService defines Service, which exposes gossip port of Alertmanager outside of kubernetes cluster.
+	// Operator generates cluster.advertise-address from it, if ClusterAdvertiseAddress is not set.
+	// +optional
+	GossipService *AlertmanagerGossipService `json:"gossipService,omitempty"`
+	// DefaultRules configures VMRule with default rules for vmalertmanager generated by operator
+	// +optional
+	DefaultRules *DefaultRules `json:"defaultRules,omitempty"`
+	// ConfigValidation runs changed configuration through amtool check-config Job before its update
+	// +optional
+	ConfigValidation *ConfigValidation `json:"configValidation,omitempty"`
 	// ServiceAccountName is the name of the ServiceAccount to use to run the pods
 	// +optional
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// ServiceAccountImagePullSecrets is an optional list of references to secrets in the same namespace,
+	// which are attached to the ServiceAccount managed by operator.
+	// Secrets are merged with global operator configuration VM_SERVICEACCOUNTIMAGEPULLSECRETS.
+	// Has no effect if serviceAccountName is set
+	// +optional
+	ServiceAccountImagePullSecrets []v1.LocalObjectReference `json:"serviceAccountImagePullSecrets,omitempty"`
 
 	CommonDefaultableParams           `json:",inline,omitempty"`
 	CommonConfigReloaderParams        `json:",inline,omitempty"`
@@ -251,6 +286,13 @@ type VMAlertmanagerStatus struct {
 	UpdateStatus UpdateStatus `json:"updateStatus,omitempty"`
 	// Reason has non empty reason for update failure
 	Reason string `json:"reason,omitempty"`
+	// Children lists objects generated by operator for this object
+	// +optional
+	Children []ChildObject `json:"children,omitempty"`
+	// Warnings contains problems found at object spec, which don't block reconciliation,
+	// such as image tags with unknown version
+	// +optional
+	Warnings []string `json:"warnings,omitempty"`
 }
 
 func (cr *VMAlertmanager) AsOwner() []metav1.OwnerReference {
@@ -277,7 +319,7 @@ func (cr VMAlertmanager) PodAnnotations() map[string]string {
 }
 
 func (cr VMAlertmanager) AnnotationsFiltered() map[string]string {
-	return filterMapKeysByPrefixes(cr.ObjectMeta.Annotations, annotationFilterPrefixes)
+	return filterMapKeysByPrefixes(cr.ObjectMeta.Annotations, childAnnotationFilterPrefixes(cr.Namespace))
 }
 
 func (cr VMAlertmanager) SelectorLabels() map[string]string {
@@ -303,7 +345,7 @@ func (cr VMAlertmanager) AllLabels() map[string]string {
 	if cr.ObjectMeta.Labels == nil {
 		return selectorLabels
 	}
-	crLabels := filterMapKeysByPrefixes(cr.ObjectMeta.Labels, labelFilterPrefixes)
+	crLabels := filterMapKeysByPrefixes(cr.ObjectMeta.Labels, childLabelFilterPrefixes(cr.Namespace))
 	return labels.Merge(crLabels, selectorLabels)
 }
 
@@ -312,6 +354,11 @@ func (cr VMAlertmanager) ConfigSecretName() string {
 	return fmt.Sprintf("%s-config", cr.PrefixedName())
 }
 
+// GossipServiceName returns name of Service, which exposes gossip port of alertmanager
+func (cr VMAlertmanager) GossipServiceName() string {
+	return fmt.Sprintf("%s-gossip", cr.PrefixedName())
+}
+
 func (cr VMAlertmanager) PrefixedName() string {
 	return fmt.Sprintf("vmalertmanager-%s", cr.Name)
 }
@@ -323,6 +370,11 @@ func (cr VMAlertmanager) GetServiceAccountName() string {
 	return cr.Spec.ServiceAccountName
 }
 
+// GetServiceAccountImagePullSecrets returns image pull secrets for managed ServiceAccount
+func (cr VMAlertmanager) GetServiceAccountImagePullSecrets() []v1.LocalObjectReference {
+	return cr.Spec.ServiceAccountImagePullSecrets
+}
+
 func (cr VMAlertmanager) IsOwnsServiceAccount() bool {
 	return cr.Spec.ServiceAccountName == ""
 }
@@ -395,9 +447,11 @@ func (cr *VMAlertmanager) AsNotifiers() []VMAlertNotifierSpec {
 	if cr.Spec.ReplicaCount != nil {
 		replicaCount = int(*cr.Spec.ReplicaCount)
 	}
+	// vmalert appends api path to the notifier url, so route prefix must be included
+	routePrefix := strings.TrimSuffix(cr.Spec.RoutePrefix, "/")
 	for i := 0; i < replicaCount; i++ {
 		ns := VMAlertNotifierSpec{
-			URL: cr.asPodFQDN(i),
+			URL: cr.asPodFQDN(i) + routePrefix,
 		}
 		r = append(r, ns)
 	}
@@ -447,6 +501,11 @@ func (cr *VMAlertmanager) ProbeNeedLiveness() bool {
 	return true
 }
 
+// IsTemplatesUnmanaged checks if alertmanager should manage any alertmanager template objects
+func (cr *VMAlertmanager) IsTemplatesUnmanaged() bool {
+	return !cr.Spec.SelectAllByDefault && cr.Spec.TemplateSelector == nil && cr.Spec.TemplateNamespaceSelector == nil
+}
+
 // IsUnmanaged checks if alertmanager should managed any alertmanager config objects
 func (cr *VMAlertmanager) IsUnmanaged() bool {
 	return !cr.Spec.SelectAllByDefault && cr.Spec.ConfigSelector == nil && cr.Spec.ConfigNamespaceSelector == nil
@@ -477,7 +536,7 @@ func (cr *VMAlertmanager) HasSpecChanges() (bool, error) {
 }
 
 func (cr *VMAlertmanager) Paused() bool {
-	return cr.Spec.Paused
+	return cr.Spec.Paused || IsReconcilePaused(cr)
 }
 
 // SetStatusTo changes update status with optional reason of fail
@@ -487,7 +546,7 @@ func (cr *VMAlertmanager) SetUpdateStatusTo(ctx context.Context, r client.Client
 
 	switch status {
 	case UpdateStatusExpanding:
-	case UpdateStatusFailed:
+	case UpdateStatusFailed, UpdateStatusDegraded, UpdateStatusBlocked:
 		if maybeErr != nil {
 			cr.Status.Reason = maybeErr.Error()
 		}
@@ -510,6 +569,21 @@ func (cr *VMAlertmanager) SetUpdateStatusTo(ctx context.Context, r client.Client
 	return nil
 }
 
+// GetStatusChildren returns objects generated by operator for VMAlertmanager
+func (cr *VMAlertmanager) GetStatusChildren() []ChildObject {
+	return cr.Status.Children
+}
+
+// GetStatusWarnings returns problems found at VMAlertmanager spec
+func (cr *VMAlertmanager) GetStatusWarnings() []string {
+	return cr.Status.Warnings
+}
+
+// SetStatusWarnings sets problems found at VMAlertmanager spec
+func (cr *VMAlertmanager) SetStatusWarnings(warnings []string) {
+	cr.Status.Warnings = warnings
+}
+
 // AlertmanagerGossipConfig defines Gossip TLS configuration for alertmanager
 type AlertmanagerGossipConfig struct {
 	// TLSServerConfig defines server TLS configuration for alertmanager
@@ -518,6 +592,21 @@ type AlertmanagerGossipConfig struct {
 	TLSClientConfig *TLSClientConfig `json:"tls_client_config,omitempty"`
 }
 
+// AlertmanagerGossipService defines Service for gossip communication with alertmanagers from the other clusters
+type AlertmanagerGossipService struct {
+	// Type of Service.
+	// LoadBalancer is supported only for a single replica, ingress address of Service is advertised to peers.
+	// NodePort advertises address of node with nodePort, replicas must be scheduled at different nodes.
+	// +kubebuilder:validation:Enum=LoadBalancer;NodePort
+	Type v1.ServiceType `json:"type"`
+	// NodePort for gossip port, required for NodePort type
+	// +optional
+	NodePort int32 `json:"nodePort,omitempty"`
+	// Annotations added to Service, e.g. load balancer settings of cloud provider
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
 // AlertmanagerWebConfig defines web server configuration for alertmanager
 type AlertmanagerWebConfig struct {
 	// TLSServerConfig defines server TLS configuration for alertmanager
@@ -18,9 +18,12 @@ package v1beta1
 
 import (
 	"fmt"
+	"net"
 
 	"github.com/prometheus/alertmanager/pkg/labels"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -42,6 +45,9 @@ func (r *VMAlertmanager) SetupWebhookWithManager(mgr ctrl.Manager) error {
 var _ webhook.Validator = &VMAlertmanager{}
 
 func (r *VMAlertmanager) sanityCheck() error {
+	if err := r.Spec.DefaultRules.validate(); err != nil {
+		return err
+	}
 	for idx, matchers := range r.Spec.EnforcedTopRouteMatchers {
 		_, err := labels.ParseMatchers(matchers)
 		if err != nil {
@@ -49,6 +55,9 @@ func (r *VMAlertmanager) sanityCheck() error {
 		}
 	}
 
+	if err := r.Spec.UpdateStrategy.sanityCheck(); err != nil {
+		return fmt.Errorf("spec.updateStrategy: %w", err)
+	}
 	if len(r.Spec.ConfigRawYaml) > 0 {
 		if err := ValidateAlertmanagerConfigSpec([]byte(r.Spec.ConfigRawYaml)); err != nil {
 			return fmt.Errorf("bad config syntax at spec.configRawYaml: %w", err)
@@ -103,6 +112,28 @@ func (r *VMAlertmanager) sanityCheck() error {
 			}
 		}
 	}
+	for idx, peer := range r.Spec.AdditionalPeers {
+		if _, _, err := net.SplitHostPort(peer); err != nil {
+			return fmt.Errorf("incorrect additionalPeers=%q at idx=%d, must be in host:port form: %w", peer, idx, err)
+		}
+	}
+	if gs := r.Spec.GossipService; gs != nil {
+		switch gs.Type {
+		case corev1.ServiceTypeLoadBalancer:
+			if ptr.Deref(r.Spec.ReplicaCount, 1) > 1 {
+				return fmt.Errorf("gossipService of LoadBalancer type supports only single replica, got replicaCount=%d", *r.Spec.ReplicaCount)
+			}
+		case corev1.ServiceTypeNodePort:
+			if gs.NodePort <= 0 {
+				return fmt.Errorf("gossipService.nodePort must be set for NodePort type")
+			}
+		default:
+			return fmt.Errorf("unsupported gossipService.type=%q, supported types: LoadBalancer, NodePort", gs.Type)
+		}
+	}
+	if err := sanityCheckOverridePatches(r.Spec.OverridePatches); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -19,7 +19,9 @@ package v1beta1
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 var _ = Describe("VMAlertmanager Webhook", func() {
@@ -59,6 +61,23 @@ global:
           `
 			Expect(am.sanityCheck()).To(Succeed())
 		})
+
+		It("Should deny additional peers without port", func() {
+			am.Spec.AdditionalPeers = []string{"alertmanager.example.com:9094", "10.0.0.1"}
+			Expect(am.sanityCheck()).NotTo(Succeed())
+		})
+
+		It("Should validate gossip service", func() {
+			am.Spec.AdditionalPeers = []string{"alertmanager.example.com:9094"}
+			am.Spec.GossipService = &AlertmanagerGossipService{Type: corev1.ServiceTypeLoadBalancer}
+			Expect(am.sanityCheck()).To(Succeed())
+			am.Spec.ReplicaCount = ptr.To[int32](2)
+			Expect(am.sanityCheck()).NotTo(Succeed())
+			am.Spec.GossipService = &AlertmanagerGossipService{Type: corev1.ServiceTypeNodePort}
+			Expect(am.sanityCheck()).NotTo(Succeed())
+			am.Spec.GossipService.NodePort = 30094
+			Expect(am.sanityCheck()).To(Succeed())
+		})
 	})
 
 	Context("When creating VMAlertmanager under Conversion Webhook", func() {
@@ -0,0 +1,88 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VMAlertmanagerTemplateSpec defines notification templates for VMAlertmanager
+type VMAlertmanagerTemplateSpec struct {
+	// Templates contains notification templates in Go template syntax, keyed by file name.
+	// Operator adds templates into VMAlertmanager config secret
+	// and registers them at the templates section of the alertmanager configuration.
+	// See https://prometheus.io/docs/alerting/latest/notifications/
+	// +kubebuilder:validation:MinProperties=1
+	Templates map[string]string `json:"templates"`
+	// ParsingError contents error with context if operator was failed to parse json object from kubernetes api server
+	ParsingError string `json:"-" yaml:"-"`
+}
+
+// VMAlertmanagerTemplateStatus defines the observed state of VMAlertmanagerTemplate
+type VMAlertmanagerTemplateStatus struct {
+	// Status defines CRD processing status
+	Status UpdateStatus `json:"status,omitempty"`
+	// LastSyncError contains error message for unsuccessful templates processing
+	LastSyncError string `json:"lastSyncError,omitempty"`
+}
+
+// VMAlertmanagerTemplate is the Schema for the vmalertmanagertemplates API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.status"
+// +kubebuilder:printcolumn:name="Sync Error",type="string",JSONPath=".status.lastSyncError"
+// +genclient
+// +k8s:openapi-gen=true
+type VMAlertmanagerTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VMAlertmanagerTemplateSpec   `json:"spec,omitempty"`
+	Status VMAlertmanagerTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VMAlertmanagerTemplateList contains a list of VMAlertmanagerTemplate
+type VMAlertmanagerTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VMAlertmanagerTemplate `json:"items"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (cr *VMAlertmanagerTemplate) UnmarshalJSON(src []byte) error {
+	type amtcr VMAlertmanagerTemplate
+	if err := json.Unmarshal(src, (*amtcr)(cr)); err != nil {
+		cr.Spec.ParsingError = fmt.Sprintf("cannot parse vmalertmanagertemplate: %s, err: %s", string(src), err)
+		return nil
+	}
+	return nil
+}
+
+// AsKey returns unique key for object
+func (cr *VMAlertmanagerTemplate) AsKey() string {
+	return fmt.Sprintf("%s/%s", cr.Namespace, cr.Name)
+}
+
+func init() {
+	SchemeBuilder.Register(&VMAlertmanagerTemplate{}, &VMAlertmanagerTemplateList{})
+}
@@ -0,0 +1,96 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	amtemplate "github.com/prometheus/alertmanager/template"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager will setup the manager to manage the webhooks
+func (r *VMAlertmanagerTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-operator-victoriametrics-com-v1beta1-vmalertmanagertemplate,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.victoriametrics.com,resources=vmalertmanagertemplates,verbs=create;update,versions=v1beta1,name=vvmalertmanagertemplate.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &VMAlertmanagerTemplate{}
+
+// Validate checks that templates have correct names and Go template syntax
+func (r *VMAlertmanagerTemplate) Validate() error {
+	if mustSkipValidation(r) {
+		return nil
+	}
+	if len(r.Spec.Templates) == 0 {
+		return fmt.Errorf("templates cannot be empty")
+	}
+	names := make([]string, 0, len(r.Spec.Templates))
+	for name := range r.Spec.Templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if errs := validation.IsConfigMapKey(name); len(errs) > 0 {
+			return fmt.Errorf("incorrect template name=%q: %s", name, strings.Join(errs, ","))
+		}
+		tmpl, err := amtemplate.New()
+		if err != nil {
+			return fmt.Errorf("cannot init alertmanager template: %w", err)
+		}
+		if err := tmpl.Parse(strings.NewReader(r.Spec.Templates[name])); err != nil {
+			return fmt.Errorf("cannot parse template=%q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMAlertmanagerTemplate) ValidateCreate() (admission.Warnings, error) {
+	if r.Spec.ParsingError != "" {
+		return nil, fmt.Errorf(r.Spec.ParsingError)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMAlertmanagerTemplate) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	if r.Spec.ParsingError != "" {
+		return nil, fmt.Errorf(r.Spec.ParsingError)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *VMAlertmanagerTemplate) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,71 @@
+package v1beta1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+var _ = Describe("VMAlertmanagerTemplate Webhook", func() {
+	Context("When creating VMAlertmanagerTemplate under Validating Webhook", func() {
+		DescribeTable("fails validation",
+			func(srcYAML string, wantErr string) {
+				var amt VMAlertmanagerTemplate
+				Expect(yaml.Unmarshal([]byte(srcYAML), &amt)).To(Succeed())
+				Expect(amt.Validate()).To(MatchError(wantErr))
+			},
+			Entry("empty templates", `
+      apiVersion: operator.victoriametrics.com/v1beta1
+      kind: VMAlertmanagerTemplate
+      metadata:
+        name: empty
+      spec:
+        templates: {}
+        `, `templates cannot be empty`),
+			Entry("bad template name", `
+      apiVersion: operator.victoriametrics.com/v1beta1
+      kind: VMAlertmanagerTemplate
+      metadata:
+        name: bad-name
+      spec:
+        templates:
+          slack/title.tmpl: '{{ define "slack.title" }}title{{ end }}'
+        `, `incorrect template name="slack/title.tmpl": a valid config key must consist of alphanumeric characters, '-', '_' or '.' (e.g. 'key.name',  or 'KEY_NAME',  or 'key-name', regex used for validation is '[-._a-zA-Z0-9]+')`),
+			Entry("bad template syntax", `
+      apiVersion: operator.victoriametrics.com/v1beta1
+      kind: VMAlertmanagerTemplate
+      metadata:
+        name: bad-syntax
+      spec:
+        templates:
+          slack.tmpl: '{{ define "slack.title" }}{{ .CommonLabels.alertname }'
+        `, `cannot parse template="slack.tmpl": template: :1: unexpected "}" in operand`),
+			Entry("unknown function", `
+      apiVersion: operator.victoriametrics.com/v1beta1
+      kind: VMAlertmanagerTemplate
+      metadata:
+        name: bad-func
+      spec:
+        templates:
+          slack.tmpl: '{{ define "slack.title" }}{{ .CommonLabels.alertname | nonExistFunc }}{{ end }}'
+        `, `cannot parse template="slack.tmpl": template: :1: function "nonExistFunc" not defined`),
+		)
+		DescribeTable("passes validation",
+			func(srcYAML string) {
+				var amt VMAlertmanagerTemplate
+				Expect(yaml.Unmarshal([]byte(srcYAML), &amt)).To(Succeed())
+				Expect(amt.Validate()).To(Succeed())
+			},
+			Entry("templates with alertmanager functions", `
+      apiVersion: operator.victoriametrics.com/v1beta1
+      kind: VMAlertmanagerTemplate
+      metadata:
+        name: slack
+      spec:
+        templates:
+          slack.tmpl: '{{ define "slack.title" }}{{ .CommonLabels.alertname | toUpper }}{{ end }}'
+          email.tmpl: '{{ define "email.subject" }}{{ .Alerts.Firing | len }} alerts{{ end }}'
+        `),
+		)
+	})
+})
@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
+	v1 "k8s.io/api/core/v1"
 	v12 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -62,12 +64,33 @@ type VMAuthSpec struct {
 	PodDisruptionBudget *EmbeddedPodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
 	// Ingress enables ingress configuration for VMAuth.
 	Ingress *EmbeddedIngress `json:"ingress,omitempty"`
+	// HTTPRoute enables Gateway API HTTPRoute configuration for VMAuth.
+	// It requires gateway.networking.k8s.io/v1 API installed at the cluster
+	// +optional
+	HTTPRoute *EmbeddedHTTPRoute `json:"httpRoute,omitempty"`
+	// CertManager issues serving certificate for VMAuth with cert-manager Certificate.
+	// Certificate is mounted into vmauth pod and vmauth serves https with it.
+	// It requires -certmanager.enable operator flag
+	// +optional
+	CertManager *CertManagerCertificate `json:"certManager,omitempty"`
 	// LivenessProbe that will be added to VMAuth pod
 	*EmbeddedProbes `json:",inline"`
 	// UnauthorizedAccessConfig configures access for un authorized users
+	// Deprecated: use unauthorizedUserAccessSpec.url_map instead
 	// +optional
 	UnauthorizedAccessConfig []UnauthorizedAccessConfigURLMap `json:"unauthorizedAccessConfig,omitempty"`
-	UserConfigOption         `json:",inline"`
+	// UnauthorizedUserAccessSpec defines unauthorized_user section of vmauth config.
+	// It cannot be used together with unauthorizedAccessConfig and inline user config options.
+	// See [here](https://docs.victoriametrics.com/vmauth#unauthorized-access) for more details
+	// +optional
+	UnauthorizedUserAccessSpec *VMAuthUnauthorizedUserAccessSpec `json:"unauthorizedUserAccessSpec,omitempty"`
+	// GlobalIPFilters defines ip filters applied to all requests, including unauthorized ones.
+	// supported only with enterprise version of [vmauth](https://docs.victoriametrics.com/vmauth/#ip-filters)
+	// +optional
+	GlobalIPFilters *VMUserIPFilters `json:"globalIPFilters,omitempty"`
+	// UserConfigOption configures unauthorized_user section of vmauth config.
+	// Deprecated: use unauthorizedUserAccessSpec instead
+	UserConfigOption `json:",inline"`
 	// License allows to configure license key to be used for enterprise features.
 	// Using license key is supported starting from VictoriaMetrics v1.94.0.
 	// See [here](https://docs.victoriametrics.com/enterprise)
@@ -80,15 +103,62 @@ type VMAuthSpec struct {
 	// If it's defined, configuration for vmauth becomes unmanaged and operator'll not create any related secrets/config-reloaders
 	// +optional
 	ConfigSecret string `json:"configSecret,omitempty"`
+	// ConfigRollout enables two-phase rollout of generated configuration.
+	// New configuration is verified at canary replica before it's applied to the rest of replicas.
+	// If canary rejects configuration, the previous configuration is kept.
+	// It cannot be used together with configSecret
+	// +optional
+	ConfigRollout *VMAuthConfigRollout `json:"configRollout,omitempty"`
 	// ServiceAccountName is the name of the ServiceAccount to use to run the pods
 	// +optional
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// ServiceAccountImagePullSecrets is an optional list of references to secrets in the same namespace,
+	// which are attached to the ServiceAccount managed by operator.
+	// Secrets are merged with global operator configuration VM_SERVICEACCOUNTIMAGEPULLSECRETS.
+	// Has no effect if serviceAccountName is set
+	// +optional
+	ServiceAccountImagePullSecrets []v1.LocalObjectReference `json:"serviceAccountImagePullSecrets,omitempty"`
 
 	CommonDefaultableParams           `json:",inline,omitempty"`
 	CommonConfigReloaderParams        `json:",inline,omitempty"`
 	CommonApplicationDeploymentParams `json:",inline,omitempty"`
 }
 
+// VMAuthConfigRollout defines two-phase rollout of vmauth configuration
+type VMAuthConfigRollout struct {
+	// CanaryTimeout defines how long operator waits for canary replica to load new configuration
+	// Defaults to 2m
+	// +optional
+	CanaryTimeout string `json:"canaryTimeout,omitempty"`
+}
+
+// GetCanaryTimeout returns timeout for canary configuration verification
+func (cr *VMAuthConfigRollout) GetCanaryTimeout() time.Duration {
+	if cr.CanaryTimeout != "" {
+		if d, err := time.ParseDuration(cr.CanaryTimeout); err == nil {
+			return d
+		}
+	}
+	return 2 * time.Minute
+}
+
+// VMAuthUnauthorizedUserAccessSpec defines unauthorized_user section configuration for vmauth
+type VMAuthUnauthorizedUserAccessSpec struct {
+	// URLPrefix defines backend url prefixes for requests, which don't match any url_map entry
+	// +optional
+	URLPrefix []string `json:"url_prefix,omitempty"`
+	// URLMap defines routing rules for unauthorized requests
+	// +optional
+	URLMap []UnauthorizedAccessConfigURLMap `json:"url_map,omitempty"`
+
+	UserConfigOption `json:",inline"`
+
+	// MetricLabels - additional labels for metrics exported by vmauth for unauthorized user.
+	// +optional
+	MetricLabels map[string]string `json:"metric_labels,omitempty"`
+}
+
+// UnauthorizedAccessConfigURLMap defines routing rule for unauthorized requests
 type UnauthorizedAccessConfigURLMap struct {
 	// SrcPaths is an optional list of regular expressions, which must match the request path.
 	SrcPaths []string `json:"src_paths,omitempty"`
@@ -252,12 +322,44 @@ type EmbeddedIngress struct {
 	Host string `json:"host,omitempty"`
 }
 
+// EmbeddedHTTPRoute describes Gateway API HTTPRoute configuration options.
+// TLS termination and gateway class are configured at the referenced Gateway listeners
+type EmbeddedHTTPRoute struct {
+	//  EmbeddedObjectMetadata adds labels and annotations for object.
+	EmbeddedObjectMetadata `json:",inline"`
+	// ParentRefs references Gateways, which serve the route
+	// +kubebuilder:validation:MinItems=1
+	ParentRefs []HTTPRouteParentRef `json:"parentRefs"`
+	// Hostnames defines hostnames matched by the route
+	// +optional
+	Hostnames []string `json:"hostnames,omitempty"`
+}
+
+// HTTPRouteParentRef references Gateway for HTTPRoute
+type HTTPRouteParentRef struct {
+	// Name of Gateway
+	Name string `json:"name"`
+	// Namespace of Gateway, VMAuth namespace is used by default
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// SectionName selects Gateway listener, for example https listener with TLS certificate
+	// +optional
+	SectionName string `json:"sectionName,omitempty"`
+}
+
 // VMAuthStatus defines the observed state of VMAuth
 type VMAuthStatus struct {
 	// UpdateStatus defines a status for update rollout, effective only for statefulMode
 	UpdateStatus UpdateStatus `json:"updateStatus,omitempty"`
 	// Reason defines fail reason for update process, effective only for statefulMode
 	Reason string `json:"reason,omitempty"`
+	// Children lists objects generated by operator for this object
+	// +optional
+	Children []ChildObject `json:"children,omitempty"`
+	// Warnings contains problems found at object spec, which don't block reconciliation,
+	// such as image tags with unknown version
+	// +optional
+	Warnings []string `json:"warnings,omitempty"`
 }
 
 // VMAuth is the Schema for the vmauths API
@@ -287,7 +389,7 @@ func (cr *VMAuth) ProbePath() string {
 }
 
 func (cr *VMAuth) ProbeScheme() string {
-	return strings.ToUpper(protoFromFlags(cr.Spec.ExtraArgs))
+	return strings.ToUpper(protoFromFlags(cr.GetExtraArgs()))
 }
 
 func (cr *VMAuth) ProbePort() string {
@@ -331,7 +433,7 @@ func (cr VMAuth) PodAnnotations() map[string]string {
 }
 
 func (cr VMAuth) AnnotationsFiltered() map[string]string {
-	return filterMapKeysByPrefixes(cr.ObjectMeta.Annotations, annotationFilterPrefixes)
+	return filterMapKeysByPrefixes(cr.ObjectMeta.Annotations, childAnnotationFilterPrefixes(cr.Namespace))
 }
 
 func (cr VMAuth) SelectorLabels() map[string]string {
@@ -357,7 +459,7 @@ func (cr VMAuth) AllLabels() map[string]string {
 	if cr.ObjectMeta.Labels == nil {
 		return selectorLabels
 	}
-	crLabels := filterMapKeysByPrefixes(cr.ObjectMeta.Labels, labelFilterPrefixes)
+	crLabels := filterMapKeysByPrefixes(cr.ObjectMeta.Labels, childLabelFilterPrefixes(cr.Namespace))
 	return labels.Merge(crLabels, selectorLabels)
 }
 
@@ -369,6 +471,29 @@ func (cr VMAuth) ConfigSecretName() string {
 	return fmt.Sprintf("vmauth-config-%s", cr.Name)
 }
 
+// CanaryName returns name of canary Deployment used for configuration rollout
+func (cr VMAuth) CanaryName() string {
+	return fmt.Sprintf("vmauth-canary-%s", cr.Name)
+}
+
+// CanaryConfigSecretName returns name of secret with configuration verified by canary
+func (cr VMAuth) CanaryConfigSecretName() string {
+	return fmt.Sprintf("vmauth-config-canary-%s", cr.Name)
+}
+
+// CanarySelectorLabels returns selector labels of canary Deployment
+// component label differs from SelectorLabels, so canary pods don't receive service traffic
+func (cr VMAuth) CanarySelectorLabels() map[string]string {
+	lbls := cr.SelectorLabels()
+	lbls["app.kubernetes.io/component"] = "canary"
+	return lbls
+}
+
+// CertManagerSecretName returns name of secret with certificate issued by cert-manager
+func (cr VMAuth) CertManagerSecretName() string {
+	return fmt.Sprintf("vmauth-tls-%s", cr.Name)
+}
+
 // GetMetricPath returns prefixed path for metric requests
 func (cr VMAuth) GetMetricPath() string {
 	return buildPathWithPrefixFlag(cr.Spec.ExtraArgs, metricPath)
@@ -376,7 +501,18 @@ func (cr VMAuth) GetMetricPath() string {
 
 // GetExtraArgs returns additionally configured command-line arguments
 func (cr VMAuth) GetExtraArgs() map[string]string {
-	return cr.Spec.ExtraArgs
+	if cr.Spec.CertManager == nil {
+		return cr.Spec.ExtraArgs
+	}
+	// vmauth serves https with certificate issued by cert-manager
+	extraArgs := make(map[string]string, len(cr.Spec.ExtraArgs)+1)
+	for k, v := range cr.Spec.ExtraArgs {
+		extraArgs[k] = v
+	}
+	if _, ok := extraArgs["tls"]; !ok {
+		extraArgs["tls"] = "true"
+	}
+	return extraArgs
 }
 
 // GetServiceScrape returns overrides for serviceScrape builder
@@ -391,6 +527,11 @@ func (cr VMAuth) GetServiceAccountName() string {
 	return cr.Spec.ServiceAccountName
 }
 
+// GetServiceAccountImagePullSecrets returns image pull secrets for managed ServiceAccount
+func (cr VMAuth) GetServiceAccountImagePullSecrets() []v1.LocalObjectReference {
+	return cr.Spec.ServiceAccountImagePullSecrets
+}
+
 func (cr VMAuth) IsOwnsServiceAccount() bool {
 	return cr.Spec.ServiceAccountName == ""
 }
@@ -399,6 +540,27 @@ func (cr VMAuth) GetNSName() string {
 	return cr.GetNamespace()
 }
 
+// AsURL returns url for accessing vmauth service
+func (cr *VMAuth) AsURL() string {
+	port := cr.Spec.Port
+	if port == "" {
+		port = "8427"
+	}
+	if cr.Spec.ServiceSpec != nil && cr.Spec.ServiceSpec.UseAsDefault {
+		for _, svcPort := range cr.Spec.ServiceSpec.Spec.Ports {
+			if svcPort.Name == "http" {
+				port = fmt.Sprintf("%d", svcPort.Port)
+				break
+			}
+		}
+	}
+	proto := protoFromFlags(cr.Spec.ExtraArgs)
+	if cr.Spec.CertManager != nil {
+		proto = "https"
+	}
+	return fmt.Sprintf("%s://%s.%s.svc:%s", proto, cr.PrefixedName(), cr.Namespace, port)
+}
+
 // AsCRDOwner implements interface
 func (cr *VMAuth) AsCRDOwner() []metav1.OwnerReference {
 	return GetCRDAsOwner(Auth)
@@ -434,7 +596,7 @@ func (cr *VMAuth) HasSpecChanges() (bool, error) {
 }
 
 func (cr *VMAuth) Paused() bool {
-	return cr.Spec.Paused
+	return cr.Spec.Paused || IsReconcilePaused(cr)
 }
 
 // SetStatusTo changes update status with optional reason of fail
@@ -443,7 +605,7 @@ func (cr *VMAuth) SetUpdateStatusTo(ctx context.Context, r client.Client, status
 	prevStatus := cr.Status.DeepCopy()
 	switch status {
 	case UpdateStatusExpanding:
-	case UpdateStatusFailed:
+	case UpdateStatusFailed, UpdateStatusDegraded, UpdateStatusBlocked:
 		if maybeErr != nil {
 			cr.Status.Reason = maybeErr.Error()
 		}
@@ -463,6 +625,21 @@ func (cr *VMAuth) SetUpdateStatusTo(ctx context.Context, r client.Client, status
 	return statusPatch(ctx, r, cr.DeepCopy(), cr.Status)
 }
 
+// GetStatusChildren returns objects generated by operator for VMAuth
+func (cr *VMAuth) GetStatusChildren() []ChildObject {
+	return cr.Status.Children
+}
+
+// GetStatusWarnings returns problems found at VMAuth spec
+func (cr *VMAuth) GetStatusWarnings() []string {
+	return cr.Status.Warnings
+}
+
+// SetStatusWarnings sets problems found at VMAuth spec
+func (cr *VMAuth) SetStatusWarnings(warnings []string) {
+	cr.Status.Warnings = warnings
+}
+
 // GetAdditionalService returns AdditionalServiceSpec settings
 func (cr *VMAuth) GetAdditionalService() *AdditionalServiceSpec {
 	return cr.Spec.ServiceSpec
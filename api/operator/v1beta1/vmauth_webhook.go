@@ -18,6 +18,12 @@ package v1beta1
 
 import (
 	"fmt"
+	"net"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -44,6 +50,167 @@ func (r *VMAuth) sanityCheck() error {
 			return fmt.Errorf("spec.ingress.tlsHosts cannot be empty with non-empty spec.ingress.tlsSecretName")
 		}
 	}
+	if r.Spec.UnauthorizedUserAccessSpec != nil {
+		if len(r.Spec.UnauthorizedAccessConfig) > 0 {
+			return fmt.Errorf("spec.unauthorizedAccessConfig cannot be used together with spec.unauthorizedUserAccessSpec")
+		}
+		if !reflect.DeepEqual(r.Spec.UserConfigOption, UserConfigOption{}) {
+			return fmt.Errorf("spec.unauthorizedUserAccessSpec cannot be used together with inline unauthorized user options at spec, move them into spec.unauthorizedUserAccessSpec")
+		}
+		if err := r.Spec.UnauthorizedUserAccessSpec.validate(); err != nil {
+			return fmt.Errorf("bad spec.unauthorizedUserAccessSpec: %w", err)
+		}
+	}
+	for idx, um := range r.Spec.UnauthorizedAccessConfig {
+		if err := um.validate(); err != nil {
+			return fmt.Errorf("bad spec.unauthorizedAccessConfig at idx=%d: %w", idx, err)
+		}
+	}
+	if err := r.Spec.UserConfigOption.validate(); err != nil {
+		return fmt.Errorf("bad unauthorized user options at spec: %w", err)
+	}
+	if r.Spec.GlobalIPFilters != nil {
+		if err := r.Spec.GlobalIPFilters.validate(); err != nil {
+			return fmt.Errorf("bad spec.globalIPFilters: %w", err)
+		}
+	}
+	if r.Spec.ConfigRollout != nil {
+		if r.Spec.ConfigSecret != "" {
+			return fmt.Errorf("spec.configRollout cannot be used together with spec.configSecret")
+		}
+		if r.Spec.ConfigRollout.CanaryTimeout != "" {
+			if _, err := time.ParseDuration(r.Spec.ConfigRollout.CanaryTimeout); err != nil {
+				return fmt.Errorf("cannot parse spec.configRollout.canaryTimeout: %w", err)
+			}
+		}
+	}
+	if err := r.Spec.License.sanityCheck(); err != nil {
+		return err
+	}
+	if err := sanityCheckOverridePatches(r.Spec.OverridePatches); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *VMAuthUnauthorizedUserAccessSpec) validate() error {
+	if len(r.URLPrefix) == 0 && len(r.URLMap) == 0 {
+		return fmt.Errorf("at least one of url_prefix or url_map must be set")
+	}
+	if err := validateURLPrefixes(r.URLPrefix); err != nil {
+		return fmt.Errorf("bad url_prefix: %w", err)
+	}
+	for idx, um := range r.URLMap {
+		if err := um.validate(); err != nil {
+			return fmt.Errorf("bad url_map at idx=%d: %w", idx, err)
+		}
+	}
+	return r.UserConfigOption.validate()
+}
+
+func (r *UnauthorizedAccessConfigURLMap) validate() error {
+	if len(r.URLPrefix) == 0 {
+		return fmt.Errorf("url_prefix cannot be empty")
+	}
+	if err := validateURLPrefixes(r.URLPrefix); err != nil {
+		return fmt.Errorf("bad url_prefix: %w", err)
+	}
+	if len(r.SrcPaths) == 0 && len(r.SrcHosts) == 0 && len(r.SrcQueryArgs) == 0 && len(r.SrcHeaders) == 0 {
+		return fmt.Errorf("at least one of src_paths, src_hosts, src_query_args or src_headers must be set")
+	}
+	if err := validateAnchoredRegexps(r.SrcPaths); err != nil {
+		return fmt.Errorf("bad src_paths: %w", err)
+	}
+	if err := validateAnchoredRegexps(r.SrcHosts); err != nil {
+		return fmt.Errorf("bad src_hosts: %w", err)
+	}
+	return r.URLMapCommon.validate()
+}
+
+func (r *URLMapCommon) validate() error {
+	if err := parseHeaders(r.SrcHeaders); err != nil {
+		return fmt.Errorf("bad src_headers: %w", err)
+	}
+	if err := parseHeaders(r.RequestHeaders); err != nil {
+		return fmt.Errorf("bad headers: %w", err)
+	}
+	if err := parseHeaders(r.ResponseHeaders); err != nil {
+		return fmt.Errorf("bad response_headers: %w", err)
+	}
+	if r.DropSrcPathPrefixParts != nil && *r.DropSrcPathPrefixParts < 0 {
+		return fmt.Errorf("drop_src_path_prefix_parts cannot be negative, got: %d", *r.DropSrcPathPrefixParts)
+	}
+	return nil
+}
+
+func (r *UserConfigOption) validate() error {
+	if err := validateURLPrefixes(r.DefaultURLs); err != nil {
+		return fmt.Errorf("bad default_url: %w", err)
+	}
+	if err := r.IPFilters.validate(); err != nil {
+		return fmt.Errorf("bad ip_filters: %w", err)
+	}
+	if err := parseHeaders(r.Headers); err != nil {
+		return fmt.Errorf("bad headers: %w", err)
+	}
+	if err := parseHeaders(r.ResponseHeaders); err != nil {
+		return fmt.Errorf("bad response_headers: %w", err)
+	}
+	if r.MaxConcurrentRequests != nil && *r.MaxConcurrentRequests < 1 {
+		return fmt.Errorf("max_concurrent_requests must be greater than 0, got: %d", *r.MaxConcurrentRequests)
+	}
+	if r.DropSrcPathPrefixParts != nil && *r.DropSrcPathPrefixParts < 0 {
+		return fmt.Errorf("drop_src_path_prefix_parts cannot be negative, got: %d", *r.DropSrcPathPrefixParts)
+	}
+	return nil
+}
+
+func (r *VMUserIPFilters) validate() error {
+	if err := validateIPList(r.AllowList); err != nil {
+		return fmt.Errorf("bad allow_list: %w", err)
+	}
+	if err := validateIPList(r.DenyList); err != nil {
+		return fmt.Errorf("bad deny_list: %w", err)
+	}
+	return nil
+}
+
+// validateIPList checks that each entry is an IP address or CIDR, as vmauth expects
+func validateIPList(src []string) error {
+	for idx, s := range src {
+		if strings.Contains(s, "/") {
+			if _, _, err := net.ParseCIDR(s); err != nil {
+				return fmt.Errorf("cannot parse %q at idx=%d as CIDR: %w", s, idx, err)
+			}
+			continue
+		}
+		if net.ParseIP(s) == nil {
+			return fmt.Errorf("cannot parse %q at idx=%d as IP address", s, idx)
+		}
+	}
+	return nil
+}
+
+func validateURLPrefixes(src []string) error {
+	for idx, s := range src {
+		u, err := url.Parse(s)
+		if err != nil {
+			return fmt.Errorf("cannot parse %q at idx=%d: %w", s, idx, err)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("url %q at idx=%d must contain scheme and host", s, idx)
+		}
+	}
+	return nil
+}
+
+// validateAnchoredRegexps checks regexps the same way as vmauth does, it anchors them to the whole value
+func validateAnchoredRegexps(src []string) error {
+	for idx, s := range src {
+		if _, err := regexp.Compile("^(?:" + s + ")$"); err != nil {
+			return fmt.Errorf("cannot compile regexp %q at idx=%d: %w", s, idx, err)
+		}
+	}
 	return nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestVMAuth_sanityCheck(t *testing.T) {
@@ -40,6 +41,155 @@ func TestVMAuth_sanityCheck(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid unauthorized user access spec",
+			fields: fields{
+				Spec: VMAuthSpec{
+					UnauthorizedUserAccessSpec: &VMAuthUnauthorizedUserAccessSpec{
+						URLMap: []UnauthorizedAccessConfigURLMap{
+							{
+								SrcPaths:     []string{"/api/v1/query", "/app1/.*"},
+								URLPrefix:    []string{"http://vmselect:8481/select/0/prometheus"},
+								URLMapCommon: URLMapCommon{DropSrcPathPrefixParts: ptr.To(1)},
+							},
+						},
+						UserConfigOption: UserConfigOption{
+							IPFilters: VMUserIPFilters{AllowList: []string{"10.0.0.0/8", "192.168.0.1"}},
+						},
+						MetricLabels: map[string]string{"team": "infra"},
+					},
+					GlobalIPFilters: &VMUserIPFilters{DenyList: []string{"5.6.7.8"}},
+				},
+			},
+		},
+		{
+			name: "valid config rollout",
+			fields: fields{
+				Spec: VMAuthSpec{
+					ConfigRollout: &VMAuthConfigRollout{CanaryTimeout: "90s"},
+				},
+			},
+		},
+		{
+			name: "config rollout with config secret",
+			fields: fields{
+				Spec: VMAuthSpec{
+					ConfigSecret:  "external-cfg",
+					ConfigRollout: &VMAuthConfigRollout{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "config rollout with invalid canary timeout",
+			fields: fields{
+				Spec: VMAuthSpec{
+					ConfigRollout: &VMAuthConfigRollout{CanaryTimeout: "1 minute"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unauthorized user access spec with legacy unauthorized access config",
+			fields: fields{
+				Spec: VMAuthSpec{
+					UnauthorizedUserAccessSpec: &VMAuthUnauthorizedUserAccessSpec{URLPrefix: []string{"http://vmsingle:8428"}},
+					UnauthorizedAccessConfig: []UnauthorizedAccessConfigURLMap{
+						{SrcPaths: []string{"/metrics"}, URLPrefix: []string{"http://vmsingle:8428"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unauthorized user access spec with inline options",
+			fields: fields{
+				Spec: VMAuthSpec{
+					UnauthorizedUserAccessSpec: &VMAuthUnauthorizedUserAccessSpec{URLPrefix: []string{"http://vmsingle:8428"}},
+					UserConfigOption:           UserConfigOption{DefaultURLs: []string{"http://default:8080"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty unauthorized user access spec",
+			fields: fields{
+				Spec: VMAuthSpec{
+					UnauthorizedUserAccessSpec: &VMAuthUnauthorizedUserAccessSpec{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "url map without src matchers",
+			fields: fields{
+				Spec: VMAuthSpec{
+					UnauthorizedUserAccessSpec: &VMAuthUnauthorizedUserAccessSpec{
+						URLMap: []UnauthorizedAccessConfigURLMap{{URLPrefix: []string{"http://vmsingle:8428"}}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "url map with bad src_paths regexp",
+			fields: fields{
+				Spec: VMAuthSpec{
+					UnauthorizedAccessConfig: []UnauthorizedAccessConfigURLMap{
+						{SrcPaths: []string{"/api/(v1"}, URLPrefix: []string{"http://vmsingle:8428"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "url map with relative url_prefix",
+			fields: fields{
+				Spec: VMAuthSpec{
+					UnauthorizedAccessConfig: []UnauthorizedAccessConfigURLMap{
+						{SrcPaths: []string{"/metrics"}, URLPrefix: []string{"vmsingle:8428/metrics"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative drop_src_path_prefix_parts",
+			fields: fields{
+				Spec: VMAuthSpec{
+					UnauthorizedUserAccessSpec: &VMAuthUnauthorizedUserAccessSpec{
+						URLMap: []UnauthorizedAccessConfigURLMap{
+							{
+								SrcPaths:     []string{"/app1/.*"},
+								URLPrefix:    []string{"http://app1:8080"},
+								URLMapCommon: URLMapCommon{DropSrcPathPrefixParts: ptr.To(-1)},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad inline ip filters",
+			fields: fields{
+				Spec: VMAuthSpec{
+					UserConfigOption: UserConfigOption{
+						IPFilters: VMUserIPFilters{AllowList: []string{"10.0.0.0/33"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad global ip filters",
+			fields: fields{
+				Spec: VMAuthSpec{
+					GlobalIPFilters: &VMUserIPFilters{DenyList: []string{"localhost"}},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
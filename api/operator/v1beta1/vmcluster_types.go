@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"path"
 	"strings"
+	"time"
 
+	"github.com/VictoriaMetrics/VictoriaMetrics/lib/flagutil"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -29,6 +31,20 @@ type VMClusterSpec struct {
 	// reverse index data at indexdb rotates once at the half of configured
 	// [retention period](https://docs.victoriametrics.com/Single-server-VictoriaMetrics/#retention)
 	RetentionPeriod string `json:"retentionPeriod"`
+	// RetentionFilters defines retention for series matching the given filters
+	// in the form of `{label="value"}:period`, e.g. `{team="dev"}:7d`.
+	// It's passed as `-retentionFilter` flag to vmstorage.
+	// Requires [enterprise license](https://docs.victoriametrics.com/enterprise)
+	// See [retention filters](https://docs.victoriametrics.com/cluster-victoriametrics/#retention-filters)
+	// +optional
+	RetentionFilters []string `json:"retentionFilters,omitempty"`
+	// DownsamplingPeriods defines downsampling rules in the form of `offset:interval`
+	// or `filter:offset:interval`, e.g. `30d:5m` or `{env="dev"}:7d:1h`.
+	// It's passed as `-downsampling.period` flag to vmstorage and vmselect.
+	// Requires [enterprise license](https://docs.victoriametrics.com/enterprise)
+	// See [downsampling](https://docs.victoriametrics.com/cluster-victoriametrics/#downsampling)
+	// +optional
+	DownsamplingPeriods []string `json:"downsamplingPeriods,omitempty"`
 	// ReplicationFactor defines how many copies of data make among
 	// distinct storage nodes
 	// +optional
@@ -38,6 +54,12 @@ type VMClusterSpec struct {
 	// VMSelect, VMStorage and VMInsert Pods.
 	// +optional
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// ServiceAccountImagePullSecrets is an optional list of references to secrets in the same namespace,
+	// which are attached to the ServiceAccount managed by operator.
+	// Secrets are merged with global operator configuration VM_SERVICEACCOUNTIMAGEPULLSECRETS.
+	// Has no effect if serviceAccountName is set
+	// +optional
+	ServiceAccountImagePullSecrets []v1.LocalObjectReference `json:"serviceAccountImagePullSecrets,omitempty"`
 
 	// ClusterVersion defines default images tag for all components.
 	// it can be overwritten with component specific image.tag value.
@@ -67,6 +89,9 @@ type VMClusterSpec struct {
 	VMInsert *VMInsert `json:"vminsert,omitempty"`
 	// +optional
 	VMStorage *VMStorage `json:"vmstorage,omitempty"`
+	// DefaultRules configures VMRule with default rules for vmcluster generated by operator
+	// +optional
+	DefaultRules *DefaultRules `json:"defaultRules,omitempty"`
 	// Paused If set to true all actions on the underlying managed objects are not
 	// going to be performed, except for delete actions.
 	// +optional
@@ -112,6 +137,7 @@ func (cr *VMClusterSpec) UnmarshalJSON(src []byte) error {
 // +genclient
 // +k8s:openapi-gen=true
 // +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.vmselect.replicaCount,statuspath=.status.vmselectReplicas,selectorpath=.status.vmselectSelector
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=vmclusters,scope=Namespaced
 // +kubebuilder:printcolumn:name="Insert Count",type="string",JSONPath=".spec.vminsert.replicaCount",description="replicas of VMInsert"
@@ -153,6 +179,34 @@ type VMClusterStatus struct {
 	LastSync     string       `json:"lastSync,omitempty"`
 	UpdateStatus UpdateStatus `json:"clusterStatus,omitempty"`
 	Reason       string       `json:"reason,omitempty"`
+	// StorageDrain reports progress of vmstorage scale down
+	// +optional
+	StorageDrain *VMStorageDrainStatus `json:"storageDrain,omitempty"`
+	// Children lists objects generated by operator for this object
+	// +optional
+	Children []ChildObject `json:"children,omitempty"`
+	// Warnings contains problems found at object spec, which don't block reconciliation,
+	// such as image tags with unknown version
+	// +optional
+	Warnings []string `json:"warnings,omitempty"`
+	// VMSelectReplicas represents observed number of vmselect replicas, used by scale subresource
+	// +optional
+	VMSelectReplicas int32 `json:"vmselectReplicas,omitempty"`
+	// VMSelectSelector string form of vmselect label value set for autoscaling
+	// +optional
+	VMSelectSelector string `json:"vmselectSelector,omitempty"`
+}
+
+// VMStorageDrainStatus defines state of vmstorage nodes draining before scale down
+type VMStorageDrainStatus struct {
+	// FromReplicas defines number of vmstorage replicas before scale down
+	FromReplicas int32 `json:"fromReplicas"`
+	// ToReplicas defines desired number of vmstorage replicas
+	ToReplicas int32 `json:"toReplicas"`
+	// StartedAt defines time, when drained nodes were excluded from vminsert routing
+	StartedAt metav1.Time `json:"startedAt"`
+	// FinishAt defines time, when drained nodes will be removed
+	FinishAt metav1.Time `json:"finishAt"`
 }
 
 // VMClusterList contains a list of VMCluster
@@ -184,6 +238,10 @@ type VMSelect struct {
 	// +optional
 	CacheMountPath string `json:"cacheMountPath,omitempty"`
 
+	// Cache configures rollup result cache of VMSelect
+	// +optional
+	Cache *VMSelectCache `json:"cache,omitempty"`
+
 	// Storage - add persistent volume for cacheMountPath
 	// its useful for persistent cache
 	// use storage instead of persistentVolume.
@@ -205,6 +263,10 @@ type VMSelect struct {
 	// ServiceScrapeSpec that will be added to vmselect VMServiceScrape spec
 	// +optional
 	ServiceScrapeSpec *VMServiceScrapeSpec `json:"serviceScrapeSpec,omitempty"`
+	// Ingress enables ingress configuration for vmselect service.
+	// Route is created instead of Ingress at OpenShift platform
+	// +optional
+	Ingress *EmbeddedIngress `json:"ingress,omitempty"`
 	// PodDisruptionBudget created by operator
 	// +optional
 	PodDisruptionBudget *EmbeddedPodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
@@ -218,17 +280,63 @@ type VMSelect struct {
 	// Can be changed for RollingUpdate
 	// +optional
 	RollingUpdateStrategy appsv1.StatefulSetUpdateStrategyType `json:"rollingUpdateStrategy,omitempty"`
+	// UpdateStrategy defines update strategy for StatefulSet
+	// it has priority over RollingUpdateStrategy and allows partition-based canary rollout
+	// +optional
+	UpdateStrategy *StatefulSetUpdateStrategy `json:"updateStrategy,omitempty"`
 	// ClaimTemplates allows adding additional VolumeClaimTemplates for StatefulSet
 	ClaimTemplates []v1.PersistentVolumeClaim `json:"claimTemplates,omitempty"`
+	// WorkloadType defines kubernetes workload for vmselect, StatefulSet is used by default
+	// StatefulSet allows to persist cache with storage and enables vmselect to vmselect communication
+	// Deployment is stateless and scales faster, cache is stored at emptyDir volume
+	// +optional
+	WorkloadType WorkloadType `json:"workloadType,omitempty"`
 
 	CommonDefaultableParams           `json:",inline"`
 	CommonApplicationDeploymentParams `json:",inline"`
 }
 
+// VMSelectCache configures [rollup result cache](https://docs.victoriametrics.com/#rollup-result-cache) of VMSelect
+type VMSelectCache struct {
+	// Disabled disables response caching with `-search.disableCache` flag.
+	// It may be useful during ingestion of historical data
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+	// TimestampOffset defines the maximum duration since the current time for response data,
+	// which is always queried from the original raw data, without using the response cache.
+	// It's passed as `-search.cacheTimestampOffset` flag
+	// +optional
+	TimestampOffset string `json:"timestampOffset,omitempty"`
+	// ResetOnStartup resets rollup result cache on vmselect start with `-search.resetRollupResultCacheOnStartup` flag
+	// +optional
+	ResetOnStartup bool `json:"resetOnStartup,omitempty"`
+	// MemoryAllowedPercent defines percent of system memory allowed for caches with `-memory.allowedPercent` flag.
+	// Rollup result cache size is derived from this value
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MemoryAllowedPercent *int32 `json:"memoryAllowedPercent,omitempty"`
+}
+
+// WorkloadType defines kind of kubernetes workload for component
+// +kubebuilder:validation:Enum=Deployment;StatefulSet
+type WorkloadType string
+
+// Supported workload types
+const (
+	WorkloadTypeDeployment  WorkloadType = "Deployment"
+	WorkloadTypeStatefulSet WorkloadType = "StatefulSet"
+)
+
 func (s VMSelect) GetNameWithPrefix(clusterName string) string {
 	return PrefixedName(clusterName, "vmselect")
 }
 
+// IsDeployment checks if vmselect uses Deployment instead of StatefulSet
+func (s *VMSelect) IsDeployment() bool {
+	return s.WorkloadType == WorkloadTypeDeployment
+}
+
 func (s VMSelect) BuildPodName(baseName string, podIndex int32, namespace, portName, domain string) string {
 	// The default DNS search path is .svc.<cluster domain>
 	if domain == "" {
@@ -297,11 +405,20 @@ type VMInsert struct {
 	*EmbeddedProbes     `json:",inline"`
 	// HPA defines kubernetes PodAutoScaling configuration version 2.
 	HPA *EmbeddedHPA `json:"hpa,omitempty"`
+	// WorkloadType defines kubernetes workload for vminsert, Deployment is used by default
+	// StatefulSet provides stable network identity for vminsert pods
+	// +optional
+	WorkloadType WorkloadType `json:"workloadType,omitempty"`
 
 	CommonDefaultableParams           `json:",inline"`
 	CommonApplicationDeploymentParams `json:",inline"`
 }
 
+// IsStatefulSet checks if vminsert uses StatefulSet instead of Deployment
+func (cr *VMInsert) IsStatefulSet() bool {
+	return cr.WorkloadType == WorkloadTypeStatefulSet
+}
+
 func (cr *VMInsert) Probe() *EmbeddedProbes {
 	return cr.EmbeddedProbes
 }
@@ -326,6 +443,11 @@ func (i VMInsert) GetNameWithPrefix(clusterName string) string {
 	return PrefixedName(clusterName, "vminsert")
 }
 
+// GetHeadlessServiceName returns name of headless service, which provides network identity for pods of vminsert StatefulSet
+func (i VMInsert) GetHeadlessServiceName(clusterName string) string {
+	return i.GetNameWithPrefix(clusterName) + "-headless"
+}
+
 type VMStorage struct {
 	// PodMetadata configures Labels and Annotations which are propagated to the VMStorage pods.
 	PodMetadata *EmbeddedObjectMetadata `json:"podMetadata,omitempty"`
@@ -374,12 +496,37 @@ type VMStorage struct {
 	MaintenanceInsertNodeIDs []int32 `json:"maintenanceInsertNodeIDs,omitempty"`
 	// MaintenanceInsertNodeIDs - excludes given node ids from select requests routing, must contain pod suffixes - for pod-0, id will be 0 and etc.
 	MaintenanceSelectNodeIDs []int32 `json:"maintenanceSelectNodeIDs,omitempty"`
+	// AllowScaleDown allows to decrease replicaCount of vmstorage.
+	// Operator refuses to scale down vmstorage without it, since removed nodes hold a part of stored data.
+	// If set, operator excludes removed nodes from vminsert routing and keeps them
+	// available for vmselect during ScaleDownDrainPeriod before removing pods.
+	// +optional
+	AllowScaleDown bool `json:"allowScaleDown,omitempty"`
+	// ScaleDownDrainPeriod defines how long removed nodes are available for vmselect
+	// after excluding them from vminsert routing.
+	// Data stored at removed nodes is not available for reads after this period.
+	// Supports the same format as retentionPeriod. Defaults to retentionPeriod of the cluster
+	// +optional
+	ScaleDownDrainPeriod string `json:"scaleDownDrainPeriod,omitempty"`
+	// CardinalityLimits limits the number of unique series stored at each vmstorage node
+	// with -storage.maxHourlySeries and -storage.maxDailySeries flags
+	// +optional
+	CardinalityLimits *CardinalityLimits `json:"cardinalityLimits,omitempty"`
+	// DisruptionProtection adds karpenter.sh/do-not-disrupt and cluster-autoscaler.kubernetes.io/safe-to-evict=false annotations to vmstorage pods.
+	// It prevents eviction of pods by node consolidation of karpenter and scale down of cluster-autoscaler,
+	// for instance during background merges of stored data. Annotations defined at podMetadata take precedence
+	// +optional
+	DisruptionProtection bool `json:"disruptionProtection,omitempty"`
 
 	// RollingUpdateStrategy defines strategy for application updates
 	// Default is OnDelete, in this case operator handles update process
 	// Can be changed for RollingUpdate
 	// +optional
 	RollingUpdateStrategy appsv1.StatefulSetUpdateStrategyType `json:"rollingUpdateStrategy,omitempty"`
+	// UpdateStrategy defines update strategy for StatefulSet
+	// it has priority over RollingUpdateStrategy and allows partition-based canary rollout
+	// +optional
+	UpdateStrategy *StatefulSetUpdateStrategy `json:"updateStrategy,omitempty"`
 
 	// ClaimTemplates allows adding additional VolumeClaimTemplates for StatefulSet
 	ClaimTemplates []v1.PersistentVolumeClaim `json:"claimTemplates,omitempty"`
@@ -470,6 +617,9 @@ func (cr *VMBackup) sanityCheck(l *License) error {
 	if !l.IsProvided() && !cr.AcceptEULA {
 		return fmt.Errorf("it is required to provide license key. See [here](https://docs.victoriametrics.com/enterprise)")
 	}
+	if cr.IsRestoreFromLatestBackup() && cr.Destination == "" {
+		return fmt.Errorf("vmBackup.destination cannot be empty with enabled restore.onStart.fromLatestBackup")
+	}
 
 	if l.IsProvided() {
 		return l.sanityCheck()
@@ -478,6 +628,11 @@ func (cr *VMBackup) sanityCheck(l *License) error {
 	return nil
 }
 
+// IsRestoreFromLatestBackup checks if the latest backup must be restored into empty storage on start
+func (cr *VMBackup) IsRestoreFromLatestBackup() bool {
+	return cr != nil && cr.Restore != nil && cr.Restore.OnStart != nil && cr.Restore.OnStart.FromLatestBackup
+}
+
 type VMRestore struct {
 	// OnStart defines configuration for restore on pod start
 	// +optional
@@ -488,6 +643,16 @@ type VMRestoreOnStartConfig struct {
 	// Enabled defines if restore on start enabled
 	// +optional
 	Enabled bool `json:"enabled,omitempty"`
+	// FromLatestBackup restores the latest backup made by vmbackupmanager with vmrestore init container,
+	// if storage data path doesn't contain data yet.
+	// Unlike Enabled, it doesn't require restore mark and allows to bootstrap fresh volume from backup.
+	// Supported only by VMSingle
+	// +optional
+	FromLatestBackup bool `json:"fromLatestBackup,omitempty"`
+	// Image defines vmrestore image for FromLatestBackup restore
+	// Tag defaults to the tag of vmbackupmanager image
+	// +optional
+	Image Image `json:"image,omitempty"`
 }
 
 func (s VMStorage) BuildPodName(baseName string, podIndex int32, namespace string, portName string, domain string) string {
@@ -600,6 +765,76 @@ func (cr VMCluster) AvailableStorageNodeIDs(requestsType string) []int32 {
 	return result
 }
 
+// VMStorageScaleDownDrainPeriod returns duration of vmstorage nodes draining before scale down
+func (cr *VMCluster) VMStorageScaleDownDrainPeriod() (time.Duration, error) {
+	period := cr.Spec.RetentionPeriod
+	if cr.Spec.VMStorage != nil && cr.Spec.VMStorage.ScaleDownDrainPeriod != "" {
+		period = cr.Spec.VMStorage.ScaleDownDrainPeriod
+	}
+	d, err := parseRetentionDuration(period)
+	if err != nil {
+		return 0, fmt.Errorf("cannot parse vmstorage scale down drain period=%q: %w", period, err)
+	}
+	return d, nil
+}
+
+func parseRetentionDuration(period string) (time.Duration, error) {
+	if period == "" {
+		// default retentionPeriod of vmstorage is 1 month
+		period = "1"
+	}
+	var d flagutil.Duration
+	if err := d.Set(period); err != nil {
+		return 0, err
+	}
+	return d.Duration(), nil
+}
+
+// DestructiveChanges returns description of spec changes compared to the last applied spec,
+// which lead to loss of vmstorage data. Such changes must be confirmed with ConfirmDestructiveChangesAnnotation.
+func (cr *VMCluster) DestructiveChanges() ([]string, error) {
+	prev := cr.ParsedLastAppliedSpec
+	if prev == nil || prev.VMStorage == nil {
+		return nil, nil
+	}
+	if cr.Spec.VMStorage == nil {
+		return []string{"vmstorage is removed, its statefulset will be deleted"}, nil
+	}
+	var changes []string
+	prevStorage, newStorage := prev.VMStorage.Storage, cr.Spec.VMStorage.Storage
+	if prevStorage.isPersistent() {
+		if !newStorage.isPersistent() {
+			changes = append(changes, "vmstorage persistent volume is replaced with emptyDir, data stored at existing volumes will not be available")
+		} else {
+			prevSize := prevStorage.VolumeClaimTemplate.Spec.Resources.Requests.Storage()
+			newSize := newStorage.VolumeClaimTemplate.Spec.Resources.Requests.Storage()
+			if newSize.Cmp(*prevSize) < 0 {
+				changes = append(changes, fmt.Sprintf("vmstorage volume size is decreased from %s to %s, statefulset will be re-created and volumes cannot be shrunk", prevSize.String(), newSize.String()))
+			}
+		}
+	}
+
+	// scale down without allowScaleDown is refused by operator
+	prevReplicas, newReplicas := ptr.Deref(prev.VMStorage.ReplicaCount, 1), ptr.Deref(cr.Spec.VMStorage.ReplicaCount, 1)
+	if cr.Spec.VMStorage.AllowScaleDown && newReplicas < prevReplicas {
+		// data is available at other nodes, if less than replicationFactor nodes are removed
+		if prevReplicas-newReplicas >= max(ptr.Deref(cr.Spec.ReplicationFactor, 1), 1) {
+			drainPeriod, err := cr.VMStorageScaleDownDrainPeriod()
+			if err != nil {
+				return nil, err
+			}
+			retention, err := parseRetentionDuration(cr.Spec.RetentionPeriod)
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse retentionPeriod=%q: %w", cr.Spec.RetentionPeriod, err)
+			}
+			if drainPeriod < retention {
+				changes = append(changes, fmt.Sprintf("vmstorage replicas are decreased from %d to %d, data of removed nodes will be deleted after scaleDownDrainPeriod=%s, which is shorter than retention period", prevReplicas, newReplicas, drainPeriod))
+			}
+		}
+	}
+	return changes, nil
+}
+
 func (cr VMCluster) VMStoragePodLabels() map[string]string {
 	selectorLabels := cr.VMStorageSelectorLabels()
 	if cr.Spec.VMStorage == nil || cr.Spec.VMStorage.PodMetadata == nil {
@@ -612,7 +847,7 @@ func (cr VMCluster) FinalLabels(baseLabels map[string]string) map[string]string
 	if cr.ObjectMeta.Labels == nil {
 		return baseLabels
 	}
-	crLabels := filterMapKeysByPrefixes(cr.ObjectMeta.Labels, labelFilterPrefixes)
+	crLabels := filterMapKeysByPrefixes(cr.ObjectMeta.Labels, childLabelFilterPrefixes(cr.Namespace))
 	return labels.Merge(crLabels, baseLabels)
 }
 
@@ -631,14 +866,24 @@ func (cr VMCluster) VMInsertPodAnnotations() map[string]string {
 }
 
 func (cr VMCluster) VMStoragePodAnnotations() map[string]string {
-	if cr.Spec.VMStorage == nil || cr.Spec.VMStorage.PodMetadata == nil {
+	if cr.Spec.VMStorage == nil {
 		return make(map[string]string)
 	}
-	return cr.Spec.VMStorage.PodMetadata.Annotations
+	var annotations map[string]string
+	if cr.Spec.VMStorage.PodMetadata != nil {
+		annotations = cr.Spec.VMStorage.PodMetadata.Annotations
+	}
+	if cr.Spec.VMStorage.DisruptionProtection {
+		return withDisruptionProtection(annotations)
+	}
+	if annotations == nil {
+		return make(map[string]string)
+	}
+	return annotations
 }
 
 func (cr VMCluster) AnnotationsFiltered() map[string]string {
-	return filterMapKeysByPrefixes(cr.ObjectMeta.Annotations, annotationFilterPrefixes)
+	return filterMapKeysByPrefixes(cr.ObjectMeta.Annotations, childAnnotationFilterPrefixes(cr.Namespace))
 }
 
 // LastAppliedSpecAsPatch return last applied cluster spec as patch annotation
@@ -666,7 +911,7 @@ func (cr *VMCluster) HasSpecChanges() (bool, error) {
 }
 
 func (cr *VMCluster) Paused() bool {
-	return cr.Spec.Paused
+	return cr.Spec.Paused || IsReconcilePaused(cr)
 }
 
 // GetMetricPath returns prefixed path for metric requests
@@ -750,6 +995,11 @@ func (cr VMCluster) GetServiceAccountName() string {
 	return cr.Spec.ServiceAccountName
 }
 
+// GetServiceAccountImagePullSecrets returns image pull secrets for managed ServiceAccount
+func (cr VMCluster) GetServiceAccountImagePullSecrets() []v1.LocalObjectReference {
+	return cr.Spec.ServiceAccountImagePullSecrets
+}
+
 func (cr VMCluster) IsOwnsServiceAccount() bool {
 	return cr.Spec.ServiceAccountName == ""
 }
@@ -773,7 +1023,7 @@ func (cr VMCluster) AllLabels() map[string]string {
 	if cr.ObjectMeta.Labels == nil {
 		return selectorLabels
 	}
-	crLabels := filterMapKeysByPrefixes(cr.ObjectMeta.Labels, labelFilterPrefixes)
+	crLabels := filterMapKeysByPrefixes(cr.ObjectMeta.Labels, childLabelFilterPrefixes(cr.Namespace))
 	return labels.Merge(crLabels, selectorLabels)
 }
 
@@ -815,7 +1065,7 @@ func (cr *VMCluster) VMInsertURL() string {
 			}
 		}
 	}
-	return fmt.Sprintf("%s://%s.%s.svc:%s", protoFromFlags(cr.Spec.VMSelect.ExtraArgs), cr.Spec.VMInsert.GetNameWithPrefix(cr.Name), cr.Namespace, port)
+	return fmt.Sprintf("%s://%s.%s.svc:%s", protoFromFlags(cr.Spec.VMInsert.ExtraArgs), cr.Spec.VMInsert.GetNameWithPrefix(cr.Name), cr.Namespace, port)
 }
 
 func (cr *VMCluster) VMStorageURL() string {
@@ -836,6 +1086,24 @@ func (cr *VMCluster) VMStorageURL() string {
 	return fmt.Sprintf("%s://%s.%s.svc:%s", protoFromFlags(cr.Spec.VMSelect.ExtraArgs), cr.Spec.VMStorage.GetNameWithPrefix(cr.Name), cr.Namespace, port)
 }
 
+// VMStoragePodURLs returns urls of vmstorage pods, which are available for select requests
+func (cr *VMCluster) VMStoragePodURLs() []string {
+	if cr.Spec.VMStorage == nil {
+		return nil
+	}
+	port := cr.Spec.VMStorage.Port
+	if port == "" {
+		port = "8482"
+	}
+	baseName := cr.Spec.VMStorage.GetNameWithPrefix(cr.Name)
+	var urls []string
+	for _, i := range cr.AvailableStorageNodeIDs("select") {
+		addr := strings.TrimSuffix(cr.Spec.VMStorage.BuildPodName(baseName, i, cr.Namespace, port, cr.Spec.ClusterDomainName), ",")
+		urls = append(urls, fmt.Sprintf("%s://%s", protoFromFlags(cr.Spec.VMStorage.ExtraArgs), addr))
+	}
+	return urls
+}
+
 // AsCRDOwner implements interface
 func (cr *VMCluster) AsCRDOwner() []metav1.OwnerReference {
 	return GetCRDAsOwner(Cluster)
@@ -887,7 +1155,7 @@ func (cr *VMCluster) SetUpdateStatusTo(ctx context.Context, r client.Client, sta
 	prevStatus := cr.Status.DeepCopy()
 	switch status {
 	case UpdateStatusExpanding:
-	case UpdateStatusFailed:
+	case UpdateStatusFailed, UpdateStatusDegraded, UpdateStatusBlocked:
 		if maybeErr != nil {
 			cr.Status.Reason = maybeErr.Error()
 		}
@@ -900,6 +1168,7 @@ func (cr *VMCluster) SetUpdateStatusTo(ctx context.Context, r client.Client, sta
 	default:
 		panic(fmt.Sprintf("BUG: not expected status=%q", status))
 	}
+	cr.resetVMSelectScaleStatus()
 	if equality.Semantic.DeepEqual(&cr.Status, prevStatus) && currentStatus == status {
 		return nil
 	}
@@ -907,6 +1176,31 @@ func (cr *VMCluster) SetUpdateStatusTo(ctx context.Context, r client.Client, sta
 	return statusPatch(ctx, r, cr.DeepCopy(), cr.Status)
 }
 
+// resetVMSelectScaleStatus clears status fields used by scale subresource of removed vmselect
+// observed replicas of vmselect are set by operator after reconcile of vmselect workload
+func (cr *VMCluster) resetVMSelectScaleStatus() {
+	if cr.Spec.VMSelect != nil {
+		return
+	}
+	cr.Status.VMSelectReplicas = 0
+	cr.Status.VMSelectSelector = ""
+}
+
+// GetStatusChildren returns objects generated by operator for VMCluster
+func (cr *VMCluster) GetStatusChildren() []ChildObject {
+	return cr.Status.Children
+}
+
+// GetStatusWarnings returns problems found at VMCluster spec
+func (cr *VMCluster) GetStatusWarnings() []string {
+	return cr.Status.Warnings
+}
+
+// SetStatusWarnings sets problems found at VMCluster spec
+func (cr *VMCluster) SetStatusWarnings(warnings []string) {
+	cr.Status.Warnings = warnings
+}
+
 // GetAdditionalService returns AdditionalServiceSpec settings
 func (cr *VMSelect) GetAdditionalService() *AdditionalServiceSpec {
 	return cr.ServiceSpec
@@ -2,8 +2,13 @@ package v1beta1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestVMBackup_SnapshotDeletePathWithFlags(t *testing.T) {
@@ -94,3 +99,200 @@ func TestVMBackup_SnapshotCreatePathWithFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestVMCluster_VMStorageScaleDownDrainPeriod(t *testing.T) {
+	f := func(retentionPeriod, drainPeriod string, want time.Duration, wantErr bool) {
+		t.Helper()
+		cr := &VMCluster{
+			Spec: VMClusterSpec{
+				RetentionPeriod: retentionPeriod,
+				VMStorage:       &VMStorage{ScaleDownDrainPeriod: drainPeriod},
+			},
+		}
+		got, err := cr.VMStorageScaleDownDrainPeriod()
+		if (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+		assert.Equal(t, want, got)
+	}
+	f("", "", 31*24*time.Hour, false)
+	f("2", "", 62*24*time.Hour, false)
+	f("1w", "", 7*24*time.Hour, false)
+	f("1y", "12h", 12*time.Hour, false)
+	f("1", "bad", 0, true)
+}
+
+func TestVMCluster_checkStorageScaleDown(t *testing.T) {
+	f := func(oldReplicas, newReplicas int32, allowScaleDown, wantErr bool) {
+		t.Helper()
+		oldCR := &VMCluster{Spec: VMClusterSpec{VMStorage: &VMStorage{
+			CommonApplicationDeploymentParams: CommonApplicationDeploymentParams{ReplicaCount: &oldReplicas},
+		}}}
+		newCR := &VMCluster{Spec: VMClusterSpec{VMStorage: &VMStorage{
+			CommonApplicationDeploymentParams: CommonApplicationDeploymentParams{ReplicaCount: &newReplicas},
+			AllowScaleDown:                    allowScaleDown,
+		}}}
+		if err := newCR.checkStorageScaleDown(oldCR); (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+	}
+	f(2, 3, false, false)
+	f(3, 3, false, false)
+	f(3, 2, false, true)
+	f(3, 2, true, false)
+}
+
+func TestVMCluster_DestructiveChanges(t *testing.T) {
+	pvcStorage := func(size string) *StorageSpec {
+		return &StorageSpec{VolumeClaimTemplate: EmbeddedPersistentVolumeClaim{
+			Spec: v1.PersistentVolumeClaimSpec{
+				Resources: v1.VolumeResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse(size)},
+				},
+			},
+		}}
+	}
+	storage := func(replicas int32, ss *StorageSpec) *VMStorage {
+		return &VMStorage{
+			CommonApplicationDeploymentParams: CommonApplicationDeploymentParams{ReplicaCount: &replicas},
+			Storage:                           ss,
+		}
+	}
+	type opts struct {
+		prev        *VMClusterSpec
+		spec        VMClusterSpec
+		wantChanges int
+	}
+	f := func(o opts) {
+		t.Helper()
+		cr := &VMCluster{Spec: o.spec, ParsedLastAppliedSpec: o.prev}
+		changes, err := cr.DestructiveChanges()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(changes) != o.wantChanges {
+			t.Fatalf("unexpected changes count, got: %d, want: %d, changes: %v", len(changes), o.wantChanges, changes)
+		}
+	}
+
+	// new object
+	f(opts{spec: VMClusterSpec{VMStorage: storage(1, nil)}})
+
+	// vmstorage removed
+	f(opts{
+		prev:        &VMClusterSpec{VMStorage: storage(1, nil)},
+		spec:        VMClusterSpec{},
+		wantChanges: 1,
+	})
+
+	// volume size increased
+	f(opts{
+		prev: &VMClusterSpec{VMStorage: storage(1, pvcStorage("10Gi"))},
+		spec: VMClusterSpec{VMStorage: storage(1, pvcStorage("20Gi"))},
+	})
+
+	// volume size decreased
+	f(opts{
+		prev:        &VMClusterSpec{VMStorage: storage(1, pvcStorage("20Gi"))},
+		spec:        VMClusterSpec{VMStorage: storage(1, pvcStorage("10Gi"))},
+		wantChanges: 1,
+	})
+
+	// persistent volume replaced with emptyDir
+	f(opts{
+		prev:        &VMClusterSpec{VMStorage: storage(1, pvcStorage("20Gi"))},
+		spec:        VMClusterSpec{VMStorage: storage(1, &StorageSpec{EmptyDir: &v1.EmptyDirVolumeSource{}})},
+		wantChanges: 1,
+	})
+
+	// scale down with default drain period
+	scaleDown := storage(2, nil)
+	scaleDown.AllowScaleDown = true
+	f(opts{
+		prev: &VMClusterSpec{RetentionPeriod: "1w", VMStorage: storage(3, nil)},
+		spec: VMClusterSpec{RetentionPeriod: "1w", VMStorage: scaleDown},
+	})
+
+	// scale down with drain period shorter than retention
+	shortDrain := scaleDown.DeepCopy()
+	shortDrain.ScaleDownDrainPeriod = "1d"
+	f(opts{
+		prev:        &VMClusterSpec{RetentionPeriod: "1w", VMStorage: storage(3, nil)},
+		spec:        VMClusterSpec{RetentionPeriod: "1w", VMStorage: shortDrain},
+		wantChanges: 1,
+	})
+
+	// data is replicated to remaining nodes
+	f(opts{
+		prev: &VMClusterSpec{RetentionPeriod: "1w", ReplicationFactor: ptr.To[int32](2), VMStorage: storage(3, nil)},
+		spec: VMClusterSpec{RetentionPeriod: "1w", ReplicationFactor: ptr.To[int32](2), VMStorage: shortDrain},
+	})
+}
+
+func TestIsDestructiveChangeConfirmed(t *testing.T) {
+	cr := &VMCluster{ObjectMeta: metav1.ObjectMeta{Generation: 5}}
+	if IsDestructiveChangeConfirmed(cr) {
+		t.Fatalf("object without annotation must not be confirmed")
+	}
+	cr.Annotations = map[string]string{ConfirmDestructiveChangesAnnotation: "4"}
+	if IsDestructiveChangeConfirmed(cr) {
+		t.Fatalf("confirmation of previous generation must be ignored")
+	}
+	cr.Annotations[ConfirmDestructiveChangesAnnotation] = "5"
+	if !IsDestructiveChangeConfirmed(cr) {
+		t.Fatalf("object must be confirmed")
+	}
+}
+
+func TestVMCluster_VMStoragePodAnnotations(t *testing.T) {
+	f := func(storage *VMStorage, want map[string]string) {
+		t.Helper()
+		cr := VMCluster{Spec: VMClusterSpec{VMStorage: storage}}
+		assert.Equal(t, want, cr.VMStoragePodAnnotations())
+	}
+
+	// no storage
+	f(nil, map[string]string{})
+
+	// without disruption protection
+	f(&VMStorage{PodMetadata: &EmbeddedObjectMetadata{Annotations: map[string]string{"key": "value"}}}, map[string]string{"key": "value"})
+
+	// with disruption protection
+	f(&VMStorage{DisruptionProtection: true}, map[string]string{
+		KarpenterDoNotDisruptAnnotation:        "true",
+		ClusterAutoscalerSafeToEvictAnnotation: "false",
+	})
+
+	// podMetadata annotations take precedence
+	f(&VMStorage{
+		DisruptionProtection: true,
+		PodMetadata: &EmbeddedObjectMetadata{Annotations: map[string]string{
+			"key":                                  "value",
+			ClusterAutoscalerSafeToEvictAnnotation: "true",
+		}},
+	}, map[string]string{
+		"key":                                  "value",
+		KarpenterDoNotDisruptAnnotation:        "true",
+		ClusterAutoscalerSafeToEvictAnnotation: "true",
+	})
+}
+
+func TestVMCluster_resetVMSelectScaleStatus(t *testing.T) {
+	f := func(vmselect *VMSelect, wantReplicas int32, wantSelector string) {
+		t.Helper()
+		cr := VMCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Spec:       VMClusterSpec{VMSelect: vmselect},
+			Status:     VMClusterStatus{VMSelectReplicas: 5, VMSelectSelector: "observed"},
+		}
+		cr.resetVMSelectScaleStatus()
+		assert.Equal(t, wantReplicas, cr.Status.VMSelectReplicas)
+		assert.Equal(t, wantSelector, cr.Status.VMSelectSelector)
+	}
+
+	// no vmselect
+	f(nil, 0, "")
+
+	// observed replicas are kept
+	f(&VMSelect{CommonApplicationDeploymentParams: CommonApplicationDeploymentParams{ReplicaCount: ptr.To[int32](3)}}, 5, "observed")
+}
@@ -18,7 +18,10 @@ package v1beta1
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/VictoriaMetrics/VictoriaMetrics/lib/flagutil"
+	"github.com/VictoriaMetrics/metricsql"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -41,6 +44,12 @@ func (r *VMCluster) SetupWebhookWithManager(mgr ctrl.Manager) error {
 var _ webhook.Validator = &VMCluster{}
 
 func (r *VMCluster) sanityCheck() error {
+	if err := r.Spec.DefaultRules.validate(); err != nil {
+		return err
+	}
+	if err := r.validateRetention(); err != nil {
+		return err
+	}
 	if r.Spec.VMSelect != nil {
 		vms := r.Spec.VMSelect
 		if vms.HPA != nil {
@@ -48,9 +57,30 @@ func (r *VMCluster) sanityCheck() error {
 				return err
 			}
 		}
+		if vms.Cache != nil && vms.Cache.TimestampOffset != "" {
+			if _, err := metricsql.DurationValue(vms.Cache.TimestampOffset, 0); err != nil {
+				return fmt.Errorf("cannot parse vmselect.cache.timestampOffset=%q: %w", vms.Cache.TimestampOffset, err)
+			}
+		}
 		if vms.StorageSpec != nil {
 			vmclusterlog.Info("deprecated property is defined `vmcluster.spec.vmselect.persistentVolume`, use `storage` instead.")
 		}
+		if vms.IsDeployment() {
+			if len(vms.ClaimTemplates) > 0 {
+				return fmt.Errorf("vmselect.claimTemplates cannot be used with vmselect.workloadType=Deployment")
+			}
+			for _, ss := range []*StorageSpec{vms.StorageSpec, vms.Storage} {
+				if ss != nil && ss.EmptyDir == nil {
+					return fmt.Errorf("vmselect persistent volume cannot be used with vmselect.workloadType=Deployment, use emptyDir storage instead")
+				}
+			}
+		}
+		if err := vms.UpdateStrategy.sanityCheck(); err != nil {
+			return fmt.Errorf("vmselect.updateStrategy: %w", err)
+		}
+		if err := sanityCheckOverridePatches(vms.OverridePatches); err != nil {
+			return fmt.Errorf("vmselect: %w", err)
+		}
 	}
 	if r.Spec.VMInsert != nil {
 		vmi := r.Spec.VMInsert
@@ -59,16 +89,121 @@ func (r *VMCluster) sanityCheck() error {
 				return err
 			}
 		}
+		if err := sanityCheckOverridePatches(vmi.OverridePatches); err != nil {
+			return fmt.Errorf("vminsert: %w", err)
+		}
+	}
+	if r.Spec.VMStorage != nil {
+		if _, err := r.VMStorageScaleDownDrainPeriod(); err != nil {
+			return err
+		}
+		if err := r.Spec.VMStorage.UpdateStrategy.sanityCheck(); err != nil {
+			return fmt.Errorf("vmstorage.updateStrategy: %w", err)
+		}
+		if err := sanityCheckOverridePatches(r.Spec.VMStorage.OverridePatches); err != nil {
+			return fmt.Errorf("vmstorage: %w", err)
+		}
 	}
 	if r.Spec.VMStorage != nil && r.Spec.VMStorage.VMBackup != nil {
 		if err := r.Spec.VMStorage.VMBackup.sanityCheck(r.Spec.License); err != nil {
 			return err
 		}
+		if r.Spec.VMStorage.VMBackup.IsRestoreFromLatestBackup() {
+			return fmt.Errorf("spec.vmstorage.vmBackup.restore.onStart.fromLatestBackup is supported only by VMSingle")
+		}
 	}
 
 	return nil
 }
 
+// validateRetention checks retention and downsampling settings.
+// Retention filters and downsampling are available only in enterprise version
+func (r *VMCluster) validateRetention() error {
+	if _, err := parseRetentionDuration(r.Spec.RetentionPeriod); err != nil {
+		return fmt.Errorf("cannot parse retentionPeriod=%q: %w", r.Spec.RetentionPeriod, err)
+	}
+	for _, rf := range r.Spec.RetentionFilters {
+		if err := validateRetentionFilter(rf); err != nil {
+			return fmt.Errorf("incorrect retentionFilters value=%q: %w", rf, err)
+		}
+	}
+	for _, dp := range r.Spec.DownsamplingPeriods {
+		if err := validateDownsamplingPeriod(dp); err != nil {
+			return fmt.Errorf("incorrect downsamplingPeriods value=%q: %w", dp, err)
+		}
+	}
+	if len(r.Spec.RetentionFilters) == 0 && len(r.Spec.DownsamplingPeriods) == 0 {
+		return nil
+	}
+	if !r.isLicenseProvided() {
+		return fmt.Errorf("retentionFilters and downsamplingPeriods require enterprise license key at spec.license. See [here](https://docs.victoriametrics.com/enterprise)")
+	}
+	return r.Spec.License.sanityCheck()
+}
+
+// isLicenseProvided checks if license key is set at spec or with vmstorage extraArgs
+func (r *VMCluster) isLicenseProvided() bool {
+	if r.Spec.License.IsProvided() {
+		return true
+	}
+	if r.Spec.VMStorage == nil {
+		return false
+	}
+	for _, name := range []string{"license", "licenseFile"} {
+		if _, ok := r.Spec.VMStorage.ExtraArgs[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// validateRetentionFilter checks value in the form of `filter:period`
+func validateRetentionFilter(value string) error {
+	n := strings.LastIndexByte(value, ':')
+	if n < 0 {
+		return fmt.Errorf("missing `:` delimiter between filter and retention period")
+	}
+	if err := validateSeriesFilter(value[:n]); err != nil {
+		return err
+	}
+	var d flagutil.Duration
+	if err := d.Set(value[n+1:]); err != nil {
+		return fmt.Errorf("cannot parse retention period: %w", err)
+	}
+	return nil
+}
+
+// validateDownsamplingPeriod checks value in the form of `offset:interval` or `filter:offset:interval`
+func validateDownsamplingPeriod(value string) error {
+	n := strings.LastIndexByte(value, ':')
+	if n < 0 {
+		return fmt.Errorf("missing `:` delimiter between offset and interval")
+	}
+	interval := value[n+1:]
+	value = value[:n]
+	offset := value
+	if n = strings.LastIndexByte(value, ':'); n >= 0 {
+		if err := validateSeriesFilter(value[:n]); err != nil {
+			return err
+		}
+		offset = value[n+1:]
+	}
+	if _, err := metricsql.DurationValue(offset, 0); err != nil {
+		return fmt.Errorf("cannot parse offset: %w", err)
+	}
+	if _, err := metricsql.DurationValue(interval, 0); err != nil {
+		return fmt.Errorf("cannot parse interval: %w", err)
+	}
+	return nil
+}
+
+func validateSeriesFilter(filter string) error {
+	if !strings.HasPrefix(filter, "{") || !strings.HasSuffix(filter, "}") {
+		return fmt.Errorf("series filter=%q must be enclosed in curly braces", filter)
+	}
+	return nil
+}
+
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (r *VMCluster) ValidateCreate() (admission.Warnings, error) {
 	if r.Spec.ParsingError != "" {
@@ -94,9 +229,26 @@ func (r *VMCluster) ValidateUpdate(old runtime.Object) (admission.Warnings, erro
 	if err := r.sanityCheck(); err != nil {
 		return nil, err
 	}
+	if oldCR, ok := old.(*VMCluster); ok {
+		if err := r.checkStorageScaleDown(oldCR); err != nil {
+			return nil, err
+		}
+	}
 	return nil, nil
 }
 
+// checkStorageScaleDown refuses vmstorage replicas decrease without allowScaleDown
+func (r *VMCluster) checkStorageScaleDown(oldCR *VMCluster) error {
+	if r.Spec.VMStorage == nil || oldCR.Spec.VMStorage == nil || r.Spec.VMStorage.AllowScaleDown {
+		return nil
+	}
+	newReplicas, oldReplicas := r.Spec.VMStorage.ReplicaCount, oldCR.Spec.VMStorage.ReplicaCount
+	if newReplicas != nil && oldReplicas != nil && *newReplicas < *oldReplicas {
+		return fmt.Errorf("vmstorage replicaCount cannot be decreased from %d to %d without spec.vmstorage.allowScaleDown, it leads to data loss", *oldReplicas, *newReplicas)
+	}
+	return nil
+}
+
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *VMCluster) ValidateDelete() (admission.Warnings, error) {
 	return nil, nil
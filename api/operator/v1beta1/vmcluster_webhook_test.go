@@ -18,6 +18,9 @@ package v1beta1
 
 import (
 	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 )
 
 var _ = Describe("VMCluster Webhook", func() {
@@ -36,6 +39,61 @@ var _ = Describe("VMCluster Webhook", func() {
 		})
 	})
 
+	Context("When validating VMCluster retention settings", func() {
+		DescribeTable("fails validation",
+			func(spec VMClusterSpec, wantErr string) {
+				cr := VMCluster{Spec: spec}
+				Expect(cr.sanityCheck()).To(MatchError(wantErr))
+			},
+			Entry("bad retention period", VMClusterSpec{
+				RetentionPeriod: "1x",
+			}, `cannot parse retentionPeriod="1x": cannot parse duration "1x"`),
+			Entry("retention filter without license", VMClusterSpec{
+				RetentionFilters: []string{`{team="dev"}:7d`},
+			}, "retentionFilters and downsamplingPeriods require enterprise license key at spec.license. See [here](https://docs.victoriametrics.com/enterprise)"),
+			Entry("retention filter without braces", VMClusterSpec{
+				License:          &License{Key: ptr.To("key")},
+				RetentionFilters: []string{`team="dev":7d`},
+			}, `incorrect retentionFilters value="team=\"dev\":7d": series filter="team=\"dev\"" must be enclosed in curly braces`),
+			Entry("bad downsampling interval", VMClusterSpec{
+				License:             &License{Key: ptr.To("key")},
+				DownsamplingPeriods: []string{"30d"},
+			}, `incorrect downsamplingPeriods value="30d": missing `+"`:`"+` delimiter between offset and interval`),
+			Entry("bad cache timestamp offset", VMClusterSpec{
+				VMSelect: &VMSelect{Cache: &VMSelectCache{TimestampOffset: "5x"}},
+			}, `cannot parse vmselect.cache.timestampOffset="5x": cannot parse duration "5x"`),
+			Entry("vmselect deployment with claim templates", VMClusterSpec{
+				VMSelect: &VMSelect{WorkloadType: WorkloadTypeDeployment, ClaimTemplates: []v1.PersistentVolumeClaim{{}}},
+			}, "vmselect.claimTemplates cannot be used with vmselect.workloadType=Deployment"),
+			Entry("vmselect deployment with persistent storage", VMClusterSpec{
+				VMSelect: &VMSelect{WorkloadType: WorkloadTypeDeployment, StorageSpec: &StorageSpec{}},
+			}, "vmselect persistent volume cannot be used with vmselect.workloadType=Deployment, use emptyDir storage instead"),
+		)
+		DescribeTable("passes validation",
+			func(spec VMClusterSpec) {
+				cr := VMCluster{Spec: spec}
+				Expect(cr.sanityCheck()).To(Succeed())
+			},
+			Entry("enterprise settings with license", VMClusterSpec{
+				RetentionPeriod:     "1y",
+				License:             &License{Key: ptr.To("key")},
+				RetentionFilters:    []string{`{team="dev"}:7d`},
+				DownsamplingPeriods: []string{"30d:5m", `{env=~"dev|stage"}:7d:1h`},
+			}),
+			Entry("vmselect deployment with emptyDir cache", VMClusterSpec{
+				VMSelect: &VMSelect{WorkloadType: WorkloadTypeDeployment, StorageSpec: &StorageSpec{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+			}),
+			Entry("license at vmstorage extraArgs", VMClusterSpec{
+				RetentionFilters: []string{`{team="dev"}:7d`},
+				VMStorage: &VMStorage{
+					CommonApplicationDeploymentParams: CommonApplicationDeploymentParams{
+						ExtraArgs: map[string]string{"licenseFile": "/etc/license"},
+					},
+				},
+			}),
+		)
+	})
+
 	Context("When creating VMCluster under Conversion Webhook", func() {
 		It("Should get the converted version of VMCluster", func() {
 
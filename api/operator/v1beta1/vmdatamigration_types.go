@@ -0,0 +1,325 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// DataMigrationPending means, that migration waits for the next chunk job
+	DataMigrationPending = "Pending"
+	// DataMigrationRunning means, that chunk job is in progress
+	DataMigrationRunning = "Running"
+	// DataMigrationSucceeded means, that all chunks were migrated successfully
+	DataMigrationSucceeded = "Succeeded"
+	// DataMigrationFailed means, that chunk job failed
+	DataMigrationFailed = "Failed"
+)
+
+// VMDataMigrationSpec defines data migration into VMSingle or VMCluster with vmctl
+// exactly one of source prometheus, thanos or influx must be set
+type VMDataMigrationSpec struct {
+	// Source defines origin of migrated data
+	Source DataMigrationSource `json:"source"`
+	// Destination defines VMSingle or VMCluster from the same namespace
+	Destination DataMigrationDestination `json:"destination"`
+	// TimeRange defines time range of migrated data
+	TimeRange DataMigrationTimeRange `json:"timeRange"`
+	// BackoffLimit defines the number of retries of chunk job before marking migration as failed
+	// Defaults to 3
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// ExtraArgs that will be passed to vmctl
+	// for example concurrency: 4
+	// +optional
+	ExtraArgs map[string]string `json:"extraArgs,omitempty"`
+	// ExtraEnvs that will be added to vmctl container
+	// +optional
+	ExtraEnvs []v1.EnvVar `json:"extraEnvs,omitempty"`
+	// Image - docker image settings for vmctl
+	// if no specified operator uses default config version
+	// +optional
+	Image Image `json:"image,omitempty"`
+	// Resources container resource request and limits for chunk Job,
+	// if not defined default resources from operator config will be used
+	// +optional
+	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+	// ParsingError contents error with context if operator was failed to parse json object from kubernetes api server
+	ParsingError string `json:"-" yaml:"-"`
+}
+
+// DataMigrationSource defines origin of migrated data
+type DataMigrationSource struct {
+	// Prometheus migrates data from Prometheus TSDB snapshot
+	// See https://docs.victoriametrics.com/vmctl/#migrating-data-from-prometheus
+	// +optional
+	Prometheus *PrometheusMigrationSource `json:"prometheus,omitempty"`
+	// Thanos migrates data from Thanos with remote read protocol
+	// See https://docs.victoriametrics.com/vmctl/#migrating-data-by-remote-read-protocol
+	// +optional
+	Thanos *ThanosMigrationSource `json:"thanos,omitempty"`
+	// Influx migrates data from InfluxDB v1
+	// See https://docs.victoriametrics.com/vmctl/#migrating-data-from-influxdb-1x
+	// +optional
+	Influx *InfluxMigrationSource `json:"influx,omitempty"`
+}
+
+// PrometheusMigrationSource defines Prometheus TSDB snapshot stored at PersistentVolumeClaim
+type PrometheusMigrationSource struct {
+	// ClaimName of PersistentVolumeClaim with Prometheus data
+	// It's mounted into vmctl pod in read-only mode
+	ClaimName string `json:"claimName"`
+	// SnapshotPath defines path to snapshot relative to the volume root
+	// for example snapshots/20240101T000000Z-5c4fa8b3e3b6c4f1
+	SnapshotPath string `json:"snapshotPath"`
+}
+
+// ThanosMigrationSource defines Thanos remote read endpoint
+type ThanosMigrationSource struct {
+	// URL of remote read API, for example http://thanos-query:10902/api/v1/read
+	URL string `json:"url"`
+	// StepInterval splits time range of chunk into smaller requests to remote read API
+	// Defaults to day
+	// +kubebuilder:validation:Enum=minute;hour;day;week;month
+	// +optional
+	StepInterval string `json:"stepInterval,omitempty"`
+	// FilterLabel defines label name for series filtering
+	// Defaults to __name__
+	// +optional
+	FilterLabel string `json:"filterLabel,omitempty"`
+	// FilterLabelValue defines regular expression for FilterLabel value
+	// All series are migrated if empty
+	// +optional
+	FilterLabelValue string `json:"filterLabelValue,omitempty"`
+	// BasicAuth allow an endpoint to authenticate over basic authentication
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
+}
+
+// InfluxMigrationSource defines InfluxDB v1 database
+type InfluxMigrationSource struct {
+	// URL of InfluxDB, for example http://influxdb:8086
+	URL string `json:"url"`
+	// Database to migrate
+	Database string `json:"database"`
+	// BasicAuth allow an endpoint to authenticate over basic authentication
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
+}
+
+// DataMigrationDestination references object, which receives migrated data
+type DataMigrationDestination struct {
+	// Kind of destination object
+	// +kubebuilder:validation:Enum=VMSingle;VMCluster
+	Kind string `json:"kind"`
+	// Name of destination object
+	Name string `json:"name"`
+	// Tenant defines VMCluster tenant in the format accountID[:projectID]
+	// 0 is used by default
+	// +optional
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// DataMigrationTimeRange defines time range of migrated data
+type DataMigrationTimeRange struct {
+	// Start of time range
+	Start metav1.Time `json:"start"`
+	// End of time range
+	// Defaults to VMDataMigration creation time
+	// +optional
+	End *metav1.Time `json:"end,omitempty"`
+	// ChunkInterval splits time range into chunks, which are migrated sequentially by separate jobs.
+	// Migration is resumed from the first not migrated chunk after failure.
+	// Time range is migrated by a single job if omitted
+	// +optional
+	ChunkInterval *metav1.Duration `json:"chunkInterval,omitempty"`
+}
+
+// VMDataMigrationStatus defines the observed state of VMDataMigration
+type VMDataMigrationStatus struct {
+	// Phase of migration
+	Phase string `json:"phase,omitempty"`
+	// Message contains details of migration progress
+	Message string `json:"message,omitempty"`
+	// TotalChunks is the number of time range chunks
+	TotalChunks int32 `json:"totalChunks,omitempty"`
+	// CompletedChunks is the number of successfully migrated chunks
+	CompletedChunks int32 `json:"completedChunks,omitempty"`
+	// Progress of migration in completed/total chunks format
+	Progress string `json:"progress,omitempty"`
+	// StartTime of migration
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime of migration
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// MigrationHash is a hash of migration settings, progress is reset on its change
+	MigrationHash string `json:"migrationHash,omitempty"`
+	// LastSyncError contains error message for unsuccessful migration
+	LastSyncError string `json:"lastSyncError,omitempty"`
+}
+
+// VMDataMigration migrates historical data into VMSingle or VMCluster with vmctl Jobs
+// +operator-sdk:gen-csv:customresourcedefinitions.displayName="VMDataMigration"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=vmdatamigrations,scope=Namespaced
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Destination Kind",type="string",JSONPath=".spec.destination.kind"
+// +kubebuilder:printcolumn:name="Destination",type="string",JSONPath=".spec.destination.name"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Progress",type="string",JSONPath=".status.progress"
+// +kubebuilder:printcolumn:name="Sync Error",type="string",JSONPath=".status.lastSyncError"
+// +genclient
+// +k8s:openapi-gen=true
+type VMDataMigration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VMDataMigrationSpec   `json:"spec,omitempty"`
+	Status VMDataMigrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VMDataMigrationList contains a list of VMDataMigration
+type VMDataMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VMDataMigration `json:"items"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (cr *VMDataMigration) UnmarshalJSON(src []byte) error {
+	type dmcr VMDataMigration
+	if err := json.Unmarshal(src, (*dmcr)(cr)); err != nil {
+		cr.Spec.ParsingError = fmt.Sprintf("cannot parse vmdatamigration: %s, err: %s", string(src), err)
+		return nil
+	}
+	return nil
+}
+
+// AsKey returns unique key for object
+func (cr *VMDataMigration) AsKey() string {
+	return fmt.Sprintf("%s/%s", cr.Namespace, cr.Name)
+}
+
+// PrefixedName returns name prefix of chunk Jobs
+func (cr *VMDataMigration) PrefixedName() string {
+	return fmt.Sprintf("vmdatamigration-%s", cr.Name)
+}
+
+// SourceMode returns vmctl mode for migration source
+func (cr *VMDataMigration) SourceMode() string {
+	switch {
+	case cr.Spec.Source.Prometheus != nil:
+		return "prometheus"
+	case cr.Spec.Source.Thanos != nil:
+		return "remote-read"
+	case cr.Spec.Source.Influx != nil:
+		return "influx"
+	default:
+		return ""
+	}
+}
+
+// Chunks splits migration time range into chunks
+// now is used as the end of time range, if it's not set and object has no creation timestamp
+func (cr *VMDataMigration) Chunks(now time.Time) [][2]time.Time {
+	tr := cr.Spec.TimeRange
+	start := tr.Start.Time
+	end := cr.CreationTimestamp.Time
+	if end.IsZero() {
+		end = now
+	}
+	if tr.End != nil {
+		end = tr.End.Time
+	}
+	if !start.Before(end) {
+		return nil
+	}
+	if tr.ChunkInterval == nil || tr.ChunkInterval.Duration <= 0 {
+		return [][2]time.Time{{start, end}}
+	}
+	var chunks [][2]time.Time
+	for chunkStart := start; chunkStart.Before(end); chunkStart = chunkStart.Add(tr.ChunkInterval.Duration) {
+		chunkEnd := chunkStart.Add(tr.ChunkInterval.Duration)
+		if chunkEnd.After(end) {
+			chunkEnd = end
+		}
+		chunks = append(chunks, [2]time.Time{chunkStart, chunkEnd})
+	}
+	return chunks
+}
+
+// AsOwner returns owner references with current object as owner
+func (cr *VMDataMigration) AsOwner() []metav1.OwnerReference {
+	return []metav1.OwnerReference{
+		{
+			APIVersion:         cr.APIVersion,
+			Kind:               cr.Kind,
+			Name:               cr.Name,
+			UID:                cr.UID,
+			Controller:         ptr.To(true),
+			BlockOwnerDeletion: ptr.To(true),
+		},
+	}
+}
+
+// AnnotationsFiltered returns global annotations to be applied by objects generated for vmdatamigration
+func (cr *VMDataMigration) AnnotationsFiltered() map[string]string {
+	annotations := make(map[string]string)
+	for annotation, value := range cr.Annotations {
+		if !strings.HasPrefix(annotation, "kubectl.kubernetes.io/") {
+			annotations[annotation] = value
+		}
+	}
+	return annotations
+}
+
+// SelectorLabels returns selector labels for vmdatamigration objects
+func (cr *VMDataMigration) SelectorLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":      "vmdatamigration",
+		"app.kubernetes.io/instance":  cr.Name,
+		"app.kubernetes.io/component": "monitoring",
+		"managed-by":                  "vm-operator",
+	}
+}
+
+// AllLabels returns combined labels for VMDataMigration
+func (cr *VMDataMigration) AllLabels() map[string]string {
+	labels := cr.SelectorLabels()
+	for label, value := range cr.Labels {
+		if _, ok := labels[label]; ok {
+			// forbid changes for selector labels
+			continue
+		}
+		labels[label] = value
+	}
+	return labels
+}
+
+func init() {
+	SchemeBuilder.Register(&VMDataMigration{}, &VMDataMigrationList{})
+}
@@ -0,0 +1,146 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// maxDataMigrationChunks limits the number of chunk jobs per migration
+const maxDataMigrationChunks = 10000
+
+// SetupWebhookWithManager will setup the manager to manage the webhooks
+func (r *VMDataMigration) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-operator-victoriametrics-com-v1beta1-vmdatamigration,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.victoriametrics.com,resources=vmdatamigrations,verbs=create;update,versions=v1beta1,name=vvmdatamigration.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &VMDataMigration{}
+
+// Validate performs symantic validation of object
+func (r *VMDataMigration) Validate() error {
+	if mustSkipValidation(r) {
+		return nil
+	}
+	src := r.Spec.Source
+	var sources int
+	for _, isSet := range []bool{src.Prometheus != nil, src.Thanos != nil, src.Influx != nil} {
+		if isSet {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return fmt.Errorf("exactly one of source.prometheus, source.thanos or source.influx must be set")
+	}
+	switch {
+	case src.Prometheus != nil:
+		if src.Prometheus.ClaimName == "" {
+			return fmt.Errorf("source.prometheus.claimName cannot be empty")
+		}
+		if src.Prometheus.SnapshotPath == "" {
+			return fmt.Errorf("source.prometheus.snapshotPath cannot be empty")
+		}
+	case src.Thanos != nil:
+		if src.Thanos.URL == "" {
+			return fmt.Errorf("source.thanos.url cannot be empty")
+		}
+		if src.Thanos.FilterLabelValue != "" {
+			if _, err := regexp.Compile(src.Thanos.FilterLabelValue); err != nil {
+				return fmt.Errorf("cannot parse source.thanos.filterLabelValue=%q: %w", src.Thanos.FilterLabelValue, err)
+			}
+		}
+	case src.Influx != nil:
+		if src.Influx.URL == "" {
+			return fmt.Errorf("source.influx.url cannot be empty")
+		}
+		if src.Influx.Database == "" {
+			return fmt.Errorf("source.influx.database cannot be empty")
+		}
+	}
+
+	dst := r.Spec.Destination
+	switch dst.Kind {
+	case "VMSingle", "VMCluster":
+	default:
+		return fmt.Errorf("unsupported destination kind=%q, only VMSingle and VMCluster are supported", dst.Kind)
+	}
+	if dst.Name == "" {
+		return fmt.Errorf("destination name cannot be empty")
+	}
+	if dst.Tenant != "" {
+		if dst.Kind != "VMCluster" {
+			return fmt.Errorf("destination.tenant is supported only for VMCluster destination")
+		}
+		if !tenantRe.MatchString(dst.Tenant) {
+			return fmt.Errorf("incorrect destination.tenant=%q, it must have accountID[:projectID] format", dst.Tenant)
+		}
+	}
+
+	tr := r.Spec.TimeRange
+	if tr.Start.IsZero() {
+		return fmt.Errorf("timeRange.start cannot be empty")
+	}
+	if tr.End != nil && !tr.Start.Before(tr.End) {
+		return fmt.Errorf("timeRange.start=%q must be before timeRange.end=%q", tr.Start.Format(time.RFC3339), tr.End.Format(time.RFC3339))
+	}
+	if ci := tr.ChunkInterval; ci != nil {
+		if ci.Duration < time.Minute {
+			return fmt.Errorf("timeRange.chunkInterval=%q must be at least 1m", ci.Duration)
+		}
+		if chunks := len(r.Chunks(time.Now())); chunks > maxDataMigrationChunks {
+			return fmt.Errorf("timeRange is split into %d chunks, it cannot exceed %d chunks, increase timeRange.chunkInterval", chunks, maxDataMigrationChunks)
+		}
+	}
+	return nil
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMDataMigration) ValidateCreate() (admission.Warnings, error) {
+	if r.Spec.ParsingError != "" {
+		return nil, fmt.Errorf(r.Spec.ParsingError)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMDataMigration) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	if r.Spec.ParsingError != "" {
+		return nil, fmt.Errorf(r.Spec.ParsingError)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *VMDataMigration) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
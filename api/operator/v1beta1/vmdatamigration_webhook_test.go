@@ -0,0 +1,76 @@
+package v1beta1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("VMDataMigration Webhook", func() {
+	start := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	end := metav1.NewTime(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	promSource := DataMigrationSource{Prometheus: &PrometheusMigrationSource{ClaimName: "prometheus-data", SnapshotPath: "snapshots/20240201"}}
+	Context("When creating VMDataMigration under Validating Webhook", func() {
+		DescribeTable("fails validation",
+			func(spec VMDataMigrationSpec, wantErr string) {
+				dm := VMDataMigration{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: spec}
+				Expect(dm.Validate()).To(MatchError(wantErr))
+			},
+			Entry("missing source", VMDataMigrationSpec{
+				Destination: DataMigrationDestination{Kind: "VMSingle", Name: "main"},
+				TimeRange:   DataMigrationTimeRange{Start: start},
+			}, `exactly one of source.prometheus, source.thanos or source.influx must be set`),
+			Entry("multiple sources", VMDataMigrationSpec{
+				Source: DataMigrationSource{
+					Prometheus: promSource.Prometheus,
+					Thanos:     &ThanosMigrationSource{URL: "http://thanos:10902/api/v1/read"},
+				},
+				Destination: DataMigrationDestination{Kind: "VMSingle", Name: "main"},
+				TimeRange:   DataMigrationTimeRange{Start: start},
+			}, `exactly one of source.prometheus, source.thanos or source.influx must be set`),
+			Entry("missing influx database", VMDataMigrationSpec{
+				Source:      DataMigrationSource{Influx: &InfluxMigrationSource{URL: "http://influx:8086"}},
+				Destination: DataMigrationDestination{Kind: "VMSingle", Name: "main"},
+				TimeRange:   DataMigrationTimeRange{Start: start},
+			}, `source.influx.database cannot be empty`),
+			Entry("unsupported destination", VMDataMigrationSpec{
+				Source:      promSource,
+				Destination: DataMigrationDestination{Kind: "VMAgent", Name: "main"},
+				TimeRange:   DataMigrationTimeRange{Start: start},
+			}, `unsupported destination kind="VMAgent", only VMSingle and VMCluster are supported`),
+			Entry("tenant for vmsingle", VMDataMigrationSpec{
+				Source:      promSource,
+				Destination: DataMigrationDestination{Kind: "VMSingle", Name: "main", Tenant: "1"},
+				TimeRange:   DataMigrationTimeRange{Start: start},
+			}, `destination.tenant is supported only for VMCluster destination`),
+			Entry("inverted time range", VMDataMigrationSpec{
+				Source:      promSource,
+				Destination: DataMigrationDestination{Kind: "VMSingle", Name: "main"},
+				TimeRange:   DataMigrationTimeRange{Start: end, End: &start},
+			}, `timeRange.start="2024-02-01T00:00:00Z" must be before timeRange.end="2024-01-01T00:00:00Z"`),
+			Entry("too many chunks", VMDataMigrationSpec{
+				Source:      promSource,
+				Destination: DataMigrationDestination{Kind: "VMSingle", Name: "main"},
+				TimeRange:   DataMigrationTimeRange{Start: start, End: &end, ChunkInterval: &metav1.Duration{Duration: time.Minute}},
+			}, `timeRange is split into 44640 chunks, it cannot exceed 10000 chunks, increase timeRange.chunkInterval`),
+		)
+		DescribeTable("passes validation",
+			func(spec VMDataMigrationSpec) {
+				dm := VMDataMigration{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: spec}
+				Expect(dm.Validate()).To(Succeed())
+			},
+			Entry("prometheus snapshot", VMDataMigrationSpec{
+				Source:      promSource,
+				Destination: DataMigrationDestination{Kind: "VMSingle", Name: "main"},
+				TimeRange:   DataMigrationTimeRange{Start: start, End: &end, ChunkInterval: &metav1.Duration{Duration: 24 * time.Hour}},
+			}),
+			Entry("thanos remote read", VMDataMigrationSpec{
+				Source:      DataMigrationSource{Thanos: &ThanosMigrationSource{URL: "http://thanos:10902/api/v1/read", FilterLabel: "job", FilterLabelValue: "node.*"}},
+				Destination: DataMigrationDestination{Kind: "VMCluster", Name: "main", Tenant: "1:2"},
+				TimeRange:   DataMigrationTimeRange{Start: start},
+			}),
+		)
+	})
+})
@@ -6,18 +6,25 @@ import (
 	"fmt"
 	"path"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	"gopkg.in/yaml.v2"
 
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/api/autoscaling/v2beta2"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	k8syaml "sigs.k8s.io/yaml"
 )
 
 // UpdateStatus defines status for application
@@ -28,12 +35,15 @@ const (
 	UpdateStatusOperational UpdateStatus = "operational"
 	UpdateStatusFailed      UpdateStatus = "failed"
 	UpdateStatusPaused      UpdateStatus = "paused"
+	UpdateStatusDegraded    UpdateStatus = "degraded"
+	UpdateStatusBlocked     UpdateStatus = "blocked"
 )
 
 const (
 	vmPathPrefixFlagName = "http.pathPrefix"
 	healthPath           = "/health"
 	metricPath           = "/metrics"
+	targetsPath          = "/api/v1/targets"
 	reloadPath           = "/-/reload"
 	reloadAuthKey        = "reloadAuthKey"
 	snapshotCreate       = "/snapshot/create"
@@ -48,8 +58,48 @@ const (
 	SkipValidationValue      = "true"
 	AdditionalServiceLabel   = "operator.victoriametrics.com/additional-service"
 	// PVCExpandableLabel controls checks for storageClass
-	PVCExpandableLabel            = "operator.victoriametrics.com/pvc-allow-volume-expansion"
+	PVCExpandableLabel = "operator.victoriametrics.com/pvc-allow-volume-expansion"
+	// ReconcileRequestedAtAnnotation is set by reconcile trigger endpoint, its update forces object reconcile
+	ReconcileRequestedAtAnnotation = "operator.victoriametrics.com/reconcile-requested-at"
+	// ReconcileRequestedByAnnotation contains name of principal, who requested reconcile
+	ReconcileRequestedByAnnotation = "operator.victoriametrics.com/reconcile-requested-by"
+	// ConfirmDestructiveChangesAnnotation allows operator to apply spec changes, which lead to data loss.
+	// Its value must be equal to metadata.generation of object with such changes.
+	ConfirmDestructiveChangesAnnotation = "operator.victoriametrics.com/confirm-destructive-changes"
+	// ReconcileAnnotation with ReconcilePaused value suspends reconcile of object the same way as spec.paused does.
+	// It allows to hold manually patched workloads during incident response without editing object spec.
+	ReconcileAnnotation = "operator.victoriametrics.com/reconcile"
+	// ReconcilePaused is a value of ReconcileAnnotation, which pauses reconcile
+	ReconcilePaused = "paused"
+	// ManagedImagePullSecretsAnnotation contains comma-separated names of imagePullSecrets,
+	// which were attached to ServiceAccount by operator.
+	// It allows to detach outdated secrets without touching secrets added by other controllers
+	ManagedImagePullSecretsAnnotation = "operator.victoriametrics.com/managed-image-pull-secrets"
+	// ParentGenerationAnnotation contains metadata.generation of parent object, which produced Deployment or StatefulSet spec
+	ParentGenerationAnnotation = "operator.victoriametrics.com/parent-generation"
+	// RevisionAnnotation contains revision number of Deployment or StatefulSet spec applied by operator
+	RevisionAnnotation = "operator.victoriametrics.com/revision"
+	// RollbackRevisionAnnotation is set after rollback to the revision from history.
+	// Operator keeps rolled back spec until the next change of parent object
+	RollbackRevisionAnnotation = "operator.victoriametrics.com/rollback-revision"
+	// RolloutApprovedAnnotation must be set at StatefulSet in order to advance partition-based rollout,
+	// if updateStrategy.requireApproval is enabled. Operator removes it after the next partition step is started
+	RolloutApprovedAnnotation = "operator.victoriametrics.com/rollout-approved"
+	// RevisionKindLabel marks ControllerRevision objects with history of Deployment or StatefulSet managed by operator
+	// and Secrets with history of generated configuration
+	RevisionKindLabel = "operator.victoriametrics.com/revision-kind"
+	// RevisionOfAnnotation contains name of Deployment or StatefulSet, which ControllerRevision belongs to.
+	// It keeps history of StatefulSet after its recreation
+	RevisionOfAnnotation = "operator.victoriametrics.com/revision-of"
+	// ConfigRollbackToAnnotation contains revision number of generated configuration,
+	// which must be applied instead of the current one.
+	// Operator applies configuration from revision history until annotation is removed
+	ConfigRollbackToAnnotation    = "operator.victoriametrics.com/config-rollback-to"
 	lastAppliedSpecAnnotationName = "operator.victoriametrics/last-applied-spec"
+	// KarpenterDoNotDisruptAnnotation prevents voluntary disruption of pod by karpenter
+	KarpenterDoNotDisruptAnnotation = "karpenter.sh/do-not-disrupt"
+	// ClusterAutoscalerSafeToEvictAnnotation with false value prevents removal of pod node by cluster-autoscaler
+	ClusterAutoscalerSafeToEvictAnnotation = "cluster-autoscaler.kubernetes.io/safe-to-evict"
 )
 
 const (
@@ -63,17 +113,78 @@ const (
 // SchemeGroupVersion is group version used to register these objects
 var SchemeGroupVersion = schema.GroupVersion{Group: "operator.victoriametrics.com", Version: "v1beta1"}
 
-var (
-	labelFilterPrefixes []string
-	// default ignored annotations
-	annotationFilterPrefixes = []string{"kubectl.kubernetes.io/", "operator.victoriametrics.com/", "operator.victoriametrics/last-applied-spec"}
-)
+// default ignored annotations
+var defaultAnnotationFilterPrefixes = []string{"kubectl.kubernetes.io/", "operator.victoriametrics.com/", "operator.victoriametrics/last-applied-spec"}
+
+type filterPrefixes struct {
+	labels      []string
+	annotations []string
+}
+
+// globalFilterPrefixes holds global filtering for child labels and annotations
+var globalFilterPrefixes atomic.Pointer[filterPrefixes]
+
+func init() {
+	globalFilterPrefixes.Store(&filterPrefixes{annotations: defaultAnnotationFilterPrefixes})
+}
 
 // SetLabelAndAnnotationPrefixes configures global filtering for child labels and annotations
-// cannot be used concurrently and should be called only once at lib init
+// it's safe to call it concurrently, e.g. at operator configuration reload
 func SetLabelAndAnnotationPrefixes(labelPrefixes, annotationPrefixes []string) {
-	labelFilterPrefixes = labelPrefixes
-	annotationFilterPrefixes = append(annotationFilterPrefixes, annotationPrefixes...)
+	annotations := make([]string, 0, len(defaultAnnotationFilterPrefixes)+len(annotationPrefixes))
+	annotations = append(annotations, defaultAnnotationFilterPrefixes...)
+	annotations = append(annotations, annotationPrefixes...)
+	globalFilterPrefixes.Store(&filterPrefixes{labels: labelPrefixes, annotations: annotations})
+}
+
+// namespacePropagationPolicies holds PropagationPolicy of VMOperatorSettings per namespace
+var namespacePropagationPolicies sync.Map
+
+// SetNamespacePropagationPolicy configures filtering for child labels and annotations of objects at the given namespace
+// in addition to global filtering. Nil policy removes namespace filtering
+func SetNamespacePropagationPolicy(namespace string, policy *PropagationPolicy) {
+	if policy == nil {
+		namespacePropagationPolicies.Delete(namespace)
+		return
+	}
+	namespacePropagationPolicies.Store(namespace, policy)
+}
+
+func childLabelFilterPrefixes(namespace string) []string {
+	labelFilterPrefixes := globalFilterPrefixes.Load().labels
+	v, ok := namespacePropagationPolicies.Load(namespace)
+	if !ok {
+		return labelFilterPrefixes
+	}
+	policy := v.(*PropagationPolicy)
+	prefixes := make([]string, 0, len(labelFilterPrefixes)+len(policy.FilterLabelPrefixes))
+	prefixes = append(prefixes, labelFilterPrefixes...)
+	return append(prefixes, policy.FilterLabelPrefixes...)
+}
+
+func childAnnotationFilterPrefixes(namespace string) []string {
+	annotationFilterPrefixes := globalFilterPrefixes.Load().annotations
+	v, ok := namespacePropagationPolicies.Load(namespace)
+	if !ok {
+		return annotationFilterPrefixes
+	}
+	policy := v.(*PropagationPolicy)
+	prefixes := make([]string, 0, len(annotationFilterPrefixes)+len(policy.FilterAnnotationPrefixes))
+	prefixes = append(prefixes, annotationFilterPrefixes...)
+	return append(prefixes, policy.FilterAnnotationPrefixes...)
+}
+
+// withDisruptionProtection returns copy of given pod annotations with disruption protection annotations
+// annotations defined by user take precedence
+func withDisruptionProtection(annotations map[string]string) map[string]string {
+	dst := map[string]string{
+		KarpenterDoNotDisruptAnnotation:        "true",
+		ClusterAutoscalerSafeToEvictAnnotation: "false",
+	}
+	for k, v := range annotations {
+		dst[k] = v
+	}
+	return dst
 }
 
 func filterMapKeysByPrefixes(src map[string]string, prefixes []string) map[string]string {
@@ -218,6 +329,11 @@ type StorageSpec struct {
 	VolumeClaimTemplate EmbeddedPersistentVolumeClaim `json:"volumeClaimTemplate,omitempty"`
 }
 
+// isPersistent checks if storage is backed by persistent volume
+func (ss *StorageSpec) isPersistent() bool {
+	return ss != nil && ss.EmptyDir == nil
+}
+
 // IntoSTSVolume converts storageSpec into proper volume for statefulsetSpec
 // by default, it adds emptyDir volume.
 func (ss *StorageSpec) IntoSTSVolume(name string, sts *appsv1.StatefulSetSpec) {
@@ -376,6 +492,12 @@ func BuildReloadPathWithPort(extraArgs map[string]string, port string) string {
 	return fmt.Sprintf("%s://localhost:%s%s", proto, port, urlPath)
 }
 
+// BuildPathWithAuthKey builds api path for given args
+// path is prefixed with http.pathPrefix flag value and authKey is taken from the given flag
+func BuildPathWithAuthKey(extraArgs map[string]string, urlPath, authKeyFlag string) string {
+	return joinPathAuthKey(buildPathWithPrefixFlag(extraArgs, urlPath), authKeyFlag, extraArgs)
+}
+
 func buildPathWithPrefixFlag(flags map[string]string, defaultPath string) string {
 	if prefix, ok := flags[vmPathPrefixFlagName]; ok {
 		return path.Join(prefix, defaultPath)
@@ -465,6 +587,82 @@ func (cr *EmbeddedHPA) sanityCheck() error {
 	return nil
 }
 
+// StatefulSetUpdateStrategy defines update strategy of StatefulSet managed by operator
+type StatefulSetUpdateStrategy struct {
+	// Type of update strategy
+	// OnDelete is default, in this case operator deletes pods one by one and waits for readiness of each pod
+	// RollingUpdate delegates update process to kubernetes StatefulSet controller
+	// +kubebuilder:validation:Enum=OnDelete;RollingUpdate
+	// +optional
+	Type appsv1.StatefulSetUpdateStrategyType `json:"type,omitempty"`
+	// MaxUnavailable defines the maximum number of pods that can be unavailable during RollingUpdate.
+	// Requires MaxUnavailableStatefulSet feature gate enabled at kubernetes cluster
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// PartitionStep enables partition-based canary rollout for RollingUpdate.
+	// Operator updates PartitionStep pods with the highest ordinals first
+	// and lowers partition by PartitionStep once updated pods become ready
+	// +optional
+	PartitionStep *int32 `json:"partitionStep,omitempty"`
+	// RequireApproval pauses partition-based rollout after each step
+	// until operator.victoriametrics.com/rollout-approved annotation is set at StatefulSet
+	// +optional
+	RequireApproval bool `json:"requireApproval,omitempty"`
+}
+
+// StrategyType returns update strategy type with fallback to the given legacy value
+func (s *StatefulSetUpdateStrategy) StrategyType(legacy appsv1.StatefulSetUpdateStrategyType) appsv1.StatefulSetUpdateStrategyType {
+	if s == nil || s.Type == "" {
+		return legacy
+	}
+	return s.Type
+}
+
+// IsPartitioned checks if partition-based rollout is enabled
+func (s *StatefulSetUpdateStrategy) IsPartitioned() bool {
+	return s != nil && s.Type == appsv1.RollingUpdateStatefulSetStrategyType && s.PartitionStep != nil
+}
+
+func (s *StatefulSetUpdateStrategy) sanityCheck() error {
+	if s == nil {
+		return nil
+	}
+	if s.Type != appsv1.RollingUpdateStatefulSetStrategyType {
+		if s.MaxUnavailable != nil || s.PartitionStep != nil {
+			return fmt.Errorf("maxUnavailable and partitionStep require type=%s", appsv1.RollingUpdateStatefulSetStrategyType)
+		}
+	}
+	if s.PartitionStep != nil && *s.PartitionStep < 1 {
+		return fmt.Errorf("partitionStep must be greater than 0, got: %d", *s.PartitionStep)
+	}
+	if s.RequireApproval && s.PartitionStep == nil {
+		return fmt.Errorf("requireApproval requires partitionStep to be set")
+	}
+	return nil
+}
+
+// DefaultAffinitySettings configures podAntiAffinity generated by operator for component pods.
+// Pods are preferred to be scheduled at different hosts
+// and required to be scheduled at different zones with ZoneAware
+type DefaultAffinitySettings struct {
+	// Enabled defines whether operator generates default podAntiAffinity
+	// By default, VM_ENABLEDEFAULTAFFINITY operator env variable is used
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// ZoneAware requires pods to be scheduled at different zones
+	// Note, number of replicas cannot exceed number of zones in this case
+	// +optional
+	ZoneAware bool `json:"zoneAware,omitempty"`
+	// HostTopologyKey defines node label for hosts spread
+	// Defaults to kubernetes.io/hostname
+	// +optional
+	HostTopologyKey string `json:"hostTopologyKey,omitempty"`
+	// ZoneTopologyKey defines node label for zones spread
+	// Defaults to topology.kubernetes.io/zone
+	// +optional
+	ZoneTopologyKey string `json:"zoneTopologyKey,omitempty"`
+}
+
 // DiscoverySelector can be used at CRD components discovery
 type DiscoverySelector struct {
 	Namespace *NamespaceSelector    `json:"namespaceSelector,omitempty"`
@@ -720,6 +918,11 @@ type License struct {
 	Key *string `json:"key,omitempty"`
 	// KeyRef is reference to secret with license key for enterprise features.
 	KeyRef *v1.SecretKeySelector `json:"keyRef,omitempty"`
+	// ExpiresAt defines expiration time of the license key.
+	// Operator doesn't decode license key, so it must be set explicitly
+	// in order to expose vm_license_expires_at metric and emit events before license expiration.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
 }
 
 // IsProvided returns true if license is provided.
@@ -774,6 +977,9 @@ func (l *License) sanityCheck() error {
 	if l.Key != nil && l.KeyRef != nil {
 		return fmt.Errorf("only one of key or keyRef can be specified")
 	}
+	if l.KeyRef != nil && (l.KeyRef.Name == "" || l.KeyRef.Key == "") {
+		return fmt.Errorf("license.keyRef.name and license.keyRef.key cannot be empty")
+	}
 
 	return nil
 }
@@ -1017,6 +1223,36 @@ type TLSClientConfig struct {
 	Certs `json:",inline"`
 }
 
+// CertManagerCertificate defines certificate issued by cert-manager for the component
+// It requires -certmanager.enable operator flag
+type CertManagerCertificate struct {
+	// IssuerRef references cert-manager Issuer or ClusterIssuer
+	// issuer from -certmanager.issuerName operator flag is used if empty
+	// +optional
+	IssuerRef *CertManagerIssuerRef `json:"issuerRef,omitempty"`
+	// DNSNames defines additional dns names of certificate
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+	// Duration defines lifetime of certificate, for example 2160h
+	// cert-manager default is used if empty
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// RenewBefore defines how long before expiration certificate must be renewed
+	// cert-manager default is used if empty
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// CertManagerIssuerRef references cert-manager issuer
+type CertManagerIssuerRef struct {
+	// Name of issuer
+	Name string `json:"name"`
+	// Kind of issuer
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +optional
+	Kind string `json:"kind,omitempty"`
+}
+
 // ScrapeObjectStatus defines the observed state of ScrapeObjects
 type ScrapeObjectStatus struct {
 	// Status defines update status of resource
@@ -1025,6 +1261,40 @@ type ScrapeObjectStatus struct {
 	LastSyncError string `json:"lastSyncError,omitempty"`
 	// CurrentSyncError holds an error occured during reconcile loop
 	CurrentSyncError string `json:"-"`
+	// SelectedBy contains VMAgents in namespace/name format,
+	// which include the object into scrape configuration
+	// +optional
+	SelectedBy []string `json:"selectedBy,omitempty"`
+	// Targets contains number of targets discovered for the object by VMAgents from selectedBy.
+	// It's reported only if operator is started with -vmagent.scrapeTargetsStatus flag
+	// +optional
+	Targets []ScrapeObjectTargets `json:"targets,omitempty"`
+}
+
+// ScrapeObjectTargets defines number of targets discovered for scrape object by VMAgent
+type ScrapeObjectTargets struct {
+	// VMAgent in namespace/name format
+	VMAgent string `json:"vmagent"`
+	// Active is a number of active targets
+	Active int32 `json:"active"`
+	// Up is a number of active targets with successful last scrape
+	Up int32 `json:"up"`
+}
+
+// IsDestructiveChangeConfirmed checks if destructive changes of the current object generation
+// are confirmed with ConfirmDestructiveChangesAnnotation
+func IsDestructiveChangeConfirmed(cr client.Object) bool {
+	return cr.GetAnnotations()[ConfirmDestructiveChangesAnnotation] == strconv.FormatInt(cr.GetGeneration(), 10)
+}
+
+// IsReconcilePaused checks if reconcile of object is paused with ReconcileAnnotation
+func IsReconcilePaused(cr client.Object) bool {
+	return cr.GetAnnotations()[ReconcileAnnotation] == ReconcilePaused
+}
+
+// HasLastAppliedSpec checks if object was already reconciled and has last applied spec
+func HasLastAppliedSpec(cr client.Object) bool {
+	return len(cr.GetAnnotations()[lastAppliedSpecAnnotationName]) > 0
 }
 
 func parseLastAppliedSpec[T any](cr client.Object) (*T, error) {
@@ -1055,6 +1325,11 @@ type CommonDefaultableParams struct {
 	// By default, operator sets built-in resource requirements
 	// +optional
 	UseDefaultResources *bool `json:"useDefaultResources,omitempty"`
+	// Profile defines name of operator resource profile, e.g. small, medium or large
+	// profile sets default resources and extraArgs, such as memory.allowedPercent and cache sizes, for the component
+	// values defined at object have priority over profile
+	// +optional
+	Profile string `json:"profile,omitempty"`
 	// Port listen address
 	// +optional
 	Port string `json:"port,omitempty"`
@@ -1097,6 +1372,10 @@ type CommonApplicationDeploymentParams struct {
 	// Affinity If specified, the pod's scheduling constraints.
 	// +optional
 	Affinity *v1.Affinity `json:"affinity,omitempty"`
+	// DefaultAffinitySettings configures podAntiAffinity generated by operator,
+	// if podAntiAffinity isn't defined at affinity
+	// +optional
+	DefaultAffinitySettings *DefaultAffinitySettings `json:"defaultAffinitySettings,omitempty"`
 	// Tolerations If specified, the pod's tolerations.
 	// +optional
 	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
@@ -1173,11 +1452,13 @@ type CommonApplicationDeploymentParams struct {
 
 	// Containers property allows to inject additions sidecars or to patch existing containers.
 	// It can be useful for proxies, backup, etc.
+	// Containers with restartPolicy: Always are started as native kubernetes sidecars since kubernetes v1.29.
 	// +optional
 	Containers []v1.Container `json:"containers,omitempty"`
 	// InitContainers allows adding initContainers to the pod definition.
 	// Any errors during the execution of an initContainer will lead to a restart of the Pod.
 	// More info: https://kubernetes.io/docs/concepts/workloads/pods/init-containers/
+	// InitContainers with restartPolicy: Always are moved to containers for kubernetes versions before v1.29.
 	// +optional
 	InitContainers []v1.Container `json:"initContainers,omitempty"`
 	// Secrets is a list of Secrets in the same namespace as the Application
@@ -1209,6 +1490,84 @@ type CommonApplicationDeploymentParams struct {
 	// going to be performed, except for delete actions.
 	// +optional
 	Paused bool `json:"paused,omitempty"`
+	// OverridePatches are applied to generated Deployment or StatefulSet as the last step of build.
+	// It allows to change fields, which are not exposed by API, e.g. pod sysctls or container lifecycle hooks.
+	// Patches may break operator managed settings, use it with caution.
+	// +optional
+	OverridePatches []OverridePatch `json:"overridePatches,omitempty"`
+}
+
+// OverridePatchType defines format of OverridePatch
+type OverridePatchType string
+
+// Supported types of OverridePatch
+const (
+	OverridePatchStrategic OverridePatchType = "strategic"
+	OverridePatchJSON      OverridePatchType = "json"
+)
+
+// OverridePatch defines patch for child object generated by operator
+type OverridePatch struct {
+	// Kind of patched child object
+	// +kubebuilder:validation:Enum=Deployment;StatefulSet
+	Kind string `json:"kind"`
+	// Name of patched child object.
+	// Patch is applied to any child object of given kind if empty
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Type of patch, strategic merge patch or JSON patch (RFC 6902)
+	// +kubebuilder:validation:Enum=strategic;json
+	// +optional
+	Type OverridePatchType `json:"type,omitempty"`
+	// Patch in YAML or JSON format
+	Patch string `json:"patch"`
+}
+
+// PatchJSON returns patch converted into JSON
+func (p *OverridePatch) PatchJSON() ([]byte, error) {
+	data, err := k8syaml.YAMLToJSON([]byte(p.Patch))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse patch: %w", err)
+	}
+	return data, nil
+}
+
+func (p *OverridePatch) sanityCheck() error {
+	var dataStruct any
+	switch p.Kind {
+	case "Deployment":
+		dataStruct = appsv1.Deployment{}
+	case "StatefulSet":
+		dataStruct = appsv1.StatefulSet{}
+	default:
+		return fmt.Errorf("unsupported kind=%q, only Deployment and StatefulSet are supported", p.Kind)
+	}
+	data, err := p.PatchJSON()
+	if err != nil {
+		return err
+	}
+	switch p.Type {
+	case "", OverridePatchStrategic:
+		if _, err := strategicpatch.StrategicMergePatch([]byte("{}"), data, dataStruct); err != nil {
+			return fmt.Errorf("cannot parse strategic merge patch: %w", err)
+		}
+	case OverridePatchJSON:
+		if _, err := jsonpatch.DecodePatch(data); err != nil {
+			return fmt.Errorf("cannot parse json patch: %w", err)
+		}
+	default:
+		return fmt.Errorf("unsupported patch type=%q", p.Type)
+	}
+	return nil
+}
+
+func sanityCheckOverridePatches(patches []OverridePatch) error {
+	for i := range patches {
+		if err := patches[i].sanityCheck(); err != nil {
+			return fmt.Errorf("incorrect overridePatches[%d]: %w", i, err)
+		}
+	}
+	return nil
 }
 
 // SecurityContext extends PodSecurityContext with ContainerSecurityContext
@@ -1253,6 +1612,85 @@ type ContainerSecurityContext struct {
 	ProcMount *v1.ProcMountType `json:"procMount,omitempty"`
 }
 
+// ChildObject defines object generated by operator for the parent custom resource
+type ChildObject struct {
+	// Kind of the object
+	Kind string `json:"kind"`
+	// Name of the object
+	Name string `json:"name"`
+	// Namespace of the object, it's empty for cluster-scoped objects
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// LastAppliedHash is a hash of the object state applied by operator during the last successful reconcile
+	// +optional
+	LastAppliedHash string `json:"lastAppliedHash,omitempty"`
+}
+
+// CardinalityLimits defines limits for the number of unique time series
+// series exceeding limits are dropped
+type CardinalityLimits struct {
+	// MaxHourlySeries defines the maximum number of unique series, which can be added during the last hour
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxHourlySeries int64 `json:"maxHourlySeries,omitempty"`
+	// MaxDailySeries defines the maximum number of unique series, which can be added during the last day
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxDailySeries int64 `json:"maxDailySeries,omitempty"`
+}
+
+// ConfigValidation defines validation of generated configuration with short-lived Job before its rollout
+// vmalert validates rules with -dryRun flag and vmalertmanager validates configuration with amtool check-config
+type ConfigValidation struct {
+	// Enabled defines if changed configuration must pass validation Job before update
+	// configuration isn't updated until validation passes, validation output is reported at status.reason
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// Resources container resource request and limits for validation Job
+	// +optional
+	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// IsEnabled checks if configuration must be validated before rollout
+func (cv *ConfigValidation) IsEnabled() bool {
+	return cv != nil && cv.Enabled
+}
+
+// DefaultRules defines VMRule with default alerting and recording rules generated by operator for the component
+// it alerts on high churn rate, exhaustion of cardinality limits, remote write lag, disk space and alertmanager cluster split
+type DefaultRules struct {
+	// Enabled defines if operator must create VMRule with default rules
+	// rules are created for all objects, if operator runs with -defaultRules.enable flag
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// ExcludeSelector is a label selector for rules excluded from VMRule, e.g. alertname in (TooHighChurnRate) or severity=warning
+	// rules are matched by alertname or record name and rule labels
+	// +optional
+	ExcludeSelector string `json:"excludeSelector,omitempty"`
+	// Labels added to VMRule object
+	// it allows to select VMRule with ruleSelector of VMAlert
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// AdditionalRuleLabels added to each generated alerting rule
+	// +optional
+	AdditionalRuleLabels map[string]string `json:"additionalRuleLabels,omitempty"`
+}
+
+// IsEnabled checks if default rules must be created
+func (dr *DefaultRules) IsEnabled() bool {
+	return dr != nil && dr.Enabled
+}
+
+func (dr *DefaultRules) validate() error {
+	if dr == nil {
+		return nil
+	}
+	if _, err := labels.Parse(dr.ExcludeSelector); err != nil {
+		return fmt.Errorf("incorrect defaultRules.excludeSelector=%q: %w", dr.ExcludeSelector, err)
+	}
+	return nil
+}
+
 func statusPatch(ctx context.Context, rclient client.Client, object client.Object, st interface{}) error {
 	type patch struct {
 		OP    string      `json:"op"`
@@ -6,6 +6,11 @@ import (
 	"testing"
 
 	"gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 )
 
 func Test_buildPathWithPrefixFlag(t *testing.T) {
@@ -92,3 +97,138 @@ func TestParsingMatch(t *testing.T) {
 		})
 	}
 }
+
+func TestLicenseSanityCheck(t *testing.T) {
+	f := func(l *License, wantErr string) {
+		t.Helper()
+		err := l.sanityCheck()
+		if wantErr == "" {
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			return
+		}
+		if err == nil || err.Error() != wantErr {
+			t.Fatalf("unexpected error, got: %v, want: %s", err, wantErr)
+		}
+	}
+
+	// not provided
+	f(nil, "")
+
+	// inline key
+	f(&License{Key: ptr.To("license-value")}, "")
+
+	// key and keyRef
+	f(&License{
+		Key:    ptr.To("license-value"),
+		KeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "vm-license"}, Key: "license"},
+	}, "only one of key or keyRef can be specified")
+
+	// keyRef without key
+	f(&License{
+		KeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "vm-license"}},
+	}, "license.keyRef.name and license.keyRef.key cannot be empty")
+}
+
+func TestVMSinglePaused(t *testing.T) {
+	f := func(specPaused bool, annotations map[string]string, want bool) {
+		t.Helper()
+		cr := &VMSingle{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+			Spec:       VMSingleSpec{CommonApplicationDeploymentParams: CommonApplicationDeploymentParams{Paused: specPaused}},
+		}
+		if got := cr.Paused(); got != want {
+			t.Fatalf("unexpected paused state, got: %v, want: %v", got, want)
+		}
+	}
+
+	// not paused
+	f(false, nil, false)
+
+	// paused with spec
+	f(true, nil, true)
+
+	// paused with annotation
+	f(false, map[string]string{ReconcileAnnotation: ReconcilePaused}, true)
+
+	// unknown annotation value
+	f(false, map[string]string{ReconcileAnnotation: "enabled"}, false)
+}
+
+func TestOverridePatchSanityCheck(t *testing.T) {
+	f := func(p OverridePatch, wantErr bool) {
+		t.Helper()
+		err := p.sanityCheck()
+		if wantErr && err == nil {
+			t.Fatalf("expected error for patch: %v", p)
+		}
+		if !wantErr && err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	// strategic merge patch in yaml
+	f(OverridePatch{Kind: "StatefulSet", Patch: `
+spec:
+  template:
+    spec:
+      securityContext:
+        sysctls:
+        - name: net.core.somaxconn
+          value: "1024"
+`}, false)
+
+	// json patch
+	f(OverridePatch{Kind: "Deployment", Type: OverridePatchJSON, Patch: `[{"op": "add", "path": "/spec/minReadySeconds", "value": 10}]`}, false)
+
+	// unsupported kind
+	f(OverridePatch{Kind: "Service", Patch: `{"spec": {}}`}, true)
+
+	// unsupported type
+	f(OverridePatch{Kind: "Deployment", Type: "merge", Patch: `{"spec": {}}`}, true)
+
+	// malformed yaml
+	f(OverridePatch{Kind: "Deployment", Patch: `spec: [`}, true)
+
+	// json patch isn't a list of operations
+	f(OverridePatch{Kind: "Deployment", Type: OverridePatchJSON, Patch: `{"spec": {}}`}, true)
+
+	// strategic merge patch isn't an object
+	f(OverridePatch{Kind: "Deployment", Patch: `[{"op": "remove", "path": "/spec"}]`}, true)
+}
+
+func TestStatefulSetUpdateStrategySanityCheck(t *testing.T) {
+	f := func(s *StatefulSetUpdateStrategy, wantErr bool) {
+		t.Helper()
+		if err := s.sanityCheck(); (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+	}
+
+	// not defined
+	f(nil, false)
+
+	// on delete
+	f(&StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType}, false)
+
+	// partitioned rollout with approval
+	f(&StatefulSetUpdateStrategy{
+		Type:            appsv1.RollingUpdateStatefulSetStrategyType,
+		MaxUnavailable:  ptr.To(intstr.FromInt32(2)),
+		PartitionStep:   ptr.To[int32](1),
+		RequireApproval: true,
+	}, false)
+
+	// partition step with on delete
+	f(&StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType, PartitionStep: ptr.To[int32](1)}, true)
+
+	// max unavailable without type
+	f(&StatefulSetUpdateStrategy{MaxUnavailable: ptr.To(intstr.FromInt32(2))}, true)
+
+	// zero partition step
+	f(&StatefulSetUpdateStrategy{Type: appsv1.RollingUpdateStatefulSetStrategyType, PartitionStep: ptr.To[int32](0)}, true)
+
+	// approval without partition step
+	f(&StatefulSetUpdateStrategy{Type: appsv1.RollingUpdateStatefulSetStrategyType, RequireApproval: true}, true)
+}
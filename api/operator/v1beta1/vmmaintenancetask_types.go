@@ -0,0 +1,227 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// MaintenanceTaskAwaitingConfirmation means, that task requires confirmation with ConfirmDestructiveChangesAnnotation
+	MaintenanceTaskAwaitingConfirmation = "AwaitingConfirmation"
+	// MaintenanceTaskRunning means, that task job is in progress
+	MaintenanceTaskRunning = "Running"
+	// MaintenanceTaskSucceeded means, that task job finished successfully
+	MaintenanceTaskSucceeded = "Succeeded"
+	// MaintenanceTaskFailed means, that task job failed
+	MaintenanceTaskFailed = "Failed"
+)
+
+// VMMaintenanceTaskSpec defines maintenance operation for VMSingle or VMCluster
+// exactly one of forceMerge or deleteSeries must be set
+type VMMaintenanceTaskSpec struct {
+	// Target defines VMSingle or VMCluster from the same namespace
+	Target MaintenanceTaskTarget `json:"target"`
+	// ForceMerge triggers forced merge of storage partitions
+	// See https://docs.victoriametrics.com/#forced-merge
+	// +optional
+	ForceMerge *ForceMergeTask `json:"forceMerge,omitempty"`
+	// DeleteSeries deletes series matching the given selectors
+	// It's a destructive operation and it must be confirmed with
+	// operator.victoriametrics.com/confirm-destructive-changes annotation set to the object generation
+	// See https://docs.victoriametrics.com/#how-to-delete-time-series
+	// +optional
+	DeleteSeries *DeleteSeriesTask `json:"deleteSeries,omitempty"`
+	// Image - docker image settings for curl, which performs maintenance api requests
+	// if no specified operator uses default config version
+	// +optional
+	Image Image `json:"image,omitempty"`
+	// Resources container resource request and limits for task Job,
+	// if not defined default resources from operator config will be used
+	// +optional
+	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+	// ParsingError contents error with context if operator was failed to parse json object from kubernetes api server
+	ParsingError string `json:"-" yaml:"-"`
+}
+
+// MaintenanceTaskTarget references object, which storage is maintained
+type MaintenanceTaskTarget struct {
+	// Kind of target object
+	// +kubebuilder:validation:Enum=VMSingle;VMCluster
+	Kind string `json:"kind"`
+	// Name of target object
+	Name string `json:"name"`
+}
+
+// ForceMergeTask defines forced merge of storage partitions
+type ForceMergeTask struct {
+	// PartitionPrefix selects monthly partitions for merge in the format YYYY_MM, for example 2024_01
+	// all partitions are merged if empty
+	// +optional
+	PartitionPrefix string `json:"partitionPrefix,omitempty"`
+}
+
+// DeleteSeriesTask defines series removal with delete API
+type DeleteSeriesTask struct {
+	// Match defines series selectors, for example `{job="node-exporter"}`
+	// +kubebuilder:validation:MinItems=1
+	Match []string `json:"match"`
+	// Tenant defines VMCluster tenant in the format accountID[:projectID]
+	// 0 is used by default
+	// +optional
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// VMMaintenanceTaskStatus defines the observed state of VMMaintenanceTask
+type VMMaintenanceTaskStatus struct {
+	// Phase of the latest task run
+	Phase string `json:"phase,omitempty"`
+	// Message contains details of the latest task run
+	Message string `json:"message,omitempty"`
+	// StartTime of the latest task run
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime of the latest task run
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// TaskHash is a hash of task settings used for the latest task run
+	TaskHash string `json:"taskHash,omitempty"`
+	// LastSyncError contains error message for unsuccessful task run
+	LastSyncError string `json:"lastSyncError,omitempty"`
+}
+
+// VMMaintenanceTask runs maintenance operation for VMSingle or VMCluster storage as a Job
+// +operator-sdk:gen-csv:customresourcedefinitions.displayName="VMMaintenanceTask"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=vmmaintenancetasks,scope=Namespaced
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Target Kind",type="string",JSONPath=".spec.target.kind"
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=".spec.target.name"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Sync Error",type="string",JSONPath=".status.lastSyncError"
+// +genclient
+// +k8s:openapi-gen=true
+type VMMaintenanceTask struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VMMaintenanceTaskSpec   `json:"spec,omitempty"`
+	Status VMMaintenanceTaskStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VMMaintenanceTaskList contains a list of VMMaintenanceTask
+type VMMaintenanceTaskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VMMaintenanceTask `json:"items"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (cr *VMMaintenanceTask) UnmarshalJSON(src []byte) error {
+	type mtcr VMMaintenanceTask
+	if err := json.Unmarshal(src, (*mtcr)(cr)); err != nil {
+		cr.Spec.ParsingError = fmt.Sprintf("cannot parse vmmaintenancetask: %s, err: %s", string(src), err)
+		return nil
+	}
+	return nil
+}
+
+// AsKey returns unique key for object
+func (cr *VMMaintenanceTask) AsKey() string {
+	return fmt.Sprintf("%s/%s", cr.Namespace, cr.Name)
+}
+
+// PrefixedName returns name of task Job
+func (cr *VMMaintenanceTask) PrefixedName() string {
+	return fmt.Sprintf("vmmaintenance-%s", cr.Name)
+}
+
+// IsDestructive checks if task removes data and requires confirmation
+func (cr *VMMaintenanceTask) IsDestructive() bool {
+	return cr.Spec.DeleteSeries != nil
+}
+
+// Operation returns human readable name of task operation
+func (cr *VMMaintenanceTask) Operation() string {
+	switch {
+	case cr.Spec.ForceMerge != nil:
+		return "forceMerge"
+	case cr.Spec.DeleteSeries != nil:
+		return "deleteSeries"
+	default:
+		return ""
+	}
+}
+
+// AsOwner returns owner references with current object as owner
+func (cr *VMMaintenanceTask) AsOwner() []metav1.OwnerReference {
+	return []metav1.OwnerReference{
+		{
+			APIVersion:         cr.APIVersion,
+			Kind:               cr.Kind,
+			Name:               cr.Name,
+			UID:                cr.UID,
+			Controller:         ptr.To(true),
+			BlockOwnerDeletion: ptr.To(true),
+		},
+	}
+}
+
+// AnnotationsFiltered returns global annotations to be applied by objects generated for vmmaintenancetask
+func (cr *VMMaintenanceTask) AnnotationsFiltered() map[string]string {
+	annotations := make(map[string]string)
+	for annotation, value := range cr.Annotations {
+		if !strings.HasPrefix(annotation, "kubectl.kubernetes.io/") && annotation != ConfirmDestructiveChangesAnnotation {
+			annotations[annotation] = value
+		}
+	}
+	return annotations
+}
+
+// SelectorLabels returns selector labels for vmmaintenancetask objects
+func (cr *VMMaintenanceTask) SelectorLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":      "vmmaintenancetask",
+		"app.kubernetes.io/instance":  cr.Name,
+		"app.kubernetes.io/component": "monitoring",
+		"managed-by":                  "vm-operator",
+	}
+}
+
+// AllLabels returns combined labels for VMMaintenanceTask
+func (cr *VMMaintenanceTask) AllLabels() map[string]string {
+	labels := cr.SelectorLabels()
+	for label, value := range cr.Labels {
+		if _, ok := labels[label]; ok {
+			// forbid changes for selector labels
+			continue
+		}
+		labels[label] = value
+	}
+	return labels
+}
+
+func init() {
+	SchemeBuilder.Register(&VMMaintenanceTask{}, &VMMaintenanceTaskList{})
+}
@@ -0,0 +1,111 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/VictoriaMetrics/metricsql"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var (
+	partitionPrefixRe = regexp.MustCompile(`^\d{4}(_\d{2})?$`)
+	tenantRe          = regexp.MustCompile(`^\d+(:\d+)?$`)
+)
+
+// SetupWebhookWithManager will setup the manager to manage the webhooks
+func (r *VMMaintenanceTask) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-operator-victoriametrics-com-v1beta1-vmmaintenancetask,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.victoriametrics.com,resources=vmmaintenancetasks,verbs=create;update,versions=v1beta1,name=vvmmaintenancetask.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &VMMaintenanceTask{}
+
+// Validate performs symantic validation of object
+func (r *VMMaintenanceTask) Validate() error {
+	if mustSkipValidation(r) {
+		return nil
+	}
+	switch r.Spec.Target.Kind {
+	case "VMSingle", "VMCluster":
+	default:
+		return fmt.Errorf("unsupported target kind=%q, only VMSingle and VMCluster are supported", r.Spec.Target.Kind)
+	}
+	if r.Spec.Target.Name == "" {
+		return fmt.Errorf("target name cannot be empty")
+	}
+	if (r.Spec.ForceMerge == nil) == (r.Spec.DeleteSeries == nil) {
+		return fmt.Errorf("exactly one of forceMerge or deleteSeries must be set")
+	}
+	if fm := r.Spec.ForceMerge; fm != nil && fm.PartitionPrefix != "" && !partitionPrefixRe.MatchString(fm.PartitionPrefix) {
+		return fmt.Errorf("incorrect forceMerge.partitionPrefix=%q, it must have YYYY_MM format", fm.PartitionPrefix)
+	}
+	if ds := r.Spec.DeleteSeries; ds != nil {
+		if len(ds.Match) == 0 {
+			return fmt.Errorf("deleteSeries.match cannot be empty")
+		}
+		for _, m := range ds.Match {
+			if _, err := metricsql.Parse(m); err != nil {
+				return fmt.Errorf("cannot parse deleteSeries.match=%q: %w", m, err)
+			}
+		}
+		if ds.Tenant != "" {
+			if r.Spec.Target.Kind != "VMCluster" {
+				return fmt.Errorf("deleteSeries.tenant is supported only for VMCluster target")
+			}
+			if !tenantRe.MatchString(ds.Tenant) {
+				return fmt.Errorf("incorrect deleteSeries.tenant=%q, it must have accountID[:projectID] format", ds.Tenant)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMMaintenanceTask) ValidateCreate() (admission.Warnings, error) {
+	if r.Spec.ParsingError != "" {
+		return nil, fmt.Errorf(r.Spec.ParsingError)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMMaintenanceTask) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	if r.Spec.ParsingError != "" {
+		return nil, fmt.Errorf(r.Spec.ParsingError)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *VMMaintenanceTask) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,56 @@
+package v1beta1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("VMMaintenanceTask Webhook", func() {
+	Context("When creating VMMaintenanceTask under Validating Webhook", func() {
+		DescribeTable("fails validation",
+			func(spec VMMaintenanceTaskSpec, wantErr string) {
+				mt := VMMaintenanceTask{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: spec}
+				Expect(mt.Validate()).To(MatchError(wantErr))
+			},
+			Entry("unsupported target", VMMaintenanceTaskSpec{
+				Target:     MaintenanceTaskTarget{Kind: "VMAgent", Name: "main"},
+				ForceMerge: &ForceMergeTask{},
+			}, `unsupported target kind="VMAgent", only VMSingle and VMCluster are supported`),
+			Entry("missing operation", VMMaintenanceTaskSpec{
+				Target: MaintenanceTaskTarget{Kind: "VMSingle", Name: "main"},
+			}, `exactly one of forceMerge or deleteSeries must be set`),
+			Entry("both operations", VMMaintenanceTaskSpec{
+				Target:       MaintenanceTaskTarget{Kind: "VMSingle", Name: "main"},
+				ForceMerge:   &ForceMergeTask{},
+				DeleteSeries: &DeleteSeriesTask{Match: []string{"up"}},
+			}, `exactly one of forceMerge or deleteSeries must be set`),
+			Entry("bad partition prefix", VMMaintenanceTaskSpec{
+				Target:     MaintenanceTaskTarget{Kind: "VMSingle", Name: "main"},
+				ForceMerge: &ForceMergeTask{PartitionPrefix: "2024-01"},
+			}, `incorrect forceMerge.partitionPrefix="2024-01", it must have YYYY_MM format`),
+			Entry("bad match", VMMaintenanceTaskSpec{
+				Target:       MaintenanceTaskTarget{Kind: "VMSingle", Name: "main"},
+				DeleteSeries: &DeleteSeriesTask{Match: []string{`up{job="node"`}},
+			}, `cannot parse deleteSeries.match="up{job=\"node\"": labelFilters: unexpected token ""; want ",", "or", "}"; unparsed data: ""`),
+			Entry("tenant for vmsingle", VMMaintenanceTaskSpec{
+				Target:       MaintenanceTaskTarget{Kind: "VMSingle", Name: "main"},
+				DeleteSeries: &DeleteSeriesTask{Match: []string{"up"}, Tenant: "1"},
+			}, `deleteSeries.tenant is supported only for VMCluster target`),
+		)
+		DescribeTable("passes validation",
+			func(spec VMMaintenanceTaskSpec) {
+				mt := VMMaintenanceTask{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: spec}
+				Expect(mt.Validate()).To(Succeed())
+			},
+			Entry("force merge", VMMaintenanceTaskSpec{
+				Target:     MaintenanceTaskTarget{Kind: "VMSingle", Name: "main"},
+				ForceMerge: &ForceMergeTask{PartitionPrefix: "2024_01"},
+			}),
+			Entry("delete series", VMMaintenanceTaskSpec{
+				Target:       MaintenanceTaskTarget{Kind: "VMCluster", Name: "main"},
+				DeleteSeries: &DeleteSeriesTask{Match: []string{`{job="node"}`}, Tenant: "1:2"},
+			}),
+		)
+	})
+})
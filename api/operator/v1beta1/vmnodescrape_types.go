@@ -27,6 +27,14 @@ type VMNodeScrapeSpec struct {
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.x-descriptors="urn:alm:descriptor:com.tectonic.ui:selector:"
 	// +optional
 	Selector metav1.LabelSelector `json:"selector,omitempty"`
+	// NodePoolShard assigns targets to the dedicated shard of VMAgent with the given name.
+	// VMAgent runs separate Deployment or StatefulSet for each node pool shard,
+	// which scrapes only targets of VMNodeScrapes with the same nodePoolShard.
+	// It could be used together with selector and interval for heterogeneous node pools, e.g. GPU nodes.
+	// +kubebuilder:validation:Pattern:="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength=32
+	// +optional
+	NodePoolShard string `json:"nodePoolShard,omitempty"`
 }
 
 // VMNodeScrape defines discovery for targets placed on kubernetes nodes,
@@ -0,0 +1,224 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VMOperatorSettingsSpec defines operator behavior for objects at the namespace of VMOperatorSettings.
+// Explicitly defined fields of objects have priority over settings,
+// settings have priority over global operator configuration.
+type VMOperatorSettingsSpec struct {
+	// ConfigReloaderResources defines default resources for config-reloader container
+	// of VMAgent, VMAlert, VMAlertmanager and VMAuth at namespace.
+	// It's used, if object doesn't define own configReloaderResources
+	// +optional
+	ConfigReloaderResources *corev1.ResourceRequirements `json:"configReloaderResources,omitempty"`
+	// Propagation defines policy of labels and annotations propagation from objects to its child objects
+	// +optional
+	Propagation *PropagationPolicy `json:"propagation,omitempty"`
+	// MaintenanceWindows defines time windows, when spec changes of objects at namespace are applied.
+	// Changes made outside of windows are deferred until the start of the next window.
+	// New objects are created immediately.
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+	// ParsingError contents error with context if operator was failed to parse json object from kubernetes api server
+	ParsingError string `json:"-" yaml:"-"`
+}
+
+// PropagationPolicy defines filtering of labels and annotations propagated to child objects
+type PropagationPolicy struct {
+	// FilterLabelPrefixes defines label prefixes, which are not propagated to child objects.
+	// Prefixes are added to the global operator filter
+	// +optional
+	FilterLabelPrefixes []string `json:"filterLabelPrefixes,omitempty"`
+	// FilterAnnotationPrefixes defines annotation prefixes, which are not propagated to child objects.
+	// Prefixes are added to the global operator filter
+	// +optional
+	FilterAnnotationPrefixes []string `json:"filterAnnotationPrefixes,omitempty"`
+}
+
+// MaintenanceWindow defines recurring time window
+type MaintenanceWindow struct {
+	// Days of week, when window starts: Mon, Tue, Wed, Thu, Fri, Sat or Sun.
+	// Window starts every day if days are not set
+	// +optional
+	Days []string `json:"days,omitempty"`
+	// Start time of window in HH:MM format
+	// +kubebuilder:validation:Pattern:="^([01][0-9]|2[0-3]):[0-5][0-9]$"
+	Start string `json:"start"`
+	// Duration of window, for example 2h or 30m. Maximum duration is 168h
+	Duration string `json:"duration"`
+	// TimeZone of start time in IANA format, for example Europe/Berlin. UTC is used by default
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// VMOperatorSettings is the Schema for the vmoperatorsettings API.
+// It allows namespace admins to tune operator behavior for objects at namespace.
+// Only single object per namespace is applied, the first one ordered by name.
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=vmoperatorsettings,scope=Namespaced
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +genclient
+// +k8s:openapi-gen=true
+type VMOperatorSettings struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VMOperatorSettingsSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VMOperatorSettingsList contains a list of VMOperatorSettings
+type VMOperatorSettingsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VMOperatorSettings `json:"items"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (cr *VMOperatorSettings) UnmarshalJSON(src []byte) error {
+	type oscr VMOperatorSettings
+	if err := json.Unmarshal(src, (*oscr)(cr)); err != nil {
+		cr.Spec.ParsingError = fmt.Sprintf("cannot parse vmoperatorsettings: %s, err: %s", string(src), err)
+		return nil
+	}
+	return nil
+}
+
+// AsKey returns unique key for object
+func (cr *VMOperatorSettings) AsKey() string {
+	return fmt.Sprintf("%s/%s", cr.Namespace, cr.Name)
+}
+
+const maxMaintenanceWindowDuration = 7 * 24 * time.Hour
+
+type parsedMaintenanceWindow struct {
+	days     map[time.Weekday]struct{}
+	hour     int
+	minute   int
+	duration time.Duration
+	location *time.Location
+}
+
+func (mw *MaintenanceWindow) parse() (*parsedMaintenanceWindow, error) {
+	start, err := time.Parse("15:04", mw.Start)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse start=%q, it must have HH:MM format: %w", mw.Start, err)
+	}
+	duration, err := time.ParseDuration(mw.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse duration=%q: %w", mw.Duration, err)
+	}
+	if duration <= 0 || duration > maxMaintenanceWindowDuration {
+		return nil, fmt.Errorf("duration=%q must be in range (0s ... %s]", mw.Duration, maxMaintenanceWindowDuration)
+	}
+	location := time.UTC
+	if mw.TimeZone != "" {
+		location, err = time.LoadLocation(mw.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load timeZone=%q: %w", mw.TimeZone, err)
+		}
+	}
+	pmw := &parsedMaintenanceWindow{
+		hour:     start.Hour(),
+		minute:   start.Minute(),
+		duration: duration,
+		location: location,
+	}
+	if len(mw.Days) > 0 {
+		pmw.days = make(map[time.Weekday]struct{}, len(mw.Days))
+	}
+	for _, day := range mw.Days {
+		wd, ok := parseWeekday(day)
+		if !ok {
+			return nil, fmt.Errorf("unsupported day=%q, supported values: Mon, Tue, Wed, Thu, Fri, Sat, Sun", day)
+		}
+		pmw.days[wd] = struct{}{}
+	}
+	return pmw, nil
+}
+
+func parseWeekday(s string) (time.Weekday, bool) {
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if strings.EqualFold(wd.String()[:3], s) {
+			return wd, true
+		}
+	}
+	return 0, false
+}
+
+// next returns true if window is active at the given time
+// otherwise it returns start time of the next window
+func (pmw *parsedMaintenanceWindow) next(now time.Time) (bool, time.Time) {
+	local := now.In(pmw.location)
+	y, m, d := local.Date()
+	// window started at previous days could be still active
+	for i := -7; i <= 7; i++ {
+		start := time.Date(y, m, d+i, pmw.hour, pmw.minute, 0, 0, pmw.location)
+		if pmw.days != nil {
+			if _, ok := pmw.days[start.Weekday()]; !ok {
+				continue
+			}
+		}
+		if start.After(now) {
+			return false, start
+		}
+		if now.Before(start.Add(pmw.duration)) {
+			return true, start
+		}
+	}
+	// unreachable for window with at least 1 day
+	return false, time.Time{}
+}
+
+// NextMaintenanceWindow checks if spec changes could be applied at the given time.
+// If changes must be deferred, it returns start time of the nearest window.
+// Changes are always allowed if windows are not defined.
+func (s *VMOperatorSettingsSpec) NextMaintenanceWindow(now time.Time) (bool, time.Time, error) {
+	if len(s.MaintenanceWindows) == 0 {
+		return true, time.Time{}, nil
+	}
+	var nearest time.Time
+	for i := range s.MaintenanceWindows {
+		pmw, err := s.MaintenanceWindows[i].parse()
+		if err != nil {
+			return false, time.Time{}, fmt.Errorf("incorrect maintenanceWindows[%d]: %w", i, err)
+		}
+		active, start := pmw.next(now)
+		if active {
+			return true, time.Time{}, nil
+		}
+		if nearest.IsZero() || start.Before(nearest) {
+			nearest = start
+		}
+	}
+	return false, nearest, nil
+}
+
+func init() {
+	SchemeBuilder.Register(&VMOperatorSettings{}, &VMOperatorSettingsList{})
+}
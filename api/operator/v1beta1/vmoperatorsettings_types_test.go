@@ -0,0 +1,85 @@
+package v1beta1
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVMOperatorSettingsSpec_NextMaintenanceWindow(t *testing.T) {
+	f := func(windows []MaintenanceWindow, now string, wantAllowed bool, wantNext string) {
+		t.Helper()
+		nowT, err := time.Parse(time.RFC3339, now)
+		if err != nil {
+			t.Fatalf("cannot parse now: %s", err)
+		}
+		spec := VMOperatorSettingsSpec{MaintenanceWindows: windows}
+		allowed, next, err := spec.NextMaintenanceWindow(nowT)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if allowed != wantAllowed {
+			t.Fatalf("unexpected allowed, got: %v, want: %v", allowed, wantAllowed)
+		}
+		if wantAllowed {
+			return
+		}
+		if got := next.UTC().Format(time.RFC3339); got != wantNext {
+			t.Fatalf("unexpected next window, got: %s, want: %s", got, wantNext)
+		}
+	}
+	// 2024-06-05 is Wednesday
+
+	// no windows
+	f(nil, "2024-06-05T10:00:00Z", true, "")
+
+	// daily window
+	daily := []MaintenanceWindow{{Start: "02:00", Duration: "2h"}}
+	f(daily, "2024-06-05T03:00:00Z", true, "")
+	f(daily, "2024-06-05T04:00:00Z", false, "2024-06-06T02:00:00Z")
+	f(daily, "2024-06-05T01:00:00Z", false, "2024-06-05T02:00:00Z")
+
+	// window crosses midnight
+	night := []MaintenanceWindow{{Start: "23:00", Duration: "3h"}}
+	f(night, "2024-06-05T01:30:00Z", true, "")
+	f(night, "2024-06-05T02:00:00Z", false, "2024-06-05T23:00:00Z")
+
+	// weekend window started at previous day
+	weekend := []MaintenanceWindow{{Days: []string{"Sat"}, Start: "00:00", Duration: "48h"}}
+	f(weekend, "2024-06-09T12:00:00Z", true, "")
+	f(weekend, "2024-06-05T12:00:00Z", false, "2024-06-08T00:00:00Z")
+
+	// window at time zone
+	berlin := []MaintenanceWindow{{Days: []string{"wed"}, Start: "12:00", Duration: "1h", TimeZone: "Europe/Berlin"}}
+	f(berlin, "2024-06-05T10:30:00Z", true, "")
+	f(berlin, "2024-06-05T09:30:00Z", false, "2024-06-05T10:00:00Z")
+
+	// the nearest of multiple windows
+	multi := []MaintenanceWindow{{Days: []string{"Fri"}, Start: "01:00", Duration: "1h"}, {Days: []string{"Thu"}, Start: "05:00", Duration: "1h"}}
+	f(multi, "2024-06-05T10:00:00Z", false, "2024-06-06T05:00:00Z")
+}
+
+func TestChildFilterPrefixes(t *testing.T) {
+	defer SetNamespacePropagationPolicy("team-a", nil)
+	SetNamespacePropagationPolicy("team-a", &PropagationPolicy{
+		FilterLabelPrefixes:      []string{"team/"},
+		FilterAnnotationPrefixes: []string{"argocd.argoproj.io/"},
+	})
+	cr := VMSingle{}
+	cr.Namespace = "team-a"
+	cr.Labels = map[string]string{"team/owner": "a", "app": "db"}
+	cr.Annotations = map[string]string{"argocd.argoproj.io/sync-wave": "1", "kubectl.kubernetes.io/last-applied-configuration": "{}", "note": "keep"}
+	if _, ok := cr.AllLabels()["team/owner"]; ok {
+		t.Fatalf("label must be filtered by namespace policy: %v", cr.AllLabels())
+	}
+	if cr.AllLabels()["app"] != "db" {
+		t.Fatalf("label must be propagated: %v", cr.AllLabels())
+	}
+	annotations := cr.AnnotationsFiltered()
+	if len(annotations) != 1 || annotations["note"] != "keep" {
+		t.Fatalf("unexpected annotations: %v", annotations)
+	}
+	cr.Namespace = "team-b"
+	if cr.AllLabels()["team/owner"] != "a" {
+		t.Fatalf("namespace policy must not be applied to other namespaces: %v", cr.AllLabels())
+	}
+}
@@ -0,0 +1,89 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager will setup the manager to manage the webhooks
+func (r *VMOperatorSettings) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-operator-victoriametrics-com-v1beta1-vmoperatorsettings,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.victoriametrics.com,resources=vmoperatorsettings,verbs=create;update,versions=v1beta1,name=vvmoperatorsettings.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &VMOperatorSettings{}
+
+// Validate checks maintenance windows and propagation prefixes
+func (r *VMOperatorSettings) Validate() error {
+	if mustSkipValidation(r) {
+		return nil
+	}
+	for i := range r.Spec.MaintenanceWindows {
+		if _, err := r.Spec.MaintenanceWindows[i].parse(); err != nil {
+			return fmt.Errorf("incorrect maintenanceWindows[%d]: %w", i, err)
+		}
+	}
+	if p := r.Spec.Propagation; p != nil {
+		for _, prefix := range p.FilterLabelPrefixes {
+			if prefix == "" {
+				return fmt.Errorf("propagation.filterLabelPrefixes cannot contain empty prefix")
+			}
+		}
+		for _, prefix := range p.FilterAnnotationPrefixes {
+			if prefix == "" {
+				return fmt.Errorf("propagation.filterAnnotationPrefixes cannot contain empty prefix")
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMOperatorSettings) ValidateCreate() (admission.Warnings, error) {
+	if r.Spec.ParsingError != "" {
+		return nil, fmt.Errorf(r.Spec.ParsingError)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMOperatorSettings) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	if r.Spec.ParsingError != "" {
+		return nil, fmt.Errorf(r.Spec.ParsingError)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *VMOperatorSettings) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
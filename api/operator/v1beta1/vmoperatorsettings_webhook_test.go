@@ -0,0 +1,49 @@
+package v1beta1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("VMOperatorSettings Webhook", func() {
+	Context("When creating VMOperatorSettings under Validating Webhook", func() {
+		DescribeTable("fails validation",
+			func(spec VMOperatorSettingsSpec, wantErr string) {
+				os := VMOperatorSettings{ObjectMeta: metav1.ObjectMeta{Name: "settings"}, Spec: spec}
+				Expect(os.Validate()).To(MatchError(wantErr))
+			},
+			Entry("bad start", VMOperatorSettingsSpec{
+				MaintenanceWindows: []MaintenanceWindow{{Start: "25:00", Duration: "1h"}},
+			}, `incorrect maintenanceWindows[0]: cannot parse start="25:00", it must have HH:MM format: parsing time "25:00": hour out of range`),
+			Entry("too long duration", VMOperatorSettingsSpec{
+				MaintenanceWindows: []MaintenanceWindow{{Start: "01:00", Duration: "1h"}, {Start: "01:00", Duration: "200h"}},
+			}, `incorrect maintenanceWindows[1]: duration="200h" must be in range (0s ... 168h0m0s]`),
+			Entry("unknown day", VMOperatorSettingsSpec{
+				MaintenanceWindows: []MaintenanceWindow{{Days: []string{"Mon", "Funday"}, Start: "01:00", Duration: "1h"}},
+			}, `incorrect maintenanceWindows[0]: unsupported day="Funday", supported values: Mon, Tue, Wed, Thu, Fri, Sat, Sun`),
+			Entry("unknown time zone", VMOperatorSettingsSpec{
+				MaintenanceWindows: []MaintenanceWindow{{Start: "01:00", Duration: "1h", TimeZone: "Mars/Olympus"}},
+			}, `incorrect maintenanceWindows[0]: cannot load timeZone="Mars/Olympus": unknown time zone Mars/Olympus`),
+			Entry("empty label prefix", VMOperatorSettingsSpec{
+				Propagation: &PropagationPolicy{FilterLabelPrefixes: []string{"team/", ""}},
+			}, `propagation.filterLabelPrefixes cannot contain empty prefix`),
+		)
+		DescribeTable("passes validation",
+			func(spec VMOperatorSettingsSpec) {
+				os := VMOperatorSettings{ObjectMeta: metav1.ObjectMeta{Name: "settings"}, Spec: spec}
+				Expect(os.Validate()).To(Succeed())
+			},
+			Entry("all settings", VMOperatorSettingsSpec{
+				MaintenanceWindows: []MaintenanceWindow{
+					{Days: []string{"Sat", "Sun"}, Start: "02:00", Duration: "4h", TimeZone: "UTC"},
+					{Start: "23:30", Duration: "30m"},
+				},
+				Propagation: &PropagationPolicy{
+					FilterLabelPrefixes:      []string{"team/"},
+					FilterAnnotationPrefixes: []string{"argocd.argoproj.io/"},
+				},
+			}),
+		)
+	})
+})
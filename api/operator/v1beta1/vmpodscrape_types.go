@@ -36,6 +36,10 @@ type VMPodScrapeSpec struct {
 	// AttachMetadata configures metadata attaching from service discovery
 	// +optional
 	AttachMetadata AttachMetadata `json:"attach_metadata,omitempty"`
+	// ScrapeClass defines name of VMAgent scrape class applied to the VMPodScrape.
+	// Default scrape class of VMAgent is used if it's not set
+	// +optional
+	ScrapeClassName *string `json:"scrapeClass,omitempty"`
 }
 
 // VMPodScrape is scrape configuration for pods,
@@ -0,0 +1,75 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY VMPodScrape, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager will setup the manager to manage the webhooks
+func (r *VMPodScrape) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-operator-victoriametrics-com-v1beta1-vmpodscrape,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.victoriametrics.com,resources=vmpodscrapes,verbs=create;update,versions=v1beta1,name=vvmpodscrape.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &VMPodScrape{}
+
+// Validate performs symantic validation of object
+func (r *VMPodScrape) Validate() error {
+	if mustSkipValidation(r) {
+		return nil
+	}
+	for i := range r.Spec.PodMetricsEndpoints {
+		ep := &r.Spec.PodMetricsEndpoints[i]
+		if err := ep.EndpointRelabelings.validate(); err != nil {
+			return fmt.Errorf("at spec.podMetricsEndpoints[%d]: %w", i, err)
+		}
+		if err := ep.EndpointAuth.validate(); err != nil {
+			return fmt.Errorf("at spec.podMetricsEndpoints[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMPodScrape) ValidateCreate() (admission.Warnings, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMPodScrape) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *VMPodScrape) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,46 @@
+package v1beta1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("VMPodScrape Webhook", func() {
+	Context("When creating VMPodScrape under Validating Webhook", func() {
+		DescribeTable("fails validation",
+			func(spec VMPodScrapeSpec, wantErr string) {
+				ps := VMPodScrape{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}, Spec: spec}
+				Expect(ps.Validate()).To(MatchError(ContainSubstring(wantErr)))
+			},
+			Entry("bad relabel action", VMPodScrapeSpec{
+				PodMetricsEndpoints: []PodMetricsEndpoint{{
+					Port: "http",
+					EndpointRelabelings: EndpointRelabelings{
+						RelabelConfigs: []*RelabelConfig{{Action: "unknown"}},
+					},
+				}},
+			}, `at spec.podMetricsEndpoints[0]: bad relabelConfigs: cannot parse relabelConfigs`),
+			Entry("oauth2 without client id", VMPodScrapeSpec{
+				PodMetricsEndpoints: []PodMetricsEndpoint{{
+					Port:         "http",
+					EndpointAuth: EndpointAuth{OAuth2: &OAuth2{TokenURL: "http://oauth2/token"}},
+				}},
+			}, `at spec.podMetricsEndpoints[0]: bad oauth2: client_id field must be set`),
+		)
+		DescribeTable("passes validation",
+			func(spec VMPodScrapeSpec) {
+				ps := VMPodScrape{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}, Spec: spec}
+				Expect(ps.Validate()).To(Succeed())
+			},
+			Entry("relabelings", VMPodScrapeSpec{
+				PodMetricsEndpoints: []PodMetricsEndpoint{{
+					Port: "http",
+					EndpointRelabelings: EndpointRelabelings{
+						RelabelConfigs: []*RelabelConfig{{SourceLabels: []string{"__meta_kubernetes_pod_label_app"}, TargetLabel: "app"}},
+					},
+				}},
+			}),
+		)
+	})
+})
@@ -19,6 +19,7 @@ package v1beta1
 import (
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -28,7 +29,7 @@ type VMProbeSpec struct {
 	// The job name assigned to scraped metrics by default.
 	JobName string `json:"jobName,omitempty"`
 	// Specification for the prober to use for probing targets.
-	// The prober.URL parameter is required. Targets cannot be probed if left empty.
+	// Either prober.URL or prober.selector is required. Targets cannot be probed if both are left empty.
 	VMProberSpec VMProberSpec `json:"vmProberSpec"`
 	// The module to use for probing specifying how to probe the target.
 	// Example module configuring in the blackbox exporter:
@@ -78,8 +79,24 @@ type ProbeTargetIngress struct {
 // VMProberSpec contains specification parameters for the Prober used for probing.
 // +k8s:openapi-gen=true
 type VMProberSpec struct {
-	// Mandatory URL of the prober.
-	URL string `json:"url"`
+	// URL of the prober.
+	// Required if selector is not set.
+	// +optional
+	URL string `json:"url,omitempty"`
+	// Selector selects prober services at VMProbe namespace, e.g. multiple blackbox exporter instances.
+	// Probe targets are distributed across selected services with consistent hashing,
+	// so each target is probed by a single prober.
+	// It has priority over URL.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// Port name or number of selected prober services.
+	// Defaults to the first port of service.
+	// +optional
+	Port string `json:"port,omitempty"`
+	// ConfigMap key with prober configuration, e.g. blackbox exporter config.
+	// If set, module is validated against modules defined at configuration.
+	// +optional
+	ConfigMap *corev1.ConfigMapKeySelector `json:"configMap,omitempty"`
 	// HTTP scheme to use for scraping.
 	// Defaults to `http`.
 	// +optional
@@ -0,0 +1,81 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VMRelabelConfigSpec defines relabeling rules shared by scrape objects and VMAgent remoteWrite
+type VMRelabelConfigSpec struct {
+	// RelabelConfigs defines relabeling rules,
+	// stage of relabeling is defined by the field of referencing object
+	RelabelConfigs []RelabelConfig `json:"relabelConfigs"`
+	// ParsingError contents error with context if operator was failed to parse json object from kubernetes api server
+	ParsingError string `json:"-" yaml:"-"`
+}
+
+// VMRelabelConfig defines reusable relabeling rules, which could be referenced by name
+// from relabelConfigRefs and metricRelabelConfigRefs of scrape objects and urlRelabelConfigRefs of VMAgent remoteWrite
+// +operator-sdk:gen-csv:customresourcedefinitions.displayName="VMRelabelConfig"
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=vmrelabelconfigs,scope=Cluster
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+type VMRelabelConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VMRelabelConfigSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VMRelabelConfigList contains a list of VMRelabelConfig
+type VMRelabelConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VMRelabelConfig `json:"items"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (cr *VMRelabelConfig) UnmarshalJSON(src []byte) error {
+	type rccr VMRelabelConfig
+	if err := json.Unmarshal(src, (*rccr)(cr)); err != nil {
+		cr.Spec.ParsingError = fmt.Sprintf("cannot parse vmrelabelconfig: %s, err: %s", string(src), err)
+		return nil
+	}
+	return nil
+}
+
+// RelabelConfigPtrs returns pointers to copies of relabeling rules
+func (cr *VMRelabelConfig) RelabelConfigPtrs() []*RelabelConfig {
+	dst := make([]*RelabelConfig, 0, len(cr.Spec.RelabelConfigs))
+	for i := range cr.Spec.RelabelConfigs {
+		dst = append(dst, cr.Spec.RelabelConfigs[i].DeepCopy())
+	}
+	return dst
+}
+
+func init() {
+	SchemeBuilder.Register(&VMRelabelConfig{}, &VMRelabelConfigList{})
+}
@@ -0,0 +1,34 @@
+package v1beta1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("VMRelabelConfig Webhook", func() {
+	Context("When creating VMRelabelConfig under Validating Webhook", func() {
+		DescribeTable("fails validation",
+			func(spec VMRelabelConfigSpec, wantErr string) {
+				rc := VMRelabelConfig{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: spec}
+				Expect(rc.Validate()).To(MatchError(ContainSubstring(wantErr)))
+			},
+			Entry("empty relabel configs", VMRelabelConfigSpec{}, `relabelConfigs cannot be empty`),
+			Entry("bad relabel config", VMRelabelConfigSpec{
+				RelabelConfigs: []RelabelConfig{{Action: "drop", SourceLabels: []string{"__name__"}, Regex: StringOrArray{"[a-z"}}},
+			}, `bad relabelConfigs`),
+		)
+		DescribeTable("passes validation",
+			func(spec VMRelabelConfigSpec) {
+				rc := VMRelabelConfig{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: spec}
+				Expect(rc.Validate()).To(Succeed())
+			},
+			Entry("drop heavy metrics", VMRelabelConfigSpec{
+				RelabelConfigs: []RelabelConfig{
+					{Action: "drop", SourceLabels: []string{"__name__"}, Regex: StringOrArray{"apiserver_request_duration_seconds_bucket"}},
+					{Action: "labeldrop", Regex: StringOrArray{"pod_template_hash"}},
+				},
+			}),
+		)
+	})
+})
@@ -0,0 +1,121 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VMRemoteWriteTargetSpec defines remote storage destination shared by VMAgents
+type VMRemoteWriteTargetSpec struct {
+	// URL of the endpoint to send samples to.
+	URL string `json:"url"`
+	// SecretsNamespace defines namespace of Secrets and ConfigMaps referenced by basicAuth, bearerTokenSecret, oauth2 and tlsConfig.
+	// Namespace of referencing VMAgent is used if empty.
+	// +optional
+	SecretsNamespace string `json:"secretsNamespace,omitempty"`
+	// BasicAuth allow an endpoint to authenticate over basic authentication
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
+	// Optional bearer auth token to use for -remoteWrite.url
+	// +optional
+	BearerTokenSecret *v1.SecretKeySelector `json:"bearerTokenSecret,omitempty"`
+	// OAuth2 defines auth configuration
+	// +optional
+	OAuth2 *OAuth2 `json:"oauth2,omitempty"`
+	// TLSConfig describes tls configuration for remote write target
+	// +optional
+	TLSConfig *TLSConfig `json:"tlsConfig,omitempty"`
+	// Timeout for sending a single block of data to -remoteWrite.url (default 1m0s)
+	// +optional
+	// +kubebuilder:validation:Pattern:="[0-9]+(ms|s|m|h)"
+	SendTimeout *string `json:"sendTimeout,omitempty"`
+	// Headers allow configuring custom http headers
+	// Must be in form of semicolon separated header with value
+	// e.g.
+	// headerName: headerValue
+	// +optional
+	Headers []string `json:"headers,omitempty"`
+	// RateLimit defines limit in bytes per second for data sent to -remoteWrite.url
+	// +optional
+	RateLimit *int64 `json:"rateLimit,omitempty"`
+	// ParsingError contents error with context if operator was failed to parse json object from kubernetes api server
+	ParsingError string `json:"-" yaml:"-"`
+}
+
+// VMRemoteWriteTarget defines remote write destination, which could be referenced by VMAgents with remoteWrite.targetRef
+// +operator-sdk:gen-csv:customresourcedefinitions.displayName="VMRemoteWriteTarget"
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=vmremotewritetargets,scope=Cluster
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.url"
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+type VMRemoteWriteTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VMRemoteWriteTargetSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VMRemoteWriteTargetList contains a list of VMRemoteWriteTarget
+type VMRemoteWriteTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VMRemoteWriteTarget `json:"items"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (cr *VMRemoteWriteTarget) UnmarshalJSON(src []byte) error {
+	type rwtcr VMRemoteWriteTarget
+	if err := json.Unmarshal(src, (*rwtcr)(cr)); err != nil {
+		cr.Spec.ParsingError = fmt.Sprintf("cannot parse vmremotewritetarget: %s, err: %s", string(src), err)
+		return nil
+	}
+	return nil
+}
+
+// ApplyTo fills endpoint settings of remoteWrite, which references target.
+// sendTimeout, headers and rateLimit defined at remoteWrite have priority over target settings
+func (cr *VMRemoteWriteTarget) ApplyTo(rw *VMAgentRemoteWriteSpec) {
+	rw.URL = cr.Spec.URL
+	rw.SecretsNamespace = cr.Spec.SecretsNamespace
+	rw.BasicAuth = cr.Spec.BasicAuth.DeepCopy()
+	rw.BearerTokenSecret = cr.Spec.BearerTokenSecret.DeepCopy()
+	rw.OAuth2 = cr.Spec.OAuth2.DeepCopy()
+	rw.TLSConfig = cr.Spec.TLSConfig.DeepCopy()
+	if rw.SendTimeout == nil {
+		rw.SendTimeout = cr.Spec.SendTimeout
+	}
+	if len(rw.Headers) == 0 {
+		rw.Headers = cr.Spec.Headers
+	}
+	if rw.RateLimit == nil {
+		rw.RateLimit = cr.Spec.RateLimit
+	}
+}
+
+func init() {
+	SchemeBuilder.Register(&VMRemoteWriteTarget{}, &VMRemoteWriteTargetList{})
+}
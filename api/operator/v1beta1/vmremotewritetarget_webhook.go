@@ -0,0 +1,82 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager will setup the manager to manage the webhooks
+func (r *VMRemoteWriteTarget) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-operator-victoriametrics-com-v1beta1-vmremotewritetarget,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.victoriametrics.com,resources=vmremotewritetargets,verbs=create;update,versions=v1beta1,name=vvmremotewritetarget.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &VMRemoteWriteTarget{}
+
+// Validate performs symantic validation of object
+func (r *VMRemoteWriteTarget) Validate() error {
+	if mustSkipValidation(r) {
+		return nil
+	}
+	if r.Spec.URL == "" {
+		return fmt.Errorf("url cannot be empty")
+	}
+	if _, err := url.Parse(r.Spec.URL); err != nil {
+		return fmt.Errorf("cannot parse url=%q: %w", r.Spec.URL, err)
+	}
+	if r.Spec.RateLimit != nil && *r.Spec.RateLimit < 0 {
+		return fmt.Errorf("rateLimit cannot be negative, got: %d", *r.Spec.RateLimit)
+	}
+	return nil
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMRemoteWriteTarget) ValidateCreate() (admission.Warnings, error) {
+	if r.Spec.ParsingError != "" {
+		return nil, fmt.Errorf(r.Spec.ParsingError)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMRemoteWriteTarget) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	if r.Spec.ParsingError != "" {
+		return nil, fmt.Errorf(r.Spec.ParsingError)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *VMRemoteWriteTarget) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,42 @@
+package v1beta1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("VMRemoteWriteTarget Webhook", func() {
+	Context("When creating VMRemoteWriteTarget under Validating Webhook", func() {
+		DescribeTable("fails validation",
+			func(spec VMRemoteWriteTargetSpec, wantErr string) {
+				rwt := VMRemoteWriteTarget{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: spec}
+				Expect(rwt.Validate()).To(MatchError(wantErr))
+			},
+			Entry("empty url", VMRemoteWriteTargetSpec{}, `url cannot be empty`),
+			Entry("negative rate limit", VMRemoteWriteTargetSpec{
+				URL:       "http://vminsert:8480/insert/0/prometheus/api/v1/write",
+				RateLimit: ptr.To[int64](-1),
+			}, `rateLimit cannot be negative, got: -1`),
+		)
+		DescribeTable("passes validation",
+			func(spec VMRemoteWriteTargetSpec) {
+				rwt := VMRemoteWriteTarget{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: spec}
+				Expect(rwt.Validate()).To(Succeed())
+			},
+			Entry("all settings", VMRemoteWriteTargetSpec{
+				URL:              "https://vminsert:8480/insert/0/prometheus/api/v1/write",
+				SecretsNamespace: "monitoring",
+				BearerTokenSecret: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "remote-write-token"},
+					Key:                  "token",
+				},
+				SendTimeout: ptr.To("30s"),
+				Headers:     []string{"X-Scope: main"},
+				RateLimit:   ptr.To[int64](1048576),
+			}),
+		)
+	})
+})
@@ -0,0 +1,271 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// RuleTestPassed means, that the latest tests run finished successfully
+	RuleTestPassed = "Passed"
+	// RuleTestFailed means, that the latest tests run finished with failed tests
+	RuleTestFailed = "Failed"
+)
+
+// VMRuleTestSpec defines unit tests for VMRule objects
+type VMRuleTestSpec struct {
+	// Rules contains names of VMRule objects from the same namespace,
+	// which are used as rule files for tests
+	// +kubebuilder:validation:MinItems=1
+	Rules []string `json:"rules"`
+	// EvaluationInterval defines default evaluation interval for rule groups
+	// vmalert-tool uses 1m by default
+	// +optional
+	EvaluationInterval string `json:"evaluationInterval,omitempty"`
+	// GroupEvalOrder defines the order of group evaluation for alerting rules,
+	// which depend on results of other groups
+	// +optional
+	GroupEvalOrder []string `json:"groupEvalOrder,omitempty"`
+	// Tests contains list of test groups
+	// See https://docs.victoriametrics.com/vmalert-tool/#unit-testing-for-rules
+	// +kubebuilder:validation:MinItems=1
+	Tests []RuleTestGroup `json:"tests"`
+	// StrictMode excludes tested VMRules from VMAlert configuration
+	// if the latest tests run failed
+	// +optional
+	StrictMode bool `json:"strictMode,omitempty"`
+	// DisableAlertgroupLabel disables adding group name as label to generated alerts and time series
+	// +optional
+	DisableAlertgroupLabel bool `json:"disableAlertgroupLabel,omitempty"`
+	// Image - docker image settings for vmalert-tool
+	// if no specified operator uses default config version
+	// +optional
+	Image Image `json:"image,omitempty"`
+	// Resources container resource request and limits for tests Job,
+	// if not defined default resources from operator config will be used
+	// +optional
+	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+	// ParsingError contents error with context if operator was failed to parse json object from kubernetes api server
+	ParsingError string `json:"-" yaml:"-"`
+}
+
+// RuleTestGroup defines input series and expected results for rules evaluation
+type RuleTestGroup struct {
+	// Name of test group
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Interval between input series samples, evaluationInterval is used by default
+	// +optional
+	Interval string `json:"interval,omitempty"`
+	// InputSeries defines series ingested into storage before rules evaluation
+	// +optional
+	InputSeries []RuleTestInputSeries `json:"input_series,omitempty"`
+	// AlertRuleTests defines expected alerts at the given evaluation time
+	// +optional
+	AlertRuleTests []AlertRuleTestCase `json:"alert_rule_test,omitempty"`
+	// MetricsqlExprTests defines expected results of MetricsQL expressions at the given evaluation time
+	// +optional
+	MetricsqlExprTests []MetricsqlExprTestCase `json:"metricsql_expr_test,omitempty"`
+	// ExternalLabels defines labels, which are available for templating at rules annotations
+	// +optional
+	ExternalLabels map[string]string `json:"external_labels,omitempty"`
+}
+
+// RuleTestInputSeries defines series and its values in expanding notation
+type RuleTestInputSeries struct {
+	// Series in the following format `<metric name>{<label name>=<label value>, ...}`
+	Series string `json:"series"`
+	// Values in expanding notation, for example `1+1x10 _ stale`
+	Values string `json:"values"`
+}
+
+// AlertRuleTestCase defines expected alerts for alerting rule
+type AlertRuleTestCase struct {
+	// EvalTime is the offset from time zero, when alerts are checked
+	EvalTime string `json:"eval_time"`
+	// GroupName is name of rule group
+	GroupName string `json:"groupname"`
+	// AlertName is name of alerting rule
+	AlertName string `json:"alertname"`
+	// ExpAlerts defines expected firing alerts, empty list means no alerts
+	// +optional
+	ExpAlerts []RuleTestExpAlert `json:"exp_alerts,omitempty"`
+}
+
+// RuleTestExpAlert defines labels and annotations of expected alert
+type RuleTestExpAlert struct {
+	// ExpLabels defines expected alert labels
+	// +optional
+	ExpLabels map[string]string `json:"exp_labels,omitempty"`
+	// ExpAnnotations defines expected alert annotations
+	// +optional
+	ExpAnnotations map[string]string `json:"exp_annotations,omitempty"`
+}
+
+// MetricsqlExprTestCase defines expected result of MetricsQL expression
+type MetricsqlExprTestCase struct {
+	// Expr is MetricsQL expression to evaluate
+	Expr string `json:"expr"`
+	// EvalTime is the offset from time zero, when expression is evaluated
+	EvalTime string `json:"eval_time"`
+	// ExpSamples defines expected samples, empty list means no samples
+	// +optional
+	ExpSamples []RuleTestExpSample `json:"exp_samples,omitempty"`
+}
+
+// RuleTestExpSample defines expected sample of MetricsQL expression
+type RuleTestExpSample struct {
+	// Labels of sample in the following format `<metric name>{<label name>=<label value>, ...}`
+	Labels string `json:"labels"`
+	// Value of sample, must be a valid float number
+	Value string `json:"value"`
+}
+
+// VMRuleTestStatus defines the observed state of VMRuleTest
+type VMRuleTestStatus struct {
+	// Status defines CRD processing status
+	Status UpdateStatus `json:"status,omitempty"`
+	// Result of the latest finished tests run, Passed or Failed
+	Result string `json:"result,omitempty"`
+	// Message contains output of the latest failed tests run
+	Message string `json:"message,omitempty"`
+	// TestedHash is a hash of rule files and tests used for the latest finished tests run
+	TestedHash string `json:"testedHash,omitempty"`
+	// LastSyncError contains error message for unsuccessful tests run
+	LastSyncError string `json:"lastSyncError,omitempty"`
+}
+
+// VMRuleTest defines unit tests for VMRule objects, which are evaluated by vmalert-tool
+// +operator-sdk:gen-csv:customresourcedefinitions.displayName="VMRuleTest"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=vmruletests,scope=Namespaced
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Result",type="string",JSONPath=".status.result"
+// +kubebuilder:printcolumn:name="Strict",type="boolean",JSONPath=".spec.strictMode"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.status"
+// +kubebuilder:printcolumn:name="Sync Error",type="string",JSONPath=".status.lastSyncError"
+// +genclient
+// +k8s:openapi-gen=true
+type VMRuleTest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VMRuleTestSpec   `json:"spec,omitempty"`
+	Status VMRuleTestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VMRuleTestList contains a list of VMRuleTest
+type VMRuleTestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VMRuleTest `json:"items"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (cr *VMRuleTest) UnmarshalJSON(src []byte) error {
+	type rtcr VMRuleTest
+	if err := json.Unmarshal(src, (*rtcr)(cr)); err != nil {
+		cr.Spec.ParsingError = fmt.Sprintf("cannot parse vmruletest: %s, err: %s", string(src), err)
+		return nil
+	}
+	return nil
+}
+
+// AsKey returns unique key for object
+func (cr *VMRuleTest) AsKey() string {
+	return fmt.Sprintf("%s/%s", cr.Namespace, cr.Name)
+}
+
+// PrefixedName returns name of tests ConfigMap and Job
+func (cr *VMRuleTest) PrefixedName() string {
+	return fmt.Sprintf("vmruletest-%s", cr.Name)
+}
+
+// IsBlocksRule checks if VMRule with given name must be excluded from VMAlert configuration
+func (cr *VMRuleTest) IsBlocksRule(name string) bool {
+	if !cr.Spec.StrictMode || cr.Status.Result != RuleTestFailed || !cr.DeletionTimestamp.IsZero() {
+		return false
+	}
+	for _, rule := range cr.Spec.Rules {
+		if rule == name {
+			return true
+		}
+	}
+	return false
+}
+
+// AsOwner returns owner references with current object as owner
+func (cr *VMRuleTest) AsOwner() []metav1.OwnerReference {
+	return []metav1.OwnerReference{
+		{
+			APIVersion:         cr.APIVersion,
+			Kind:               cr.Kind,
+			Name:               cr.Name,
+			UID:                cr.UID,
+			Controller:         ptr.To(true),
+			BlockOwnerDeletion: ptr.To(true),
+		},
+	}
+}
+
+// AnnotationsFiltered returns global annotations to be applied by objects generated for vmruletest
+func (cr *VMRuleTest) AnnotationsFiltered() map[string]string {
+	annotations := make(map[string]string)
+	for annotation, value := range cr.Annotations {
+		if !strings.HasPrefix(annotation, "kubectl.kubernetes.io/") {
+			annotations[annotation] = value
+		}
+	}
+	return annotations
+}
+
+// SelectorLabels returns selector labels for vmruletest objects
+func (cr *VMRuleTest) SelectorLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":      "vmruletest",
+		"app.kubernetes.io/instance":  cr.Name,
+		"app.kubernetes.io/component": "monitoring",
+		"managed-by":                  "vm-operator",
+	}
+}
+
+// AllLabels returns combined labels for VMRuleTest
+func (cr *VMRuleTest) AllLabels() map[string]string {
+	labels := cr.SelectorLabels()
+	for label, value := range cr.Labels {
+		if _, ok := labels[label]; ok {
+			// forbid changes for selector labels
+			continue
+		}
+		labels[label] = value
+	}
+	return labels
+}
+
+func init() {
+	SchemeBuilder.Register(&VMRuleTest{}, &VMRuleTestList{})
+}
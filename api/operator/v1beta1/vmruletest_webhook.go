@@ -0,0 +1,150 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/VictoriaMetrics/VictoriaMetrics/lib/promutils"
+	"github.com/VictoriaMetrics/metricsql"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager will setup the manager to manage the webhooks
+func (r *VMRuleTest) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-operator-victoriametrics-com-v1beta1-vmruletest,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.victoriametrics.com,resources=vmruletests,verbs=create;update,versions=v1beta1,name=vvmruletest.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &VMRuleTest{}
+
+// Validate performs symantic validation of object
+func (r *VMRuleTest) Validate() error {
+	if mustSkipValidation(r) {
+		return nil
+	}
+	if len(r.Spec.Rules) == 0 {
+		return fmt.Errorf("rules cannot be empty")
+	}
+	uniqRules := make(map[string]struct{}, len(r.Spec.Rules))
+	for _, rule := range r.Spec.Rules {
+		if rule == "" {
+			return fmt.Errorf("rule name cannot be empty")
+		}
+		if _, ok := uniqRules[rule]; ok {
+			return fmt.Errorf("duplicate rule name=%q", rule)
+		}
+		uniqRules[rule] = struct{}{}
+	}
+	if len(r.Spec.Tests) == 0 {
+		return fmt.Errorf("tests cannot be empty")
+	}
+	if err := validateRuleTestDuration(r.Spec.EvaluationInterval); err != nil {
+		return fmt.Errorf("incorrect evaluationInterval: %w", err)
+	}
+	for i, tg := range r.Spec.Tests {
+		if err := tg.validate(); err != nil {
+			return fmt.Errorf("incorrect test group at idx=%d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (tg *RuleTestGroup) validate() error {
+	if err := validateRuleTestDuration(tg.Interval); err != nil {
+		return fmt.Errorf("incorrect interval: %w", err)
+	}
+	for _, is := range tg.InputSeries {
+		if _, err := metricsql.Parse(is.Series); err != nil {
+			return fmt.Errorf("cannot parse input series=%q: %w", is.Series, err)
+		}
+		if is.Values == "" {
+			return fmt.Errorf("values for input series=%q cannot be empty", is.Series)
+		}
+	}
+	for _, at := range tg.AlertRuleTests {
+		if at.AlertName == "" || at.GroupName == "" {
+			return fmt.Errorf("alertname and groupname cannot be empty for alert_rule_test")
+		}
+		if err := validateRuleTestEvalTime(at.EvalTime); err != nil {
+			return fmt.Errorf("incorrect eval_time for alertname=%q: %w", at.AlertName, err)
+		}
+	}
+	for _, mt := range tg.MetricsqlExprTests {
+		if _, err := metricsql.Parse(mt.Expr); err != nil {
+			return fmt.Errorf("cannot parse expr=%q: %w", mt.Expr, err)
+		}
+		if err := validateRuleTestEvalTime(mt.EvalTime); err != nil {
+			return fmt.Errorf("incorrect eval_time for expr=%q: %w", mt.Expr, err)
+		}
+		for _, s := range mt.ExpSamples {
+			if _, err := strconv.ParseFloat(s.Value, 64); err != nil {
+				return fmt.Errorf("cannot parse value=%q of expected sample=%q: %w", s.Value, s.Labels, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateRuleTestDuration(d string) error {
+	if d == "" {
+		return nil
+	}
+	_, err := promutils.ParseDuration(d)
+	return err
+}
+
+func validateRuleTestEvalTime(d string) error {
+	if d == "" {
+		return fmt.Errorf("eval_time cannot be empty")
+	}
+	return validateRuleTestDuration(d)
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMRuleTest) ValidateCreate() (admission.Warnings, error) {
+	if r.Spec.ParsingError != "" {
+		return nil, fmt.Errorf(r.Spec.ParsingError)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMRuleTest) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	if r.Spec.ParsingError != "" {
+		return nil, fmt.Errorf(r.Spec.ParsingError)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *VMRuleTest) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,81 @@
+package v1beta1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("VMRuleTest Webhook", func() {
+	Context("When creating VMRuleTest under Validating Webhook", func() {
+		DescribeTable("fails validation",
+			func(spec VMRuleTestSpec, wantErr string) {
+				rt := VMRuleTest{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: spec}
+				Expect(rt.Validate()).To(MatchError(wantErr))
+			},
+			Entry("empty rules", VMRuleTestSpec{
+				Tests: []RuleTestGroup{{}},
+			}, `rules cannot be empty`),
+			Entry("duplicate rule", VMRuleTestSpec{
+				Rules: []string{"node-alerts", "node-alerts"},
+				Tests: []RuleTestGroup{{}},
+			}, `duplicate rule name="node-alerts"`),
+			Entry("empty tests", VMRuleTestSpec{
+				Rules: []string{"node-alerts"},
+			}, `tests cannot be empty`),
+			Entry("bad evaluation interval", VMRuleTestSpec{
+				Rules:              []string{"node-alerts"},
+				EvaluationInterval: "1minute",
+				Tests:              []RuleTestGroup{{}},
+			}, `incorrect evaluationInterval: cannot parse duration "1minute"`),
+			Entry("bad input series", VMRuleTestSpec{
+				Rules: []string{"node-alerts"},
+				Tests: []RuleTestGroup{{
+					InputSeries: []RuleTestInputSeries{{Series: `up{job="node"`, Values: "1x10"}},
+				}},
+			}, `incorrect test group at idx=0: cannot parse input series="up{job=\"node\"": labelFilters: unexpected token ""; want ",", "or", "}"; unparsed data: ""`),
+			Entry("missing eval time", VMRuleTestSpec{
+				Rules: []string{"node-alerts"},
+				Tests: []RuleTestGroup{{
+					AlertRuleTests: []AlertRuleTestCase{{GroupName: "node", AlertName: "NodeDown"}},
+				}},
+			}, `incorrect test group at idx=0: incorrect eval_time for alertname="NodeDown": eval_time cannot be empty`),
+			Entry("bad sample value", VMRuleTestSpec{
+				Rules: []string{"node-alerts"},
+				Tests: []RuleTestGroup{{
+					MetricsqlExprTests: []MetricsqlExprTestCase{{
+						Expr:       "up",
+						EvalTime:   "5m",
+						ExpSamples: []RuleTestExpSample{{Labels: `up{job="node"}`, Value: "one"}},
+					}},
+				}},
+			}, `incorrect test group at idx=0: cannot parse value="one" of expected sample="up{job=\"node\"}": strconv.ParseFloat: parsing "one": invalid syntax`),
+		)
+		DescribeTable("passes validation",
+			func(spec VMRuleTestSpec) {
+				rt := VMRuleTest{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: spec}
+				Expect(rt.Validate()).To(Succeed())
+			},
+			Entry("alert and expr tests", VMRuleTestSpec{
+				Rules:              []string{"node-alerts"},
+				EvaluationInterval: "1m",
+				StrictMode:         true,
+				Tests: []RuleTestGroup{{
+					Interval:    "1m",
+					InputSeries: []RuleTestInputSeries{{Series: `up{job="node"}`, Values: "0x10"}},
+					AlertRuleTests: []AlertRuleTestCase{{
+						EvalTime:  "5m",
+						GroupName: "node",
+						AlertName: "NodeDown",
+						ExpAlerts: []RuleTestExpAlert{{ExpLabels: map[string]string{"job": "node"}}},
+					}},
+					MetricsqlExprTests: []MetricsqlExprTestCase{{
+						Expr:       "up == 0",
+						EvalTime:   "5m",
+						ExpSamples: []RuleTestExpSample{{Labels: `up{job="node"}`, Value: "0"}},
+					}},
+				}},
+			}),
+		)
+	})
+})
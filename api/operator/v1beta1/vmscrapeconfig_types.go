@@ -75,9 +75,24 @@ type VMScrapeConfigSpec struct {
 	// DigitalOceanSDConfigs defines a list of DigitalOcean service discovery configurations.
 	// +optional
 	DigitalOceanSDConfigs []DigitalOceanSDConfig `json:"digitalOceanSDConfigs,omitempty"`
-	EndpointScrapeParams  `json:",inline"`
-	EndpointRelabelings   `json:",inline"`
-	EndpointAuth          `json:",inline"`
+	// DockerSDConfigs defines a list of Docker service discovery configurations.
+	// +optional
+	DockerSDConfigs []DockerSDConfig `json:"dockerSDConfigs,omitempty"`
+	// NomadSDConfigs defines a list of Nomad service discovery configurations.
+	// +optional
+	NomadSDConfigs []NomadSDConfig `json:"nomadSDConfigs,omitempty"`
+	// PuppetDBSDConfigs defines a list of PuppetDB service discovery configurations.
+	// +optional
+	PuppetDBSDConfigs []PuppetDBSDConfig `json:"puppetDBSDConfigs,omitempty"`
+	// HetznerSDConfigs defines a list of Hetzner service discovery configurations.
+	// +optional
+	HetznerSDConfigs []HetznerSDConfig `json:"hetznerSDConfigs,omitempty"`
+	// OVHCloudSDConfigs defines a list of OVHcloud service discovery configurations.
+	// +optional
+	OVHCloudSDConfigs    []OVHCloudSDConfig `json:"ovhcloudSDConfigs,omitempty"`
+	EndpointScrapeParams `json:",inline"`
+	EndpointRelabelings  `json:",inline"`
+	EndpointAuth         `json:",inline"`
 }
 
 // StaticConfig defines a static configuration.
@@ -490,6 +505,210 @@ type DigitalOceanSDConfig struct {
 	Port *int `json:"port,omitempty"`
 }
 
+// DockerSDConfig allows retrieving scrape targets from Docker containers.
+// Each exposed port of the container is discovered as a separate target.
+// See [here](https://docs.victoriametrics.com/sd_configs#docker_sd_configs)
+// +k8s:openapi-gen=true
+type DockerSDConfig struct {
+	// Address of the Docker daemon, e.g. unix:///var/run/docker.sock or tcp://docker:2375
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Host string `json:"host"`
+	// The port to scrape metrics from, when the container has no exposed ports.
+	// +optional
+	Port *int `json:"port,omitempty"`
+	// HostNetworkingHost is the host used for containers with host networking mode.
+	// +optional
+	HostNetworkingHost *string `json:"hostNetworkingHost,omitempty"`
+	// MatchFirstNetwork defines whether to use only the first network of container connected to multiple networks.
+	// +optional
+	MatchFirstNetwork *bool `json:"matchFirstNetwork,omitempty"`
+	// Filters to limit the discovery process to a subset of available resources.
+	// See https://docs.docker.com/engine/api/v1.40/#operation/ContainerList
+	// +optional
+	Filters []DockerSDFilter `json:"filters,omitempty"`
+	// BasicAuth information to use on every scrape request.
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
+	// Authorization header to use on every scrape request.
+	// +optional
+	Authorization *Authorization `json:"authorization,omitempty"`
+	// OAuth2 defines auth configuration
+	// +optional
+	OAuth2 *OAuth2 `json:"oauth2,omitempty"`
+	// ProxyURL eg http://proxyserver:2195 Directs scrapes to proxy through this endpoint.
+	// +optional
+	ProxyURL *string `json:"proxyURL,omitempty"`
+	// ProxyClientConfig configures proxy auth settings for scraping
+	// See [feature description](https://docs.victoriametrics.com/vmagent#scraping-targets-via-a-proxy)
+	// +optional
+	ProxyClientConfig *ProxyAuth `json:"proxy_client_config,omitempty"`
+	// Configure whether HTTP requests follow HTTP 3xx redirects.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// TLS configuration to use on every scrape request
+	// +optional
+	TLSConfig *TLSConfig `json:"tlsConfig,omitempty"`
+}
+
+// DockerSDFilter is the configuration for filtering Docker containers.
+type DockerSDFilter struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// NomadSDConfig allows retrieving scrape targets from HashiCorp Nomad services.
+// See [here](https://docs.victoriametrics.com/sd_configs#nomad_sd_configs)
+// +k8s:openapi-gen=true
+type NomadSDConfig struct {
+	// A valid string consisting of a hostname or IP followed by an optional port number.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Server string `json:"server"`
+	// Nomad namespace, if not provided default namespace is used.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+	// Nomad region, if not provided default region is used.
+	// +optional
+	Region *string `json:"region,omitempty"`
+	// The string by which Nomad tags are joined into the tag label.
+	// If unset, use its default value.
+	// +optional
+	TagSeparator *string `json:"tagSeparator,omitempty"`
+	// Allow stale Nomad results. Will reduce load on Nomad.
+	// If unset, use its default value.
+	// +optional
+	AllowStale *bool `json:"allowStale,omitempty"`
+	// BasicAuth information to use on every scrape request.
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
+	// Authorization header to use on every scrape request.
+	// +optional
+	Authorization *Authorization `json:"authorization,omitempty"`
+	// OAuth2 defines auth configuration
+	// +optional
+	OAuth2 *OAuth2 `json:"oauth2,omitempty"`
+	// ProxyURL eg http://proxyserver:2195 Directs scrapes to proxy through this endpoint.
+	// +optional
+	ProxyURL *string `json:"proxyURL,omitempty"`
+	// ProxyClientConfig configures proxy auth settings for scraping
+	// See [feature description](https://docs.victoriametrics.com/vmagent#scraping-targets-via-a-proxy)
+	// +optional
+	ProxyClientConfig *ProxyAuth `json:"proxy_client_config,omitempty"`
+	// Configure whether HTTP requests follow HTTP 3xx redirects.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// TLS configuration to use on every scrape request
+	// +optional
+	TLSConfig *TLSConfig `json:"tlsConfig,omitempty"`
+}
+
+// PuppetDBSDConfig allows retrieving scrape targets from PuppetDB resources.
+// See [here](https://docs.victoriametrics.com/sd_configs#puppetdb_sd_configs)
+// +k8s:openapi-gen=true
+type PuppetDBSDConfig struct {
+	// URL of the PuppetDB root query endpoint.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:Pattern:="^http(s)?://.+$"
+	// +required
+	URL string `json:"url"`
+	// Puppet Query Language (PQL) query. Only resources are supported.
+	// See https://puppet.com/docs/puppetdb/latest/api/query/v4/pql.html
+	// +kubebuilder:validation:MinLength:=1
+	// +required
+	Query string `json:"query"`
+	// Whether to include the parameters as meta labels.
+	// Note: Enabling this exposes parameters in the vmagent UI and API.
+	// +optional
+	IncludeParameters *bool `json:"includeParameters,omitempty"`
+	// The port to scrape metrics from.
+	// +optional
+	Port *int `json:"port,omitempty"`
+	// BasicAuth information to use on every scrape request.
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
+	// Authorization header to use on every scrape request.
+	// +optional
+	Authorization *Authorization `json:"authorization,omitempty"`
+	// OAuth2 defines auth configuration
+	// +optional
+	OAuth2 *OAuth2 `json:"oauth2,omitempty"`
+	// ProxyURL eg http://proxyserver:2195 Directs scrapes to proxy through this endpoint.
+	// +optional
+	ProxyURL *string `json:"proxyURL,omitempty"`
+	// ProxyClientConfig configures proxy auth settings for scraping
+	// See [feature description](https://docs.victoriametrics.com/vmagent#scraping-targets-via-a-proxy)
+	// +optional
+	ProxyClientConfig *ProxyAuth `json:"proxy_client_config,omitempty"`
+	// Configure whether HTTP requests follow HTTP 3xx redirects.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// TLS configuration to use on every scrape request
+	// +optional
+	TLSConfig *TLSConfig `json:"tlsConfig,omitempty"`
+}
+
+// HetznerSDConfig allows retrieving scrape targets from Hetzner Cloud API and Robot API.
+// Hetzner Cloud API requires authorization, Robot API requires basicAuth.
+// See [here](https://docs.victoriametrics.com/sd_configs#hetzner_sd_configs)
+// +k8s:openapi-gen=true
+type HetznerSDConfig struct {
+	// The Hetzner role of entities that should be discovered.
+	// +kubebuilder:validation:Enum=hcloud;robot
+	// +required
+	Role string `json:"role"`
+	// The port to scrape metrics from.
+	// +optional
+	Port *int `json:"port,omitempty"`
+	// BasicAuth information to use on every scrape request.
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
+	// Authorization header to use on every scrape request.
+	// +optional
+	Authorization *Authorization `json:"authorization,omitempty"`
+	// OAuth2 defines auth configuration
+	// +optional
+	OAuth2 *OAuth2 `json:"oauth2,omitempty"`
+	// ProxyURL eg http://proxyserver:2195 Directs scrapes to proxy through this endpoint.
+	// +optional
+	ProxyURL *string `json:"proxyURL,omitempty"`
+	// ProxyClientConfig configures proxy auth settings for scraping
+	// See [feature description](https://docs.victoriametrics.com/vmagent#scraping-targets-via-a-proxy)
+	// +optional
+	ProxyClientConfig *ProxyAuth `json:"proxy_client_config,omitempty"`
+	// Configure whether HTTP requests follow HTTP 3xx redirects.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// TLS configuration to use on every scrape request
+	// +optional
+	TLSConfig *TLSConfig `json:"tlsConfig,omitempty"`
+}
+
+// OVHCloudSDConfig allows retrieving scrape targets from OVHcloud VPS and dedicated servers.
+// See [here](https://docs.victoriametrics.com/sd_configs#ovhcloud_sd_configs)
+// +k8s:openapi-gen=true
+type OVHCloudSDConfig struct {
+	// Endpoint of OVH API, either API url or endpoint name.
+	// If unset, ovh-eu is used.
+	// +optional
+	Endpoint *string `json:"endpoint,omitempty"`
+	// ApplicationKey is a self generated tokens.
+	// Create one by following https://github.com/ovh/go-ovh#supported-apis
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	ApplicationKey string `json:"applicationKey"`
+	// ApplicationSecret holds secret for the ApplicationKey.
+	// +required
+	ApplicationSecret *corev1.SecretKeySelector `json:"applicationSecret"`
+	// ConsumerKey holds user/app specific token.
+	// +required
+	ConsumerKey *corev1.SecretKeySelector `json:"consumerKey"`
+	// Service of the targets to retrieve.
+	// +kubebuilder:validation:Enum=vps;dedicated_server
+	// +required
+	Service string `json:"service"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // VMScrapeConfigList contains a list of VMScrapeConfig
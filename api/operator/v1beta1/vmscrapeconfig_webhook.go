@@ -0,0 +1,117 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY VMScrapeConfig, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager will setup the manager to manage the webhooks
+func (r *VMScrapeConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-operator-victoriametrics-com-v1beta1-vmscrapeconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.victoriametrics.com,resources=vmscrapeconfigs,verbs=create;update,versions=v1beta1,name=vvmscrapeconfig.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &VMScrapeConfig{}
+
+// Validate performs symantic validation of object
+func (r *VMScrapeConfig) Validate() error {
+	if mustSkipValidation(r) {
+		return nil
+	}
+	if err := r.Spec.EndpointRelabelings.validate(); err != nil {
+		return err
+	}
+	if err := r.Spec.EndpointAuth.validate(); err != nil {
+		return err
+	}
+	for i, sc := range r.Spec.HTTPSDConfigs {
+		if err := validateTLSAndOAuth2(sc.TLSConfig, nil); err != nil {
+			return fmt.Errorf("at spec.httpSDConfigs[%d]: %w", i, err)
+		}
+	}
+	for i, sc := range r.Spec.KubernetesSDConfigs {
+		if err := validateTLSAndOAuth2(sc.TLSConfig, sc.OAuth2); err != nil {
+			return fmt.Errorf("at spec.kubernetesSDConfigs[%d]: %w", i, err)
+		}
+	}
+	for i, sc := range r.Spec.ConsulSDConfigs {
+		if err := validateTLSAndOAuth2(sc.TLSConfig, sc.OAuth2); err != nil {
+			return fmt.Errorf("at spec.consulSDConfigs[%d]: %w", i, err)
+		}
+	}
+	for i, sc := range r.Spec.OpenStackSDConfigs {
+		if err := validateTLSAndOAuth2(sc.TLSConfig, nil); err != nil {
+			return fmt.Errorf("at spec.openstackSDConfigs[%d]: %w", i, err)
+		}
+	}
+	for i, sc := range r.Spec.DigitalOceanSDConfigs {
+		if err := validateTLSAndOAuth2(sc.TLSConfig, sc.OAuth2); err != nil {
+			return fmt.Errorf("at spec.digitalOceanSDConfigs[%d]: %w", i, err)
+		}
+	}
+	for i, sc := range r.Spec.DockerSDConfigs {
+		if err := validateTLSAndOAuth2(sc.TLSConfig, sc.OAuth2); err != nil {
+			return fmt.Errorf("at spec.dockerSDConfigs[%d]: %w", i, err)
+		}
+	}
+	for i, sc := range r.Spec.NomadSDConfigs {
+		if err := validateTLSAndOAuth2(sc.TLSConfig, sc.OAuth2); err != nil {
+			return fmt.Errorf("at spec.nomadSDConfigs[%d]: %w", i, err)
+		}
+	}
+	for i, sc := range r.Spec.PuppetDBSDConfigs {
+		if err := validateTLSAndOAuth2(sc.TLSConfig, sc.OAuth2); err != nil {
+			return fmt.Errorf("at spec.puppetDBSDConfigs[%d]: %w", i, err)
+		}
+	}
+	for i, sc := range r.Spec.HetznerSDConfigs {
+		if err := validateTLSAndOAuth2(sc.TLSConfig, sc.OAuth2); err != nil {
+			return fmt.Errorf("at spec.hetznerSDConfigs[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMScrapeConfig) ValidateCreate() (admission.Warnings, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMScrapeConfig) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *VMScrapeConfig) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,48 @@
+package v1beta1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("VMScrapeConfig Webhook", func() {
+	Context("When creating VMScrapeConfig under Validating Webhook", func() {
+		DescribeTable("fails validation",
+			func(spec VMScrapeConfigSpec, wantErr string) {
+				sc := VMScrapeConfig{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}, Spec: spec}
+				Expect(sc.Validate()).To(MatchError(ContainSubstring(wantErr)))
+			},
+			Entry("bad metric relabel action", VMScrapeConfigSpec{
+				EndpointRelabelings: EndpointRelabelings{
+					MetricRelabelConfigs: []*RelabelConfig{{Action: "unknown"}},
+				},
+			}, `bad metricRelabelConfigs: cannot parse relabelConfigs`),
+			Entry("kubernetes sd tls with both cert and cert file", VMScrapeConfigSpec{
+				KubernetesSDConfigs: []KubernetesSDConfig{{
+					Role: "pod",
+					TLSConfig: &TLSConfig{
+						CertFile: "/etc/tls.crt",
+						Cert:     SecretOrConfigMap{Secret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "tls"}, Key: "cert"}},
+					},
+				}},
+			}, `at spec.kubernetesSDConfigs[0]: bad tlsConfig: tls config can not both specify CertFile and Cert`),
+			Entry("consul sd oauth2 without token url", VMScrapeConfigSpec{
+				ConsulSDConfigs: []ConsulSDConfig{{Server: "consul:8500", OAuth2: &OAuth2{}}},
+			}, `at spec.consulSDConfigs[0]: bad oauth2: token_url field for oauth2 config must be set`),
+		)
+		DescribeTable("passes validation",
+			func(spec VMScrapeConfigSpec) {
+				sc := VMScrapeConfig{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}, Spec: spec}
+				Expect(sc.Validate()).To(Succeed())
+			},
+			Entry("static configs with relabelings", VMScrapeConfigSpec{
+				StaticConfigs: []StaticConfig{{Targets: []string{"host:9100"}}},
+				EndpointRelabelings: EndpointRelabelings{
+					RelabelConfigs: []*RelabelConfig{{SourceLabels: []string{"__address__"}, TargetLabel: "instance"}},
+				},
+			}),
+		)
+	})
+})
@@ -0,0 +1,112 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// VMScrapeGlobalConfigSpec defines default scrape settings for VMAgents.
+// Settings are merged into generated configuration with the following precedence, from highest to lowest:
+// scrape object (VMServiceScrape, VMPodScrape and others) fields, VMAgent spec fields,
+// VMScrapeGlobalConfig fields and operator defaults.
+type VMScrapeGlobalConfigSpec struct {
+	// VMAgentSelector selects VMAgents, which use this config.
+	// Empty selector matches all VMAgents.
+	// If VMAgent is matched by multiple configs, the first one ordered by name is used
+	// +optional
+	VMAgentSelector *metav1.LabelSelector `json:"vmAgentSelector,omitempty"`
+	// ScrapeInterval defines default scrape interval for VMAgents without spec.scrapeInterval
+	// +optional
+	ScrapeInterval string `json:"scrapeInterval,omitempty"`
+	// ScrapeTimeout defines default scrape timeout for VMAgents without spec.scrapeTimeout
+	// +optional
+	ScrapeTimeout string `json:"scrapeTimeout,omitempty"`
+	// ExternalLabels defines labels added to any time series scraped by vmagent.
+	// Labels defined at VMAgent spec.externalLabels have priority over it
+	// +optional
+	ExternalLabels map[string]string `json:"externalLabels,omitempty"`
+	// SampleLimit defines per-scrape limit on number of scraped samples
+	// for generated jobs without sampleLimit
+	// +optional
+	SampleLimit uint64 `json:"sampleLimit,omitempty"`
+	// SeriesLimit defines per-scrape limit on number of unique time series
+	// for generated jobs without seriesLimit
+	// +optional
+	SeriesLimit uint64 `json:"seriesLimit,omitempty"`
+	// MaxScrapeSize defines a maximum size of scraped data
+	// for generated jobs without max_scrape_size
+	// +optional
+	MaxScrapeSize string `json:"maxScrapeSize,omitempty"`
+	// ParsingError contents error with context if operator was failed to parse json object from kubernetes api server
+	ParsingError string `json:"-" yaml:"-"`
+}
+
+// VMScrapeGlobalConfig defines default scrape settings merged into configuration of selected VMAgents
+// +operator-sdk:gen-csv:customresourcedefinitions.displayName="VMScrapeGlobalConfig"
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=vmscrapeglobalconfigs,scope=Cluster
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Scrape Interval",type="string",JSONPath=".spec.scrapeInterval"
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+type VMScrapeGlobalConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VMScrapeGlobalConfigSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VMScrapeGlobalConfigList contains a list of VMScrapeGlobalConfig
+type VMScrapeGlobalConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VMScrapeGlobalConfig `json:"items"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (cr *VMScrapeGlobalConfig) UnmarshalJSON(src []byte) error {
+	type gccr VMScrapeGlobalConfig
+	if err := json.Unmarshal(src, (*gccr)(cr)); err != nil {
+		cr.Spec.ParsingError = fmt.Sprintf("cannot parse vmscrapeglobalconfig: %s, err: %s", string(src), err)
+		return nil
+	}
+	return nil
+}
+
+// MatchesVMAgent checks if config is applied to the given VMAgent
+func (cr *VMScrapeGlobalConfig) MatchesVMAgent(vmagent *VMAgent) (bool, error) {
+	if cr.Spec.VMAgentSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(cr.Spec.VMAgentSelector)
+	if err != nil {
+		return false, fmt.Errorf("cannot parse vmAgentSelector of vmscrapeglobalconfig=%q: %w", cr.Name, err)
+	}
+	return selector.Matches(labels.Set(vmagent.Labels)), nil
+}
+
+func init() {
+	SchemeBuilder.Register(&VMScrapeGlobalConfig{}, &VMScrapeGlobalConfigList{})
+}
@@ -0,0 +1,96 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+
+	"github.com/VictoriaMetrics/VictoriaMetrics/lib/flagutil"
+	"github.com/VictoriaMetrics/metricsql"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager will setup the manager to manage the webhooks
+func (r *VMScrapeGlobalConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-operator-victoriametrics-com-v1beta1-vmscrapeglobalconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.victoriametrics.com,resources=vmscrapeglobalconfigs,verbs=create;update,versions=v1beta1,name=vvmscrapeglobalconfig.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &VMScrapeGlobalConfig{}
+
+// Validate performs symantic validation of object
+func (r *VMScrapeGlobalConfig) Validate() error {
+	if mustSkipValidation(r) {
+		return nil
+	}
+	if r.Spec.VMAgentSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(r.Spec.VMAgentSelector); err != nil {
+			return fmt.Errorf("cannot parse vmAgentSelector: %w", err)
+		}
+	}
+	if r.Spec.ScrapeInterval != "" {
+		if _, err := metricsql.DurationValue(r.Spec.ScrapeInterval, 0); err != nil {
+			return fmt.Errorf("cannot parse scrapeInterval=%q: %w", r.Spec.ScrapeInterval, err)
+		}
+	}
+	if r.Spec.ScrapeTimeout != "" {
+		if _, err := metricsql.DurationValue(r.Spec.ScrapeTimeout, 0); err != nil {
+			return fmt.Errorf("cannot parse scrapeTimeout=%q: %w", r.Spec.ScrapeTimeout, err)
+		}
+	}
+	if r.Spec.MaxScrapeSize != "" {
+		var b flagutil.Bytes
+		if err := b.Set(r.Spec.MaxScrapeSize); err != nil {
+			return fmt.Errorf("cannot parse maxScrapeSize=%q: %w", r.Spec.MaxScrapeSize, err)
+		}
+	}
+	return nil
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMScrapeGlobalConfig) ValidateCreate() (admission.Warnings, error) {
+	if r.Spec.ParsingError != "" {
+		return nil, fmt.Errorf(r.Spec.ParsingError)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *VMScrapeGlobalConfig) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	if r.Spec.ParsingError != "" {
+		return nil, fmt.Errorf(r.Spec.ParsingError)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *VMScrapeGlobalConfig) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
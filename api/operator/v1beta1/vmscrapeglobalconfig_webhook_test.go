@@ -0,0 +1,38 @@
+package v1beta1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("VMScrapeGlobalConfig Webhook", func() {
+	Context("When creating VMScrapeGlobalConfig under Validating Webhook", func() {
+		DescribeTable("fails validation",
+			func(spec VMScrapeGlobalConfigSpec, wantErr string) {
+				gc := VMScrapeGlobalConfig{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: spec}
+				Expect(gc.Validate()).To(MatchError(wantErr))
+			},
+			Entry("bad scrape interval", VMScrapeGlobalConfigSpec{
+				ScrapeInterval: "1x",
+			}, `cannot parse scrapeInterval="1x": cannot parse duration "1x"`),
+			Entry("bad max scrape size", VMScrapeGlobalConfigSpec{
+				MaxScrapeSize: "10Zb",
+			}, `cannot parse maxScrapeSize="10Zb": strconv.ParseFloat: parsing "10ZB": invalid syntax`),
+		)
+		DescribeTable("passes validation",
+			func(spec VMScrapeGlobalConfigSpec) {
+				gc := VMScrapeGlobalConfig{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: spec}
+				Expect(gc.Validate()).To(Succeed())
+			},
+			Entry("all settings", VMScrapeGlobalConfigSpec{
+				VMAgentSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+				ScrapeInterval:  "1m",
+				ScrapeTimeout:   "10s",
+				ExternalLabels:  map[string]string{"cluster": "main"},
+				SampleLimit:     10000,
+				MaxScrapeSize:   "16MiB",
+			}),
+		)
+	})
+})
@@ -47,6 +47,10 @@ type VMServiceScrapeSpec struct {
 	// AttachMetadata configures metadata attaching from service discovery
 	// +optional
 	AttachMetadata AttachMetadata `json:"attach_metadata,omitempty"`
+	// ScrapeClass defines name of VMAgent scrape class applied to the VMServiceScrape.
+	// Default scrape class of VMAgent is used if it's not set
+	// +optional
+	ScrapeClassName *string `json:"scrapeClass,omitempty"`
 }
 
 // VMServiceScrape is scrape configuration for endpoints associated with
@@ -0,0 +1,68 @@
+package v1beta1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("VMServiceScrape Webhook", func() {
+	Context("When creating VMServiceScrape under Validating Webhook", func() {
+		DescribeTable("fails validation",
+			func(spec VMServiceScrapeSpec, wantErr string) {
+				ss := VMServiceScrape{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}, Spec: spec}
+				Expect(ss.Validate()).To(MatchError(ContainSubstring(wantErr)))
+			},
+			Entry("bad relabel action", VMServiceScrapeSpec{
+				Endpoints: []Endpoint{{
+					Port: "http",
+					EndpointRelabelings: EndpointRelabelings{
+						RelabelConfigs: []*RelabelConfig{{Action: "unknown"}},
+					},
+				}},
+			}, `at spec.endpoints[0]: bad relabelConfigs: cannot parse relabelConfigs`),
+			Entry("bad metric relabel regex", VMServiceScrapeSpec{
+				Endpoints: []Endpoint{
+					{Port: "http"},
+					{
+						Port: "http",
+						EndpointRelabelings: EndpointRelabelings{
+							MetricRelabelConfigs: []*RelabelConfig{{SourceLabels: []string{"__name__"}, Regex: StringOrArray{"go_("}, Action: "drop"}},
+						},
+					},
+				},
+			}, `at spec.endpoints[1]: bad metricRelabelConfigs: cannot parse relabelConfigs`),
+			Entry("oauth2 without token url", VMServiceScrapeSpec{
+				Endpoints: []Endpoint{{
+					Port:         "http",
+					EndpointAuth: EndpointAuth{OAuth2: &OAuth2{}},
+				}},
+			}, `at spec.endpoints[0]: bad oauth2: token_url field for oauth2 config must be set`),
+			Entry("tls with both ca and ca file", VMServiceScrapeSpec{
+				Endpoints: []Endpoint{{
+					Port: "http",
+					EndpointAuth: EndpointAuth{TLSConfig: &TLSConfig{
+						CAFile: "/etc/ca.crt",
+						CA:     SecretOrConfigMap{Secret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "tls"}, Key: "ca"}},
+					}},
+				}},
+			}, `at spec.endpoints[0]: bad tlsConfig: tls config can not both specify CAFile and CA`),
+		)
+		DescribeTable("passes validation",
+			func(spec VMServiceScrapeSpec) {
+				ss := VMServiceScrape{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}, Spec: spec}
+				Expect(ss.Validate()).To(Succeed())
+			},
+			Entry("relabelings", VMServiceScrapeSpec{
+				Endpoints: []Endpoint{{
+					Port: "http",
+					EndpointRelabelings: EndpointRelabelings{
+						RelabelConfigs:       []*RelabelConfig{{SourceLabels: []string{"__meta_kubernetes_pod_name"}, TargetLabel: "pod"}},
+						MetricRelabelConfigs: []*RelabelConfig{{SourceLabels: []string{"__name__"}, Regex: StringOrArray{"go_.*"}, Action: "drop"}},
+					},
+				}},
+			}),
+		)
+	})
+})
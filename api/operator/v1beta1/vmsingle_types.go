@@ -56,6 +56,11 @@ type VMSingleSpec struct {
 	// by controller manager
 	// +optional
 	RemovePvcAfterDelete bool `json:"removePvcAfterDelete,omitempty"`
+	// DisruptionProtection adds karpenter.sh/do-not-disrupt and cluster-autoscaler.kubernetes.io/safe-to-evict=false annotations to vmsingle pods.
+	// It prevents eviction of pods by node consolidation of karpenter and scale down of cluster-autoscaler,
+	// for instance during background merges of stored data. Annotations defined at podMetadata take precedence
+	// +optional
+	DisruptionProtection bool `json:"disruptionProtection,omitempty"`
 
 	// RetentionPeriod for the stored metrics
 	// Note VictoriaMetrics has data/ and indexdb/ folders
@@ -76,6 +81,9 @@ type VMSingleSpec struct {
 	// ServiceScrapeSpec that will be added to vmsingle VMServiceScrape spec
 	// +optional
 	ServiceScrapeSpec *VMServiceScrapeSpec `json:"serviceScrapeSpec,omitempty"`
+	// DefaultRules configures VMRule with default rules for vmsingle generated by operator
+	// +optional
+	DefaultRules *DefaultRules `json:"defaultRules,omitempty"`
 	// LivenessProbe that will be added to VMSingle pod
 	*EmbeddedProbes `json:",inline"`
 	// StreamAggrConfig defines stream aggregation configuration for VMSingle
@@ -84,6 +92,12 @@ type VMSingleSpec struct {
 	// ServiceAccountName is the name of the ServiceAccount to use to run the pods
 	// +optional
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// ServiceAccountImagePullSecrets is an optional list of references to secrets in the same namespace,
+	// which are attached to the ServiceAccount managed by operator.
+	// Secrets are merged with global operator configuration VM_SERVICEACCOUNTIMAGEPULLSECRETS.
+	// Has no effect if serviceAccountName is set
+	// +optional
+	ServiceAccountImagePullSecrets []v1.LocalObjectReference `json:"serviceAccountImagePullSecrets,omitempty"`
 
 	CommonDefaultableParams           `json:",inline"`
 	CommonApplicationDeploymentParams `json:",inline"`
@@ -134,6 +148,47 @@ type VMSingleStatus struct {
 	UpdateStatus UpdateStatus `json:"singleStatus,omitempty"`
 	// Reason defines a reason in case of update failure
 	Reason string `json:"reason,omitempty"`
+	// Restore defines state of the latest backup restore on start
+	// +optional
+	Restore *VMSingleRestoreStatus `json:"restore,omitempty"`
+	// Children lists objects generated by operator for this object
+	// +optional
+	Children []ChildObject `json:"children,omitempty"`
+	// Warnings contains problems found at object spec, which don't block reconciliation,
+	// such as image tags with unknown version
+	// +optional
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+const (
+	// RestorePending means, that vmrestore init container waits for start
+	RestorePending = "Pending"
+	// RestoreRunning means, that backup restore is in progress
+	RestoreRunning = "Running"
+	// RestoreSucceeded means, that the latest backup was restored
+	RestoreSucceeded = "Succeeded"
+	// RestoreSkipped means, that storage already contains data and restore wasn't performed
+	RestoreSkipped = "Skipped"
+	// RestoreFailed means, that vmrestore init container failed
+	RestoreFailed = "Failed"
+)
+
+// VMSingleRestoreStatus defines state of the latest backup restore performed on VMSingle pod start
+type VMSingleRestoreStatus struct {
+	// Phase of restore
+	Phase string `json:"phase,omitempty"`
+	// Message contains details of restore progress
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Pod performing restore
+	// +optional
+	Pod string `json:"pod,omitempty"`
+	// StartTime of restore
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime of restore
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
 }
 
 // VMSingle  is fast, cost-effective and scalable time-series database.
@@ -148,6 +203,7 @@ type VMSingleStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=vmsingles,scope=Namespaced
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.singleStatus",description="Current status of single node update process"
+// +kubebuilder:printcolumn:name="Restore",type="string",JSONPath=".status.restore.phase",description="Phase of the latest backup restore on start",priority=1
 type VMSingle struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -206,11 +262,14 @@ func (cr VMSingle) PodAnnotations() map[string]string {
 			annotations[annotation] = value
 		}
 	}
+	if cr.Spec.DisruptionProtection {
+		return withDisruptionProtection(annotations)
+	}
 	return annotations
 }
 
 func (cr VMSingle) AnnotationsFiltered() map[string]string {
-	return filterMapKeysByPrefixes(cr.ObjectMeta.Annotations, annotationFilterPrefixes)
+	return filterMapKeysByPrefixes(cr.ObjectMeta.Annotations, childAnnotationFilterPrefixes(cr.Namespace))
 }
 
 func (cr VMSingle) SelectorLabels() map[string]string {
@@ -236,7 +295,7 @@ func (cr VMSingle) AllLabels() map[string]string {
 	if cr.ObjectMeta.Labels == nil {
 		return selectorLabels
 	}
-	crLabels := filterMapKeysByPrefixes(cr.ObjectMeta.Labels, labelFilterPrefixes)
+	crLabels := filterMapKeysByPrefixes(cr.ObjectMeta.Labels, childLabelFilterPrefixes(cr.Namespace))
 	return labels.Merge(crLabels, selectorLabels)
 }
 
@@ -270,6 +329,11 @@ func (cr VMSingle) GetServiceAccountName() string {
 	return cr.Spec.ServiceAccountName
 }
 
+// GetServiceAccountImagePullSecrets returns image pull secrets for managed ServiceAccount
+func (cr VMSingle) GetServiceAccountImagePullSecrets() []v1.LocalObjectReference {
+	return cr.Spec.ServiceAccountImagePullSecrets
+}
+
 func (cr VMSingle) IsOwnsServiceAccount() bool {
 	return cr.Spec.ServiceAccountName == ""
 }
@@ -324,7 +388,7 @@ func (cr *VMSingle) HasSpecChanges() (bool, error) {
 }
 
 func (cr *VMSingle) Paused() bool {
-	return cr.Spec.Paused
+	return cr.Spec.Paused || IsReconcilePaused(cr)
 }
 
 // SetStatusTo changes update status with optional reason of fail
@@ -333,7 +397,7 @@ func (cr *VMSingle) SetUpdateStatusTo(ctx context.Context, r client.Client, stat
 	prevStatus := cr.Status.DeepCopy()
 	switch status {
 	case UpdateStatusExpanding:
-	case UpdateStatusFailed:
+	case UpdateStatusFailed, UpdateStatusDegraded, UpdateStatusBlocked:
 		if maybeErr != nil {
 			cr.Status.Reason = maybeErr.Error()
 		}
@@ -354,6 +418,21 @@ func (cr *VMSingle) SetUpdateStatusTo(ctx context.Context, r client.Client, stat
 	return statusPatch(ctx, r, cr.DeepCopy(), cr.Status)
 }
 
+// GetStatusChildren returns objects generated by operator for VMSingle
+func (cr *VMSingle) GetStatusChildren() []ChildObject {
+	return cr.Status.Children
+}
+
+// GetStatusWarnings returns problems found at VMSingle spec
+func (cr *VMSingle) GetStatusWarnings() []string {
+	return cr.Status.Warnings
+}
+
+// SetStatusWarnings sets problems found at VMSingle spec
+func (cr *VMSingle) SetStatusWarnings(warnings []string) {
+	cr.Status.Warnings = warnings
+}
+
 // GetAdditionalService returns AdditionalServiceSpec settings
 func (cr *VMSingle) GetAdditionalService() *AdditionalServiceSpec {
 	return cr.Spec.ServiceSpec
@@ -41,11 +41,20 @@ func (r *VMSingle) SetupWebhookWithManager(mgr ctrl.Manager) error {
 var _ webhook.Validator = &VMSingle{}
 
 func (r *VMSingle) sanityCheck() error {
+	if err := r.Spec.DefaultRules.validate(); err != nil {
+		return err
+	}
 	if r.Spec.VMBackup != nil {
 		if err := r.Spec.VMBackup.sanityCheck(r.Spec.License); err != nil {
 			return err
 		}
 	}
+	if err := r.Spec.License.sanityCheck(); err != nil {
+		return err
+	}
+	if err := sanityCheckOverridePatches(r.Spec.OverridePatches); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3,6 +3,7 @@ package v1beta1
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,6 +33,10 @@ type VMUserSpec struct {
 	// if spec.password if empty.
 	// +optional
 	GeneratePassword bool `json:"generatePassword,omitempty"`
+	// PasswordRotation configures periodic regeneration of password generated by operator.
+	// It works only with generatePassword
+	// +optional
+	PasswordRotation *VMUserPasswordRotation `json:"passwordRotation,omitempty"`
 	// BearerToken Authorization header value for accessing protected endpoint.
 	// +optional
 	BearerToken *string `json:"bearerToken,omitempty"`
@@ -88,13 +93,26 @@ type VMUserIPFilters struct {
 // CRDRef describe CRD target reference.
 type CRDRef struct {
 	// Kind one of:
-	// VMAgent,VMAlert, VMSingle, VMCluster/vmselect, VMCluster/vmstorage,VMCluster/vminsert  or VMAlertManager
-	// +kubebuilder:validation:Enum=VMAgent;VMAlert;VMSingle;VMAlertManager;VMAlertmanager;VMCluster/vmselect;VMCluster/vmstorage;VMCluster/vminsert
+	// VMAgent,VMAlert, VMSingle, VMCluster, VMCluster/vmselect, VMCluster/vmstorage,VMCluster/vminsert  or VMAlertManager
+	// VMCluster kind routes write requests to vminsert and read requests to vmselect of the given tenantID
+	// +kubebuilder:validation:Enum=VMAgent;VMAlert;VMSingle;VMAlertManager;VMAlertmanager;VMCluster;VMCluster/vmselect;VMCluster/vmstorage;VMCluster/vminsert
 	Kind string `json:"kind"`
 	// Name target CRD object name
 	Name string `json:"name"`
 	// Namespace target CRD object namespace.
 	Namespace string `json:"namespace"`
+	// TenantID of VMCluster in format accountID or accountID:projectID.
+	// Operator adds /insert/<tenantID>/prometheus or /select/<tenantID>/prometheus path to the generated url.
+	// Required for VMCluster kind and optional for VMCluster/vmselect and VMCluster/vminsert kinds
+	// +kubebuilder:validation:Pattern:="^[0-9]+(:[0-9]+)?$"
+	// +optional
+	TenantID string `json:"tenantID,omitempty"`
+	// ReadOnly allows only read requests to vmselect for VMCluster kind
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// WriteOnly allows only write requests to vminsert for VMCluster kind
+	// +optional
+	WriteOnly bool `json:"writeOnly,omitempty"`
 }
 
 // AddRefToObj adds reference to given object and return it.
@@ -129,6 +147,30 @@ type TargetRefBasicAuth struct {
 	Password v1.SecretKeySelector `json:"password"`
 }
 
+// VMUserPasswordRotation defines rotation policy of password generated for VMUser
+type VMUserPasswordRotation struct {
+	// Interval between password rotations, e.g. 720h
+	Interval string `json:"interval"`
+}
+
+// Validate checks rotation interval
+func (cr *VMUserPasswordRotation) Validate() error {
+	d, err := time.ParseDuration(cr.Interval)
+	if err != nil {
+		return fmt.Errorf("cannot parse interval: %w", err)
+	}
+	if d < time.Minute {
+		return fmt.Errorf("interval=%s cannot be less than 1m", cr.Interval)
+	}
+	return nil
+}
+
+// GetInterval returns interval between password rotations
+func (cr *VMUserPasswordRotation) GetInterval() time.Duration {
+	d, _ := time.ParseDuration(cr.Interval)
+	return d
+}
+
 // VMUserStatus defines the observed state of VMUser
 type VMUserStatus struct {
 	// Status defines update status of resource
@@ -136,6 +178,9 @@ type VMUserStatus struct {
 	// LastSyncError contains error message for unsuccessful config generation
 	// for given user
 	LastSyncError string `json:"lastSyncError,omitempty"`
+	// LastPasswordRotationTime is a time of the last generated password rotation
+	// +optional
+	LastPasswordRotationTime *metav1.Time `json:"lastPasswordRotationTime,omitempty"`
 	// CurrentSyncError holds an error occured during reconcile loop
 	CurrentSyncError string `json:"-"`
 }
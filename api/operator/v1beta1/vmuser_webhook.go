@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -27,9 +28,11 @@ import (
 )
 
 var supportedCRDKinds = []string{
-	"VMAgent", "VMAlert", "VMAlertmanager", "VMSingle", "VMCluster/vmselect", "VMCluster/vminsert", "VMCluster/vmstorage",
+	"VMAgent", "VMAlert", "VMAlertmanager", "VMSingle", "VMCluster", "VMCluster/vmselect", "VMCluster/vminsert", "VMCluster/vmstorage",
 }
 
+var tenantIDRe = regexp.MustCompile(`^[0-9]+(:[0-9]+)?$`)
+
 // SetupWebhookWithManager will setup the manager to manage the webhooks
 func (r *VMUser) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
@@ -48,6 +51,14 @@ func (r *VMUser) sanityCheck() error {
 	if r.Spec.PasswordRef != nil && r.Spec.Password != nil {
 		return fmt.Errorf("one of spec.password or spec.passwordRef must be used for user, got both")
 	}
+	if r.Spec.PasswordRotation != nil {
+		if !r.Spec.GeneratePassword || r.Spec.Password != nil || r.Spec.PasswordRef != nil {
+			return fmt.Errorf("spec.passwordRotation requires spec.generatePassword without spec.password and spec.passwordRef")
+		}
+		if err := r.Spec.PasswordRotation.Validate(); err != nil {
+			return fmt.Errorf("bad spec.passwordRotation: %w", err)
+		}
+	}
 	if len(r.Spec.TargetRefs) == 0 {
 		return fmt.Errorf("at least 1 TargetRef must be provided for spec.targetRefs")
 	}
@@ -67,13 +78,16 @@ func (r *VMUser) sanityCheck() error {
 		}
 		if targetRef.CRD != nil {
 			switch targetRef.CRD.Kind {
-			case "VMAgent", "VMAlert", "VMAlertmanager", "VMSingle", "VMCluster/vmselect", "VMCluster/vminsert", "VMCluster/vmstorage":
+			case "VMAgent", "VMAlert", "VMAlertmanager", "VMSingle", "VMCluster", "VMCluster/vmselect", "VMCluster/vminsert", "VMCluster/vmstorage":
 			default:
 				return fmt.Errorf("unsupported crd.kind for target ref, got: `%s`, want one of: `%s`", targetRef.CRD.Kind, strings.Join(supportedCRDKinds, ","))
 			}
 			if targetRef.CRD.Namespace == "" || targetRef.CRD.Name == "" {
 				return fmt.Errorf("crd.name and crd.namespace cannot be empty")
 			}
+			if err := validateCRDRefTenant(targetRef); err != nil {
+				return fmt.Errorf("incorrect targetRef at idx=%d: %w", i, err)
+			}
 		}
 		if err := parseHeaders(targetRef.ResponseHeaders); err != nil {
 			return fmt.Errorf("failed to parse targetRef response headers :%w", err)
@@ -81,6 +95,9 @@ func (r *VMUser) sanityCheck() error {
 		if err := parseHeaders(targetRef.RequestHeaders); err != nil {
 			return fmt.Errorf("failed to parse targetRef headers :%w", err)
 		}
+		if targetRef.DropSrcPathPrefixParts != nil && *targetRef.DropSrcPathPrefixParts < 0 {
+			return fmt.Errorf("drop_src_path_prefix_parts cannot be negative at targetRef idx=%d", i)
+		}
 		if isRetryCodesSet && len(targetRef.RetryStatusCodes) > 0 {
 			return fmt.Errorf("retry_status_codes already set at VMUser.spec level")
 		}
@@ -91,6 +108,40 @@ func (r *VMUser) sanityCheck() error {
 	if err := parseHeaders(r.Spec.ResponseHeaders); err != nil {
 		return fmt.Errorf("failed to parse vmuser response headers: %w", err)
 	}
+	if err := r.Spec.IPFilters.validate(); err != nil {
+		return fmt.Errorf("bad spec.ip_filters: %w", err)
+	}
+	if r.Spec.DropSrcPathPrefixParts != nil && *r.Spec.DropSrcPathPrefixParts < 0 {
+		return fmt.Errorf("spec.drop_src_path_prefix_parts cannot be negative, got: %d", *r.Spec.DropSrcPathPrefixParts)
+	}
+	return nil
+}
+
+func validateCRDRefTenant(targetRef TargetRef) error {
+	crd := targetRef.CRD
+	switch crd.Kind {
+	case "VMCluster":
+		if crd.TenantID == "" {
+			return fmt.Errorf("crd.tenantID must be set for crd.kind=VMCluster")
+		}
+		if crd.ReadOnly && crd.WriteOnly {
+			return fmt.Errorf("only one of crd.readOnly or crd.writeOnly could be set")
+		}
+		if len(targetRef.Paths) > 0 && !crd.ReadOnly && !crd.WriteOnly {
+			return fmt.Errorf("paths cannot be set for crd.kind=VMCluster without crd.readOnly or crd.writeOnly")
+		}
+	case "VMCluster/vmselect", "VMCluster/vminsert":
+		if crd.ReadOnly || crd.WriteOnly {
+			return fmt.Errorf("crd.readOnly and crd.writeOnly are supported only for crd.kind=VMCluster")
+		}
+	default:
+		if crd.TenantID != "" || crd.ReadOnly || crd.WriteOnly {
+			return fmt.Errorf("crd.tenantID, crd.readOnly and crd.writeOnly are not supported for crd.kind=%s", crd.Kind)
+		}
+	}
+	if crd.TenantID != "" && !tenantIDRe.MatchString(crd.TenantID) {
+		return fmt.Errorf("crd.tenantID=%q must have accountID or accountID:projectID format", crd.TenantID)
+	}
 	return nil
 }
 
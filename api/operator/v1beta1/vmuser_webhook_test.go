@@ -113,6 +113,38 @@ func TestVMUser_sanityCheck(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "password rotation",
+			fields: fields{
+				Spec: VMUserSpec{
+					GeneratePassword: true,
+					PasswordRotation: &VMUserPasswordRotation{Interval: "720h"},
+					TargetRefs:       []TargetRef{{Static: &StaticRef{URL: "http://some-url"}}},
+				},
+			},
+		},
+		{
+			name: "password rotation without generated password",
+			fields: fields{
+				Spec: VMUserSpec{
+					Password:         ptr.To("pass"),
+					PasswordRotation: &VMUserPasswordRotation{Interval: "720h"},
+					TargetRefs:       []TargetRef{{Static: &StaticRef{URL: "http://some-url"}}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "password rotation with too small interval",
+			fields: fields{
+				Spec: VMUserSpec{
+					GeneratePassword: true,
+					PasswordRotation: &VMUserPasswordRotation{Interval: "10s"},
+					TargetRefs:       []TargetRef{{Static: &StaticRef{URL: "http://some-url"}}},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "correct crd target",
 			fields: fields{
@@ -136,6 +168,165 @@ func TestVMUser_sanityCheck(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "correct cluster tenant target",
+			fields: fields{
+				Spec: VMUserSpec{
+					TargetRefs: []TargetRef{
+						{
+							CRD: &CRDRef{
+								Name:      "main",
+								Namespace: "some-ns",
+								Kind:      "VMCluster",
+								TenantID:  "1:2",
+							},
+						},
+						{
+							CRD: &CRDRef{
+								Name:      "main",
+								Namespace: "some-ns",
+								Kind:      "VMCluster/vmselect",
+								TenantID:  "3",
+							},
+							Paths: []string{"/api/v1/query"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "cluster target without tenant",
+			fields: fields{
+				Spec: VMUserSpec{
+					TargetRefs: []TargetRef{
+						{
+							CRD: &CRDRef{
+								Name:      "main",
+								Namespace: "some-ns",
+								Kind:      "VMCluster",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cluster target with readOnly and writeOnly",
+			fields: fields{
+				Spec: VMUserSpec{
+					TargetRefs: []TargetRef{
+						{
+							CRD: &CRDRef{
+								Name:      "main",
+								Namespace: "some-ns",
+								Kind:      "VMCluster",
+								TenantID:  "1",
+								ReadOnly:  true,
+								WriteOnly: true,
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cluster target with paths for read and write",
+			fields: fields{
+				Spec: VMUserSpec{
+					TargetRefs: []TargetRef{
+						{
+							CRD: &CRDRef{
+								Name:      "main",
+								Namespace: "some-ns",
+								Kind:      "VMCluster",
+								TenantID:  "1",
+							},
+							Paths: []string{"/api/v1/query"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "incorrect tenant format",
+			fields: fields{
+				Spec: VMUserSpec{
+					TargetRefs: []TargetRef{
+						{
+							CRD: &CRDRef{
+								Name:      "main",
+								Namespace: "some-ns",
+								Kind:      "VMCluster/vminsert",
+								TenantID:  "team-a",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "tenant for vmsingle target",
+			fields: fields{
+				Spec: VMUserSpec{
+					TargetRefs: []TargetRef{
+						{
+							CRD: &CRDRef{
+								Name:      "main",
+								Namespace: "some-ns",
+								Kind:      "VMSingle",
+								TenantID:  "1",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ip filters",
+			fields: fields{
+				Spec: VMUserSpec{
+					TargetRefs: []TargetRef{{Static: &StaticRef{URL: "http://vmsingle:8428"}}},
+					UserConfigOption: UserConfigOption{
+						IPFilters: VMUserIPFilters{
+							AllowList: []string{"10.0.0.0/24", "1.2.3.4", "2001:db8::/32"},
+							DenyList:  []string{"10.0.0.42"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "bad ip filters",
+			fields: fields{
+				Spec: VMUserSpec{
+					TargetRefs: []TargetRef{{Static: &StaticRef{URL: "http://vmsingle:8428"}}},
+					UserConfigOption: UserConfigOption{
+						IPFilters: VMUserIPFilters{AllowList: []string{"10.0.0.256"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative drop_src_path_prefix_parts at target",
+			fields: fields{
+				Spec: VMUserSpec{
+					TargetRefs: []TargetRef{
+						{
+							Static:       &StaticRef{URL: "http://vmsingle:8428"},
+							Paths:        []string{"/vmsingle/.*"},
+							URLMapCommon: URLMapCommon{DropSrcPathPrefixParts: ptr.To(-1)},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
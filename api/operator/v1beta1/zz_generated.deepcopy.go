@@ -79,6 +79,28 @@ func (in *AdditionalServiceSpec) DeepCopy() *AdditionalServiceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRuleTestCase) DeepCopyInto(out *AlertRuleTestCase) {
+	*out = *in
+	if in.ExpAlerts != nil {
+		in, out := &in.ExpAlerts, &out.ExpAlerts
+		*out = make([]RuleTestExpAlert, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRuleTestCase.
+func (in *AlertRuleTestCase) DeepCopy() *AlertRuleTestCase {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRuleTestCase)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertmanagerGossipConfig) DeepCopyInto(out *AlertmanagerGossipConfig) {
 	*out = *in
@@ -104,6 +126,28 @@ func (in *AlertmanagerGossipConfig) DeepCopy() *AlertmanagerGossipConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerGossipService) DeepCopyInto(out *AlertmanagerGossipService) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerGossipService.
+func (in *AlertmanagerGossipService) DeepCopy() *AlertmanagerGossipService {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerGossipService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertmanagerHTTPConfig) DeepCopyInto(out *AlertmanagerHTTPConfig) {
 	*out = *in
@@ -315,6 +359,71 @@ func (in *CRDRef) DeepCopy() *CRDRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CardinalityLimits) DeepCopyInto(out *CardinalityLimits) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CardinalityLimits.
+func (in *CardinalityLimits) DeepCopy() *CardinalityLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(CardinalityLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerCertificate) DeepCopyInto(out *CertManagerCertificate) {
+	*out = *in
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(CertManagerIssuerRef)
+		**out = **in
+	}
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerCertificate.
+func (in *CertManagerCertificate) DeepCopy() *CertManagerCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerIssuerRef) DeepCopyInto(out *CertManagerIssuerRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerRef.
+func (in *CertManagerIssuerRef) DeepCopy() *CertManagerIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Certs) DeepCopyInto(out *Certs) {
 	*out = *in
@@ -340,6 +449,21 @@ func (in *Certs) DeepCopy() *Certs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChildObject) DeepCopyInto(out *ChildObject) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChildObject.
+func (in *ChildObject) DeepCopy() *ChildObject {
+	if in == nil {
+		return nil
+	}
+	out := new(ChildObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonApplicationDeploymentParams) DeepCopyInto(out *CommonApplicationDeploymentParams) {
 	*out = *in
@@ -348,6 +472,11 @@ func (in *CommonApplicationDeploymentParams) DeepCopyInto(out *CommonApplication
 		*out = new(v1.Affinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DefaultAffinitySettings != nil {
+		in, out := &in.DefaultAffinitySettings, &out.DefaultAffinitySettings
+		*out = new(DefaultAffinitySettings)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
 		*out = make([]v1.Toleration, len(*in))
@@ -475,6 +604,11 @@ func (in *CommonApplicationDeploymentParams) DeepCopyInto(out *CommonApplication
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OverridePatches != nil {
+		in, out := &in.OverridePatches, &out.OverridePatches
+		*out = make([]OverridePatch, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonApplicationDeploymentParams.
@@ -563,6 +697,22 @@ func (in *ConfigMapKeyReference) DeepCopy() *ConfigMapKeyReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigValidation) DeepCopyInto(out *ConfigValidation) {
+	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigValidation.
+func (in *ConfigValidation) DeepCopy() *ConfigValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConsulSDConfig) DeepCopyInto(out *ConsulSDConfig) {
 	*out = *in
@@ -735,6 +885,145 @@ func (in *DNSSDConfig) DeepCopy() *DNSSDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataMigrationDestination) DeepCopyInto(out *DataMigrationDestination) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataMigrationDestination.
+func (in *DataMigrationDestination) DeepCopy() *DataMigrationDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(DataMigrationDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataMigrationSource) DeepCopyInto(out *DataMigrationSource) {
+	*out = *in
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusMigrationSource)
+		**out = **in
+	}
+	if in.Thanos != nil {
+		in, out := &in.Thanos, &out.Thanos
+		*out = new(ThanosMigrationSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Influx != nil {
+		in, out := &in.Influx, &out.Influx
+		*out = new(InfluxMigrationSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataMigrationSource.
+func (in *DataMigrationSource) DeepCopy() *DataMigrationSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DataMigrationSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataMigrationTimeRange) DeepCopyInto(out *DataMigrationTimeRange) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+	if in.ChunkInterval != nil {
+		in, out := &in.ChunkInterval, &out.ChunkInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataMigrationTimeRange.
+func (in *DataMigrationTimeRange) DeepCopy() *DataMigrationTimeRange {
+	if in == nil {
+		return nil
+	}
+	out := new(DataMigrationTimeRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultAffinitySettings) DeepCopyInto(out *DefaultAffinitySettings) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultAffinitySettings.
+func (in *DefaultAffinitySettings) DeepCopy() *DefaultAffinitySettings {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultAffinitySettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultRules) DeepCopyInto(out *DefaultRules) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AdditionalRuleLabels != nil {
+		in, out := &in.AdditionalRuleLabels, &out.AdditionalRuleLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultRules.
+func (in *DefaultRules) DeepCopy() *DefaultRules {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeleteSeriesTask) DeepCopyInto(out *DeleteSeriesTask) {
+	*out = *in
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteSeriesTask.
+func (in *DeleteSeriesTask) DeepCopy() *DeleteSeriesTask {
+	if in == nil {
+		return nil
+	}
+	out := new(DeleteSeriesTask)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DigitalOceanSDConfig) DeepCopyInto(out *DigitalOceanSDConfig) {
 	*out = *in
@@ -846,73 +1135,165 @@ func (in *DiscoverySelector) DeepCopy() *DiscoverySelector {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EC2Filter) DeepCopyInto(out *EC2Filter) {
-	*out = *in
-	if in.Values != nil {
-		in, out := &in.Values, &out.Values
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2Filter.
-func (in *EC2Filter) DeepCopy() *EC2Filter {
-	if in == nil {
-		return nil
-	}
-	out := new(EC2Filter)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EC2SDConfig) DeepCopyInto(out *EC2SDConfig) {
+func (in *DockerSDConfig) DeepCopyInto(out *DockerSDConfig) {
 	*out = *in
-	if in.Region != nil {
-		in, out := &in.Region, &out.Region
-		*out = new(string)
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
 		**out = **in
 	}
-	if in.AccessKey != nil {
-		in, out := &in.AccessKey, &out.AccessKey
-		*out = new(v1.SecretKeySelector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SecretKey != nil {
-		in, out := &in.SecretKey, &out.SecretKey
-		*out = new(v1.SecretKeySelector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RoleARN != nil {
-		in, out := &in.RoleARN, &out.RoleARN
+	if in.HostNetworkingHost != nil {
+		in, out := &in.HostNetworkingHost, &out.HostNetworkingHost
 		*out = new(string)
 		**out = **in
 	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int)
+	if in.MatchFirstNetwork != nil {
+		in, out := &in.MatchFirstNetwork, &out.MatchFirstNetwork
+		*out = new(bool)
 		**out = **in
 	}
 	if in.Filters != nil {
 		in, out := &in.Filters, &out.Filters
-		*out = make([]*EC2Filter, len(*in))
+		*out = make([]DockerSDFilter, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(EC2Filter)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2SDConfig.
-func (in *EC2SDConfig) DeepCopy() *EC2SDConfig {
-	if in == nil {
-		return nil
-	}
-	out := new(EC2SDConfig)
-	in.DeepCopyInto(out)
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(Authorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(OAuth2)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProxyURL != nil {
+		in, out := &in.ProxyURL, &out.ProxyURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProxyClientConfig != nil {
+		in, out := &in.ProxyClientConfig, &out.ProxyClientConfig
+		*out = new(ProxyAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerSDConfig.
+func (in *DockerSDConfig) DeepCopy() *DockerSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DockerSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DockerSDFilter) DeepCopyInto(out *DockerSDFilter) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerSDFilter.
+func (in *DockerSDFilter) DeepCopy() *DockerSDFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(DockerSDFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2Filter) DeepCopyInto(out *EC2Filter) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2Filter.
+func (in *EC2Filter) DeepCopy() *EC2Filter {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2Filter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2SDConfig) DeepCopyInto(out *EC2SDConfig) {
+	*out = *in
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessKey != nil {
+		in, out := &in.AccessKey, &out.AccessKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKey != nil {
+		in, out := &in.SecretKey, &out.SecretKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]*EC2Filter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(EC2Filter)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2SDConfig.
+func (in *EC2SDConfig) DeepCopy() *EC2SDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2SDConfig)
+	in.DeepCopyInto(out)
 	return out
 }
 
@@ -995,6 +1376,32 @@ func (in *EmbeddedHPA) DeepCopy() *EmbeddedHPA {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmbeddedHTTPRoute) DeepCopyInto(out *EmbeddedHTTPRoute) {
+	*out = *in
+	in.EmbeddedObjectMetadata.DeepCopyInto(&out.EmbeddedObjectMetadata)
+	if in.ParentRefs != nil {
+		in, out := &in.ParentRefs, &out.ParentRefs
+		*out = make([]HTTPRouteParentRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmbeddedHTTPRoute.
+func (in *EmbeddedHTTPRoute) DeepCopy() *EmbeddedHTTPRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(EmbeddedHTTPRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EmbeddedIngress) DeepCopyInto(out *EmbeddedIngress) {
 	*out = *in
@@ -1234,6 +1641,16 @@ func (in *EndpointRelabelings) DeepCopyInto(out *EndpointRelabelings) {
 			}
 		}
 	}
+	if in.MetricRelabelConfigRefs != nil {
+		in, out := &in.MetricRelabelConfigRefs, &out.MetricRelabelConfigRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RelabelConfigRefs != nil {
+		in, out := &in.RelabelConfigRefs, &out.RelabelConfigRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointRelabelings.
@@ -1317,6 +1734,21 @@ func (in *FileSDConfig) DeepCopy() *FileSDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForceMergeTask) DeepCopyInto(out *ForceMergeTask) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForceMergeTask.
+func (in *ForceMergeTask) DeepCopy() *ForceMergeTask {
+	if in == nil {
+		return nil
+	}
+	out := new(ForceMergeTask)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCESDConfig) DeepCopyInto(out *GCESDConfig) {
 	*out = *in
@@ -1427,6 +1859,21 @@ func (in *HTTPConfig) DeepCopy() *HTTPConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRouteParentRef) DeepCopyInto(out *HTTPRouteParentRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteParentRef.
+func (in *HTTPRouteParentRef) DeepCopy() *HTTPRouteParentRef {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteParentRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPSDConfig) DeepCopyInto(out *HTTPSDConfig) {
 	*out = *in
@@ -1467,6 +1914,61 @@ func (in *HTTPSDConfig) DeepCopy() *HTTPSDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HetznerSDConfig) DeepCopyInto(out *HetznerSDConfig) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(Authorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(OAuth2)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProxyURL != nil {
+		in, out := &in.ProxyURL, &out.ProxyURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProxyClientConfig != nil {
+		in, out := &in.ProxyClientConfig, &out.ProxyClientConfig
+		*out = new(ProxyAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HetznerSDConfig.
+func (in *HetznerSDConfig) DeepCopy() *HetznerSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HetznerSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Image) DeepCopyInto(out *Image) {
 	*out = *in
@@ -1497,6 +1999,26 @@ func (in *ImageConfig) DeepCopy() *ImageConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfluxMigrationSource) DeepCopyInto(out *InfluxMigrationSource) {
+	*out = *in
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfluxMigrationSource.
+func (in *InfluxMigrationSource) DeepCopy() *InfluxMigrationSource {
+	if in == nil {
+		return nil
+	}
+	out := new(InfluxMigrationSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InhibitRule) DeepCopyInto(out *InhibitRule) {
 	*out = *in
@@ -1636,6 +2158,10 @@ func (in *License) DeepCopyInto(out *License) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new License.
@@ -1699,32 +2225,87 @@ func (in *MSTeamsConfig) DeepCopy() *MSTeamsConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamespaceDiscovery) DeepCopyInto(out *NamespaceDiscovery) {
+func (in *MaintenanceTaskTarget) DeepCopyInto(out *MaintenanceTaskTarget) {
 	*out = *in
-	if in.IncludeOwnNamespace != nil {
-		in, out := &in.IncludeOwnNamespace, &out.IncludeOwnNamespace
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Names != nil {
-		in, out := &in.Names, &out.Names
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceDiscovery.
-func (in *NamespaceDiscovery) DeepCopy() *NamespaceDiscovery {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceTaskTarget.
+func (in *MaintenanceTaskTarget) DeepCopy() *MaintenanceTaskTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(NamespaceDiscovery)
+	out := new(MaintenanceTaskTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamespaceSelector) DeepCopyInto(out *NamespaceSelector) {
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsqlExprTestCase) DeepCopyInto(out *MetricsqlExprTestCase) {
+	*out = *in
+	if in.ExpSamples != nil {
+		in, out := &in.ExpSamples, &out.ExpSamples
+		*out = make([]RuleTestExpSample, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsqlExprTestCase.
+func (in *MetricsqlExprTestCase) DeepCopy() *MetricsqlExprTestCase {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsqlExprTestCase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceDiscovery) DeepCopyInto(out *NamespaceDiscovery) {
+	*out = *in
+	if in.IncludeOwnNamespace != nil {
+		in, out := &in.IncludeOwnNamespace, &out.IncludeOwnNamespace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceDiscovery.
+func (in *NamespaceDiscovery) DeepCopy() *NamespaceDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceSelector) DeepCopyInto(out *NamespaceSelector) {
 	*out = *in
 	if in.MatchNames != nil {
 		in, out := &in.MatchNames, &out.MatchNames
@@ -1743,6 +2324,76 @@ func (in *NamespaceSelector) DeepCopy() *NamespaceSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NomadSDConfig) DeepCopyInto(out *NomadSDConfig) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.TagSeparator != nil {
+		in, out := &in.TagSeparator, &out.TagSeparator
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowStale != nil {
+		in, out := &in.AllowStale, &out.AllowStale
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(Authorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(OAuth2)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProxyURL != nil {
+		in, out := &in.ProxyURL, &out.ProxyURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProxyClientConfig != nil {
+		in, out := &in.ProxyClientConfig, &out.ProxyClientConfig
+		*out = new(ProxyAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NomadSDConfig.
+func (in *NomadSDConfig) DeepCopy() *NomadSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NomadSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OAuth2) DeepCopyInto(out *OAuth2) {
 	*out = *in
@@ -1776,6 +2427,36 @@ func (in *OAuth2) DeepCopy() *OAuth2 {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OVHCloudSDConfig) DeepCopyInto(out *OVHCloudSDConfig) {
+	*out = *in
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplicationSecret != nil {
+		in, out := &in.ApplicationSecret, &out.ApplicationSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConsumerKey != nil {
+		in, out := &in.ConsumerKey, &out.ConsumerKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVHCloudSDConfig.
+func (in *OVHCloudSDConfig) DeepCopy() *OVHCloudSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OVHCloudSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenStackSDConfig) DeepCopyInto(out *OpenStackSDConfig) {
 	*out = *in
@@ -1923,6 +2604,21 @@ func (in *OpsGenieConfigResponder) DeepCopy() *OpsGenieConfigResponder {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OverridePatch) DeepCopyInto(out *OverridePatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OverridePatch.
+func (in *OverridePatch) DeepCopy() *OverridePatch {
+	if in == nil {
+		return nil
+	}
+	out := new(OverridePatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PagerDutyConfig) DeepCopyInto(out *PagerDutyConfig) {
 	*out = *in
@@ -2032,6 +2728,46 @@ func (in *ProbeTargetIngress) DeepCopy() *ProbeTargetIngress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusMigrationSource) DeepCopyInto(out *PrometheusMigrationSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusMigrationSource.
+func (in *PrometheusMigrationSource) DeepCopy() *PrometheusMigrationSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusMigrationSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PropagationPolicy) DeepCopyInto(out *PropagationPolicy) {
+	*out = *in
+	if in.FilterLabelPrefixes != nil {
+		in, out := &in.FilterLabelPrefixes, &out.FilterLabelPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FilterAnnotationPrefixes != nil {
+		in, out := &in.FilterAnnotationPrefixes, &out.FilterAnnotationPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PropagationPolicy.
+func (in *PropagationPolicy) DeepCopy() *PropagationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PropagationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyAuth) DeepCopyInto(out *ProxyAuth) {
 	*out = *in
@@ -2062,6 +2798,66 @@ func (in *ProxyAuth) DeepCopy() *ProxyAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PuppetDBSDConfig) DeepCopyInto(out *PuppetDBSDConfig) {
+	*out = *in
+	if in.IncludeParameters != nil {
+		in, out := &in.IncludeParameters, &out.IncludeParameters
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(Authorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(OAuth2)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProxyURL != nil {
+		in, out := &in.ProxyURL, &out.ProxyURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProxyClientConfig != nil {
+		in, out := &in.ProxyClientConfig, &out.ProxyClientConfig
+		*out = new(ProxyAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PuppetDBSDConfig.
+func (in *PuppetDBSDConfig) DeepCopy() *PuppetDBSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PuppetDBSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PushoverConfig) DeepCopyInto(out *PushoverConfig) {
 	*out = *in
@@ -2405,29 +3201,254 @@ func (in *RuleGroup) DeepCopy() *RuleGroup {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScrapeObjectStatus) DeepCopyInto(out *ScrapeObjectStatus) {
+func (in *RuleTestExpAlert) DeepCopyInto(out *RuleTestExpAlert) {
 	*out = *in
+	if in.ExpLabels != nil {
+		in, out := &in.ExpLabels, &out.ExpLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExpAnnotations != nil {
+		in, out := &in.ExpAnnotations, &out.ExpAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeObjectStatus.
-func (in *ScrapeObjectStatus) DeepCopy() *ScrapeObjectStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleTestExpAlert.
+func (in *RuleTestExpAlert) DeepCopy() *RuleTestExpAlert {
 	if in == nil {
 		return nil
 	}
-	out := new(ScrapeObjectStatus)
+	out := new(RuleTestExpAlert)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretOrConfigMap) DeepCopyInto(out *SecretOrConfigMap) {
+func (in *RuleTestExpSample) DeepCopyInto(out *RuleTestExpSample) {
 	*out = *in
-	if in.Secret != nil {
-		in, out := &in.Secret, &out.Secret
-		*out = new(v1.SecretKeySelector)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleTestExpSample.
+func (in *RuleTestExpSample) DeepCopy() *RuleTestExpSample {
+	if in == nil {
+		return nil
 	}
-	if in.ConfigMap != nil {
+	out := new(RuleTestExpSample)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleTestGroup) DeepCopyInto(out *RuleTestGroup) {
+	*out = *in
+	if in.InputSeries != nil {
+		in, out := &in.InputSeries, &out.InputSeries
+		*out = make([]RuleTestInputSeries, len(*in))
+		copy(*out, *in)
+	}
+	if in.AlertRuleTests != nil {
+		in, out := &in.AlertRuleTests, &out.AlertRuleTests
+		*out = make([]AlertRuleTestCase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MetricsqlExprTests != nil {
+		in, out := &in.MetricsqlExprTests, &out.MetricsqlExprTests
+		*out = make([]MetricsqlExprTestCase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExternalLabels != nil {
+		in, out := &in.ExternalLabels, &out.ExternalLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleTestGroup.
+func (in *RuleTestGroup) DeepCopy() *RuleTestGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleTestGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleTestInputSeries) DeepCopyInto(out *RuleTestInputSeries) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleTestInputSeries.
+func (in *RuleTestInputSeries) DeepCopy() *RuleTestInputSeries {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleTestInputSeries)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeClass) DeepCopyInto(out *ScrapeClass) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RelabelConfigs != nil {
+		in, out := &in.RelabelConfigs, &out.RelabelConfigs
+		*out = make([]*RelabelConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RelabelConfig)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.MetricRelabelConfigs != nil {
+		in, out := &in.MetricRelabelConfigs, &out.MetricRelabelConfigs
+		*out = make([]*RelabelConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RelabelConfig)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AttachMetadata != nil {
+		in, out := &in.AttachMetadata, &out.AttachMetadata
+		*out = new(AttachMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeClass.
+func (in *ScrapeClass) DeepCopy() *ScrapeClass {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeLimits) DeepCopyInto(out *ScrapeLimits) {
+	*out = *in
+	if in.MaxTargets != nil {
+		in, out := &in.MaxTargets, &out.MaxTargets
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxSeriesPerScrape != nil {
+		in, out := &in.MaxSeriesPerScrape, &out.MaxSeriesPerScrape
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeLimits.
+func (in *ScrapeLimits) DeepCopy() *ScrapeLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeObjectLimits) DeepCopyInto(out *ScrapeObjectLimits) {
+	*out = *in
+	in.ScrapeLimits.DeepCopyInto(&out.ScrapeLimits)
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make(map[string]ScrapeLimits, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeObjectLimits.
+func (in *ScrapeObjectLimits) DeepCopy() *ScrapeObjectLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeObjectLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeObjectStatus) DeepCopyInto(out *ScrapeObjectStatus) {
+	*out = *in
+	if in.SelectedBy != nil {
+		in, out := &in.SelectedBy, &out.SelectedBy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]ScrapeObjectTargets, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeObjectStatus.
+func (in *ScrapeObjectStatus) DeepCopy() *ScrapeObjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeObjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeObjectTargets) DeepCopyInto(out *ScrapeObjectTargets) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeObjectTargets.
+func (in *ScrapeObjectTargets) DeepCopy() *ScrapeObjectTargets {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeObjectTargets)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretOrConfigMap) DeepCopyInto(out *SecretOrConfigMap) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMap != nil {
 		in, out := &in.ConfigMap, &out.ConfigMap
 		*out = new(v1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
@@ -2650,6 +3671,31 @@ func (in *SnsConfig) DeepCopy() *SnsConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatefulSetUpdateStrategy) DeepCopyInto(out *StatefulSetUpdateStrategy) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.PartitionStep != nil {
+		in, out := &in.PartitionStep, &out.PartitionStep
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatefulSetUpdateStrategy.
+func (in *StatefulSetUpdateStrategy) DeepCopy() *StatefulSetUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(StatefulSetUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StaticConfig) DeepCopyInto(out *StaticConfig) {
 	*out = *in
@@ -3112,6 +4158,48 @@ func (in *TelegramConfig) DeepCopy() *TelegramConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantLabelRouting) DeepCopyInto(out *TenantLabelRouting) {
+	*out = *in
+	if in.Tenants != nil {
+		in, out := &in.Tenants, &out.Tenants
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantLabelRouting.
+func (in *TenantLabelRouting) DeepCopy() *TenantLabelRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantLabelRouting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosMigrationSource) DeepCopyInto(out *ThanosMigrationSource) {
+	*out = *in
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosMigrationSource.
+func (in *ThanosMigrationSource) DeepCopy() *ThanosMigrationSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosMigrationSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeInterval) DeepCopyInto(out *TimeInterval) {
 	*out = *in
@@ -3347,7 +4435,7 @@ func (in *VLogs) DeepCopyInto(out *VLogs) {
 		*out = new(VLogsSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLogs.
@@ -3431,6 +4519,11 @@ func (in *VLogsSpec) DeepCopyInto(out *VLogsSpec) {
 		*out = new(EmbeddedProbes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceAccountImagePullSecrets != nil {
+		in, out := &in.ServiceAccountImagePullSecrets, &out.ServiceAccountImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLogsSpec.
@@ -3446,6 +4539,16 @@ func (in *VLogsSpec) DeepCopy() *VLogsSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VLogsStatus) DeepCopyInto(out *VLogsStatus) {
 	*out = *in
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]ChildObject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLogsStatus.
@@ -3469,7 +4572,7 @@ func (in *VMAgent) DeepCopyInto(out *VMAgent) {
 		*out = new(VMAgentSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAgent.
@@ -3490,6 +4593,26 @@ func (in *VMAgent) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMAgentClusterMode) DeepCopyInto(out *VMAgentClusterMode) {
+	*out = *in
+	if in.ReplicationFactor != nil {
+		in, out := &in.ReplicationFactor, &out.ReplicationFactor
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAgentClusterMode.
+func (in *VMAgentClusterMode) DeepCopy() *VMAgentClusterMode {
+	if in == nil {
+		return nil
+	}
+	out := new(VMAgentClusterMode)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMAgentList) DeepCopyInto(out *VMAgentList) {
 	*out = *in
@@ -3523,22 +4646,59 @@ func (in *VMAgentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VMAgentRemoteWriteSettings) DeepCopyInto(out *VMAgentRemoteWriteSettings) {
+func (in *VMAgentRemoteWriteMirror) DeepCopyInto(out *VMAgentRemoteWriteMirror) {
 	*out = *in
-	if in.MaxBlockSize != nil {
-		in, out := &in.MaxBlockSize, &out.MaxBlockSize
-		*out = new(int32)
-		**out = **in
+	in.VMAgentRemoteWriteSpec.DeepCopyInto(&out.VMAgentRemoteWriteSpec)
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
 	}
-	if in.MaxDiskUsagePerURL != nil {
-		in, out := &in.MaxDiskUsagePerURL, &out.MaxDiskUsagePerURL
-		*out = new(int64)
-		**out = **in
+	in.StopTime.DeepCopyInto(&out.StopTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAgentRemoteWriteMirror.
+func (in *VMAgentRemoteWriteMirror) DeepCopy() *VMAgentRemoteWriteMirror {
+	if in == nil {
+		return nil
 	}
-	if in.Queues != nil {
-		in, out := &in.Queues, &out.Queues
-		*out = new(int32)
-		**out = **in
+	out := new(VMAgentRemoteWriteMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMAgentRemoteWriteMirrorStatus) DeepCopyInto(out *VMAgentRemoteWriteMirrorStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAgentRemoteWriteMirrorStatus.
+func (in *VMAgentRemoteWriteMirrorStatus) DeepCopy() *VMAgentRemoteWriteMirrorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VMAgentRemoteWriteMirrorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMAgentRemoteWriteSettings) DeepCopyInto(out *VMAgentRemoteWriteSettings) {
+	*out = *in
+	if in.MaxBlockSize != nil {
+		in, out := &in.MaxBlockSize, &out.MaxBlockSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxDiskUsagePerURL != nil {
+		in, out := &in.MaxDiskUsagePerURL, &out.MaxDiskUsagePerURL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Queues != nil {
+		in, out := &in.Queues, &out.Queues
+		*out = new(int32)
+		**out = **in
 	}
 	if in.ShowURL != nil {
 		in, out := &in.ShowURL, &out.ShowURL
@@ -3599,6 +4759,11 @@ func (in *VMAgentRemoteWriteSpec) DeepCopyInto(out *VMAgentRemoteWriteSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.UrlRelabelConfigRefs != nil {
+		in, out := &in.UrlRelabelConfigRefs, &out.UrlRelabelConfigRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
 		*out = new(OAuth2)
@@ -3624,6 +4789,21 @@ func (in *VMAgentRemoteWriteSpec) DeepCopyInto(out *VMAgentRemoteWriteSpec) {
 		*out = new(StreamAggrConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TenantLabelRouting != nil {
+		in, out := &in.TenantLabelRouting, &out.TenantLabelRouting
+		*out = new(TenantLabelRouting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ThroughputHint != nil {
+		in, out := &in.ThroughputHint, &out.ThroughputHint
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAgentRemoteWriteSpec.
@@ -3636,10 +4816,55 @@ func (in *VMAgentRemoteWriteSpec) DeepCopy() *VMAgentRemoteWriteSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMAgentRemoteWriteThroughputStatus) DeepCopyInto(out *VMAgentRemoteWriteThroughputStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAgentRemoteWriteThroughputStatus.
+func (in *VMAgentRemoteWriteThroughputStatus) DeepCopy() *VMAgentRemoteWriteThroughputStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VMAgentRemoteWriteThroughputStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMAgentScrapeGlobalConfigStatus) DeepCopyInto(out *VMAgentScrapeGlobalConfigStatus) {
+	*out = *in
+	if in.Overridden != nil {
+		in, out := &in.Overridden, &out.Overridden
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ignored != nil {
+		in, out := &in.Ignored, &out.Ignored
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAgentScrapeGlobalConfigStatus.
+func (in *VMAgentScrapeGlobalConfigStatus) DeepCopy() *VMAgentScrapeGlobalConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VMAgentScrapeGlobalConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMAgentSecurityEnforcements) DeepCopyInto(out *VMAgentSecurityEnforcements) {
 	*out = *in
 	out.ArbitraryFSAccessThroughSMs = in.ArbitraryFSAccessThroughSMs
+	if in.ScrapeObjectLimits != nil {
+		in, out := &in.ScrapeObjectLimits, &out.ScrapeObjectLimits
+		*out = new(ScrapeObjectLimits)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAgentSecurityEnforcements.
@@ -3689,6 +4914,11 @@ func (in *VMAgentSpec) DeepCopyInto(out *VMAgentSpec) {
 		*out = new(VMAgentRemoteWriteSettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RemoteWriteMirror != nil {
+		in, out := &in.RemoteWriteMirror, &out.RemoteWriteMirror
+		*out = new(VMAgentRemoteWriteMirror)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RelabelConfig != nil {
 		in, out := &in.RelabelConfig, &out.RelabelConfig
 		*out = new(v1.ConfigMapKeySelector)
@@ -3791,6 +5021,11 @@ func (in *VMAgentSpec) DeepCopyInto(out *VMAgentSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.ClusterMode != nil {
+		in, out := &in.ClusterMode, &out.ClusterMode
+		*out = new(VMAgentClusterMode)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.UpdateStrategy != nil {
 		in, out := &in.UpdateStrategy, &out.UpdateStrategy
 		*out = new(appsv1.DeploymentStrategyType)
@@ -3811,6 +5046,11 @@ func (in *VMAgentSpec) DeepCopyInto(out *VMAgentSpec) {
 		*out = new(EmbeddedProbes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HPA != nil {
+		in, out := &in.HPA, &out.HPA
+		*out = new(EmbeddedHPA)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ServiceScrapeRelabelTemplate != nil {
 		in, out := &in.ServiceScrapeRelabelTemplate, &out.ServiceScrapeRelabelTemplate
 		*out = make([]*RelabelConfig, len(*in))
@@ -3877,6 +5117,13 @@ func (in *VMAgentSpec) DeepCopyInto(out *VMAgentSpec) {
 			}
 		}
 	}
+	if in.ScrapeClasses != nil {
+		in, out := &in.ScrapeClasses, &out.ScrapeClasses
+		*out = make([]ScrapeClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.MinScrapeInterval != nil {
 		in, out := &in.MinScrapeInterval, &out.MinScrapeInterval
 		*out = new(string)
@@ -3892,6 +5139,11 @@ func (in *VMAgentSpec) DeepCopyInto(out *VMAgentSpec) {
 		*out = new(StorageSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StatefulUpdateStrategy != nil {
+		in, out := &in.StatefulUpdateStrategy, &out.StatefulUpdateStrategy
+		*out = new(StatefulSetUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ClaimTemplates != nil {
 		in, out := &in.ClaimTemplates, &out.ClaimTemplates
 		*out = make([]v1.PersistentVolumeClaim, len(*in))
@@ -3899,12 +5151,32 @@ func (in *VMAgentSpec) DeepCopyInto(out *VMAgentSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ScrapeClientCertManager != nil {
+		in, out := &in.ScrapeClientCertManager, &out.ScrapeClientCertManager
+		*out = new(CertManagerCertificate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CardinalityLimits != nil {
+		in, out := &in.CardinalityLimits, &out.CardinalityLimits
+		*out = new(CardinalityLimits)
+		**out = **in
+	}
+	if in.DefaultRules != nil {
+		in, out := &in.DefaultRules, &out.DefaultRules
+		*out = new(DefaultRules)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.License != nil {
 		in, out := &in.License, &out.License
 		*out = new(License)
 		(*in).DeepCopyInto(*out)
 	}
-	out.VMAgentSecurityEnforcements = in.VMAgentSecurityEnforcements
+	if in.ServiceAccountImagePullSecrets != nil {
+		in, out := &in.ServiceAccountImagePullSecrets, &out.ServiceAccountImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	in.VMAgentSecurityEnforcements.DeepCopyInto(&out.VMAgentSecurityEnforcements)
 	in.CommonDefaultableParams.DeepCopyInto(&out.CommonDefaultableParams)
 	in.CommonConfigReloaderParams.DeepCopyInto(&out.CommonConfigReloaderParams)
 	in.CommonApplicationDeploymentParams.DeepCopyInto(&out.CommonApplicationDeploymentParams)
@@ -3923,6 +5195,36 @@ func (in *VMAgentSpec) DeepCopy() *VMAgentSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMAgentStatus) DeepCopyInto(out *VMAgentStatus) {
 	*out = *in
+	if in.RemoteWriteMirror != nil {
+		in, out := &in.RemoteWriteMirror, &out.RemoteWriteMirror
+		*out = new(VMAgentRemoteWriteMirrorStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalScrapeConfigsErrors != nil {
+		in, out := &in.AdditionalScrapeConfigsErrors, &out.AdditionalScrapeConfigsErrors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ScrapeGlobalConfig != nil {
+		in, out := &in.ScrapeGlobalConfig, &out.ScrapeGlobalConfig
+		*out = new(VMAgentScrapeGlobalConfigStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemoteWriteThroughput != nil {
+		in, out := &in.RemoteWriteThroughput, &out.RemoteWriteThroughput
+		*out = make([]VMAgentRemoteWriteThroughputStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]ChildObject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAgentStatus.
@@ -3946,7 +5248,7 @@ func (in *VMAlert) DeepCopyInto(out *VMAlert) {
 		*out = new(VMAlertSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAlert.
@@ -4138,11 +5440,21 @@ func (in *VMAlertSpec) DeepCopyInto(out *VMAlertSpec) {
 		*out = new(VMAlertRemoteReadSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StatePersistence != nil {
+		in, out := &in.StatePersistence, &out.StatePersistence
+		*out = new(VMAlertStatePersistence)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RulePath != nil {
 		in, out := &in.RulePath, &out.RulePath
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ConfigValidation != nil {
+		in, out := &in.ConfigValidation, &out.ConfigValidation
+		*out = new(ConfigValidation)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Datasource.DeepCopyInto(&out.Datasource)
 	if in.ExternalLabels != nil {
 		in, out := &in.ExternalLabels, &out.ExternalLabels
@@ -4161,6 +5473,11 @@ func (in *VMAlertSpec) DeepCopyInto(out *VMAlertSpec) {
 		*out = new(VMServiceScrapeSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShardCount != nil {
+		in, out := &in.ShardCount, &out.ShardCount
+		*out = new(int)
+		**out = **in
+	}
 	if in.UpdateStrategy != nil {
 		in, out := &in.UpdateStrategy, &out.UpdateStrategy
 		*out = new(appsv1.DeploymentStrategyType)
@@ -4186,6 +5503,11 @@ func (in *VMAlertSpec) DeepCopyInto(out *VMAlertSpec) {
 		*out = new(License)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceAccountImagePullSecrets != nil {
+		in, out := &in.ServiceAccountImagePullSecrets, &out.ServiceAccountImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
 	in.CommonDefaultableParams.DeepCopyInto(&out.CommonDefaultableParams)
 	in.CommonConfigReloaderParams.DeepCopyInto(&out.CommonConfigReloaderParams)
 	in.CommonApplicationDeploymentParams.DeepCopyInto(&out.CommonApplicationDeploymentParams)
@@ -4201,9 +5523,39 @@ func (in *VMAlertSpec) DeepCopy() *VMAlertSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMAlertStatePersistence) DeepCopyInto(out *VMAlertStatePersistence) {
+	*out = *in
+	if in.Lookback != nil {
+		in, out := &in.Lookback, &out.Lookback
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAlertStatePersistence.
+func (in *VMAlertStatePersistence) DeepCopy() *VMAlertStatePersistence {
+	if in == nil {
+		return nil
+	}
+	out := new(VMAlertStatePersistence)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMAlertStatus) DeepCopyInto(out *VMAlertStatus) {
 	*out = *in
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]ChildObject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAlertStatus.
@@ -4227,7 +5579,7 @@ func (in *VMAlertmanager) DeepCopyInto(out *VMAlertmanager) {
 		*out = new(VMAlertmanagerSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAlertmanager.
@@ -4448,11 +5800,26 @@ func (in *VMAlertmanagerSpec) DeepCopyInto(out *VMAlertmanagerSpec) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TemplateSelector != nil {
+		in, out := &in.TemplateSelector, &out.TemplateSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemplateNamespaceSelector != nil {
+		in, out := &in.TemplateNamespaceSelector, &out.TemplateNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EnforcedTopRouteMatchers != nil {
 		in, out := &in.EnforcedTopRouteMatchers, &out.EnforcedTopRouteMatchers
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(StatefulSetUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ClaimTemplates != nil {
 		in, out := &in.ClaimTemplates, &out.ClaimTemplates
 		*out = make([]v1.PersistentVolumeClaim, len(*in))
@@ -4475,6 +5842,26 @@ func (in *VMAlertmanagerSpec) DeepCopyInto(out *VMAlertmanagerSpec) {
 		*out = new(AlertmanagerGossipConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GossipService != nil {
+		in, out := &in.GossipService, &out.GossipService
+		*out = new(AlertmanagerGossipService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultRules != nil {
+		in, out := &in.DefaultRules, &out.DefaultRules
+		*out = new(DefaultRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigValidation != nil {
+		in, out := &in.ConfigValidation, &out.ConfigValidation
+		*out = new(ConfigValidation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountImagePullSecrets != nil {
+		in, out := &in.ServiceAccountImagePullSecrets, &out.ServiceAccountImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
 	in.CommonDefaultableParams.DeepCopyInto(&out.CommonDefaultableParams)
 	in.CommonConfigReloaderParams.DeepCopyInto(&out.CommonConfigReloaderParams)
 	in.CommonApplicationDeploymentParams.DeepCopyInto(&out.CommonApplicationDeploymentParams)
@@ -4493,6 +5880,16 @@ func (in *VMAlertmanagerSpec) DeepCopy() *VMAlertmanagerSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMAlertmanagerStatus) DeepCopyInto(out *VMAlertmanagerStatus) {
 	*out = *in
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]ChildObject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAlertmanagerStatus.
@@ -4506,31 +5903,26 @@ func (in *VMAlertmanagerStatus) DeepCopy() *VMAlertmanagerStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VMAuth) DeepCopyInto(out *VMAuth) {
+func (in *VMAlertmanagerTemplate) DeepCopyInto(out *VMAlertmanagerTemplate) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	if in.ParsedLastAppliedSpec != nil {
-		in, out := &in.ParsedLastAppliedSpec, &out.ParsedLastAppliedSpec
-		*out = new(VMAuthSpec)
-		(*in).DeepCopyInto(*out)
-	}
 	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAuth.
-func (in *VMAuth) DeepCopy() *VMAuth {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAlertmanagerTemplate.
+func (in *VMAlertmanagerTemplate) DeepCopy() *VMAlertmanagerTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(VMAuth)
+	out := new(VMAlertmanagerTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VMAuth) DeepCopyObject() runtime.Object {
+func (in *VMAlertmanagerTemplate) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4538,31 +5930,31 @@ func (in *VMAuth) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VMAuthList) DeepCopyInto(out *VMAuthList) {
+func (in *VMAlertmanagerTemplateList) DeepCopyInto(out *VMAlertmanagerTemplateList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]VMAuth, len(*in))
+		*out = make([]VMAlertmanagerTemplate, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAuthList.
-func (in *VMAuthList) DeepCopy() *VMAuthList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAlertmanagerTemplateList.
+func (in *VMAlertmanagerTemplateList) DeepCopy() *VMAlertmanagerTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(VMAuthList)
+	out := new(VMAlertmanagerTemplateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VMAuthList) DeepCopyObject() runtime.Object {
+func (in *VMAlertmanagerTemplateList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4570,93 +5962,284 @@ func (in *VMAuthList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VMAuthSpec) DeepCopyInto(out *VMAuthSpec) {
+func (in *VMAlertmanagerTemplateSpec) DeepCopyInto(out *VMAlertmanagerTemplateSpec) {
 	*out = *in
-	if in.PodMetadata != nil {
-		in, out := &in.PodMetadata, &out.PodMetadata
-		*out = new(EmbeddedObjectMetadata)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.UserSelector != nil {
-		in, out := &in.UserSelector, &out.UserSelector
-		*out = new(metav1.LabelSelector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.UserNamespaceSelector != nil {
-		in, out := &in.UserNamespaceSelector, &out.UserNamespaceSelector
-		*out = new(metav1.LabelSelector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ServiceSpec != nil {
-		in, out := &in.ServiceSpec, &out.ServiceSpec
-		*out = new(AdditionalServiceSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ServiceScrapeSpec != nil {
-		in, out := &in.ServiceScrapeSpec, &out.ServiceScrapeSpec
-		*out = new(VMServiceScrapeSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PodDisruptionBudget != nil {
-		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
-		*out = new(EmbeddedPodDisruptionBudgetSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Ingress != nil {
-		in, out := &in.Ingress, &out.Ingress
-		*out = new(EmbeddedIngress)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.EmbeddedProbes != nil {
-		in, out := &in.EmbeddedProbes, &out.EmbeddedProbes
-		*out = new(EmbeddedProbes)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.UnauthorizedAccessConfig != nil {
-		in, out := &in.UnauthorizedAccessConfig, &out.UnauthorizedAccessConfig
-		*out = make([]UnauthorizedAccessConfigURLMap, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
-	in.UserConfigOption.DeepCopyInto(&out.UserConfigOption)
-	if in.License != nil {
-		in, out := &in.License, &out.License
-		*out = new(License)
-		(*in).DeepCopyInto(*out)
-	}
-	in.CommonDefaultableParams.DeepCopyInto(&out.CommonDefaultableParams)
-	in.CommonConfigReloaderParams.DeepCopyInto(&out.CommonConfigReloaderParams)
-	in.CommonApplicationDeploymentParams.DeepCopyInto(&out.CommonApplicationDeploymentParams)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAuthSpec.
-func (in *VMAuthSpec) DeepCopy() *VMAuthSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAlertmanagerTemplateSpec.
+func (in *VMAlertmanagerTemplateSpec) DeepCopy() *VMAlertmanagerTemplateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VMAuthSpec)
+	out := new(VMAlertmanagerTemplateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VMAuthStatus) DeepCopyInto(out *VMAuthStatus) {
+func (in *VMAlertmanagerTemplateStatus) DeepCopyInto(out *VMAlertmanagerTemplateStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAuthStatus.
-func (in *VMAuthStatus) DeepCopy() *VMAuthStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAlertmanagerTemplateStatus.
+func (in *VMAlertmanagerTemplateStatus) DeepCopy() *VMAlertmanagerTemplateStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VMAuthStatus)
+	out := new(VMAlertmanagerTemplateStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VMBackup) DeepCopyInto(out *VMBackup) {
+func (in *VMAuth) DeepCopyInto(out *VMAuth) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.ParsedLastAppliedSpec != nil {
+		in, out := &in.ParsedLastAppliedSpec, &out.ParsedLastAppliedSpec
+		*out = new(VMAuthSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAuth.
+func (in *VMAuth) DeepCopy() *VMAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(VMAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMAuth) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMAuthConfigRollout) DeepCopyInto(out *VMAuthConfigRollout) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAuthConfigRollout.
+func (in *VMAuthConfigRollout) DeepCopy() *VMAuthConfigRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(VMAuthConfigRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMAuthList) DeepCopyInto(out *VMAuthList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VMAuth, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAuthList.
+func (in *VMAuthList) DeepCopy() *VMAuthList {
+	if in == nil {
+		return nil
+	}
+	out := new(VMAuthList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMAuthList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMAuthSpec) DeepCopyInto(out *VMAuthSpec) {
+	*out = *in
+	if in.PodMetadata != nil {
+		in, out := &in.PodMetadata, &out.PodMetadata
+		*out = new(EmbeddedObjectMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserSelector != nil {
+		in, out := &in.UserSelector, &out.UserSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserNamespaceSelector != nil {
+		in, out := &in.UserNamespaceSelector, &out.UserNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceSpec != nil {
+		in, out := &in.ServiceSpec, &out.ServiceSpec
+		*out = new(AdditionalServiceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceScrapeSpec != nil {
+		in, out := &in.ServiceScrapeSpec, &out.ServiceScrapeSpec
+		*out = new(VMServiceScrapeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(EmbeddedPodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(EmbeddedIngress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPRoute != nil {
+		in, out := &in.HTTPRoute, &out.HTTPRoute
+		*out = new(EmbeddedHTTPRoute)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertManager != nil {
+		in, out := &in.CertManager, &out.CertManager
+		*out = new(CertManagerCertificate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EmbeddedProbes != nil {
+		in, out := &in.EmbeddedProbes, &out.EmbeddedProbes
+		*out = new(EmbeddedProbes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UnauthorizedAccessConfig != nil {
+		in, out := &in.UnauthorizedAccessConfig, &out.UnauthorizedAccessConfig
+		*out = make([]UnauthorizedAccessConfigURLMap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UnauthorizedUserAccessSpec != nil {
+		in, out := &in.UnauthorizedUserAccessSpec, &out.UnauthorizedUserAccessSpec
+		*out = new(VMAuthUnauthorizedUserAccessSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GlobalIPFilters != nil {
+		in, out := &in.GlobalIPFilters, &out.GlobalIPFilters
+		*out = new(VMUserIPFilters)
+		(*in).DeepCopyInto(*out)
+	}
+	in.UserConfigOption.DeepCopyInto(&out.UserConfigOption)
+	if in.License != nil {
+		in, out := &in.License, &out.License
+		*out = new(License)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigRollout != nil {
+		in, out := &in.ConfigRollout, &out.ConfigRollout
+		*out = new(VMAuthConfigRollout)
+		**out = **in
+	}
+	if in.ServiceAccountImagePullSecrets != nil {
+		in, out := &in.ServiceAccountImagePullSecrets, &out.ServiceAccountImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	in.CommonDefaultableParams.DeepCopyInto(&out.CommonDefaultableParams)
+	in.CommonConfigReloaderParams.DeepCopyInto(&out.CommonConfigReloaderParams)
+	in.CommonApplicationDeploymentParams.DeepCopyInto(&out.CommonApplicationDeploymentParams)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAuthSpec.
+func (in *VMAuthSpec) DeepCopy() *VMAuthSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VMAuthSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMAuthStatus) DeepCopyInto(out *VMAuthStatus) {
+	*out = *in
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]ChildObject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAuthStatus.
+func (in *VMAuthStatus) DeepCopy() *VMAuthStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VMAuthStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMAuthUnauthorizedUserAccessSpec) DeepCopyInto(out *VMAuthUnauthorizedUserAccessSpec) {
+	*out = *in
+	if in.URLPrefix != nil {
+		in, out := &in.URLPrefix, &out.URLPrefix
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.URLMap != nil {
+		in, out := &in.URLMap, &out.URLMap
+		*out = make([]UnauthorizedAccessConfigURLMap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.UserConfigOption.DeepCopyInto(&out.UserConfigOption)
+	if in.MetricLabels != nil {
+		in, out := &in.MetricLabels, &out.MetricLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMAuthUnauthorizedUserAccessSpec.
+func (in *VMAuthUnauthorizedUserAccessSpec) DeepCopy() *VMAuthUnauthorizedUserAccessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VMAuthUnauthorizedUserAccessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMBackup) DeepCopyInto(out *VMBackup) {
 	*out = *in
 	if in.Concurrency != nil {
 		in, out := &in.Concurrency, &out.Concurrency
@@ -4754,7 +6337,7 @@ func (in *VMCluster) DeepCopyInto(out *VMCluster) {
 		*out = new(VMClusterSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMCluster.
@@ -4810,11 +6393,26 @@ func (in *VMClusterList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMClusterSpec) DeepCopyInto(out *VMClusterSpec) {
 	*out = *in
+	if in.RetentionFilters != nil {
+		in, out := &in.RetentionFilters, &out.RetentionFilters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DownsamplingPeriods != nil {
+		in, out := &in.DownsamplingPeriods, &out.DownsamplingPeriods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.ReplicationFactor != nil {
 		in, out := &in.ReplicationFactor, &out.ReplicationFactor
 		*out = new(int32)
 		**out = **in
 	}
+	if in.ServiceAccountImagePullSecrets != nil {
+		in, out := &in.ServiceAccountImagePullSecrets, &out.ServiceAccountImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
 	if in.ImagePullSecrets != nil {
 		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
 		*out = make([]v1.LocalObjectReference, len(*in))
@@ -4840,6 +6438,11 @@ func (in *VMClusterSpec) DeepCopyInto(out *VMClusterSpec) {
 		*out = new(VMStorage)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DefaultRules != nil {
+		in, out := &in.DefaultRules, &out.DefaultRules
+		*out = new(DefaultRules)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.UseStrictSecurity != nil {
 		in, out := &in.UseStrictSecurity, &out.UseStrictSecurity
 		*out = new(bool)
@@ -4860,6 +6463,21 @@ func (in *VMClusterSpec) DeepCopy() *VMClusterSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMClusterStatus) DeepCopyInto(out *VMClusterStatus) {
 	*out = *in
+	if in.StorageDrain != nil {
+		in, out := &in.StorageDrain, &out.StorageDrain
+		*out = new(VMStorageDrainStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]ChildObject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMClusterStatus.
@@ -4873,21 +6491,142 @@ func (in *VMClusterStatus) DeepCopy() *VMClusterStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VMInsert) DeepCopyInto(out *VMInsert) {
+func (in *VMDataMigration) DeepCopyInto(out *VMDataMigration) {
 	*out = *in
-	if in.PodMetadata != nil {
-		in, out := &in.PodMetadata, &out.PodMetadata
-		*out = new(EmbeddedObjectMetadata)
-		(*in).DeepCopyInto(*out)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMDataMigration.
+func (in *VMDataMigration) DeepCopy() *VMDataMigration {
+	if in == nil {
+		return nil
 	}
-	if in.InsertPorts != nil {
-		in, out := &in.InsertPorts, &out.InsertPorts
-		*out = new(InsertPorts)
-		**out = **in
+	out := new(VMDataMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMDataMigration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.ServiceSpec != nil {
-		in, out := &in.ServiceSpec, &out.ServiceSpec
-		*out = new(AdditionalServiceSpec)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMDataMigrationList) DeepCopyInto(out *VMDataMigrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VMDataMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMDataMigrationList.
+func (in *VMDataMigrationList) DeepCopy() *VMDataMigrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(VMDataMigrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMDataMigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMDataMigrationSpec) DeepCopyInto(out *VMDataMigrationSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	out.Destination = in.Destination
+	in.TimeRange.DeepCopyInto(&out.TimeRange)
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ExtraArgs != nil {
+		in, out := &in.ExtraArgs, &out.ExtraArgs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExtraEnvs != nil {
+		in, out := &in.ExtraEnvs, &out.ExtraEnvs
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Image = in.Image
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMDataMigrationSpec.
+func (in *VMDataMigrationSpec) DeepCopy() *VMDataMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VMDataMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMDataMigrationStatus) DeepCopyInto(out *VMDataMigrationStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMDataMigrationStatus.
+func (in *VMDataMigrationStatus) DeepCopy() *VMDataMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VMDataMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMInsert) DeepCopyInto(out *VMInsert) {
+	*out = *in
+	if in.PodMetadata != nil {
+		in, out := &in.PodMetadata, &out.PodMetadata
+		*out = new(EmbeddedObjectMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InsertPorts != nil {
+		in, out := &in.InsertPorts, &out.InsertPorts
+		*out = new(InsertPorts)
+		**out = **in
+	}
+	if in.ServiceSpec != nil {
+		in, out := &in.ServiceSpec, &out.ServiceSpec
+		*out = new(AdditionalServiceSpec)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ServiceScrapeSpec != nil {
@@ -4934,13 +6673,123 @@ func (in *VMInsert) DeepCopy() *VMInsert {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMMaintenanceTask) DeepCopyInto(out *VMMaintenanceTask) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMMaintenanceTask.
+func (in *VMMaintenanceTask) DeepCopy() *VMMaintenanceTask {
+	if in == nil {
+		return nil
+	}
+	out := new(VMMaintenanceTask)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMMaintenanceTask) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMMaintenanceTaskList) DeepCopyInto(out *VMMaintenanceTaskList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VMMaintenanceTask, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMMaintenanceTaskList.
+func (in *VMMaintenanceTaskList) DeepCopy() *VMMaintenanceTaskList {
+	if in == nil {
+		return nil
+	}
+	out := new(VMMaintenanceTaskList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMMaintenanceTaskList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMMaintenanceTaskSpec) DeepCopyInto(out *VMMaintenanceTaskSpec) {
+	*out = *in
+	out.Target = in.Target
+	if in.ForceMerge != nil {
+		in, out := &in.ForceMerge, &out.ForceMerge
+		*out = new(ForceMergeTask)
+		**out = **in
+	}
+	if in.DeleteSeries != nil {
+		in, out := &in.DeleteSeries, &out.DeleteSeries
+		*out = new(DeleteSeriesTask)
+		(*in).DeepCopyInto(*out)
+	}
+	out.Image = in.Image
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMMaintenanceTaskSpec.
+func (in *VMMaintenanceTaskSpec) DeepCopy() *VMMaintenanceTaskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VMMaintenanceTaskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMMaintenanceTaskStatus) DeepCopyInto(out *VMMaintenanceTaskStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMMaintenanceTaskStatus.
+func (in *VMMaintenanceTaskStatus) DeepCopy() *VMMaintenanceTaskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VMMaintenanceTaskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMNodeScrape) DeepCopyInto(out *VMNodeScrape) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMNodeScrape.
@@ -5017,13 +6866,103 @@ func (in *VMNodeScrapeSpec) DeepCopy() *VMNodeScrapeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMOperatorSettings) DeepCopyInto(out *VMOperatorSettings) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMOperatorSettings.
+func (in *VMOperatorSettings) DeepCopy() *VMOperatorSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(VMOperatorSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMOperatorSettings) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMOperatorSettingsList) DeepCopyInto(out *VMOperatorSettingsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VMOperatorSettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMOperatorSettingsList.
+func (in *VMOperatorSettingsList) DeepCopy() *VMOperatorSettingsList {
+	if in == nil {
+		return nil
+	}
+	out := new(VMOperatorSettingsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMOperatorSettingsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMOperatorSettingsSpec) DeepCopyInto(out *VMOperatorSettingsSpec) {
+	*out = *in
+	if in.ConfigReloaderResources != nil {
+		in, out := &in.ConfigReloaderResources, &out.ConfigReloaderResources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Propagation != nil {
+		in, out := &in.Propagation, &out.Propagation
+		*out = new(PropagationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMOperatorSettingsSpec.
+func (in *VMOperatorSettingsSpec) DeepCopy() *VMOperatorSettingsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VMOperatorSettingsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMPodScrape) DeepCopyInto(out *VMPodScrape) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMPodScrape.
@@ -5094,6 +7033,11 @@ func (in *VMPodScrapeSpec) DeepCopyInto(out *VMPodScrapeSpec) {
 	in.Selector.DeepCopyInto(&out.Selector)
 	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
 	in.AttachMetadata.DeepCopyInto(&out.AttachMetadata)
+	if in.ScrapeClassName != nil {
+		in, out := &in.ScrapeClassName, &out.ScrapeClassName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMPodScrapeSpec.
@@ -5112,7 +7056,7 @@ func (in *VMProbe) DeepCopyInto(out *VMProbe) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMProbe.
@@ -5168,7 +7112,7 @@ func (in *VMProbeList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMProbeSpec) DeepCopyInto(out *VMProbeSpec) {
 	*out = *in
-	out.VMProberSpec = in.VMProberSpec
+	in.VMProberSpec.DeepCopyInto(&out.VMProberSpec)
 	in.Targets.DeepCopyInto(&out.Targets)
 	if in.MetricRelabelConfigs != nil {
 		in, out := &in.MetricRelabelConfigs, &out.MetricRelabelConfigs
@@ -5261,6 +7205,16 @@ func (in *VMProbeTargets) DeepCopy() *VMProbeTargets {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMProberSpec) DeepCopyInto(out *VMProberSpec) {
 	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMProberSpec.
@@ -5274,42 +7228,331 @@ func (in *VMProberSpec) DeepCopy() *VMProberSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VMRestore) DeepCopyInto(out *VMRestore) {
+func (in *VMRelabelConfig) DeepCopyInto(out *VMRelabelConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRelabelConfig.
+func (in *VMRelabelConfig) DeepCopy() *VMRelabelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VMRelabelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMRelabelConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMRelabelConfigList) DeepCopyInto(out *VMRelabelConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VMRelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRelabelConfigList.
+func (in *VMRelabelConfigList) DeepCopy() *VMRelabelConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(VMRelabelConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMRelabelConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMRelabelConfigSpec) DeepCopyInto(out *VMRelabelConfigSpec) {
+	*out = *in
+	if in.RelabelConfigs != nil {
+		in, out := &in.RelabelConfigs, &out.RelabelConfigs
+		*out = make([]RelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRelabelConfigSpec.
+func (in *VMRelabelConfigSpec) DeepCopy() *VMRelabelConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VMRelabelConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMRemoteWriteTarget) DeepCopyInto(out *VMRemoteWriteTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRemoteWriteTarget.
+func (in *VMRemoteWriteTarget) DeepCopy() *VMRemoteWriteTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(VMRemoteWriteTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMRemoteWriteTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMRemoteWriteTargetList) DeepCopyInto(out *VMRemoteWriteTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VMRemoteWriteTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRemoteWriteTargetList.
+func (in *VMRemoteWriteTargetList) DeepCopy() *VMRemoteWriteTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(VMRemoteWriteTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMRemoteWriteTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMRemoteWriteTargetSpec) DeepCopyInto(out *VMRemoteWriteTargetSpec) {
+	*out = *in
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BearerTokenSecret != nil {
+		in, out := &in.BearerTokenSecret, &out.BearerTokenSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(OAuth2)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SendTimeout != nil {
+		in, out := &in.SendTimeout, &out.SendTimeout
+		*out = new(string)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRemoteWriteTargetSpec.
+func (in *VMRemoteWriteTargetSpec) DeepCopy() *VMRemoteWriteTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VMRemoteWriteTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMRestore) DeepCopyInto(out *VMRestore) {
+	*out = *in
+	if in.OnStart != nil {
+		in, out := &in.OnStart, &out.OnStart
+		*out = new(VMRestoreOnStartConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRestore.
+func (in *VMRestore) DeepCopy() *VMRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(VMRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMRestoreOnStartConfig) DeepCopyInto(out *VMRestoreOnStartConfig) {
+	*out = *in
+	out.Image = in.Image
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRestoreOnStartConfig.
+func (in *VMRestoreOnStartConfig) DeepCopy() *VMRestoreOnStartConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VMRestoreOnStartConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMRule) DeepCopyInto(out *VMRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRule.
+func (in *VMRule) DeepCopy() *VMRule {
+	if in == nil {
+		return nil
+	}
+	out := new(VMRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMRuleList) DeepCopyInto(out *VMRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*VMRule, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VMRule)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRuleList.
+func (in *VMRuleList) DeepCopy() *VMRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(VMRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMRuleSpec) DeepCopyInto(out *VMRuleSpec) {
 	*out = *in
-	if in.OnStart != nil {
-		in, out := &in.OnStart, &out.OnStart
-		*out = new(VMRestoreOnStartConfig)
-		**out = **in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]RuleGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRestore.
-func (in *VMRestore) DeepCopy() *VMRestore {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRuleSpec.
+func (in *VMRuleSpec) DeepCopy() *VMRuleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VMRestore)
+	out := new(VMRuleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VMRestoreOnStartConfig) DeepCopyInto(out *VMRestoreOnStartConfig) {
+func (in *VMRuleStatus) DeepCopyInto(out *VMRuleStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRestoreOnStartConfig.
-func (in *VMRestoreOnStartConfig) DeepCopy() *VMRestoreOnStartConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRuleStatus.
+func (in *VMRuleStatus) DeepCopy() *VMRuleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VMRestoreOnStartConfig)
+	out := new(VMRuleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VMRule) DeepCopyInto(out *VMRule) {
+func (in *VMRuleTest) DeepCopyInto(out *VMRuleTest) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -5317,18 +7560,18 @@ func (in *VMRule) DeepCopyInto(out *VMRule) {
 	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRule.
-func (in *VMRule) DeepCopy() *VMRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRuleTest.
+func (in *VMRuleTest) DeepCopy() *VMRuleTest {
 	if in == nil {
 		return nil
 	}
-	out := new(VMRule)
+	out := new(VMRuleTest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VMRule) DeepCopyObject() runtime.Object {
+func (in *VMRuleTest) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -5336,35 +7579,31 @@ func (in *VMRule) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VMRuleList) DeepCopyInto(out *VMRuleList) {
+func (in *VMRuleTestList) DeepCopyInto(out *VMRuleTestList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]*VMRule, len(*in))
+		*out = make([]VMRuleTest, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(VMRule)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRuleList.
-func (in *VMRuleList) DeepCopy() *VMRuleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRuleTestList.
+func (in *VMRuleTestList) DeepCopy() *VMRuleTestList {
 	if in == nil {
 		return nil
 	}
-	out := new(VMRuleList)
+	out := new(VMRuleTestList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VMRuleList) DeepCopyObject() runtime.Object {
+func (in *VMRuleTestList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -5372,38 +7611,50 @@ func (in *VMRuleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VMRuleSpec) DeepCopyInto(out *VMRuleSpec) {
+func (in *VMRuleTestSpec) DeepCopyInto(out *VMRuleTestSpec) {
 	*out = *in
-	if in.Groups != nil {
-		in, out := &in.Groups, &out.Groups
-		*out = make([]RuleGroup, len(*in))
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GroupEvalOrder != nil {
+		in, out := &in.GroupEvalOrder, &out.GroupEvalOrder
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = make([]RuleTestGroup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	out.Image = in.Image
+	in.Resources.DeepCopyInto(&out.Resources)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRuleSpec.
-func (in *VMRuleSpec) DeepCopy() *VMRuleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRuleTestSpec.
+func (in *VMRuleTestSpec) DeepCopy() *VMRuleTestSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VMRuleSpec)
+	out := new(VMRuleTestSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VMRuleStatus) DeepCopyInto(out *VMRuleStatus) {
+func (in *VMRuleTestStatus) DeepCopyInto(out *VMRuleTestStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRuleStatus.
-func (in *VMRuleStatus) DeepCopy() *VMRuleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMRuleTestStatus.
+func (in *VMRuleTestStatus) DeepCopy() *VMRuleTestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VMRuleStatus)
+	out := new(VMRuleTestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -5414,7 +7665,7 @@ func (in *VMScrapeConfig) DeepCopyInto(out *VMScrapeConfig) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMScrapeConfig.
@@ -5547,6 +7798,41 @@ func (in *VMScrapeConfigSpec) DeepCopyInto(out *VMScrapeConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DockerSDConfigs != nil {
+		in, out := &in.DockerSDConfigs, &out.DockerSDConfigs
+		*out = make([]DockerSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NomadSDConfigs != nil {
+		in, out := &in.NomadSDConfigs, &out.NomadSDConfigs
+		*out = make([]NomadSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PuppetDBSDConfigs != nil {
+		in, out := &in.PuppetDBSDConfigs, &out.PuppetDBSDConfigs
+		*out = make([]PuppetDBSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HetznerSDConfigs != nil {
+		in, out := &in.HetznerSDConfigs, &out.HetznerSDConfigs
+		*out = make([]HetznerSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OVHCloudSDConfigs != nil {
+		in, out := &in.OVHCloudSDConfigs, &out.OVHCloudSDConfigs
+		*out = make([]OVHCloudSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.EndpointScrapeParams.DeepCopyInto(&out.EndpointScrapeParams)
 	in.EndpointRelabelings.DeepCopyInto(&out.EndpointRelabelings)
 	in.EndpointAuth.DeepCopyInto(&out.EndpointAuth)
@@ -5562,6 +7848,91 @@ func (in *VMScrapeConfigSpec) DeepCopy() *VMScrapeConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMScrapeGlobalConfig) DeepCopyInto(out *VMScrapeGlobalConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMScrapeGlobalConfig.
+func (in *VMScrapeGlobalConfig) DeepCopy() *VMScrapeGlobalConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VMScrapeGlobalConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMScrapeGlobalConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMScrapeGlobalConfigList) DeepCopyInto(out *VMScrapeGlobalConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VMScrapeGlobalConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMScrapeGlobalConfigList.
+func (in *VMScrapeGlobalConfigList) DeepCopy() *VMScrapeGlobalConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(VMScrapeGlobalConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMScrapeGlobalConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMScrapeGlobalConfigSpec) DeepCopyInto(out *VMScrapeGlobalConfigSpec) {
+	*out = *in
+	if in.VMAgentSelector != nil {
+		in, out := &in.VMAgentSelector, &out.VMAgentSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalLabels != nil {
+		in, out := &in.ExternalLabels, &out.ExternalLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMScrapeGlobalConfigSpec.
+func (in *VMScrapeGlobalConfigSpec) DeepCopy() *VMScrapeGlobalConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VMScrapeGlobalConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMScrapeParams) DeepCopyInto(out *VMScrapeParams) {
 	*out = *in
@@ -5625,6 +7996,11 @@ func (in *VMSelect) DeepCopyInto(out *VMSelect) {
 		*out = new(EmbeddedObjectMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(VMSelectCache)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Storage != nil {
 		in, out := &in.Storage, &out.Storage
 		*out = new(StorageSpec)
@@ -5645,6 +8021,11 @@ func (in *VMSelect) DeepCopyInto(out *VMSelect) {
 		*out = new(VMServiceScrapeSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(EmbeddedIngress)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PodDisruptionBudget != nil {
 		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
 		*out = new(EmbeddedPodDisruptionBudgetSpec)
@@ -5660,6 +8041,11 @@ func (in *VMSelect) DeepCopyInto(out *VMSelect) {
 		*out = new(EmbeddedHPA)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(StatefulSetUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ClaimTemplates != nil {
 		in, out := &in.ClaimTemplates, &out.ClaimTemplates
 		*out = make([]v1.PersistentVolumeClaim, len(*in))
@@ -5681,13 +8067,33 @@ func (in *VMSelect) DeepCopy() *VMSelect {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMSelectCache) DeepCopyInto(out *VMSelectCache) {
+	*out = *in
+	if in.MemoryAllowedPercent != nil {
+		in, out := &in.MemoryAllowedPercent, &out.MemoryAllowedPercent
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMSelectCache.
+func (in *VMSelectCache) DeepCopy() *VMSelectCache {
+	if in == nil {
+		return nil
+	}
+	out := new(VMSelectCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMServiceScrape) DeepCopyInto(out *VMServiceScrape) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMServiceScrape.
@@ -5763,6 +8169,11 @@ func (in *VMServiceScrapeSpec) DeepCopyInto(out *VMServiceScrapeSpec) {
 	in.Selector.DeepCopyInto(&out.Selector)
 	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
 	in.AttachMetadata.DeepCopyInto(&out.AttachMetadata)
+	if in.ScrapeClassName != nil {
+		in, out := &in.ScrapeClassName, &out.ScrapeClassName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMServiceScrapeSpec.
@@ -5786,7 +8197,7 @@ func (in *VMSingle) DeepCopyInto(out *VMSingle) {
 		*out = new(VMSingleSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMSingle.
@@ -5839,6 +8250,29 @@ func (in *VMSingleList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMSingleRestoreStatus) DeepCopyInto(out *VMSingleRestoreStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMSingleRestoreStatus.
+func (in *VMSingleRestoreStatus) DeepCopy() *VMSingleRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VMSingleRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMSingleSpec) DeepCopyInto(out *VMSingleSpec) {
 	*out = *in
@@ -5878,6 +8312,11 @@ func (in *VMSingleSpec) DeepCopyInto(out *VMSingleSpec) {
 		*out = new(VMServiceScrapeSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DefaultRules != nil {
+		in, out := &in.DefaultRules, &out.DefaultRules
+		*out = new(DefaultRules)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EmbeddedProbes != nil {
 		in, out := &in.EmbeddedProbes, &out.EmbeddedProbes
 		*out = new(EmbeddedProbes)
@@ -5888,6 +8327,11 @@ func (in *VMSingleSpec) DeepCopyInto(out *VMSingleSpec) {
 		*out = new(StreamAggrConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceAccountImagePullSecrets != nil {
+		in, out := &in.ServiceAccountImagePullSecrets, &out.ServiceAccountImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
 	in.CommonDefaultableParams.DeepCopyInto(&out.CommonDefaultableParams)
 	in.CommonApplicationDeploymentParams.DeepCopyInto(&out.CommonApplicationDeploymentParams)
 }
@@ -5905,6 +8349,21 @@ func (in *VMSingleSpec) DeepCopy() *VMSingleSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMSingleStatus) DeepCopyInto(out *VMSingleStatus) {
 	*out = *in
+	if in.Restore != nil {
+		in, out := &in.Restore, &out.Restore
+		*out = new(VMSingleRestoreStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]ChildObject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMSingleStatus.
@@ -5923,7 +8382,7 @@ func (in *VMStaticScrape) DeepCopyInto(out *VMStaticScrape) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMStaticScrape.
@@ -6050,6 +8509,16 @@ func (in *VMStorage) DeepCopyInto(out *VMStorage) {
 		*out = make([]int32, len(*in))
 		copy(*out, *in)
 	}
+	if in.CardinalityLimits != nil {
+		in, out := &in.CardinalityLimits, &out.CardinalityLimits
+		*out = new(CardinalityLimits)
+		**out = **in
+	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(StatefulSetUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ClaimTemplates != nil {
 		in, out := &in.ClaimTemplates, &out.ClaimTemplates
 		*out = make([]v1.PersistentVolumeClaim, len(*in))
@@ -6071,13 +8540,30 @@ func (in *VMStorage) DeepCopy() *VMStorage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMStorageDrainStatus) DeepCopyInto(out *VMStorageDrainStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	in.FinishAt.DeepCopyInto(&out.FinishAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMStorageDrainStatus.
+func (in *VMStorageDrainStatus) DeepCopy() *VMStorageDrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VMStorageDrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMUser) DeepCopyInto(out *VMUser) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMUser.
@@ -6155,6 +8641,21 @@ func (in *VMUserList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMUserPasswordRotation) DeepCopyInto(out *VMUserPasswordRotation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMUserPasswordRotation.
+func (in *VMUserPasswordRotation) DeepCopy() *VMUserPasswordRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(VMUserPasswordRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMUserSpec) DeepCopyInto(out *VMUserSpec) {
 	*out = *in
@@ -6183,6 +8684,11 @@ func (in *VMUserSpec) DeepCopyInto(out *VMUserSpec) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PasswordRotation != nil {
+		in, out := &in.PasswordRotation, &out.PasswordRotation
+		*out = new(VMUserPasswordRotation)
+		**out = **in
+	}
 	if in.BearerToken != nil {
 		in, out := &in.BearerToken, &out.BearerToken
 		*out = new(string)
@@ -6218,6 +8724,10 @@ func (in *VMUserSpec) DeepCopy() *VMUserSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMUserStatus) DeepCopyInto(out *VMUserStatus) {
 	*out = *in
+	if in.LastPasswordRotationTime != nil {
+		in, out := &in.LastPasswordRotationTime, &out.LastPasswordRotationTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMUserStatus.
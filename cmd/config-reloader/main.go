@@ -55,6 +55,12 @@ var (
 		"resync-interval", 0, "interval for force resync of the last configuration")
 	webhookMethod = flag.String(
 		"webhook-method", "GET", "the HTTP method url to use to send the webhook")
+	reloadMaxRetries = flag.Int(
+		"reload.maxRetries", 5, "the number of retries for failed requests to -reload-url. Zero value disables retries")
+	reloadInitialBackoff = flag.Duration(
+		"reload.initialBackoff", time.Second, "the initial delay between retries of failed requests to -reload-url. It's doubled after each retry")
+	reloadMaxBackoff = flag.Duration(
+		"reload.maxBackoff", 30*time.Second, "the maximum delay between retries of failed requests to -reload-url")
 
 	tlsCaFile = flag.String("reload.tlsCAFile", "",
 		"Optional path to client-side TLS CA file to use when connecting to -reload-url")
@@ -69,12 +75,14 @@ var (
 )
 
 var (
-	configLastOkReloadTime  = metrics.NewCounter(`configreloader_last_reload_success_timestamp_seconds`)
-	configLastReloadSuccess = metrics.NewCounter(`configreloader_last_reload_successful`)
-	configReloadErrorsTotal = metrics.NewCounter(`configreloader_last_reload_errors_total`)
-	configReloadsTotal      = metrics.NewCounter(`configreloader_config_last_reload_total`)
-	k8sAPIWatchErrorsTotal  = metrics.NewCounter(`configreloader_k8s_watch_errors_total`)
-	contentUpdateErrosTotal = metrics.NewCounter(`configreloader_secret_content_update_errors_total`)
+	configLastOkReloadTime   = metrics.NewCounter(`configreloader_last_reload_success_timestamp_seconds`)
+	configLastReloadSuccess  = metrics.NewCounter(`configreloader_last_reload_successful`)
+	configReloadErrorsTotal  = metrics.NewCounter(`configreloader_last_reload_errors_total`)
+	configReloadsTotal       = metrics.NewCounter(`configreloader_config_last_reload_total`)
+	k8sAPIWatchErrorsTotal   = metrics.NewCounter(`configreloader_k8s_watch_errors_total`)
+	contentUpdateErrosTotal  = metrics.NewCounter(`configreloader_secret_content_update_errors_total`)
+	configReloadRetriesTotal = metrics.NewCounter(`configreloader_reload_retries_total`)
+	configReloadDuration     = metrics.NewHistogram(`configreloader_reload_request_duration_seconds`)
 )
 
 func main() {
@@ -203,6 +211,8 @@ type reloader struct {
 
 func (r *reloader) reload(ctx context.Context) error {
 	configReloadsTotal.Inc()
+	startTime := time.Now()
+	defer configReloadDuration.UpdateDuration(startTime)
 	req, err := http.NewRequestWithContext(ctx, *webhookMethod, *reloadURL, nil)
 	if err != nil {
 		return fmt.Errorf("cannot build request for reload api: %w", err)
@@ -225,27 +235,26 @@ func (c *cfgWatcher) start(ctx context.Context) {
 		for {
 			select {
 			case <-c.updates:
-				go func() {
-					if *delayInterval > 0 {
-						t := time.NewTimer(*delayInterval)
-						defer t.Stop()
-						select {
-						case <-t.C:
-						case <-ctx.Done():
-							return
-						}
-					}
-					if err := c.reloader(ctx); err != nil {
-						logger.Errorf("cannot trigger api reload: %s", err.Error())
-						configLastReloadSuccess.Set(0)
-						configReloadErrorsTotal.Inc()
+				if *delayInterval > 0 {
+					t := time.NewTimer(*delayInterval)
+					select {
+					case <-t.C:
+					case <-ctx.Done():
+						t.Stop()
 						return
 					}
-					configLastReloadSuccess.Set(1)
-					configLastOkReloadTime.Set(uint64(time.Now().UnixMilli()))
-					logger.Infof("reload config ok.")
-				}()
-
+				}
+				// updates received during delay are applied by the current reload
+				drainUpdates(c.updates)
+				if err := c.reloadWithRetries(ctx); err != nil {
+					logger.Errorf("cannot trigger api reload: %s", err.Error())
+					configLastReloadSuccess.Set(0)
+					configReloadErrorsTotal.Inc()
+					continue
+				}
+				configLastReloadSuccess.Set(1)
+				configLastOkReloadTime.Set(uint64(time.Now().UnixMilli()))
+				logger.Infof("reload config ok.")
 			case <-ctx.Done():
 				return
 			}
@@ -253,6 +262,41 @@ func (c *cfgWatcher) start(ctx context.Context) {
 	}()
 }
 
+// reloadWithRetries retries failed reload with exponential backoff
+func (c *cfgWatcher) reloadWithRetries(ctx context.Context) error {
+	backoff := *reloadInitialBackoff
+	var err error
+	for attempt := 0; ; attempt++ {
+		err = c.reloader(ctx)
+		if err == nil || attempt >= *reloadMaxRetries {
+			return err
+		}
+		logger.Warnf("cannot trigger api reload, retrying in %s: %s", backoff, err)
+		configReloadRetriesTotal.Inc()
+		t := time.NewTimer(backoff)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			return err
+		}
+		backoff *= 2
+		if backoff > *reloadMaxBackoff {
+			backoff = *reloadMaxBackoff
+		}
+	}
+}
+
+func drainUpdates(updates chan struct{}) {
+	for {
+		select {
+		case <-updates:
+		default:
+			return
+		}
+	}
+}
+
 func (c *cfgWatcher) close() {
 	c.wg.Wait()
 }
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestReloadWithRetries(t *testing.T) {
+	defer func(retries int, initial, max time.Duration) {
+		*reloadMaxRetries, *reloadInitialBackoff, *reloadMaxBackoff = retries, initial, max
+	}(*reloadMaxRetries, *reloadInitialBackoff, *reloadMaxBackoff)
+	*reloadInitialBackoff = time.Millisecond
+	*reloadMaxBackoff = 2 * time.Millisecond
+
+	f := func(maxRetries, failures, wantCalls int, wantErr bool) {
+		t.Helper()
+		*reloadMaxRetries = maxRetries
+		var calls int
+		c := cfgWatcher{reloader: func(_ context.Context) error {
+			calls++
+			if calls <= failures {
+				return fmt.Errorf("reload failed")
+			}
+			return nil
+		}}
+		err := c.reloadWithRetries(context.Background())
+		if (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+		if calls != wantCalls {
+			t.Fatalf("unexpected reload calls, got: %d, want: %d", calls, wantCalls)
+		}
+	}
+	// success at the first attempt
+	f(3, 0, 1, false)
+	// success after retries
+	f(3, 2, 3, false)
+	// retries exhausted
+	f(3, 10, 4, true)
+	// retries disabled
+	f(0, 1, 1, true)
+}
+
+func TestDrainUpdates(t *testing.T) {
+	updates := make(chan struct{}, 10)
+	for i := 0; i < 5; i++ {
+		updates <- struct{}{}
+	}
+	drainUpdates(updates)
+	if len(updates) != 0 {
+		t.Fatalf("expected empty updates channel, got: %d", len(updates))
+	}
+}
@@ -19,6 +19,8 @@ package main
 import (
 	"context"
 	"os"
+	// embed time zones for VMOperatorSettings maintenance windows, operator image has no tzdata
+	_ "time/tzdata"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/VictoriaMetrics/operator/api/client/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// childStatus defines health of object created by operator for custom resource
+type childStatus struct {
+	Kind  string
+	Name  string
+	Ready string
+	// Healthy is true if all desired replicas are ready
+	Healthy bool
+}
+
+type description struct {
+	resourceStatus
+	Children []childStatus
+}
+
+// describeObject returns status of the given custom resource with its child workloads
+func describeObject(ctx context.Context, vmc versioned.Interface, kc kubernetes.Interface, kind, ns, name string) (*description, error) {
+	c := vmc.OperatorV1beta1()
+	opts := metav1.GetOptions{}
+	var d description
+	var uid types.UID
+	switch strings.ToLower(kind) {
+	case "vmagent":
+		cr, err := c.VMAgents(ns).Get(ctx, name, opts)
+		if err != nil {
+			return nil, err
+		}
+		uid = cr.UID
+		d.resourceStatus = resourceStatus{Kind: "VMAgent", Status: cr.Status.UpdateStatus, Ready: replicasReady(cr.Status.AvailableReplicas, cr.Status.Replicas), Error: cr.Status.Reason}
+	case "vmalert":
+		cr, err := c.VMAlerts(ns).Get(ctx, name, opts)
+		if err != nil {
+			return nil, err
+		}
+		uid = cr.UID
+		d.resourceStatus = resourceStatus{Kind: "VMAlert", Status: cr.Status.UpdateStatus, Ready: replicasReady(cr.Status.AvailableReplicas, cr.Status.Replicas), Error: cr.Status.Reason}
+	case "vmalertmanager":
+		cr, err := c.VMAlertmanagers(ns).Get(ctx, name, opts)
+		if err != nil {
+			return nil, err
+		}
+		uid = cr.UID
+		d.resourceStatus = resourceStatus{Kind: "VMAlertmanager", Status: cr.Status.UpdateStatus, Error: cr.Status.Reason}
+	case "vmauth":
+		cr, err := c.VMAuths(ns).Get(ctx, name, opts)
+		if err != nil {
+			return nil, err
+		}
+		uid = cr.UID
+		d.resourceStatus = resourceStatus{Kind: "VMAuth", Status: cr.Status.UpdateStatus, Error: cr.Status.Reason}
+	case "vmcluster":
+		cr, err := c.VMClusters(ns).Get(ctx, name, opts)
+		if err != nil {
+			return nil, err
+		}
+		uid = cr.UID
+		d.resourceStatus = resourceStatus{Kind: "VMCluster", Status: cr.Status.UpdateStatus, Error: cr.Status.Reason}
+	case "vmsingle":
+		cr, err := c.VMSingles(ns).Get(ctx, name, opts)
+		if err != nil {
+			return nil, err
+		}
+		uid = cr.UID
+		d.resourceStatus = resourceStatus{Kind: "VMSingle", Status: cr.Status.UpdateStatus, Ready: replicasReady(cr.Status.AvailableReplicas, cr.Status.Replicas), Error: cr.Status.Reason}
+	case "vlogs":
+		cr, err := c.VLogs(ns).Get(ctx, name, opts)
+		if err != nil {
+			return nil, err
+		}
+		uid = cr.UID
+		d.resourceStatus = resourceStatus{Kind: "VLogs", Status: cr.Status.UpdateStatus, Ready: replicasReady(cr.Status.AvailableReplicas, cr.Status.Replicas), Error: cr.Status.Reason}
+	default:
+		return nil, fmt.Errorf("unsupported kind=%q, supported kinds: vmagent,vmalert,vmalertmanager,vmauth,vmcluster,vmsingle,vlogs", kind)
+	}
+	d.Namespace = ns
+	d.Name = name
+
+	children, err := collectChildren(ctx, kc, ns, uid)
+	if err != nil {
+		return nil, err
+	}
+	d.Children = children
+	return &d, nil
+}
+
+func isOwnedBy(refs []metav1.OwnerReference, uid types.UID) bool {
+	for _, ref := range refs {
+		if ref.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// collectChildren returns deployments and statefulsets owned by object with the given uid
+func collectChildren(ctx context.Context, kc kubernetes.Interface, ns string, uid types.UID) ([]childStatus, error) {
+	var children []childStatus
+	deployments, err := kc.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot list deployments: %w", err)
+	}
+	for _, dep := range deployments.Items {
+		if !isOwnedBy(dep.OwnerReferences, uid) {
+			continue
+		}
+		desired := int32(1)
+		if dep.Spec.Replicas != nil {
+			desired = *dep.Spec.Replicas
+		}
+		children = append(children, childStatus{
+			Kind:    "Deployment",
+			Name:    dep.Name,
+			Ready:   replicasReady(dep.Status.ReadyReplicas, desired),
+			Healthy: dep.Status.ReadyReplicas >= desired && dep.Status.UpdatedReplicas >= desired,
+		})
+	}
+	statefulsets, err := kc.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot list statefulsets: %w", err)
+	}
+	for _, sts := range statefulsets.Items {
+		if !isOwnedBy(sts.OwnerReferences, uid) {
+			continue
+		}
+		desired := int32(1)
+		if sts.Spec.Replicas != nil {
+			desired = *sts.Spec.Replicas
+		}
+		children = append(children, childStatus{
+			Kind:    "StatefulSet",
+			Name:    sts.Name,
+			Ready:   replicasReady(sts.Status.ReadyReplicas, desired),
+			Healthy: sts.Status.ReadyReplicas >= desired && sts.Status.UpdatedReplicas >= desired,
+		})
+	}
+	return children, nil
+}
+
+func printDescription(dst io.Writer, d *description) error {
+	fmt.Fprintf(dst, "Kind:       %s\n", d.Kind)
+	fmt.Fprintf(dst, "Namespace:  %s\n", d.Namespace)
+	fmt.Fprintf(dst, "Name:       %s\n", d.Name)
+	fmt.Fprintf(dst, "Status:     %s\n", orDash(string(d.Status)))
+	if d.Ready != "" {
+		fmt.Fprintf(dst, "Ready:      %s\n", d.Ready)
+	}
+	fmt.Fprintf(dst, "Last Error: %s\n", orDash(d.Error))
+	fmt.Fprintln(dst, "Children:")
+	if len(d.Children) == 0 {
+		fmt.Fprintln(dst, "  <none>")
+		return nil
+	}
+	w := tabwriter.NewWriter(dst, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  KIND\tNAME\tREADY\tHEALTHY")
+	for _, c := range d.Children {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%t\n", c.Kind, c.Name, c.Ready, c.Healthy)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/VictoriaMetrics/operator/api/client/versioned"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var (
+	namespace = flag.String("namespace", "", "namespace to inspect objects at. By default objects from all namespaces are listed for status command")
+	timeout   = flag.Duration("timeout", 30*time.Second, "timeout for requests to kubernetes API")
+)
+
+const usage = `vmopctl summarizes health of VictoriaMetrics operator custom resources.
+
+Usage:
+  vmopctl [flags] status
+  vmopctl [flags] describe <kind> <name>
+
+Commands:
+  status     lists all VictoriaMetrics custom resources with their status, replica readiness and last errors
+  describe   shows status of the given custom resource and health of its child objects
+
+Flags:
+`
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if err := run(flag.Args()); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	if len(args) == 0 {
+		flag.Usage()
+		return fmt.Errorf("command must be provided")
+	}
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return fmt.Errorf("cannot build kubernetes config: %w", err)
+	}
+	vmc, err := versioned.NewForConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("cannot build operator client: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	switch args[0] {
+	case "status":
+		statuses, err := collectStatuses(ctx, vmc, *namespace)
+		if err != nil {
+			return err
+		}
+		return printStatuses(os.Stdout, statuses)
+	case "describe":
+		if len(args) != 3 {
+			return fmt.Errorf("describe expects <kind> and <name> arguments, got: %q", args[1:])
+		}
+		kc, err := kubernetes.NewForConfig(cfg)
+		if err != nil {
+			return fmt.Errorf("cannot build kubernetes client: %w", err)
+		}
+		ns := *namespace
+		if ns == "" {
+			ns = "default"
+		}
+		d, err := describeObject(ctx, vmc, kc, args[1], ns, args[2])
+		if err != nil {
+			return err
+		}
+		return printDescription(os.Stdout, d)
+	default:
+		flag.Usage()
+		return fmt.Errorf("unsupported command=%q", args[0])
+	}
+}
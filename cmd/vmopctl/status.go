@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/VictoriaMetrics/operator/api/client/versioned"
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// resourceStatus defines status of single custom resource
+type resourceStatus struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Status    vmv1beta1.UpdateStatus
+	// Ready is formatted as available/desired replicas,
+	// it's empty for objects without replicas at status
+	Ready string
+	Error string
+}
+
+func replicasReady(available, desired int32) string {
+	return fmt.Sprintf("%d/%d", available, desired)
+}
+
+// collectStatuses lists all VictoriaMetrics custom resources at the given namespace
+// empty namespace means all namespaces
+func collectStatuses(ctx context.Context, vmc versioned.Interface, ns string) ([]resourceStatus, error) {
+	c := vmc.OperatorV1beta1()
+	opts := metav1.ListOptions{}
+	var statuses []resourceStatus
+	add := func(kind string, meta metav1.ObjectMeta, status vmv1beta1.UpdateStatus, ready, reason string) {
+		statuses = append(statuses, resourceStatus{
+			Kind:      kind,
+			Namespace: meta.Namespace,
+			Name:      meta.Name,
+			Status:    status,
+			Ready:     ready,
+			Error:     reason,
+		})
+	}
+
+	vmagents, err := c.VMAgents(ns).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list vmagents: %w", err)
+	}
+	for _, cr := range vmagents.Items {
+		add("VMAgent", cr.ObjectMeta, cr.Status.UpdateStatus, replicasReady(cr.Status.AvailableReplicas, cr.Status.Replicas), cr.Status.Reason)
+	}
+	vmalerts, err := c.VMAlerts(ns).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list vmalerts: %w", err)
+	}
+	for _, cr := range vmalerts.Items {
+		add("VMAlert", cr.ObjectMeta, cr.Status.UpdateStatus, replicasReady(cr.Status.AvailableReplicas, cr.Status.Replicas), cr.Status.Reason)
+	}
+	vmalertmanagers, err := c.VMAlertmanagers(ns).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list vmalertmanagers: %w", err)
+	}
+	for _, cr := range vmalertmanagers.Items {
+		add("VMAlertmanager", cr.ObjectMeta, cr.Status.UpdateStatus, "", cr.Status.Reason)
+	}
+	vmauths, err := c.VMAuths(ns).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list vmauths: %w", err)
+	}
+	for _, cr := range vmauths.Items {
+		add("VMAuth", cr.ObjectMeta, cr.Status.UpdateStatus, "", cr.Status.Reason)
+	}
+	vmclusters, err := c.VMClusters(ns).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list vmclusters: %w", err)
+	}
+	for _, cr := range vmclusters.Items {
+		add("VMCluster", cr.ObjectMeta, cr.Status.UpdateStatus, "", cr.Status.Reason)
+	}
+	vmsingles, err := c.VMSingles(ns).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list vmsingles: %w", err)
+	}
+	for _, cr := range vmsingles.Items {
+		add("VMSingle", cr.ObjectMeta, cr.Status.UpdateStatus, replicasReady(cr.Status.AvailableReplicas, cr.Status.Replicas), cr.Status.Reason)
+	}
+	vlogs, err := c.VLogs(ns).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list vlogs: %w", err)
+	}
+	for _, cr := range vlogs.Items {
+		add("VLogs", cr.ObjectMeta, cr.Status.UpdateStatus, replicasReady(cr.Status.AvailableReplicas, cr.Status.Replicas), cr.Status.Reason)
+	}
+
+	vmusers, err := c.VMUsers(ns).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list vmusers: %w", err)
+	}
+	for _, cr := range vmusers.Items {
+		add("VMUser", cr.ObjectMeta, cr.Status.Status, "", cr.Status.LastSyncError)
+	}
+	vmrules, err := c.VMRules(ns).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list vmrules: %w", err)
+	}
+	for _, cr := range vmrules.Items {
+		add("VMRule", cr.ObjectMeta, cr.Status.Status, "", cr.Status.LastSyncError)
+	}
+	vmamcfgs, err := c.VMAlertmanagerConfigs(ns).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list vmalertmanagerconfigs: %w", err)
+	}
+	for _, cr := range vmamcfgs.Items {
+		add("VMAlertmanagerConfig", cr.ObjectMeta, cr.Status.Status, "", cr.Status.LastSyncError)
+	}
+	vmamtemplates, err := c.VMAlertmanagerTemplates(ns).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list vmalertmanagertemplates: %w", err)
+	}
+	for _, cr := range vmamtemplates.Items {
+		add("VMAlertmanagerTemplate", cr.ObjectMeta, cr.Status.Status, "", cr.Status.LastSyncError)
+	}
+	vmservicescrapes, err := c.VMServiceScrapes(ns).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list vmservicescrapes: %w", err)
+	}
+	for _, cr := range vmservicescrapes.Items {
+		add("VMServiceScrape", cr.ObjectMeta, cr.Status.Status, "", cr.Status.LastSyncError)
+	}
+	vmpodscrapes, err := c.VMPodScrapes(ns).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list vmpodscrapes: %w", err)
+	}
+	for _, cr := range vmpodscrapes.Items {
+		add("VMPodScrape", cr.ObjectMeta, cr.Status.Status, "", cr.Status.LastSyncError)
+	}
+	vmnodescrapes, err := c.VMNodeScrapes(ns).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list vmnodescrapes: %w", err)
+	}
+	for _, cr := range vmnodescrapes.Items {
+		add("VMNodeScrape", cr.ObjectMeta, cr.Status.Status, "", cr.Status.LastSyncError)
+	}
+	vmprobes, err := c.VMProbes(ns).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list vmprobes: %w", err)
+	}
+	for _, cr := range vmprobes.Items {
+		add("VMProbe", cr.ObjectMeta, cr.Status.Status, "", cr.Status.LastSyncError)
+	}
+	vmstaticscrapes, err := c.VMStaticScrapes(ns).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list vmstaticscrapes: %w", err)
+	}
+	for _, cr := range vmstaticscrapes.Items {
+		add("VMStaticScrape", cr.ObjectMeta, cr.Status.Status, "", cr.Status.LastSyncError)
+	}
+	vmscrapeconfigs, err := c.VMScrapeConfigs(ns).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list vmscrapeconfigs: %w", err)
+	}
+	for _, cr := range vmscrapeconfigs.Items {
+		add("VMScrapeConfig", cr.ObjectMeta, cr.Status.Status, "", cr.Status.LastSyncError)
+	}
+
+	sort.SliceStable(statuses, func(i, j int) bool {
+		if statuses[i].Namespace != statuses[j].Namespace {
+			return statuses[i].Namespace < statuses[j].Namespace
+		}
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses, nil
+}
+
+func printStatuses(dst io.Writer, statuses []resourceStatus) error {
+	w := tabwriter.NewWriter(dst, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tKIND\tNAME\tSTATUS\tREADY\tERROR")
+	for _, s := range statuses {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", s.Namespace, s.Kind, s.Name, orDash(string(s.Status)), orDash(s.Ready), orDash(s.Error))
+	}
+	return w.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
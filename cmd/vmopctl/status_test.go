@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/VictoriaMetrics/operator/api/client/versioned/fake"
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func TestCollectStatuses(t *testing.T) {
+	vmc := fake.NewSimpleClientset(
+		&vmv1beta1.VMAgent{
+			ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"},
+			Status: vmv1beta1.VMAgentStatus{
+				Replicas:          2,
+				AvailableReplicas: 1,
+				UpdateStatus:      vmv1beta1.UpdateStatusExpanding,
+			},
+		},
+		&vmv1beta1.VMServiceScrape{
+			ObjectMeta: metav1.ObjectMeta{Name: "scrape", Namespace: "default"},
+			Status: vmv1beta1.ScrapeObjectStatus{
+				Status:        vmv1beta1.UpdateStatusFailed,
+				LastSyncError: "bad config",
+			},
+		},
+	)
+	statuses, err := collectStatuses(context.Background(), vmc, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var buf bytes.Buffer
+	if err := printStatuses(&buf, statuses); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("unexpected output:\n%s", buf.String())
+	}
+	if got := strings.Fields(lines[1]); strings.Join(got, " ") != "default VMAgent agent expanding 1/2 -" {
+		t.Fatalf("unexpected vmagent line: %q", lines[1])
+	}
+	if got := strings.Fields(lines[2]); strings.Join(got, " ") != "default VMServiceScrape scrape failed - bad config" {
+		t.Fatalf("unexpected vmservicescrape line: %q", lines[2])
+	}
+}
+
+func TestDescribeObject(t *testing.T) {
+	vmc := fake.NewSimpleClientset(&vmv1beta1.VMCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default", UID: "cluster-uid"},
+	})
+	owned := []metav1.OwnerReference{{UID: "cluster-uid", Name: "cluster"}}
+	kc := k8sfake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "vminsert-cluster", Namespace: "default", OwnerReferences: owned},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: 2, UpdatedReplicas: 2},
+		},
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "vmstorage-cluster", Namespace: "default", OwnerReferences: owned},
+			Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To[int32](2)},
+			Status:     appsv1.StatefulSetStatus{ReadyReplicas: 1, UpdatedReplicas: 2},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+		},
+	)
+	d, err := describeObject(context.Background(), vmc, kc, "VMCluster", "default", "cluster")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(d.Children) != 2 {
+		t.Fatalf("unexpected children: %v", d.Children)
+	}
+	if !d.Children[0].Healthy || d.Children[0].Name != "vminsert-cluster" {
+		t.Fatalf("unexpected deployment status: %v", d.Children[0])
+	}
+	if d.Children[1].Healthy || d.Children[1].Ready != "1/2" {
+		t.Fatalf("unexpected statefulset status: %v", d.Children[1])
+	}
+
+	if _, err := describeObject(context.Background(), vmc, kc, "unknown", "default", "cluster"); err == nil {
+		t.Fatalf("expected error for unsupported kind")
+	}
+}
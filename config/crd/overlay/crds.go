@@ -0,0 +1,9 @@
+// Package overlay contains CRD manifests of operator
+package overlay
+
+import _ "embed"
+
+// CRDs contains multi-document YAML with all operator CustomResourceDefinitions
+//
+//go:embed crd.yaml
+var CRDs []byte
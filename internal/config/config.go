@@ -3,20 +3,26 @@ package config
 import (
 	"fmt"
 	"math/rand"
+	"net/url"
 	"os"
+	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"text/tabwriter"
 	"time"
 
 	version "github.com/hashicorp/go-version"
 	"github.com/kelseyhightower/envconfig"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 var (
-	opConf   *BaseOperatorConf
+	opConf   atomic.Pointer[BaseOperatorConf]
 	initConf sync.Once
 
 	opNamespace   []string
@@ -78,6 +84,9 @@ type BaseOperatorConf struct {
 	CustomConfigReloaderImage        string `default:"victoriametrics/operator:config-reloader-v0.48.2"`
 	parsedConfigReloaderImageVersion *version.Version
 	PSPAutoCreateEnabled             bool `default:"false"`
+	// image pull secrets names, which are attached to ServiceAccounts managed by operator, e.g. registry-creds
+	// secrets must exist at the namespace of ServiceAccount
+	ServiceAccountImagePullSecrets []string `default:""`
 
 	VLogsDefault struct {
 		Image   string `default:"victoriametrics/victoria-logs"`
@@ -122,6 +131,54 @@ type BaseOperatorConf struct {
 		ConfigReloaderMemory string `default:"25Mi"`
 	}
 
+	VMRuleTestDefault struct {
+		Image               string `default:"victoriametrics/vmalert-tool"`
+		Version             string `default:"v1.103.0"`
+		UseDefaultResources bool   `default:"true"`
+		Resource            struct {
+			Limit struct {
+				Mem string `default:"500Mi"`
+				Cpu string `default:"500m"`
+			}
+			Request struct {
+				Mem string `default:"100Mi"`
+				Cpu string `default:"100m"`
+			}
+		}
+	}
+
+	VMMaintenanceTaskDefault struct {
+		Image               string `default:"curlimages/curl"`
+		Version             string `default:"8.9.1"`
+		UseDefaultResources bool   `default:"true"`
+		Resource            struct {
+			Limit struct {
+				Mem string `default:"64Mi"`
+				Cpu string `default:"100m"`
+			}
+			Request struct {
+				Mem string `default:"16Mi"`
+				Cpu string `default:"10m"`
+			}
+		}
+	}
+
+	VMDataMigrationDefault struct {
+		Image               string `default:"victoriametrics/vmctl"`
+		Version             string `default:"v1.103.0"`
+		UseDefaultResources bool   `default:"true"`
+		Resource            struct {
+			Limit struct {
+				Mem string `default:"1Gi"`
+				Cpu string `default:"1"`
+			}
+			Request struct {
+				Mem string `default:"256Mi"`
+				Cpu string `default:"250m"`
+			}
+		}
+	}
+
 	VMAgentDefault struct {
 		Image               string `default:"victoriametrics/vmagent"`
 		Version             string `default:"v1.103.0"`
@@ -240,6 +297,7 @@ type BaseOperatorConf struct {
 	VMBackup                         struct {
 		Image               string `default:"victoriametrics/vmbackupmanager"`
 		Version             string `default:"v1.103.0-enterprise"`
+		RestoreImage        string `default:"victoriametrics/vmrestore"`
 		Port                string `default:"8300"`
 		UseDefaultResources bool   `default:"true"`
 		Resource            struct {
@@ -291,6 +349,25 @@ type BaseOperatorConf struct {
 	FilterPrometheusConverterLabelPrefixes []string `default:""`
 	// allows filtering for converted annotations, annotations with matched prefix will be ignored
 	FilterPrometheusConverterAnnotationPrefixes []string `default:""`
+	// maps namespace of prometheus objects into the target name, e.g. team-a:team-a,team-b:shared
+	// objects converted at mapped namespaces get PrometheusConverterTargetLabel label with the target name,
+	// it allows to select them with VMAgent and VMAlert selectors of the team stack
+	PrometheusConverterNamespaceTargets map[string]string `default:""`
+	// label name for the target of converted objects
+	PrometheusConverterTargetLabel string `default:"operator.victoriametrics.com/converter-target"`
+	// allows to restrict conversion to namespaces matched by label selector, e.g. victoriametrics.com/convert=enabled
+	// prometheus objects at not matched namespaces are ignored, previously converted objects are kept
+	PrometheusConverterNamespaceSelector string `default:""`
+	// adds operator.victoriametrics.com/converted-from annotation with kind, namespace and name of the source prometheus object
+	PrometheusConverterAddOriginAnnotation bool `default:"false"`
+	// path to yaml file with rules, which skip or modify objects converted from ServiceMonitor and PodMonitor
+	PrometheusConverterTransformRulesFile string `default:""`
+	// exports VictoriaMetrics objects into prometheus-operator objects, e.g. for tools which support only prometheus-operator API
+	// exported objects have operator.victoriametrics.com/exported-from annotation and are ignored by prometheus converter
+	EnabledPrometheusExportConverter struct {
+		ServiceScrape bool `default:"false"`
+		Rule          bool `default:"false"`
+	}
 	// Defines domain name suffix for in-cluster addresses
 	// most known ClusterDomainName is .cluster.local
 	ClusterDomainName string `default:""`
@@ -304,8 +381,17 @@ type BaseOperatorConf struct {
 	// Defines poll interval for pods ready check
 	// at statefulset rollout update
 	PodWaitReadyIntervalCheck time.Duration `default:"5s"`
+	// Defines number of independent child components of the same object,
+	// which are updated and waited for readiness concurrently, e.g. vmselect and vminsert of VMCluster
+	ParallelChildReconciles int `default:"2"`
 	// configures force resync interval for VMAgent, VMAlert, VMAlertmanager and VMAuth.
 	ForceResyncInterval time.Duration `default:"60s"`
+	// defines period before license expiration, when operator emits warning events for objects with spec.license.expiresAt
+	LicenseExpirationWarningPeriod time.Duration `default:"720h"`
+	// path to yaml file with resource profiles, which override or extend built-in small, medium and large profiles
+	// profiles are selected with spec.profile of objects
+	ResourceProfilesFile string `default:""`
+	resourceProfiles     ResourceProfiles
 	// EnableStrictSecurity will add default `securityContext` to pods and containers created by operator
 	// Default PodSecurityContext include:
 	// 1. RunAsNonRoot: true
@@ -328,6 +414,10 @@ type BaseOperatorConf struct {
 	//        - all
 	// turn off `EnableStrictSecurity` by default, see https://github.com/VictoriaMetrics/operator/issues/749 for details
 	EnableStrictSecurity bool `default:"false"`
+	// EnableDefaultAffinity adds default podAntiAffinity to pods created by operator, if podAntiAffinity isn't defined explicitly.
+	// Pods of the same component are preferred to be scheduled at different hosts.
+	// It could be overridden with spec.defaultAffinitySettings of objects
+	EnableDefaultAffinity bool `default:"false"`
 }
 
 // ResyncAfterDuration returns requeue duration for object period reconcile
@@ -401,6 +491,15 @@ func (boc BaseOperatorConf) Validate() error {
 	if err := validateResource("vmalert", Resource(boc.VMAlertDefault.Resource)); err != nil {
 		return err
 	}
+	if err := validateResource("vmruletest", Resource(boc.VMRuleTestDefault.Resource)); err != nil {
+		return err
+	}
+	if err := validateResource("vmmaintenancetask", Resource(boc.VMMaintenanceTaskDefault.Resource)); err != nil {
+		return err
+	}
+	if err := validateResource("vmdatamigration", Resource(boc.VMDataMigrationDefault.Resource)); err != nil {
+		return err
+	}
 	if err := validateResource("vmalertmanager", Resource(boc.VMAlertManager.Resource)); err != nil {
 		return err
 	}
@@ -423,6 +522,19 @@ func (boc BaseOperatorConf) Validate() error {
 	if err := validateResource("vlogs", Resource(boc.VLogsDefault.Resource)); err != nil {
 		return err
 	}
+	if len(boc.PrometheusConverterNamespaceTargets) > 0 {
+		if errs := validation.IsQualifiedName(boc.PrometheusConverterTargetLabel); len(errs) > 0 {
+			return fmt.Errorf("incorrect prometheus converter target label name=%q: %s", boc.PrometheusConverterTargetLabel, strings.Join(errs, ","))
+		}
+		for ns, target := range boc.PrometheusConverterNamespaceTargets {
+			if errs := validation.IsValidLabelValue(target); len(errs) > 0 {
+				return fmt.Errorf("incorrect prometheus converter target=%q for namespace=%q: %s", target, ns, strings.Join(errs, ","))
+			}
+		}
+	}
+	if _, err := labels.Parse(boc.PrometheusConverterNamespaceSelector); err != nil {
+		return fmt.Errorf("incorrect prometheus converter namespace selector=%q: %w", boc.PrometheusConverterNamespaceSelector, err)
+	}
 
 	return nil
 }
@@ -455,23 +567,112 @@ func (boc BaseOperatorConf) PrintDefaults(format string) error {
 }
 
 // MustGetBaseConfig returns operator configuration with default values populated from env variables
+// returned configuration must not be modified, it could be replaced with ReloadBaseConfig
 func MustGetBaseConfig() *BaseOperatorConf {
 	initConf.Do(func() {
-		c := &BaseOperatorConf{}
-		err := envconfig.Process(prefixVar, c)
+		c, err := newBaseConfig(nil)
 		if err != nil {
 			panic(err)
 		}
+		opConf.Store(c)
+	})
+	return opConf.Load()
+}
 
-		if err := c.Validate(); err != nil {
-			panic(err)
+// newBaseConfig builds configuration from env variables, the given overrides take precedence over env variables
+func newBaseConfig(overrides map[string]string) (*BaseOperatorConf, error) {
+	c := &BaseOperatorConf{}
+	if err := envconfig.Process(prefixVar, c); err != nil {
+		return nil, err
+	}
+	if err := applyOverrides(prefixVar, reflect.ValueOf(c).Elem(), overrides); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	if err := parseAndSetCustomerConfigReloadImageVersion(c); err != nil {
+		return nil, err
+	}
+	if err := loadResourceProfiles(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// sensitiveKeyRegex matches names of variables and flags, which may hold credentials
+var sensitiveKeyRegex = regexp.MustCompile(`(?i)(password|passwd|token|credential|apikey|secretkey|privatekey)`)
+
+// RedactedValue replaces sensitive values at effective configuration
+const RedactedValue = "<redacted>"
+
+// RedactValue hides value of the given variable or flag, if it may contain credentials.
+// It also hides password of urls with user info.
+func RedactValue(key, value string) string {
+	if value == "" {
+		return value
+	}
+	if sensitiveKeyRegex.MatchString(key) {
+		return RedactedValue
+	}
+	if u, err := url.Parse(value); err == nil && u.User != nil {
+		if _, ok := u.User.Password(); ok {
+			u.User = url.UserPassword(u.User.Username(), "xxxxx")
+			return strings.Replace(u.String(), "xxxxx", RedactedValue, 1)
 		}
-		if err := parseAndSetCustomerConfigReloadImageVersion(c); err != nil {
-			panic(err)
+	}
+	return value
+}
+
+// EffectiveValues returns resolved configuration as env variable names mapped to its values.
+// Sensitive values are redacted.
+func (boc *BaseOperatorConf) EffectiveValues() map[string]string {
+	dst := make(map[string]string)
+	collectEffectiveValues(dst, prefixVar, reflect.ValueOf(boc).Elem())
+	dst[WatchNamespaceEnvVar] = strings.Join(MustGetWatchNamespaces(), ",")
+	return dst
+}
+
+func collectEffectiveValues(dst map[string]string, prefix string, v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		ft := t.Field(i)
+		if !ft.IsExported() || ft.Tag.Get("ignored") == "true" {
+			continue
 		}
-		opConf = c
-	})
-	return opConf
+		key := prefix + "_" + strings.ToUpper(ft.Name)
+		fv := v.Field(i)
+		if ft.Type.Kind() == reflect.Struct {
+			collectEffectiveValues(dst, key, fv)
+			continue
+		}
+		dst[key] = RedactValue(key, formatEffectiveValue(fv))
+	}
+}
+
+// formatEffectiveValue formats value in the same way as it's defined at env variable
+func formatEffectiveValue(v reflect.Value) string {
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		return s.String()
+	}
+	switch v.Kind() {
+	case reflect.Slice:
+		items := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			items = append(items, fmt.Sprintf("%v", v.Index(i).Interface()))
+		}
+		return strings.Join(items, ",")
+	case reflect.Map:
+		items := make([]string, 0, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			items = append(items, fmt.Sprintf("%v:%v", iter.Key().Interface(), iter.Value().Interface()))
+		}
+		sort.Strings(items)
+		return strings.Join(items, ",")
+	default:
+		return fmt.Sprintf("%v", v.Interface())
+	}
 }
 
 var validNamespaceRegex = regexp.MustCompile(`[a-z0-9]([-a-z0-9]*[a-z0-9])?`)
@@ -512,6 +713,46 @@ func IsClusterWideAccessAllowed() bool {
 	return len(MustGetWatchNamespaces()) == 0
 }
 
+var watchNamespaceSelector labels.Selector
+
+// SetWatchNamespaceSelector configures label selector of namespaces watched by operator
+// matched namespaces are resolved at runtime, so it cannot be used together with WATCH_NAMESPACE
+func SetWatchNamespaceSelector(s string) error {
+	if s == "" {
+		watchNamespaceSelector = nil
+		return nil
+	}
+	if len(MustGetWatchNamespaces()) > 0 {
+		return fmt.Errorf("watch namespace selector cannot be used together with %s env var", WatchNamespaceEnvVar)
+	}
+	selector, err := labels.Parse(s)
+	if err != nil {
+		return fmt.Errorf("cannot parse watch namespace selector=%q: %w", s, err)
+	}
+	watchNamespaceSelector = selector
+	return nil
+}
+
+// GetWatchNamespaceSelector returns label selector of namespaces watched by operator
+// nil value means that namespaces aren't filtered by labels
+func GetWatchNamespaceSelector() labels.Selector {
+	return watchNamespaceSelector
+}
+
+var finalizersDisabled bool
+
+// SetFinalizersDisabled configures global usage of vm-operator finalizer
+// if disabled, operator removes its finalizer from objects instead of adding it
+// cannot be used concurrently and should be called only once at operator start
+func SetFinalizersDisabled(disabled bool) {
+	finalizersDisabled = disabled
+}
+
+// IsFinalizersDisabled checks if operator must not add finalizers to objects
+func IsFinalizersDisabled() bool {
+	return finalizersDisabled
+}
+
 type Labels struct {
 	LabelsString string
 	LabelsMap    map[string]string
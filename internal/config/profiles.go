@@ -0,0 +1,147 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// ResourceProfile defines default settings of component
+// applied to objects with spec.profile
+type ResourceProfile struct {
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	ExtraArgs map[string]string           `json:"extraArgs,omitempty"`
+}
+
+// ResourceProfiles maps profile name to component settings,
+// where component is one of vmagent, vmalert, vmalertmanager, vmauth, vmsingle, vlogs, vmstorage, vmselect or vminsert
+type ResourceProfiles map[string]map[string]ResourceProfile
+
+func newResourceProfile(cpuRequest, memRequest, cpuLimit, memLimit string, extraArgs map[string]string) ResourceProfile {
+	return ResourceProfile{
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpuRequest),
+				corev1.ResourceMemory: resource.MustParse(memRequest),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpuLimit),
+				corev1.ResourceMemory: resource.MustParse(memLimit),
+			},
+		},
+		ExtraArgs: extraArgs,
+	}
+}
+
+// builtinResourceProfiles returns profiles available without ResourceProfilesFile
+func builtinResourceProfiles() ResourceProfiles {
+	memArgs := func(allowedPercent string) map[string]string {
+		return map[string]string{"memory.allowedPercent": allowedPercent}
+	}
+	storageArgs := func(allowedPercent, tsidCacheSize string) map[string]string {
+		return map[string]string{"memory.allowedPercent": allowedPercent, "storage.cacheSizeStorageTSID": tsidCacheSize}
+	}
+	return ResourceProfiles{
+		"small": {
+			"vmagent":        newResourceProfile("50m", "128Mi", "250m", "256Mi", memArgs("60")),
+			"vmalert":        newResourceProfile("50m", "64Mi", "100m", "128Mi", memArgs("60")),
+			"vmalertmanager": newResourceProfile("30m", "56Mi", "100m", "128Mi", nil),
+			"vmauth":         newResourceProfile("50m", "64Mi", "200m", "128Mi", memArgs("60")),
+			"vmsingle":       newResourceProfile("150m", "512Mi", "1", "1Gi", storageArgs("60", "64MB")),
+			"vlogs":          newResourceProfile("150m", "512Mi", "1", "1Gi", memArgs("60")),
+			"vmstorage":      newResourceProfile("250m", "512Mi", "1", "1Gi", storageArgs("60", "64MB")),
+			"vmselect":       newResourceProfile("100m", "256Mi", "500m", "512Mi", memArgs("60")),
+			"vminsert":       newResourceProfile("100m", "128Mi", "500m", "256Mi", memArgs("60")),
+		},
+		"medium": {
+			"vmagent":        newResourceProfile("250m", "512Mi", "1", "1Gi", memArgs("60")),
+			"vmalert":        newResourceProfile("100m", "128Mi", "500m", "256Mi", memArgs("60")),
+			"vmalertmanager": newResourceProfile("100m", "128Mi", "250m", "256Mi", nil),
+			"vmauth":         newResourceProfile("200m", "128Mi", "1", "512Mi", memArgs("60")),
+			"vmsingle":       newResourceProfile("500m", "2Gi", "2", "4Gi", storageArgs("60", "256MB")),
+			"vlogs":          newResourceProfile("500m", "2Gi", "2", "4Gi", memArgs("60")),
+			"vmstorage":      newResourceProfile("1", "2Gi", "2", "4Gi", storageArgs("60", "256MB")),
+			"vmselect":       newResourceProfile("500m", "1Gi", "2", "2Gi", memArgs("60")),
+			"vminsert":       newResourceProfile("250m", "256Mi", "1", "512Mi", memArgs("60")),
+		},
+		"large": {
+			"vmagent":        newResourceProfile("1", "2Gi", "4", "4Gi", memArgs("80")),
+			"vmalert":        newResourceProfile("500m", "512Mi", "2", "1Gi", memArgs("80")),
+			"vmalertmanager": newResourceProfile("250m", "256Mi", "1", "512Mi", nil),
+			"vmauth":         newResourceProfile("1", "512Mi", "4", "1Gi", memArgs("80")),
+			"vmsingle":       newResourceProfile("2", "8Gi", "8", "16Gi", storageArgs("80", "1GB")),
+			"vlogs":          newResourceProfile("2", "8Gi", "8", "16Gi", memArgs("80")),
+			"vmstorage":      newResourceProfile("4", "8Gi", "8", "16Gi", storageArgs("80", "1GB")),
+			"vmselect":       newResourceProfile("2", "4Gi", "8", "8Gi", memArgs("80")),
+			"vminsert":       newResourceProfile("1", "1Gi", "4", "2Gi", memArgs("80")),
+		},
+	}
+}
+
+// parseResourceProfiles parses profiles from yaml and merges it with built-in profiles.
+// Components defined at data replace built-in components of the same profile
+func parseResourceProfiles(data []byte) (ResourceProfiles, error) {
+	var custom ResourceProfiles
+	if err := yaml.Unmarshal(data, &custom); err != nil {
+		return nil, fmt.Errorf("cannot parse resource profiles: %w", err)
+	}
+	profiles := builtinResourceProfiles()
+	for name, components := range custom {
+		if len(name) == 0 {
+			return nil, fmt.Errorf("resource profile name cannot be empty")
+		}
+		if _, ok := profiles[name]; !ok {
+			profiles[name] = make(map[string]ResourceProfile)
+		}
+		for component, p := range components {
+			profiles[name][component] = p
+		}
+	}
+	return profiles, nil
+}
+
+// loadResourceProfiles reads profiles from ResourceProfilesFile if it's set
+func loadResourceProfiles(boc *BaseOperatorConf) error {
+	if len(boc.ResourceProfilesFile) == 0 {
+		return nil
+	}
+	data, err := os.ReadFile(boc.ResourceProfilesFile)
+	if err != nil {
+		return fmt.Errorf("cannot read resource profiles file=%q: %w", boc.ResourceProfilesFile, err)
+	}
+	profiles, err := parseResourceProfiles(data)
+	if err != nil {
+		return fmt.Errorf("incorrect resource profiles file=%q: %w", boc.ResourceProfilesFile, err)
+	}
+	boc.resourceProfiles = profiles
+	return nil
+}
+
+// ResourceProfile returns settings of the given component for the named profile.
+// It returns nil if profile has no settings for the component
+// and error if profile is not defined
+func (boc *BaseOperatorConf) ResourceProfile(name, component string) (*ResourceProfile, error) {
+	profiles := boc.resourceProfiles
+	if profiles == nil {
+		profiles = builtinResourceProfiles()
+	}
+	components, ok := profiles[name]
+	if !ok {
+		known := make([]string, 0, len(profiles))
+		for k := range profiles {
+			known = append(known, k)
+		}
+		sort.Strings(known)
+		return nil, fmt.Errorf("unknown resource profile=%q, supported profiles: %s", name, strings.Join(known, ","))
+	}
+	p, ok := components[component]
+	if !ok {
+		return nil, nil
+	}
+	return &p, nil
+}
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"testing"
+)
+
+func TestResourceProfile(t *testing.T) {
+	f := func(data, name, component, wantMemLimit string, wantArgs map[string]string, wantErr bool) {
+		t.Helper()
+		boc := &BaseOperatorConf{}
+		if len(data) > 0 {
+			profiles, err := parseResourceProfiles([]byte(data))
+			if err != nil {
+				t.Fatalf("unexpected error at profiles parsing: %s", err)
+			}
+			boc.resourceProfiles = profiles
+		}
+		p, err := boc.ResourceProfile(name, component)
+		if (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+		if wantMemLimit == "" {
+			if p != nil {
+				t.Fatalf("expected empty profile, got: %v", p)
+			}
+			return
+		}
+		if p == nil {
+			t.Fatalf("expected profile %s for %s", name, component)
+		}
+		if got := p.Resources.Limits.Memory().String(); got != wantMemLimit {
+			t.Fatalf("unexpected memory limit, got: %s, want: %s", got, wantMemLimit)
+		}
+		if len(p.ExtraArgs) != len(wantArgs) {
+			t.Fatalf("unexpected extraArgs, got: %v, want: %v", p.ExtraArgs, wantArgs)
+		}
+		for k, v := range wantArgs {
+			if p.ExtraArgs[k] != v {
+				t.Fatalf("unexpected extraArgs, got: %v, want: %v", p.ExtraArgs, wantArgs)
+			}
+		}
+	}
+
+	// built-in profiles
+	f("", "medium", "vmstorage", "4Gi", map[string]string{"memory.allowedPercent": "60", "storage.cacheSizeStorageTSID": "256MB"}, false)
+	f("", "small", "vmalertmanager", "128Mi", nil, false)
+	f("", "unknown", "vmagent", "", nil, true)
+
+	// custom profiles override built-in components
+	data := `
+medium:
+  vmagent:
+    resources:
+      limits:
+        memory: 3Gi
+    extraArgs:
+      memory.allowedPercent: "70"
+xlarge:
+  vmselect:
+    resources:
+      limits:
+        memory: 32Gi
+`
+	f(data, "medium", "vmagent", "3Gi", map[string]string{"memory.allowedPercent": "70"}, false)
+	f(data, "medium", "vmselect", "2Gi", map[string]string{"memory.allowedPercent": "60"}, false)
+	f(data, "xlarge", "vmselect", "32Gi", nil, false)
+	f(data, "xlarge", "vmagent", "", nil, false)
+}
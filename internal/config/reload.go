@@ -0,0 +1,161 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// reloadMu serializes configuration reloads, so concurrent reload cannot replace configuration with a stale one
+var reloadMu sync.Mutex
+
+// ParseConfigFile parses file with operator configuration variables in KEY=VALUE format.
+// Empty lines and lines starting with # are ignored.
+// Only variables with VM_ prefix defined at BaseOperatorConf are allowed
+func ParseConfigFile(data []byte) (map[string]string, error) {
+	known := make(map[string]string)
+	collectEffectiveValues(known, prefixVar, reflect.ValueOf(&BaseOperatorConf{}).Elem())
+	dst := make(map[string]string)
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	var lineNum int
+	for sc.Scan() {
+		lineNum++
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("missing = delimiter at line=%d", lineNum)
+		}
+		key = strings.TrimSpace(key)
+		if _, ok := known[key]; !ok {
+			return nil, fmt.Errorf("unknown variable=%q at line=%d", key, lineNum)
+		}
+		if _, ok := dst[key]; ok {
+			return nil, fmt.Errorf("duplicate variable=%q at line=%d", key, lineNum)
+		}
+		dst[key] = strings.TrimSpace(value)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// ReloadBaseConfig builds operator configuration from env variables and the given overrides,
+// overrides take precedence over env variables.
+// Current configuration is replaced only if the new one is valid and differs from it.
+// It returns true if configuration was replaced
+func ReloadBaseConfig(overrides map[string]string) (bool, error) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	prev := MustGetBaseConfig()
+	c, err := newBaseConfig(overrides)
+	if err != nil {
+		return false, err
+	}
+	if reflect.DeepEqual(prev, c) {
+		return false, nil
+	}
+	opConf.Store(c)
+	return true, nil
+}
+
+// applyOverrides sets configuration fields from overrides keyed by env variable names.
+// Overrides are decoded into fields directly instead of env variables,
+// since env variables are shared by the whole process and must not be changed at runtime
+func applyOverrides(prefix string, v reflect.Value, overrides map[string]string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		ft := t.Field(i)
+		if !ft.IsExported() || ft.Tag.Get("ignored") == "true" {
+			continue
+		}
+		key := prefix + "_" + strings.ToUpper(ft.Name)
+		fv := v.Field(i)
+		if ft.Type.Kind() == reflect.Struct {
+			if err := applyOverrides(key, fv, overrides); err != nil {
+				return err
+			}
+			continue
+		}
+		value, ok := overrides[key]
+		if !ok {
+			continue
+		}
+		if err := decodeEnvValue(fv, value); err != nil {
+			return fmt.Errorf("cannot parse variable=%q: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// decodeEnvValue parses value in the same format as envconfig parses env variables
+func decodeEnvValue(v reflect.Value, value string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Type() == reflect.TypeOf(time.Duration(0)) {
+			d, err := time.ParseDuration(value)
+			if err != nil {
+				return err
+			}
+			v.SetInt(int64(d))
+			return nil
+		}
+		n, err := strconv.ParseInt(value, 0, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Slice:
+		items := reflect.MakeSlice(v.Type(), 0, 0)
+		if len(strings.TrimSpace(value)) != 0 {
+			for _, s := range strings.Split(value, ",") {
+				item := reflect.New(v.Type().Elem()).Elem()
+				if err := decodeEnvValue(item, s); err != nil {
+					return err
+				}
+				items = reflect.Append(items, item)
+			}
+		}
+		v.Set(items)
+	case reflect.Map:
+		m := reflect.MakeMap(v.Type())
+		if len(strings.TrimSpace(value)) != 0 {
+			for _, pair := range strings.Split(value, ",") {
+				kv := strings.Split(pair, ":")
+				if len(kv) != 2 {
+					return fmt.Errorf("invalid map item: %q", pair)
+				}
+				key := reflect.New(v.Type().Key()).Elem()
+				if err := decodeEnvValue(key, kv[0]); err != nil {
+					return err
+				}
+				item := reflect.New(v.Type().Elem()).Elem()
+				if err := decodeEnvValue(item, kv[1]); err != nil {
+					return err
+				}
+				m.SetMapIndex(key, item)
+			}
+		}
+		v.Set(m)
+	default:
+		return fmt.Errorf("unsupported type=%s", v.Type())
+	}
+	return nil
+}
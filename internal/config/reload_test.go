@@ -0,0 +1,162 @@
+package config
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseConfigFile(t *testing.T) {
+	f := func(data string, want map[string]string, wantErr bool) {
+		t.Helper()
+		got, err := ParseConfigFile([]byte(data))
+		if (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+		if wantErr {
+			return
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("unexpected result, got: %v, want: %v", got, want)
+		}
+	}
+
+	// empty file
+	f("", map[string]string{}, false)
+
+	// comments and empty lines
+	f(`
+# filters
+VM_FILTERCHILDLABELPREFIXES=team.example.com/,tmp
+ VM_VMALERTDEFAULT_RESOURCE_LIMIT_MEM = 1Gi
+VM_ENABLEDEFAULTAFFINITY=true
+`, map[string]string{
+		"VM_FILTERCHILDLABELPREFIXES":          "team.example.com/,tmp",
+		"VM_VMALERTDEFAULT_RESOURCE_LIMIT_MEM": "1Gi",
+		"VM_ENABLEDEFAULTAFFINITY":             "true",
+	}, false)
+
+	// missing delimiter
+	f("VM_ENABLEDEFAULTAFFINITY", nil, true)
+
+	// unknown variable
+	f("VM_UNKNOWN=1", nil, true)
+
+	// watch namespace cannot be changed at runtime
+	f("WATCH_NAMESPACE=default", nil, true)
+
+	// duplicate variable
+	f("VM_ENABLEDEFAULTAFFINITY=true\nVM_ENABLEDEFAULTAFFINITY=false", nil, true)
+}
+
+func TestReloadBaseConfig(t *testing.T) {
+	origin := MustGetBaseConfig()
+	defer func() {
+		if _, err := ReloadBaseConfig(nil); err != nil {
+			t.Fatalf("cannot restore configuration: %s", err)
+		}
+	}()
+	f := func(overrides map[string]string, wantChanged, wantErr bool) {
+		t.Helper()
+		prev := MustGetBaseConfig()
+		changed, err := ReloadBaseConfig(overrides)
+		if (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+		if changed != wantChanged {
+			t.Fatalf("unexpected changed, got: %v, want: %v", changed, wantChanged)
+		}
+		if !changed && MustGetBaseConfig() != prev {
+			t.Fatalf("configuration must not be replaced")
+		}
+		for key := range overrides {
+			if _, ok := os.LookupEnv(key); ok {
+				t.Fatalf("env variable=%q must be restored after reload", key)
+			}
+		}
+	}
+
+	// the same configuration
+	f(nil, false, false)
+
+	// changed configuration
+	f(map[string]string{"VM_FILTERCHILDANNOTATIONPREFIXES": "team.example.com/"}, true, false)
+	if got := MustGetBaseConfig().FilterChildAnnotationPrefixes; !reflect.DeepEqual(got, []string{"team.example.com/"}) {
+		t.Fatalf("unexpected FilterChildAnnotationPrefixes: %v", got)
+	}
+	if len(origin.FilterChildAnnotationPrefixes) != 0 {
+		t.Fatalf("previous configuration must not be modified")
+	}
+
+	// invalid configuration keeps the previous one
+	f(map[string]string{"VM_VMALERTDEFAULT_RESOURCE_LIMIT_MEM": "bad-value"}, false, true)
+	if got := MustGetBaseConfig().FilterChildAnnotationPrefixes; !reflect.DeepEqual(got, []string{"team.example.com/"}) {
+		t.Fatalf("unexpected FilterChildAnnotationPrefixes after failed reload: %v", got)
+	}
+}
+
+func TestApplyOverrides(t *testing.T) {
+	type conf struct {
+		Name     string
+		Enabled  bool
+		Count    int
+		Timeout  time.Duration
+		Items    []string
+		Labels   map[string]string
+		Ignored  string `ignored:"true"`
+		Resource struct {
+			Mem string
+		}
+	}
+	f := func(overrides map[string]string, want conf, wantErr bool) {
+		t.Helper()
+		var got conf
+		err := applyOverrides("VM", reflect.ValueOf(&got).Elem(), overrides)
+		if (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+		if wantErr {
+			return
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("unexpected configuration,\ngot:  %+v\nwant: %+v", got, want)
+		}
+	}
+
+	// no overrides
+	f(nil, conf{}, false)
+
+	// all supported types
+	want := conf{
+		Name:    "name",
+		Enabled: true,
+		Count:   10,
+		Timeout: time.Minute,
+		Items:   []string{"a", "b"},
+		Labels:  map[string]string{"team": "a", "env": "prod"},
+	}
+	want.Resource.Mem = "100Mi"
+	f(map[string]string{
+		"VM_NAME":         "name",
+		"VM_ENABLED":      "true",
+		"VM_COUNT":        "10",
+		"VM_TIMEOUT":      "1m",
+		"VM_ITEMS":        "a,b",
+		"VM_LABELS":       "team:a,env:prod",
+		"VM_IGNORED":      "value",
+		"VM_RESOURCE_MEM": "100Mi",
+	}, want, false)
+
+	// empty collections
+	f(map[string]string{"VM_ITEMS": "", "VM_LABELS": ""}, conf{Items: []string{}, Labels: map[string]string{}}, false)
+
+	// invalid bool
+	f(map[string]string{"VM_ENABLED": "yes-please"}, conf{}, true)
+
+	// invalid duration
+	f(map[string]string{"VM_TIMEOUT": "10"}, conf{}, true)
+
+	// invalid map item
+	f(map[string]string{"VM_LABELS": "team"}, conf{}, true)
+}
@@ -2,22 +2,30 @@ package operator
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
 	"reflect"
+	"runtime/debug"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/build"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
-	"github.com/google/uuid"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
 	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -30,11 +38,83 @@ import (
 func BindFlags(f *flag.FlagSet) {
 	cacheSyncTimeout = f.Duration("controller.cacheSyncTimeout", *cacheSyncTimeout, "controls timeout for caches to be synced.")
 	maxConcurrency = f.Int("controller.maxConcurrentReconciles", *maxConcurrency, "Configures number of concurrent reconciles. It should improve performance for clusters with many objects.")
+	shardsCount = f.Int("controller.shardsCount", *shardsCount, "Enables active-active mode, in which namespaces of reconciled objects are split between the given number of operator replicas with consistent hashing. "+
+		"Each replica must have unique -controller.shardNum")
+	shardNum = f.String("controller.shardNum", *shardNum, "Shard number of operator replica in the range [0 ... controller.shardsCount-1]. It accepts number or statefulset pod name with ordinal suffix, for example vm-operator-1")
+	stateStoreConfigMap = f.String("controller.stateStoreConfigMap", *stateStoreConfigMap, "Optional namespace/name of ConfigMap, where operator persists state of processed scrape objects between restarts. "+
+		"It allows to skip regeneration of vmagent configs for not changed objects after operator restart. Disabled by default")
+	stateStoreFlushInterval = f.Duration("controller.stateStoreFlushInterval", *stateStoreFlushInterval, "Interval for persisting operator state changes into -controller.stateStoreConfigMap")
+	driftCheckInterval = f.Duration("controller.driftCheckInterval", *driftCheckInterval, "Optional interval for periodic full reconciliation of VMAgent, VMAlert, VMAlertmanager, VMAuth, VMCluster, VMSingle and VLogs, "+
+		"which detects manual changes of managed Deployments, StatefulSets and Secrets. Detected changes are reported with vm_operator_object_drift metric and DriftDetected event. Disabled by default")
+	driftAutoRevert = f.Bool("controller.driftAutoRevert", *driftAutoRevert, "Whether to revert detected manual changes of managed Deployments, StatefulSets and Secrets. "+
+		"If disabled, manual changes are only reported and kept until the next change of the parent object")
+	childDiffEvents = f.Bool("controller.childDiffEvents", *childDiffEvents, "Whether to emit ChildObjectUpdated event with changed fields of updated Deployments, StatefulSets and Secrets for the parent object. "+
+		"Values of env variables and secret data are redacted. Changes are always logged at debug level")
+	revisionHistoryLimit = f.Int("controller.revisionHistoryLimit", *revisionHistoryLimit, "Number of pod template revisions of managed Deployments and StatefulSets kept at ControllerRevision objects for rollback. "+
+		"Zero value disables revision tracking")
+	configRevisionHistoryLimit = f.Int("controller.configRevisionHistoryLimit", *configRevisionHistoryLimit, "Number of generated configuration revisions of VMAgent, VMAlertmanager and VMAlert kept at Secrets for rollback "+
+		"with "+vmv1beta1.ConfigRollbackToAnnotation+" annotation. Zero value disables revision tracking")
+	namespaceFairness = f.Bool("controller.namespaceFairness", *namespaceFairness, "Enables fair processing of reconcile requests between namespaces. Queued requests of each controller are served in round-robin order by namespace, "+
+		"so namespace with many changing objects cannot delay reconciliation of objects from other namespaces. Queue state is exposed with operator_controller_namespace_queue_depth and operator_controller_namespace_reconciles_inflight metrics")
+	maxConcurrencyPerNamespace = f.Int("controller.maxConcurrentReconcilesPerNamespace", *maxConcurrencyPerNamespace, "Optional limit of concurrent reconciles for objects from the same namespace per controller. "+
+		"Works only with -controller.namespaceFairness. Zero value means no limit")
+	watchReferences = f.Bool("controller.watchReferencedSecrets", *watchReferences, "Whether to reconcile VMAgent, VMAlert and VMAuth on changes of Secrets and ConfigMaps fetched during their reconcile, "+
+		"such as basicAuth, TLS and oauth2 credentials of scrape objects and additional scrape configs. Secrets and ConfigMaps are watched with metadata only informers. "+
+		"If disabled, changes are applied at the next resync period")
+}
+
+// RevisionHistoryLimit returns number of revisions kept for managed Deployments and StatefulSets
+func RevisionHistoryLimit() int {
+	return *revisionHistoryLimit
+}
+
+// ConfigRevisionHistoryLimit returns number of generated configuration revisions kept for VMAgent, VMAlertmanager and VMAlert
+func ConfigRevisionHistoryLimit() int {
+	return *configRevisionHistoryLimit
+}
+
+// IsDriftAutoRevertEnabled checks if manual changes of child objects must be reverted
+func IsDriftAutoRevertEnabled() bool {
+	return *driftAutoRevert
+}
+
+// IsChildDiffEventsEnabled checks if changes of updated child objects must be emitted as events
+func IsChildDiffEventsEnabled() bool {
+	return *childDiffEvents
+}
+
+// requeueAfter returns duration for periodic object reconcile
+// it respects -controller.driftCheckInterval if it's lower than force resync interval
+func requeueAfter(cfg *config.BaseOperatorConf) time.Duration {
+	d := cfg.ResyncAfterDuration()
+	if *driftCheckInterval > 0 && (d == 0 || *driftCheckInterval < d) {
+		d = *driftCheckInterval
+	}
+	return d
+}
+
+// requeueBefore returns duration for periodic object reconcile
+// it's limited by the given time left until object state change, if it's not zero
+func requeueBefore(cfg *config.BaseOperatorConf, left time.Duration) time.Duration {
+	d := requeueAfter(cfg)
+	if left > 0 && (d == 0 || left < d) {
+		d = left
+	}
+	return d
 }
 
 var (
-	cacheSyncTimeout = ptr.To(3 * time.Minute)
-	maxConcurrency   = ptr.To(5)
+	cacheSyncTimeout     = ptr.To(3 * time.Minute)
+	maxConcurrency       = ptr.To(5)
+	driftCheckInterval   = ptr.To(time.Duration(0))
+	driftAutoRevert      = ptr.To(true)
+	childDiffEvents      = ptr.To(false)
+	revisionHistoryLimit = ptr.To(10)
+	namespaceFairness    = ptr.To(false)
+
+	maxConcurrencyPerNamespace = ptr.To(0)
+	configRevisionHistoryLimit = ptr.To(0)
+	watchReferences            = ptr.To(true)
 )
 
 var (
@@ -59,11 +139,20 @@ var (
 		Name: "operator_controller_reconcile_errors_total",
 		Help: "Counts number contex.Canceled errors",
 	})
+	panicsRecoveredTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "operator_controller_panics_recovered_total",
+		Help: "Counts number of recovered panics at reconciliation loops and informer event handlers",
+	}, []string{"controller"})
+	converterEventDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "operator_prometheus_converter_event_duration_seconds",
+		Help:    "Duration of prometheus objects conversion to VictoriaMetrics objects by informer event handlers",
+		Buckets: []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"informer"})
 )
 
 // InitMetrics adds metrics to the Registry
 func init() {
-	metrics.Registry.MustRegister(parseObjectErrorsTotal, getObjectsErrorsTotal, conflictErrorsTotal, contextCancelErrorsTotal)
+	metrics.Registry.MustRegister(parseObjectErrorsTotal, getObjectsErrorsTotal, conflictErrorsTotal, contextCancelErrorsTotal, panicsRecoveredTotal, converterEventDuration)
 }
 
 func getDefaultOptions() controller.Options {
@@ -73,6 +162,9 @@ func getDefaultOptions() controller.Options {
 			CacheSyncTimeout:        *cacheSyncTimeout,
 			MaxConcurrentReconciles: *maxConcurrency,
 		}
+		if *namespaceFairness {
+			defaultOptions.NewQueue = newNamespaceFairQueue
+		}
 	})
 	return *defaultOptions
 }
@@ -89,6 +181,39 @@ func (pe *parsingError) Error() string {
 		pe.controller, pe.origin)
 }
 
+// panicError occurs if object processing panics
+// it's recovered in order to continue processing of other objects
+type panicError struct {
+	origin     interface{}
+	controller string
+	stack      []byte
+}
+
+func (pe *panicError) Error() string {
+	return fmt.Sprintf("recovered panic for object controller=%q: %v", pe.controller, pe.origin)
+}
+
+// recoverReconcilePanic converts panic into panicError
+// it must be deferred after handleReconcileErr, so panicError is properly handled
+func recoverReconcilePanic(controller string, err *error) {
+	if r := recover(); r != nil {
+		*err = &panicError{origin: r, controller: controller, stack: debug.Stack()}
+	}
+}
+
+// blockedError occurs if object spec has changes, which lead to data loss
+// such changes must be confirmed by user with annotation
+type blockedError struct {
+	changes    []string
+	controller string
+	generation int64
+}
+
+func (be *blockedError) Error() string {
+	return fmt.Sprintf("reconcile is blocked for object controller=%q, spec changes lead to data loss: %s. Add annotation %s=%q to confirm them",
+		be.controller, strings.Join(be.changes, "; "), vmv1beta1.ConfirmDestructiveChangesAnnotation, strconv.FormatInt(be.generation, 10))
+}
+
 // getError could usually occur at following cases:
 // - not enough k8s permissions
 // - object was deleted and due to race condition queue by operator cache
@@ -113,15 +238,60 @@ func handleReconcileErr(ctx context.Context, rclient client.Client, object objec
 	}
 	var ge *getError
 	var pe *parsingError
+	var pne *panicError
+	var be *blockedError
+	var de *deferredError
+	var cvpe *reconcile.ConfigValidationPendingError
+	var cvfe *reconcile.ConfigValidationFailedError
 	switch {
 	case errors.Is(err, context.Canceled):
 		contextCancelErrorsTotal.Inc()
 		return originResult, nil
+	case errors.As(err, &pne):
+		panicsRecoveredTotal.WithLabelValues(pne.controller).Inc()
+		logger.WithContext(ctx).Error(err, "recovered panic at reconcile", "stack", string(pne.stack))
+		if object != nil && !reflect.ValueOf(object).IsNil() && object.GetNamespace() != "" {
+			if err := object.SetUpdateStatusTo(ctx, rclient, vmv1beta1.UpdateStatusDegraded, err); err != nil {
+				logger.WithContext(ctx).Error(err, "failed to update status with recovered panic")
+			}
+			events.Warning(ctx, events.ReasonDegraded, "%s", pne.Error())
+		}
+		return originResult, err
+	case errors.As(err, &be):
+		logger.WithContext(ctx).Info("reconcile is blocked by destructive changes", "changes", be.changes)
+		if err := object.SetUpdateStatusTo(ctx, rclient, vmv1beta1.UpdateStatusBlocked, be); err != nil {
+			logger.WithContext(ctx).Error(err, "failed to update status with blocked changes")
+		}
+		events.Warning(ctx, events.ReasonDestructiveChangeBlocked, "%s", be.Error())
+		// object update triggers reconcile, there is no need to retry
+		return originResult, nil
+	case errors.As(err, &de):
+		logger.WithContext(ctx).Info("spec changes are deferred by maintenance windows", "next_window", de.nextWindow)
+		events.Normal(ctx, events.ReasonMaintenanceWindowDeferred, "%s", de.Error())
+		return ctrl.Result{RequeueAfter: time.Until(de.nextWindow)}, nil
+	case errors.As(err, &cvpe):
+		logger.WithContext(ctx).Info("configuration update is waiting for validation", "job", cvpe.Job)
+		if object != nil && !reflect.ValueOf(object).IsNil() && object.GetNamespace() != "" {
+			if err := object.SetUpdateStatusTo(ctx, rclient, vmv1beta1.UpdateStatusExpanding, nil); err != nil {
+				logger.WithContext(ctx).Error(err, "failed to update status with pending configuration validation")
+			}
+		}
+		// job status change triggers reconcile of its owner
+		return originResult, nil
+	case errors.As(err, &cvfe):
+		logger.WithContext(ctx).Info("configuration didn't pass validation, keeping previous configuration", "job", cvfe.Job)
+		if object != nil && !reflect.ValueOf(object).IsNil() && object.GetNamespace() != "" {
+			events.Warning(ctx, events.ReasonConfigValidationFailed, "%s", cvfe.Error())
+		}
+		// configuration changes trigger reconcile, there is no need to retry
+		return originResult, nil
 	case errors.As(err, &pe):
 		if err := object.SetUpdateStatusTo(ctx, rclient, vmv1beta1.UpdateStatusFailed, err); err != nil {
 			logger.WithContext(ctx).Error(err, "failed to status with parsing error")
 		}
 		parseObjectErrorsTotal.WithLabelValues(pe.controller, fmt.Sprintf("%s/%s", object.GetNamespace(), object.GetName())).Inc()
+		events.Warning(ctx, events.ReasonValidationFailed, "%s", pe.Error())
+		return originResult, err
 	case errors.As(err, &ge):
 		deregisterObjectByCollector(ge.requestObject.Name, ge.requestObject.Namespace, ge.controller)
 		getObjectsErrorsTotal.WithLabelValues(ge.controller, ge.requestObject.String()).Inc()
@@ -140,29 +310,7 @@ func handleReconcileErr(ctx context.Context, rclient client.Client, object objec
 		return ctrl.Result{RequeueAfter: time.Second * 5}, nil
 	}
 	if object != nil && !reflect.ValueOf(object).IsNil() && object.GetNamespace() != "" {
-		errEvent := &corev1.Event{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "victoria-metrics-operator-" + uuid.New().String(),
-				Namespace: object.GetNamespace(),
-			},
-			Type:    corev1.EventTypeWarning,
-			Reason:  "ReconcilationError",
-			Message: err.Error(),
-			Source: corev1.EventSource{
-				Component: "victoria-metrics-operator",
-			},
-			LastTimestamp: metav1.NewTime(time.Now()),
-			InvolvedObject: corev1.ObjectReference{
-				Kind:            object.GetObjectKind().GroupVersionKind().Kind,
-				Namespace:       object.GetNamespace(),
-				Name:            object.GetName(),
-				UID:             object.GetUID(),
-				ResourceVersion: object.GetResourceVersion(),
-			},
-		}
-		if err := rclient.Create(ctx, errEvent); err != nil {
-			logger.WithContext(ctx).Error(err, "failed to create error event at kubernetes API during reconciliation error")
-		}
+		events.Warning(ctx, events.ReasonReconcileError, "%s", err.Error())
 	}
 
 	return originResult, err
@@ -192,7 +340,7 @@ func isNamespaceSelectorMatches(ctx context.Context, rclient client.Client, sour
 	}
 
 	for _, n := range ns.Items {
-		if n.Name == targetCRD.GetNamespace() {
+		if n.Name == sourceCRD.GetNamespace() {
 			return true, nil
 		}
 	}
@@ -233,37 +381,62 @@ type objectWithStatusTrack interface {
 	HasSpecChanges() (bool, error)
 	LastAppliedSpecAsPatch() (client.Patch, error)
 	SetUpdateStatusTo(ctx context.Context, r client.Client, status vmv1beta1.UpdateStatus, maybeReason error) error
+	GetStatusChildren() []vmv1beta1.ChildObject
+	GetStatusWarnings() []string
+	SetStatusWarnings(warnings []string)
 	Paused() bool
 }
 
-func createGenericEventForObject(ctx context.Context, c client.Client, object client.Object, message string) error {
-	ev := &corev1.Event{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "victoria-metrics-operator-" + uuid.New().String(),
-			Namespace: object.GetNamespace(),
-		},
-		Type:    corev1.EventTypeNormal,
-		Reason:  "ReconcileEvent",
-		Message: message,
-		Source: corev1.EventSource{
-			Component: "victoria-metrics-operator",
-		},
-		LastTimestamp: metav1.NewTime(time.Now()),
-		InvolvedObject: corev1.ObjectReference{
-			Kind:            object.GetObjectKind().GroupVersionKind().Kind,
-			Namespace:       object.GetNamespace(),
-			Name:            object.GetName(),
-			UID:             object.GetUID(),
-			ResourceVersion: object.GetResourceVersion(),
-		},
+// warnUnknownImageVersions reports image tags with unknown version at object status.warnings
+// and creates warning event for object with changed spec.
+// Command-line flags for such images are generated for the latest supported release.
+func warnUnknownImageVersions(ctx context.Context, c client.Client, object objectWithStatusTrack, imageTags ...string) error {
+	var warnings []string
+	for _, tag := range imageTags {
+		if tag == "" || build.IsImageVersionKnown(tag) {
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf("cannot parse version of image tag=%q, command-line flags are generated for the latest supported release", tag))
 	}
-	if err := c.Create(ctx, ev); err != nil {
-		return fmt.Errorf("cannot create generic event at k8s api for object: %q: %w", object.GetObjectKind().GroupVersionKind().GroupKind(), err)
+	if specChanged, err := object.HasSpecChanges(); err == nil && specChanged {
+		for _, msg := range warnings {
+			logger.WithContext(ctx).Info(msg)
+			events.Warning(ctx, events.ReasonUnknownImageVersion, "%s", msg)
+		}
 	}
+	return updateStatusWarnings(ctx, c, object, warnings)
+}
+
+// updateStatusWarnings reports problems found at object spec at status.warnings
+func updateStatusWarnings(ctx context.Context, c client.Client, object objectWithStatusTrack, warnings []string) error {
+	if equality.Semantic.DeepEqual(object.GetStatusWarnings(), warnings) {
+		return nil
+	}
+	data, err := json.Marshal(map[string]any{"status": map[string]any{"warnings": warnings}})
+	if err != nil {
+		return fmt.Errorf("cannot marshal status warnings: %w", err)
+	}
+	// patch a copy of object, since spec of the given object could be already modified by operator
+	if err := c.Status().Patch(ctx, object.DeepCopyObject().(client.Object), client.RawPatch(types.MergePatchType, data)); err != nil {
+		return fmt.Errorf("cannot update status warnings: %w", err)
+	}
+	object.SetStatusWarnings(warnings)
 	return nil
 }
 
-func reconcileAndTrackStatus(ctx context.Context, c client.Client, object objectWithStatusTrack, cb func() (ctrl.Result, error)) (result ctrl.Result, resultErr error) {
+// updateStatusChildren reports objects generated by operator during the last successful reconcile at status.children
+func updateStatusChildren(ctx context.Context, c client.Client, object objectWithStatusTrack, children []vmv1beta1.ChildObject) error {
+	if equality.Semantic.DeepEqual(object.GetStatusChildren(), children) {
+		return nil
+	}
+	data, err := json.Marshal(map[string]any{"status": map[string]any{"children": children}})
+	if err != nil {
+		return fmt.Errorf("cannot marshal status children: %w", err)
+	}
+	return c.Status().Patch(ctx, object, client.RawPatch(types.MergePatchType, data))
+}
+
+func reconcileAndTrackStatus(ctx context.Context, c client.Client, object objectWithStatusTrack, cb func(ctx context.Context) (ctrl.Result, error)) (result ctrl.Result, resultErr error) {
 	if object.Paused() {
 		if err := object.SetUpdateStatusTo(ctx, c, vmv1beta1.UpdateStatusPaused, nil); err != nil {
 			resultErr = fmt.Errorf("failed to update object status: %w", err)
@@ -278,6 +451,13 @@ func reconcileAndTrackStatus(ctx context.Context, c client.Client, object object
 	}
 	var diffPatch client.Patch
 	if specChanged {
+		// new objects are created regardless of maintenance windows
+		if vmv1beta1.HasLastAppliedSpec(object) {
+			if err := checkMaintenanceWindow(ctx, c, object); err != nil {
+				resultErr = err
+				return
+			}
+		}
 		diffPatch, err = object.LastAppliedSpecAsPatch()
 		if err != nil {
 			resultErr = fmt.Errorf("cannot parse last applied spec for cluster: %w", err)
@@ -287,13 +467,12 @@ func reconcileAndTrackStatus(ctx context.Context, c client.Client, object object
 			resultErr = fmt.Errorf("failed to update object status: %w", err)
 			return
 		}
-		if err := createGenericEventForObject(ctx, c, object, "starting object update"); err != nil {
-			logger.WithContext(ctx).Error(err, " cannot create k8s api event")
-		}
+		events.Normal(ctx, events.ReasonReconcile, "starting object update")
 		logger.WithContext(ctx).Info("object has changes with previous state, applying changes")
 	}
 
-	result, err = cb()
+	cbCtx := reconcile.WithChildrenCollector(ctx)
+	result, err = cb(cbCtx)
 	if err != nil {
 		if updateErr := object.SetUpdateStatusTo(ctx, c, vmv1beta1.UpdateStatusFailed, err); updateErr != nil {
 			resultErr = fmt.Errorf("failed to update object status: %q, origin err: %w", updateErr, err)
@@ -307,6 +486,10 @@ func reconcileAndTrackStatus(ctx context.Context, c client.Client, object object
 		resultErr = fmt.Errorf("failed to update object status: %w", err)
 		return
 	}
+	if err := updateStatusChildren(ctx, c, object, reconcile.CollectedChildren(cbCtx)); err != nil {
+		resultErr = fmt.Errorf("failed to update object status children: %w", err)
+		return
+	}
 	if specChanged {
 
 		// use patch instead of update, only 1 field must be changed.
@@ -314,9 +497,7 @@ func reconcileAndTrackStatus(ctx context.Context, c client.Client, object object
 			resultErr = fmt.Errorf("cannot update cluster with last applied spec: %w", err)
 			return
 		}
-		if err := createGenericEventForObject(ctx, c, object, "reconcile of object finished successfully"); err != nil {
-			logger.WithContext(ctx).Error(err, " cannot create k8s api event")
-		}
+		events.Normal(ctx, events.ReasonReconcile, "reconcile of object finished successfully")
 		logger.WithContext(ctx).Info("object was successfully reconciled")
 
 	}
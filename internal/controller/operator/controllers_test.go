@@ -2,15 +2,24 @@ package operator
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
 )
 
 func TestIsSelectorsMatchesTargetCRD(t *testing.T) {
@@ -301,6 +310,66 @@ func TestIsSelectorsMatchesTargetCRD(t *testing.T) {
 			},
 			isMatch: true,
 		},
+		{
+			name: "namespaceselector matches source namespace",
+			sourceCRD: &vmv1beta1.VMRule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rule",
+					Namespace: "vm-stack",
+				},
+			},
+			targetCRD: &vmv1beta1.VMAlert{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-vmalert",
+					Namespace: "default",
+				},
+			},
+			selector: nil,
+			namespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"kubernetes.io/metadata.name": "vm-stack",
+				},
+			},
+			predefinedObjects: []runtime.Object{
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{Name: "default", Labels: map[string]string{"kubernetes.io/metadata.name": "default"}},
+				},
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{Name: "vm-stack", Labels: map[string]string{"kubernetes.io/metadata.name": "vm-stack"}},
+				},
+			},
+			isMatch: true,
+		},
+		{
+			name: "namespaceselector matches only target namespace",
+			sourceCRD: &vmv1beta1.VMRule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rule",
+					Namespace: "vm-stack",
+				},
+			},
+			targetCRD: &vmv1beta1.VMAlert{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-vmalert",
+					Namespace: "default",
+				},
+			},
+			selector: nil,
+			namespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"kubernetes.io/metadata.name": "default",
+				},
+			},
+			predefinedObjects: []runtime.Object{
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{Name: "default", Labels: map[string]string{"kubernetes.io/metadata.name": "default"}},
+				},
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{Name: "vm-stack", Labels: map[string]string{"kubernetes.io/metadata.name": "vm-stack"}},
+				},
+			},
+			isMatch: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -315,3 +384,323 @@ func TestIsSelectorsMatchesTargetCRD(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleReconcilePanic(t *testing.T) {
+	ctx := context.Background()
+	cr := &vmv1beta1.VMSingle{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "single",
+			Namespace: "default",
+		},
+	}
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{cr})
+	reconcile := func() (result ctrl.Result, err error) {
+		defer func() {
+			result, err = handleReconcileErr(ctx, fclient, cr, result, err)
+		}()
+		defer recoverReconcilePanic("vmsingle", &err)
+		panic("malformed object")
+	}
+	_, err := reconcile()
+	var pe *panicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected panicError, got: %v", err)
+	}
+	var got vmv1beta1.VMSingle
+	if err := fclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.Name}, &got); err != nil {
+		t.Fatalf("cannot get object: %s", err)
+	}
+	if got.Status.UpdateStatus != vmv1beta1.UpdateStatusDegraded {
+		t.Fatalf("unexpected status, got: %q, want: %q", got.Status.UpdateStatus, vmv1beta1.UpdateStatusDegraded)
+	}
+}
+
+func TestConverterPanicRecovery(t *testing.T) {
+	c := &ConverterController{ctx: context.Background()}
+	var processed int
+	h := c.withPanicRecovery("pod_monitor", cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if obj.(client.Object).GetName() == "malformed" {
+				panic("malformed object")
+			}
+			processed++
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			panic("malformed object")
+		},
+	})
+	for _, name := range []string{"malformed", "valid-1", "valid-2"} {
+		h.OnAdd(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}, false)
+	}
+	h.OnUpdate(&corev1.ConfigMap{}, &corev1.ConfigMap{})
+	if processed != 2 {
+		t.Fatalf("expected 2 processed objects, got: %d", processed)
+	}
+}
+
+func TestConverterNamespaceSelector(t *testing.T) {
+	defer func() {
+		if _, err := config.ReloadBaseConfig(nil); err != nil {
+			t.Fatalf("cannot restore configuration: %s", err)
+		}
+	}()
+	// selector is taken from the current configuration on each event
+	c := &ConverterController{ctx: context.Background()}
+	f := func(selector string, namespaceLabels map[string]string, want bool) {
+		t.Helper()
+		if _, err := config.ReloadBaseConfig(map[string]string{"VM_PROMETHEUSCONVERTERNAMESPACESELECTOR": selector}); err != nil {
+			t.Fatalf("cannot reload configuration: %s", err)
+		}
+		c.rclient = fake.NewClientBuilder().WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: namespaceLabels}}).Build()
+		var processed bool
+		h := c.withNamespaceSelector(cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				processed = true
+			},
+		})
+		h.OnAdd(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "sm", Namespace: "team-a"}}, false)
+		if processed != want {
+			t.Fatalf("unexpected processed state, got: %v, want: %v", processed, want)
+		}
+	}
+	// empty selector
+	f("", nil, true)
+	// matched namespace
+	f("victoriametrics.com/convert=enabled", map[string]string{"victoriametrics.com/convert": "enabled"}, true)
+	// not matched namespace
+	f("victoriametrics.com/convert=enabled", map[string]string{"team": "a"}, false)
+	f("victoriametrics.com/convert!=disabled", map[string]string{"victoriametrics.com/convert": "disabled"}, false)
+}
+
+func TestReconcileBlockedByDestructiveChanges(t *testing.T) {
+	ctx := context.Background()
+	cr := &vmv1beta1.VMCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "cluster",
+			Namespace:  "default",
+			Generation: 2,
+			Annotations: map[string]string{
+				"operator.victoriametrics/last-applied-spec": `{"retentionPeriod":"1","vmstorage":{"replicaCount":1}}`,
+			},
+		},
+		Spec: vmv1beta1.VMClusterSpec{RetentionPeriod: "1"},
+	}
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{cr})
+	r := &VMClusterReconciler{Client: fclient, Log: ctrl.Log, BaseConf: config.MustGetBaseConfig()}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: cr.Namespace, Name: cr.Name}}
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var got vmv1beta1.VMCluster
+	if err := fclient.Get(ctx, req.NamespacedName, &got); err != nil {
+		t.Fatalf("cannot get object: %s", err)
+	}
+	if got.Status.UpdateStatus != vmv1beta1.UpdateStatusBlocked {
+		t.Fatalf("unexpected status, got: %q, want: %q", got.Status.UpdateStatus, vmv1beta1.UpdateStatusBlocked)
+	}
+	if !strings.Contains(got.Status.Reason, "vmstorage is removed") {
+		t.Fatalf("status reason must explain blocked changes, got: %q", got.Status.Reason)
+	}
+}
+
+func TestVMAlertsForAlertmanager(t *testing.T) {
+	f := func(am *vmv1beta1.VMAlertmanager, want []string) {
+		t.Helper()
+		fclient := k8stools.GetTestClientWithObjects([]runtime.Object{
+			&vmv1beta1.VMAlert{
+				ObjectMeta: metav1.ObjectMeta{Name: "by-labels", Namespace: "default"},
+				Spec: vmv1beta1.VMAlertSpec{
+					Notifiers: []vmv1beta1.VMAlertNotifierSpec{
+						{URL: "http://static-am:9093"},
+						{Selector: &vmv1beta1.DiscoverySelector{
+							Labels: &metav1.LabelSelector{MatchLabels: map[string]string{"notify": "true"}},
+						}},
+					},
+				},
+			},
+			&vmv1beta1.VMAlert{
+				ObjectMeta: metav1.ObjectMeta{Name: "by-namespace", Namespace: "default"},
+				Spec: vmv1beta1.VMAlertSpec{
+					Notifier: &vmv1beta1.VMAlertNotifierSpec{Selector: &vmv1beta1.DiscoverySelector{
+						Namespace: &vmv1beta1.NamespaceSelector{MatchNames: []string{"monitoring"}},
+					}},
+				},
+			},
+			&vmv1beta1.VMAlert{
+				ObjectMeta: metav1.ObjectMeta{Name: "static", Namespace: "default"},
+				Spec: vmv1beta1.VMAlertSpec{
+					Notifier: &vmv1beta1.VMAlertNotifierSpec{URL: "http://static-am:9093"},
+				},
+			},
+		})
+		r := &VMAlertReconciler{Client: fclient}
+		var got []string
+		for _, req := range r.vmalertsForAlertmanager(context.Background(), am) {
+			got = append(got, req.Name)
+		}
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Fatalf("unexpected vmalerts, got: %v, want: %v", got, want)
+		}
+	}
+	f(&vmv1beta1.VMAlertmanager{ObjectMeta: metav1.ObjectMeta{Name: "am", Namespace: "team-a", Labels: map[string]string{"notify": "true"}}}, []string{"by-labels"})
+	f(&vmv1beta1.VMAlertmanager{ObjectMeta: metav1.ObjectMeta{Name: "am", Namespace: "monitoring", Labels: map[string]string{"notify": "true"}}}, []string{"by-labels", "by-namespace"})
+	f(&vmv1beta1.VMAlertmanager{ObjectMeta: metav1.ObjectMeta{Name: "am", Namespace: "team-a"}}, nil)
+}
+
+func TestVMAlertsForRule(t *testing.T) {
+	f := func(rule *vmv1beta1.VMRule, want []string) {
+		t.Helper()
+		vmalerts := []*vmv1beta1.VMAlert{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "all", Namespace: "default"},
+				Spec:       vmv1beta1.VMAlertSpec{SelectAllByDefault: true},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "by-labels", Namespace: "default"},
+				Spec: vmv1beta1.VMAlertSpec{
+					RuleSelector:          &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+					RuleNamespaceSelector: &metav1.LabelSelector{},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "paused", Namespace: "default"},
+				Spec:       vmv1beta1.VMAlertSpec{SelectAllByDefault: true, CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{Paused: true}},
+			},
+		}
+		objects := []runtime.Object{
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+		}
+		origin := vmAlertParents
+		defer func() { vmAlertParents = origin }()
+		vmAlertParents = newParentIndex()
+		for _, vma := range vmalerts {
+			objects = append(objects, vma)
+			vmAlertParents.set(types.NamespacedName{Namespace: vma.Namespace, Name: vma.Name}, vmAlertChildSelectors(vma))
+		}
+		fclient := k8stools.GetTestClientWithObjects(objects)
+		r := &VMAlertReconciler{Client: fclient}
+		var got []string
+		for _, req := range r.vmalertsForRule(context.Background(), rule) {
+			got = append(got, req.Name)
+		}
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Fatalf("unexpected vmalerts, got: %v, want: %v", got, want)
+		}
+	}
+	f(&vmv1beta1.VMRule{ObjectMeta: metav1.ObjectMeta{Name: "rule", Namespace: "team-a", Labels: map[string]string{"team": "a"}}}, []string{"all", "by-labels"})
+	f(&vmv1beta1.VMRule{ObjectMeta: metav1.ObjectMeta{Name: "rule", Namespace: "team-a"}}, []string{"all"})
+}
+
+func TestReconcileTracksStatusChildren(t *testing.T) {
+	ctx := context.Background()
+	cr := &vmv1beta1.VMSingle{
+		ObjectMeta: metav1.ObjectMeta{Name: "single", Namespace: "default"},
+	}
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{cr})
+	f := func(cms []string, want []string) {
+		t.Helper()
+		_, err := reconcileAndTrackStatus(ctx, fclient, cr, func(ctx context.Context) (ctrl.Result, error) {
+			for _, name := range cms {
+				cm := &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: cr.Namespace},
+					Data:       map[string]string{"key": name},
+				}
+				if err := reconcile.ConfigMap(ctx, fclient, cm); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+			return ctrl.Result{}, nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		var got vmv1beta1.VMSingle
+		if err := fclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.Name}, &got); err != nil {
+			t.Fatalf("cannot get object: %s", err)
+		}
+		var gotNames []string
+		for _, child := range got.Status.Children {
+			if child.Kind != "ConfigMap" || child.Namespace != cr.Namespace || child.LastAppliedHash == "" {
+				t.Fatalf("unexpected child object: %+v", child)
+			}
+			gotNames = append(gotNames, child.Name)
+		}
+		if strings.Join(gotNames, ",") != strings.Join(want, ",") {
+			t.Fatalf("unexpected children, got: %v, want: %v", gotNames, want)
+		}
+	}
+
+	// new objects
+	f([]string{"vmsingle-b", "vmsingle-a"}, []string{"vmsingle-a", "vmsingle-b"})
+
+	// removed object
+	f([]string{"vmsingle-b"}, []string{"vmsingle-b"})
+
+	// no objects
+	f(nil, nil)
+}
+
+func TestWarnUnknownImageVersions(t *testing.T) {
+	ctx := context.Background()
+	cr := &vmv1beta1.VMSingle{
+		ObjectMeta: metav1.ObjectMeta{Name: "single", Namespace: "default"},
+	}
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{cr})
+	f := func(tag string, wantWarnings int) {
+		t.Helper()
+		// in-memory spec changes must be kept
+		cr.Spec.RetentionPeriod = "10y"
+		if err := warnUnknownImageVersions(ctx, fclient, cr, tag); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if cr.Spec.RetentionPeriod != "10y" {
+			t.Fatalf("in-memory spec must not be changed, got retentionPeriod: %q", cr.Spec.RetentionPeriod)
+		}
+		var got vmv1beta1.VMSingle
+		if err := fclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.Name}, &got); err != nil {
+			t.Fatalf("cannot get object: %s", err)
+		}
+		if len(got.Status.Warnings) != wantWarnings || len(cr.Status.Warnings) != wantWarnings {
+			t.Fatalf("unexpected status warnings, got: %v, in-memory: %v, want count: %d", got.Status.Warnings, cr.Status.Warnings, wantWarnings)
+		}
+		for _, w := range got.Status.Warnings {
+			if !strings.Contains(w, tag) {
+				t.Fatalf("warning must mention image tag=%q, got: %q", tag, w)
+			}
+		}
+	}
+
+	// unknown version
+	f("latest", 1)
+
+	// warning is removed after version change
+	f("v1.103.0", 0)
+
+	// empty tag
+	f("", 0)
+}
+
+func TestRequeueBefore(t *testing.T) {
+	defer func(v time.Duration) { *driftCheckInterval = v }(*driftCheckInterval)
+	cfg := &config.BaseOperatorConf{ForceResyncInterval: 0}
+	f := func(driftCheck, left, want time.Duration) {
+		t.Helper()
+		*driftCheckInterval = driftCheck
+		if got := requeueBefore(cfg, left); got != want {
+			t.Fatalf("unexpected requeue duration, got: %s, want: %s", got, want)
+		}
+	}
+
+	// periodic reconcile is disabled, nothing to wait
+	f(0, 0, 0)
+
+	// periodic reconcile is disabled, object must be reconciled at drain finish
+	f(0, time.Hour, time.Hour)
+
+	// drift check happens earlier
+	f(time.Minute, time.Hour, time.Minute)
+
+	// drain finishes earlier
+	f(time.Hour, time.Minute, time.Minute)
+}
@@ -1,6 +1,7 @@
 package converter
 
 import (
+	"fmt"
 	"strings"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
@@ -15,6 +16,11 @@ import (
 const (
 	prometheusSecretDir    = "/etc/prometheus/secrets"
 	prometheusConfigmapDir = "/etc/prometheus/configmaps"
+
+	// OriginAnnotation contains kind, namespace and name of prometheus object, from which object was converted
+	// annotations:
+	//   operator.victoriametrics.com/converted-from: ServiceMonitor/default/example
+	OriginAnnotation = "operator.victoriametrics.com/converted-from"
 )
 
 var log = ctrl.Log.WithValues("controller", "prometheus.converter")
@@ -51,7 +57,7 @@ func ConvertPromRule(prom *promv1.PrometheusRule, conf *config.BaseOperatorConf)
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:   prom.Namespace,
 			Name:        prom.Name,
-			Labels:      FilterPrefixes(prom.Labels, conf.FilterPrometheusConverterLabelPrefixes),
+			Labels:      ConvertLabels(prom.Labels, prom.Namespace, conf),
 			Annotations: FilterPrefixes(prom.Annotations, conf.FilterPrometheusConverterAnnotationPrefixes),
 		},
 		Spec: vmv1beta1.VMRuleSpec{
@@ -71,6 +77,7 @@ func ConvertPromRule(prom *promv1.PrometheusRule, conf *config.BaseOperatorConf)
 		}
 	}
 	cr.Annotations = MaybeAddArgoCDIgnoreAnnotations(conf.PrometheusConverterAddArgoCDIgnoreAnnotations, cr.Annotations)
+	cr.Annotations = MaybeAddOriginAnnotation(conf.PrometheusConverterAddOriginAnnotation, cr.Annotations, promv1.PrometheusRuleKind, prom.Namespace, prom.Name)
 	return cr
 }
 
@@ -88,6 +95,21 @@ func MaybeAddArgoCDIgnoreAnnotations(mustAdd bool, dst map[string]string) map[st
 	return dst
 }
 
+// MaybeAddOriginAnnotation optionally adds annotation with the source prometheus object
+func MaybeAddOriginAnnotation(mustAdd bool, dst map[string]string, kind, namespace, name string) map[string]string {
+	if !mustAdd {
+		// fast path
+		return dst
+	}
+	// dst could be shared with prometheus object
+	annotations := make(map[string]string, len(dst)+1)
+	for k, v := range dst {
+		annotations[k] = v
+	}
+	annotations[OriginAnnotation] = fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+	return annotations
+}
+
 // ConvertServiceMonitor create VMServiceScrape from ServiceMonitor
 func ConvertServiceMonitor(serviceMon *promv1.ServiceMonitor, conf *config.BaseOperatorConf) *vmv1beta1.VMServiceScrape {
 	cs := &vmv1beta1.VMServiceScrape{
@@ -95,7 +117,7 @@ func ConvertServiceMonitor(serviceMon *promv1.ServiceMonitor, conf *config.BaseO
 			Name:        serviceMon.Name,
 			Namespace:   serviceMon.Namespace,
 			Annotations: FilterPrefixes(serviceMon.Annotations, conf.FilterPrometheusConverterAnnotationPrefixes),
-			Labels:      FilterPrefixes(serviceMon.Labels, conf.FilterPrometheusConverterLabelPrefixes),
+			Labels:      ConvertLabels(serviceMon.Labels, serviceMon.Namespace, conf),
 		},
 		Spec: vmv1beta1.VMServiceScrapeSpec{
 			JobLabel:        serviceMon.Spec.JobLabel,
@@ -107,6 +129,7 @@ func ConvertServiceMonitor(serviceMon *promv1.ServiceMonitor, conf *config.BaseO
 				Any:        serviceMon.Spec.NamespaceSelector.Any,
 				MatchNames: serviceMon.Spec.NamespaceSelector.MatchNames,
 			},
+			ScrapeClassName: serviceMon.Spec.ScrapeClassName,
 		},
 	}
 	if serviceMon.Spec.SampleLimit != nil {
@@ -130,6 +153,7 @@ func ConvertServiceMonitor(serviceMon *promv1.ServiceMonitor, conf *config.BaseO
 		}
 	}
 	cs.Annotations = MaybeAddArgoCDIgnoreAnnotations(conf.PrometheusConverterAddArgoCDIgnoreAnnotations, cs.Annotations)
+	cs.Annotations = MaybeAddOriginAnnotation(conf.PrometheusConverterAddOriginAnnotation, cs.Annotations, promv1.ServiceMonitorsKind, serviceMon.Namespace, serviceMon.Name)
 	return cs
 }
 
@@ -380,7 +404,7 @@ func ConvertPodMonitor(podMon *promv1.PodMonitor, conf *config.BaseOperatorConf)
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        podMon.Name,
 			Namespace:   podMon.Namespace,
-			Labels:      FilterPrefixes(podMon.Labels, conf.FilterPrometheusConverterLabelPrefixes),
+			Labels:      ConvertLabels(podMon.Labels, podMon.Namespace, conf),
 			Annotations: FilterPrefixes(podMon.Annotations, conf.FilterPrometheusConverterAnnotationPrefixes),
 		},
 		Spec: vmv1beta1.VMPodScrapeSpec{
@@ -392,6 +416,7 @@ func ConvertPodMonitor(podMon *promv1.PodMonitor, conf *config.BaseOperatorConf)
 				MatchNames: podMon.Spec.NamespaceSelector.MatchNames,
 			},
 			PodMetricsEndpoints: convertPodEndpoints(podMon.Spec.PodMetricsEndpoints),
+			ScrapeClassName:     podMon.Spec.ScrapeClassName,
 		},
 	}
 	if podMon.Spec.SampleLimit != nil {
@@ -415,6 +440,7 @@ func ConvertPodMonitor(podMon *promv1.PodMonitor, conf *config.BaseOperatorConf)
 		}
 	}
 	cs.Annotations = MaybeAddArgoCDIgnoreAnnotations(conf.PrometheusConverterAddArgoCDIgnoreAnnotations, cs.Annotations)
+	cs.Annotations = MaybeAddOriginAnnotation(conf.PrometheusConverterAddOriginAnnotation, cs.Annotations, promv1.PodMonitorsKind, podMon.Namespace, podMon.Name)
 	return cs
 }
 
@@ -449,7 +475,7 @@ func ConvertProbe(probe *promv1.Probe, conf *config.BaseOperatorConf) *vmv1beta1
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        probe.Name,
 			Namespace:   probe.Namespace,
-			Labels:      FilterPrefixes(probe.Labels, conf.FilterPrometheusConverterLabelPrefixes),
+			Labels:      ConvertLabels(probe.Labels, probe.Namespace, conf),
 			Annotations: FilterPrefixes(probe.Annotations, conf.FilterPrometheusConverterAnnotationPrefixes),
 		},
 		Spec: vmv1beta1.VMProbeSpec{
@@ -497,6 +523,7 @@ func ConvertProbe(probe *promv1.Probe, conf *config.BaseOperatorConf) *vmv1beta1
 		}
 	}
 	cp.Annotations = MaybeAddArgoCDIgnoreAnnotations(conf.PrometheusConverterAddArgoCDIgnoreAnnotations, cp.Annotations)
+	cp.Annotations = MaybeAddOriginAnnotation(conf.PrometheusConverterAddOriginAnnotation, cp.Annotations, promv1.ProbesKind, probe.Namespace, probe.Name)
 	return cp
 }
 
@@ -515,6 +542,22 @@ func filterUnsupportedRelabelCfg(relabelCfgs []*vmv1beta1.RelabelConfig) []*vmv1
 	return newRelabelCfg
 }
 
+// ConvertLabels returns labels for object converted from prometheus object at the given namespace.
+// It filters labels with configured prefixes and adds converter target label for the namespace, if it's configured.
+func ConvertLabels(src map[string]string, namespace string, conf *config.BaseOperatorConf) map[string]string {
+	dst := FilterPrefixes(src, conf.FilterPrometheusConverterLabelPrefixes)
+	target, ok := conf.PrometheusConverterNamespaceTargets[namespace]
+	if !ok {
+		return dst
+	}
+	labels := make(map[string]string, len(dst)+1)
+	for k, v := range dst {
+		labels[k] = v
+	}
+	labels[conf.PrometheusConverterTargetLabel] = target
+	return labels
+}
+
 // FilterPrefixes filters given prefixes from src map
 func FilterPrefixes(src map[string]string, filterPrefixes []string) map[string]string {
 	if len(src) == 0 || len(filterPrefixes) == 0 {
@@ -273,12 +273,57 @@ func TestConvertServiceMonitor(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with namespace target",
+			args: args{
+				serviceMon: &promv1.ServiceMonitor{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "team-a",
+						Labels:    map[string]string{"helm.sh/release": "prod", "keep-label": "value"},
+					},
+					Spec: promv1.ServiceMonitorSpec{
+						Endpoints: []promv1.Endpoint{{Port: "http"}},
+					},
+				},
+			},
+			want: vmv1beta1.VMServiceScrape{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "team-a",
+					Labels: map[string]string{
+						"keep-label": "value",
+						"operator.victoriametrics.com/converter-target": "team-a-stack",
+					},
+				},
+				Spec: vmv1beta1.VMServiceScrapeSpec{
+					Endpoints: []vmv1beta1.Endpoint{{Port: "http"}},
+				},
+			},
+		},
+		{
+			name: "with scrape class",
+			args: args{
+				serviceMon: &promv1.ServiceMonitor{
+					Spec: promv1.ServiceMonitorSpec{
+						ScrapeClassName: ptr.To("tls"),
+						Endpoints:       []promv1.Endpoint{{Port: "http"}},
+					},
+				},
+			},
+			want: vmv1beta1.VMServiceScrape{
+				Spec: vmv1beta1.VMServiceScrapeSpec{
+					ScrapeClassName: ptr.To("tls"),
+					Endpoints:       []vmv1beta1.Endpoint{{Port: "http"}},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			got := ConvertServiceMonitor(tt.args.serviceMon, &config.BaseOperatorConf{
 				FilterPrometheusConverterLabelPrefixes:      []string{"helm.sh"},
 				FilterPrometheusConverterAnnotationPrefixes: []string{"app.kubernetes"},
+				PrometheusConverterNamespaceTargets:         map[string]string{"team-a": "team-a-stack"},
+				PrometheusConverterTargetLabel:              "operator.victoriametrics.com/converter-target",
 			})
 			if !reflect.DeepEqual(*got, tt.want) {
 				t.Errorf("ConvertServiceMonitor() got = \n%v, \nwant \n%v", got, tt.want)
@@ -505,6 +550,51 @@ func TestConvertProbe(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with prober settings",
+			args: args{
+				probe: &promv1.Probe{
+					Spec: promv1.ProbeSpec{
+						JobName: "blackbox",
+						Module:  "http_2xx",
+						ProberSpec: promv1.ProberSpec{
+							URL:    "blackbox-exporter:9115",
+							Scheme: "https",
+							Path:   "/blackbox/probe",
+						},
+						Interval:      "30s",
+						ScrapeTimeout: "10s",
+						SampleLimit:   ptr.To[uint64](100),
+						Targets: promv1.ProbeTargets{
+							StaticConfig: &promv1.ProbeTargetStaticConfig{
+								Targets: []string{"https://example.com"},
+							},
+						},
+					},
+				},
+			},
+			want: vmv1beta1.VMProbe{
+				Spec: vmv1beta1.VMProbeSpec{
+					JobName: "blackbox",
+					Module:  "http_2xx",
+					VMProberSpec: vmv1beta1.VMProberSpec{
+						URL:    "blackbox-exporter:9115",
+						Scheme: "https",
+						Path:   "/blackbox/probe",
+					},
+					EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{
+						Interval:      "30s",
+						ScrapeTimeout: "10s",
+						SampleLimit:   100,
+					},
+					Targets: vmv1beta1.VMProbeTargets{
+						StaticConfig: &vmv1beta1.VMProbeTargetStaticConfig{
+							Targets: []string{"https://example.com"},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -519,3 +609,26 @@ func TestConvertProbe(t *testing.T) {
 		})
 	}
 }
+
+func TestMaybeAddOriginAnnotation(t *testing.T) {
+	f := func(mustAdd bool, src, want map[string]string) {
+		t.Helper()
+		srcCopy := make(map[string]string, len(src))
+		for k, v := range src {
+			srcCopy[k] = v
+		}
+		got := MaybeAddOriginAnnotation(mustAdd, src, promv1.ServiceMonitorsKind, "default", "example")
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("unexpected annotations, got: %v, want: %v", got, want)
+		}
+		if len(src) > 0 && !reflect.DeepEqual(src, srcCopy) {
+			t.Fatalf("source annotations must not be modified, got: %v, want: %v", src, srcCopy)
+		}
+	}
+	// disabled
+	f(false, map[string]string{"key": "value"}, map[string]string{"key": "value"})
+	// empty annotations
+	f(true, nil, map[string]string{OriginAnnotation: "ServiceMonitor/default/example"})
+	// with annotations
+	f(true, map[string]string{"key": "value"}, map[string]string{"key": "value", OriginAnnotation: "ServiceMonitor/default/example"})
+}
@@ -0,0 +1,319 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// ExportedFromAnnotation contains kind, namespace and name of VictoriaMetrics object, from which prometheus object was exported
+	// annotations:
+	//   operator.victoriametrics.com/exported-from: VMServiceScrape/default/example
+	// prometheus converter ignores objects with this annotation, it prevents conversion loops
+	ExportedFromAnnotation = "operator.victoriametrics.com/exported-from"
+)
+
+// supportedPromRelabelActions defines relabel actions supported by prometheus
+var supportedPromRelabelActions = map[string]struct{}{
+	"":          {},
+	"replace":   {},
+	"keep":      {},
+	"drop":      {},
+	"hashmod":   {},
+	"labelmap":  {},
+	"labeldrop": {},
+	"labelkeep": {},
+	"lowercase": {},
+	"uppercase": {},
+	"keepequal": {},
+	"dropequal": {},
+}
+
+// IsExported checks if prometheus object was exported from VictoriaMetrics object
+func IsExported(obj metav1.Object) bool {
+	_, ok := obj.GetAnnotations()[ExportedFromAnnotation]
+	return ok
+}
+
+// IsConvertedFromPrometheus checks if VictoriaMetrics object was converted from prometheus object
+// such objects must not be exported back
+func IsConvertedFromPrometheus(obj metav1.Object) bool {
+	if _, ok := obj.GetAnnotations()[OriginAnnotation]; ok {
+		return true
+	}
+	for _, ref := range obj.GetOwnerReferences() {
+		if strings.HasPrefix(ref.APIVersion, promv1.SchemeGroupVersion.Group+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func exportedMeta(obj metav1.Object, gvk metav1.GroupVersionKind) metav1.ObjectMeta {
+	annotations := make(map[string]string, len(obj.GetAnnotations())+1)
+	for k, v := range obj.GetAnnotations() {
+		annotations[k] = v
+	}
+	annotations[ExportedFromAnnotation] = fmt.Sprintf("%s/%s/%s", gvk.Kind, obj.GetNamespace(), obj.GetName())
+	return metav1.ObjectMeta{
+		Name:        obj.GetName(),
+		Namespace:   obj.GetNamespace(),
+		Labels:      obj.GetLabels(),
+		Annotations: annotations,
+		OwnerReferences: []metav1.OwnerReference{
+			{
+				APIVersion:         gvk.Group + "/" + gvk.Version,
+				Kind:               gvk.Kind,
+				Name:               obj.GetName(),
+				UID:                obj.GetUID(),
+				Controller:         ptr.To(true),
+				BlockOwnerDeletion: ptr.To(true),
+			},
+		},
+	}
+}
+
+// ExportVMServiceScrape creates ServiceMonitor from VMServiceScrape
+// VictoriaMetrics specific settings, which cannot be expressed with ServiceMonitor, are dropped
+func ExportVMServiceScrape(cr *vmv1beta1.VMServiceScrape) *promv1.ServiceMonitor {
+	sm := &promv1.ServiceMonitor{
+		ObjectMeta: exportedMeta(cr, metav1.GroupVersionKind{
+			Group:   vmv1beta1.GroupVersion.Group,
+			Version: vmv1beta1.GroupVersion.Version,
+			Kind:    "VMServiceScrape",
+		}),
+		Spec: promv1.ServiceMonitorSpec{
+			JobLabel:        cr.Spec.JobLabel,
+			TargetLabels:    cr.Spec.TargetLabels,
+			PodTargetLabels: cr.Spec.PodTargetLabels,
+			Selector:        cr.Spec.Selector,
+			Endpoints:       exportEndpoints(cr.Spec.Endpoints),
+			NamespaceSelector: promv1.NamespaceSelector{
+				Any:        cr.Spec.NamespaceSelector.Any,
+				MatchNames: cr.Spec.NamespaceSelector.MatchNames,
+			},
+			ScrapeClassName: cr.Spec.ScrapeClassName,
+		},
+	}
+	if cr.Spec.SampleLimit > 0 {
+		sm.Spec.SampleLimit = ptr.To(cr.Spec.SampleLimit)
+	}
+	if cr.Spec.AttachMetadata.Node != nil {
+		sm.Spec.AttachMetadata = &promv1.AttachMetadata{
+			Node: cr.Spec.AttachMetadata.Node,
+		}
+	}
+	return sm
+}
+
+func exportEndpoints(vmEndpoints []vmv1beta1.Endpoint) []promv1.Endpoint {
+	endpoints := make([]promv1.Endpoint, 0, len(vmEndpoints))
+	for _, endpoint := range vmEndpoints {
+		ep := promv1.Endpoint{
+			Port:                 endpoint.Port,
+			TargetPort:           endpoint.TargetPort,
+			Path:                 endpoint.Path,
+			Scheme:               endpoint.Scheme,
+			Params:               endpoint.Params,
+			Interval:             promv1.Duration(endpoint.Interval),
+			ScrapeTimeout:        promv1.Duration(endpoint.ScrapeTimeout),
+			HonorLabels:          endpoint.HonorLabels,
+			HonorTimestamps:      endpoint.HonorTimestamps,
+			ProxyURL:             endpoint.ProxyURL,
+			FollowRedirects:      endpoint.FollowRedirects,
+			TLSConfig:            exportTLSConfig(endpoint.TLSConfig),
+			BasicAuth:            exportBasicAuth(endpoint.BasicAuth),
+			OAuth2:               exportOAuth(endpoint.OAuth2),
+			Authorization:        exportAuthorization(endpoint.Authorization),
+			MetricRelabelConfigs: exportRelabelConfigs(endpoint.MetricRelabelConfigs),
+			RelabelConfigs:       exportRelabelConfigs(endpoint.RelabelConfigs),
+			//nolint:staticcheck
+			BearerTokenFile: replaceVMDirPath(endpoint.BearerTokenFile),
+			//nolint:staticcheck
+			BearerTokenSecret: endpoint.BearerTokenSecret,
+		}
+		endpoints = append(endpoints, ep)
+	}
+	return endpoints
+}
+
+// replaceVMDirPath replaces VictoriaMetrics directory path for config maps and secrets to prometheus one
+func replaceVMDirPath(origin string) string {
+	if strings.HasPrefix(origin, vmv1beta1.SecretsDir) {
+		return strings.Replace(origin, vmv1beta1.SecretsDir, prometheusSecretDir, 1)
+	}
+	if strings.HasPrefix(origin, vmv1beta1.ConfigMapsDir) {
+		return strings.Replace(origin, vmv1beta1.ConfigMapsDir, prometheusConfigmapDir, 1)
+	}
+	return origin
+}
+
+func exportTLSConfig(tlsConf *vmv1beta1.TLSConfig) *promv1.TLSConfig {
+	if tlsConf == nil {
+		return nil
+	}
+	tc := &promv1.TLSConfig{
+		SafeTLSConfig: promv1.SafeTLSConfig{
+			CA: promv1.SecretOrConfigMap{
+				Secret:    tlsConf.CA.Secret,
+				ConfigMap: tlsConf.CA.ConfigMap,
+			},
+			Cert: promv1.SecretOrConfigMap{
+				Secret:    tlsConf.Cert.Secret,
+				ConfigMap: tlsConf.Cert.ConfigMap,
+			},
+			KeySecret: tlsConf.KeySecret,
+		},
+		CAFile:   replaceVMDirPath(tlsConf.CAFile),
+		CertFile: replaceVMDirPath(tlsConf.CertFile),
+		KeyFile:  replaceVMDirPath(tlsConf.KeyFile),
+	}
+	if tlsConf.InsecureSkipVerify {
+		tc.InsecureSkipVerify = ptr.To(true)
+	}
+	if tlsConf.ServerName != "" {
+		tc.ServerName = ptr.To(tlsConf.ServerName)
+	}
+	return tc
+}
+
+func exportBasicAuth(bAuth *vmv1beta1.BasicAuth) *promv1.BasicAuth {
+	if bAuth == nil {
+		return nil
+	}
+	return &promv1.BasicAuth{
+		Username: bAuth.Username,
+		Password: bAuth.Password,
+	}
+}
+
+func exportOAuth(src *vmv1beta1.OAuth2) *promv1.OAuth2 {
+	// ServiceMonitor supports only client secret from Secret
+	if src == nil || src.ClientSecret == nil {
+		return nil
+	}
+	return &promv1.OAuth2{
+		ClientID: promv1.SecretOrConfigMap{
+			Secret:    src.ClientID.Secret,
+			ConfigMap: src.ClientID.ConfigMap,
+		},
+		ClientSecret:   *src.ClientSecret,
+		TokenURL:       src.TokenURL,
+		Scopes:         src.Scopes,
+		EndpointParams: src.EndpointParams,
+	}
+}
+
+func exportAuthorization(src *vmv1beta1.Authorization) *promv1.SafeAuthorization {
+	if src == nil || src.Credentials == nil {
+		return nil
+	}
+	return &promv1.SafeAuthorization{
+		Type:        src.Type,
+		Credentials: src.Credentials,
+	}
+}
+
+// exportRelabelConfigs converts VictoriaMetrics relabel configs to prometheus one
+// configs with VictoriaMetrics specific actions and fields are skipped
+func exportRelabelConfigs(vmRelabelConfigs []*vmv1beta1.RelabelConfig) []promv1.RelabelConfig {
+	if vmRelabelConfigs == nil {
+		return nil
+	}
+	relabelCfg := []promv1.RelabelConfig{}
+	for _, relabel := range vmRelabelConfigs {
+		if _, ok := supportedPromRelabelActions[strings.ToLower(relabel.Action)]; !ok || len(relabel.If) > 0 || relabel.Match != "" || len(relabel.Labels) > 0 {
+			log.Info("skipping relabelConfig unsupported by prometheus", "action", relabel.Action)
+			continue
+		}
+		sourceLabels := relabel.SourceLabels
+		if len(sourceLabels) == 0 {
+			sourceLabels = relabel.UnderScoreSourceLabels
+		}
+		targetLabel := relabel.TargetLabel
+		if targetLabel == "" {
+			targetLabel = relabel.UnderScoreTargetLabel
+		}
+		rc := promv1.RelabelConfig{
+			TargetLabel: targetLabel,
+			Modulus:     relabel.Modulus,
+			Action:      relabel.Action,
+		}
+		for _, l := range sourceLabels {
+			rc.SourceLabels = append(rc.SourceLabels, promv1.LabelName(l))
+		}
+		if relabel.Separator != "" {
+			rc.Separator = ptr.To(relabel.Separator)
+		}
+		if relabel.Replacement != "" {
+			rc.Replacement = ptr.To(relabel.Replacement)
+		}
+		switch len(relabel.Regex) {
+		case 0:
+		case 1:
+			rc.Regex = relabel.Regex[0]
+		default:
+			// multiple regexes are matched with OR at VictoriaMetrics
+			rc.Regex = "(?:" + strings.Join(relabel.Regex, ")|(?:") + ")"
+		}
+		relabelCfg = append(relabelCfg, rc)
+	}
+	return relabelCfg
+}
+
+// ExportVMRule creates PrometheusRule from VMRule
+// groups with non-prometheus datasource type are skipped,
+// VictoriaMetrics specific group and rule settings are dropped
+func ExportVMRule(cr *vmv1beta1.VMRule) *promv1.PrometheusRule {
+	ruleGroups := make([]promv1.RuleGroup, 0, len(cr.Spec.Groups))
+	for _, vmGroup := range cr.Spec.Groups {
+		if vmGroup.Type != "" && vmGroup.Type != "prometheus" {
+			log.Info("skipping rule group with datasource type unsupported by prometheus", "group", vmGroup.Name, "type", vmGroup.Type)
+			continue
+		}
+		ruleItems := make([]promv1.Rule, 0, len(vmGroup.Rules))
+		for _, vmRuleItem := range vmGroup.Rules {
+			trule := promv1.Rule{
+				Labels:      vmRuleItem.Labels,
+				Annotations: vmRuleItem.Annotations,
+				Expr:        intstr.FromString(vmRuleItem.Expr),
+				Record:      vmRuleItem.Record,
+				Alert:       vmRuleItem.Alert,
+			}
+			if vmRuleItem.For != "" {
+				trule.For = ptr.To(promv1.Duration(vmRuleItem.For))
+			}
+			if vmRuleItem.KeepFiringFor != "" {
+				trule.KeepFiringFor = ptr.To(promv1.NonEmptyDuration(vmRuleItem.KeepFiringFor))
+			}
+			ruleItems = append(ruleItems, trule)
+		}
+		tgroup := promv1.RuleGroup{
+			Name:  vmGroup.Name,
+			Rules: ruleItems,
+		}
+		if vmGroup.Interval != "" {
+			tgroup.Interval = ptr.To(promv1.Duration(vmGroup.Interval))
+		}
+		if vmGroup.Limit > 0 {
+			tgroup.Limit = ptr.To(vmGroup.Limit)
+		}
+		ruleGroups = append(ruleGroups, tgroup)
+	}
+	return &promv1.PrometheusRule{
+		ObjectMeta: exportedMeta(cr, metav1.GroupVersionKind{
+			Group:   vmv1beta1.GroupVersion.Group,
+			Version: vmv1beta1.GroupVersion.Version,
+			Kind:    "VMRule",
+		}),
+		Spec: promv1.PrometheusRuleSpec{
+			Groups: ruleGroups,
+		},
+	}
+}
@@ -0,0 +1,171 @@
+package converter
+
+import (
+	"testing"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+func TestExportVMServiceScrape(t *testing.T) {
+	cr := &vmv1beta1.VMServiceScrape{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "example",
+			Namespace:   "default",
+			UID:         "some-uid",
+			Labels:      map[string]string{"app": "example"},
+			Annotations: map[string]string{"team": "a"},
+		},
+		Spec: vmv1beta1.VMServiceScrapeSpec{
+			JobLabel:    "app",
+			Selector:    metav1.LabelSelector{MatchLabels: map[string]string{"app": "example"}},
+			SampleLimit: 100,
+			Endpoints: []vmv1beta1.Endpoint{
+				{
+					Port: "http",
+					EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{
+						Path:     "/metrics",
+						Scheme:   "https",
+						Interval: "30s",
+					},
+					EndpointAuth: vmv1beta1.EndpointAuth{
+						TLSConfig: &vmv1beta1.TLSConfig{
+							CAFile:             "/etc/vm/secrets/tls/ca.crt",
+							InsecureSkipVerify: true,
+						},
+						BearerTokenSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "token"}, Key: "token"},
+					},
+					EndpointRelabelings: vmv1beta1.EndpointRelabelings{
+						RelabelConfigs: []*vmv1beta1.RelabelConfig{
+							{SourceLabels: []string{"__meta_kubernetes_pod_name"}, TargetLabel: "pod", Action: "replace"},
+						},
+					},
+				},
+			},
+		},
+	}
+	got := ExportVMServiceScrape(cr)
+	assert.Equal(t, map[string]string{"team": "a", ExportedFromAnnotation: "VMServiceScrape/default/example"}, got.Annotations)
+	assert.Equal(t, cr.Labels, got.Labels)
+	if assert.Len(t, got.OwnerReferences, 1) {
+		ref := got.OwnerReferences[0]
+		assert.Equal(t, "operator.victoriametrics.com/v1beta1", ref.APIVersion)
+		assert.Equal(t, "VMServiceScrape", ref.Kind)
+		assert.Equal(t, cr.UID, ref.UID)
+	}
+	assert.Equal(t, ptr.To(uint64(100)), got.Spec.SampleLimit)
+	if assert.Len(t, got.Spec.Endpoints, 1) {
+		ep := got.Spec.Endpoints[0]
+		assert.Equal(t, "/etc/prometheus/secrets/tls/ca.crt", ep.TLSConfig.CAFile)
+		assert.Equal(t, ptr.To(true), ep.TLSConfig.InsecureSkipVerify)
+		assert.Equal(t, promv1.Duration("30s"), ep.Interval)
+	}
+	assert.True(t, IsExported(got))
+
+	// converting back must produce the same spec
+	converted := ConvertServiceMonitor(got, &config.BaseOperatorConf{})
+	assert.Equal(t, cr.Spec, converted.Spec)
+}
+
+func TestExportRelabelConfigs(t *testing.T) {
+	f := func(src []*vmv1beta1.RelabelConfig, want []promv1.RelabelConfig) {
+		t.Helper()
+		assert.Equal(t, want, exportRelabelConfigs(src))
+	}
+
+	// nil configs
+	f(nil, nil)
+
+	// prometheus compatible config
+	f([]*vmv1beta1.RelabelConfig{
+		{UnderScoreSourceLabels: []string{"job"}, UnderScoreTargetLabel: "service", Regex: vmv1beta1.StringOrArray{"(.+)"}, Replacement: "$1", Separator: ";"},
+	}, []promv1.RelabelConfig{
+		{SourceLabels: []promv1.LabelName{"job"}, TargetLabel: "service", Regex: "(.+)", Replacement: ptr.To("$1"), Separator: ptr.To(";")},
+	})
+
+	// multiple regexes
+	f([]*vmv1beta1.RelabelConfig{
+		{SourceLabels: []string{"job"}, Regex: vmv1beta1.StringOrArray{"a", "b"}, Action: "keep"},
+	}, []promv1.RelabelConfig{
+		{SourceLabels: []promv1.LabelName{"job"}, Regex: "(?:a)|(?:b)", Action: "keep"},
+	})
+
+	// victoriametrics specific configs are skipped
+	f([]*vmv1beta1.RelabelConfig{
+		{Action: "keep_if_equal", SourceLabels: []string{"a", "b"}},
+		{If: vmv1beta1.StringOrArray{`{job="a"}`}, TargetLabel: "team", Replacement: "a"},
+		{Action: "drop", SourceLabels: []string{"job"}, Regex: vmv1beta1.StringOrArray{"b"}},
+	}, []promv1.RelabelConfig{
+		{Action: "drop", SourceLabels: []promv1.LabelName{"job"}, Regex: "b"},
+	})
+}
+
+func TestExportVMRule(t *testing.T) {
+	cr := &vmv1beta1.VMRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "rules", Namespace: "monitoring"},
+		Spec: vmv1beta1.VMRuleSpec{
+			Groups: []vmv1beta1.RuleGroup{
+				{
+					Name:     "prometheus",
+					Interval: "1m",
+					Limit:    10,
+					Rules: []vmv1beta1.Rule{
+						{Alert: "Down", Expr: "up == 0", For: "5m", KeepFiringFor: "1m", Labels: map[string]string{"severity": "critical"}},
+						{Record: "job:up:sum", Expr: "sum(up) by (job)"},
+					},
+				},
+				{
+					Name: "logs",
+					Type: "vlogs",
+					Rules: []vmv1beta1.Rule{
+						{Alert: "Errors", Expr: "error | stats count() as errors"},
+					},
+				},
+			},
+		},
+	}
+	got := ExportVMRule(cr)
+	assert.Equal(t, "VMRule/monitoring/rules", got.Annotations[ExportedFromAnnotation])
+	assert.Equal(t, []promv1.RuleGroup{
+		{
+			Name:     "prometheus",
+			Interval: ptr.To(promv1.Duration("1m")),
+			Limit:    ptr.To(10),
+			Rules: []promv1.Rule{
+				{
+					Alert:         "Down",
+					Expr:          intstr.FromString("up == 0"),
+					For:           ptr.To(promv1.Duration("5m")),
+					KeepFiringFor: ptr.To(promv1.NonEmptyDuration("1m")),
+					Labels:        map[string]string{"severity": "critical"},
+				},
+				{Record: "job:up:sum", Expr: intstr.FromString("sum(up) by (job)")},
+			},
+		},
+	}, got.Spec.Groups)
+}
+
+func TestIsConvertedFromPrometheus(t *testing.T) {
+	f := func(meta metav1.ObjectMeta, want bool) {
+		t.Helper()
+		assert.Equal(t, want, IsConvertedFromPrometheus(&vmv1beta1.VMRule{ObjectMeta: meta}))
+	}
+
+	// regular object
+	f(metav1.ObjectMeta{Name: "rule"}, false)
+
+	// with origin annotation
+	f(metav1.ObjectMeta{Name: "rule", Annotations: map[string]string{OriginAnnotation: "PrometheusRule/default/rule"}}, true)
+
+	// with prometheus owner reference
+	f(metav1.ObjectMeta{Name: "rule", OwnerReferences: []metav1.OwnerReference{{APIVersion: "monitoring.coreos.com/v1", Kind: "PrometheusRule", Name: "rule"}}}, true)
+
+	// with other owner reference
+	f(metav1.ObjectMeta{Name: "rule", OwnerReferences: []metav1.OwnerReference{{APIVersion: "v1", Kind: "ConfigMap", Name: "rule"}}}, false)
+}
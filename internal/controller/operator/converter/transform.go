@@ -0,0 +1,219 @@
+package converter
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+)
+
+// TransformRules defines rules, which filter and modify objects converted from ServiceMonitor and PodMonitor
+// rules are applied in the defined order
+type TransformRules struct {
+	Rules []TransformRule `json:"rules"`
+}
+
+// TransformRule defines filter and transformation for converted objects matched by rule
+type TransformRule struct {
+	// Name is used for logging
+	Name string `json:"name,omitempty"`
+	// Match defines prometheus objects, to which rule is applied
+	// empty match selects all objects
+	Match TransformMatch `json:"match,omitempty"`
+	// Skip disables conversion of matched objects
+	// previously converted objects are kept as is
+	Skip bool `json:"skip,omitempty"`
+	// Endpoint defines changes applied to each endpoint of converted object
+	Endpoint *EndpointTransform `json:"endpoint,omitempty"`
+}
+
+// TransformMatch selects prometheus objects by kind, namespace and labels
+type TransformMatch struct {
+	// Kinds of prometheus objects, ServiceMonitor or PodMonitor
+	Kinds []string `json:"kinds,omitempty"`
+	// Namespaces of prometheus objects
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Selector for labels of prometheus objects
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	selector labels.Selector
+}
+
+// EndpointTransform defines changes of converted endpoint
+// fields are dropped first, then values are set and relabel configs are appended
+type EndpointTransform struct {
+	// DropFields resets given endpoint fields to empty values
+	DropFields []string `json:"dropFields,omitempty"`
+	// Scheme overrides endpoint scheme
+	Scheme string `json:"scheme,omitempty"`
+	// Interval overrides endpoint scrape interval
+	Interval string `json:"interval,omitempty"`
+	// ScrapeTimeout overrides endpoint scrape timeout
+	ScrapeTimeout string `json:"scrapeTimeout,omitempty"`
+	// AppendRelabelConfigs are added after endpoint relabelConfigs
+	AppendRelabelConfigs []*vmv1beta1.RelabelConfig `json:"appendRelabelConfigs,omitempty"`
+	// AppendMetricRelabelConfigs are added after endpoint metricRelabelConfigs
+	AppendMetricRelabelConfigs []*vmv1beta1.RelabelConfig `json:"appendMetricRelabelConfigs,omitempty"`
+}
+
+// supportedDropFields defines endpoint fields supported by EndpointTransform.DropFields
+var supportedDropFields = map[string]struct{}{
+	"honorLabels":          {},
+	"honorTimestamps":      {},
+	"params":               {},
+	"interval":             {},
+	"scrapeTimeout":        {},
+	"proxyURL":             {},
+	"followRedirects":      {},
+	"tlsConfig":            {},
+	"relabelConfigs":       {},
+	"metricRelabelConfigs": {},
+}
+
+func dropEndpointField(field string, p *vmv1beta1.EndpointScrapeParams, a *vmv1beta1.EndpointAuth, r *vmv1beta1.EndpointRelabelings) {
+	switch field {
+	case "honorLabels":
+		p.HonorLabels = false
+	case "honorTimestamps":
+		p.HonorTimestamps = nil
+	case "params":
+		p.Params = nil
+	case "interval":
+		p.Interval = ""
+	case "scrapeTimeout":
+		p.ScrapeTimeout = ""
+	case "proxyURL":
+		p.ProxyURL = nil
+	case "followRedirects":
+		p.FollowRedirects = nil
+	case "tlsConfig":
+		a.TLSConfig = nil
+	case "relabelConfigs":
+		r.RelabelConfigs = nil
+	case "metricRelabelConfigs":
+		r.MetricRelabelConfigs = nil
+	}
+}
+
+// LoadTransformRules reads and validates transform rules from the given file
+func LoadTransformRules(path string) (*TransformRules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read transform rules file: %w", err)
+	}
+	return ParseTransformRules(data)
+}
+
+// ParseTransformRules parses and validates transform rules from yaml
+func ParseTransformRules(data []byte) (*TransformRules, error) {
+	var trs TransformRules
+	if err := yaml.UnmarshalStrict(data, &trs); err != nil {
+		return nil, fmt.Errorf("cannot parse transform rules: %w", err)
+	}
+	for i := range trs.Rules {
+		r := &trs.Rules[i]
+		for _, kind := range r.Match.Kinds {
+			if kind != promv1.ServiceMonitorsKind && kind != promv1.PodMonitorsKind {
+				return nil, fmt.Errorf("rule=%q: unsupported kind=%q, only %s and %s are supported", r.Name, kind, promv1.ServiceMonitorsKind, promv1.PodMonitorsKind)
+			}
+		}
+		r.Match.selector = labels.Everything()
+		if r.Match.Selector != nil {
+			s, err := metav1.LabelSelectorAsSelector(r.Match.Selector)
+			if err != nil {
+				return nil, fmt.Errorf("rule=%q: cannot parse selector: %w", r.Name, err)
+			}
+			r.Match.selector = s
+		}
+		if r.Endpoint != nil {
+			for _, f := range r.Endpoint.DropFields {
+				if _, ok := supportedDropFields[f]; !ok {
+					return nil, fmt.Errorf("rule=%q: unsupported dropFields value=%q", r.Name, f)
+				}
+			}
+		}
+	}
+	return &trs, nil
+}
+
+func (tm *TransformMatch) matches(kind string, obj metav1.Object) bool {
+	if len(tm.Kinds) > 0 && !slices.Contains(tm.Kinds, kind) {
+		return false
+	}
+	if len(tm.Namespaces) > 0 && !slices.Contains(tm.Namespaces, obj.GetNamespace()) {
+		return false
+	}
+	return tm.selector == nil || tm.selector.Matches(labels.Set(obj.GetLabels()))
+}
+
+func (et *EndpointTransform) apply(p *vmv1beta1.EndpointScrapeParams, a *vmv1beta1.EndpointAuth, r *vmv1beta1.EndpointRelabelings) {
+	for _, f := range et.DropFields {
+		dropEndpointField(f, p, a, r)
+	}
+	if et.Scheme != "" {
+		p.Scheme = et.Scheme
+	}
+	if et.Interval != "" {
+		p.Interval = et.Interval
+	}
+	if et.ScrapeTimeout != "" {
+		p.ScrapeTimeout = et.ScrapeTimeout
+	}
+	r.RelabelConfigs = append(r.RelabelConfigs, et.AppendRelabelConfigs...)
+	r.MetricRelabelConfigs = append(r.MetricRelabelConfigs, et.AppendMetricRelabelConfigs...)
+}
+
+// TransformServiceScrape applies matched rules to VMServiceScrape converted from the given ServiceMonitor
+// returns false if conversion of ServiceMonitor must be skipped
+func (trs *TransformRules) TransformServiceScrape(src *promv1.ServiceMonitor, dst *vmv1beta1.VMServiceScrape) bool {
+	if trs == nil {
+		return true
+	}
+	for _, r := range trs.Rules {
+		if !r.Match.matches(promv1.ServiceMonitorsKind, src) {
+			continue
+		}
+		if r.Skip {
+			log.Info("skipping conversion by transform rule", "rule", r.Name, "kind", promv1.ServiceMonitorsKind, "name", src.Name, "namespace", src.Namespace)
+			return false
+		}
+		if r.Endpoint == nil {
+			continue
+		}
+		for i := range dst.Spec.Endpoints {
+			ep := &dst.Spec.Endpoints[i]
+			r.Endpoint.apply(&ep.EndpointScrapeParams, &ep.EndpointAuth, &ep.EndpointRelabelings)
+		}
+	}
+	return true
+}
+
+// TransformPodScrape applies matched rules to VMPodScrape converted from the given PodMonitor
+// returns false if conversion of PodMonitor must be skipped
+func (trs *TransformRules) TransformPodScrape(src *promv1.PodMonitor, dst *vmv1beta1.VMPodScrape) bool {
+	if trs == nil {
+		return true
+	}
+	for _, r := range trs.Rules {
+		if !r.Match.matches(promv1.PodMonitorsKind, src) {
+			continue
+		}
+		if r.Skip {
+			log.Info("skipping conversion by transform rule", "rule", r.Name, "kind", promv1.PodMonitorsKind, "name", src.Name, "namespace", src.Namespace)
+			return false
+		}
+		if r.Endpoint == nil {
+			continue
+		}
+		for i := range dst.Spec.PodMetricsEndpoints {
+			ep := &dst.Spec.PodMetricsEndpoints[i]
+			r.Endpoint.apply(&ep.EndpointScrapeParams, &ep.EndpointAuth, &ep.EndpointRelabelings)
+		}
+	}
+	return true
+}
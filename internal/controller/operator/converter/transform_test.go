@@ -0,0 +1,181 @@
+package converter
+
+import (
+	"testing"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestParseTransformRules(t *testing.T) {
+	f := func(data string, wantErr bool) {
+		t.Helper()
+		_, err := ParseTransformRules([]byte(data))
+		if wantErr {
+			assert.Error(t, err)
+			return
+		}
+		assert.NoError(t, err)
+	}
+
+	// empty rules
+	f(``, false)
+
+	// valid rules
+	f(`
+rules:
+- name: legacy
+  match:
+    kinds: [ServiceMonitor]
+    namespaces: [team-a]
+    selector:
+      matchLabels:
+        app: legacy
+  endpoint:
+    scheme: https
+    dropFields: [honorLabels]
+    appendMetricRelabelConfigs:
+    - action: drop
+      source_labels: [__name__]
+      regex: go_.*
+- name: skip-pods
+  match:
+    kinds: [PodMonitor]
+  skip: true
+`, false)
+
+	// unknown field
+	f(`
+rules:
+- name: typo
+  endpiont:
+    scheme: https
+`, true)
+
+	// unsupported kind
+	f(`
+rules:
+- match:
+    kinds: [Probe]
+  skip: true
+`, true)
+
+	// unsupported drop field
+	f(`
+rules:
+- endpoint:
+    dropFields: [port]
+`, true)
+
+	// incorrect selector
+	f(`
+rules:
+- match:
+    selector:
+      matchExpressions:
+      - key: app
+        operator: Unknown
+  skip: true
+`, true)
+}
+
+func TestTransformServiceScrape(t *testing.T) {
+	f := func(rules string, src *promv1.ServiceMonitor, wantConverted bool, wantEndpoints []vmv1beta1.Endpoint) {
+		t.Helper()
+		trs, err := ParseTransformRules([]byte(rules))
+		assert.NoError(t, err)
+		dst := &vmv1beta1.VMServiceScrape{
+			ObjectMeta: metav1.ObjectMeta{Name: src.Name, Namespace: src.Namespace},
+			Spec:       vmv1beta1.VMServiceScrapeSpec{Endpoints: convertEndpoint(src.Spec.Endpoints)},
+		}
+		assert.Equal(t, wantConverted, trs.TransformServiceScrape(src, dst))
+		if wantConverted {
+			assert.Equal(t, wantEndpoints, dst.Spec.Endpoints)
+		}
+	}
+	src := &promv1.ServiceMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "legacy", Namespace: "team-a", Labels: map[string]string{"app": "legacy"}},
+		Spec: promv1.ServiceMonitorSpec{
+			Endpoints: []promv1.Endpoint{{Port: "http", Scheme: "http", HonorLabels: true}},
+		},
+	}
+	origin := []vmv1beta1.Endpoint{{Port: "http", EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{Scheme: "http", HonorLabels: true}}}
+
+	// without rules
+	f(``, src, true, origin)
+
+	// skip matched object
+	f(`
+rules:
+- match:
+    namespaces: [team-a]
+  skip: true
+`, src, false, nil)
+
+	// rule for other namespace
+	f(`
+rules:
+- match:
+    namespaces: [team-b]
+  skip: true
+`, src, true, origin)
+
+	// rule for other kind
+	f(`
+rules:
+- match:
+    kinds: [PodMonitor]
+  skip: true
+`, src, true, origin)
+
+	// transform endpoints
+	f(`
+rules:
+- match:
+    selector:
+      matchLabels:
+        app: legacy
+  endpoint:
+    scheme: https
+    dropFields: [honorLabels]
+    appendMetricRelabelConfigs:
+    - action: drop
+      source_labels: [__name__]
+      regex: go_.*
+`, src, true, []vmv1beta1.Endpoint{{
+		Port:                 "http",
+		EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{Scheme: "https"},
+		EndpointRelabelings: vmv1beta1.EndpointRelabelings{
+			MetricRelabelConfigs: []*vmv1beta1.RelabelConfig{{Action: "drop", SourceLabels: []string{"__name__"}, UnderScoreSourceLabels: []string{"__name__"}, Regex: vmv1beta1.StringOrArray{"go_.*"}}},
+		},
+	}})
+}
+
+func TestTransformPodScrape(t *testing.T) {
+	trs, err := ParseTransformRules([]byte(`
+rules:
+- match:
+    kinds: [PodMonitor]
+  endpoint:
+    interval: 1m
+    dropFields: [honorTimestamps]
+`))
+	assert.NoError(t, err)
+	src := &promv1.PodMonitor{ObjectMeta: metav1.ObjectMeta{Name: "pods", Namespace: "default"}}
+	dst := &vmv1beta1.VMPodScrape{
+		Spec: vmv1beta1.VMPodScrapeSpec{
+			PodMetricsEndpoints: []vmv1beta1.PodMetricsEndpoint{
+				{Port: "http", EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{Interval: "30s", HonorTimestamps: ptr.To(true)}},
+			},
+		},
+	}
+	assert.True(t, trs.TransformPodScrape(src, dst))
+	assert.Equal(t, vmv1beta1.EndpointScrapeParams{Interval: "1m"}, dst.Spec.PodMetricsEndpoints[0].EndpointScrapeParams)
+
+	// nil rules keep object as is
+	var empty *TransformRules
+	assert.True(t, empty.TransformPodScrape(src, dst))
+}
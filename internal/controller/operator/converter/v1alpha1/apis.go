@@ -89,7 +89,7 @@ func ConvertAlertmanagerConfig(promAMCfg *promv1alpha1.AlertmanagerConfig, conf
 			Name:        promAMCfg.Name,
 			Namespace:   promAMCfg.Namespace,
 			Annotations: converter.FilterPrefixes(promAMCfg.Annotations, conf.FilterPrometheusConverterAnnotationPrefixes),
-			Labels:      converter.FilterPrefixes(promAMCfg.Labels, conf.FilterPrometheusConverterLabelPrefixes),
+			Labels:      converter.ConvertLabels(promAMCfg.Labels, promAMCfg.Namespace, conf),
 		},
 		Spec: vmv1beta1.VMAlertmanagerConfigSpec{
 			InhibitRules: convertInhibitRules(promAMCfg.Spec.InhibitRules),
@@ -116,6 +116,7 @@ func ConvertAlertmanagerConfig(promAMCfg *promv1alpha1.AlertmanagerConfig, conf
 		}
 	}
 	vamc.Annotations = converter.MaybeAddArgoCDIgnoreAnnotations(conf.PrometheusConverterAddArgoCDIgnoreAnnotations, vamc.Annotations)
+	vamc.Annotations = converter.MaybeAddOriginAnnotation(conf.PrometheusConverterAddOriginAnnotation, vamc.Annotations, promv1alpha1.AlertmanagerConfigKind, promAMCfg.Namespace, promAMCfg.Name)
 	return vamc, nil
 }
 
@@ -125,7 +126,7 @@ func ConvertScrapeConfig(promscrapeConfig *promv1alpha1.ScrapeConfig, conf *conf
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        promscrapeConfig.Name,
 			Namespace:   promscrapeConfig.Namespace,
-			Labels:      converter.FilterPrefixes(promscrapeConfig.Labels, conf.FilterPrometheusConverterLabelPrefixes),
+			Labels:      converter.ConvertLabels(promscrapeConfig.Labels, promscrapeConfig.Namespace, conf),
 			Annotations: converter.FilterPrefixes(promscrapeConfig.Annotations, conf.FilterPrometheusConverterAnnotationPrefixes),
 		},
 	}
@@ -139,7 +140,7 @@ func ConvertScrapeConfig(promscrapeConfig *promv1alpha1.ScrapeConfig, conf *conf
 		log.Error(err, "POSSIBLE BUG: failed to convert prometheus scrapeconfig to VMScrapeConfig", "name", promscrapeConfig.Name, "namespace", promscrapeConfig.Namespace)
 		return cs
 	}
-	cs.Labels = converter.FilterPrefixes(promscrapeConfig.Labels, conf.FilterPrometheusConverterLabelPrefixes)
+	cs.Labels = converter.ConvertLabels(promscrapeConfig.Labels, promscrapeConfig.Namespace, conf)
 	cs.Annotations = converter.FilterPrefixes(promscrapeConfig.Annotations, conf.FilterPrometheusConverterAnnotationPrefixes)
 	cs.Spec.RelabelConfigs = converter.ConvertRelabelConfig(promscrapeConfig.Spec.RelabelConfigs)
 	cs.Spec.MetricRelabelConfigs = converter.ConvertRelabelConfig(promscrapeConfig.Spec.MetricRelabelConfigs)
@@ -163,6 +164,7 @@ func ConvertScrapeConfig(promscrapeConfig *promv1alpha1.ScrapeConfig, conf *conf
 		}
 	}
 	cs.Annotations = converter.MaybeAddArgoCDIgnoreAnnotations(conf.PrometheusConverterAddArgoCDIgnoreAnnotations, cs.Annotations)
+	cs.Annotations = converter.MaybeAddOriginAnnotation(conf.PrometheusConverterAddOriginAnnotation, cs.Annotations, promv1alpha1.ScrapeConfigsKind, promscrapeConfig.Namespace, promscrapeConfig.Name)
 	return cs
 }
 
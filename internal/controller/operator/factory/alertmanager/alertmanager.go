@@ -6,11 +6,14 @@ import (
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/build"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/defaultrules"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/tracing"
 
 	"github.com/prometheus/client_golang/prometheus"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -28,8 +31,16 @@ var badConfigsTotal = prometheus.NewCounter(prometheus.CounterOpts{
 	},
 })
 
+var badTemplatesTotal = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "operator_alertmanager_bad_objects_count",
+	Help: "Number of child CRDs with bad or incomplete configurations",
+	ConstLabels: prometheus.Labels{
+		"crd": "vmalertmanager_template",
+	},
+})
+
 func init() {
-	metrics.Registry.MustRegister(badConfigsTotal)
+	metrics.Registry.MustRegister(badConfigsTotal, badTemplatesTotal)
 }
 
 // CreateOrUpdateAlertManager creates alertmanagerand and bulds config for it
@@ -52,12 +63,23 @@ func CreateOrUpdateAlertManager(ctx context.Context, cr *vmv1beta1.VMAlertmanage
 	if err != nil {
 		return err
 	}
+	gossipAdvertiseAddress, err := createOrUpdateGossipService(ctx, cr, rclient)
+	if err != nil {
+		return err
+	}
 	if !ptr.Deref(cr.Spec.DisableSelfServiceScrape, false) {
 		err := reconcile.VMServiceScrapeForCRD(ctx, rclient, build.VMServiceScrapeForAlertmanager(service, cr))
 		if err != nil {
 			return err
 		}
 	}
+	var prevDefaultRules *vmv1beta1.DefaultRules
+	if cr.ParsedLastAppliedSpec != nil {
+		prevDefaultRules = cr.ParsedLastAppliedSpec.DefaultRules
+	}
+	if err := defaultrules.CreateOrUpdate(ctx, rclient, cr.Spec.DefaultRules, prevDefaultRules, defaultrules.ForVMAlertmanager(cr)); err != nil {
+		return fmt.Errorf("cannot create or update default rules: %w", err)
+	}
 
 	if cr.Spec.PodDisruptionBudget != nil {
 		if err := reconcile.PDB(ctx, rclient, build.PodDisruptionBudget(cr, cr.Spec.PodDisruptionBudget)); err != nil {
@@ -70,19 +92,28 @@ func CreateOrUpdateAlertManager(ctx context.Context, cr *vmv1beta1.VMAlertmanage
 		prevCR := cr.DeepCopy()
 		prevCR.Spec = *cr.ParsedLastAppliedSpec
 		var err error
-		prevSts, err = newStsForAlertManager(prevCR)
+		prevSts, err = newStsForAlertManager(prevCR, gossipAdvertiseAddress)
 		if err != nil {
 			return fmt.Errorf("cannot generate prev alertmanager sts, name: %s,err: %w", cr.Name, err)
 		}
+		if err := build.ApplyOverridePatches(prevCR.Spec.OverridePatches, prevSts); err != nil {
+			return fmt.Errorf("cannot apply override patches to prev object: %w", err)
+		}
 	}
-	newSts, err := newStsForAlertManager(cr)
+	newSts, err := tracing.Build(ctx, "vmalertmanager", func() (*appsv1.StatefulSet, error) {
+		return newStsForAlertManager(cr, gossipAdvertiseAddress)
+	})
 	if err != nil {
 		return fmt.Errorf("cannot generate alertmanager sts, name: %s,err: %w", cr.Name, err)
 	}
+	if err := build.ApplyOverridePatches(cr.Spec.OverridePatches, newSts); err != nil {
+		return err
+	}
 
 	stsOpts := reconcile.STSOptions{
 		HasClaim:       len(newSts.Spec.VolumeClaimTemplates) > 0,
 		SelectorLabels: cr.SelectorLabels,
+		UpdateStrategy: cr.Spec.UpdateStrategy,
 	}
 	return reconcile.HandleSTSUpdate(ctx, rclient, stsOpts, newSts, prevSts)
 }
@@ -97,6 +128,11 @@ func deletePrevStateResources(ctx context.Context, cr *vmv1beta1.VMAlertmanager,
 	}
 
 	objMeta := metav1.ObjectMeta{Name: cr.PrefixedName(), Namespace: cr.Namespace}
+	if cr.Spec.GossipService == nil && cr.ParsedLastAppliedSpec.GossipService != nil {
+		if err := finalize.SafeDeleteWithFinalizer(ctx, rclient, &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: cr.GossipServiceName(), Namespace: cr.Namespace}}); err != nil {
+			return fmt.Errorf("cannot remove gossip service: %w", err)
+		}
+	}
 	if cr.Spec.PodDisruptionBudget == nil && cr.ParsedLastAppliedSpec.PodDisruptionBudget != nil {
 		if err := finalize.SafeDeleteWithFinalizer(ctx, rclient, &policyv1.PodDisruptionBudget{ObjectMeta: objMeta}); err != nil {
 			return fmt.Errorf("cannot delete PDB from prev state: %w", err)
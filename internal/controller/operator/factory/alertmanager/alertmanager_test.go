@@ -2,15 +2,19 @@ package alertmanager
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/build"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
 	"github.com/go-test/deep"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -203,6 +207,80 @@ func TestCreateOrUpdateAlertManager(t *testing.T) {
 				return nil
 			},
 		},
+		{
+			name: "alertmanager with nodePort gossip service",
+			args: args{
+				ctx: context.TODO(),
+				cr: &vmv1beta1.VMAlertmanager{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-am",
+						Namespace: "monitoring",
+					},
+					Spec: vmv1beta1.VMAlertmanagerSpec{
+						CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+							ReplicaCount: ptr.To(int32(1)),
+						},
+						AdditionalPeers: []string{"alertmanager.other-cluster.example.com:30094"},
+						GossipService: &vmv1beta1.AlertmanagerGossipService{
+							Type:     corev1.ServiceTypeNodePort,
+							NodePort: 30094,
+						},
+					},
+				},
+			},
+			validate: func(set *appsv1.StatefulSet) error {
+				amContainer := set.Spec.Template.Spec.Containers[0]
+				for _, arg := range []string{
+					"--cluster.listen-address=[$(POD_IP)]:9094",
+					"--cluster.advertise-address=$(HOST_IP):30094",
+					"--cluster.peer=alertmanager.other-cluster.example.com:30094",
+				} {
+					if !slices.Contains(amContainer.Args, arg) {
+						return fmt.Errorf("cannot find arg=%q at container args: %v", arg, amContainer.Args)
+					}
+				}
+				if !slices.ContainsFunc(amContainer.Env, func(e corev1.EnvVar) bool { return e.Name == "HOST_IP" }) {
+					return fmt.Errorf("cannot find HOST_IP env at container envs: %v", amContainer.Env)
+				}
+				return nil
+			},
+		},
+		{
+			name: "alertmanager with load balancer gossip service",
+			args: args{
+				ctx: context.TODO(),
+				cr: &vmv1beta1.VMAlertmanager{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-am",
+						Namespace: "monitoring",
+					},
+					Spec: vmv1beta1.VMAlertmanagerSpec{
+						CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+							ReplicaCount: ptr.To(int32(1)),
+						},
+						GossipService: &vmv1beta1.AlertmanagerGossipService{
+							Type: corev1.ServiceTypeLoadBalancer,
+						},
+					},
+				},
+			},
+			predefinedObjets: []runtime.Object{
+				&corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: "vmalertmanager-test-am-gossip", Namespace: "monitoring"},
+					Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+					Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{
+						Ingress: []corev1.LoadBalancerIngress{{IP: "203.0.113.10"}},
+					}},
+				},
+			},
+			validate: func(set *appsv1.StatefulSet) error {
+				amContainer := set.Spec.Template.Spec.Containers[0]
+				if !slices.Contains(amContainer.Args, "--cluster.advertise-address=203.0.113.10:9094") {
+					return fmt.Errorf("cannot find advertise address at container args: %v", amContainer.Args)
+				}
+				return nil
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -264,6 +342,8 @@ func Test_createDefaultAMConfig(t *testing.T) {
 		wantErr             bool
 		predefinedObjects   []runtime.Object
 		secretMustBeMissing bool
+		wantDataKeys        []string
+		missingDataKeys     []string
 	}{
 		{
 			name: "create alertmanager config",
@@ -346,6 +426,49 @@ func Test_createDefaultAMConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with alertmanager templates",
+			args: args{
+				ctx: context.TODO(),
+				cr: &vmv1beta1.VMAlertmanager{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-am",
+						Namespace: "default",
+					},
+					Spec: vmv1beta1.VMAlertmanagerSpec{
+						TemplateSelector:          &metav1.LabelSelector{},
+						TemplateNamespaceSelector: &metav1.LabelSelector{},
+					},
+				},
+			},
+			predefinedObjects: []runtime.Object{
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+				&vmv1beta1.VMAlertmanagerTemplate{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "slack",
+						Namespace: "default",
+					},
+					Spec: vmv1beta1.VMAlertmanagerTemplateSpec{
+						Templates: map[string]string{
+							"slack.tmpl": `{{ define "slack.title" }}{{ .CommonLabels.alertname }}{{ end }}`,
+						},
+					},
+				},
+				&vmv1beta1.VMAlertmanagerTemplate{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "bad",
+						Namespace: "default",
+					},
+					Spec: vmv1beta1.VMAlertmanagerTemplateSpec{
+						Templates: map[string]string{
+							"bad.tmpl": `{{ define "bad" }}{{ .CommonLabels.alertname }`,
+						},
+					},
+				},
+			},
+			wantDataKeys:    []string{"template_default_slack_slack.tmpl"},
+			missingDataKeys: []string{"template_default_bad_bad.tmpl"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -363,6 +486,99 @@ func Test_createDefaultAMConfig(t *testing.T) {
 				}
 				t.Fatalf("config for alertmanager not exist, err: %v", err)
 			}
+			for _, key := range tt.wantDataKeys {
+				if _, ok := createdSecret.Data[key]; !ok {
+					t.Fatalf("config secret must contain key=%q", key)
+				}
+			}
+			for _, key := range tt.missingDataKeys {
+				if _, ok := createdSecret.Data[key]; ok {
+					t.Fatalf("config secret must not contain key=%q", key)
+				}
+			}
 		})
 	}
 }
+
+func TestRunConfigValidation(t *testing.T) {
+	type opts struct {
+		cr                *vmv1beta1.VMAlertmanager
+		data              map[string][]byte
+		predefinedObjects []runtime.Object
+		wantJob           bool
+		wantPending       bool
+	}
+	f := func(o opts) {
+		t.Helper()
+		ctx := context.Background()
+		fclient := k8stools.GetTestClientWithObjects(o.predefinedObjects)
+		err := runConfigValidation(ctx, fclient, o.cr, o.data)
+		var pe *reconcile.ConfigValidationPendingError
+		if o.wantPending != stderrors.As(err, &pe) {
+			t.Fatalf("unexpected error: %v, want pending: %v", err, o.wantPending)
+		}
+		if !o.wantPending && err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		var job batchv1.Job
+		err = fclient.Get(ctx, types.NamespacedName{Namespace: o.cr.Namespace, Name: configValidationName(o.cr)}, &job)
+		if !o.wantJob {
+			if !errors.IsNotFound(err) {
+				t.Fatalf("expected validation job to be missing, got: %v", err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("cannot get validation job: %s", err)
+		}
+		c := job.Spec.Template.Spec.Containers[0]
+		if diff := deep.Equal(append(c.Command, c.Args...), []string{"amtool", "check-config", alertmanagerConfFile}); len(diff) > 0 {
+			t.Fatalf("unexpected validation command: %v", diff)
+		}
+		var s corev1.Secret
+		if err := fclient.Get(ctx, types.NamespacedName{Namespace: o.cr.Namespace, Name: configValidationName(o.cr)}, &s); err != nil {
+			t.Fatalf("cannot get validation secret: %s", err)
+		}
+		if diff := deep.Equal(s.Data, o.data); len(diff) > 0 {
+			t.Fatalf("unexpected validation secret data: %v", diff)
+		}
+	}
+	cr := &vmv1beta1.VMAlertmanager{
+		ObjectMeta: metav1.ObjectMeta{Name: "base", Namespace: "default"},
+		Spec: vmv1beta1.VMAlertmanagerSpec{
+			ConfigValidation: &vmv1beta1.ConfigValidation{Enabled: true},
+		},
+	}
+	currentSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: cr.ConfigSecretName(), Namespace: "default"},
+		Data:       map[string][]byte{alertmanagerSecretConfigKey: []byte("route: {receiver: blackhole}")},
+	}
+
+	// validation is disabled
+	f(opts{
+		cr:   &vmv1beta1.VMAlertmanager{ObjectMeta: metav1.ObjectMeta{Name: "base", Namespace: "default"}},
+		data: map[string][]byte{alertmanagerSecretConfigKey: []byte("route: {}")},
+	})
+
+	// initial configuration
+	f(opts{
+		cr:   cr,
+		data: map[string][]byte{alertmanagerSecretConfigKey: []byte("route: {}")},
+	})
+
+	// configuration isn't changed
+	f(opts{
+		cr:                cr,
+		data:              map[string][]byte{alertmanagerSecretConfigKey: []byte("route: {receiver: blackhole}")},
+		predefinedObjects: []runtime.Object{currentSecret},
+	})
+
+	// configuration changed
+	f(opts{
+		cr:                cr,
+		data:              map[string][]byte{alertmanagerSecretConfigKey: []byte("route: {receiver: slack}")},
+		predefinedObjects: []runtime.Object{currentSecret},
+		wantJob:           true,
+		wantPending:       true,
+	})
+}
@@ -144,6 +144,42 @@ OUTER:
 	return &result, nil
 }
 
+// ValidateConfig renders configuration of the given VMAlertmanagerConfig in memory
+// global configuration of VMAlertmanager is not known in advance, so receivers are allowed to rely on it
+// if allowMissingSecrets is set, receivers with not existing secrets or keys are not rejected
+func ValidateConfig(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAlertmanagerConfig, allowMissingSecrets bool) error {
+	if cr.Spec.Route == nil {
+		return fmt.Errorf("spec.route cannot be empty")
+	}
+	var globalConfigOpts globalAlertmanagerConfig
+	globalConfigOpts.Global.SMTPFrom = "dry-run"
+	globalConfigOpts.Global.SMTPSmarthost = "dry-run"
+	globalConfigOpts.Global.SlackAPIURL = "dry-run"
+	globalConfigOpts.Global.OpsGenieAPIKey = "dry-run"
+	globalConfigOpts.Global.WeChatAPISecret = "dry-run"
+	globalConfigOpts.Global.WeChatAPICorpID = "dry-run"
+	globalConfigOpts.Global.VictorOpsAPIKey = "dry-run"
+
+	secretCache := make(map[string]*corev1.Secret)
+	configmapCache := make(map[string]*corev1.ConfigMap)
+	tlsAssets := make(map[string]string)
+	for _, receiver := range cr.Spec.Receivers {
+		if _, err := buildReceiver(ctx, rclient, cr, receiver, &globalConfigOpts, secretCache, configmapCache, tlsAssets); err != nil {
+			if allowMissingSecrets && k8stools.IsMissingSecretError(err) {
+				continue
+			}
+			return fmt.Errorf("cannot build receiver=%q: %w", receiver.Name, err)
+		}
+	}
+	if _, err := buildGlobalTimeIntervals(cr); err != nil {
+		return err
+	}
+	if _, err := buildRoute(cr, cr.Spec.Route, true, &vmv1beta1.VMAlertmanager{}); err != nil {
+		return fmt.Errorf("cannot build route: %w", err)
+	}
+	return nil
+}
+
 // addConfigTemplates adds external templates to the given based configuration
 func addConfigTemplates(baseCfg []byte, templates []string) ([]byte, error) {
 	if len(templates) == 0 {
@@ -1857,3 +1857,68 @@ tls_client_config:
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	f := func(cr *vmv1beta1.VMAlertmanagerConfig, allowMissingSecrets, wantErr bool, predefinedObjects ...runtime.Object) {
+		t.Helper()
+		fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		err := ValidateConfig(context.Background(), fclient, cr, allowMissingSecrets)
+		if (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+	}
+	webhookCfg := func(secretName string) *vmv1beta1.VMAlertmanagerConfig {
+		return &vmv1beta1.VMAlertmanagerConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "base", Namespace: "default"},
+			Spec: vmv1beta1.VMAlertmanagerConfigSpec{
+				Receivers: []vmv1beta1.Receiver{{
+					Name: "webhook",
+					WebhookConfigs: []vmv1beta1.WebhookConfig{{
+						URLSecret: &corev1.SecretKeySelector{
+							Key:                  "url",
+							LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+						},
+					}},
+				}},
+				Route: &vmv1beta1.Route{Receiver: "webhook"},
+			},
+		}
+	}
+	urlSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "webhook", Namespace: "default"},
+		Data:       map[string][]byte{"url": []byte("http://some-url")},
+	}
+	badURLSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "bad-webhook", Namespace: "default"},
+		Data:       map[string][]byte{"url": []byte("bad url")},
+	}
+
+	// valid config
+	f(webhookCfg("webhook"), false, false, urlSecret)
+
+	// missing secret
+	f(webhookCfg("missing"), false, true, urlSecret)
+
+	// missing secret allowed
+	f(webhookCfg("missing"), true, false, urlSecret)
+
+	// invalid url at secret
+	f(webhookCfg("bad-webhook"), true, true, badURLSecret)
+
+	// missing route
+	f(&vmv1beta1.VMAlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "base", Namespace: "default"},
+	}, false, true)
+
+	// slack with global api url
+	f(&vmv1beta1.VMAlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "base", Namespace: "default"},
+		Spec: vmv1beta1.VMAlertmanagerConfigSpec{
+			Receivers: []vmv1beta1.Receiver{{
+				Name:         "slack",
+				SlackConfigs: []vmv1beta1.SlackConfig{{Channel: "some-channel"}},
+			}},
+			Route: &vmv1beta1.Route{Receiver: "slack"},
+		},
+	}, false, false)
+}
@@ -0,0 +1,174 @@
+package alertmanager
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// runConfigValidation checks changed alertmanager configuration with amtool check-config job
+// it returns nil, if configuration wasn't changed or passed validation
+// initial configuration isn't validated, since there is no running alertmanager to protect yet
+func runConfigValidation(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAlertmanager, data map[string][]byte) error {
+	name := configValidationName(cr)
+	if !cr.Spec.ConfigValidation.IsEnabled() {
+		return reconcile.RemoveConfigValidation(ctx, rclient, cr.Namespace, name)
+	}
+	var currentSecret corev1.Secret
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.ConfigSecretName()}, &currentSecret); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("cannot get alertmanager config secret: %w", err)
+	}
+	if equality.Semantic.DeepEqual(currentSecret.Data, data) {
+		return nil
+	}
+
+	// validation objects are short-lived and removed by garbage collector together with parent object
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       cr.Namespace,
+			Labels:          configValidationLabels(cr),
+			OwnerReferences: cr.AsOwner(),
+		},
+		Data: data,
+	}
+	if err := reconcile.Secret(ctx, rclient, s); err != nil {
+		return fmt.Errorf("cannot reconcile config validation secret: %w", err)
+	}
+	return reconcile.ConfigValidation(ctx, rclient, buildConfigValidationJob(cr), data)
+}
+
+func configValidationName(cr *vmv1beta1.VMAlertmanager) string {
+	return cr.PrefixedName() + "-config-validation"
+}
+
+// configValidationLabels must not match selector of alertmanager pods
+func configValidationLabels(cr *vmv1beta1.VMAlertmanager) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":      "vmalertmanager-config-validation",
+		"app.kubernetes.io/instance":  cr.Name,
+		"app.kubernetes.io/component": "monitoring",
+		"managed-by":                  "vm-operator",
+	}
+}
+
+// buildConfigValidationJob builds job, which mounts configuration the same way as alertmanager statefulset
+func buildConfigValidationJob(cr *vmv1beta1.VMAlertmanager) *batchv1.Job {
+	name := configValidationName(cr)
+	volumes := []corev1.Volume{
+		{
+			Name: configVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: name},
+			},
+		},
+	}
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      configVolumeName,
+			MountPath: alertmanagerConfDir,
+			ReadOnly:  true,
+		},
+		{
+			Name:      configVolumeName,
+			MountPath: tlsAssetsDir,
+			ReadOnly:  true,
+		},
+	}
+	for _, s := range cr.Spec.Secrets {
+		volumes = append(volumes, corev1.Volume{
+			Name: k8stools.SanitizeVolumeName("secret-" + s),
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: s},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      k8stools.SanitizeVolumeName("secret-" + s),
+			ReadOnly:  true,
+			MountPath: path.Join(vmv1beta1.SecretsDir, s),
+		})
+	}
+	for _, c := range cr.Spec.ConfigMaps {
+		volumes = append(volumes, corev1.Volume{
+			Name: k8stools.SanitizeVolumeName("configmap-" + c),
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: c},
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      k8stools.SanitizeVolumeName("configmap-" + c),
+			ReadOnly:  true,
+			MountPath: path.Join(vmv1beta1.ConfigMapsDir, c),
+		})
+	}
+	volumeByName := make(map[string]struct{})
+	for _, t := range cr.Spec.Templates {
+		if _, ok := volumeByName[t.Name]; ok {
+			continue
+		}
+		volumeByName[t.Name] = struct{}{}
+		volumes = append(volumes, corev1.Volume{
+			Name: k8stools.SanitizeVolumeName("templates-" + t.Name),
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: t.LocalObjectReference},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      k8stools.SanitizeVolumeName("templates-" + t.Name),
+			MountPath: path.Join(templatesDir, t.Name),
+			ReadOnly:  true,
+		})
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       cr.Namespace,
+			Labels:          configValidationLabels(cr),
+			OwnerReferences: cr.AsOwner(),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To[int32](0),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: configValidationLabels(cr),
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: cr.GetServiceAccountName(),
+					ImagePullSecrets:   cr.Spec.ImagePullSecrets,
+					Containers: []corev1.Container{
+						{
+							Name:                     "amtool",
+							Image:                    fmt.Sprintf("%s:%s", cr.Spec.Image.Repository, cr.Spec.Image.Tag),
+							ImagePullPolicy:          cr.Spec.Image.PullPolicy,
+							Command:                  []string{"amtool"},
+							Args:                     []string{"check-config", alertmanagerConfFile},
+							Resources:                cr.Spec.ConfigValidation.Resources,
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+							VolumeMounts:             volumeMounts,
+						},
+					},
+					Volumes: volumes,
+				},
+			},
+		},
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
 
 	corev1 "k8s.io/api/rbac/v1"
@@ -40,7 +41,7 @@ func buildVMAlertmanagerRole(cr *vmv1beta1.VMAlertmanager) *corev1.Role {
 			Namespace:       cr.Namespace,
 			Labels:          cr.AllLabels(),
 			Annotations:     cr.AnnotationsFiltered(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 			OwnerReferences: cr.AsOwner(),
 		},
 		Rules: []corev1.PolicyRule{
@@ -60,7 +61,7 @@ func buildVMAlertmanagerRoleBinding(cr *vmv1beta1.VMAlertmanager) *corev1.RoleBi
 			Namespace:       cr.Namespace,
 			Labels:          cr.AllLabels(),
 			Annotations:     cr.AnnotationsFiltered(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 			OwnerReferences: cr.AsOwner(),
 		},
 		RoleRef: corev1.RoleRef{
@@ -3,6 +3,7 @@ package alertmanager
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/url"
 	"path"
 	"sort"
@@ -12,6 +13,7 @@ import (
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/build"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
@@ -47,12 +49,12 @@ receivers:
 `
 )
 
-func newStsForAlertManager(cr *vmv1beta1.VMAlertmanager) (*appsv1.StatefulSet, error) {
+func newStsForAlertManager(cr *vmv1beta1.VMAlertmanager, gossipAdvertiseAddress string) (*appsv1.StatefulSet, error) {
 	if cr.Spec.Retention == "" {
 		cr.Spec.Retention = defaultRetention
 	}
 
-	spec, err := makeStatefulSetSpec(cr)
+	spec, err := makeStatefulSetSpec(cr, gossipAdvertiseAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +66,7 @@ func newStsForAlertManager(cr *vmv1beta1.VMAlertmanager) (*appsv1.StatefulSet, e
 			Annotations:     cr.AnnotationsFiltered(),
 			Namespace:       cr.Namespace,
 			OwnerReferences: cr.AsOwner(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Spec: *spec,
 	}
@@ -144,7 +146,81 @@ func createOrUpdateAlertManagerService(ctx context.Context, cr *vmv1beta1.VMAler
 	return newService, nil
 }
 
-func makeStatefulSetSpec(cr *vmv1beta1.VMAlertmanager) (*appsv1.StatefulSetSpec, error) {
+// createOrUpdateGossipService reconciles Service, which exposes gossip port outside of kubernetes cluster
+// and returns address, which must be advertised to the cluster peers
+func createOrUpdateGossipService(ctx context.Context, cr *vmv1beta1.VMAlertmanager, rclient client.Client) (string, error) {
+	if cr.Spec.GossipService == nil {
+		return "", nil
+	}
+	newService := buildGossipService(cr)
+	if err := reconcile.Service(ctx, rclient, newService, nil); err != nil {
+		return "", fmt.Errorf("cannot reconcile gossip service for vmalertmanager: %w", err)
+	}
+	if cr.Spec.ClusterAdvertiseAddress != "" {
+		return "", nil
+	}
+	switch newService.Spec.Type {
+	case corev1.ServiceTypeNodePort:
+		// node address is resolved by kubelet for each pod
+		return fmt.Sprintf("$(HOST_IP):%d", cr.Spec.GossipService.NodePort), nil
+	case corev1.ServiceTypeLoadBalancer:
+		var svc corev1.Service
+		if err := rclient.Get(ctx, types.NamespacedName{Namespace: newService.Namespace, Name: newService.Name}, &svc); err != nil {
+			return "", fmt.Errorf("cannot get gossip service for vmalertmanager: %w", err)
+		}
+		for _, ingress := range svc.Status.LoadBalancer.Ingress {
+			host := ingress.IP
+			if host == "" {
+				host = ingress.Hostname
+			}
+			if host != "" {
+				return net.JoinHostPort(host, "9094"), nil
+			}
+		}
+		return "", fmt.Errorf("gossip service=%s of vmalertmanager has no load balancer ingress address yet", svc.Name)
+	default:
+		return "", fmt.Errorf("unsupported gossipService.type=%q", newService.Spec.Type)
+	}
+}
+
+func buildGossipService(cr *vmv1beta1.VMAlertmanager) *corev1.Service {
+	gs := cr.Spec.GossipService
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            cr.GossipServiceName(),
+			Namespace:       cr.Namespace,
+			Labels:          cr.AllLabels(),
+			Annotations:     gs.Annotations,
+			OwnerReferences: cr.AsOwner(),
+			Finalizers:      finalize.ChildFinalizers(),
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     gs.Type,
+			Selector: cr.SelectorLabels(),
+			// preserve client address and route traffic only to the local pod
+			ExternalTrafficPolicy:    corev1.ServiceExternalTrafficPolicyLocal,
+			PublishNotReadyAddresses: true,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "tcp-mesh",
+					Port:       9094,
+					TargetPort: intstr.FromInt(9094),
+					Protocol:   corev1.ProtocolTCP,
+					NodePort:   gs.NodePort,
+				},
+				{
+					Name:       "udp-mesh",
+					Port:       9094,
+					TargetPort: intstr.FromInt(9094),
+					Protocol:   corev1.ProtocolUDP,
+					NodePort:   gs.NodePort,
+				},
+			},
+		},
+	}
+}
+
+func makeStatefulSetSpec(cr *vmv1beta1.VMAlertmanager, gossipAdvertiseAddress string) (*appsv1.StatefulSetSpec, error) {
 
 	image := fmt.Sprintf("%s:%s", cr.Spec.Image.Repository, cr.Spec.Image.Tag)
 
@@ -160,7 +236,8 @@ func makeStatefulSetSpec(cr *vmv1beta1.VMAlertmanager) (*appsv1.StatefulSetSpec,
 		amArgs = append(amArgs, fmt.Sprintf("--cluster.tls-config=%s/%s", tlsAssetsDir, gossipConfigKey))
 	}
 
-	if ptr.Deref(cr.Spec.ReplicaCount, 0) == 1 {
+	// single replica requires cluster only for peering with alertmanagers from the other clusters
+	if ptr.Deref(cr.Spec.ReplicaCount, 0) == 1 && len(cr.Spec.AdditionalPeers) == 0 && cr.Spec.GossipService == nil {
 		amArgs = append(amArgs, "--cluster.listen-address=")
 	} else {
 		amArgs = append(amArgs, "--cluster.listen-address=[$(POD_IP)]:9094")
@@ -197,6 +274,8 @@ func makeStatefulSetSpec(cr *vmv1beta1.VMAlertmanager) (*appsv1.StatefulSetSpec,
 
 	if cr.Spec.ClusterAdvertiseAddress != "" {
 		amArgs = append(amArgs, fmt.Sprintf("--cluster.advertise-address=%s", cr.Spec.ClusterAdvertiseAddress))
+	} else if gossipAdvertiseAddress != "" {
+		amArgs = append(amArgs, fmt.Sprintf("--cluster.advertise-address=%s", gossipAdvertiseAddress))
 	}
 
 	var clusterPeerDomain string
@@ -358,6 +437,7 @@ func makeStatefulSetSpec(cr *vmv1beta1.VMAlertmanager) (*appsv1.StatefulSetSpec,
 
 	amVolumeMounts = append(amVolumeMounts, cr.Spec.VolumeMounts...)
 
+	amArgs = build.AddVersionedArgs("vmalertmanager", cr.Spec.Image.Tag, amArgs, "--")
 	amArgs = build.AddExtraArgsOverrideDefaults(amArgs, cr.Spec.ExtraArgs, "--")
 	sort.Strings(amArgs)
 
@@ -372,6 +452,17 @@ func makeStatefulSetSpec(cr *vmv1beta1.VMAlertmanager) (*appsv1.StatefulSetSpec,
 			},
 		},
 	}
+	if cr.Spec.GossipService != nil && cr.Spec.GossipService.Type == corev1.ServiceTypeNodePort {
+		envs = append(envs, corev1.EnvVar{
+			// Necessary for '--cluster.advertise-address' flag with NodePort gossip service
+			Name: "HOST_IP",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "status.hostIP",
+				},
+			},
+		})
+	}
 	envs = append(envs, cr.Spec.ExtraEnvs...)
 
 	var initContainers []corev1.Container
@@ -416,10 +507,8 @@ func makeStatefulSetSpec(cr *vmv1beta1.VMAlertmanager) (*appsv1.StatefulSetSpec,
 		}
 	}
 	return &appsv1.StatefulSetSpec{
-		ServiceName: cr.PrefixedName(),
-		UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
-			Type: cr.Spec.RollingUpdateStrategy,
-		},
+		ServiceName:    cr.PrefixedName(),
+		UpdateStrategy: build.StatefulSetUpdateStrategy(cr.Spec.UpdateStrategy, cr.Spec.RollingUpdateStrategy),
 		Selector: &metav1.LabelSelector{
 			MatchLabels: cr.SelectorLabels(),
 		},
@@ -490,12 +579,18 @@ func CreateAMConfig(ctx context.Context, cr *vmv1beta1.VMAlertmanager, rclient c
 		alertmananagerConfig = []byte(defaultAMConfig)
 	}
 
-	// add templates from CR to alermanager config
-	if len(cr.Spec.Templates) > 0 {
-		templatePaths := make([]string, 0, len(cr.Spec.Templates))
+	crdTemplates, err := buildTemplatesFromCRDs(ctx, rclient, cr)
+	if err != nil {
+		return fmt.Errorf("cannot build alertmanager templates with templateSelector, err: %w", err)
+	}
+
+	// add templates from CR and VMAlertmanagerTemplates to alermanager config
+	if len(cr.Spec.Templates) > 0 || len(crdTemplates) > 0 {
+		templatePaths := make([]string, 0, len(cr.Spec.Templates)+len(crdTemplates))
 		for _, template := range cr.Spec.Templates {
 			templatePaths = append(templatePaths, path.Join(templatesDir, template.Name, template.Key))
 		}
+		templatePaths = append(templatePaths, templatesPaths(crdTemplates)...)
 		mergedCfg, err := addConfigTemplates(alertmananagerConfig, templatePaths)
 		if err != nil {
 			return fmt.Errorf("cannot build alertmanager config with templates, err: %w", err)
@@ -510,7 +605,7 @@ func CreateAMConfig(ctx context.Context, cr *vmv1beta1.VMAlertmanager, rclient c
 			Labels:          cr.AllLabels(),
 			Annotations:     cr.AnnotationsFiltered(),
 			OwnerReferences: cr.AsOwner(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Data: map[string][]byte{alertmanagerSecretConfigKey: alertmananagerConfig},
 	}
@@ -524,6 +619,16 @@ func CreateAMConfig(ctx context.Context, cr *vmv1beta1.VMAlertmanager, rclient c
 	for assetKey, assetValue := range tlsAssets {
 		newAMSecretConfig.Data[assetKey] = []byte(assetValue)
 	}
+	for templateKey, templateValue := range crdTemplates {
+		newAMSecretConfig.Data[templateKey] = []byte(templateValue)
+	}
+	newAMSecretConfig.Data, err = reconcile.ConfigRevision(ctx, rclient, cr, newAMSecretConfig.Name, newAMSecretConfig.Data)
+	if err != nil {
+		return err
+	}
+	if err := runConfigValidation(ctx, rclient, cr, newAMSecretConfig.Data); err != nil {
+		return err
+	}
 
 	return reconcile.Secret(ctx, rclient, newAMSecretConfig)
 }
@@ -610,6 +715,19 @@ func buildVMAlertmanagerConfigReloader(cr *vmv1beta1.VMAlertmanager, crVolumeMou
 	return configReloaderContainer
 }
 
+// GeneratedConfig returns alertmanager configuration generated by operator for the given VMAlertmanager
+func GeneratedConfig(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAlertmanager) ([]byte, error) {
+	var s corev1.Secret
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.ConfigSecretName()}, &s); err != nil {
+		return nil, err
+	}
+	data, ok := s.Data[alertmanagerSecretConfigKey]
+	if !ok {
+		return nil, fmt.Errorf("cannot find key=%q at secret=%q", alertmanagerSecretConfigKey, s.Name)
+	}
+	return data, nil
+}
+
 func getSecretContentForAlertmanager(ctx context.Context, rclient client.Client, secretName, ns string) ([]byte, error) {
 	var s corev1.Secret
 	if err := rclient.Get(ctx, types.NamespacedName{Namespace: ns, Name: secretName}, &s); err != nil {
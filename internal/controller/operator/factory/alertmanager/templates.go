@@ -0,0 +1,99 @@
+package alertmanager
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// templateSecretKey returns key of config secret for the given template
+// secret is mounted as tls-assets volume, it's watched by config-reloader
+func templateSecretKey(amt *vmv1beta1.VMAlertmanagerTemplate, name string) string {
+	return fmt.Sprintf("template_%s_%s_%s", amt.Namespace, amt.Name, name)
+}
+
+// buildTemplatesFromCRDs selects VMAlertmanagerTemplates for the given alertmanager
+// and returns content of valid templates keyed by config secret key
+func buildTemplatesFromCRDs(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAlertmanager) (map[string]string, error) {
+	if cr.IsTemplatesUnmanaged() {
+		return nil, nil
+	}
+	var okTemplates, badTemplates []*vmv1beta1.VMAlertmanagerTemplate
+	if err := k8stools.VisitObjectsForSelectorsAtNs(ctx, rclient, cr.Spec.TemplateNamespaceSelector, cr.Spec.TemplateSelector, cr.Namespace, cr.Spec.SelectAllByDefault,
+		func(amts *vmv1beta1.VMAlertmanagerTemplateList) {
+			for i := range amts.Items {
+				item := &amts.Items[i]
+				if !item.DeletionTimestamp.IsZero() {
+					continue
+				}
+				if item.Spec.ParsingError != "" {
+					item.Status.LastSyncError = item.Spec.ParsingError
+					badTemplates = append(badTemplates, item)
+					continue
+				}
+				if err := item.Validate(); err != nil {
+					item.Status.LastSyncError = err.Error()
+					badTemplates = append(badTemplates, item)
+					continue
+				}
+				item.Status.LastSyncError = ""
+				okTemplates = append(okTemplates, item)
+			}
+		}); err != nil {
+		return nil, fmt.Errorf("cannot select alertmanager templates: %w", err)
+	}
+	templates := make(map[string]string)
+	for _, amt := range okTemplates {
+		for name, content := range amt.Spec.Templates {
+			templates[templateSecretKey(amt, name)] = content
+		}
+	}
+	logger.WithContext(ctx).Info("selected alertmanager templates", "len", len(okTemplates), "invalid templates", len(badTemplates))
+	if err := updateTemplatesStatuses(ctx, rclient, append(okTemplates, badTemplates...)); err != nil {
+		return nil, fmt.Errorf("failed to update vmalertmanagerTemplates statuses: %w", err)
+	}
+	badTemplatesTotal.Add(float64(len(badTemplates)))
+	return templates, nil
+}
+
+// templatesPaths returns sorted paths of templates mounted from config secret
+func templatesPaths(templates map[string]string) []string {
+	paths := make([]string, 0, len(templates))
+	for key := range templates {
+		paths = append(paths, path.Join(tlsAssetsDir, key))
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// updateTemplatesStatuses updates status of templates, if it was changed
+func updateTemplatesStatuses(ctx context.Context, rclient client.Client, amts []*vmv1beta1.VMAlertmanagerTemplate) error {
+	var errors []string
+	for _, amt := range amts {
+		status := vmv1beta1.UpdateStatusOperational
+		if amt.Status.LastSyncError != "" {
+			status = vmv1beta1.UpdateStatusFailed
+			errors = append(errors, fmt.Sprintf("template=%s error text: %s", amt.AsKey(), amt.Status.LastSyncError))
+		}
+		if amt.Status.Status == status {
+			continue
+		}
+		pt := client.RawPatch(types.MergePatchType,
+			[]byte(fmt.Sprintf(`{"status": {"lastSyncError": %q, "status": %q}}`, amt.Status.LastSyncError, status)))
+		if err := rclient.Status().Patch(ctx, amt, pt); err != nil {
+			return fmt.Errorf("failed to patch status of VMAlertmanagerTemplate=%q: %w", amt.AsKey(), err)
+		}
+	}
+	if len(errors) > 0 {
+		logger.WithContext(ctx).Error(fmt.Errorf("VMAlertmanagerTemplates have errors"), "skip it for config generation", "errors", strings.Join(errors, ","))
+	}
+	return nil
+}
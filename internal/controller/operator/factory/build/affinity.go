@@ -0,0 +1,65 @@
+package build
+
+import (
+	"maps"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultHostTopologyKey = "kubernetes.io/hostname"
+	defaultZoneTopologyKey = "topology.kubernetes.io/zone"
+)
+
+// AffinityWithDefaults returns affinity for pods matched by the given selector
+// it adds default podAntiAffinity if it's enabled and podAntiAffinity isn't defined at affinity
+func AffinityWithDefaults(affinity *corev1.Affinity, s *vmv1beta1.DefaultAffinitySettings, selector *metav1.LabelSelector) *corev1.Affinity {
+	enabled := getCfg().EnableDefaultAffinity
+	if s != nil && s.Enabled != nil {
+		enabled = *s.Enabled
+	}
+	if !enabled || selector == nil || len(selector.MatchLabels) == 0 {
+		return affinity
+	}
+	if affinity != nil && affinity.PodAntiAffinity != nil {
+		return affinity
+	}
+	if affinity == nil {
+		affinity = &corev1.Affinity{}
+	} else {
+		affinity = affinity.DeepCopy()
+	}
+	hostKey := defaultHostTopologyKey
+	zoneKey := defaultZoneTopologyKey
+	var zoneAware bool
+	if s != nil {
+		if s.HostTopologyKey != "" {
+			hostKey = s.HostTopologyKey
+		}
+		if s.ZoneTopologyKey != "" {
+			zoneKey = s.ZoneTopologyKey
+		}
+		zoneAware = s.ZoneAware
+	}
+	// selector labels could be mutated later, e.g. for vmagent shards
+	podSelector := &metav1.LabelSelector{MatchLabels: maps.Clone(selector.MatchLabels)}
+	affinity.PodAntiAffinity = &corev1.PodAntiAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+			Weight: 100,
+			PodAffinityTerm: corev1.PodAffinityTerm{
+				LabelSelector: podSelector,
+				TopologyKey:   hostKey,
+			},
+		}},
+	}
+	if zoneAware {
+		affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = []corev1.PodAffinityTerm{{
+			LabelSelector: podSelector,
+			TopologyKey:   zoneKey,
+		}}
+	}
+	return affinity
+}
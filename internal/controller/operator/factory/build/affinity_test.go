@@ -0,0 +1,78 @@
+package build
+
+import (
+	"testing"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestAffinityWithDefaults(t *testing.T) {
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/name": "vmstorage"}}
+	f := func(affinity *corev1.Affinity, s *vmv1beta1.DefaultAffinitySettings, globalEnabled bool, want *corev1.Affinity) {
+		t.Helper()
+		cfg := getCfg()
+		prev := cfg.EnableDefaultAffinity
+		cfg.EnableDefaultAffinity = globalEnabled
+		defer func() { cfg.EnableDefaultAffinity = prev }()
+		got := AffinityWithDefaults(affinity, s, selector)
+		assert.Equal(t, want, got)
+	}
+	hostTerm := func(key string) []corev1.WeightedPodAffinityTerm {
+		return []corev1.WeightedPodAffinityTerm{{
+			Weight: 100,
+			PodAffinityTerm: corev1.PodAffinityTerm{
+				LabelSelector: selector,
+				TopologyKey:   key,
+			},
+		}}
+	}
+	nodeAffinity := &corev1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "node-role", Operator: corev1.NodeSelectorOpIn, Values: []string{"storage"}}},
+			}},
+		},
+	}
+
+	// disabled
+	f(nil, nil, false, nil)
+
+	// enabled globally
+	f(nil, nil, true, &corev1.Affinity{
+		PodAntiAffinity: &corev1.PodAntiAffinity{PreferredDuringSchedulingIgnoredDuringExecution: hostTerm("kubernetes.io/hostname")},
+	})
+
+	// disabled at object
+	f(nil, &vmv1beta1.DefaultAffinitySettings{Enabled: ptr.To(false)}, true, nil)
+
+	// zone aware with custom topology keys
+	f(nil, &vmv1beta1.DefaultAffinitySettings{
+		Enabled:         ptr.To(true),
+		ZoneAware:       true,
+		HostTopologyKey: "custom/host",
+		ZoneTopologyKey: "custom/zone",
+	}, false, &corev1.Affinity{
+		PodAntiAffinity: &corev1.PodAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: hostTerm("custom/host"),
+			RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{
+				LabelSelector: selector,
+				TopologyKey:   "custom/zone",
+			}},
+		},
+	})
+
+	// user defined node affinity is kept
+	f(&corev1.Affinity{NodeAffinity: nodeAffinity}, nil, true, &corev1.Affinity{
+		NodeAffinity:    nodeAffinity,
+		PodAntiAffinity: &corev1.PodAntiAffinity{PreferredDuringSchedulingIgnoredDuringExecution: hostTerm("kubernetes.io/hostname")},
+	})
+
+	// user defined pod anti affinity overrides defaults
+	userAntiAffinity := &corev1.Affinity{PodAntiAffinity: &corev1.PodAntiAffinity{PreferredDuringSchedulingIgnoredDuringExecution: hostTerm("custom/host")}}
+	f(userAntiAffinity, &vmv1beta1.DefaultAffinitySettings{ZoneAware: true}, true, userAntiAffinity)
+}
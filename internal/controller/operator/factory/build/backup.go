@@ -235,3 +235,81 @@ func VMRestore(
 	}
 	return vmRestore, nil
 }
+
+const (
+	// VMRestoreLatestContainerName is the name of init container, which restores the latest backup
+	VMRestoreLatestContainerName = "vmrestore-latest"
+	// VMRestoreSkippedMessage is reported by init container, if storage already contains data
+	VMRestoreSkippedMessage = "storage data path already contains data, restore is skipped"
+)
+
+// VMRestoreFromLatestBackup creates vmrestore init container,
+// which restores the latest backup made by vmbackupmanager into empty storage data path
+func VMRestoreFromLatestBackup(cr *vmv1beta1.VMBackup, storagePath, dataVolumeName string) *corev1.Container {
+	src := strings.TrimSuffix(cr.Destination, "/") + "/latest"
+	args := []string{
+		fmt.Sprintf("-src=%s", src),
+		fmt.Sprintf("-storageDataPath=%s", storagePath),
+		"-eula",
+	}
+	if cr.LogLevel != nil {
+		args = append(args, fmt.Sprintf("-loggerLevel=%s", *cr.LogLevel))
+	}
+	if cr.LogFormat != nil {
+		args = append(args, fmt.Sprintf("-loggerFormat=%s", *cr.LogFormat))
+	}
+	if cr.Concurrency != nil {
+		args = append(args, fmt.Sprintf("-concurrency=%d", *cr.Concurrency))
+	}
+	if cr.CustomS3Endpoint != nil {
+		args = append(args, fmt.Sprintf("-customS3Endpoint=%s", *cr.CustomS3Endpoint))
+	}
+
+	mounts := []corev1.VolumeMount{
+		{
+			Name:      dataVolumeName,
+			MountPath: storagePath,
+			ReadOnly:  false,
+		},
+	}
+	mounts = append(mounts, cr.VolumeMounts...)
+
+	if cr.CredentialsSecret != nil {
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      k8stools.SanitizeVolumeName("secret-" + cr.CredentialsSecret.Name),
+			MountPath: vmBackuperCreds,
+			ReadOnly:  true,
+		})
+		args = append(args, fmt.Sprintf("-credsFilePath=%s/%s", vmBackuperCreds, cr.CredentialsSecret.Key))
+	}
+	extraEnvs := cr.ExtraEnvs
+	if len(cr.ExtraEnvs) > 0 {
+		args = append(args, "-envflag.enable=true")
+	}
+	sort.Strings(args)
+	quotedArgs := make([]string, 0, len(args))
+	for _, arg := range args {
+		quotedArgs = append(quotedArgs, "'"+strings.ReplaceAll(arg, "'", `'"'"'`)+"'")
+	}
+
+	// vmsingle creates data directory at storage data path on start,
+	// its presence means that storage was already bootstrapped
+	script := fmt.Sprintf(`if [ -d %[1]s/data ]; then
+  echo %[2]q > /dev/termination-log
+  exit 0
+fi
+/vmrestore-prod %[3]s && echo "restored from %[4]s" > /dev/termination-log`, storagePath, VMRestoreSkippedMessage, strings.Join(quotedArgs, " "), src)
+
+	img := cr.Restore.OnStart.Image
+	return &corev1.Container{
+		Name:                     VMRestoreLatestContainerName,
+		Image:                    fmt.Sprintf("%s:%s", img.Repository, img.Tag),
+		ImagePullPolicy:          img.PullPolicy,
+		Command:                  []string{"/bin/sh", "-c"},
+		Args:                     []string{script},
+		Env:                      extraEnvs,
+		VolumeMounts:             mounts,
+		Resources:                cr.Resources,
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -291,6 +292,21 @@ func AppendArgsForInsertPorts(args []string, ip *vmv1beta1.InsertPorts) []string
 	return args
 }
 
+// AppendArgsForCardinalityLimits conditionally appends series limits as flags with the given prefix to the given args
+// e.g. remoteWrite for vmagent and storage for vmstorage
+func AppendArgsForCardinalityLimits(args []string, limits *vmv1beta1.CardinalityLimits, flagPrefix string) []string {
+	if limits == nil {
+		return args
+	}
+	if limits.MaxHourlySeries > 0 {
+		args = append(args, fmt.Sprintf("-%s.maxHourlySeries=%d", flagPrefix, limits.MaxHourlySeries))
+	}
+	if limits.MaxDailySeries > 0 {
+		args = append(args, fmt.Sprintf("-%s.maxDailySeries=%d", flagPrefix, limits.MaxDailySeries))
+	}
+	return args
+}
+
 var (
 	configReloaderDefaultPort    = 8435
 	configReloaderContainerProbe = corev1.ProbeHandler{
@@ -329,3 +345,39 @@ func AddsPortProbesToConfigReloaderContainer(useCustomConfigReloader bool, crCon
 		ProbeHandler:        configReloaderContainerProbe,
 	}
 }
+
+// classifyNativeSidecars places containers with `restartPolicy: Always` according to kubernetes version.
+// If native sidecars are supported, such containers from pod containers are moved to init containers,
+// so kubernetes starts them before the main containers and stops them after.
+// Otherwise, such init containers are moved to pod containers with restartPolicy removed,
+// since they never exit and would block pod start.
+func classifyNativeSidecars(spec *corev1.PodSpec) {
+	isSidecar := func(c *corev1.Container) bool {
+		return c.RestartPolicy != nil && *c.RestartPolicy == corev1.ContainerRestartPolicyAlways
+	}
+	if k8stools.IsNativeSidecarsSupported() {
+		var containers []corev1.Container
+		for _, c := range spec.Containers {
+			if isSidecar(&c) {
+				spec.InitContainers = append(spec.InitContainers, c)
+				continue
+			}
+			containers = append(containers, c)
+		}
+		spec.Containers = containers
+		return
+	}
+	var initContainers []corev1.Container
+	for _, c := range spec.InitContainers {
+		if isSidecar(&c) {
+			c.RestartPolicy = nil
+			spec.Containers = append(spec.Containers, c)
+			continue
+		}
+		initContainers = append(initContainers, c)
+	}
+	spec.InitContainers = initContainers
+	for i := range spec.Containers {
+		spec.Containers[i].RestartPolicy = nil
+	}
+}
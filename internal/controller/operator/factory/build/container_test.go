@@ -5,8 +5,11 @@ import (
 	"testing"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/utils/ptr"
 )
 
 type testBuildProbeCR struct {
@@ -274,3 +277,69 @@ func TestFormatContainerImage(t *testing.T) {
 	// correct behaviour, user must fix image naming
 	f("private.github.io", "my-private.registry/victoria-metrics/storage", "private.github.io/my-private.registry/victoria-metrics/storage")
 }
+
+func TestClassifyNativeSidecars(t *testing.T) {
+	f := func(serverVersion version.Info, spec, want corev1.PodSpec) {
+		t.Helper()
+		if err := k8stools.SetKubernetesVersionWithDefaults(&serverVersion, 0, 0); err != nil {
+			t.Fatalf("cannot set k8s version for testing: %q", err)
+		}
+		defer func() {
+			// return back defaults after test
+			restoreVersion := version.Info{Major: "0", Minor: "0"}
+			if err := k8stools.SetKubernetesVersionWithDefaults(&restoreVersion, 0, 0); err != nil {
+				t.Fatalf("cannot set k8s version for testing: %q", err)
+			}
+		}()
+		classifyNativeSidecars(&spec)
+		assert.Equal(t, want, spec)
+	}
+	always := ptr.To(corev1.ContainerRestartPolicyAlways)
+
+	// no sidecars
+	f(version.Info{Major: "1", Minor: "30"}, corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "config-init"}},
+		Containers:     []corev1.Container{{Name: "vmagent"}, {Name: "config-reloader"}},
+	}, corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "config-init"}},
+		Containers:     []corev1.Container{{Name: "vmagent"}, {Name: "config-reloader"}},
+	})
+
+	// sidecar container moved to init containers
+	f(version.Info{Major: "1", Minor: "29"}, corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "config-init"}},
+		Containers:     []corev1.Container{{Name: "vmagent"}, {Name: "log-shipper", RestartPolicy: always}},
+	}, corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "config-init"}, {Name: "log-shipper", RestartPolicy: always}},
+		Containers:     []corev1.Container{{Name: "vmagent"}},
+	})
+
+	// sidecar init container is kept
+	f(version.Info{Major: "1", Minor: "30"}, corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "proxy", RestartPolicy: always}},
+		Containers:     []corev1.Container{{Name: "vmsingle"}},
+	}, corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "proxy", RestartPolicy: always}},
+		Containers:     []corev1.Container{{Name: "vmsingle"}},
+	})
+
+	// not supported, sidecars fall back to regular containers
+	f(version.Info{Major: "1", Minor: "28"}, corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "config-init"}, {Name: "proxy", RestartPolicy: always}},
+		Containers:     []corev1.Container{{Name: "vmagent"}, {Name: "log-shipper", RestartPolicy: always}},
+	}, corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "config-init"}},
+		Containers:     []corev1.Container{{Name: "vmagent"}, {Name: "log-shipper"}, {Name: "proxy"}},
+	})
+}
+
+func TestAppendArgsForCardinalityLimits(t *testing.T) {
+	f := func(limits *vmv1beta1.CardinalityLimits, prefix string, want []string) {
+		t.Helper()
+		got := AppendArgsForCardinalityLimits([]string{"-httpListenAddr=:8429"}, limits, prefix)
+		assert.Equal(t, want, got)
+	}
+	f(nil, "storage", []string{"-httpListenAddr=:8429"})
+	f(&vmv1beta1.CardinalityLimits{MaxDailySeries: 100}, "storage", []string{"-httpListenAddr=:8429", "-storage.maxDailySeries=100"})
+	f(&vmv1beta1.CardinalityLimits{MaxHourlySeries: 10, MaxDailySeries: 100}, "remoteWrite", []string{"-httpListenAddr=:8429", "-remoteWrite.maxHourlySeries=10", "-remoteWrite.maxDailySeries=100"})
+}
@@ -7,6 +7,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
@@ -28,6 +29,9 @@ func AddDefaults(scheme *runtime.Scheme) {
 	scheme.AddTypeDefaultingFunc(&vmv1beta1.VMAlertmanager{}, addVMAlertmanagerDefaults)
 	scheme.AddTypeDefaultingFunc(&vmv1beta1.VMCluster{}, addVMClusterDefaults)
 	scheme.AddTypeDefaultingFunc(&vmv1beta1.VLogs{}, addVlogsDefaults)
+	scheme.AddTypeDefaultingFunc(&vmv1beta1.VMRuleTest{}, addVMRuleTestDefaults)
+	scheme.AddTypeDefaultingFunc(&vmv1beta1.VMMaintenanceTask{}, addVMMaintenanceTaskDefaults)
+	scheme.AddTypeDefaultingFunc(&vmv1beta1.VMDataMigration{}, addVMDataMigrationDefaults)
 
 }
 
@@ -197,15 +201,103 @@ func addVMAlertDefaults(objI interface{}) {
 	}
 }
 
+func addVMRuleTestDefaults(objI interface{}) {
+	cr := objI.(*vmv1beta1.VMRuleTest)
+	c := getCfg()
+
+	if cr.Spec.Image.Repository == "" {
+		cr.Spec.Image.Repository = c.VMRuleTestDefault.Image
+	}
+	cr.Spec.Image.Repository = FormatContainerImage(c.ContainerRegistry, cr.Spec.Image.Repository)
+	if cr.Spec.Image.Tag == "" {
+		cr.Spec.Image.Tag = c.VMRuleTestDefault.Version
+	}
+	if cr.Spec.Image.PullPolicy == "" {
+		cr.Spec.Image.PullPolicy = corev1.PullIfNotPresent
+	}
+	cr.Spec.Resources = Resources(cr.Spec.Resources, config.Resource(c.VMRuleTestDefault.Resource), c.VMRuleTestDefault.UseDefaultResources)
+}
+
+func addVMMaintenanceTaskDefaults(objI interface{}) {
+	cr := objI.(*vmv1beta1.VMMaintenanceTask)
+	c := getCfg()
+
+	if cr.Spec.Image.Repository == "" {
+		cr.Spec.Image.Repository = c.VMMaintenanceTaskDefault.Image
+	}
+	cr.Spec.Image.Repository = FormatContainerImage(c.ContainerRegistry, cr.Spec.Image.Repository)
+	if cr.Spec.Image.Tag == "" {
+		cr.Spec.Image.Tag = c.VMMaintenanceTaskDefault.Version
+	}
+	if cr.Spec.Image.PullPolicy == "" {
+		cr.Spec.Image.PullPolicy = corev1.PullIfNotPresent
+	}
+	cr.Spec.Resources = Resources(cr.Spec.Resources, config.Resource(c.VMMaintenanceTaskDefault.Resource), c.VMMaintenanceTaskDefault.UseDefaultResources)
+}
+
+func addVMDataMigrationDefaults(objI interface{}) {
+	cr := objI.(*vmv1beta1.VMDataMigration)
+	c := getCfg()
+
+	if cr.Spec.Image.Repository == "" {
+		cr.Spec.Image.Repository = c.VMDataMigrationDefault.Image
+	}
+	cr.Spec.Image.Repository = FormatContainerImage(c.ContainerRegistry, cr.Spec.Image.Repository)
+	if cr.Spec.Image.Tag == "" {
+		cr.Spec.Image.Tag = c.VMDataMigrationDefault.Version
+	}
+	if cr.Spec.Image.PullPolicy == "" {
+		cr.Spec.Image.PullPolicy = corev1.PullIfNotPresent
+	}
+	if cr.Spec.BackoffLimit == nil {
+		cr.Spec.BackoffLimit = ptr.To[int32](3)
+	}
+	if th := cr.Spec.Source.Thanos; th != nil && th.StepInterval == "" {
+		th.StepInterval = "day"
+	}
+	cr.Spec.Resources = Resources(cr.Spec.Resources, config.Resource(c.VMDataMigrationDefault.Resource), c.VMDataMigrationDefault.UseDefaultResources)
+}
+
 func addVMAgentDefaults(objI interface{}) {
 	cr := objI.(*vmv1beta1.VMAgent)
 	c := getCfg()
 
 	cv := config.ApplicationDefaults(c.VMAgentDefault)
+	memIsSet := hasMemoryResource(cr.Spec.Resources)
 	addDefaultsToCommonParams(&cr.Spec.CommonDefaultableParams, &cv)
+	if !memIsSet {
+		addVMAgentThroughputMemory(&cr.Spec.Resources, cr.RemoteWriteThroughputHint())
+	}
 	addDefaluesToConfigReloader(&cr.Spec.CommonConfigReloaderParams, ptr.Deref(cr.Spec.UseDefaultResources, false), &cv)
 }
 
+const (
+	// vmAgentBaseMemory is memory required by vmagent without load
+	vmAgentBaseMemory = 256 << 20
+	// vmAgentMemoryPerSample is memory required by vmagent for each sample per second sent to remote storages
+	vmAgentMemoryPerSample = 1 << 10
+)
+
+// addVMAgentThroughputMemory raises default memory limit of vmagent
+// up to the value required for the given throughput hint in samples per second
+func addVMAgentThroughputMemory(resources *corev1.ResourceRequirements, throughputHint int64) {
+	if throughputHint <= 0 {
+		return
+	}
+	want := resource.NewQuantity(vmAgentBaseMemory+throughputHint*vmAgentMemoryPerSample, resource.BinarySI)
+	if current, ok := resources.Limits[corev1.ResourceMemory]; ok && current.Cmp(*want) < 0 {
+		resources.Limits[corev1.ResourceMemory] = *want
+	}
+}
+
+func hasMemoryResource(resources corev1.ResourceRequirements) bool {
+	if _, ok := resources.Limits[corev1.ResourceMemory]; ok {
+		return true
+	}
+	_, ok := resources.Requests[corev1.ResourceMemory]
+	return ok
+}
+
 func addVMSingleDefaults(objI interface{}) {
 	cr := objI.(*vmv1beta1.VMSingle)
 	c := getCfg()
@@ -540,6 +632,19 @@ func addDefaultsToVMBackup(cr *vmv1beta1.VMBackup, useDefaultResources bool, app
 	if cr.Image.PullPolicy == "" {
 		cr.Image.PullPolicy = corev1.PullIfNotPresent
 	}
+	if cr.IsRestoreFromLatestBackup() {
+		img := &cr.Restore.OnStart.Image
+		if img.Repository == "" {
+			img.Repository = c.VMBackup.RestoreImage
+		}
+		img.Repository = FormatContainerImage(c.ContainerRegistry, img.Repository)
+		if img.Tag == "" {
+			img.Tag = cr.Image.Tag
+		}
+		if img.PullPolicy == "" {
+			img.PullPolicy = corev1.PullIfNotPresent
+		}
+	}
 
 	cr.Resources = Resources(cr.Resources, config.Resource(appDefaults.Resource), useDefaultResources)
 
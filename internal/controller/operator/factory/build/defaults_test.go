@@ -0,0 +1,47 @@
+package build
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+func TestAddVMAgentDefaultsThroughputMemory(t *testing.T) {
+	f := func(resources corev1.ResourceRequirements, throughputHint *int64, wantLimit string) {
+		t.Helper()
+		cr := &vmv1beta1.VMAgent{
+			Spec: vmv1beta1.VMAgentSpec{
+				CommonDefaultableParams: vmv1beta1.CommonDefaultableParams{
+					UseDefaultResources: ptr.To(true),
+					Resources:           resources,
+				},
+				RemoteWrite: []vmv1beta1.VMAgentRemoteWriteSpec{
+					{URL: "http://vminsert-1:8480", ThroughputHint: throughputHint},
+					{URL: "http://vminsert-2:8480", ThroughputHint: throughputHint},
+				},
+			},
+		}
+		addVMAgentDefaults(cr)
+		got := cr.Spec.Resources.Limits[corev1.ResourceMemory]
+		assert.Equal(t, wantLimit, got.String())
+	}
+
+	// default limit without throughputHint
+	f(corev1.ResourceRequirements{}, nil, "500Mi")
+
+	// default limit is enough for throughputHint
+	f(corev1.ResourceRequirements{}, ptr.To[int64](10_000), "500Mi")
+
+	// default limit is raised for throughputHint
+	f(corev1.ResourceRequirements{}, ptr.To[int64](500_000), "1262144Ki")
+
+	// explicit memory limit has priority over throughputHint
+	f(corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("300Mi")},
+	}, ptr.To[int64](500_000), "300Mi")
+}
@@ -8,7 +8,7 @@ import (
 
 // DeploymentAddCommonParams adds common params for all deployments
 func DeploymentAddCommonParams(dst *appsv1.Deployment, useStrictSecurity bool, params *vmv1beta1.CommonApplicationDeploymentParams) {
-	dst.Spec.Template.Spec.Affinity = params.Affinity
+	dst.Spec.Template.Spec.Affinity = AffinityWithDefaults(params.Affinity, params.DefaultAffinitySettings, dst.Spec.Selector)
 	dst.Spec.Template.Spec.Tolerations = params.Tolerations
 	dst.Spec.Template.Spec.SchedulerName = params.SchedulerName
 	dst.Spec.Template.Spec.RuntimeClassName = params.RuntimeClassName
@@ -27,6 +27,7 @@ func DeploymentAddCommonParams(dst *appsv1.Deployment, useStrictSecurity bool, p
 	dst.Spec.Template.Spec.ImagePullSecrets = params.ImagePullSecrets
 	dst.Spec.Template.Spec.TerminationGracePeriodSeconds = params.TerminationGracePeriodSeconds
 	dst.Spec.Template.Spec.ReadinessGates = params.ReadinessGates
+	classifyNativeSidecars(&dst.Spec.Template.Spec)
 	dst.Spec.MinReadySeconds = params.MinReadySeconds
 	dst.Spec.Replicas = params.ReplicaCount
 	dst.Spec.RevisionHistoryLimit = params.RevisionHistoryLimitCount
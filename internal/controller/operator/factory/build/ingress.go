@@ -0,0 +1,65 @@
+package build
+
+import (
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+)
+
+var defaultIngressPathType = networkingv1.PathTypePrefix
+
+// Ingress builds ingress for the given service with http port from ingress configuration
+// objMeta labels are used as default labels of ingress
+func Ingress(objMeta metav1.ObjectMeta, serviceName string, spec *vmv1beta1.EmbeddedIngress) *networkingv1.Ingress {
+	defaultRule := networkingv1.IngressRule{
+		Host: spec.Host,
+		IngressRuleValue: networkingv1.IngressRuleValue{
+			HTTP: &networkingv1.HTTPIngressRuleValue{
+				Paths: []networkingv1.HTTPIngressPath{
+					{
+						Path: "/",
+						Backend: networkingv1.IngressBackend{
+							Service: &networkingv1.IngressServiceBackend{
+								Name: serviceName,
+								Port: networkingv1.ServiceBackendPort{Name: "http"},
+							},
+						},
+						PathType: &defaultIngressPathType,
+					},
+				},
+			},
+		},
+	}
+	ingressSpec := networkingv1.IngressSpec{
+		Rules:            []networkingv1.IngressRule{},
+		IngressClassName: spec.ClassName,
+	}
+	if spec.TlsSecretName != "" {
+		ingressSpec.TLS = []networkingv1.IngressTLS{
+			{
+				SecretName: spec.TlsSecretName,
+				Hosts:      spec.TlsHosts,
+			},
+		}
+		for _, host := range spec.TlsHosts {
+			hostRule := defaultRule.DeepCopy()
+			hostRule.Host = host
+			ingressSpec.Rules = append(ingressSpec.Rules, *hostRule)
+		}
+	} else {
+		ingressSpec.Rules = append(ingressSpec.Rules, defaultRule)
+	}
+	// add user defined routes.
+	ingressSpec.Rules = append(ingressSpec.Rules, spec.ExtraRules...)
+	ingressSpec.TLS = append(ingressSpec.TLS, spec.ExtraTLS...)
+	objMeta.Labels = labels.Merge(spec.Labels, objMeta.Labels)
+	objMeta.Annotations = spec.Annotations
+	objMeta.Finalizers = finalize.ChildFinalizers()
+	return &networkingv1.Ingress{
+		ObjectMeta: objMeta,
+		Spec:       ingressSpec,
+	}
+}
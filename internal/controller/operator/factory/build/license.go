@@ -0,0 +1,49 @@
+package build
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+// LicenseChecksumAnnotation holds checksum of license key stored at secret
+const LicenseChecksumAnnotation = "operator.victoriametrics.com/license-checksum"
+
+// AddLicenseChecksumAnnotation adds checksum of license key referenced by keyRef
+// into pod template annotations of given Deployment or StatefulSet.
+// It triggers rolling update of pods, when license key is rotated at secret.
+func AddLicenseChecksumAnnotation(ctx context.Context, rclient client.Client, ns string, l *vmv1beta1.License, obj runtime.Object) error {
+	if l == nil || l.KeyRef == nil {
+		return nil
+	}
+	var tmpl *corev1.PodTemplateSpec
+	switch obj := obj.(type) {
+	case *appsv1.Deployment:
+		tmpl = &obj.Spec.Template
+	case *appsv1.StatefulSet:
+		tmpl = &obj.Spec.Template
+	default:
+		return fmt.Errorf("BUG: unexpected type of object=%T", obj)
+	}
+	var s corev1.Secret
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: ns, Name: l.KeyRef.Name}, &s); err != nil {
+		return fmt.Errorf("cannot fetch license secret=%q: %w", l.KeyRef.Name, err)
+	}
+	key, ok := s.Data[l.KeyRef.Key]
+	if !ok {
+		return fmt.Errorf("license key=%q is missing at secret=%q", l.KeyRef.Key, l.KeyRef.Name)
+	}
+	if tmpl.Annotations == nil {
+		tmpl.Annotations = make(map[string]string)
+	}
+	tmpl.Annotations[LicenseChecksumAnnotation] = fmt.Sprintf("%x", sha256.Sum256(key))
+	return nil
+}
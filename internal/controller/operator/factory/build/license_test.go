@@ -0,0 +1,56 @@
+package build
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestAddLicenseChecksumAnnotation(t *testing.T) {
+	f := func(l *vmv1beta1.License, licenseKey string, wantChecksum string, wantErr bool) {
+		t.Helper()
+		var predefinedObjects []runtime.Object
+		if licenseKey != "" {
+			predefinedObjects = append(predefinedObjects, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "vm-license", Namespace: "default"},
+				Data:       map[string][]byte{"key": []byte(licenseKey)},
+			})
+		}
+		rclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		sts := &appsv1.StatefulSet{}
+		err := AddLicenseChecksumAnnotation(context.Background(), rclient, "default", l, sts)
+		if wantErr {
+			assert.Error(t, err)
+			return
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, wantChecksum, sts.Spec.Template.Annotations[LicenseChecksumAnnotation])
+	}
+	keyRef := &vmv1beta1.License{
+		KeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "vm-license"},
+			Key:                  "key",
+		},
+	}
+
+	// no license
+	f(nil, "", "", false)
+
+	// inline license key is passed with container args
+	f(&vmv1beta1.License{Key: ptr.To("license-value")}, "", "", false)
+
+	// license key at secret
+	f(keyRef, "license-value", "b435719e55152ea6aae82047e367536caff935479d1a13ed7861eaf6d096bc70", false)
+
+	// missing secret
+	f(keyRef, "", "", true)
+}
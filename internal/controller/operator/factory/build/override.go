@@ -0,0 +1,76 @@
+package build
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+// ApplyOverridePatches applies user defined patches to the given Deployment or StatefulSet.
+// Patches with mismatched kind or name are ignored.
+// It must be called as the last step of object build.
+// Previous object built from the last applied spec must be patched with its own patches as well, since it's compared with the new object.
+func ApplyOverridePatches(patches []vmv1beta1.OverridePatch, obj runtime.Object) error {
+	if len(patches) == 0 {
+		return nil
+	}
+	var kind, name string
+	var dataStruct any
+	switch obj := obj.(type) {
+	case *appsv1.Deployment:
+		kind, name, dataStruct = "Deployment", obj.Name, appsv1.Deployment{}
+	case *appsv1.StatefulSet:
+		kind, name, dataStruct = "StatefulSet", obj.Name, appsv1.StatefulSet{}
+	default:
+		return fmt.Errorf("BUG: unexpected type of object=%T", obj)
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("cannot marshal %s=%s: %w", kind, name, err)
+	}
+	var patched bool
+	for i := range patches {
+		p := &patches[i]
+		if p.Kind != kind || (p.Name != "" && p.Name != name) {
+			continue
+		}
+		patch, err := p.PatchJSON()
+		if err != nil {
+			return fmt.Errorf("incorrect overridePatches[%d]: %w", i, err)
+		}
+		switch p.Type {
+		case vmv1beta1.OverridePatchJSON:
+			var jp jsonpatch.Patch
+			jp, err = jsonpatch.DecodePatch(patch)
+			if err == nil {
+				data, err = jp.Apply(data)
+			}
+		default:
+			data, err = strategicpatch.StrategicMergePatch(data, patch, dataStruct)
+		}
+		if err != nil {
+			return fmt.Errorf("cannot apply overridePatches[%d] to %s=%s: %w", i, kind, name, err)
+		}
+		patched = true
+	}
+	if !patched {
+		return nil
+	}
+	// reset object, since json.Unmarshal keeps fields missing at patched data
+	switch obj := obj.(type) {
+	case *appsv1.Deployment:
+		*obj = appsv1.Deployment{}
+	case *appsv1.StatefulSet:
+		*obj = appsv1.StatefulSet{}
+	}
+	if err := json.Unmarshal(data, obj); err != nil {
+		return fmt.Errorf("cannot unmarshal patched %s=%s: %w", kind, name, err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package build
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+func TestApplyOverridePatches(t *testing.T) {
+	f := func(patches []vmv1beta1.OverridePatch, obj, want runtime.Object, wantErr bool) {
+		t.Helper()
+		err := ApplyOverridePatches(patches, obj)
+		if wantErr {
+			assert.Error(t, err)
+			return
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, want, obj)
+	}
+	newSts := func() *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "vmstorage-main", Namespace: "default"},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: ptr.To[int32](2),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{Name: "vmstorage", Image: "victoriametrics/vmstorage"},
+							{Name: "sidecar", Image: "busybox"},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	// no patches
+	f(nil, newSts(), newSts(), false)
+
+	// strategic merge patch of container by name
+	want := newSts()
+	want.Spec.Template.Spec.Containers[0].Lifecycle = &corev1.Lifecycle{
+		PreStop: &corev1.LifecycleHandler{Exec: &corev1.ExecAction{Command: []string{"sleep", "5"}}},
+	}
+	want.Spec.Template.Spec.SecurityContext = &corev1.PodSecurityContext{
+		Sysctls: []corev1.Sysctl{{Name: "net.core.somaxconn", Value: "1024"}},
+	}
+	f([]vmv1beta1.OverridePatch{{Kind: "StatefulSet", Patch: `
+spec:
+  template:
+    spec:
+      securityContext:
+        sysctls:
+        - name: net.core.somaxconn
+          value: "1024"
+      containers:
+      - name: vmstorage
+        lifecycle:
+          preStop:
+            exec:
+              command: ["sleep", "5"]
+`}}, newSts(), want, false)
+
+	// json patch removes field
+	want = newSts()
+	want.Spec.Template.Spec.Containers = want.Spec.Template.Spec.Containers[:1]
+	f([]vmv1beta1.OverridePatch{{Kind: "StatefulSet", Type: vmv1beta1.OverridePatchJSON, Patch: `[{"op": "remove", "path": "/spec/template/spec/containers/1"}]`}}, newSts(), want, false)
+
+	// patches for other kind and name are ignored
+	f([]vmv1beta1.OverridePatch{
+		{Kind: "Deployment", Patch: `{"spec": {"replicas": 5}}`},
+		{Kind: "StatefulSet", Name: "vmselect-main", Patch: `{"spec": {"replicas": 5}}`},
+	}, newSts(), newSts(), false)
+
+	// patches are applied in order
+	want = newSts()
+	want.Spec.Replicas = ptr.To[int32](4)
+	f([]vmv1beta1.OverridePatch{
+		{Kind: "StatefulSet", Name: "vmstorage-main", Patch: `{"spec": {"replicas": 3}}`},
+		{Kind: "StatefulSet", Type: vmv1beta1.OverridePatchJSON, Patch: `[{"op": "replace", "path": "/spec/replicas", "value": 4}]`},
+	}, newSts(), want, false)
+
+	// json patch for missing path
+	f([]vmv1beta1.OverridePatch{{Kind: "StatefulSet", Type: vmv1beta1.OverridePatchJSON, Patch: `[{"op": "remove", "path": "/spec/template/spec/volumes/0"}]`}}, newSts(), nil, true)
+}
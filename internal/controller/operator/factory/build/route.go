@@ -0,0 +1,46 @@
+package build
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+// Route builds edge terminated OpenShift route for the given service with http port from ingress configuration
+// only the first host is used, since route serves a single host
+func Route(objMeta metav1.ObjectMeta, serviceName string, spec *vmv1beta1.EmbeddedIngress) *unstructured.Unstructured {
+	routeSpec := map[string]any{
+		"to": map[string]any{
+			"kind": "Service",
+			"name": serviceName,
+		},
+		"port": map[string]any{
+			"targetPort": "http",
+		},
+		"path": "/",
+	}
+	host := spec.Host
+	if len(spec.TlsHosts) > 0 {
+		host = spec.TlsHosts[0]
+	}
+	if host != "" {
+		routeSpec["host"] = host
+	}
+	if spec.TlsSecretName != "" || len(spec.TlsHosts) > 0 {
+		routeSpec["tls"] = map[string]any{
+			"termination":                   "edge",
+			"insecureEdgeTerminationPolicy": "Redirect",
+		}
+	}
+	route := &unstructured.Unstructured{Object: map[string]any{"spec": routeSpec}}
+	route.SetGroupVersionKind(k8stools.RouteGVK)
+	route.SetName(objMeta.Name)
+	route.SetNamespace(objMeta.Namespace)
+	route.SetLabels(labels.Merge(spec.Labels, objMeta.Labels))
+	route.SetAnnotations(spec.Annotations)
+	route.SetOwnerReferences(objMeta.OwnerReferences)
+	return route
+}
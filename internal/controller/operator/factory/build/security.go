@@ -34,6 +34,13 @@ var (
 			},
 		},
 	}
+	// OpenShift assigns user and group ids from the namespace range
+	openShiftSecurityContext = func() *corev1.SecurityContext {
+		sc := defaultSecurityContext.DeepCopy()
+		sc.RunAsUser = nil
+		sc.RunAsGroup = nil
+		return sc
+	}()
 	defaultPodSecurityContext = &corev1.PodSecurityContext{
 		RunAsNonRoot: &runNonRoot,
 		RunAsUser:    &containerUserGroup,
@@ -61,6 +68,9 @@ func AddStrictSecuritySettingsToContainers(p *vmv1beta1.SecurityContext, contain
 
 func containerSecurityContext(p *vmv1beta1.SecurityContext) *corev1.SecurityContext {
 	if p == nil {
+		if k8stools.IsOpenShift() {
+			return openShiftSecurityContext
+		}
 		return defaultSecurityContext
 	}
 	var sc corev1.SecurityContext
@@ -91,6 +101,14 @@ func AddStrictSecuritySettingsToPod(p *vmv1beta1.SecurityContext, enableStrictSe
 		return nil
 	}
 	securityContext := defaultPodSecurityContext.DeepCopy()
+	if k8stools.IsOpenShift() {
+		// restricted SCC assigns user, group and fsGroup from the namespace range
+		// and rejects pods with values outside of it
+		securityContext.RunAsUser = nil
+		securityContext.RunAsGroup = nil
+		securityContext.FSGroup = nil
+		return securityContext
+	}
 	if k8stools.IsFSGroupChangePolicySupported() {
 		onRootMismatch := corev1.FSGroupChangeOnRootMismatch
 		securityContext.FSGroupChangePolicy = &onRootMismatch
@@ -66,6 +66,21 @@ func TestAddStrictSecuritySettingsToPod(t *testing.T) {
 				kubeletVersion: version.Info{Major: "1", Minor: "27"},
 			},
 		},
+		{
+			name: "use custom security with sysctls",
+			args: args{
+				podSecurityPolicy: &vmv1beta1.SecurityContext{
+					PodSecurityContext: &corev1.PodSecurityContext{
+						Sysctls: []corev1.Sysctl{{Name: "net.ipv4.tcp_keepalive_time", Value: "600"}},
+					},
+				},
+				enableStrictSecurity: false,
+				exp: &corev1.PodSecurityContext{
+					Sysctls: []corev1.Sysctl{{Name: "net.ipv4.tcp_keepalive_time", Value: "600"}},
+				},
+				kubeletVersion: version.Info{Major: "1", Minor: "27"},
+			},
+		},
 	}
 	for _, tt := range tests {
 		if err := k8stools.SetKubernetesVersionWithDefaults(&tt.args.kubeletVersion, 0, 0); err != nil {
@@ -268,3 +283,29 @@ func TestAddStrictSecuritySettingsToContainers(t *testing.T) {
 		})
 	}
 }
+
+func TestStrictSecuritySettingsOpenShift(t *testing.T) {
+	if err := k8stools.SetPlatform(k8stools.PlatformOpenShift, "", nil); err != nil {
+		t.Fatalf("cannot set platform: %s", err)
+	}
+	defer func() {
+		if err := k8stools.SetPlatform(k8stools.PlatformKubernetes, "", nil); err != nil {
+			t.Fatalf("cannot restore platform: %s", err)
+		}
+	}()
+	assert.Equal(t, &corev1.PodSecurityContext{
+		RunAsNonRoot: ptr.To(true),
+		SeccompProfile: &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		},
+	}, AddStrictSecuritySettingsToPod(nil, true))
+
+	containers := AddStrictSecuritySettingsToContainers(nil, []corev1.Container{{Name: "vmagent"}}, true)
+	sc := containers[0].SecurityContext
+	assert.Nil(t, sc.RunAsUser)
+	assert.Nil(t, sc.RunAsGroup)
+	assert.Equal(t, ptr.To(true), sc.RunAsNonRoot)
+	assert.Equal(t, ptr.To(false), sc.AllowPrivilegeEscalation)
+	// defaults must not be modified
+	assert.Equal(t, ptr.To(int64(65534)), defaultSecurityContext.RunAsUser)
+}
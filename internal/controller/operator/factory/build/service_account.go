@@ -1,7 +1,10 @@
 package build
 
 import (
+	"strings"
+
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -12,20 +15,56 @@ type objectForServiceAccountBuilder interface {
 	AsOwner() []metav1.OwnerReference
 	GetNSName() string
 	GetServiceAccountName() string
+	GetServiceAccountImagePullSecrets() []v1.LocalObjectReference
 	IsOwnsServiceAccount() bool
 	PrefixedName() string
 }
 
 // ServiceAccount builds service account for CRD
 func ServiceAccount(cr objectForServiceAccountBuilder) *v1.ServiceAccount {
-	return &v1.ServiceAccount{
+	sa := &v1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            cr.GetServiceAccountName(),
 			Namespace:       cr.GetNSName(),
 			Labels:          cr.AllLabels(),
 			Annotations:     cr.AnnotationsFiltered(),
 			OwnerReferences: cr.AsOwner(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
+		ImagePullSecrets: serviceAccountImagePullSecrets(cr),
+	}
+	if len(sa.ImagePullSecrets) > 0 {
+		names := make([]string, 0, len(sa.ImagePullSecrets))
+		for _, ref := range sa.ImagePullSecrets {
+			names = append(names, ref.Name)
+		}
+		if sa.Annotations == nil {
+			sa.Annotations = make(map[string]string, 1)
+		}
+		sa.Annotations[vmv1beta1.ManagedImagePullSecretsAnnotation] = strings.Join(names, ",")
+	}
+	return sa
+}
+
+// serviceAccountImagePullSecrets merges global operator image pull secrets with secrets defined at CR
+func serviceAccountImagePullSecrets(cr objectForServiceAccountBuilder) []v1.LocalObjectReference {
+	var secrets []v1.LocalObjectReference
+	seen := make(map[string]struct{})
+	add := func(name string) {
+		if name == "" {
+			return
+		}
+		if _, ok := seen[name]; ok {
+			return
+		}
+		seen[name] = struct{}{}
+		secrets = append(secrets, v1.LocalObjectReference{Name: name})
+	}
+	for _, name := range getCfg().ServiceAccountImagePullSecrets {
+		add(name)
+	}
+	for _, ref := range cr.GetServiceAccountImagePullSecrets() {
+		add(ref.Name)
 	}
+	return secrets
 }
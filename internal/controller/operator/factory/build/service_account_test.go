@@ -1,3 +1,43 @@
 package build
 
-// TODO create test for ServiceAccount
+import (
+	"testing"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/go-test/deep"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestServiceAccount(t *testing.T) {
+	cfg := getCfg()
+	defer func(secrets []string) {
+		cfg.ServiceAccountImagePullSecrets = secrets
+	}(cfg.ServiceAccountImagePullSecrets)
+
+	f := func(globalSecrets []string, crSecrets []corev1.LocalObjectReference, want []corev1.LocalObjectReference, wantAnnotation string) {
+		t.Helper()
+		cfg.ServiceAccountImagePullSecrets = globalSecrets
+		cr := &vmv1beta1.VMSingle{
+			ObjectMeta: metav1.ObjectMeta{Name: "single", Namespace: "default"},
+			Spec:       vmv1beta1.VMSingleSpec{ServiceAccountImagePullSecrets: crSecrets},
+		}
+		sa := ServiceAccount(cr)
+		if diff := deep.Equal(sa.ImagePullSecrets, want); len(diff) > 0 {
+			t.Fatalf("unexpected imagePullSecrets: %v", diff)
+		}
+		if got := sa.Annotations[vmv1beta1.ManagedImagePullSecretsAnnotation]; got != wantAnnotation {
+			t.Fatalf("unexpected managed secrets annotation, got: %q, want: %q", got, wantAnnotation)
+		}
+	}
+
+	// no secrets
+	f(nil, nil, nil, "")
+
+	// global secrets only
+	f([]string{"registry-creds"}, nil, []corev1.LocalObjectReference{{Name: "registry-creds"}}, "registry-creds")
+
+	// global and object secrets without duplicates
+	f([]string{"registry-creds", ""}, []corev1.LocalObjectReference{{Name: "team-creds"}, {Name: "registry-creds"}},
+		[]corev1.LocalObjectReference{{Name: "registry-creds"}, {Name: "team-creds"}}, "registry-creds,team-creds")
+}
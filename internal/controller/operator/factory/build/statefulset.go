@@ -8,7 +8,7 @@ import (
 
 // StatefulSetAddCommonParams adds common params to given statefulset
 func StatefulSetAddCommonParams(dst *appsv1.StatefulSet, useStrictSecurity bool, params *vmv1beta1.CommonApplicationDeploymentParams) {
-	dst.Spec.Template.Spec.Affinity = params.Affinity
+	dst.Spec.Template.Spec.Affinity = AffinityWithDefaults(params.Affinity, params.DefaultAffinitySettings, dst.Spec.Selector)
 	dst.Spec.Template.Spec.Tolerations = params.Tolerations
 	dst.Spec.Template.Spec.SchedulerName = params.SchedulerName
 	dst.Spec.Template.Spec.RuntimeClassName = params.RuntimeClassName
@@ -27,8 +27,23 @@ func StatefulSetAddCommonParams(dst *appsv1.StatefulSet, useStrictSecurity bool,
 	dst.Spec.Template.Spec.ImagePullSecrets = params.ImagePullSecrets
 	dst.Spec.Template.Spec.TerminationGracePeriodSeconds = params.TerminationGracePeriodSeconds
 	dst.Spec.Template.Spec.ReadinessGates = params.ReadinessGates
+	classifyNativeSidecars(&dst.Spec.Template.Spec)
 	dst.Spec.MinReadySeconds = params.MinReadySeconds
 	dst.Spec.Replicas = params.ReplicaCount
 	dst.Spec.RevisionHistoryLimit = params.RevisionHistoryLimitCount
+}
 
+// StatefulSetUpdateStrategy builds update strategy for statefulset
+// legacy strategy type is used if it isn't defined by the given strategy
+// partition of partition-based rollout is managed at reconcile
+func StatefulSetUpdateStrategy(s *vmv1beta1.StatefulSetUpdateStrategy, legacy appsv1.StatefulSetUpdateStrategyType) appsv1.StatefulSetUpdateStrategy {
+	us := appsv1.StatefulSetUpdateStrategy{
+		Type: s.StrategyType(legacy),
+	}
+	if s != nil && us.Type == appsv1.RollingUpdateStatefulSetStrategyType && s.MaxUnavailable != nil {
+		us.RollingUpdate = &appsv1.RollingUpdateStatefulSetStrategy{
+			MaxUnavailable: s.MaxUnavailable,
+		}
+	}
+	return us
 }
@@ -0,0 +1,168 @@
+package build
+
+import (
+	"sort"
+	"strings"
+
+	version "github.com/hashicorp/go-version"
+)
+
+// versionedFlags describes command-line flags changes introduced at the given component release
+type versionedFlags struct {
+	// since defines the first release of component with flags changes
+	since *version.Version
+	// defaults contains flags with values, which must be set for images since release
+	defaults map[string]string
+	// renamed maps flag name used by images prior to release into the current flag name
+	renamed map[string]string
+	// unsupported contains flags, which are not known by images prior to release
+	unsupported []string
+}
+
+// componentFlagsByVersion contains known flags changes for components managed by operator.
+// Operator generates flags for the latest supported release,
+// older releases get renamed flags and unsupported flags removed.
+//
+// entries must be sorted by since version in ascending order.
+var componentFlagsByVersion = map[string][]versionedFlags{
+	"vmagent": {
+		{
+			since:       version.Must(version.NewVersion("v1.96.0")),
+			unsupported: []string{"enableMultitenantHandlers"},
+		},
+		{
+			since: version.Must(version.NewVersion("v1.100.0")),
+			unsupported: []string{
+				"streamAggr.config",
+				"streamAggr.keepInput",
+				"streamAggr.dropInput",
+				"streamAggr.dedupInterval",
+				"streamAggr.dropInputLabels",
+				"streamAggr.ignoreOldSamples",
+			},
+		},
+	},
+	"vmalertmanager": {
+		{
+			since: version.Must(version.NewVersion("v0.15.0")),
+			renamed: map[string]string{
+				"mesh.listen-address": "cluster.listen-address",
+				"mesh.peer":           "cluster.peer",
+			},
+			unsupported: []string{"cluster.advertise-address"},
+		},
+		{
+			since:       version.Must(version.NewVersion("v0.24.0")),
+			unsupported: []string{"cluster.tls-config"},
+		},
+	},
+}
+
+// ParseImageVersion returns core version of the given image tag
+// it ignores tag suffixes, like -cluster or -enterprise and image digest.
+// Returns nil, if tag cannot be parsed as a semantic version
+func ParseImageVersion(tag string) *version.Version {
+	if idx := strings.IndexByte(tag, '@'); idx >= 0 {
+		tag = tag[:idx]
+	}
+	v, err := version.NewVersion(tag)
+	if err != nil {
+		return nil
+	}
+	return v.Core()
+}
+
+// IsImageVersionKnown checks if operator is able to parse version of the given image tag
+// and adjust generated flags for it.
+func IsImageVersionKnown(tag string) bool {
+	return ParseImageVersion(tag) != nil
+}
+
+// AddVersionedArgs adjusts args for the given component according to image tag version
+// It adds defaults required by release, renames and removes flags for older releases.
+// Args are returned without changes, if image version is unknown.
+// dashes is either "-" or "--", depending on the process.
+func AddVersionedArgs(component, imageTag string, args []string, dashes string) []string {
+	return addVersionedArgs(componentFlagsByVersion[component], imageTag, args, dashes)
+}
+
+func addVersionedArgs(changes []versionedFlags, imageTag string, args []string, dashes string) []string {
+	if len(changes) == 0 {
+		return args
+	}
+	imageVersion := ParseImageVersion(imageTag)
+	if imageVersion == nil {
+		return args
+	}
+	for _, change := range changes {
+		if imageVersion.LessThan(change.since) {
+			args = renameAndRemoveArgs(args, change, dashes)
+			continue
+		}
+		args = addMissingArgs(args, change.defaults, dashes)
+	}
+	return args
+}
+
+func splitArg(arg string) (string, string, bool) {
+	arg = strings.TrimLeft(arg, "-")
+	name, value, hasValue := strings.Cut(arg, "=")
+	return name, value, hasValue
+}
+
+func renameAndRemoveArgs(args []string, change versionedFlags, dashes string) []string {
+	if len(change.renamed) == 0 && len(change.unsupported) == 0 {
+		return args
+	}
+	legacyNames := make(map[string]string, len(change.renamed))
+	for legacyName, currentName := range change.renamed {
+		legacyNames[currentName] = legacyName
+	}
+	unsupported := make(map[string]struct{}, len(change.unsupported))
+	for _, name := range change.unsupported {
+		unsupported[name] = struct{}{}
+	}
+	var cnt int
+	for _, arg := range args {
+		name, value, hasValue := splitArg(arg)
+		if _, ok := unsupported[name]; ok {
+			continue
+		}
+		if legacyName, ok := legacyNames[name]; ok {
+			arg = dashes + legacyName
+			if hasValue {
+				arg += "=" + value
+			}
+		}
+		args[cnt] = arg
+		cnt++
+	}
+	return args[:cnt]
+}
+
+func addMissingArgs(args []string, defaults map[string]string, dashes string) []string {
+	if len(defaults) == 0 {
+		return args
+	}
+	exist := make(map[string]struct{}, len(args))
+	for _, arg := range args {
+		name, _, _ := splitArg(arg)
+		exist[name] = struct{}{}
+	}
+	names := make([]string, 0, len(defaults))
+	for name := range defaults {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, ok := exist[name]; ok {
+			continue
+		}
+		arg := dashes + name
+		if value := defaults[name]; value != "" {
+			arg += "=" + value
+		}
+		args = append(args, arg)
+	}
+	return args
+}
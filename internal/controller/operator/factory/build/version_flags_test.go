@@ -0,0 +1,71 @@
+package build
+
+import (
+	"testing"
+
+	version "github.com/hashicorp/go-version"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImageVersion(t *testing.T) {
+	f := func(tag, want string) {
+		t.Helper()
+		got := ParseImageVersion(tag)
+		if want == "" {
+			assert.Nil(t, got)
+			return
+		}
+		if assert.NotNil(t, got) {
+			assert.Equal(t, want, got.String())
+		}
+	}
+	f("v1.103.0", "1.103.0")
+	f("v1.103.0-cluster", "1.103.0")
+	f("v1.103.0-enterprise-cluster", "1.103.0")
+	f("v0.31.0-victorialogs", "0.31.0")
+	f("v1.103.0@sha256:3fdb2d5c1b1e6d0f", "1.103.0")
+	f("latest", "")
+	f("", "")
+}
+
+func TestAddVersionedArgs(t *testing.T) {
+	f := func(component, tag string, args, want []string, dashes string) {
+		t.Helper()
+		got := AddVersionedArgs(component, tag, args, dashes)
+		assert.Equal(t, want, got)
+	}
+	vmagentArgs := func() []string {
+		return []string{"-httpListenAddr=:8429", "-enableMultitenantHandlers=true", "-streamAggr.config=/etc/aggr.yaml", "-streamAggr.keepInput=true"}
+	}
+	// latest release
+	f("vmagent", "v1.103.0", vmagentArgs(), vmagentArgs(), "-")
+	// unknown version
+	f("vmagent", "latest", vmagentArgs(), vmagentArgs(), "-")
+	// unknown component
+	f("vmstorage", "v1.80.0-cluster", []string{"-retentionPeriod=1"}, []string{"-retentionPeriod=1"}, "-")
+	// global stream aggregation is not supported
+	f("vmagent", "v1.99.0", vmagentArgs(), []string{"-httpListenAddr=:8429", "-enableMultitenantHandlers=true"}, "-")
+	f("vmagent", "v1.95.1", vmagentArgs(), []string{"-httpListenAddr=:8429"}, "-")
+	// renamed flags
+	f("vmalertmanager", "v0.14.0",
+		[]string{"--cluster.listen-address=[$(POD_IP)]:9094", "--cluster.peer=am-0:9094", "--cluster.tls-config=/etc/tls.yaml"},
+		[]string{"--mesh.listen-address=[$(POD_IP)]:9094", "--mesh.peer=am-0:9094"}, "--")
+	f("vmalertmanager", "v0.23.0",
+		[]string{"--cluster.listen-address=", "--cluster.tls-config=/etc/tls.yaml"},
+		[]string{"--cluster.listen-address="}, "--")
+	f("vmalertmanager", "v0.27.0",
+		[]string{"--cluster.listen-address=", "--cluster.tls-config=/etc/tls.yaml"},
+		[]string{"--cluster.listen-address=", "--cluster.tls-config=/etc/tls.yaml"}, "--")
+}
+
+func TestAddVersionedArgsDefaults(t *testing.T) {
+	changes := []versionedFlags{
+		{
+			since:    version.Must(version.NewVersion("v1.10.0")),
+			defaults: map[string]string{"newFlag": "value", "boolFlag": "", "httpListenAddr": ":80"},
+		},
+	}
+
+	assert.Equal(t, []string{"-httpListenAddr=:8429"}, addVersionedArgs(changes, "v1.9.0", []string{"-httpListenAddr=:8429"}, "-"))
+	assert.Equal(t, []string{"-httpListenAddr=:8429", "-boolFlag", "-newFlag=value"}, addVersionedArgs(changes, "v1.10.0", []string{"-httpListenAddr=:8429"}, "-"))
+}
@@ -38,19 +38,6 @@ func VMServiceScrapeForServiceWithSpec(service *v1.Service, builder serviceScrap
 // optionally could filter out ports from service
 func vmServiceScrapeForServiceWithSpec(service *v1.Service, serviceScrapeSpec *vmv1beta1.VMServiceScrapeSpec, extraArgs map[string]string, metricPath string, filterPortNames ...string) *vmv1beta1.VMServiceScrape {
 	var endPoints []vmv1beta1.Endpoint
-	var isTLS bool
-	v, ok := extraArgs["tls"]
-	if ok {
-		// tls is array flag type at VictoriaMetrics components
-		// use first value
-		firstIdx := strings.IndexByte(v, ',')
-		if firstIdx > 0 {
-			v = v[:firstIdx]
-		}
-		isTLS = strings.ToLower(v) == "true"
-	}
-	authKey := extraArgs["metricsAuthKey"]
-
 	for _, servicePort := range service.Spec.Ports {
 		var nameMatched bool
 		for _, filter := range filterPortNames {
@@ -62,27 +49,7 @@ func vmServiceScrapeForServiceWithSpec(service *v1.Service, serviceScrapeSpec *v
 		if len(filterPortNames) > 0 && !nameMatched {
 			continue
 		}
-
-		ep := vmv1beta1.Endpoint{
-			Port: servicePort.Name,
-			EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{
-				Path: metricPath,
-			},
-		}
-		if isTLS {
-			ep.Scheme = "https"
-			// add insecure by default
-			// if needed user will override it with direct config
-			ep.TLSConfig = &vmv1beta1.TLSConfig{
-				InsecureSkipVerify: true,
-			}
-		}
-		if len(authKey) > 0 {
-			ep.Params = map[string][]string{
-				"authKey": {authKey},
-			}
-		}
-		endPoints = append(endPoints, ep)
+		endPoints = append(endPoints, endpointForPort(servicePort.Name, metricPath, extraArgs))
 	}
 
 	if serviceScrapeSpec == nil {
@@ -126,3 +93,56 @@ func vmServiceScrapeForServiceWithSpec(service *v1.Service, serviceScrapeSpec *v
 
 	return scrapeSvc
 }
+
+// AddVMBackupEndpoint adds endpoint for vmbackupmanager sidecar container to the given VMServiceScrape.
+// vmbackupmanager serves metrics at own port and uses own tls and metricsAuthKey flags
+func AddVMBackupEndpoint(scrapeSvc *vmv1beta1.VMServiceScrape, vmb *vmv1beta1.VMBackup) {
+	if vmb == nil {
+		return
+	}
+	for _, ep := range scrapeSvc.Spec.Endpoints {
+		if ep.Port == vmBackupPortName {
+			// keep user defined endpoint as is
+			return
+		}
+	}
+	scrapeSvc.Spec.Endpoints = append(scrapeSvc.Spec.Endpoints, endpointForPort(vmBackupPortName, "/metrics", vmb.ExtraArgs))
+}
+
+const vmBackupPortName = "vmbackupmanager"
+
+func endpointForPort(portName, metricPath string, extraArgs map[string]string) vmv1beta1.Endpoint {
+	var isTLS bool
+	v, ok := extraArgs["tls"]
+	if ok {
+		// tls is array flag type at VictoriaMetrics components
+		// use first value
+		firstIdx := strings.IndexByte(v, ',')
+		if firstIdx > 0 {
+			v = v[:firstIdx]
+		}
+		isTLS = strings.ToLower(v) == "true"
+	}
+	authKey := extraArgs["metricsAuthKey"]
+
+	ep := vmv1beta1.Endpoint{
+		Port: portName,
+		EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{
+			Path: metricPath,
+		},
+	}
+	if isTLS {
+		ep.Scheme = "https"
+		// add insecure by default
+		// if needed user will override it with direct config
+		ep.TLSConfig = &vmv1beta1.TLSConfig{
+			InsecureSkipVerify: true,
+		}
+	}
+	if len(authKey) > 0 {
+		ep.Params = map[string][]string{
+			"authKey": {authKey},
+		}
+	}
+	return ep
+}
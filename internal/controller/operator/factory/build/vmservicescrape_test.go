@@ -248,3 +248,36 @@ func TestVMServiceScrapeForServiceWithSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestAddVMBackupEndpoint(t *testing.T) {
+	f := func(endpoints []vmv1beta1.Endpoint, vmb *vmv1beta1.VMBackup, want []vmv1beta1.Endpoint) {
+		t.Helper()
+		svs := &vmv1beta1.VMServiceScrape{Spec: vmv1beta1.VMServiceScrapeSpec{Endpoints: endpoints}}
+		AddVMBackupEndpoint(svs, vmb)
+		assert.Equal(t, want, svs.Spec.Endpoints)
+	}
+	httpEP := vmv1beta1.Endpoint{Port: "http", EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{Path: "/metrics"}}
+
+	// without backup
+	f([]vmv1beta1.Endpoint{httpEP}, nil, []vmv1beta1.Endpoint{httpEP})
+
+	// with backup
+	f([]vmv1beta1.Endpoint{httpEP}, &vmv1beta1.VMBackup{Port: "8300"}, []vmv1beta1.Endpoint{
+		httpEP,
+		{Port: "vmbackupmanager", EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{Path: "/metrics"}},
+	})
+
+	// backup with own tls and authKey
+	f([]vmv1beta1.Endpoint{httpEP}, &vmv1beta1.VMBackup{Port: "8300", ExtraArgs: map[string]string{"tls": "true", "metricsAuthKey": "secret"}}, []vmv1beta1.Endpoint{
+		httpEP,
+		{
+			Port:                 "vmbackupmanager",
+			EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{Path: "/metrics", Scheme: "https", Params: map[string][]string{"authKey": {"secret"}}},
+			EndpointAuth:         vmv1beta1.EndpointAuth{TLSConfig: &vmv1beta1.TLSConfig{InsecureSkipVerify: true}},
+		},
+	})
+
+	// user defined backup endpoint
+	userEP := vmv1beta1.Endpoint{Port: "vmbackupmanager", EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{Path: "/custom"}}
+	f([]vmv1beta1.Endpoint{httpEP, userEP}, &vmv1beta1.VMBackup{Port: "8300"}, []vmv1beta1.Endpoint{httpEP, userEP})
+}
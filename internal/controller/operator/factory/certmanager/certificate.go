@@ -0,0 +1,191 @@
+package certmanager
+
+import (
+	"context"
+	"fmt"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// certificateGVK is cert-manager Certificate
+// it's managed as unstructured object, since cert-manager isn't a part of kubernetes API
+var certificateGVK = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+
+const (
+	// IssuerKind is a namespaced cert-manager issuer
+	IssuerKind = "Issuer"
+	// ClusterIssuerKind is a cluster-wide cert-manager issuer
+	ClusterIssuerKind = "ClusterIssuer"
+)
+
+// Certificate usages
+const (
+	UsageServerAuth       = "server auth"
+	UsageClientAuth       = "client auth"
+	UsageDigitalSignature = "digital signature"
+	UsageKeyEncipherment  = "key encipherment"
+)
+
+var (
+	enabled           bool
+	defaultIssuerName string
+	defaultIssuerKind string
+)
+
+// Init configures cert-manager integration
+// issuerName and issuerKind are used for certificates without issuerRef
+func Init(enable bool, issuerName, issuerKind string) error {
+	switch issuerKind {
+	case IssuerKind, ClusterIssuerKind:
+	default:
+		return fmt.Errorf("unsupported cert-manager issuer kind=%q, supported values: %s, %s", issuerKind, IssuerKind, ClusterIssuerKind)
+	}
+	enabled = enable
+	defaultIssuerName = issuerName
+	defaultIssuerKind = issuerKind
+	return nil
+}
+
+// IsEnabled checks if cert-manager integration is enabled
+func IsEnabled() bool {
+	return enabled
+}
+
+// Certificate defines cert-manager Certificate issued for operator or its components
+type Certificate struct {
+	metav1.ObjectMeta
+	// SecretName where cert-manager stores issued certificate
+	SecretName string
+	CommonName string
+	DNSNames   []string
+	Usages     []string
+	// Spec contains optional user defined settings
+	Spec *vmv1beta1.CertManagerCertificate
+}
+
+// CreateOrUpdate creates or updates cert-manager Certificate
+func CreateOrUpdate(ctx context.Context, rclient client.Client, cert *Certificate) error {
+	if !enabled {
+		return fmt.Errorf("cert-manager integration is disabled, it must be enabled with -certmanager.enable flag")
+	}
+	newCert, err := buildCertificate(cert)
+	if err != nil {
+		return err
+	}
+	reconcile.TrackChild(ctx, "Certificate", newCert, newCert.Object["spec"])
+	existCert := &unstructured.Unstructured{}
+	existCert.SetGroupVersionKind(certificateGVK)
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: newCert.GetNamespace(), Name: newCert.GetName()}, existCert); err != nil {
+		if errors.IsNotFound(err) {
+			return rclient.Create(ctx, newCert)
+		}
+		return fmt.Errorf("cannot get cert-manager certificate: %w", err)
+	}
+	newCert.SetAnnotations(labels.Merge(existCert.GetAnnotations(), newCert.GetAnnotations()))
+	newCert.SetResourceVersion(existCert.GetResourceVersion())
+	return rclient.Update(ctx, newCert)
+}
+
+// Delete removes cert-manager Certificate and secret with issued certificate
+func Delete(ctx context.Context, rclient client.Client, objMeta metav1.ObjectMeta, secretName string) error {
+	cert := &unstructured.Unstructured{}
+	cert.SetGroupVersionKind(certificateGVK)
+	cert.SetName(objMeta.Name)
+	cert.SetNamespace(objMeta.Namespace)
+	if err := finalize.SafeDelete(ctx, rclient, cert); err != nil {
+		return err
+	}
+	// cert-manager keeps secret after certificate removal by default
+	return finalize.SafeDelete(ctx, rclient, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: objMeta.Namespace}})
+}
+
+// ServiceDNSNames returns in-cluster dns names of the given service
+func ServiceDNSNames(name, namespace string) []string {
+	return []string{
+		name,
+		fmt.Sprintf("%s.%s", name, namespace),
+		fmt.Sprintf("%s.%s.svc", name, namespace),
+	}
+}
+
+func buildCertificate(cert *Certificate) (*unstructured.Unstructured, error) {
+	spec := cert.Spec
+	if spec == nil {
+		spec = &vmv1beta1.CertManagerCertificate{}
+	}
+	issuerName, issuerKind := defaultIssuerName, defaultIssuerKind
+	if spec.IssuerRef != nil {
+		issuerName = spec.IssuerRef.Name
+		issuerKind = spec.IssuerRef.Kind
+		if issuerKind == "" {
+			issuerKind = IssuerKind
+		}
+	}
+	if issuerName == "" {
+		return nil, fmt.Errorf("cert-manager issuer for certificate=%s/%s is not defined, set issuerRef or -certmanager.issuerName flag", cert.Namespace, cert.Name)
+	}
+	dnsNames := make([]any, 0, len(cert.DNSNames)+len(spec.DNSNames))
+	for _, name := range cert.DNSNames {
+		dnsNames = append(dnsNames, name)
+	}
+	for _, name := range spec.DNSNames {
+		dnsNames = append(dnsNames, name)
+	}
+	usages := make([]any, 0, len(cert.Usages))
+	for _, u := range cert.Usages {
+		usages = append(usages, u)
+	}
+	certSpec := map[string]any{
+		"secretName": cert.SecretName,
+		"issuerRef": map[string]any{
+			"group": "cert-manager.io",
+			"kind":  issuerKind,
+			"name":  issuerName,
+		},
+		"secretTemplate": map[string]any{
+			"labels": toAnyMap(cert.Labels),
+		},
+	}
+	if cert.CommonName != "" {
+		certSpec["commonName"] = cert.CommonName
+	}
+	if len(dnsNames) > 0 {
+		certSpec["dnsNames"] = dnsNames
+	}
+	if len(usages) > 0 {
+		certSpec["usages"] = usages
+	}
+	if spec.Duration != nil {
+		certSpec["duration"] = spec.Duration.Duration.String()
+	}
+	if spec.RenewBefore != nil {
+		certSpec["renewBefore"] = spec.RenewBefore.Duration.String()
+	}
+	obj := &unstructured.Unstructured{Object: map[string]any{"spec": certSpec}}
+	obj.SetGroupVersionKind(certificateGVK)
+	obj.SetName(cert.Name)
+	obj.SetNamespace(cert.Namespace)
+	obj.SetLabels(cert.Labels)
+	obj.SetAnnotations(cert.Annotations)
+	obj.SetOwnerReferences(cert.OwnerReferences)
+	return obj, nil
+}
+
+func toAnyMap(src map[string]string) map[string]any {
+	dst := make(map[string]any, len(src))
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
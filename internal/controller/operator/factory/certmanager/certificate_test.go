@@ -0,0 +1,87 @@
+package certmanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+func TestCreateOrUpdate(t *testing.T) {
+	f := func(issuerName string, spec *vmv1beta1.CertManagerCertificate, wantSpec map[string]any, wantErr bool) {
+		t.Helper()
+		if err := Init(true, issuerName, ClusterIssuerKind); err != nil {
+			t.Fatalf("cannot init cert-manager integration: %s", err)
+		}
+		defer func() { _ = Init(false, "", ClusterIssuerKind) }()
+		ctx := context.Background()
+		rclient := fake.NewClientBuilder().Build()
+		cert := &Certificate{
+			ObjectMeta: metav1.ObjectMeta{Name: "vmauth-main", Namespace: "default", Labels: map[string]string{"app": "vmauth"}},
+			SecretName: "vmauth-tls-main",
+			DNSNames:   ServiceDNSNames("vmauth-main", "default"),
+			Usages:     []string{UsageServerAuth},
+			Spec:       spec,
+		}
+		// create and update
+		for i := 0; i < 2; i++ {
+			err := CreateOrUpdate(ctx, rclient, cert)
+			if wantErr {
+				if err == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("cannot reconcile certificate: %s", err)
+			}
+		}
+		got := &unstructured.Unstructured{}
+		got.SetGroupVersionKind(certificateGVK)
+		if err := rclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vmauth-main"}, got); err != nil {
+			t.Fatalf("cannot get certificate: %s", err)
+		}
+		assert.Equal(t, wantSpec, got.Object["spec"])
+
+		if err := Delete(ctx, rclient, metav1.ObjectMeta{Namespace: "default", Name: "vmauth-main"}, cert.SecretName); err != nil {
+			t.Fatalf("cannot delete certificate: %s", err)
+		}
+	}
+	dnsNames := []any{"vmauth-main", "vmauth-main.default", "vmauth-main.default.svc"}
+	secretTemplate := map[string]any{"labels": map[string]any{"app": "vmauth"}}
+
+	// default issuer
+	f("selfsigned", nil, map[string]any{
+		"secretName":     "vmauth-tls-main",
+		"issuerRef":      map[string]any{"group": "cert-manager.io", "kind": "ClusterIssuer", "name": "selfsigned"},
+		"secretTemplate": secretTemplate,
+		"dnsNames":       dnsNames,
+		"usages":         []any{"server auth"},
+	}, false)
+
+	// issuer and additional settings from spec
+	f("selfsigned", &vmv1beta1.CertManagerCertificate{
+		IssuerRef:   &vmv1beta1.CertManagerIssuerRef{Name: "team-ca"},
+		DNSNames:    []string{"vmauth.example.com"},
+		Duration:    &metav1.Duration{Duration: 2160 * time.Hour},
+		RenewBefore: &metav1.Duration{Duration: 360 * time.Hour},
+	}, map[string]any{
+		"secretName":     "vmauth-tls-main",
+		"issuerRef":      map[string]any{"group": "cert-manager.io", "kind": "Issuer", "name": "team-ca"},
+		"secretTemplate": secretTemplate,
+		"dnsNames":       append(dnsNames, "vmauth.example.com"),
+		"usages":         []any{"server auth"},
+		"duration":       "2160h0m0s",
+		"renewBefore":    "360h0m0s",
+	}, false)
+
+	// missing issuer
+	f("", nil, nil, true)
+}
@@ -0,0 +1,436 @@
+package datamigration
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	migrationHashAnnotation = "operator.victoriametrics.com/vmdatamigration-hash"
+	migrationHashLabel      = "operator.victoriametrics.com/vmdatamigration-hash"
+	migrationChunkLabel     = "operator.victoriametrics.com/vmdatamigration-chunk"
+	// termination message is limited by kubelet with 4096 bytes
+	maxMigrationMessageLen = 4096
+
+	prometheusVolumeName = "prometheus-data"
+	prometheusMountPath  = "/prometheus"
+
+	sourceUsernameEnv = "SOURCE_USERNAME"
+	sourcePasswordEnv = "SOURCE_PASSWORD"
+)
+
+// CreateOrUpdateDataMigration migrates time range chunks of VMDataMigration sequentially with vmctl Jobs
+// and tracks migration progress at VMDataMigration status.
+// Job of completed chunk is removed and migration continues with the next chunk.
+// Job of failed chunk is kept for troubleshooting, migration is resumed from the failed chunk after its removal.
+// Progress is reset, if migration source, destination or time range changes.
+// It returns true, if migration is finished for the current settings.
+func CreateOrUpdateDataMigration(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMDataMigration) (bool, error) {
+	dst, vmArgs, err := buildDestination(ctx, rclient, cr)
+	if err != nil {
+		if patchErr := patchMigrationStatus(ctx, rclient, cr, func(status *vmv1beta1.VMDataMigrationStatus) {
+			status.LastSyncError = err.Error()
+		}); patchErr != nil {
+			return false, patchErr
+		}
+		return false, err
+	}
+	chunks := cr.Chunks(time.Now())
+	if len(chunks) == 0 {
+		return false, fmt.Errorf("timeRange of vmdatamigration is empty")
+	}
+	dstCtx := events.AddToContext(ctx, dst)
+	hash := migrationHash(cr, vmArgs, chunks)
+	if cr.Status.MigrationHash != hash {
+		logger.WithContext(ctx).Info("migration settings changed, starting migration from the beginning", "hash", hash, "chunks", len(chunks))
+		if err := deleteMigrationJobs(ctx, rclient, cr); err != nil {
+			return false, err
+		}
+		if err := patchMigrationStatus(ctx, rclient, cr, func(status *vmv1beta1.VMDataMigrationStatus) {
+			*status = vmv1beta1.VMDataMigrationStatus{
+				Phase:         vmv1beta1.DataMigrationPending,
+				Message:       "migration is scheduled",
+				TotalChunks:   int32(len(chunks)),
+				Progress:      progress(0, len(chunks)),
+				MigrationHash: hash,
+			}
+		}); err != nil {
+			return false, err
+		}
+	}
+
+	for {
+		if cr.Status.Phase == vmv1beta1.DataMigrationSucceeded {
+			return true, nil
+		}
+		idx := int(cr.Status.CompletedChunks)
+		if idx >= len(chunks) {
+			logger.WithContext(ctx).Info("data migration succeeded")
+			events.Normal(ctx, events.ReasonDataMigrationSucceeded, "migrated %d chunks into %s=%s", len(chunks), cr.Spec.Destination.Kind, cr.Spec.Destination.Name)
+			events.Normal(dstCtx, events.ReasonDataMigrationSucceeded, "vmdatamigration=%s migrated %d chunks", cr.Name, len(chunks))
+			completionTime := metav1.Now()
+			return true, patchMigrationStatus(ctx, rclient, cr, func(status *vmv1beta1.VMDataMigrationStatus) {
+				status.Phase = vmv1beta1.DataMigrationSucceeded
+				status.Message = fmt.Sprintf("migrated time range from %s to %s", formatTime(chunks[0][0]), formatTime(chunks[len(chunks)-1][1]))
+				status.CompletionTime = &completionTime
+				status.LastSyncError = ""
+			})
+		}
+		done, err := reconcileChunk(ctx, dstCtx, rclient, cr, chunks, idx, vmArgs, hash)
+		if err != nil || !done || cr.Status.Phase == vmv1beta1.DataMigrationFailed {
+			return cr.Status.Phase == vmv1beta1.DataMigrationFailed, err
+		}
+	}
+}
+
+// reconcileChunk runs job for chunk with the given idx
+// It returns true, if chunk is migrated and the next chunk could be started
+func reconcileChunk(ctx, dstCtx context.Context, rclient client.Client, cr *vmv1beta1.VMDataMigration, chunks [][2]time.Time, idx int, vmArgs []string, hash string) (bool, error) {
+	jobName := chunkJobName(cr, idx)
+	var job batchv1.Job
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: jobName}, &job); err != nil {
+		if !errors.IsNotFound(err) {
+			return false, fmt.Errorf("cannot get job for vmdatamigration: %w", err)
+		}
+		logger.WithContext(ctx).Info("starting data migration job", "job", jobName, "chunk", idx, "start", formatTime(chunks[idx][0]), "end", formatTime(chunks[idx][1]))
+		if err := rclient.Create(ctx, buildChunkJob(cr, buildArgs(cr, chunks[idx], vmArgs), idx, hash)); err != nil {
+			return false, fmt.Errorf("cannot create job for vmdatamigration: %w", err)
+		}
+		if idx == 0 || cr.Status.Phase == vmv1beta1.DataMigrationFailed {
+			events.Normal(ctx, events.ReasonDataMigrationStarted, "started migration from chunk %d into %s=%s", idx, cr.Spec.Destination.Kind, cr.Spec.Destination.Name)
+			events.Normal(dstCtx, events.ReasonDataMigrationStarted, "vmdatamigration=%s started migration", cr.Name)
+		}
+		return false, patchMigrationStatus(ctx, rclient, cr, func(status *vmv1beta1.VMDataMigrationStatus) {
+			status.Phase = vmv1beta1.DataMigrationRunning
+			status.Message = fmt.Sprintf("migrating time range from %s to %s at job=%s", formatTime(chunks[idx][0]), formatTime(chunks[idx][1]), jobName)
+			if status.StartTime == nil {
+				status.StartTime = ptr.To(metav1.Now())
+			}
+			status.LastSyncError = ""
+		})
+	}
+	if !job.DeletionTimestamp.IsZero() {
+		// wait for previous chunk run removal
+		return false, nil
+	}
+	if job.Annotations[migrationHashAnnotation] != hash {
+		logger.WithContext(ctx).Info("migration settings changed, removing outdated data migration job", "job", job.Name)
+		return false, deleteJob(ctx, rclient, &job)
+	}
+
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			logger.WithContext(ctx).Info("data migration chunk completed", "job", job.Name, "chunk", idx)
+			if err := deleteJob(ctx, rclient, &job); err != nil {
+				return false, err
+			}
+			return true, patchMigrationStatus(ctx, rclient, cr, func(status *vmv1beta1.VMDataMigrationStatus) {
+				status.Phase = vmv1beta1.DataMigrationPending
+				status.CompletedChunks = int32(idx + 1)
+				status.Progress = progress(idx+1, len(chunks))
+				status.Message = fmt.Sprintf("migrated time range from %s to %s", formatTime(chunks[0][0]), formatTime(chunks[idx][1]))
+			})
+		case batchv1.JobFailed:
+			if cr.Status.Phase == vmv1beta1.DataMigrationFailed {
+				return false, nil
+			}
+			message, err := chunkFailureMessage(ctx, rclient, cr, idx, hash)
+			if err != nil {
+				return false, err
+			}
+			logger.WithContext(ctx).Info("data migration chunk failed", "job", job.Name, "chunk", idx, "message", message)
+			events.Warning(ctx, events.ReasonDataMigrationFailed, "migration of chunk %d into %s=%s failed", idx, cr.Spec.Destination.Kind, cr.Spec.Destination.Name)
+			events.Warning(dstCtx, events.ReasonDataMigrationFailed, "vmdatamigration=%s failed", cr.Name)
+			return false, patchMigrationStatus(ctx, rclient, cr, func(status *vmv1beta1.VMDataMigrationStatus) {
+				status.Phase = vmv1beta1.DataMigrationFailed
+				status.Message = message
+			})
+		}
+	}
+	return false, nil
+}
+
+// buildDestination returns destination object and vmctl arguments for it
+func buildDestination(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMDataMigration) (client.Object, []string, error) {
+	nsn := types.NamespacedName{Namespace: cr.Namespace, Name: cr.Spec.Destination.Name}
+	switch cr.Spec.Destination.Kind {
+	case "VMSingle":
+		var vmSingle vmv1beta1.VMSingle
+		if err := rclient.Get(ctx, nsn, &vmSingle); err != nil {
+			return nil, nil, destinationGetError(cr, err)
+		}
+		return &vmSingle, []string{"--vm-addr=" + vmSingle.AsURL()}, nil
+	case "VMCluster":
+		var vmCluster vmv1beta1.VMCluster
+		if err := rclient.Get(ctx, nsn, &vmCluster); err != nil {
+			return nil, nil, destinationGetError(cr, err)
+		}
+		if vmCluster.Spec.VMInsert == nil {
+			return nil, nil, fmt.Errorf("vmcluster=%q must have vminsert for data migration", vmCluster.Name)
+		}
+		tenant := cr.Spec.Destination.Tenant
+		if tenant == "" {
+			tenant = "0"
+		}
+		return &vmCluster, []string{"--vm-addr=" + vmCluster.VMInsertURL(), "--vm-account-id=" + tenant}, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported destination kind=%q", cr.Spec.Destination.Kind)
+	}
+}
+
+func destinationGetError(cr *vmv1beta1.VMDataMigration, err error) error {
+	if errors.IsNotFound(err) {
+		return fmt.Errorf("cannot find %s=%q referenced by vmdatamigration", cr.Spec.Destination.Kind, cr.Spec.Destination.Name)
+	}
+	return fmt.Errorf("cannot get %s=%q: %w", cr.Spec.Destination.Kind, cr.Spec.Destination.Name, err)
+}
+
+// buildArgs returns vmctl arguments for migration of the given time range chunk
+func buildArgs(cr *vmv1beta1.VMDataMigration, chunk [2]time.Time, vmArgs []string) []string {
+	args := append(buildSourceArgs(cr, chunk), vmArgs...)
+	extraArgs := make([]string, 0, len(cr.Spec.ExtraArgs))
+	for k, v := range cr.Spec.ExtraArgs {
+		extraArgs = append(extraArgs, fmt.Sprintf("--%s=%s", strings.TrimLeft(k, "-"), v))
+	}
+	sort.Strings(extraArgs)
+	return append(args, extraArgs...)
+}
+
+func buildSourceArgs(cr *vmv1beta1.VMDataMigration, chunk [2]time.Time) []string {
+	start, end := formatTime(chunk[0]), formatTime(chunk[1])
+	src := cr.Spec.Source
+	args := []string{cr.SourceMode(), "-s"}
+	switch {
+	case src.Prometheus != nil:
+		args = append(args,
+			"--prom-snapshot="+path.Join(prometheusMountPath, src.Prometheus.SnapshotPath),
+			"--prom-filter-time-start="+start,
+			"--prom-filter-time-end="+end,
+		)
+	case src.Thanos != nil:
+		args = append(args,
+			"--remote-read-src-addr="+src.Thanos.URL,
+			"--remote-read-step-interval="+src.Thanos.StepInterval,
+			"--remote-read-filter-time-start="+start,
+			"--remote-read-filter-time-end="+end,
+		)
+		if src.Thanos.FilterLabel != "" {
+			args = append(args, "--remote-read-filter-label="+src.Thanos.FilterLabel)
+		}
+		if src.Thanos.FilterLabelValue != "" {
+			args = append(args, "--remote-read-filter-label-value="+src.Thanos.FilterLabelValue)
+		}
+		args = append(args, buildAuthArgs(src.Thanos.BasicAuth, "remote-read")...)
+	case src.Influx != nil:
+		args = append(args,
+			"--influx-addr="+src.Influx.URL,
+			"--influx-database="+src.Influx.Database,
+			"--influx-filter-time-start="+start,
+			"--influx-filter-time-end="+end,
+		)
+		args = append(args, buildAuthArgs(src.Influx.BasicAuth, "influx")...)
+	}
+	return args
+}
+
+// buildAuthArgs references source credentials from container env variables
+func buildAuthArgs(ba *vmv1beta1.BasicAuth, flagPrefix string) []string {
+	if ba == nil {
+		return nil
+	}
+	var args []string
+	if ba.Username.Name != "" {
+		args = append(args, fmt.Sprintf("--%s-user=$(%s)", flagPrefix, sourceUsernameEnv))
+	}
+	if ba.Password.Name != "" {
+		args = append(args, fmt.Sprintf("--%s-password=$(%s)", flagPrefix, sourcePasswordEnv))
+	}
+	return args
+}
+
+func buildAuthEnvs(cr *vmv1beta1.VMDataMigration) []corev1.EnvVar {
+	var ba *vmv1beta1.BasicAuth
+	switch {
+	case cr.Spec.Source.Thanos != nil:
+		ba = cr.Spec.Source.Thanos.BasicAuth
+	case cr.Spec.Source.Influx != nil:
+		ba = cr.Spec.Source.Influx.BasicAuth
+	}
+	if ba == nil {
+		return nil
+	}
+	var envs []corev1.EnvVar
+	if ba.Username.Name != "" {
+		envs = append(envs, corev1.EnvVar{Name: sourceUsernameEnv, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: ba.Username.DeepCopy()}})
+	}
+	if ba.Password.Name != "" {
+		envs = append(envs, corev1.EnvVar{Name: sourcePasswordEnv, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: ba.Password.DeepCopy()}})
+	}
+	return envs
+}
+
+// migrationHash calculates hash of settings, which define migrated data
+func migrationHash(cr *vmv1beta1.VMDataMigration, vmArgs []string, chunks [][2]time.Time) string {
+	h := sha256.New()
+	h.Write([]byte(cr.Spec.Destination.Kind))                             //nolint:errcheck
+	h.Write([]byte(cr.Spec.Destination.Name))                             //nolint:errcheck
+	h.Write([]byte(strings.Join(vmArgs, "\xff")))                         //nolint:errcheck
+	h.Write([]byte(strings.Join(buildSourceArgs(cr, chunks[0]), "\xff"))) //nolint:errcheck
+	h.Write([]byte(formatTime(chunks[len(chunks)-1][1])))                 //nolint:errcheck
+	h.Write([]byte(strconv.Itoa(len(chunks))))                            //nolint:errcheck
+	if prom := cr.Spec.Source.Prometheus; prom != nil {
+		h.Write([]byte(prom.ClaimName)) //nolint:errcheck
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+func chunkJobName(cr *vmv1beta1.VMDataMigration, idx int) string {
+	return fmt.Sprintf("%s-%d", cr.PrefixedName(), idx)
+}
+
+func buildChunkJob(cr *vmv1beta1.VMDataMigration, args []string, idx int, hash string) *batchv1.Job {
+	podLabels := labels.Merge(cr.AllLabels(), map[string]string{migrationHashLabel: hash, migrationChunkLabel: strconv.Itoa(idx)})
+	container := corev1.Container{
+		Name:                     "vmctl",
+		Image:                    fmt.Sprintf("%s:%s", cr.Spec.Image.Repository, cr.Spec.Image.Tag),
+		ImagePullPolicy:          cr.Spec.Image.PullPolicy,
+		Args:                     args,
+		Env:                      append(buildAuthEnvs(cr), cr.Spec.ExtraEnvs...),
+		Resources:                cr.Spec.Resources,
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+	}
+	var volumes []corev1.Volume
+	if prom := cr.Spec.Source.Prometheus; prom != nil {
+		volumes = append(volumes, corev1.Volume{
+			Name: prometheusVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: prom.ClaimName,
+					ReadOnly:  true,
+				},
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      prometheusVolumeName,
+			MountPath: prometheusMountPath,
+			ReadOnly:  true,
+		})
+	}
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            chunkJobName(cr, idx),
+			Namespace:       cr.Namespace,
+			Labels:          cr.AllLabels(),
+			Annotations:     labels.Merge(cr.AnnotationsFiltered(), map[string]string{migrationHashAnnotation: hash}),
+			OwnerReferences: cr.AsOwner(),
+			Finalizers:      finalize.ChildFinalizers(),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: cr.Spec.BackoffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: podLabels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers:    []corev1.Container{container},
+					Volumes:       volumes,
+				},
+			},
+		},
+	}
+}
+
+// deleteMigrationJobs removes all jobs of migration
+func deleteMigrationJobs(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMDataMigration) error {
+	var jobs batchv1.JobList
+	if err := rclient.List(ctx, &jobs, client.InNamespace(cr.Namespace), client.MatchingLabels(cr.SelectorLabels())); err != nil {
+		return fmt.Errorf("cannot list jobs of vmdatamigration: %w", err)
+	}
+	for i := range jobs.Items {
+		if err := deleteJob(ctx, rclient, &jobs.Items[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteJob removes finalizer from job and deletes it with its pods
+func deleteJob(ctx context.Context, rclient client.Client, job *batchv1.Job) error {
+	if err := finalize.RemoveFinalizer(ctx, rclient, job); err != nil {
+		return err
+	}
+	if err := rclient.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("cannot delete data migration job: %w", err)
+	}
+	return nil
+}
+
+// chunkFailureMessage returns termination message of failed vmctl container
+func chunkFailureMessage(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMDataMigration, idx int, hash string) (string, error) {
+	var pods corev1.PodList
+	podLabels := labels.Merge(cr.SelectorLabels(), map[string]string{migrationHashLabel: hash, migrationChunkLabel: strconv.Itoa(idx)})
+	if err := rclient.List(ctx, &pods, client.InNamespace(cr.Namespace), client.MatchingLabels(podLabels)); err != nil {
+		return "", fmt.Errorf("cannot list pods for vmdatamigration: %w", err)
+	}
+	for _, pod := range pods.Items {
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.State.Terminated != nil && cs.State.Terminated.Message != "" {
+				msg := strings.TrimSpace(cs.State.Terminated.Message)
+				if len(msg) > maxMigrationMessageLen {
+					msg = msg[len(msg)-maxMigrationMessageLen:]
+				}
+				return msg, nil
+			}
+		}
+	}
+	return "vmctl failed, check logs of job=" + chunkJobName(cr, idx), nil
+}
+
+func progress(completed, total int) string {
+	return fmt.Sprintf("%d/%d", completed, total)
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func patchMigrationStatus(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMDataMigration, update func(status *vmv1beta1.VMDataMigrationStatus)) error {
+	status := cr.Status.DeepCopy()
+	update(status)
+	if equality.Semantic.DeepEqual(*status, cr.Status) {
+		return nil
+	}
+	// merge patch is calculated from the previous object in order to remove cleared fields
+	patch := client.MergeFrom(cr.DeepCopy())
+	cr.Status = *status
+	if err := rclient.Status().Patch(ctx, cr, patch); err != nil {
+		return fmt.Errorf("cannot patch status of vmdatamigration=%q: %w", cr.Name, err)
+	}
+	return nil
+}
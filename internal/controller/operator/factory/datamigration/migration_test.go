@@ -0,0 +1,230 @@
+package datamigration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestBuildArgs(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	f := func(spec vmv1beta1.VMDataMigrationSpec, predefinedObjects []runtime.Object, wantArgs []string, wantErr string) {
+		t.Helper()
+		cr := &vmv1beta1.VMDataMigration{
+			ObjectMeta: metav1.ObjectMeta{Name: "migration", Namespace: "default"},
+			Spec:       spec,
+		}
+		rclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		_, vmArgs, err := buildDestination(context.Background(), rclient, cr)
+		if wantErr != "" {
+			assert.EqualError(t, err, wantErr)
+			return
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assert.Equal(t, wantArgs, buildArgs(cr, [2]time.Time{start, end}, vmArgs))
+	}
+	vmSingle := &vmv1beta1.VMSingle{ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"}}
+	vmCluster := &vmv1beta1.VMCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"},
+		Spec: vmv1beta1.VMClusterSpec{
+			VMInsert: &vmv1beta1.VMInsert{},
+		},
+	}
+	promSource := vmv1beta1.DataMigrationSource{
+		Prometheus: &vmv1beta1.PrometheusMigrationSource{ClaimName: "prometheus-data", SnapshotPath: "snapshots/20240102"},
+	}
+
+	// missing destination
+	f(vmv1beta1.VMDataMigrationSpec{
+		Source:      promSource,
+		Destination: vmv1beta1.DataMigrationDestination{Kind: "VMSingle", Name: "main"},
+	}, nil, nil, `cannot find VMSingle="main" referenced by vmdatamigration`)
+
+	// prometheus snapshot into vmsingle
+	f(vmv1beta1.VMDataMigrationSpec{
+		Source:      promSource,
+		Destination: vmv1beta1.DataMigrationDestination{Kind: "VMSingle", Name: "main"},
+		ExtraArgs:   map[string]string{"vm-concurrency": "4", "-prom-concurrency": "2"},
+	}, []runtime.Object{vmSingle}, []string{
+		"prometheus", "-s",
+		"--prom-snapshot=/prometheus/snapshots/20240102",
+		"--prom-filter-time-start=2024-01-01T00:00:00Z",
+		"--prom-filter-time-end=2024-01-02T00:00:00Z",
+		"--vm-addr=http://vmsingle-main.default.svc:8429",
+		"--prom-concurrency=2",
+		"--vm-concurrency=4",
+	}, "")
+
+	// thanos remote read into vmcluster tenant
+	f(vmv1beta1.VMDataMigrationSpec{
+		Source: vmv1beta1.DataMigrationSource{
+			Thanos: &vmv1beta1.ThanosMigrationSource{
+				URL:              "http://thanos-query:10902/api/v1/read",
+				StepInterval:     "hour",
+				FilterLabel:      "job",
+				FilterLabelValue: "node.*",
+				BasicAuth: &vmv1beta1.BasicAuth{
+					Username: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "thanos-auth"}, Key: "user"},
+					Password: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "thanos-auth"}, Key: "password"},
+				},
+			},
+		},
+		Destination: vmv1beta1.DataMigrationDestination{Kind: "VMCluster", Name: "main", Tenant: "1:2"},
+	}, []runtime.Object{vmCluster}, []string{
+		"remote-read", "-s",
+		"--remote-read-src-addr=http://thanos-query:10902/api/v1/read",
+		"--remote-read-step-interval=hour",
+		"--remote-read-filter-time-start=2024-01-01T00:00:00Z",
+		"--remote-read-filter-time-end=2024-01-02T00:00:00Z",
+		"--remote-read-filter-label=job",
+		"--remote-read-filter-label-value=node.*",
+		"--remote-read-user=$(SOURCE_USERNAME)",
+		"--remote-read-password=$(SOURCE_PASSWORD)",
+		"--vm-addr=http://vminsert-main.default.svc:8480",
+		"--vm-account-id=1:2",
+	}, "")
+
+	// influx into vmcluster default tenant
+	f(vmv1beta1.VMDataMigrationSpec{
+		Source: vmv1beta1.DataMigrationSource{
+			Influx: &vmv1beta1.InfluxMigrationSource{URL: "http://influx:8086", Database: "telegraf"},
+		},
+		Destination: vmv1beta1.DataMigrationDestination{Kind: "VMCluster", Name: "main"},
+	}, []runtime.Object{vmCluster}, []string{
+		"influx", "-s",
+		"--influx-addr=http://influx:8086",
+		"--influx-database=telegraf",
+		"--influx-filter-time-start=2024-01-01T00:00:00Z",
+		"--influx-filter-time-end=2024-01-02T00:00:00Z",
+		"--vm-addr=http://vminsert-main.default.svc:8480",
+		"--vm-account-id=0",
+	}, "")
+}
+
+func TestCreateOrUpdateDataMigration(t *testing.T) {
+	ctx := context.Background()
+	cr := &vmv1beta1.VMDataMigration{
+		ObjectMeta: metav1.ObjectMeta{Name: "import", Namespace: "default"},
+		Spec: vmv1beta1.VMDataMigrationSpec{
+			Source: vmv1beta1.DataMigrationSource{
+				Prometheus: &vmv1beta1.PrometheusMigrationSource{ClaimName: "prometheus-data", SnapshotPath: "snapshots/20240104"},
+			},
+			Destination: vmv1beta1.DataMigrationDestination{Kind: "VMSingle", Name: "main"},
+			TimeRange: vmv1beta1.DataMigrationTimeRange{
+				Start:         metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				End:           ptr.To(metav1.NewTime(time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC))),
+				ChunkInterval: &metav1.Duration{Duration: 24 * time.Hour},
+			},
+			BackoffLimit: ptr.To[int32](3),
+			Image:        vmv1beta1.Image{Repository: "victoriametrics/vmctl", Tag: "v1.103.0"},
+		},
+	}
+	vmSingle := &vmv1beta1.VMSingle{ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"}}
+	rclient := k8stools.GetTestClientWithObjects([]runtime.Object{cr, vmSingle})
+	reconcileMigration := func(wantFinished bool, wantPhase, wantProgress string) {
+		t.Helper()
+		if err := rclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.Name}, cr); err != nil {
+			t.Fatalf("cannot get migration: %s", err)
+		}
+		finished, err := CreateOrUpdateDataMigration(ctx, rclient, cr)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assert.Equal(t, wantFinished, finished)
+		assert.Equal(t, wantPhase, cr.Status.Phase)
+		assert.Equal(t, wantProgress, cr.Status.Progress)
+	}
+	getJob := func(idx int) (*batchv1.Job, error) {
+		var job batchv1.Job
+		err := rclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: chunkJobName(cr, idx)}, &job)
+		return &job, err
+	}
+	setJobCondition := func(idx int, condType batchv1.JobConditionType) {
+		t.Helper()
+		job, err := getJob(idx)
+		if err != nil {
+			t.Fatalf("cannot get job: %s", err)
+		}
+		job.Status.Conditions = []batchv1.JobCondition{{Type: condType, Status: corev1.ConditionTrue}}
+		if err := rclient.Status().Update(ctx, job); err != nil {
+			t.Fatalf("cannot update job status: %s", err)
+		}
+	}
+
+	// the first chunk is started
+	reconcileMigration(false, vmv1beta1.DataMigrationRunning, "0/3")
+	assert.Equal(t, int32(3), cr.Status.TotalChunks)
+	assert.NotNil(t, cr.Status.StartTime)
+	job, err := getJob(0)
+	if err != nil {
+		t.Fatalf("cannot get job: %s", err)
+	}
+	container := job.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "victoriametrics/vmctl:v1.103.0", container.Image)
+	assert.Contains(t, container.Args, "--prom-filter-time-end=2024-01-02T00:00:00Z")
+	assert.Equal(t, []corev1.VolumeMount{{Name: "prometheus-data", MountPath: "/prometheus", ReadOnly: true}}, container.VolumeMounts)
+	assert.Equal(t, ptr.To[int32](3), job.Spec.BackoffLimit)
+
+	// completed chunk job is removed and the next chunk is started
+	setJobCondition(0, batchv1.JobComplete)
+	reconcileMigration(false, vmv1beta1.DataMigrationRunning, "1/3")
+	if _, err := getJob(0); err == nil {
+		t.Fatalf("job of completed chunk must be removed")
+	}
+
+	// failed chunk job is kept
+	setJobCondition(1, batchv1.JobFailed)
+	reconcileMigration(true, vmv1beta1.DataMigrationFailed, "1/3")
+	assert.Equal(t, "vmctl failed, check logs of job=vmdatamigration-import-1", cr.Status.Message)
+	reconcileMigration(true, vmv1beta1.DataMigrationFailed, "1/3")
+
+	// migration is resumed from failed chunk after job removal
+	job, err = getJob(1)
+	if err != nil {
+		t.Fatalf("cannot get job: %s", err)
+	}
+	if err := deleteJob(ctx, rclient, job); err != nil {
+		t.Fatalf("cannot delete job: %s", err)
+	}
+	reconcileMigration(false, vmv1beta1.DataMigrationRunning, "1/3")
+	job, err = getJob(1)
+	if err != nil {
+		t.Fatalf("cannot get job: %s", err)
+	}
+	assert.Contains(t, job.Spec.Template.Spec.Containers[0].Args, "--prom-filter-time-start=2024-01-02T00:00:00Z")
+
+	// the last chunk is limited by time range end
+	setJobCondition(1, batchv1.JobComplete)
+	reconcileMigration(false, vmv1beta1.DataMigrationRunning, "2/3")
+	job, err = getJob(2)
+	if err != nil {
+		t.Fatalf("cannot get job: %s", err)
+	}
+	assert.Contains(t, job.Spec.Template.Spec.Containers[0].Args, "--prom-filter-time-end=2024-01-03T12:00:00Z")
+	setJobCondition(2, batchv1.JobComplete)
+	reconcileMigration(true, vmv1beta1.DataMigrationSucceeded, "3/3")
+	assert.NotNil(t, cr.Status.CompletionTime)
+	reconcileMigration(true, vmv1beta1.DataMigrationSucceeded, "3/3")
+
+	// time range change restarts migration
+	cr.Spec.TimeRange.Start = metav1.NewTime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	if err := rclient.Update(ctx, cr); err != nil {
+		t.Fatalf("cannot update migration: %s", err)
+	}
+	reconcileMigration(false, vmv1beta1.DataMigrationRunning, "0/2")
+	assert.Nil(t, cr.Status.CompletionTime)
+}
@@ -0,0 +1,136 @@
+package defaultrules
+
+import (
+	"context"
+	"fmt"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	enabled         bool
+	excludeSelector labels.Selector
+)
+
+// Init configures default rules
+// excludeSelectorFlag is a label selector for rules excluded from all generated VMRules
+func Init(enable bool, excludeSelectorFlag string) error {
+	selector, err := parseExcludeSelector(excludeSelectorFlag)
+	if err != nil {
+		return err
+	}
+	enabled = enable
+	excludeSelector = selector
+	return nil
+}
+
+// IsEnabled checks if default rules must be created for object with the given settings
+func IsEnabled(dr *vmv1beta1.DefaultRules) bool {
+	return enabled || dr.IsEnabled()
+}
+
+// CreateOrUpdate creates or updates VMRule with default rules of component
+// It removes VMRule previously created by operator, if default rules are disabled or all rules are excluded
+// prevDR is default rules config of the last applied spec, VMRule is looked up for removal only if it was enabled
+func CreateOrUpdate(ctx context.Context, rclient client.Client, dr, prevDR *vmv1beta1.DefaultRules, rule *vmv1beta1.VMRule) error {
+	if IsEnabled(dr) {
+		groups, err := excludeRules(rule.Spec.Groups, dr)
+		if err != nil {
+			return err
+		}
+		if len(groups) > 0 {
+			rule.Spec.Groups = groups
+			return reconcile.VMRuleForCRD(ctx, rclient, rule)
+		}
+		return deleteOwned(ctx, rclient, rule.ObjectMeta)
+	}
+	if !prevDR.IsEnabled() {
+		return nil
+	}
+	return deleteOwned(ctx, rclient, rule.ObjectMeta)
+}
+
+// deleteOwned removes VMRule, if it was created by operator for the owner of objMeta
+func deleteOwned(ctx context.Context, rclient client.Client, objMeta metav1.ObjectMeta) error {
+	var existRule vmv1beta1.VMRule
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: objMeta.Namespace, Name: objMeta.Name}, &existRule); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	var owned bool
+	for _, ref := range objMeta.OwnerReferences {
+		for _, existRef := range existRule.OwnerReferences {
+			if ref.UID == existRef.UID {
+				owned = true
+			}
+		}
+	}
+	if !owned {
+		return nil
+	}
+	return finalize.SafeDeleteWithFinalizer(ctx, rclient, &existRule)
+}
+
+func parseExcludeSelector(selector string) (labels.Selector, error) {
+	if len(selector) == 0 {
+		return nil, nil
+	}
+	s, err := labels.Parse(selector)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse default rules exclude selector=%q: %w", selector, err)
+	}
+	return s, nil
+}
+
+// excludeRules removes rules matched by global and object exclude selectors
+// rules are matched by its labels with alertname or record label
+func excludeRules(groups []vmv1beta1.RuleGroup, dr *vmv1beta1.DefaultRules) ([]vmv1beta1.RuleGroup, error) {
+	selectors := []labels.Selector{excludeSelector}
+	if dr != nil {
+		s, err := parseExcludeSelector(dr.ExcludeSelector)
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, s)
+	}
+	var dst []vmv1beta1.RuleGroup
+	for _, g := range groups {
+		var rules []vmv1beta1.Rule
+		for _, r := range g.Rules {
+			ruleLabels := labels.Set{}
+			for k, v := range r.Labels {
+				ruleLabels[k] = v
+			}
+			if r.Alert != "" {
+				ruleLabels["alertname"] = r.Alert
+			} else {
+				ruleLabels["record"] = r.Record
+			}
+			var excluded bool
+			for _, s := range selectors {
+				if s != nil && s.Matches(ruleLabels) {
+					excluded = true
+					break
+				}
+			}
+			if !excluded {
+				rules = append(rules, r)
+			}
+		}
+		if len(rules) > 0 {
+			g.Rules = rules
+			dst = append(dst, g)
+		}
+	}
+	return dst, nil
+}
@@ -0,0 +1,223 @@
+package defaultrules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func ruleNames(rule *vmv1beta1.VMRule) []string {
+	var names []string
+	for _, g := range rule.Spec.Groups {
+		for _, r := range g.Rules {
+			if r.Alert != "" {
+				names = append(names, r.Alert)
+			} else {
+				names = append(names, r.Record)
+			}
+		}
+	}
+	return names
+}
+
+func TestForVMAgent(t *testing.T) {
+	f := func(limits *vmv1beta1.CardinalityLimits, wantRules []string) {
+		t.Helper()
+		cr := &vmv1beta1.VMAgent{
+			ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "monitoring"},
+			Spec: vmv1beta1.VMAgentSpec{
+				CardinalityLimits: limits,
+				DefaultRules: &vmv1beta1.DefaultRules{
+					Enabled:              true,
+					Labels:               map[string]string{"team": "infra"},
+					AdditionalRuleLabels: map[string]string{"cluster": "prod"},
+				},
+			},
+		}
+		rule := ForVMAgent(cr)
+		assert.Equal(t, "vmagent-agent", rule.Name)
+		assert.Equal(t, "monitoring", rule.Namespace)
+		assert.Equal(t, "infra", rule.Labels["team"])
+		assert.Equal(t, cr.AsOwner(), rule.OwnerReferences)
+		for _, g := range rule.Spec.Groups {
+			assert.Equal(t, map[string]string{"cluster": "prod"}, g.Labels)
+			for _, r := range g.Rules {
+				assert.Contains(t, r.Expr, `job="vmagent-agent",namespace="monitoring"`)
+			}
+		}
+		assert.Equal(t, wantRules, ruleNames(rule))
+	}
+	remoteWriteRules := []string{"job:vmagent_remotewrite_pending_data_bytes:sum", "VMAgentRemoteWriteLag", "PersistentQueueIsDroppingData"}
+
+	// no limits
+	f(nil, remoteWriteRules)
+	f(&vmv1beta1.CardinalityLimits{}, remoteWriteRules)
+
+	// hourly limit
+	f(&vmv1beta1.CardinalityLimits{MaxHourlySeries: 1000}, append([]string{"VMAgentHourlySeriesLimitReached"}, remoteWriteRules...))
+
+	// both limits
+	f(&vmv1beta1.CardinalityLimits{MaxHourlySeries: 1000, MaxDailySeries: 5000},
+		append([]string{"VMAgentHourlySeriesLimitReached", "VMAgentDailySeriesLimitReached"}, remoteWriteRules...))
+}
+
+func TestForVMCluster(t *testing.T) {
+	f := func(storage *vmv1beta1.VMStorage, wantRules []string) {
+		t.Helper()
+		cr := &vmv1beta1.VMCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "monitoring"},
+			Spec: vmv1beta1.VMClusterSpec{
+				VMStorage:    storage,
+				DefaultRules: &vmv1beta1.DefaultRules{Enabled: true},
+			},
+		}
+		rule := ForVMCluster(cr)
+		assert.Equal(t, "vmcluster-cluster", rule.Name)
+		for _, g := range rule.Spec.Groups {
+			assert.Nil(t, g.Labels)
+			for _, r := range g.Rules {
+				assert.Contains(t, r.Expr, `job="vmstorage-cluster",namespace="monitoring"`)
+			}
+		}
+		assert.Equal(t, wantRules, ruleNames(rule))
+	}
+
+	// without vmstorage
+	f(nil, nil)
+
+	// churn rate and disk alerts only
+	f(&vmv1beta1.VMStorage{}, []string{"TooHighChurnRate", "TooHighChurnRate24h", "DiskRunsOutOfSpace", "DiskRunsOutOfSpaceIn3Days"})
+
+	// with limits
+	f(&vmv1beta1.VMStorage{
+		CardinalityLimits: &vmv1beta1.CardinalityLimits{MaxHourlySeries: 1000, MaxDailySeries: 5000},
+	}, []string{"TooHighChurnRate", "TooHighChurnRate24h", "VMStorageHourlySeriesLimitReached", "VMStorageDailySeriesLimitReached", "DiskRunsOutOfSpace", "DiskRunsOutOfSpaceIn3Days"})
+}
+
+func TestExcludeRules(t *testing.T) {
+	f := func(globalSelector string, dr *vmv1beta1.DefaultRules, wantRules []string) {
+		t.Helper()
+		if err := Init(false, globalSelector); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		defer Init(false, "") // nolint:errcheck
+		cr := &vmv1beta1.VMSingle{
+			ObjectMeta: metav1.ObjectMeta{Name: "single", Namespace: "default"},
+			Spec:       vmv1beta1.VMSingleSpec{DefaultRules: dr},
+		}
+		groups, err := excludeRules(ForVMSingle(cr).Spec.Groups, dr)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assert.Equal(t, wantRules, ruleNames(&vmv1beta1.VMRule{Spec: vmv1beta1.VMRuleSpec{Groups: groups}}))
+	}
+	all := []string{"TooHighChurnRate", "TooHighChurnRate24h", "DiskRunsOutOfSpace", "DiskRunsOutOfSpaceIn3Days"}
+
+	// no selectors
+	f("", nil, all)
+
+	// exclude by severity
+	f("severity=warning", nil, []string{"DiskRunsOutOfSpace"})
+
+	// exclude by alertname at object
+	f("", &vmv1beta1.DefaultRules{ExcludeSelector: "alertname in (TooHighChurnRate,TooHighChurnRate24h)"}, []string{"DiskRunsOutOfSpace", "DiskRunsOutOfSpaceIn3Days"})
+
+	// global and object selectors
+	f("alertname=DiskRunsOutOfSpace", &vmv1beta1.DefaultRules{ExcludeSelector: "alertname=TooHighChurnRate24h"}, []string{"TooHighChurnRate", "DiskRunsOutOfSpaceIn3Days"})
+}
+
+func TestCreateOrUpdate(t *testing.T) {
+	ctx := context.Background()
+	cr := &vmv1beta1.VMAlertmanager{
+		ObjectMeta: metav1.ObjectMeta{Name: "am", Namespace: "default", UID: "am-uid"},
+	}
+	foreignRule := &vmv1beta1.VMRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "vmalertmanager-other", Namespace: "default"},
+	}
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{foreignRule})
+	getRule := func(name string) (*vmv1beta1.VMRule, error) {
+		var rule vmv1beta1.VMRule
+		err := fclient.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, &rule)
+		return &rule, err
+	}
+
+	// disabled
+	if err := CreateOrUpdate(ctx, fclient, cr.Spec.DefaultRules, nil, ForVMAlertmanager(cr)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := getRule(cr.PrefixedName()); !errors.IsNotFound(err) {
+		t.Fatalf("expected not found error, got: %v", err)
+	}
+
+	// enabled globally
+	if err := Init(true, ""); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer Init(false, "") // nolint:errcheck
+	if err := CreateOrUpdate(ctx, fclient, cr.Spec.DefaultRules, nil, ForVMAlertmanager(cr)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	rule, err := getRule(cr.PrefixedName())
+	if err != nil {
+		t.Fatalf("cannot get default rules: %s", err)
+	}
+	assert.Equal(t, []string{"AlertmanagerMembersInconsistent", "AlertmanagerFailedReload"}, ruleNames(rule))
+
+	// all rules excluded
+	cr.Spec.DefaultRules = &vmv1beta1.DefaultRules{ExcludeSelector: "severity=critical"}
+	if err := CreateOrUpdate(ctx, fclient, cr.Spec.DefaultRules, nil, ForVMAlertmanager(cr)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := getRule(cr.PrefixedName()); !errors.IsNotFound(err) {
+		t.Fatalf("expected not found error, got: %v", err)
+	}
+
+	// enabled at object
+	if err := Init(false, ""); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	cr.Spec.DefaultRules = &vmv1beta1.DefaultRules{Enabled: true}
+	if err := CreateOrUpdate(ctx, fclient, cr.Spec.DefaultRules, nil, ForVMAlertmanager(cr)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := getRule(cr.PrefixedName()); err != nil {
+		t.Fatalf("cannot get default rules: %s", err)
+	}
+
+	// disabled without previously enabled rules, VMRule isn't looked up
+	cr.Spec.DefaultRules = nil
+	if err := CreateOrUpdate(ctx, fclient, cr.Spec.DefaultRules, nil, ForVMAlertmanager(cr)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := getRule(cr.PrefixedName()); err != nil {
+		t.Fatalf("VMRule must be kept without previously enabled rules: %s", err)
+	}
+
+	// disabled after previously enabled rules
+	if err := CreateOrUpdate(ctx, fclient, cr.Spec.DefaultRules, &vmv1beta1.DefaultRules{Enabled: true}, ForVMAlertmanager(cr)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := getRule(cr.PrefixedName()); !errors.IsNotFound(err) {
+		t.Fatalf("expected not found error, got: %v", err)
+	}
+
+	// VMRule not owned by object is kept
+	other := &vmv1beta1.VMAlertmanager{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default", UID: "other-uid"},
+		Spec:       vmv1beta1.VMAlertmanagerSpec{DefaultRules: &vmv1beta1.DefaultRules{ExcludeSelector: "severity=critical"}},
+	}
+	if err := CreateOrUpdate(ctx, fclient, other.Spec.DefaultRules, nil, ForVMAlertmanager(other)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := getRule(foreignRule.Name); err != nil {
+		t.Fatalf("VMRule not owned by object must be kept: %s", err)
+	}
+}
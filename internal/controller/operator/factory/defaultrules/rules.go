@@ -0,0 +1,238 @@
+package defaultrules
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+// seriesLimitUsageThreshold defines ratio of current series to the limit, which triggers alert
+const seriesLimitUsageThreshold = 0.9
+
+// ForVMAgent builds VMRule with default rules for remote write and cardinality limits of vmagent
+func ForVMAgent(cr *vmv1beta1.VMAgent) *vmv1beta1.VMRule {
+	selector := jobSelector(cr.PrefixedName(), cr.Namespace)
+	var groups []vmv1beta1.RuleGroup
+	if limits := cr.Spec.CardinalityLimits; limits != nil {
+		var rules []vmv1beta1.Rule
+		if limits.MaxHourlySeries > 0 {
+			rules = append(rules, seriesLimitRule("VMAgentHourlySeriesLimitReached", "vmagent_hourly_series_limit", "hourly", "remoteWrite.maxHourlySeries", selector))
+		}
+		if limits.MaxDailySeries > 0 {
+			rules = append(rules, seriesLimitRule("VMAgentDailySeriesLimitReached", "vmagent_daily_series_limit", "daily", "remoteWrite.maxDailySeries", selector))
+		}
+		if len(rules) > 0 {
+			groups = append(groups, vmv1beta1.RuleGroup{Name: "vmagent-cardinality", Rules: rules})
+		}
+	}
+	groups = append(groups, vmv1beta1.RuleGroup{
+		Name: "vmagent-remote-write",
+		Rules: []vmv1beta1.Rule{
+			{
+				Record: "job:vmagent_remotewrite_pending_data_bytes:sum",
+				Expr:   fmt.Sprintf(`sum(vmagent_remotewrite_pending_data_bytes{%s}) by(job, url)`, selector),
+			},
+			{
+				Alert:  "VMAgentRemoteWriteLag",
+				Expr:   fmt.Sprintf(`sum(deriv(vmagent_remotewrite_pending_data_bytes{%s}[15m])) by(job, instance, url) > 0`, selector),
+				For:    "30m",
+				Labels: map[string]string{"severity": "warning"},
+				Annotations: map[string]string{
+					"summary":     "Remote write to {{ $labels.url }} lags behind at {{ $labels.instance }}",
+					"description": "Pending data of remote write grows for the last 30m. vmagent cannot send data to remote storage in time, check remote storage availability and remote write connection saturation.",
+				},
+			},
+			{
+				Alert:  "PersistentQueueIsDroppingData",
+				Expr:   fmt.Sprintf(`sum(increase(vm_persistentqueue_bytes_dropped_total{%s}[5m])) by(job, instance) > 0`, selector),
+				For:    "10m",
+				Labels: map[string]string{"severity": "critical"},
+				Annotations: map[string]string{
+					"summary":     "Instance {{ $labels.instance }} is dropping data from persistent queue",
+					"description": "vmagent dropped data from persistent queue, since it reached the max size. Data is lost.",
+				},
+			},
+		},
+	})
+	return newRule(cr.PrefixedName(), cr.Namespace, cr.AllLabels(), cr.AsOwner(), cr.Spec.DefaultRules, groups)
+}
+
+// ForVMCluster builds VMRule with default rules for churn rate, cardinality limits and disk space of vmstorage
+// VMRule has no rules if vmcluster has no vmstorage
+func ForVMCluster(cr *vmv1beta1.VMCluster) *vmv1beta1.VMRule {
+	var groups []vmv1beta1.RuleGroup
+	if cr.Spec.VMStorage != nil {
+		selector := jobSelector(cr.Spec.VMStorage.GetNameWithPrefix(cr.Name), cr.Namespace)
+		rules := churnRateRules(selector)
+		if limits := cr.Spec.VMStorage.CardinalityLimits; limits != nil {
+			if limits.MaxHourlySeries > 0 {
+				rules = append(rules, seriesLimitRule("VMStorageHourlySeriesLimitReached", "vm_hourly_series_limit", "hourly", "storage.maxHourlySeries", selector))
+			}
+			if limits.MaxDailySeries > 0 {
+				rules = append(rules, seriesLimitRule("VMStorageDailySeriesLimitReached", "vm_daily_series_limit", "daily", "storage.maxDailySeries", selector))
+			}
+		}
+		groups = append(groups,
+			vmv1beta1.RuleGroup{Name: "vmcluster-cardinality", Rules: rules},
+			vmv1beta1.RuleGroup{Name: "vmstorage-disk", Rules: diskSpaceRules(selector)},
+		)
+	}
+	return newRule(cr.PrefixedName(), cr.Namespace, cr.AllLabels(), cr.AsOwner(), cr.Spec.DefaultRules, groups)
+}
+
+// ForVMSingle builds VMRule with default rules for churn rate and disk space of vmsingle
+func ForVMSingle(cr *vmv1beta1.VMSingle) *vmv1beta1.VMRule {
+	selector := jobSelector(cr.PrefixedName(), cr.Namespace)
+	groups := []vmv1beta1.RuleGroup{
+		{Name: "vmsingle-cardinality", Rules: churnRateRules(selector)},
+		{Name: "vmsingle-disk", Rules: diskSpaceRules(selector)},
+	}
+	return newRule(cr.PrefixedName(), cr.Namespace, cr.AllLabels(), cr.AsOwner(), cr.Spec.DefaultRules, groups)
+}
+
+// ForVMAlertmanager builds VMRule with default rules for cluster state and config reloads of alertmanager
+func ForVMAlertmanager(cr *vmv1beta1.VMAlertmanager) *vmv1beta1.VMRule {
+	selector := jobSelector(cr.PrefixedName(), cr.Namespace)
+	groups := []vmv1beta1.RuleGroup{
+		{
+			Name: "vmalertmanager-cluster",
+			Rules: []vmv1beta1.Rule{
+				{
+					Alert: "AlertmanagerMembersInconsistent",
+					Expr: fmt.Sprintf(`max_over_time(alertmanager_cluster_members{%[1]s}[5m])
+< on(job) group_left()
+count(max_over_time(alertmanager_cluster_members{%[1]s}[5m])) by(job)`, selector),
+					For:    "15m",
+					Labels: map[string]string{"severity": "critical"},
+					Annotations: map[string]string{
+						"summary":     "Alertmanager cluster is split at {{ $labels.job }}",
+						"description": "Alertmanager instance {{ $labels.instance }} has not found all other members of the cluster. Notifications may be duplicated.",
+					},
+				},
+				{
+					Alert:  "AlertmanagerFailedReload",
+					Expr:   fmt.Sprintf(`max_over_time(alertmanager_config_last_reload_successful{%s}[5m]) == 0`, selector),
+					For:    "10m",
+					Labels: map[string]string{"severity": "critical"},
+					Annotations: map[string]string{
+						"summary":     "Configuration reload has failed at {{ $labels.instance }}",
+						"description": "Alertmanager runs with outdated configuration, since configuration reload has failed.",
+					},
+				},
+			},
+		},
+	}
+	return newRule(cr.PrefixedName(), cr.Namespace, cr.AllLabels(), cr.AsOwner(), cr.Spec.DefaultRules, groups)
+}
+
+func jobSelector(job, namespace string) string {
+	return fmt.Sprintf(`job=%q,namespace=%q`, job, namespace)
+}
+
+func churnRateRules(selector string) []vmv1beta1.Rule {
+	return []vmv1beta1.Rule{
+		{
+			Alert: "TooHighChurnRate",
+			Expr: fmt.Sprintf(`(
+  sum(rate(vm_new_timeseries_created_total{%[1]s}[5m])) by(job)
+  /
+  sum(rate(vm_rows_added_to_storage_total{%[1]s}[5m])) by(job)
+) > 0.1`, selector),
+			For:    "15m",
+			Labels: map[string]string{"severity": "warning"},
+			Annotations: map[string]string{
+				"summary":     "Churn rate is more than 10% for the last 15m",
+				"description": "VM constantly creates new time series at {{ $labels.job }}. This effect is known as Churn Rate. High Churn Rate is tightly connected with database performance and may result in unexpected OOM's or slow queries.",
+			},
+		},
+		{
+			Alert: "TooHighChurnRate24h",
+			Expr: fmt.Sprintf(`sum(increase(vm_new_timeseries_created_total{%[1]s}[24h])) by(job)
+>
+(sum(vm_cache_entries{%[1]s,type="storage/hour_metric_ids"}) by(job) * 3)`, selector),
+			For:    "15m",
+			Labels: map[string]string{"severity": "warning"},
+			Annotations: map[string]string{
+				"summary":     "Too high number of new series created over last 24h",
+				"description": "The number of created new time series over last 24h is 3x times higher than current number of active series at {{ $labels.job }}. This effect is known as Churn Rate. High Churn Rate is tightly connected with database performance and may result in unexpected OOM's or slow queries.",
+			},
+		},
+	}
+}
+
+func diskSpaceRules(selector string) []vmv1beta1.Rule {
+	return []vmv1beta1.Rule{
+		{
+			Alert: "DiskRunsOutOfSpace",
+			Expr: fmt.Sprintf(`sum(vm_data_size_bytes{%[1]s}) by(job, instance)
+/
+(
+  sum(vm_free_disk_space_bytes{%[1]s}) by(job, instance)
+  +
+  sum(vm_data_size_bytes{%[1]s}) by(job, instance)
+) > 0.8`, selector),
+			For:    "30m",
+			Labels: map[string]string{"severity": "critical"},
+			Annotations: map[string]string{
+				"summary":     "Instance {{ $labels.instance }} will run out of disk space soon",
+				"description": "Disk utilisation on instance {{ $labels.instance }} is more than 80%. Having less than 20% of free disk space could cripple merge processes and overall performance.",
+			},
+		},
+		{
+			Alert: "DiskRunsOutOfSpaceIn3Days",
+			Expr: fmt.Sprintf(`sum(vm_free_disk_space_bytes{%[1]s}) by(job, instance)
+/
+(
+  sum(rate(vm_rows_added_to_storage_total{%[1]s}[1d])) by(job, instance)
+  *
+  scalar(sum(vm_data_size_bytes{%[1]s,type!~"indexdb.*"}) / sum(vm_rows{%[1]s,type!~"indexdb.*"}))
+) < 3 * 24 * 3600 > 0`, selector),
+			For:    "30m",
+			Labels: map[string]string{"severity": "warning"},
+			Annotations: map[string]string{
+				"summary":     "Instance {{ $labels.instance }} will run out of disk space in 3 days",
+				"description": "Taking into account current ingestion rate, free disk space will be enough only for {{ $value | humanizeDuration }} on instance {{ $labels.instance }}.",
+			},
+		},
+	}
+}
+
+func seriesLimitRule(alert, metricPrefix, period, flag, selector string) vmv1beta1.Rule {
+	return vmv1beta1.Rule{
+		Alert: alert,
+		Expr: fmt.Sprintf(`(%[1]s_current_series{%[2]s} / %[1]s_max_series{%[2]s}) > %[3]g`,
+			metricPrefix, selector, seriesLimitUsageThreshold),
+		For:    "15m",
+		Labels: map[string]string{"severity": "critical"},
+		Annotations: map[string]string{
+			"summary":     fmt.Sprintf("Instance {{ $labels.instance }} reached %.0f%% of the %s series limit", seriesLimitUsageThreshold*100, period),
+			"description": fmt.Sprintf("The number of unique %s series is close to the limit set via -%s flag. Samples of new time series are dropped after reaching the limit.", period, flag),
+		},
+	}
+}
+
+func newRule(name, namespace string, crLabels map[string]string, owners []metav1.OwnerReference, dr *vmv1beta1.DefaultRules, groups []vmv1beta1.RuleGroup) *vmv1beta1.VMRule {
+	var ruleLabels map[string]string
+	if dr != nil {
+		ruleLabels = dr.Labels
+		if len(dr.AdditionalRuleLabels) > 0 {
+			for i := range groups {
+				groups[i].Labels = dr.AdditionalRuleLabels
+			}
+		}
+	}
+	return &vmv1beta1.VMRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       namespace,
+			Labels:          labels.Merge(ruleLabels, crLabels),
+			OwnerReferences: owners,
+		},
+		Spec: vmv1beta1.VMRuleSpec{
+			Groups: groups,
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package events
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Reasons of events emitted by operator
+const (
+	ReasonReconcile                  = "ReconcileEvent"
+	ReasonReconcileError             = "ReconcilationError"
+	ReasonValidationFailed           = "ValidationFailed"
+	ReasonConfigUpdated              = "ConfigUpdated"
+	ReasonRollingUpdateStarted       = "RollingUpdateStarted"
+	ReasonRollingUpdateFinished      = "RollingUpdateFinished"
+	ReasonRollingUpdatePaused        = "RollingUpdatePaused"
+	ReasonChildObjectError           = "ChildObjectError"
+	ReasonChildObjectUpdated         = "ChildObjectUpdated"
+	ReasonUnknownImageVersion        = "UnknownImageVersion"
+	ReasonDegraded                   = "Degraded"
+	ReasonStorageDrainStarted        = "StorageDrainStarted"
+	ReasonStorageDrainFinished       = "StorageDrainFinished"
+	ReasonStorageDrainCancelled      = "StorageDrainCancelled"
+	ReasonReconcileRequested         = "ReconcileRequested"
+	ReasonDestructiveChangeBlocked   = "DestructiveChangeBlocked"
+	ReasonDestructiveChangeConfirmed = "DestructiveChangeConfirmed"
+	ReasonMaintenanceWindowDeferred  = "MaintenanceWindowDeferred"
+	ReasonRuleTestPassed             = "RuleTestPassed"
+	ReasonRuleTestFailed             = "RuleTestFailed"
+	ReasonMaintenanceTaskStarted     = "MaintenanceTaskStarted"
+	ReasonMaintenanceTaskSucceeded   = "MaintenanceTaskSucceeded"
+	ReasonMaintenanceTaskFailed      = "MaintenanceTaskFailed"
+	ReasonDataMigrationStarted       = "DataMigrationStarted"
+	ReasonDataMigrationSucceeded     = "DataMigrationSucceeded"
+	ReasonDataMigrationFailed        = "DataMigrationFailed"
+	ReasonDriftDetected              = "DriftDetected"
+	ReasonConfigValidationFailed     = "ConfigValidationFailed"
+	ReasonLicenseExpiresSoon         = "LicenseExpiresSoon"
+	ReasonLicenseExpired             = "LicenseExpired"
+)
+
+var globalRecorder record.EventRecorder
+
+type contextObjectKey string
+
+var contextKey contextObjectKey = "operator_event_object_key"
+
+// Init sets recorder used for events emission
+func Init(r record.EventRecorder) {
+	globalRecorder = r
+}
+
+// AddToContext adds given object into context.
+// Events emitted with this context are attached to the object.
+func AddToContext(ctx context.Context, object client.Object) context.Context {
+	return context.WithValue(ctx, contextKey, object)
+}
+
+// ObjectFromContext returns object added with AddToContext or nil
+func ObjectFromContext(ctx context.Context) client.Object {
+	object, _ := ctx.Value(contextKey).(client.Object)
+	return object
+}
+
+// Normal emits event with Normal type for object from context
+func Normal(ctx context.Context, reason, messageFmt string, args ...interface{}) {
+	emit(ctx, corev1.EventTypeNormal, reason, messageFmt, args...)
+}
+
+// Warning emits event with Warning type for object from context
+func Warning(ctx context.Context, reason, messageFmt string, args ...interface{}) {
+	emit(ctx, corev1.EventTypeWarning, reason, messageFmt, args...)
+}
+
+func emit(ctx context.Context, eventType, reason, messageFmt string, args ...interface{}) {
+	if globalRecorder == nil {
+		return
+	}
+	object, ok := ctx.Value(contextKey).(client.Object)
+	if !ok || object == nil {
+		return
+	}
+	// object wasn't fetched from API server
+	if object.GetName() == "" || object.GetUID() == "" {
+		return
+	}
+	globalRecorder.Eventf(object, eventType, reason, messageFmt, args...)
+}
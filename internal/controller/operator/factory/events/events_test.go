@@ -0,0 +1,72 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestObjectFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, ObjectFromContext(ctx))
+
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "default"}}
+	assert.Equal(t, client.Object(cm), ObjectFromContext(AddToContext(ctx, cm)))
+
+	// nested context keeps the latest object
+	other := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}}
+	assert.Equal(t, client.Object(other), ObjectFromContext(AddToContext(AddToContext(ctx, cm), other)))
+}
+
+func TestEmit(t *testing.T) {
+	defer Init(nil)
+	f := func(object client.Object, emit func(ctx context.Context), wantEvent string) {
+		t.Helper()
+		recorder := record.NewFakeRecorder(10)
+		Init(recorder)
+		ctx := context.Background()
+		if object != nil {
+			ctx = AddToContext(ctx, object)
+		}
+		emit(ctx)
+		var gotEvent string
+		select {
+		case gotEvent = <-recorder.Events:
+		default:
+		}
+		assert.Equal(t, wantEvent, gotEvent)
+	}
+	normal := func(ctx context.Context) {
+		Normal(ctx, ReasonReconcile, "reconciled %s", "object")
+	}
+	warning := func(ctx context.Context) {
+		Warning(ctx, ReasonDegraded, "degraded %s", "object")
+	}
+	fetched := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "default", UID: "cm-uid"}}
+
+	// normal event
+	f(fetched, normal, "Normal ReconcileEvent reconciled object")
+
+	// warning event
+	f(fetched, warning, "Warning Degraded degraded object")
+
+	// object is missing at context
+	f(nil, normal, "")
+
+	// object wasn't fetched from API server
+	f(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "default"}}, normal, "")
+}
+
+func TestEmitWithoutRecorder(t *testing.T) {
+	Init(nil)
+	ctx := AddToContext(context.Background(), &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "default", UID: "cm-uid"}})
+	assert.NotPanics(t, func() {
+		Normal(ctx, ReasonReconcile, "reconciled")
+		Warning(ctx, ReasonDegraded, "degraded")
+	})
+}
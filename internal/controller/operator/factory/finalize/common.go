@@ -6,6 +6,8 @@ import (
 	"fmt"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -48,12 +50,41 @@ func patchReplaceFinalizers(ctx context.Context, rclient client.Client, instance
 }
 
 // AddFinalizer adds finalizer to instance if needed.
+// if finalizers are disabled, it removes previously added finalizer instead
 func AddFinalizer(ctx context.Context, rclient client.Client, instance client.Object) error {
+	if config.IsFinalizersDisabled() {
+		return RemoveFinalizer(ctx, rclient, instance)
+	}
 	return vmv1beta1.AddFinalizerAndThen(instance, func(o client.Object) error {
 		return patchReplaceFinalizers(ctx, rclient, o)
 	})
 }
 
+// ChildFinalizers returns finalizers for objects created by operator
+func ChildFinalizers() []string {
+	if config.IsFinalizersDisabled() {
+		return nil
+	}
+	return []string{vmv1beta1.FinalizerName}
+}
+
+// MergeFinalizers conditionally adds vm-operator finalizer to the dst object
+// respectfully merges exist finalizers from src to dst
+// if finalizers are disabled, it drops vm-operator finalizer from dst object
+func MergeFinalizers(dst, src client.Object) {
+	if config.IsFinalizersDisabled() {
+		dstFinalizers := make([]string, 0, len(src.GetFinalizers()))
+		for _, f := range src.GetFinalizers() {
+			if f != vmv1beta1.FinalizerName {
+				dstFinalizers = append(dstFinalizers, f)
+			}
+		}
+		dst.SetFinalizers(dstFinalizers)
+		return
+	}
+	vmv1beta1.AddFinalizer(dst, src)
+}
+
 // RemoveFinalizer removes finalizer from instance if needed.
 func RemoveFinalizer(ctx context.Context, rclient client.Client, instance client.Object) error {
 	return vmv1beta1.RemoveFinalizer(instance, func(o client.Object) error {
@@ -124,6 +155,15 @@ func deleteSA(ctx context.Context, rclient client.Client, crd crdObject) error {
 	if err := removeFinalizeObjByName(ctx, rclient, &corev1.ServiceAccount{}, crd.GetServiceAccountName(), crd.GetNSName()); err != nil {
 		return err
 	}
+	if k8stools.OpenShiftSCC() != "" {
+		sccRBName := k8stools.OpenShiftSCCRoleBindingName(crd.GetServiceAccountName())
+		if err := removeFinalizeObjByName(ctx, rclient, &rbacv1.RoleBinding{}, sccRBName, crd.GetNSName()); err != nil {
+			return err
+		}
+		if err := SafeDelete(ctx, rclient, &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Namespace: crd.GetNSName(), Name: sccRBName}}); err != nil {
+			return err
+		}
+	}
 	return SafeDelete(ctx, rclient, &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: crd.GetNSName(), Name: crd.GetServiceAccountName()}})
 }
 
@@ -0,0 +1,74 @@
+package finalize
+
+import (
+	"context"
+	"testing"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestAddFinalizer(t *testing.T) {
+	tests := []struct {
+		name              string
+		disableFinalizers bool
+		finalizers        []string
+		wantFinalizers    []string
+	}{
+		{
+			name:           "add finalizer",
+			finalizers:     []string{"some-other/finalizer"},
+			wantFinalizers: []string{"some-other/finalizer", vmv1beta1.FinalizerName},
+		},
+		{
+			name:           "keep exist finalizer",
+			finalizers:     []string{vmv1beta1.FinalizerName},
+			wantFinalizers: []string{vmv1beta1.FinalizerName},
+		},
+		{
+			name:              "remove finalizer if disabled",
+			disableFinalizers: true,
+			finalizers:        []string{vmv1beta1.FinalizerName, "some-other/finalizer"},
+			wantFinalizers:    []string{"some-other/finalizer"},
+		},
+		{
+			name:              "do not add finalizer if disabled",
+			disableFinalizers: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.SetFinalizersDisabled(tt.disableFinalizers)
+			t.Cleanup(func() { config.SetFinalizersDisabled(false) })
+			ctx := context.TODO()
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "base",
+					Namespace:  "default",
+					Finalizers: tt.finalizers,
+				},
+			}
+			fclient := k8stools.GetTestClientWithObjects([]runtime.Object{cm.DeepCopy()})
+			if err := AddFinalizer(ctx, fclient, cm); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			var got corev1.ConfigMap
+			if err := fclient.Get(ctx, types.NamespacedName{Namespace: cm.Namespace, Name: cm.Name}, &got); err != nil {
+				t.Fatalf("cannot get configmap: %s", err)
+			}
+			if len(got.Finalizers) != len(tt.wantFinalizers) {
+				t.Fatalf("unexpected finalizers, got: %v, want: %v", got.Finalizers, tt.wantFinalizers)
+			}
+			for i := range got.Finalizers {
+				if got.Finalizers[i] != tt.wantFinalizers[i] {
+					t.Fatalf("unexpected finalizers, got: %v, want: %v", got.Finalizers, tt.wantFinalizers)
+				}
+			}
+		})
+	}
+}
@@ -6,9 +6,11 @@ import (
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/config"
 	appsv1 "k8s.io/api/apps/v1"
+	v2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -21,6 +23,9 @@ func OnVMAgentDelete(ctx context.Context, rclient client.Client, crd *vmv1beta1.
 	if err := removeFinalizeObjByName(ctx, rclient, &appsv1.StatefulSet{}, crd.PrefixedName(), crd.Namespace); err != nil {
 		return err
 	}
+	if err := removeFinalizeObjByName(ctx, rclient, &appsv1.DaemonSet{}, crd.PrefixedName(), crd.Namespace); err != nil {
+		return err
+	}
 
 	if err := RemoveOrphanedDeployments(ctx, rclient, crd, nil); err != nil {
 		return err
@@ -41,6 +46,10 @@ func OnVMAgentDelete(ctx context.Context, rclient client.Client, crd *vmv1beta1.
 	if err := removeFinalizeObjByName(ctx, rclient, &corev1.Secret{}, crd.PrefixedName(), crd.Namespace); err != nil {
 		return err
 	}
+	// config configmap created with generatedConfigStorage: configmap
+	if err := removeFinalizeObjByName(ctx, rclient, &corev1.ConfigMap{}, crd.PrefixedName(), crd.Namespace); err != nil {
+		return err
+	}
 
 	// check secret for tls assests
 	if err := removeFinalizeObjByName(ctx, rclient, &corev1.Secret{}, crd.TLSAssetName(), crd.Namespace); err != nil {
@@ -61,19 +70,14 @@ func OnVMAgentDelete(ctx context.Context, rclient client.Client, crd *vmv1beta1.
 			return err
 		}
 	}
-	// remove vmagents service discovery rbac.
-	if config.IsClusterWideAccessAllowed() {
-		if err := removeFinalizeObjByName(ctx, rclient, &rbacv1.ClusterRoleBinding{}, crd.GetClusterRoleName(), crd.GetNSName()); err != nil {
-			return err
-		}
-		if err := removeFinalizeObjByName(ctx, rclient, &rbacv1.ClusterRole{}, crd.GetClusterRoleName(), crd.GetNSName()); err != nil {
+	if crd.Spec.HPA != nil {
+		if err := removeFinalizeObjByName(ctx, rclient, &v2.HorizontalPodAutoscaler{}, crd.PrefixedName(), crd.Namespace); err != nil {
 			return err
 		}
-		if err := SafeDelete(ctx, rclient, &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: crd.GetClusterRoleName(), Namespace: crd.GetNSName()}}); err != nil {
-			return err
-		}
-
-		if err := SafeDelete(ctx, rclient, &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: crd.GetClusterRoleName(), Namespace: crd.GetNSName()}}); err != nil {
+	}
+	// remove vmagents service discovery rbac.
+	if config.IsClusterWideAccessAllowed() {
+		if err := removeVMAgentClusterRBAC(ctx, rclient, crd); err != nil {
 			return err
 		}
 	} else {
@@ -113,3 +117,40 @@ func OnVMAgentDelete(ctx context.Context, rclient client.Client, crd *vmv1beta1.
 	}
 	return nil
 }
+
+// OnVMAgentDeleted removes cluster-scoped rbac of already deleted vmagent
+// kubernetes garbage collector cannot remove it by owner reference, since cluster-scoped objects cannot be owned by namespaced objects
+// it must be called only if operator finalizers are disabled, otherwise it's done by OnVMAgentDelete
+func OnVMAgentDeleted(ctx context.Context, rclient client.Client, nsn types.NamespacedName) error {
+	if !config.IsClusterWideAccessAllowed() {
+		return nil
+	}
+	// cluster-scoped objects are named by vmagent name only
+	// and must be kept, if vmagent with the same name exists at another namespace
+	var vmagents vmv1beta1.VMAgentList
+	if err := rclient.List(ctx, &vmagents); err != nil {
+		return err
+	}
+	for _, item := range vmagents.Items {
+		if item.Name == nsn.Name {
+			return nil
+		}
+	}
+	crd := &vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: nsn.Name, Namespace: nsn.Namespace}}
+	return removeVMAgentClusterRBAC(ctx, rclient, crd)
+}
+
+func removeVMAgentClusterRBAC(ctx context.Context, rclient client.Client, crd *vmv1beta1.VMAgent) error {
+	// cluster-scoped objects has no namespace
+	if err := removeFinalizeObjByName(ctx, rclient, &rbacv1.ClusterRoleBinding{}, crd.GetClusterRoleName(), ""); err != nil {
+		return err
+	}
+	if err := removeFinalizeObjByName(ctx, rclient, &rbacv1.ClusterRole{}, crd.GetClusterRoleName(), ""); err != nil {
+		return err
+	}
+	if err := SafeDelete(ctx, rclient, &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: crd.GetClusterRoleName()}}); err != nil {
+		return err
+	}
+
+	return SafeDelete(ctx, rclient, &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: crd.GetClusterRoleName()}})
+}
@@ -0,0 +1,58 @@
+package finalize
+
+import (
+	"context"
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestOnVMAgentDeleted(t *testing.T) {
+	f := func(predefinedObjects []runtime.Object, wantDeleted bool) {
+		t.Helper()
+		ctx := context.TODO()
+		fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		nsn := types.NamespacedName{Namespace: "default", Name: "base"}
+		if err := OnVMAgentDeleted(ctx, fclient, nsn); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		name := (&vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: nsn.Name}}).GetClusterRoleName()
+		for _, obj := range []client.Object{&rbacv1.ClusterRole{}, &rbacv1.ClusterRoleBinding{}} {
+			err := fclient.Get(ctx, types.NamespacedName{Name: name}, obj)
+			if wantDeleted != k8serrors.IsNotFound(err) {
+				t.Fatalf("unexpected state of %T, want deleted=%v, got err: %v", obj, wantDeleted, err)
+			}
+		}
+	}
+	clusterRBAC := func() []runtime.Object {
+		return []runtime.Object{
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{
+				Name:       "monitoring:vmagent-cluster-access-base",
+				Finalizers: []string{vmv1beta1.FinalizerName},
+			}},
+			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{
+				Name:       "monitoring:vmagent-cluster-access-base",
+				Finalizers: []string{vmv1beta1.FinalizerName},
+			}},
+		}
+	}
+
+	// remove cluster-scoped objects with finalizers
+	f(clusterRBAC(), true)
+
+	// keep objects of vmagent with the same name at another namespace
+	f(append(clusterRBAC(), &vmv1beta1.VMAgent{
+		ObjectMeta: metav1.ObjectMeta{Name: "base", Namespace: "other"},
+	}), false)
+
+	// objects are already removed
+	f(nil, true)
+}
@@ -17,6 +17,10 @@ func OnVMAlertDelete(ctx context.Context, rclient client.Client, crd *vmv1beta1.
 	if err := removeFinalizeObjByName(ctx, rclient, &appsv1.Deployment{}, crd.PrefixedName(), crd.Namespace); err != nil {
 		return err
 	}
+	// deployments of shards
+	if err := RemoveOrphanedDeployments(ctx, rclient, crd, nil); err != nil {
+		return err
+	}
 	// check service
 	if err := removeFinalizeObjByName(ctx, rclient, &corev1.Service{}, crd.PrefixedName(), crd.Namespace); err != nil {
 		return err
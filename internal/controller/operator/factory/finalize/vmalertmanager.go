@@ -24,6 +24,11 @@ func OnVMAlertManagerDelete(ctx context.Context, rclient client.Client, crd *vmv
 			return err
 		}
 	}
+	if crd.Spec.GossipService != nil {
+		if err := removeFinalizeObjByName(ctx, rclient, &v1.Service{}, crd.GossipServiceName(), crd.Namespace); err != nil {
+			return err
+		}
+	}
 
 	// check config secret finalizer.
 	if err := removeFinalizeObjByName(ctx, rclient, &v1.Secret{}, crd.ConfigSecretName(), crd.Namespace); err != nil {
@@ -33,6 +33,11 @@ func OnVMAuthDelete(ctx context.Context, rclient client.Client, crd *vmv1beta1.V
 		return err
 	}
 
+	// check canary of config rollout
+	if err := removeFinalizeObjByName(ctx, rclient, &appsv1.Deployment{}, crd.CanaryName(), crd.Namespace); err != nil {
+		return err
+	}
+
 	// check PDB
 	if crd.Spec.PodDisruptionBudget != nil {
 		if err := finalizePBD(ctx, rclient, crd); err != nil {
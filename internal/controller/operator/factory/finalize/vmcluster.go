@@ -5,12 +5,15 @@ import (
 	"fmt"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 
 	appsv1 "k8s.io/api/apps/v1"
 	v2 "k8s.io/api/autoscaling/v2"
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -22,9 +25,15 @@ func OnVMInsertDelete(ctx context.Context, rclient client.Client, crd *vmv1beta1
 		Name:      obj.GetNameWithPrefix(crd.Name),
 	}
 	objsToRemove := []client.Object{
-		&appsv1.Deployment{ObjectMeta: objMeta},
 		&v1.Service{ObjectMeta: objMeta},
 	}
+	if obj.IsStatefulSet() {
+		objsToRemove = append(objsToRemove,
+			&appsv1.StatefulSet{ObjectMeta: objMeta},
+			&v1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: crd.Namespace, Name: obj.GetHeadlessServiceName(crd.Name)}})
+	} else {
+		objsToRemove = append(objsToRemove, &appsv1.Deployment{ObjectMeta: objMeta})
+	}
 	if obj.ServiceSpec != nil && !obj.ServiceSpec.UseAsDefault {
 		objsToRemove = append(objsToRemove, &v1.Service{
 			ObjectMeta: metav1.ObjectMeta{
@@ -58,9 +67,13 @@ func OnVMSelectDelete(ctx context.Context, rclient client.Client, crd *vmv1beta1
 		Name:      obj.GetNameWithPrefix(crd.Name),
 	}
 	objsToRemove := []client.Object{
-		&appsv1.StatefulSet{ObjectMeta: objMeta},
 		&v1.Service{ObjectMeta: objMeta},
 	}
+	if obj.IsDeployment() {
+		objsToRemove = append(objsToRemove, &appsv1.Deployment{ObjectMeta: objMeta})
+	} else {
+		objsToRemove = append(objsToRemove, &appsv1.StatefulSet{ObjectMeta: objMeta})
+	}
 	if obj.ServiceSpec != nil && !obj.ServiceSpec.UseAsDefault {
 		objsToRemove = append(objsToRemove, &v1.Service{
 			ObjectMeta: metav1.ObjectMeta{
@@ -83,6 +96,29 @@ func OnVMSelectDelete(ctx context.Context, rclient client.Client, crd *vmv1beta1
 			return fmt.Errorf("failed to remove object=%s: %w", objToRemove.GetObjectKind().GroupVersionKind(), err)
 		}
 	}
+	if obj.Ingress != nil {
+		if err := OnVMSelectIngressDelete(ctx, rclient, objMeta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnVMSelectIngressDelete removes ingress of vmselect component or route at OpenShift platform
+func OnVMSelectIngressDelete(ctx context.Context, rclient client.Client, objMeta metav1.ObjectMeta) error {
+	if k8stools.IsOpenShift() {
+		route := &unstructured.Unstructured{}
+		route.SetGroupVersionKind(k8stools.RouteGVK)
+		route.SetName(objMeta.Name)
+		route.SetNamespace(objMeta.Namespace)
+		if err := SafeDelete(ctx, rclient, route); err != nil {
+			return fmt.Errorf("failed to remove vmselect route: %w", err)
+		}
+		return nil
+	}
+	if err := SafeDeleteWithFinalizer(ctx, rclient, &networkingv1.Ingress{ObjectMeta: objMeta}); err != nil {
+		return fmt.Errorf("failed to remove vmselect ingress: %w", err)
+	}
 	return nil
 }
 
@@ -0,0 +1,24 @@
+package finalize
+
+import (
+	"context"
+	"fmt"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	batchv1 "k8s.io/api/batch/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// OnVMDataMigrationDelete deletes all vmdatamigration related resources
+func OnVMDataMigrationDelete(ctx context.Context, rclient client.Client, crd *vmv1beta1.VMDataMigration) error {
+	var jobs batchv1.JobList
+	if err := rclient.List(ctx, &jobs, client.InNamespace(crd.Namespace), client.MatchingLabels(crd.SelectorLabels())); err != nil {
+		return fmt.Errorf("cannot list jobs of vmdatamigration: %w", err)
+	}
+	for i := range jobs.Items {
+		if err := RemoveFinalizer(ctx, rclient, &jobs.Items[i]); err != nil {
+			return err
+		}
+	}
+	return removeFinalizeObjByName(ctx, rclient, crd, crd.Name, crd.Namespace)
+}
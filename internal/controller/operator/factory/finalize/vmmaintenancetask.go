@@ -0,0 +1,17 @@
+package finalize
+
+import (
+	"context"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	batchv1 "k8s.io/api/batch/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// OnVMMaintenanceTaskDelete deletes all vmmaintenancetask related resources
+func OnVMMaintenanceTaskDelete(ctx context.Context, rclient client.Client, crd *vmv1beta1.VMMaintenanceTask) error {
+	if err := removeFinalizeObjByName(ctx, rclient, &batchv1.Job{}, crd.PrefixedName(), crd.Namespace); err != nil {
+		return err
+	}
+	return removeFinalizeObjByName(ctx, rclient, crd, crd.Name, crd.Namespace)
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 
 	"github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/secretstore"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -14,8 +17,27 @@ func OnVMUserDelete(ctx context.Context, rclient client.Client, crd *v1beta1.VMU
 		return err
 	}
 
+	if err := secretstore.Delete(ctx, secretstore.Key(crd.Namespace, crd.SecretName())); err != nil {
+		return err
+	}
+
 	if err := removeFinalizeObjByName(ctx, rclient, crd, crd.Name, crd.Namespace); err != nil {
 		return err
 	}
 	return nil
 }
+
+// OnVMUserDeleted removes generated credentials of already deleted vmuser
+// it must be called only if operator finalizers are disabled, otherwise it's done by OnVMUserDelete
+// it removes finalizer from generated secret at vmuser namespace, deletes the secret
+// and removes credentials stored at external secret store
+func OnVMUserDeleted(ctx context.Context, rclient client.Client, nsn types.NamespacedName) error {
+	crd := &v1beta1.VMUser{ObjectMeta: metav1.ObjectMeta{Name: nsn.Name, Namespace: nsn.Namespace}}
+	if err := removeFinalizeObjByName(ctx, rclient, &v1.Secret{}, crd.SecretName(), crd.Namespace); err != nil {
+		return err
+	}
+	if err := SafeDelete(ctx, rclient, &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: crd.SecretName(), Namespace: crd.Namespace}}); err != nil {
+		return err
+	}
+	return secretstore.Delete(ctx, secretstore.Key(crd.Namespace, crd.SecretName()))
+}
@@ -0,0 +1,83 @@
+package finalize
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/secretstore"
+)
+
+func TestOnVMUserDeleted(t *testing.T) {
+	var mu sync.Mutex
+	var deletedPaths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method == http.MethodDelete {
+			deletedPaths = append(deletedPaths, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("test-token"), 0o600); err != nil {
+		t.Fatalf("cannot write token file: %s", err)
+	}
+	if err := secretstore.Init(secretstore.BackendVault, 0, secretstore.VaultConfig{
+		Address:   srv.URL,
+		MountPath: "secret",
+		TokenFile: tokenFile,
+	}, secretstore.AWSConfig{}); err != nil {
+		t.Fatalf("cannot init secret store: %s", err)
+	}
+	t.Cleanup(func() {
+		_ = secretstore.Init(secretstore.BackendKubernetes, 0, secretstore.VaultConfig{}, secretstore.AWSConfig{})
+	})
+
+	f := func(predefinedObjects []runtime.Object) {
+		t.Helper()
+		mu.Lock()
+		deletedPaths = nil
+		mu.Unlock()
+		ctx := context.TODO()
+		fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		nsn := types.NamespacedName{Namespace: "default", Name: "base"}
+		if err := OnVMUserDeleted(ctx, fclient, nsn); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		var s corev1.Secret
+		if err := fclient.Get(ctx, types.NamespacedName{Namespace: nsn.Namespace, Name: "vmuser-base"}, &s); !k8serrors.IsNotFound(err) {
+			t.Fatalf("expected generated secret to be deleted, got err: %v", err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if len(deletedPaths) != 1 || deletedPaths[0] != "/v1/secret/metadata/default/vmuser-base" {
+			t.Fatalf("expected credentials to be deleted from secret store, got deleted paths: %v", deletedPaths)
+		}
+	}
+
+	// remove generated secret with finalizer
+	f([]runtime.Object{
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:       "vmuser-base",
+			Namespace:  "default",
+			Finalizers: []string{vmv1beta1.FinalizerName},
+		}},
+	})
+
+	// secret is already removed
+	f(nil)
+}
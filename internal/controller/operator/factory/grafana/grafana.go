@@ -0,0 +1,234 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// grafana-operator objects are managed as unstructured objects, since grafana-operator isn't a part of kubernetes API
+var (
+	datasourceGVK = schema.GroupVersionKind{Group: "grafana.integreatly.org", Version: "v1beta1", Kind: "GrafanaDatasource"}
+	dashboardGVK  = schema.GroupVersionKind{Group: "grafana.integreatly.org", Version: "v1beta1", Kind: "GrafanaDashboard"}
+)
+
+// Supported integration modes
+const (
+	// ModeOperator provisions GrafanaDatasource and GrafanaDashboard objects of grafana-operator
+	ModeOperator = "grafana-operator"
+	// ModeSidecar provisions datasources with ConfigMaps discovered by grafana sidecar
+	ModeSidecar = "sidecar"
+)
+
+const (
+	// SidecarDatasourceLabel is a default label of datasource ConfigMaps watched by grafana sidecar
+	SidecarDatasourceLabel = "grafana_datasource"
+	// datasourceInputName is an input of grafana.com VictoriaMetrics dashboards
+	datasourceInputName = "DS_PROMETHEUS"
+)
+
+// grafana.com ids of VictoriaMetrics dashboards
+const (
+	DashboardVMSingle  = 10229
+	DashboardVMCluster = 11176
+	DashboardVMAuth    = 21394
+)
+
+var (
+	mode             string
+	instanceSelector map[string]string
+)
+
+// Init configures grafana integration
+// instanceSelectorFlag is a comma-separated list of key=value labels of grafana-operator Grafana instances
+func Init(integration, instanceSelectorFlag string) error {
+	switch integration {
+	case "", ModeOperator, ModeSidecar:
+	default:
+		return fmt.Errorf("unsupported grafana integration=%q, supported values: %s, %s", integration, ModeOperator, ModeSidecar)
+	}
+	selector, err := labels.ConvertSelectorToLabelsMap(instanceSelectorFlag)
+	if err != nil {
+		return fmt.Errorf("cannot parse grafana instance selector=%q: %w", instanceSelectorFlag, err)
+	}
+	mode = integration
+	instanceSelector = selector
+	return nil
+}
+
+// IsEnabled checks if grafana integration is enabled
+func IsEnabled() bool {
+	return mode != ""
+}
+
+// Datasource defines Grafana datasource for read endpoint of operator component
+type Datasource struct {
+	metav1.ObjectMeta
+	// DisplayName is a name of datasource at Grafana
+	DisplayName string
+	// URL of Prometheus compatible querying API
+	URL string
+	// DashboardID is an optional grafana.com id of component dashboard
+	DashboardID int
+}
+
+// CreateOrUpdate provisions Grafana datasource and dashboard for the given component
+// It's no-op if integration is disabled
+func CreateOrUpdate(ctx context.Context, rclient client.Client, ds *Datasource) error {
+	switch mode {
+	case ModeOperator:
+		if err := createOrUpdate(ctx, rclient, buildGrafanaDatasource(ds), newUnstructuredOf(datasourceGVK)); err != nil {
+			return fmt.Errorf("cannot create or update GrafanaDatasource: %w", err)
+		}
+		if ds.DashboardID > 0 {
+			if err := createOrUpdate(ctx, rclient, buildGrafanaDashboard(ds), newUnstructuredOf(dashboardGVK)); err != nil {
+				return fmt.Errorf("cannot create or update GrafanaDashboard: %w", err)
+			}
+		}
+	case ModeSidecar:
+		cm, err := buildDatasourceConfigMap(ds)
+		if err != nil {
+			return err
+		}
+		if err := createOrUpdate(ctx, rclient, cm, &corev1.ConfigMap{}); err != nil {
+			return fmt.Errorf("cannot create or update grafana datasource configmap: %w", err)
+		}
+	}
+	return nil
+}
+
+// Delete removes Grafana datasource and dashboard of component
+// Objects are also garbage collected by kubernetes with owner references
+func Delete(ctx context.Context, rclient client.Client, objMeta metav1.ObjectMeta) error {
+	switch mode {
+	case ModeOperator:
+		for _, gvk := range []schema.GroupVersionKind{datasourceGVK, dashboardGVK} {
+			obj := newUnstructuredOf(gvk)
+			obj.SetName(objMeta.Name)
+			obj.SetNamespace(objMeta.Namespace)
+			if err := finalize.SafeDelete(ctx, rclient, obj); err != nil {
+				return err
+			}
+		}
+	case ModeSidecar:
+		return finalize.SafeDelete(ctx, rclient, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: datasourceConfigMapName(objMeta.Name), Namespace: objMeta.Namespace}})
+	}
+	return nil
+}
+
+// createOrUpdate creates or updates object without finalizers, existObj is used for fetching current state
+func createOrUpdate(ctx context.Context, rclient client.Client, newObj, existObj client.Object) error {
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: newObj.GetNamespace(), Name: newObj.GetName()}, existObj); err != nil {
+		if errors.IsNotFound(err) {
+			return rclient.Create(ctx, newObj)
+		}
+		return err
+	}
+	newObj.SetAnnotations(labels.Merge(existObj.GetAnnotations(), newObj.GetAnnotations()))
+	newObj.SetResourceVersion(existObj.GetResourceVersion())
+	return rclient.Update(ctx, newObj)
+}
+
+func newUnstructuredOf(gvk schema.GroupVersionKind) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
+
+func buildInstanceSelector() map[string]any {
+	return map[string]any{
+		"matchLabels": toAnyMap(instanceSelector),
+	}
+}
+
+func buildGrafanaDatasource(ds *Datasource) *unstructured.Unstructured {
+	spec := map[string]any{
+		"instanceSelector": buildInstanceSelector(),
+		"datasource": map[string]any{
+			"name":   ds.DisplayName,
+			"type":   "prometheus",
+			"access": "proxy",
+			"url":    ds.URL,
+		},
+	}
+	return newUnstructured(datasourceGVK, ds.ObjectMeta, spec)
+}
+
+func buildGrafanaDashboard(ds *Datasource) *unstructured.Unstructured {
+	spec := map[string]any{
+		"instanceSelector": buildInstanceSelector(),
+		"grafanaCom": map[string]any{
+			"id": int64(ds.DashboardID),
+		},
+		"datasources": []any{
+			map[string]any{
+				"inputName":      datasourceInputName,
+				"datasourceName": ds.DisplayName,
+			},
+		},
+	}
+	return newUnstructured(dashboardGVK, ds.ObjectMeta, spec)
+}
+
+func newUnstructured(gvk schema.GroupVersionKind, objMeta metav1.ObjectMeta, spec map[string]any) *unstructured.Unstructured {
+	obj := newUnstructuredOf(gvk)
+	obj.Object["spec"] = spec
+	obj.SetName(objMeta.Name)
+	obj.SetNamespace(objMeta.Namespace)
+	obj.SetLabels(objMeta.Labels)
+	obj.SetAnnotations(objMeta.Annotations)
+	obj.SetOwnerReferences(objMeta.OwnerReferences)
+	return obj
+}
+
+func datasourceConfigMapName(name string) string {
+	return fmt.Sprintf("%s-grafana-datasource", name)
+}
+
+func buildDatasourceConfigMap(ds *Datasource) (*corev1.ConfigMap, error) {
+	cfg := yaml.MapSlice{
+		{Key: "apiVersion", Value: 1},
+		{Key: "datasources", Value: []yaml.MapSlice{{
+			{Key: "name", Value: ds.DisplayName},
+			{Key: "type", Value: "prometheus"},
+			{Key: "access", Value: "proxy"},
+			{Key: "url", Value: ds.URL},
+		}}},
+	}
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal grafana datasource: %w", err)
+	}
+	lbls := labels.Merge(ds.Labels, map[string]string{SidecarDatasourceLabel: "1"})
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            datasourceConfigMapName(ds.Name),
+			Namespace:       ds.Namespace,
+			Labels:          lbls,
+			Annotations:     ds.Annotations,
+			OwnerReferences: ds.OwnerReferences,
+		},
+		Data: map[string]string{
+			fmt.Sprintf("%s.yaml", ds.Name): string(data),
+		},
+	}, nil
+}
+
+func toAnyMap(src map[string]string) map[string]any {
+	dst := make(map[string]any, len(src))
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
@@ -0,0 +1,117 @@
+package grafana
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestInit(t *testing.T) {
+	f := func(integration, selector string, wantSelector map[string]string, wantErr bool) {
+		t.Helper()
+		defer func() { _ = Init("", "") }()
+		err := Init(integration, selector)
+		if wantErr {
+			assert.Error(t, err)
+			return
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, integration != "", IsEnabled())
+		assert.Equal(t, wantSelector, map[string]string(instanceSelector))
+	}
+
+	f("", "", map[string]string{}, false)
+	f(ModeOperator, "dashboards=grafana,team=infra", map[string]string{"dashboards": "grafana", "team": "infra"}, false)
+	f(ModeSidecar, "", map[string]string{}, false)
+	f("grafana", "", nil, true)
+	f(ModeOperator, "dashboards", nil, true)
+}
+
+func TestCreateOrUpdate(t *testing.T) {
+	ds := &Datasource{
+		ObjectMeta:  metav1.ObjectMeta{Name: "vmsingle-main", Namespace: "default", Labels: map[string]string{"app": "vmsingle"}},
+		DisplayName: "vmsingle/default/main",
+		URL:         "http://vmsingle-main.default.svc:8429",
+		DashboardID: DashboardVMSingle,
+	}
+	f := func(integration string, check func(ctx context.Context, t *testing.T, rclient client.Client)) {
+		t.Helper()
+		if err := Init(integration, "dashboards=grafana"); err != nil {
+			t.Fatalf("cannot init grafana integration: %s", err)
+		}
+		defer func() { _ = Init("", "") }()
+		check(context.Background(), t, fake.NewClientBuilder().Build())
+	}
+
+	// grafana-operator objects
+	f(ModeOperator, func(ctx context.Context, t *testing.T, rclient client.Client) {
+		// create and update
+		for i := 0; i < 2; i++ {
+			assert.NoError(t, CreateOrUpdate(ctx, rclient, ds))
+		}
+		instanceSelector := map[string]any{"matchLabels": map[string]any{"dashboards": "grafana"}}
+		got := newUnstructuredOf(datasourceGVK)
+		assert.NoError(t, rclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vmsingle-main"}, got))
+		assert.Equal(t, map[string]any{
+			"instanceSelector": instanceSelector,
+			"datasource": map[string]any{
+				"name":   "vmsingle/default/main",
+				"type":   "prometheus",
+				"access": "proxy",
+				"url":    "http://vmsingle-main.default.svc:8429",
+			},
+		}, got.Object["spec"])
+		got = newUnstructuredOf(dashboardGVK)
+		assert.NoError(t, rclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vmsingle-main"}, got))
+		assert.Equal(t, map[string]any{
+			"instanceSelector": instanceSelector,
+			"grafanaCom":       map[string]any{"id": int64(DashboardVMSingle)},
+			"datasources": []any{
+				map[string]any{"inputName": "DS_PROMETHEUS", "datasourceName": "vmsingle/default/main"},
+			},
+		}, got.Object["spec"])
+
+		assert.NoError(t, Delete(ctx, rclient, ds.ObjectMeta))
+		for _, obj := range []*unstructured.Unstructured{newUnstructuredOf(datasourceGVK), newUnstructuredOf(dashboardGVK)} {
+			err := rclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vmsingle-main"}, obj)
+			assert.True(t, k8serrors.IsNotFound(err))
+		}
+	})
+
+	// sidecar configmap
+	f(ModeSidecar, func(ctx context.Context, t *testing.T, rclient client.Client) {
+		for i := 0; i < 2; i++ {
+			assert.NoError(t, CreateOrUpdate(ctx, rclient, ds))
+		}
+		var cm corev1.ConfigMap
+		assert.NoError(t, rclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vmsingle-main-grafana-datasource"}, &cm))
+		assert.Equal(t, map[string]string{"app": "vmsingle", "grafana_datasource": "1"}, cm.Labels)
+		assert.Equal(t, map[string]string{"vmsingle-main.yaml": `apiVersion: 1
+datasources:
+- name: vmsingle/default/main
+  type: prometheus
+  access: proxy
+  url: http://vmsingle-main.default.svc:8429
+`}, cm.Data)
+
+		assert.NoError(t, Delete(ctx, rclient, ds.ObjectMeta))
+		err := rclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vmsingle-main-grafana-datasource"}, &cm)
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	// disabled integration
+	f("", func(ctx context.Context, t *testing.T, rclient client.Client) {
+		assert.NoError(t, CreateOrUpdate(ctx, rclient, ds))
+		var cms corev1.ConfigMapList
+		assert.NoError(t, rclient.List(ctx, &cms))
+		assert.Empty(t, cms.Items)
+	})
+}
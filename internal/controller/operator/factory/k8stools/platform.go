@@ -0,0 +1,81 @@
+package k8stools
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+const (
+	// PlatformKubernetes is a generic kubernetes cluster
+	PlatformKubernetes = "kubernetes"
+	// PlatformOpenShift is an OpenShift cluster
+	PlatformOpenShift = "openshift"
+	// PlatformAuto detects platform with API discovery
+	PlatformAuto = "auto"
+)
+
+// API groups served only by OpenShift
+const (
+	openShiftRouteGroup    = "route.openshift.io"
+	openShiftSecurityGroup = "security.openshift.io"
+)
+
+var (
+	isOpenShift  bool
+	openShiftSCC string
+)
+
+// IsOpenShift checks if operator runs at OpenShift cluster
+func IsOpenShift() bool {
+	return isOpenShift
+}
+
+// OpenShiftSCC returns name of SecurityContextConstraints, which must be granted to ServiceAccounts of components
+// empty value means that binding is not needed
+func OpenShiftSCC() string {
+	if !isOpenShift {
+		return ""
+	}
+	return openShiftSCC
+}
+
+// SetPlatform configures platform specific behaviour
+// for PlatformAuto platform is detected by API groups served by the cluster
+func SetPlatform(platform, sccName string, dc discovery.ServerGroupsInterface) error {
+	openShiftSCC = sccName
+	switch platform {
+	case PlatformKubernetes, "":
+		isOpenShift = false
+	case PlatformOpenShift:
+		isOpenShift = true
+	case PlatformAuto:
+		groups, err := dc.ServerGroups()
+		if err != nil {
+			return fmt.Errorf("cannot discover API groups: %w", err)
+		}
+		var hasRoutes, hasSecurity bool
+		for _, g := range groups.Groups {
+			switch g.Name {
+			case openShiftRouteGroup:
+				hasRoutes = true
+			case openShiftSecurityGroup:
+				hasSecurity = true
+			}
+		}
+		isOpenShift = hasRoutes && hasSecurity
+	default:
+		return fmt.Errorf("unsupported platform=%q, supported values: %s, %s, %s", platform, PlatformKubernetes, PlatformOpenShift, PlatformAuto)
+	}
+	return nil
+}
+
+// OpenShiftSCCRoleBindingName returns name of RoleBinding, which grants SecurityContextConstraints to the given ServiceAccount
+func OpenShiftSCCRoleBindingName(serviceAccountName string) string {
+	return serviceAccountName + "-scc"
+}
+
+// RouteGVK is OpenShift Route, it's used instead of Ingress at OpenShift platform.
+// Route is managed as unstructured object, since its API isn't served by generic kubernetes
+var RouteGVK = schema.GroupVersionKind{Group: openShiftRouteGroup, Version: "v1", Kind: "Route"}
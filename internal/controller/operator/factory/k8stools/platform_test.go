@@ -0,0 +1,45 @@
+package k8stools
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type testServerGroups []string
+
+func (tsg testServerGroups) ServerGroups() (*metav1.APIGroupList, error) {
+	var gl metav1.APIGroupList
+	for _, name := range tsg {
+		gl.Groups = append(gl.Groups, metav1.APIGroup{Name: name})
+	}
+	return &gl, nil
+}
+
+func TestSetPlatform(t *testing.T) {
+	f := func(platform string, groups testServerGroups, wantOpenShift bool, wantSCC string) {
+		t.Helper()
+		defer func() {
+			isOpenShift = false
+			openShiftSCC = ""
+		}()
+		if err := SetPlatform(platform, "nonroot-v2", groups); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got := IsOpenShift(); got != wantOpenShift {
+			t.Fatalf("unexpected platform detection for platform=%q, got openshift: %v, want: %v", platform, got, wantOpenShift)
+		}
+		if got := OpenShiftSCC(); got != wantSCC {
+			t.Fatalf("unexpected scc, got: %q, want: %q", got, wantSCC)
+		}
+	}
+	f(PlatformKubernetes, testServerGroups{"route.openshift.io", "security.openshift.io"}, false, "")
+	f(PlatformOpenShift, nil, true, "nonroot-v2")
+	f(PlatformAuto, testServerGroups{"apps", "route.openshift.io", "security.openshift.io"}, true, "nonroot-v2")
+	f(PlatformAuto, testServerGroups{"apps", "route.openshift.io"}, false, "")
+	f(PlatformAuto, testServerGroups{"apps"}, false, "")
+
+	if err := SetPlatform("gke", "", nil); err == nil {
+		t.Fatalf("expected error for unsupported platform")
+	}
+}
@@ -2,12 +2,14 @@ package k8stools
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"unicode"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -49,6 +51,13 @@ func (ke *KeyNotFoundError) Error() string {
 	return fmt.Sprintf("expected key=%q was not found at=%q cache_key=%q", ke.key, ke.context, ke.cacheKey)
 }
 
+// IsMissingSecretError checks if error was caused by
+// not existing secret, configmap or key at it
+func IsMissingSecretError(err error) bool {
+	var ke *KeyNotFoundError
+	return errors.As(err, &ke) || k8serrors.IsNotFound(err)
+}
+
 // OAuthCreds represents OAuth2 secret values within plain text
 type OAuthCreds struct {
 	ClientSecret string
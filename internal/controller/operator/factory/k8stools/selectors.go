@@ -21,6 +21,7 @@ func VisitObjectsForSelectorsAtNs[T any, PT interface {
 	objNamespace string, selectAllByDefault bool, cb func(PT),
 ) error {
 	watchNS := config.MustGetWatchNamespaces()
+	watchNSSelector := config.GetWatchNamespaceSelector()
 	// fast path, empty selectors and cannot select all by default
 	if nsSelector == nil && objectSelector == nil && !selectAllByDefault {
 		return nil
@@ -38,17 +39,32 @@ func VisitObjectsForSelectorsAtNs[T any, PT interface {
 	case objectSelector != nil && nsSelector == nil:
 		// in single namespace mode, return object ns
 		namespaces = append(namespaces, objNamespace)
+	case nsSelector == nil && watchNSSelector != nil:
+		// select all by default only from namespaces matched by watch namespace selector
+		var err error
+		namespaces, err = SelectNamespaces(ctx, rclient, watchNSSelector)
+		if err != nil {
+			return fmt.Errorf("cannot select watched namespaces: %w", err)
+		}
 	default:
 		// perform a cluster wide request for namespaces with given filters
 		nsSelector, err := metav1.LabelSelectorAsSelector(nsSelector)
 		if err != nil {
 			return fmt.Errorf("cannot convert  selector: %w", err)
 		}
+		if watchNSSelector != nil {
+			reqs, _ := watchNSSelector.Requirements()
+			nsSelector = nsSelector.Add(reqs...)
+		}
 		namespaces, err = SelectNamespaces(ctx, rclient, nsSelector)
 		if err != nil {
 			return fmt.Errorf("cannot select namespaces for  match: %w", err)
 		}
 	}
+	// objects cannot be selected from not watched namespaces
+	if watchNSSelector != nil && len(namespaces) == 0 {
+		return nil
+	}
 	// fast path nothing selected
 	if namespaces == nil && !selectAllByDefault {
 		return nil
@@ -0,0 +1,52 @@
+package k8stools
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestVisitObjectsForSelectorsAtNsWithWatchSelector(t *testing.T) {
+	defer func() {
+		assert.NoError(t, config.SetWatchNamespaceSelector(""))
+	}()
+	rclient := GetTestClientWithObjects([]runtime.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "observability", "env": "prod"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"team": "observability"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default", Labels: map[string]string{"env": "prod"}}},
+		&vmv1beta1.VMRule{ObjectMeta: metav1.ObjectMeta{Name: "rule-a", Namespace: "team-a"}},
+		&vmv1beta1.VMRule{ObjectMeta: metav1.ObjectMeta{Name: "rule-b", Namespace: "team-b"}},
+		&vmv1beta1.VMRule{ObjectMeta: metav1.ObjectMeta{Name: "rule-default", Namespace: "default"}},
+	})
+	f := func(watchSelector string, nsSelector *metav1.LabelSelector, selectAllByDefault bool, want []string) {
+		t.Helper()
+		assert.NoError(t, config.SetWatchNamespaceSelector(watchSelector))
+		var got []string
+		assert.NoError(t, VisitObjectsForSelectorsAtNs(context.Background(), rclient, nsSelector, nil, "default", selectAllByDefault, func(l *vmv1beta1.VMRuleList) {
+			for _, item := range l.Items {
+				got = append(got, item.Name)
+			}
+		}))
+		sort.Strings(got)
+		assert.Equal(t, want, got)
+	}
+
+	// select all objects without watch selector
+	f("", nil, true, []string{"rule-a", "rule-b", "rule-default"})
+
+	// select all objects from watched namespaces
+	f("team=observability", nil, true, []string{"rule-a", "rule-b"})
+
+	// namespace selector is combined with watch selector
+	f("team=observability", &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}, false, []string{"rule-a"})
+
+	// no watched namespaces matched
+	f("team=missing", &metav1.LabelSelector{}, true, nil)
+}
@@ -8,6 +8,7 @@ import (
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 
 	"github.com/go-test/deep"
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,6 +35,8 @@ func testGetScheme() *runtime.Scheme {
 		&vmv1beta1.VMUserList{},
 		&vmv1beta1.VMAuthList{},
 		&vmv1beta1.VMAlertmanagerConfigList{},
+		&vmv1beta1.VMAlertmanagerTemplateList{},
+		&vmv1beta1.VMOperatorSettingsList{},
 		&vmv1beta1.VMScrapeConfigList{},
 		&vmv1beta1.VMClusterList{},
 		&vmv1beta1.VLogsList{},
@@ -46,6 +49,12 @@ func testGetScheme() *runtime.Scheme {
 		&vmv1beta1.VMServiceScrapeList{},
 		&vmv1beta1.VMRule{},
 		&vmv1beta1.VMRuleList{},
+		&vmv1beta1.VMRuleTest{},
+		&vmv1beta1.VMRuleTestList{},
+		&vmv1beta1.VMMaintenanceTask{},
+		&vmv1beta1.VMMaintenanceTaskList{},
+		&vmv1beta1.VMDataMigration{},
+		&vmv1beta1.VMDataMigrationList{},
 		&vmv1beta1.VMProbe{},
 		&vmv1beta1.VMProbeList{},
 		&vmv1beta1.VMNodeScrape{},
@@ -53,10 +62,24 @@ func testGetScheme() *runtime.Scheme {
 		&vmv1beta1.VMUser{},
 		&vmv1beta1.VMAuth{},
 		&vmv1beta1.VMAlertmanagerConfig{},
+		&vmv1beta1.VMAlertmanagerTemplate{},
+		&vmv1beta1.VMOperatorSettings{},
 		&vmv1beta1.VMScrapeConfig{},
+		&vmv1beta1.VMScrapeGlobalConfig{},
+		&vmv1beta1.VMScrapeGlobalConfigList{},
+		&vmv1beta1.VMRemoteWriteTarget{},
+		&vmv1beta1.VMRemoteWriteTargetList{},
+		&vmv1beta1.VMRelabelConfig{},
+		&vmv1beta1.VMRelabelConfigList{},
 		&vmv1beta1.VMCluster{},
 		&vmv1beta1.VLogs{},
 	)
+	s.AddKnownTypes(promv1.SchemeGroupVersion,
+		&promv1.ServiceMonitor{},
+		&promv1.ServiceMonitorList{},
+		&promv1.PrometheusRule{},
+		&promv1.PrometheusRuleList{},
+	)
 	return s
 }
 
@@ -69,6 +92,9 @@ func GetTestClientWithObjects(predefinedObjects []runtime.Object) client.Client
 	fclient := fake.NewClientBuilder().WithScheme(testGetScheme()).
 		WithStatusSubresource(
 			&vmv1beta1.VMRule{},
+			&vmv1beta1.VMRuleTest{},
+			&vmv1beta1.VMMaintenanceTask{},
+			&vmv1beta1.VMDataMigration{},
 			&vmv1beta1.VMAlert{},
 			&vmv1beta1.VMAuth{},
 			&vmv1beta1.VMUser{},
@@ -77,6 +103,7 @@ func GetTestClientWithObjects(predefinedObjects []runtime.Object) client.Client
 			&vmv1beta1.VMAgent{},
 			&vmv1beta1.VMAlertmanager{},
 			&vmv1beta1.VMAlertmanagerConfig{},
+			&vmv1beta1.VMAlertmanagerTemplate{},
 			&vmv1beta1.VLogs{},
 			&vmv1beta1.VMServiceScrape{},
 			&vmv1beta1.VMPodScrape{},
@@ -49,6 +49,39 @@ func IsFSGroupChangePolicySupported() bool {
 	return false
 }
 
+// IsEndpointSlicesSupported checks if `discovery.k8s.io/v1` EndpointSlice API is served,
+// Supported since 1.21
+// https://kubernetes.io/docs/reference/using-api/deprecation-guide/#endpointslice-v125
+func IsEndpointSlicesSupported() bool {
+	return isServerVersionAtLeast(1, 21)
+}
+
+// IsIngressNetworkingV1Supported checks if `networking.k8s.io/v1` Ingress API is served,
+// Supported since 1.19
+// https://kubernetes.io/docs/reference/using-api/deprecation-guide/#ingress-v122
+func IsIngressNetworkingV1Supported() bool {
+	return isServerVersionAtLeast(1, 19)
+}
+
+// IsNativeSidecarsSupported checks if init containers with `restartPolicy: Always` are started as sidecars,
+// Enabled by default since 1.29
+// https://kubernetes.io/docs/concepts/workloads/pods/sidecar-containers/
+func IsNativeSidecarsSupported() bool {
+	return isServerVersionAtLeast(1, 29)
+}
+
+// isServerVersionAtLeast checks kubernetes API server version
+// not initialized version is considered as the most recent one
+func isServerVersionAtLeast(major, minor uint64) bool {
+	if ServerMajorVersion == 0 {
+		return true
+	}
+	if ServerMajorVersion != major {
+		return ServerMajorVersion > major
+	}
+	return ServerMinorVersion >= minor
+}
+
 // MustConvertObjectVersionsJSON objects with json serialize and deserialize
 // it could be used only for converting BETA apis to Stable version
 func MustConvertObjectVersionsJSON[A, B any](src *A, objectName string) *B {
@@ -1 +1,41 @@
 package k8stools
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/version"
+)
+
+func TestIsServerVersionAtLeast(t *testing.T) {
+	f := func(serverVersion version.Info, endpointSlicesSupported, ingressV1Supported, nativeSidecarsSupported bool) {
+		t.Helper()
+		if err := SetKubernetesVersionWithDefaults(&serverVersion, 0, 0); err != nil {
+			t.Fatalf("cannot set k8s version for testing: %q", err)
+		}
+		defer func() {
+			// return back defaults after test
+			restoreVersion := version.Info{Major: "0", Minor: "0"}
+			if err := SetKubernetesVersionWithDefaults(&restoreVersion, 0, 0); err != nil {
+				t.Fatalf("cannot set k8s version for testing: %q", err)
+			}
+		}()
+		if got := IsEndpointSlicesSupported(); got != endpointSlicesSupported {
+			t.Fatalf("unexpected endpointslices support for version=%s.%s, got: %v, want: %v", serverVersion.Major, serverVersion.Minor, got, endpointSlicesSupported)
+		}
+		if got := IsIngressNetworkingV1Supported(); got != ingressV1Supported {
+			t.Fatalf("unexpected ingress networking/v1 support for version=%s.%s, got: %v, want: %v", serverVersion.Major, serverVersion.Minor, got, ingressV1Supported)
+		}
+		if got := IsNativeSidecarsSupported(); got != nativeSidecarsSupported {
+			t.Fatalf("unexpected native sidecars support for version=%s.%s, got: %v, want: %v", serverVersion.Major, serverVersion.Minor, got, nativeSidecarsSupported)
+		}
+	}
+	// not initialized version
+	f(version.Info{Major: "0", Minor: "0"}, true, true, true)
+	f(version.Info{Major: "1", Minor: "18"}, false, false, false)
+	f(version.Info{Major: "1", Minor: "19"}, false, true, false)
+	f(version.Info{Major: "1", Minor: "21+"}, true, true, false)
+	f(version.Info{Major: "1", Minor: "28"}, true, true, false)
+	f(version.Info{Major: "1", Minor: "29+"}, true, true, true)
+	f(version.Info{Major: "1", Minor: "30"}, true, true, true)
+	f(version.Info{Major: "2", Minor: "0"}, true, true, true)
+}
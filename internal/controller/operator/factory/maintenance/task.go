@@ -0,0 +1,302 @@
+package maintenance
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	taskHashAnnotation = "operator.victoriametrics.com/vmmaintenancetask-hash"
+	taskHashLabel      = "operator.victoriametrics.com/vmmaintenancetask-hash"
+	// termination message is limited by kubelet with 4096 bytes
+	maxTaskMessageLen = 4096
+)
+
+// CreateOrUpdateMaintenanceTask runs maintenance operation of VMMaintenanceTask as a Job
+// and updates VMMaintenanceTask status with its result.
+// Destructive operations are started only after confirmation with ConfirmDestructiveChangesAnnotation.
+// It returns true, if task run is finished for the current task settings.
+func CreateOrUpdateMaintenanceTask(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMMaintenanceTask) (bool, error) {
+	target, args, err := buildTaskRequest(ctx, rclient, cr)
+	if err != nil {
+		if patchErr := patchTaskStatus(ctx, rclient, cr, func(status *vmv1beta1.VMMaintenanceTaskStatus) {
+			status.LastSyncError = err.Error()
+		}); patchErr != nil {
+			return false, patchErr
+		}
+		return false, err
+	}
+	hash := taskHash(cr, args)
+	if cr.Status.TaskHash == hash && (cr.Status.Phase == vmv1beta1.MaintenanceTaskSucceeded || cr.Status.Phase == vmv1beta1.MaintenanceTaskFailed) {
+		return true, nil
+	}
+	targetCtx := events.AddToContext(ctx, target)
+
+	var job batchv1.Job
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.PrefixedName()}, &job); err != nil {
+		if !errors.IsNotFound(err) {
+			return false, fmt.Errorf("cannot get job for vmmaintenancetask: %w", err)
+		}
+		if cr.IsDestructive() && !vmv1beta1.IsDestructiveChangeConfirmed(cr) {
+			if cr.Status.Phase != vmv1beta1.MaintenanceTaskAwaitingConfirmation || cr.Status.TaskHash != hash {
+				events.Warning(ctx, events.ReasonDestructiveChangeBlocked, "%s for %s=%s removes data, confirm it with annotation %s=%d",
+					cr.Operation(), cr.Spec.Target.Kind, cr.Spec.Target.Name, vmv1beta1.ConfirmDestructiveChangesAnnotation, cr.Generation)
+			}
+			return false, patchTaskStatus(ctx, rclient, cr, func(status *vmv1beta1.VMMaintenanceTaskStatus) {
+				status.Phase = vmv1beta1.MaintenanceTaskAwaitingConfirmation
+				status.Message = fmt.Sprintf("set annotation %s=%d to confirm the task", vmv1beta1.ConfirmDestructiveChangesAnnotation, cr.Generation)
+				status.TaskHash = hash
+				status.StartTime = nil
+				status.CompletionTime = nil
+				status.LastSyncError = ""
+			})
+		}
+		logger.WithContext(ctx).Info("starting maintenance task job", "job", cr.PrefixedName(), "operation", cr.Operation(), "target_kind", cr.Spec.Target.Kind, "target", cr.Spec.Target.Name, "hash", hash)
+		if err := rclient.Create(ctx, buildTaskJob(cr, args, hash)); err != nil {
+			return false, fmt.Errorf("cannot create job for vmmaintenancetask: %w", err)
+		}
+		events.Normal(ctx, events.ReasonMaintenanceTaskStarted, "started %s for %s=%s", cr.Operation(), cr.Spec.Target.Kind, cr.Spec.Target.Name)
+		events.Normal(targetCtx, events.ReasonMaintenanceTaskStarted, "vmmaintenancetask=%s started %s", cr.Name, cr.Operation())
+		return false, patchTaskStatus(ctx, rclient, cr, func(status *vmv1beta1.VMMaintenanceTaskStatus) {
+			status.Phase = vmv1beta1.MaintenanceTaskRunning
+			status.Message = fmt.Sprintf("%s is running at job=%s", cr.Operation(), cr.PrefixedName())
+			status.TaskHash = hash
+			status.StartTime = ptr.To(metav1.Now())
+			status.CompletionTime = nil
+			status.LastSyncError = ""
+		})
+	}
+	if !job.DeletionTimestamp.IsZero() {
+		// wait for previous task run removal
+		return false, nil
+	}
+	if job.Annotations[taskHashAnnotation] != hash {
+		logger.WithContext(ctx).Info("task settings changed, removing outdated maintenance task job", "job", job.Name)
+		return false, deleteTaskJob(ctx, rclient, &job)
+	}
+
+	var phase string
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			phase = vmv1beta1.MaintenanceTaskSucceeded
+		case batchv1.JobFailed:
+			phase = vmv1beta1.MaintenanceTaskFailed
+		}
+	}
+	if phase == "" {
+		return false, nil
+	}
+	message := fmt.Sprintf("%s finished for %s=%s", cr.Operation(), cr.Spec.Target.Kind, cr.Spec.Target.Name)
+	if phase == vmv1beta1.MaintenanceTaskFailed {
+		message, err = taskFailureMessage(ctx, rclient, cr, hash)
+		if err != nil {
+			return false, err
+		}
+		logger.WithContext(ctx).Info("maintenance task failed", "job", job.Name, "message", message)
+		events.Warning(ctx, events.ReasonMaintenanceTaskFailed, "%s failed for %s=%s", cr.Operation(), cr.Spec.Target.Kind, cr.Spec.Target.Name)
+		events.Warning(targetCtx, events.ReasonMaintenanceTaskFailed, "vmmaintenancetask=%s failed %s", cr.Name, cr.Operation())
+	} else {
+		logger.WithContext(ctx).Info("maintenance task succeeded", "job", job.Name)
+		events.Normal(ctx, events.ReasonMaintenanceTaskSucceeded, "%s succeeded for %s=%s", cr.Operation(), cr.Spec.Target.Kind, cr.Spec.Target.Name)
+		events.Normal(targetCtx, events.ReasonMaintenanceTaskSucceeded, "vmmaintenancetask=%s succeeded %s", cr.Name, cr.Operation())
+	}
+	completionTime := metav1.Now()
+	return true, patchTaskStatus(ctx, rclient, cr, func(status *vmv1beta1.VMMaintenanceTaskStatus) {
+		status.Phase = phase
+		status.Message = message
+		status.TaskHash = hash
+		status.CompletionTime = &completionTime
+		status.LastSyncError = ""
+	})
+}
+
+// buildTaskRequest returns target object and curl arguments for task operation
+func buildTaskRequest(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMMaintenanceTask) (client.Object, []string, error) {
+	nsn := types.NamespacedName{Namespace: cr.Namespace, Name: cr.Spec.Target.Name}
+	var target client.Object
+	var urls []string
+	switch cr.Spec.Target.Kind {
+	case "VMSingle":
+		var vmSingle vmv1beta1.VMSingle
+		if err := rclient.Get(ctx, nsn, &vmSingle); err != nil {
+			return nil, nil, targetGetError(cr, err)
+		}
+		target = &vmSingle
+		switch {
+		case cr.Spec.ForceMerge != nil:
+			urls = append(urls, vmSingle.AsURL()+vmv1beta1.BuildPathWithAuthKey(vmSingle.Spec.ExtraArgs, forceMergePath(cr.Spec.ForceMerge), "forceMergeAuthKey"))
+		case cr.Spec.DeleteSeries != nil:
+			urls = append(urls, vmSingle.AsURL()+vmv1beta1.BuildPathWithAuthKey(vmSingle.Spec.ExtraArgs, "/api/v1/admin/tsdb/delete_series", "deleteAuthKey"))
+		}
+	case "VMCluster":
+		var vmCluster vmv1beta1.VMCluster
+		if err := rclient.Get(ctx, nsn, &vmCluster); err != nil {
+			return nil, nil, targetGetError(cr, err)
+		}
+		target = &vmCluster
+		switch {
+		case cr.Spec.ForceMerge != nil:
+			// forced merge is performed by each vmstorage node independently
+			for _, podURL := range vmCluster.VMStoragePodURLs() {
+				urls = append(urls, podURL+vmv1beta1.BuildPathWithAuthKey(vmCluster.Spec.VMStorage.ExtraArgs, forceMergePath(cr.Spec.ForceMerge), "forceMergeAuthKey"))
+			}
+			if len(urls) == 0 {
+				return nil, nil, fmt.Errorf("vmcluster=%q has no available vmstorage nodes for forceMerge", vmCluster.Name)
+			}
+		case cr.Spec.DeleteSeries != nil:
+			if vmCluster.Spec.VMSelect == nil {
+				return nil, nil, fmt.Errorf("vmcluster=%q must have vmselect for deleteSeries", vmCluster.Name)
+			}
+			tenant := cr.Spec.DeleteSeries.Tenant
+			if tenant == "" {
+				tenant = "0"
+			}
+			urls = append(urls, vmCluster.VMSelectURL()+vmv1beta1.BuildPathWithAuthKey(vmCluster.Spec.VMSelect.ExtraArgs, "/delete/"+tenant+"/prometheus/api/v1/admin/tsdb/delete_series", "deleteAuthKey"))
+		}
+	default:
+		return nil, nil, fmt.Errorf("unsupported target kind=%q", cr.Spec.Target.Kind)
+	}
+	if len(urls) == 0 {
+		return nil, nil, fmt.Errorf("exactly one of forceMerge or deleteSeries must be set")
+	}
+
+	args := []string{"--silent", "--show-error", "--fail", "--fail-early"}
+	if cr.Spec.DeleteSeries != nil {
+		for _, m := range cr.Spec.DeleteSeries.Match {
+			args = append(args, "--data-urlencode", "match[]="+m)
+		}
+	}
+	args = append(args, urls...)
+	return target, args, nil
+}
+
+func targetGetError(cr *vmv1beta1.VMMaintenanceTask, err error) error {
+	if errors.IsNotFound(err) {
+		return fmt.Errorf("cannot find %s=%q referenced by vmmaintenancetask", cr.Spec.Target.Kind, cr.Spec.Target.Name)
+	}
+	return fmt.Errorf("cannot get %s=%q: %w", cr.Spec.Target.Kind, cr.Spec.Target.Name, err)
+}
+
+func forceMergePath(fm *vmv1beta1.ForceMergeTask) string {
+	if fm.PartitionPrefix == "" {
+		return "/internal/force_merge"
+	}
+	return "/internal/force_merge?partition_prefix=" + fm.PartitionPrefix
+}
+
+// taskHash calculates hash of task request and job settings
+func taskHash(cr *vmv1beta1.VMMaintenanceTask, args []string) string {
+	h := sha256.New()
+	h.Write([]byte(cr.Spec.Target.Kind))                    //nolint:errcheck
+	h.Write([]byte(cr.Spec.Target.Name))                    //nolint:errcheck
+	h.Write([]byte(strings.Join(args, "\xff")))             //nolint:errcheck
+	h.Write([]byte(cr.Spec.Image.Repository))               //nolint:errcheck
+	h.Write([]byte(cr.Spec.Image.Tag))                      //nolint:errcheck
+	h.Write([]byte(strconv.FormatBool(cr.IsDestructive()))) //nolint:errcheck
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+func buildTaskJob(cr *vmv1beta1.VMMaintenanceTask, args []string, hash string) *batchv1.Job {
+	podLabels := labels.Merge(cr.AllLabels(), map[string]string{taskHashLabel: hash})
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            cr.PrefixedName(),
+			Namespace:       cr.Namespace,
+			Labels:          cr.AllLabels(),
+			Annotations:     labels.Merge(cr.AnnotationsFiltered(), map[string]string{taskHashAnnotation: hash}),
+			OwnerReferences: cr.AsOwner(),
+			Finalizers:      finalize.ChildFinalizers(),
+		},
+		Spec: batchv1.JobSpec{
+			// maintenance requests must not be repeated without user intention
+			BackoffLimit: ptr.To[int32](0),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: podLabels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:                     "maintenance",
+							Image:                    fmt.Sprintf("%s:%s", cr.Spec.Image.Repository, cr.Spec.Image.Tag),
+							ImagePullPolicy:          cr.Spec.Image.PullPolicy,
+							Command:                  []string{"curl"},
+							Args:                     args,
+							Resources:                cr.Spec.Resources,
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// deleteTaskJob removes finalizer from job and deletes it with its pods
+func deleteTaskJob(ctx context.Context, rclient client.Client, job *batchv1.Job) error {
+	if err := finalize.RemoveFinalizer(ctx, rclient, job); err != nil {
+		return err
+	}
+	if err := rclient.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("cannot delete outdated maintenance task job: %w", err)
+	}
+	return nil
+}
+
+// taskFailureMessage returns termination message of failed curl container
+func taskFailureMessage(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMMaintenanceTask, hash string) (string, error) {
+	var pods corev1.PodList
+	if err := rclient.List(ctx, &pods, client.InNamespace(cr.Namespace), client.MatchingLabels(labels.Merge(cr.SelectorLabels(), map[string]string{taskHashLabel: hash}))); err != nil {
+		return "", fmt.Errorf("cannot list pods for vmmaintenancetask: %w", err)
+	}
+	for _, pod := range pods.Items {
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.State.Terminated != nil && cs.State.Terminated.Message != "" {
+				msg := strings.TrimSpace(cs.State.Terminated.Message)
+				if len(msg) > maxTaskMessageLen {
+					msg = msg[len(msg)-maxTaskMessageLen:]
+				}
+				return msg, nil
+			}
+		}
+	}
+	return "maintenance job failed, check logs of job=" + cr.PrefixedName(), nil
+}
+
+func patchTaskStatus(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMMaintenanceTask, update func(status *vmv1beta1.VMMaintenanceTaskStatus)) error {
+	status := cr.Status.DeepCopy()
+	update(status)
+	if equality.Semantic.DeepEqual(*status, cr.Status) {
+		return nil
+	}
+	// merge patch is calculated from the previous object in order to remove cleared fields
+	patch := client.MergeFrom(cr.DeepCopy())
+	cr.Status = *status
+	if err := rclient.Status().Patch(ctx, cr, patch); err != nil {
+		return fmt.Errorf("cannot patch status of vmmaintenancetask=%q: %w", cr.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package maintenance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestBuildTaskRequest(t *testing.T) {
+	f := func(spec vmv1beta1.VMMaintenanceTaskSpec, predefinedObjects []runtime.Object, wantArgs []string, wantErr string) {
+		t.Helper()
+		cr := &vmv1beta1.VMMaintenanceTask{
+			ObjectMeta: metav1.ObjectMeta{Name: "task", Namespace: "default"},
+			Spec:       spec,
+		}
+		rclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		_, args, err := buildTaskRequest(context.Background(), rclient, cr)
+		if wantErr != "" {
+			assert.EqualError(t, err, wantErr)
+			return
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assert.Equal(t, wantArgs, args)
+	}
+	vmSingle := &vmv1beta1.VMSingle{
+		ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"},
+		Spec: vmv1beta1.VMSingleSpec{
+			CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+				ExtraArgs: map[string]string{"http.pathPrefix": "/vm", "deleteAuthKey": "secret"},
+			},
+		},
+	}
+	vmCluster := &vmv1beta1.VMCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"},
+		Spec: vmv1beta1.VMClusterSpec{
+			VMStorage: &vmv1beta1.VMStorage{
+				CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+					ReplicaCount: ptr.To[int32](3),
+				},
+				MaintenanceSelectNodeIDs: []int32{1},
+			},
+			VMSelect: &vmv1beta1.VMSelect{},
+		},
+	}
+	commonArgs := []string{"--silent", "--show-error", "--fail", "--fail-early"}
+
+	// missing target
+	f(vmv1beta1.VMMaintenanceTaskSpec{
+		Target:     vmv1beta1.MaintenanceTaskTarget{Kind: "VMSingle", Name: "main"},
+		ForceMerge: &vmv1beta1.ForceMergeTask{},
+	}, nil, nil, `cannot find VMSingle="main" referenced by vmmaintenancetask`)
+
+	// vmsingle force merge
+	f(vmv1beta1.VMMaintenanceTaskSpec{
+		Target:     vmv1beta1.MaintenanceTaskTarget{Kind: "VMSingle", Name: "main"},
+		ForceMerge: &vmv1beta1.ForceMergeTask{PartitionPrefix: "2024_01"},
+	}, []runtime.Object{vmSingle}, append(commonArgs,
+		"http://vmsingle-main.default.svc:8429/vm/internal/force_merge?partition_prefix=2024_01",
+	), "")
+
+	// vmsingle delete series with auth key
+	f(vmv1beta1.VMMaintenanceTaskSpec{
+		Target:       vmv1beta1.MaintenanceTaskTarget{Kind: "VMSingle", Name: "main"},
+		DeleteSeries: &vmv1beta1.DeleteSeriesTask{Match: []string{`{job="node"}`, "up"}},
+	}, []runtime.Object{vmSingle}, append(commonArgs,
+		"--data-urlencode", `match[]={job="node"}`,
+		"--data-urlencode", "match[]=up",
+		"http://vmsingle-main.default.svc:8429/vm/api/v1/admin/tsdb/delete_series?authKey=secret",
+	), "")
+
+	// vmcluster force merge skips storage nodes under maintenance
+	f(vmv1beta1.VMMaintenanceTaskSpec{
+		Target:     vmv1beta1.MaintenanceTaskTarget{Kind: "VMCluster", Name: "main"},
+		ForceMerge: &vmv1beta1.ForceMergeTask{},
+	}, []runtime.Object{vmCluster}, append(commonArgs,
+		"http://vmstorage-main-0.vmstorage-main.default:8482/internal/force_merge",
+		"http://vmstorage-main-2.vmstorage-main.default:8482/internal/force_merge",
+	), "")
+
+	// vmcluster delete series for tenant
+	f(vmv1beta1.VMMaintenanceTaskSpec{
+		Target:       vmv1beta1.MaintenanceTaskTarget{Kind: "VMCluster", Name: "main"},
+		DeleteSeries: &vmv1beta1.DeleteSeriesTask{Match: []string{"up"}, Tenant: "1:2"},
+	}, []runtime.Object{vmCluster}, append(commonArgs,
+		"--data-urlencode", "match[]=up",
+		"http://vmselect-main.default.svc:8481/delete/1:2/prometheus/api/v1/admin/tsdb/delete_series",
+	), "")
+}
+
+func TestCreateOrUpdateMaintenanceTask(t *testing.T) {
+	ctx := context.Background()
+	cr := &vmv1beta1.VMMaintenanceTask{
+		ObjectMeta: metav1.ObjectMeta{Name: "cleanup", Namespace: "default", Generation: 1},
+		Spec: vmv1beta1.VMMaintenanceTaskSpec{
+			Target:       vmv1beta1.MaintenanceTaskTarget{Kind: "VMSingle", Name: "main"},
+			DeleteSeries: &vmv1beta1.DeleteSeriesTask{Match: []string{"up"}},
+			Image:        vmv1beta1.Image{Repository: "curlimages/curl", Tag: "8.9.1"},
+		},
+	}
+	vmSingle := &vmv1beta1.VMSingle{ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"}}
+	rclient := k8stools.GetTestClientWithObjects([]runtime.Object{cr, vmSingle})
+	reconcileTask := func(wantFinished bool, wantPhase string) {
+		t.Helper()
+		if err := rclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.Name}, cr); err != nil {
+			t.Fatalf("cannot get task: %s", err)
+		}
+		finished, err := CreateOrUpdateMaintenanceTask(ctx, rclient, cr)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assert.Equal(t, wantFinished, finished)
+		assert.Equal(t, wantPhase, cr.Status.Phase)
+	}
+	getJob := func() (*batchv1.Job, error) {
+		var job batchv1.Job
+		err := rclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.PrefixedName()}, &job)
+		return &job, err
+	}
+
+	// destructive task isn't started without confirmation
+	reconcileTask(false, vmv1beta1.MaintenanceTaskAwaitingConfirmation)
+	if _, err := getJob(); err == nil {
+		t.Fatalf("job must not be created without confirmation")
+	}
+
+	// confirmation of the current generation starts job
+	cr.Annotations = map[string]string{vmv1beta1.ConfirmDestructiveChangesAnnotation: "1"}
+	if err := rclient.Update(ctx, cr); err != nil {
+		t.Fatalf("cannot update task: %s", err)
+	}
+	reconcileTask(false, vmv1beta1.MaintenanceTaskRunning)
+	assert.NotNil(t, cr.Status.StartTime)
+	job, err := getJob()
+	if err != nil {
+		t.Fatalf("cannot get job: %s", err)
+	}
+	assert.Equal(t, []string{"curl"}, job.Spec.Template.Spec.Containers[0].Command)
+	assert.NotContains(t, job.Annotations, vmv1beta1.ConfirmDestructiveChangesAnnotation)
+
+	// job is in progress
+	reconcileTask(false, vmv1beta1.MaintenanceTaskRunning)
+
+	// job completion is recorded at status
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	if err := rclient.Status().Update(ctx, job); err != nil {
+		t.Fatalf("cannot update job status: %s", err)
+	}
+	reconcileTask(true, vmv1beta1.MaintenanceTaskSucceeded)
+	assert.NotNil(t, cr.Status.CompletionTime)
+	assert.Equal(t, "deleteSeries finished for VMSingle=main", cr.Status.Message)
+
+	// finished task isn't started again
+	reconcileTask(true, vmv1beta1.MaintenanceTaskSucceeded)
+}
@@ -0,0 +1,76 @@
+package reconcile
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+type childrenCollectorKey struct{}
+
+// childrenCollector records objects reconciled for the parent object
+// it's safe for concurrent use, since objects could be reconciled in parallel
+type childrenCollector struct {
+	mu       sync.Mutex
+	children map[vmv1beta1.ChildObject]string
+}
+
+// WithChildrenCollector returns context, which records objects reconciled with it
+// recorded objects are returned by CollectedChildren
+func WithChildrenCollector(ctx context.Context) context.Context {
+	return context.WithValue(ctx, childrenCollectorKey{}, &childrenCollector{
+		children: make(map[vmv1beta1.ChildObject]string),
+	})
+}
+
+// TrackChild records given object as a child of the parent object from context
+// hash is calculated from the given desired state of object
+// it's no-op, if context has no collector
+func TrackChild(ctx context.Context, kind string, obj client.Object, spec any) {
+	c, ok := ctx.Value(childrenCollectorKey{}).(*childrenCollector)
+	if !ok {
+		return
+	}
+	key := vmv1beta1.ChildObject{
+		Kind:      kind,
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+	}
+	hash := SpecHash(obj, spec)
+	c.mu.Lock()
+	c.children[key] = hash
+	c.mu.Unlock()
+}
+
+// CollectedChildren returns objects recorded at the given context sorted by kind, namespace and name
+func CollectedChildren(ctx context.Context) []vmv1beta1.ChildObject {
+	c, ok := ctx.Value(childrenCollectorKey{}).(*childrenCollector)
+	if !ok {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.children) == 0 {
+		return nil
+	}
+	children := make([]vmv1beta1.ChildObject, 0, len(c.children))
+	for key, hash := range c.children {
+		key.LastAppliedHash = hash
+		children = append(children, key)
+	}
+	sort.Slice(children, func(i, j int) bool {
+		a, b := children[i], children[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return children
+}
@@ -0,0 +1,80 @@
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-test/deep"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+func TestCollectedChildren(t *testing.T) {
+	newSecret := func(name, value string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Data:       map[string][]byte{"key": []byte(value)},
+		}
+	}
+
+	// context without collector
+	ctx := context.Background()
+	TrackChild(ctx, "Secret", newSecret("vmagent-base", "value"), nil)
+	if got := CollectedChildren(ctx); got != nil {
+		t.Fatalf("expected no children without collector, got: %v", got)
+	}
+
+	// objects tracked in parallel are deduplicated and sorted
+	ctx = WithChildrenCollector(context.Background())
+	err := Parallel(ctx,
+		func(ctx context.Context) error {
+			s := newSecret("vmagent-tls", "value")
+			TrackChild(ctx, "Secret", s, s.Data)
+			return nil
+		},
+		func(ctx context.Context) error {
+			s := newSecret("vmagent-base", "value")
+			TrackChild(ctx, "Secret", s, s.Data)
+			TrackChild(ctx, "Secret", s, s.Data)
+			return nil
+		},
+		func(ctx context.Context) error {
+			sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "vmagent-base", Namespace: "default"}}
+			TrackChild(ctx, "ServiceAccount", sa, sa.ImagePullSecrets)
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	got := CollectedChildren(ctx)
+	var gotKeys []vmv1beta1.ChildObject
+	for _, child := range got {
+		if child.LastAppliedHash == "" {
+			t.Fatalf("expected hash for child: %+v", child)
+		}
+		child.LastAppliedHash = ""
+		gotKeys = append(gotKeys, child)
+	}
+	want := []vmv1beta1.ChildObject{
+		{Kind: "Secret", Name: "vmagent-base", Namespace: "default"},
+		{Kind: "Secret", Name: "vmagent-tls", Namespace: "default"},
+		{Kind: "ServiceAccount", Name: "vmagent-base", Namespace: "default"},
+	}
+	if diff := deep.Equal(gotKeys, want); len(diff) > 0 {
+		t.Fatalf("unexpected children: %v", diff)
+	}
+
+	// hash follows desired state
+	s := newSecret("vmagent-tls", "changed")
+	TrackChild(ctx, "Secret", s, s.Data)
+	changed := CollectedChildren(ctx)
+	if changed[1].LastAppliedHash == got[1].LastAppliedHash {
+		t.Fatalf("expected hash change for updated object")
+	}
+	if changed[0].LastAppliedHash != got[0].LastAppliedHash {
+		t.Fatalf("unexpected hash change for not updated object")
+	}
+}
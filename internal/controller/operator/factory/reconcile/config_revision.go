@@ -0,0 +1,134 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// configRevisionKind is a value of RevisionKindLabel for Secrets with history of generated configuration
+const configRevisionKind = "Config"
+
+var configRevisionHistoryLimit = 0
+
+// InitConfigRevisionHistory configures number of generated configuration revisions kept for VMAgent, VMAlertmanager and VMAlert
+// zero value disables revision tracking
+func InitConfigRevisionHistory(limit int) {
+	configRevisionHistoryLimit = limit
+}
+
+type configOwner interface {
+	client.Object
+	AsOwner() []metav1.OwnerReference
+}
+
+// configRevisionName returns name of Secret with the given revision of configuration
+func configRevisionName(name string, rev int64) string {
+	return fmt.Sprintf("%s-rev-%d", name, rev)
+}
+
+// ConfigRevision records generated configuration with the given name into revision history of parent object
+// and returns configuration data, which must be applied.
+// If parent object has ConfigRollbackToAnnotation, data of the requested revision is returned instead of generated one
+func ConfigRevision(ctx context.Context, rclient client.Client, parent configOwner, name string, data map[string][]byte) (map[string][]byte, error) {
+	rollbackTo := parent.GetAnnotations()[vmv1beta1.ConfigRollbackToAnnotation]
+	if configRevisionHistoryLimit == 0 && rollbackTo == "" {
+		return data, nil
+	}
+	history, err := configRevisionHistory(ctx, rclient, parent.GetNamespace(), name)
+	if err != nil {
+		return nil, err
+	}
+	if rollbackTo != "" {
+		rev, err := strconv.ParseInt(rollbackTo, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %s=%q: %w", vmv1beta1.ConfigRollbackToAnnotation, rollbackTo, err)
+		}
+		for i := range history {
+			if configRevisionOf(&history[i]) == rev {
+				logger.WithContext(ctx).Info(fmt.Sprintf("applying configuration revision=%d of %s from %s annotation", rev, name, vmv1beta1.ConfigRollbackToAnnotation))
+				return history[i].Data, nil
+			}
+		}
+		return nil, fmt.Errorf("cannot find configuration revision=%d of %s requested by %s annotation", rev, name, vmv1beta1.ConfigRollbackToAnnotation)
+	}
+
+	dataHash := secretDataHash(data)
+	var latest int64
+	if len(history) > 0 {
+		last := &history[len(history)-1]
+		if last.Annotations[secretDataHashAnnotation] == dataHash {
+			return data, nil
+		}
+		latest = configRevisionOf(last)
+	}
+	var size int
+	for _, v := range data {
+		size += len(v)
+	}
+	// revision Secret must fit into object size limit
+	if size > vmv1beta1.MaxConfigMapDataSize {
+		logger.WithContext(ctx).Info(fmt.Sprintf("skipping revision of %s, configuration size=%d exceeds size limit=%d", name, size, vmv1beta1.MaxConfigMapDataSize))
+		return data, nil
+	}
+	rev := latest + 1
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configRevisionName(name, rev),
+			Namespace: parent.GetNamespace(),
+			Labels:    map[string]string{vmv1beta1.RevisionKindLabel: configRevisionKind},
+			Annotations: map[string]string{
+				vmv1beta1.RevisionOfAnnotation: name,
+				vmv1beta1.RevisionAnnotation:   strconv.FormatInt(rev, 10),
+				secretDataHashAnnotation:       dataHash,
+			},
+			OwnerReferences: parent.AsOwner(),
+		},
+		Data: data,
+	}
+	if err := rclient.Create(ctx, s); err != nil {
+		return nil, fmt.Errorf("cannot create configuration revision=%d of %s: %w", rev, name, err)
+	}
+	events.Normal(ctx, events.ReasonConfigUpdated, "configuration revision=%d of %s recorded", rev, name)
+	history = append(history, *s)
+	for i := 0; i < len(history)-configRevisionHistoryLimit; i++ {
+		logger.WithContext(ctx).Info(fmt.Sprintf("removing configuration revision=%d of %s exceeding history limit", configRevisionOf(&history[i]), name))
+		if err := finalize.SafeDelete(ctx, rclient, &history[i]); err != nil {
+			return nil, fmt.Errorf("cannot remove configuration revision=%d of %s: %w", configRevisionOf(&history[i]), name, err)
+		}
+	}
+	return data, nil
+}
+
+// configRevisionHistory returns Secrets with revisions of configuration sorted by revision number
+func configRevisionHistory(ctx context.Context, rclient client.Client, namespace, name string) ([]corev1.Secret, error) {
+	var l corev1.SecretList
+	if err := rclient.List(ctx, &l, client.InNamespace(namespace), client.MatchingLabels{vmv1beta1.RevisionKindLabel: configRevisionKind}); err != nil {
+		return nil, fmt.Errorf("cannot list configuration revisions of %s: %w", name, err)
+	}
+	var history []corev1.Secret
+	for _, s := range l.Items {
+		if s.Annotations[vmv1beta1.RevisionOfAnnotation] == name {
+			history = append(history, s)
+		}
+	}
+	sort.Slice(history, func(i, j int) bool {
+		return configRevisionOf(&history[i]) < configRevisionOf(&history[j])
+	})
+	return history, nil
+}
+
+func configRevisionOf(s *corev1.Secret) int64 {
+	rev, _ := strconv.ParseInt(s.Annotations[vmv1beta1.RevisionAnnotation], 10, 64)
+	return rev
+}
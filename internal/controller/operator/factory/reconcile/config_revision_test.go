@@ -0,0 +1,74 @@
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestConfigRevision(t *testing.T) {
+	defer InitConfigRevisionHistory(0)
+	InitConfigRevisionHistory(2)
+
+	ctx := context.Background()
+	rclient := k8stools.GetTestClientWithObjects(nil)
+	parent := &vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"}}
+	f := func(config string, wantConfig string, wantHistory []string) {
+		t.Helper()
+		got, err := ConfigRevision(ctx, rclient, parent, "vmagent-main", map[string][]byte{"config.yaml": []byte(config)})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assert.Equal(t, wantConfig, string(got["config.yaml"]))
+		history, err := configRevisionHistory(ctx, rclient, "default", "vmagent-main")
+		if err != nil {
+			t.Fatalf("cannot get history: %s", err)
+		}
+		var gotHistory []string
+		for _, s := range history {
+			gotHistory = append(gotHistory, s.Name+"="+string(s.Data["config.yaml"]))
+		}
+		assert.Equal(t, wantHistory, gotHistory)
+	}
+
+	// revision is recorded only on configuration change
+	f("v1", "v1", []string{"vmagent-main-rev-1=v1"})
+	f("v1", "v1", []string{"vmagent-main-rev-1=v1"})
+	f("v2", "v2", []string{"vmagent-main-rev-1=v1", "vmagent-main-rev-2=v2"})
+
+	// history is limited
+	f("v3", "v3", []string{"vmagent-main-rev-2=v2", "vmagent-main-rev-3=v3"})
+
+	// rollback to revision from history
+	parent.Annotations = map[string]string{vmv1beta1.ConfigRollbackToAnnotation: "2"}
+	f("v4", "v2", []string{"vmagent-main-rev-2=v2", "vmagent-main-rev-3=v3"})
+
+	// missing and bad revisions
+	for _, rev := range []string{"1", "bad"} {
+		parent.Annotations = map[string]string{vmv1beta1.ConfigRollbackToAnnotation: rev}
+		_, err := ConfigRevision(ctx, rclient, parent, "vmagent-main", map[string][]byte{"config.yaml": []byte("v4")})
+		assert.Error(t, err)
+	}
+
+	// generated configuration is applied after annotation removal
+	parent.Annotations = nil
+	f("v4", "v4", []string{"vmagent-main-rev-3=v3", "vmagent-main-rev-4=v4"})
+
+	// revisions of other configurations are ignored
+	var other corev1.Secret
+	other.Name, other.Namespace = "vmagent-other-rev-1", "default"
+	other.Labels = map[string]string{vmv1beta1.RevisionKindLabel: configRevisionKind}
+	other.Annotations = map[string]string{vmv1beta1.RevisionOfAnnotation: "vmagent-other", vmv1beta1.RevisionAnnotation: "1"}
+	assert.NoError(t, rclient.Create(ctx, &other))
+	f("v4", "v4", []string{"vmagent-main-rev-3=v3", "vmagent-main-rev-4=v4"})
+
+	// disabled history
+	InitConfigRevisionHistory(0)
+	f("v5", "v5", []string{"vmagent-main-rev-3=v3", "vmagent-main-rev-4=v4"})
+}
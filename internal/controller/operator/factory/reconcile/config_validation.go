@@ -0,0 +1,142 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+)
+
+const (
+	configValidationHashAnnotation = "operator.victoriametrics.com/config-validation-hash"
+	// termination message is limited by kubelet with 4096 bytes
+	maxConfigValidationMessageLen = 4096
+)
+
+// ConfigValidationPendingError is returned until validation job for the current configuration is finished
+type ConfigValidationPendingError struct {
+	Job string
+}
+
+// Error implements error interface
+func (e *ConfigValidationPendingError) Error() string {
+	return fmt.Sprintf("waiting for configuration validation job=%s", e.Job)
+}
+
+// ConfigValidationFailedError is returned if configuration didn't pass validation job
+type ConfigValidationFailedError struct {
+	Job    string
+	Output string
+}
+
+// Error implements error interface
+func (e *ConfigValidationFailedError) Error() string {
+	return fmt.Sprintf("configuration validation job=%s failed: %s", e.Job, e.Output)
+}
+
+// ConfigValidation runs given job for validation of configuration data
+// job is re-created on changes of data or job containers.
+// It returns nil, if job for the current configuration completed successfully,
+// ConfigValidationPendingError until job is finished and ConfigValidationFailedError if job failed
+func ConfigValidation(ctx context.Context, rclient client.Client, job *batchv1.Job, data map[string][]byte) error {
+	hash := configValidationHash(job, data)
+	job.Annotations = labels.Merge(job.Annotations, map[string]string{configValidationHashAnnotation: hash})
+	job.Spec.Template.Labels = labels.Merge(job.Spec.Template.Labels, map[string]string{configValidationHashAnnotation: hash})
+
+	var currentJob batchv1.Job
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: job.Namespace, Name: job.Name}, &currentJob); err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("cannot get configuration validation job=%s: %w", job.Name, err)
+		}
+		logger.WithContext(ctx).Info("starting configuration validation job", "job", job.Name, "hash", hash)
+		if err := rclient.Create(ctx, job); err != nil {
+			return fmt.Errorf("cannot create configuration validation job=%s: %w", job.Name, err)
+		}
+		return &ConfigValidationPendingError{Job: job.Name}
+	}
+	if !currentJob.DeletionTimestamp.IsZero() {
+		// wait for previous validation removal
+		return &ConfigValidationPendingError{Job: job.Name}
+	}
+	if currentJob.Annotations[configValidationHashAnnotation] != hash {
+		logger.WithContext(ctx).Info("configuration changed, removing outdated validation job", "job", job.Name)
+		if err := deleteConfigValidationJob(ctx, rclient, &currentJob); err != nil {
+			return err
+		}
+		return &ConfigValidationPendingError{Job: job.Name}
+	}
+	for _, cond := range currentJob.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return nil
+		case batchv1.JobFailed:
+			output, err := configValidationOutput(ctx, rclient, job)
+			if err != nil {
+				return err
+			}
+			return &ConfigValidationFailedError{Job: job.Name, Output: output}
+		}
+	}
+	return &ConfigValidationPendingError{Job: job.Name}
+}
+
+// RemoveConfigValidation removes configuration validation job, if it exists
+func RemoveConfigValidation(ctx context.Context, rclient client.Client, namespace, name string) error {
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	return deleteConfigValidationJob(ctx, rclient, job)
+}
+
+func deleteConfigValidationJob(ctx context.Context, rclient client.Client, job *batchv1.Job) error {
+	if err := rclient.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("cannot delete configuration validation job=%s: %w", job.Name, err)
+	}
+	return nil
+}
+
+// configValidationHash calculates hash of configuration data and validation containers
+func configValidationHash(job *batchv1.Job, data map[string][]byte) string {
+	h := fnv.New64a()
+	h.Write([]byte(secretDataHash(data)))
+	for _, c := range job.Spec.Template.Spec.Containers {
+		h.Write([]byte(c.Image))
+		h.Write([]byte{0})
+		h.Write([]byte(strings.Join(c.Command, " ")))
+		h.Write([]byte{0})
+		h.Write([]byte(strings.Join(c.Args, " ")))
+		h.Write([]byte{0})
+	}
+	return fmt.Sprintf("%x", h.Sum64())
+}
+
+// configValidationOutput returns termination message of failed validation container
+func configValidationOutput(ctx context.Context, rclient client.Client, job *batchv1.Job) (string, error) {
+	var pods corev1.PodList
+	if err := rclient.List(ctx, &pods, client.InNamespace(job.Namespace), client.MatchingLabels(job.Spec.Template.Labels)); err != nil {
+		return "", fmt.Errorf("cannot list pods of configuration validation job=%s: %w", job.Name, err)
+	}
+	for _, pod := range pods.Items {
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.State.Terminated != nil && cs.State.Terminated.Message != "" {
+				msg := strings.TrimSpace(cs.State.Terminated.Message)
+				if len(msg) > maxConfigValidationMessageLen {
+					msg = msg[len(msg)-maxConfigValidationMessageLen:]
+				}
+				return msg, nil
+			}
+		}
+	}
+	return "check logs of job=" + job.Name, nil
+}
@@ -0,0 +1,145 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestConfigValidation(t *testing.T) {
+	newJob := func() *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "vmalertmanager-base-config-validation", Namespace: "default"},
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app.kubernetes.io/name": "vmalertmanager-config-validation"}},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:  "amtool",
+							Image: "prom/alertmanager:v0.27.0",
+							Args:  []string{"check-config", "/etc/alertmanager/config/alertmanager.yaml"},
+						}},
+					},
+				},
+			},
+		}
+	}
+	data := map[string][]byte{"alertmanager.yaml": []byte("route: {}")}
+	existingJob := func(hashData map[string][]byte, conditions ...batchv1.JobCondition) *batchv1.Job {
+		job := newJob()
+		hash := configValidationHash(job, hashData)
+		job.Annotations = map[string]string{configValidationHashAnnotation: hash}
+		job.Spec.Template.Labels[configValidationHashAnnotation] = hash
+		job.Status.Conditions = conditions
+		return job
+	}
+	type opts struct {
+		predefinedObjects []runtime.Object
+		wantPending       bool
+		wantOutput        string
+		wantJob           bool
+	}
+	f := func(o opts) {
+		t.Helper()
+		ctx := context.Background()
+		fclient := k8stools.GetTestClientWithObjects(o.predefinedObjects)
+		err := ConfigValidation(ctx, fclient, newJob(), data)
+		var pe *ConfigValidationPendingError
+		var fe *ConfigValidationFailedError
+		switch {
+		case o.wantPending:
+			if !errors.As(err, &pe) {
+				t.Fatalf("expected pending error, got: %v", err)
+			}
+		case len(o.wantOutput) > 0:
+			if !errors.As(err, &fe) {
+				t.Fatalf("expected failed error, got: %v", err)
+			}
+			if fe.Output != o.wantOutput {
+				t.Fatalf("unexpected validation output, got: %q, want: %q", fe.Output, o.wantOutput)
+			}
+		case err != nil:
+			t.Fatalf("unexpected error: %s", err)
+		}
+		var got batchv1.Job
+		err = fclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vmalertmanager-base-config-validation"}, &got)
+		if o.wantJob {
+			if err != nil {
+				t.Fatalf("cannot get validation job: %s", err)
+			}
+			if got.Annotations[configValidationHashAnnotation] != configValidationHash(newJob(), data) {
+				t.Fatalf("unexpected validation hash annotation: %q", got.Annotations[configValidationHashAnnotation])
+			}
+		} else if !k8serrors.IsNotFound(err) {
+			t.Fatalf("expected validation job to be removed, got: %v", err)
+		}
+	}
+
+	// new job
+	f(opts{
+		wantPending: true,
+		wantJob:     true,
+	})
+
+	// job is running
+	f(opts{
+		predefinedObjects: []runtime.Object{existingJob(data)},
+		wantPending:       true,
+		wantJob:           true,
+	})
+
+	// job completed
+	f(opts{
+		predefinedObjects: []runtime.Object{
+			existingJob(data, batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}),
+		},
+		wantJob: true,
+	})
+
+	// job failed with termination message
+	failedJob := existingJob(data, batchv1.JobCondition{Type: batchv1.JobFailed, Status: corev1.ConditionTrue})
+	f(opts{
+		predefinedObjects: []runtime.Object{
+			failedJob,
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "vmalertmanager-base-config-validation-abcd", Namespace: "default", Labels: failedJob.Spec.Template.Labels},
+				Status: corev1.PodStatus{
+					ContainerStatuses: []corev1.ContainerStatus{{
+						Name: "amtool",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Message: "FAILED: undefined receiver \"slack\" used in route\n"},
+						},
+					}},
+				},
+			},
+		},
+		wantOutput: "FAILED: undefined receiver \"slack\" used in route",
+		wantJob:    true,
+	})
+
+	// job failed without pods
+	f(opts{
+		predefinedObjects: []runtime.Object{
+			existingJob(data, batchv1.JobCondition{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}),
+		},
+		wantOutput: "check logs of job=vmalertmanager-base-config-validation",
+		wantJob:    true,
+	})
+
+	// outdated completed job is removed
+	f(opts{
+		predefinedObjects: []runtime.Object{
+			existingJob(map[string][]byte{"alertmanager.yaml": []byte("route: {receiver: blackhole}")},
+				batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}),
+		},
+		wantPending: true,
+	})
+}
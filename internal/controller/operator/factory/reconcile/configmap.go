@@ -3,7 +3,8 @@ package reconcile
 import (
 	"context"
 
-	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -15,14 +16,15 @@ import (
 
 // ConfigMap reconciles configmap object
 func ConfigMap(ctx context.Context, rclient client.Client, cm *corev1.ConfigMap) error {
+	TrackChild(ctx, "ConfigMap", cm, cm.Data)
 	var existCM corev1.ConfigMap
 	if err := rclient.Get(ctx, types.NamespacedName{Namespace: cm.Namespace, Name: cm.Name}, &existCM); err != nil {
 		if errors.IsNotFound(err) {
-			return rclient.Create(ctx, cm)
+			return createChild(ctx, rclient, cm)
 		}
 	}
 	cm.Annotations = labels.Merge(existCM.Annotations, cm.Annotations)
-	vmv1beta1.AddFinalizer(cm, &existCM)
+	finalize.MergeFinalizers(cm, &existCM)
 
 	if equality.Semantic.DeepEqual(cm.Data, existCM.Data) &&
 		equality.Semantic.DeepEqual(cm.Labels, existCM.Labels) &&
@@ -31,5 +33,9 @@ func ConfigMap(ctx context.Context, rclient client.Client, cm *corev1.ConfigMap)
 	}
 	logger.WithContext(ctx).Info("updating configmap configuration", "cm_name", cm.Name)
 
-	return rclient.Update(ctx, cm)
+	if err := rclient.Update(ctx, cm); err != nil {
+		return err
+	}
+	events.Normal(ctx, events.ReasonConfigUpdated, "configuration configmap=%s updated", cm.Name)
+	return nil
 }
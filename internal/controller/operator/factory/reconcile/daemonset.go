@@ -0,0 +1,112 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/tracing"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DaemonSet performs an update or create operator for daemonset and waits until its pods are ready at all nodes
+func DaemonSet(ctx context.Context, rclient client.Client, newDS, prevDS *appsv1.DaemonSet) (err error) {
+	ctx, span := tracing.Start(ctx, tracing.SpanApply, tracing.ObjectAttributes("DaemonSet", newDS.Namespace, newDS.Name)...)
+	defer func() { tracing.End(span, err) }()
+	TrackChild(ctx, "DaemonSet", newDS, &newDS.Spec)
+
+	var isPrevEqual bool
+	if prevDS != nil {
+		isPrevEqual = equality.Semantic.DeepDerivative(prevDS.Spec, newDS.Spec)
+	}
+	rclient.Scheme().Default(newDS)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var currentDS appsv1.DaemonSet
+		err := rclient.Get(ctx, types.NamespacedName{Name: newDS.Name, Namespace: newDS.Namespace}, &currentDS)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				if err := createChild(ctx, rclient, newDS); err != nil {
+					return fmt.Errorf("cannot create new daemonset for app: %s, err: %w", newDS.Name, err)
+				}
+				return waitDaemonSetReady(ctx, rclient, newDS, appWaitReadyDeadline)
+			}
+			return fmt.Errorf("cannot get daemonset for app: %s err: %w", newDS.Name, err)
+		}
+		if err := finalize.FreeIfNeeded(ctx, rclient, &currentDS); err != nil {
+			return err
+		}
+		newDS.Spec.Template.Annotations = labels.Merge(currentDS.Spec.Template.Annotations, newDS.Spec.Template.Annotations)
+		newDS.Status = currentDS.Status
+		newDS.Annotations = labels.Merge(currentDS.Annotations, newDS.Annotations)
+		finalize.MergeFinalizers(newDS, &currentDS)
+
+		isEqual := equality.Semantic.DeepDerivative(newDS.Spec, currentDS.Spec)
+		// desired state wasn't changed, but the current daemonset differs from it
+		hasDrift := isPrevEqual && !isEqual
+		reportDrift(ctx, "DaemonSet", newDS, hasDrift)
+		if hasDrift && !driftAutoRevert {
+			// keep manual changes
+			newDS.Spec = currentDS.Spec
+			isEqual = true
+		}
+		if isEqual &&
+			isPrevEqual &&
+			equality.Semantic.DeepEqual(newDS.Labels, currentDS.Labels) &&
+			equality.Semantic.DeepEqual(newDS.Annotations, currentDS.Annotations) {
+			return waitDaemonSetReady(ctx, rclient, newDS, appWaitReadyDeadline)
+		}
+		logger.WithContext(ctx).Info("updating daemonset configuration",
+			"is_prev_equal", isPrevEqual, "is_current_equal", isEqual,
+			"is_prev_nil", prevDS == nil)
+		reportUpdateDiff(ctx, "DaemonSet", newDS, objectDiff(
+			diffView{Labels: currentDS.Labels, Annotations: currentDS.Annotations, Spec: currentDS.Spec},
+			diffView{Labels: newDS.Labels, Annotations: newDS.Annotations, Spec: newDS.Spec}))
+
+		if err := rclient.Update(ctx, newDS); err != nil {
+			return fmt.Errorf("cannot update daemonset for app: %s, err: %w", newDS.Name, err)
+		}
+		events.Normal(ctx, events.ReasonRollingUpdateStarted, "rolling update of daemonset=%s started", newDS.Name)
+
+		if err := waitDaemonSetReady(ctx, rclient, newDS, appWaitReadyDeadline); err != nil {
+			return err
+		}
+		events.Normal(ctx, events.ReasonRollingUpdateFinished, "rolling update of daemonset=%s finished", newDS.Name)
+		return nil
+	})
+}
+
+// waitDaemonSetReady waits until daemonset pods are updated and available at all scheduled nodes
+func waitDaemonSetReady(ctx context.Context, rclient client.Client, ds *appsv1.DaemonSet, deadline time.Duration) (err error) {
+	ctx, span := tracing.Start(ctx, tracing.SpanWaitReady, tracing.ObjectAttributes("DaemonSet", ds.Namespace, ds.Name)...)
+	defer func() { tracing.End(span, err) }()
+	err = wait.PollUntilContextTimeout(ctx, time.Second, deadline, false, func(ctx context.Context) (done bool, err error) {
+		var actualDS appsv1.DaemonSet
+		if err := rclient.Get(ctx, types.NamespacedName{Namespace: ds.Namespace, Name: ds.Name}, &actualDS); err != nil {
+			return false, fmt.Errorf("cannot fetch actual daemonset state: %w", err)
+		}
+		return isDaemonSetReady(&actualDS), nil
+	})
+	if err != nil {
+		return reportFirstNotReadyPodOnError(ctx, rclient, fmt.Errorf("cannot wait for daemonset to become ready: %w", err), ds.Namespace, labels.SelectorFromSet(ds.Spec.Selector.MatchLabels), ds.Spec.MinReadySeconds)
+	}
+	return nil
+}
+
+func isDaemonSetReady(ds *appsv1.DaemonSet) bool {
+	if ds.Status.ObservedGeneration < ds.Generation {
+		return false
+	}
+	return ds.Status.UpdatedNumberScheduled >= ds.Status.DesiredNumberScheduled &&
+		ds.Status.NumberUnavailable == 0
+}
@@ -0,0 +1,85 @@
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestDaemonSetReconcile(t *testing.T) {
+	newDS := func(image string) *appsv1.DaemonSet {
+		return &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "vmagent-base", Namespace: "default"},
+			Spec: appsv1.DaemonSetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "vmagent"}},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "vmagent"}},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "vmagent", Image: image}},
+					},
+				},
+			},
+		}
+	}
+	f := func(current *appsv1.DaemonSet, desired *appsv1.DaemonSet, wantCreates, wantUpdates int64) {
+		t.Helper()
+		ctx := context.Background()
+		var predefinedObjects []runtime.Object
+		if current != nil {
+			predefinedObjects = append(predefinedObjects, current)
+		}
+		rclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		clientStats := rclient.(*k8stools.TestClientWithStatsTrack)
+		if err := DaemonSet(ctx, rclient, desired, nil); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got := clientStats.CreateCalls.Load(); got != wantCreates {
+			t.Fatalf("unexpected create calls, got: %d, want: %d", got, wantCreates)
+		}
+		if got := clientStats.UpdateCalls.Load(); got != wantUpdates {
+			t.Fatalf("unexpected update calls, got: %d, want: %d", got, wantUpdates)
+		}
+		var got appsv1.DaemonSet
+		if err := rclient.Get(ctx, types.NamespacedName{Namespace: desired.Namespace, Name: desired.Name}, &got); err != nil {
+			t.Fatalf("cannot get daemonset: %s", err)
+		}
+		if got.Spec.Template.Spec.Containers[0].Image != desired.Spec.Template.Spec.Containers[0].Image {
+			t.Fatalf("unexpected image: %q", got.Spec.Template.Spec.Containers[0].Image)
+		}
+	}
+
+	// new daemonset
+	f(nil, newDS("vmagent:v1.100.0"), 1, 0)
+
+	// changed image
+	f(newDS("vmagent:v1.100.0"), newDS("vmagent:v1.101.0"), 0, 1)
+}
+
+func TestIsDaemonSetReady(t *testing.T) {
+	f := func(generation int64, status appsv1.DaemonSetStatus, want bool) {
+		t.Helper()
+		ds := &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Generation: generation}, Status: status}
+		if got := isDaemonSetReady(ds); got != want {
+			t.Fatalf("unexpected readiness, got: %v, want: %v", got, want)
+		}
+	}
+
+	// not observed generation
+	f(2, appsv1.DaemonSetStatus{ObservedGeneration: 1, DesiredNumberScheduled: 3, UpdatedNumberScheduled: 3}, false)
+
+	// rollout in progress
+	f(2, appsv1.DaemonSetStatus{ObservedGeneration: 2, DesiredNumberScheduled: 3, UpdatedNumberScheduled: 2, NumberUnavailable: 1}, false)
+
+	// unavailable pod
+	f(2, appsv1.DaemonSetStatus{ObservedGeneration: 2, DesiredNumberScheduled: 3, UpdatedNumberScheduled: 3, NumberUnavailable: 1}, false)
+
+	// all pods are updated and available
+	f(2, appsv1.DaemonSetStatus{ObservedGeneration: 2, DesiredNumberScheduled: 3, UpdatedNumberScheduled: 3, NumberAvailable: 3}, true)
+}
@@ -5,9 +5,10 @@ import (
 	"fmt"
 	"time"
 
-	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/tracing"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -20,7 +21,10 @@ import (
 )
 
 // Deployment performs an update or create operator for deployment and waits until it's replicas is ready
-func Deployment(ctx context.Context, rclient client.Client, newDeploy, prevDeploy *appsv1.Deployment, hasHPA bool) error {
+func Deployment(ctx context.Context, rclient client.Client, newDeploy, prevDeploy *appsv1.Deployment, hasHPA bool) (err error) {
+	ctx, span := tracing.Start(ctx, tracing.SpanApply, tracing.ObjectAttributes("Deployment", newDeploy.Namespace, newDeploy.Name)...)
+	defer func() { tracing.End(span, err) }()
+	TrackChild(ctx, "Deployment", newDeploy, &newDeploy.Spec)
 
 	var isPrevEqual bool
 	if prevDeploy != nil {
@@ -33,9 +37,13 @@ func Deployment(ctx context.Context, rclient client.Client, newDeploy, prevDeplo
 		err := rclient.Get(ctx, types.NamespacedName{Name: newDeploy.Name, Namespace: newDeploy.Namespace}, &currentDeploy)
 		if err != nil {
 			if errors.IsNotFound(err) {
-				if err := rclient.Create(ctx, newDeploy); err != nil {
+				rev := setRevisionAnnotations(ctx, newDeploy, nil, true)
+				if err := createChild(ctx, rclient, newDeploy); err != nil {
 					return fmt.Errorf("cannot create new deployment for app: %s, err: %w", newDeploy.Name, err)
 				}
+				if err := recordRevision(ctx, rclient, newDeploy, &newDeploy.Spec.Template, rev); err != nil {
+					return err
+				}
 				return waitDeploymentReady(ctx, rclient, newDeploy, appWaitReadyDeadline)
 			}
 			return fmt.Errorf("cannot get deployment for app: %s err: %w", newDeploy.Name, err)
@@ -49,9 +57,27 @@ func Deployment(ctx context.Context, rclient client.Client, newDeploy, prevDeplo
 		newDeploy.Spec.Template.Annotations = labels.Merge(currentDeploy.Spec.Template.Annotations, newDeploy.Spec.Template.Annotations)
 		newDeploy.Status = currentDeploy.Status
 		newDeploy.Annotations = labels.Merge(currentDeploy.Annotations, newDeploy.Annotations)
-		vmv1beta1.AddFinalizer(newDeploy, &currentDeploy)
+		finalize.MergeFinalizers(newDeploy, &currentDeploy)
 
 		isEqual := equality.Semantic.DeepDerivative(newDeploy.Spec, currentDeploy.Spec)
+		var rev int64
+		if isRollbackActive(ctx, &currentDeploy) {
+			// keep rolled back revision until the next change of parent object
+			newDeploy.Spec = currentDeploy.Spec
+			keepRollbackAnnotations(newDeploy, &currentDeploy)
+			isEqual = true
+		} else {
+			// desired state wasn't changed, but the current deployment differs from it
+			hasDrift := isPrevEqual && !isEqual
+			reportDrift(ctx, "Deployment", newDeploy, hasDrift)
+			if hasDrift && !driftAutoRevert {
+				// keep manual changes
+				newDeploy.Spec = currentDeploy.Spec
+				isEqual = true
+			}
+			templateChanged := !equality.Semantic.DeepDerivative(newDeploy.Spec.Template, currentDeploy.Spec.Template)
+			rev = setRevisionAnnotations(ctx, newDeploy, &currentDeploy, templateChanged)
+		}
 		if isEqual &&
 			isPrevEqual &&
 			equality.Semantic.DeepEqual(newDeploy.Labels, currentDeploy.Labels) &&
@@ -61,18 +87,31 @@ func Deployment(ctx context.Context, rclient client.Client, newDeploy, prevDeplo
 		logger.WithContext(ctx).Info("updating deployment configuration",
 			"is_prev_equal", isPrevEqual, "is_current_equal", isEqual,
 			"is_prev_nil", prevDeploy == nil)
+		reportUpdateDiff(ctx, "Deployment", newDeploy, objectDiff(
+			diffView{Labels: currentDeploy.Labels, Annotations: currentDeploy.Annotations, Spec: currentDeploy.Spec},
+			diffView{Labels: newDeploy.Labels, Annotations: newDeploy.Annotations, Spec: newDeploy.Spec}))
 
 		if err := rclient.Update(ctx, newDeploy); err != nil {
 			return fmt.Errorf("cannot update deployment for app: %s, err: %w", newDeploy.Name, err)
 		}
+		if err := recordRevision(ctx, rclient, newDeploy, &newDeploy.Spec.Template, rev); err != nil {
+			return err
+		}
+		events.Normal(ctx, events.ReasonRollingUpdateStarted, "rolling update of deployment=%s started", newDeploy.Name)
 
-		return waitDeploymentReady(ctx, rclient, newDeploy, appWaitReadyDeadline)
+		if err := waitDeploymentReady(ctx, rclient, newDeploy, appWaitReadyDeadline); err != nil {
+			return err
+		}
+		events.Normal(ctx, events.ReasonRollingUpdateFinished, "rolling update of deployment=%s finished", newDeploy.Name)
+		return nil
 	})
 }
 
 // waitDeploymentReady waits until deployment's replicaSet rollouts and all new pods is ready
-func waitDeploymentReady(ctx context.Context, rclient client.Client, dep *appsv1.Deployment, deadline time.Duration) error {
-	err := wait.PollUntilContextTimeout(ctx, time.Second, deadline, false, func(ctx context.Context) (done bool, err error) {
+func waitDeploymentReady(ctx context.Context, rclient client.Client, dep *appsv1.Deployment, deadline time.Duration) (err error) {
+	ctx, span := tracing.Start(ctx, tracing.SpanWaitReady, tracing.ObjectAttributes("Deployment", dep.Namespace, dep.Name)...)
+	defer func() { tracing.End(span, err) }()
+	err = wait.PollUntilContextTimeout(ctx, time.Second, deadline, false, func(ctx context.Context) (done bool, err error) {
 		var actualDeploy appsv1.Deployment
 		if err := rclient.Get(ctx, types.NamespacedName{Namespace: dep.Namespace, Name: dep.Name}, &actualDeploy); err != nil {
 			return false, fmt.Errorf("cannot fetch actual deployment state: %w", err)
@@ -18,6 +18,9 @@ import (
 )
 
 func TestDeployOk(t *testing.T) {
+	// api calls for revision history are checked at TestRevisionHistory
+	InitRevisionHistory(0)
+	defer InitRevisionHistory(10)
 	f := func(dep *appsv1.Deployment) {
 		t.Helper()
 		ctx := context.Background()
@@ -0,0 +1,90 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-test/deep"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+)
+
+// maxDiffEventMessageLen limits size of event message
+// API server rejects events with message longer than 1024 bytes
+const maxDiffEventMessageLen = 1000
+
+const redactedValue = "<redacted>"
+
+var diffEventsEnabled bool
+
+// InitDiffEvents configures emission of events with changes of child objects
+// changes are always logged at debug level
+func InitDiffEvents(enabled bool) {
+	diffEventsEnabled = enabled
+}
+
+// diffView defines compared parts of child object
+type diffView struct {
+	Labels      map[string]string
+	Annotations map[string]string
+	Spec        any
+}
+
+// objectDiff returns structural diff between current and desired state of object in path: current != desired format
+// values of env variables are redacted, since they may contain credentials
+func objectDiff(current, desired diffView) []string {
+	diff := deep.Equal(current, desired)
+	for i, d := range diff {
+		path, _, ok := strings.Cut(d, ": ")
+		if ok && isSensitiveDiffPath(path) {
+			diff[i] = path + ": " + redactedValue
+		}
+	}
+	return diff
+}
+
+func isSensitiveDiffPath(path string) bool {
+	return strings.Contains(path, ".Env.") || strings.HasSuffix(path, ".Env")
+}
+
+// secretDataDiff returns names of changed keys of secret data without values
+func secretDataDiff(current, desired map[string][]byte) []string {
+	var diff []string
+	for k, v := range desired {
+		cv, ok := current[k]
+		switch {
+		case !ok:
+			diff = append(diff, fmt.Sprintf("Data[%s]: added", k))
+		case string(cv) != string(v):
+			diff = append(diff, fmt.Sprintf("Data[%s]: changed", k))
+		}
+	}
+	for k := range current {
+		if _, ok := desired[k]; !ok {
+			diff = append(diff, fmt.Sprintf("Data[%s]: removed", k))
+		}
+	}
+	sort.Strings(diff)
+	return diff
+}
+
+// reportUpdateDiff logs changes of updated child object at debug level
+// and emits event for the parent object from context, if it's enabled
+func reportUpdateDiff(ctx context.Context, kind string, obj client.Object, diff []string) {
+	if len(diff) == 0 {
+		return
+	}
+	logger.WithContext(ctx).V(1).Info(fmt.Sprintf("changes of %s=%s", kind, obj.GetName()), "diff", diff)
+	if !diffEventsEnabled {
+		return
+	}
+	msg := strings.Join(diff, "; ")
+	if len(msg) > maxDiffEventMessageLen {
+		msg = msg[:maxDiffEventMessageLen] + "..."
+	}
+	events.Normal(ctx, events.ReasonChildObjectUpdated, "%s=%s updated: %s", kind, obj.GetName(), msg)
+}
@@ -0,0 +1,103 @@
+package reconcile
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+)
+
+func TestObjectDiff(t *testing.T) {
+	newSpec := func(image, password string) appsv1.DeploymentSpec {
+		return appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  "vmagent",
+						Image: image,
+						Env:   []corev1.EnvVar{{Name: "PASSWORD", Value: password}},
+					}},
+				},
+			},
+		}
+	}
+	f := func(current, desired diffView, want []string) {
+		t.Helper()
+		assert.Equal(t, want, objectDiff(current, desired))
+	}
+
+	// no changes
+	f(diffView{Spec: newSpec("vmagent:v1", "secret")}, diffView{Spec: newSpec("vmagent:v1", "secret")}, nil)
+
+	// image change
+	f(diffView{Spec: newSpec("vmagent:v1", "secret")}, diffView{Spec: newSpec("vmagent:v2", "secret")},
+		[]string{"Spec.Template.Spec.Containers.slice[0].Image: vmagent:v1 != vmagent:v2"})
+
+	// env value is redacted
+	f(diffView{Spec: newSpec("vmagent:v1", "secret")}, diffView{Spec: newSpec("vmagent:v1", "new-secret")},
+		[]string{"Spec.Template.Spec.Containers.slice[0].Env.slice[0].Value: <redacted>"})
+
+	// labels change
+	f(diffView{Labels: map[string]string{"app": "vmagent"}}, diffView{Labels: map[string]string{"app": "vmagent", "team": "infra"}},
+		[]string{"Labels.map[team]: <does not have key> != infra"})
+}
+
+func TestSecretDataDiff(t *testing.T) {
+	f := func(current, desired map[string][]byte, want []string) {
+		t.Helper()
+		assert.Equal(t, want, secretDataDiff(current, desired))
+	}
+
+	f(nil, nil, nil)
+	f(map[string][]byte{"config.yaml": []byte("a")}, map[string][]byte{"config.yaml": []byte("a")}, nil)
+	f(map[string][]byte{"config.yaml": []byte("a"), "old.yaml": []byte("c")}, map[string][]byte{"config.yaml": []byte("b"), "new.yaml": []byte("d")},
+		[]string{"Data[config.yaml]: changed", "Data[new.yaml]: added", "Data[old.yaml]: removed"})
+}
+
+func TestReportUpdateDiff(t *testing.T) {
+	defer InitDiffEvents(false)
+	defer events.Init(nil)
+	f := func(enabled bool, diff []string, wantEvent string) {
+		t.Helper()
+		InitDiffEvents(enabled)
+		recorder := record.NewFakeRecorder(10)
+		events.Init(recorder)
+		parent := &vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: "vmagent", Namespace: "default", UID: "1"}}
+		ctx := events.AddToContext(context.Background(), parent)
+		child := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "vmagent-config", Namespace: "default"}}
+		reportUpdateDiff(ctx, "Secret", child, diff)
+		var got string
+		select {
+		case got = <-recorder.Events:
+		default:
+		}
+		if wantEvent == "" {
+			assert.Empty(t, got)
+			return
+		}
+		assert.Equal(t, wantEvent, got)
+	}
+
+	// events are disabled
+	f(false, []string{"Data[config.yaml]: changed"}, "")
+
+	// no changes
+	f(true, nil, "")
+
+	f(true, []string{"Data[config.yaml]: changed", "Data[new.yaml]: added"},
+		"Normal ChildObjectUpdated Secret=vmagent-config updated: Data[config.yaml]: changed; Data[new.yaml]: added")
+
+	// long message is truncated
+	long := strings.Repeat("a", 2*maxDiffEventMessageLen)
+	f(true, []string{long}, "Normal ChildObjectUpdated Secret=vmagent-config updated: "+long[:maxDiffEventMessageLen]+"...")
+}
@@ -0,0 +1,69 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+)
+
+// secretDataHashAnnotation holds hash of secret data applied by operator
+// it allows to detect manual changes of secret data
+const secretDataHashAnnotation = "operator.victoriametrics.com/data-hash"
+
+var driftAutoRevert = true
+
+var objectDrift = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "vm_operator_object_drift",
+	Help: "Shows child objects, which were manually changed and differ from the state desired by operator",
+}, []string{"kind", "namespace", "name"})
+
+func init() {
+	metrics.Registry.MustRegister(objectDrift)
+}
+
+// InitDriftDetection configures handling of manual changes of child objects
+// if autoRevert is false, manual changes are only reported and kept until the next change of parent object
+func InitDriftDetection(autoRevert bool) {
+	driftAutoRevert = autoRevert
+}
+
+// reportDrift updates drift state of the given child object
+// and emits event for the parent object from context, if drift was detected
+func reportDrift(ctx context.Context, kind string, obj client.Object, hasDrift bool) {
+	if !hasDrift {
+		objectDrift.DeleteLabelValues(kind, obj.GetNamespace(), obj.GetName())
+		return
+	}
+	objectDrift.WithLabelValues(kind, obj.GetNamespace(), obj.GetName()).Set(1)
+	action := "reverting"
+	if !driftAutoRevert {
+		action = "keeping"
+	}
+	logger.WithContext(ctx).Info(fmt.Sprintf("detected manual changes of %s=%s, %s them", kind, obj.GetName(), action))
+	events.Warning(ctx, events.ReasonDriftDetected, "%s=%s was manually changed and differs from desired state, %s changes", kind, obj.GetName(), action)
+}
+
+// secretDataHash returns stable hash of secret data
+func secretDataHash(data map[string][]byte) string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	h := fnv.New64a()
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write(data[k])
+		h.Write([]byte{0})
+	}
+	return fmt.Sprintf("%x", h.Sum64())
+}
@@ -0,0 +1,111 @@
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestSecretDrift(t *testing.T) {
+	defer InitDriftDetection(true)
+	f := func(autoRevert bool, wantData string) {
+		t.Helper()
+		InitDriftDetection(autoRevert)
+		defer objectDrift.Reset()
+		ctx := context.Background()
+		rclient := k8stools.GetTestClientWithObjects(nil)
+		newSecret := func() *corev1.Secret {
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "default"},
+				Data:       map[string][]byte{"config.yaml": []byte("desired")},
+			}
+		}
+		if err := Secret(ctx, rclient, newSecret()); err != nil {
+			t.Fatalf("cannot create secret: %s", err)
+		}
+		assert.Equal(t, 0, testutil.CollectAndCount(objectDrift))
+
+		// manual change
+		var cur corev1.Secret
+		if err := rclient.Get(ctx, types.NamespacedName{Name: "config", Namespace: "default"}, &cur); err != nil {
+			t.Fatalf("cannot get secret: %s", err)
+		}
+		cur.Data["config.yaml"] = []byte("manual")
+		if err := rclient.Update(ctx, &cur); err != nil {
+			t.Fatalf("cannot update secret: %s", err)
+		}
+
+		if err := Secret(ctx, rclient, newSecret()); err != nil {
+			t.Fatalf("cannot reconcile secret: %s", err)
+		}
+		assert.Equal(t, float64(1), testutil.ToFloat64(objectDrift.WithLabelValues("Secret", "default", "config")))
+		if err := rclient.Get(ctx, types.NamespacedName{Name: "config", Namespace: "default"}, &cur); err != nil {
+			t.Fatalf("cannot get secret: %s", err)
+		}
+		assert.Equal(t, wantData, string(cur.Data["config.yaml"]))
+
+		// change of desired state isn't a drift
+		s := newSecret()
+		s.Data["config.yaml"] = []byte("updated")
+		if err := Secret(ctx, rclient, s); err != nil {
+			t.Fatalf("cannot reconcile secret: %s", err)
+		}
+		assert.Equal(t, 0, testutil.CollectAndCount(objectDrift))
+	}
+	f(true, "desired")
+	f(false, "manual")
+}
+
+func TestDeploymentDrift(t *testing.T) {
+	defer InitDriftDetection(true)
+	f := func(autoRevert bool, wantReplicas int32) {
+		t.Helper()
+		InitDriftDetection(autoRevert)
+		defer objectDrift.Reset()
+		ctx := context.Background()
+		newDeploy := func() *appsv1.Deployment {
+			return &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "vmagent", Namespace: "default"},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: ptr.To[int32](1),
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "vmagent"}},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "vmagent"}},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "vmagent", Image: "vmagent:v1"}},
+						},
+					},
+				},
+			}
+		}
+		// manually scaled deployment
+		current := newDeploy()
+		current.Spec.Replicas = ptr.To[int32](3)
+		current.Status.Conditions = []appsv1.DeploymentCondition{
+			{Type: appsv1.DeploymentProgressing, Reason: "NewReplicaSetAvailable", Status: "True"},
+		}
+		rclient := k8stools.GetTestClientWithObjects([]runtime.Object{current})
+
+		if err := Deployment(ctx, rclient, newDeploy(), newDeploy(), false); err != nil {
+			t.Fatalf("cannot reconcile deployment: %s", err)
+		}
+		assert.Equal(t, float64(1), testutil.ToFloat64(objectDrift.WithLabelValues("Deployment", "default", "vmagent")))
+		var got appsv1.Deployment
+		if err := rclient.Get(ctx, types.NamespacedName{Name: "vmagent", Namespace: "default"}, &got); err != nil {
+			t.Fatalf("cannot get deployment: %s", err)
+		}
+		assert.Equal(t, wantReplicas, *got.Spec.Replicas)
+	}
+	f(true, 1)
+	f(false, 3)
+}
@@ -18,11 +18,12 @@ import (
 
 // HPA creates or update horizontalPodAutoscaler object
 func HPA(ctx context.Context, rclient client.Client, targetHPA *v2.HorizontalPodAutoscaler) error {
+	TrackChild(ctx, "HorizontalPodAutoscaler", targetHPA, &targetHPA.Spec)
 	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		var existHPA v2.HorizontalPodAutoscaler
 		if err := rclient.Get(ctx, types.NamespacedName{Name: targetHPA.GetName(), Namespace: targetHPA.GetNamespace()}, &existHPA); err != nil {
 			if errors.IsNotFound(err) {
-				return rclient.Create(ctx, targetHPA)
+				return createChild(ctx, rclient, targetHPA)
 			}
 			return fmt.Errorf("cannot get exist hpa object: %w", err)
 		}
@@ -0,0 +1,32 @@
+package reconcile
+
+import (
+	"context"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+)
+
+// Ingress creates or updates Ingress
+func Ingress(ctx context.Context, rclient client.Client, newIngress *networkingv1.Ingress) error {
+	TrackChild(ctx, "Ingress", newIngress, &newIngress.Spec)
+	var existIngress networkingv1.Ingress
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: newIngress.Namespace, Name: newIngress.Name}, &existIngress); err != nil {
+		if errors.IsNotFound(err) {
+			return createChild(ctx, rclient, newIngress)
+		}
+		return err
+	}
+	if err := finalize.FreeIfNeeded(ctx, rclient, &existIngress); err != nil {
+		return err
+	}
+	// TODO compare
+	newIngress.Annotations = labels.Merge(existIngress.Annotations, newIngress.Annotations)
+	finalize.MergeFinalizers(newIngress, &existIngress)
+	return rclient.Update(ctx, newIngress)
+}
@@ -0,0 +1,45 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+var maxParallelChildren = 2
+
+// InitParallelism configures number of independent child objects,
+// which could be updated and waited for readiness concurrently
+func InitParallelism(limit int) {
+	if limit < 1 {
+		limit = 1
+	}
+	maxParallelChildren = limit
+}
+
+// Parallel runs given independent tasks concurrently with bounded number of workers.
+// Tasks are not cancelled on failure of other tasks in order to not interrupt rolling updates in progress.
+// It returns errors of all failed tasks.
+func Parallel(ctx context.Context, tasks ...func(ctx context.Context) error) error {
+	if len(tasks) == 1 {
+		return tasks[0](ctx)
+	}
+	var g errgroup.Group
+	g.SetLimit(maxParallelChildren)
+	var mu sync.Mutex
+	var errs []error
+	for _, task := range tasks {
+		g.Go(func() error {
+			if err := task(ctx); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return errors.Join(errs...)
+}
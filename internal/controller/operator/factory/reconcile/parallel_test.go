@@ -0,0 +1,67 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallel(t *testing.T) {
+	defer InitParallelism(2)
+	f := func(limit, tasksCount int, failed map[int]bool, wantMaxInflight int32) {
+		t.Helper()
+		InitParallelism(limit)
+		var inflight, maxInflight, completed atomic.Int32
+		var tasks []func(ctx context.Context) error
+		for i := 0; i < tasksCount; i++ {
+			tasks = append(tasks, func(_ context.Context) error {
+				n := inflight.Add(1)
+				for {
+					prev := maxInflight.Load()
+					if n <= prev || maxInflight.CompareAndSwap(prev, n) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+				inflight.Add(-1)
+				completed.Add(1)
+				if failed[i] {
+					return fmt.Errorf("task %d failed", i)
+				}
+				return nil
+			})
+		}
+		err := Parallel(context.Background(), tasks...)
+		assert.Equal(t, int32(tasksCount), completed.Load(), "all tasks must be completed")
+		assert.Equal(t, wantMaxInflight, maxInflight.Load())
+		if len(failed) == 0 {
+			assert.NoError(t, err)
+			return
+		}
+		for i := range failed {
+			assert.ErrorContains(t, err, fmt.Sprintf("task %d failed", i))
+		}
+	}
+
+	// no tasks
+	f(2, 0, nil, 0)
+
+	// single task
+	f(2, 1, nil, 1)
+
+	// concurrent tasks
+	f(2, 2, nil, 2)
+
+	// tasks are limited by workers count
+	f(2, 4, nil, 2)
+
+	// sequential execution
+	f(1, 3, nil, 1)
+
+	// failed task doesn't cancel others
+	f(2, 3, map[int]bool{0: true, 2: true}, 2)
+}
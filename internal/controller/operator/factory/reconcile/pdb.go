@@ -17,13 +17,14 @@ import (
 
 // PDB creates or updates PodDisruptionBudget
 func PDB(ctx context.Context, rclient client.Client, pdb *policyv1.PodDisruptionBudget) error {
+	TrackChild(ctx, "PodDisruptionBudget", pdb, &pdb.Spec)
 	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		currentPdb := &policyv1.PodDisruptionBudget{}
 		err := rclient.Get(ctx, types.NamespacedName{Namespace: pdb.Namespace, Name: pdb.Name}, currentPdb)
 		if err != nil {
 			if errors.IsNotFound(err) {
 				logger.WithContext(ctx).Info("creating new pdb", "pdb_name", pdb.Name)
-				return rclient.Create(ctx, pdb)
+				return createChild(ctx, rclient, pdb)
 			}
 			return fmt.Errorf("cannot get existing pdb: %s, err: %w", pdb.Name, err)
 		}
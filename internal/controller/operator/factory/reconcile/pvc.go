@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -21,13 +21,14 @@ import (
 // in case of deletion timestamp > 0 does nothing
 // user must manually remove finalizer if needed
 func PersistentVolumeClaim(ctx context.Context, rclient client.Client, pvc *corev1.PersistentVolumeClaim) error {
+	TrackChild(ctx, "PersistentVolumeClaim", pvc, &pvc.Spec)
 	l := logger.WithContext(ctx)
 	existPvc := &corev1.PersistentVolumeClaim{}
 	err := rclient.Get(ctx, types.NamespacedName{Namespace: pvc.Namespace, Name: pvc.Name}, existPvc)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			l.Info("creating new pvc")
-			if err := rclient.Create(ctx, pvc); err != nil {
+			if err := createChild(ctx, rclient, pvc); err != nil {
 				return fmt.Errorf("cannot create new pvc: %w", err)
 			}
 			return nil
@@ -66,7 +67,7 @@ func PersistentVolumeClaim(ctx context.Context, rclient client.Client, pvc *core
 	// keep old spec with new resource requests
 	pvc.Spec = existPvc.Spec
 	pvc.Spec.Resources = *newResources
-	vmv1beta1.AddFinalizer(pvc, existPvc)
+	finalize.MergeFinalizers(pvc, existPvc)
 
 	if err := rclient.Update(ctx, pvc); err != nil {
 		return err
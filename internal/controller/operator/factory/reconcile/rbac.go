@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
 
@@ -18,10 +17,14 @@ import (
 
 // RoleBinding reconciles rolebindg object
 func RoleBinding(ctx context.Context, rclient client.Client, rb *rbacv1.RoleBinding) error {
+	TrackChild(ctx, "RoleBinding", rb, struct {
+		RoleRef  rbacv1.RoleRef
+		Subjects []rbacv1.Subject
+	}{rb.RoleRef, rb.Subjects})
 	var existRoleBinding rbacv1.RoleBinding
 	if err := rclient.Get(ctx, types.NamespacedName{Namespace: rb.Namespace, Name: rb.Name}, &existRoleBinding); err != nil {
 		if errors.IsNotFound(err) {
-			return rclient.Create(ctx, rb)
+			return createChild(ctx, rclient, rb)
 		}
 		return fmt.Errorf("cannot get rolebinding for vmauth: %w", err)
 	}
@@ -42,17 +45,18 @@ func RoleBinding(ctx context.Context, rclient client.Client, rb *rbacv1.RoleBind
 	existRoleBinding.Labels = rb.Labels
 	existRoleBinding.Subjects = rb.Subjects
 	existRoleBinding.RoleRef = rb.RoleRef
-	vmv1beta1.AddFinalizer(&existRoleBinding, &existRoleBinding)
+	finalize.MergeFinalizers(&existRoleBinding, &existRoleBinding)
 
 	return rclient.Update(ctx, &existRoleBinding)
 }
 
 // Role reconciles role object
 func Role(ctx context.Context, rclient client.Client, rl *rbacv1.Role) error {
+	TrackChild(ctx, "Role", rl, rl.Rules)
 	var existRole rbacv1.Role
 	if err := rclient.Get(ctx, types.NamespacedName{Namespace: rl.Namespace, Name: rl.Name}, &existRole); err != nil {
 		if errors.IsNotFound(err) {
-			return rclient.Create(ctx, rl)
+			return createChild(ctx, rclient, rl)
 		}
 		return fmt.Errorf("cannot get role for vmauth: %w", err)
 	}
@@ -71,7 +75,7 @@ func Role(ctx context.Context, rclient client.Client, rl *rbacv1.Role) error {
 
 	existRole.Labels = rl.Labels
 	existRole.Rules = rl.Rules
-	vmv1beta1.AddFinalizer(&existRole, &existRole)
+	finalize.MergeFinalizers(&existRole, &existRole)
 
 	return rclient.Update(ctx, &existRole)
 }
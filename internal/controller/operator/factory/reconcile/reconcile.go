@@ -1,7 +1,12 @@
 package reconcile
 
 import (
+	"context"
+	"reflect"
 	"time"
+
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var (
@@ -16,3 +21,13 @@ func InitDeadlines(intervalCheck, appWaitDeadline, podReadyDeadline time.Duratio
 	appWaitReadyDeadline = appWaitDeadline
 	podWaitReadyTimeout = podReadyDeadline
 }
+
+// createChild creates given child object
+// and reports creation error with event for the parent object from context
+func createChild(ctx context.Context, rclient client.Client, obj client.Object) error {
+	if err := rclient.Create(ctx, obj); err != nil {
+		events.Warning(ctx, events.ReasonChildObjectError, "cannot create %s=%s/%s: %s", reflect.TypeOf(obj).Elem().Name(), obj.GetNamespace(), obj.GetName(), err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/VictoriaMetrics/operator/api/client/revision"
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var revisionHistoryLimit = 10
+
+// InitRevisionHistory configures number of pod template revisions kept for Deployments and StatefulSets
+// zero value disables revision tracking
+func InitRevisionHistory(limit int) {
+	revisionHistoryLimit = limit
+}
+
+// parentGeneration returns generation of parent object from context
+func parentGeneration(ctx context.Context) string {
+	parent := events.ObjectFromContext(ctx)
+	if parent == nil || parent.GetGeneration() == 0 {
+		return ""
+	}
+	return strconv.FormatInt(parent.GetGeneration(), 10)
+}
+
+// isRollbackActive checks if current object was rolled back to the revision from history
+// and parent object wasn't changed since then
+func isRollbackActive(ctx context.Context, current client.Object) bool {
+	annotations := current.GetAnnotations()
+	if annotations[vmv1beta1.RollbackRevisionAnnotation] == "" {
+		return false
+	}
+	gen := parentGeneration(ctx)
+	return gen != "" && annotations[vmv1beta1.ParentGenerationAnnotation] == gen
+}
+
+// setRevisionAnnotations marks new object with generation of parent object
+// and increments revision number if pod template was changed
+// it returns new revision number or zero if revision wasn't changed
+func setRevisionAnnotations(ctx context.Context, newObj, current client.Object, templateChanged bool) int64 {
+	annotations := newObj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	delete(annotations, vmv1beta1.RollbackRevisionAnnotation)
+	if gen := parentGeneration(ctx); gen != "" {
+		annotations[vmv1beta1.ParentGenerationAnnotation] = gen
+	}
+	var rev int64
+	if current != nil {
+		rev = revision.Current(current)
+		if rev > 0 {
+			annotations[vmv1beta1.RevisionAnnotation] = strconv.FormatInt(rev, 10)
+		}
+	}
+	if revisionHistoryLimit == 0 || !templateChanged {
+		newObj.SetAnnotations(annotations)
+		return 0
+	}
+	rev++
+	annotations[vmv1beta1.RevisionAnnotation] = strconv.FormatInt(rev, 10)
+	newObj.SetAnnotations(annotations)
+	return rev
+}
+
+// keepRollbackAnnotations copies annotations of rolled back object into the new one
+func keepRollbackAnnotations(newObj, current client.Object) {
+	annotations := newObj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	for _, key := range []string{vmv1beta1.RollbackRevisionAnnotation, vmv1beta1.ParentGenerationAnnotation, vmv1beta1.RevisionAnnotation} {
+		if v, ok := current.GetAnnotations()[key]; ok {
+			annotations[key] = v
+		}
+	}
+	newObj.SetAnnotations(annotations)
+}
+
+// recordRevision stores pod template of the given workload into ControllerRevision
+// and removes revisions exceeding history limit
+func recordRevision(ctx context.Context, rclient client.Client, obj client.Object, tpl *corev1.PodTemplateSpec, rev int64) error {
+	if rev == 0 {
+		return nil
+	}
+	kind, err := revision.Kind(obj)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(tpl)
+	if err != nil {
+		return fmt.Errorf("cannot serialize pod template of %s=%s: %w", kind, obj.GetName(), err)
+	}
+	cr := &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      revision.Name(kind, obj.GetName(), rev),
+			Namespace: obj.GetNamespace(),
+			Labels:    map[string]string{vmv1beta1.RevisionKindLabel: kind},
+			Annotations: map[string]string{
+				vmv1beta1.ParentGenerationAnnotation: obj.GetAnnotations()[vmv1beta1.ParentGenerationAnnotation],
+				vmv1beta1.RevisionOfAnnotation:       obj.GetName(),
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         appsv1.SchemeGroupVersion.String(),
+					Kind:               kind,
+					Name:               obj.GetName(),
+					UID:                obj.GetUID(),
+					BlockOwnerDeletion: ptr.To(false),
+				},
+			},
+		},
+		Data:     runtime.RawExtension{Raw: data},
+		Revision: rev,
+	}
+	if err := rclient.Create(ctx, cr); err != nil {
+		if !errors.IsAlreadyExists(err) {
+			return fmt.Errorf("cannot create revision=%d of %s=%s: %w", rev, kind, obj.GetName(), err)
+		}
+		// revision annotation was reset, override stale revision
+		var exist appsv1.ControllerRevision
+		if err := rclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.Name}, &exist); err != nil {
+			return fmt.Errorf("cannot get revision=%d of %s=%s: %w", rev, kind, obj.GetName(), err)
+		}
+		exist.Labels = cr.Labels
+		exist.Annotations = cr.Annotations
+		exist.OwnerReferences = cr.OwnerReferences
+		exist.Data = cr.Data
+		if err := rclient.Update(ctx, &exist); err != nil {
+			return fmt.Errorf("cannot update revision=%d of %s=%s: %w", rev, kind, obj.GetName(), err)
+		}
+	}
+	history, err := revision.History(ctx, rclient, obj)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(history)-revisionHistoryLimit; i++ {
+		logger.WithContext(ctx).Info(fmt.Sprintf("removing revision=%d of %s=%s exceeding history limit", history[i].Revision, kind, obj.GetName()))
+		if err := finalize.SafeDelete(ctx, rclient, &history[i]); err != nil {
+			return fmt.Errorf("cannot remove revision=%d of %s=%s: %w", history[i].Revision, kind, obj.GetName(), err)
+		}
+	}
+	return nil
+}
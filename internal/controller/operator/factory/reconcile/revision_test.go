@@ -0,0 +1,102 @@
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/VictoriaMetrics/operator/api/client/revision"
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestRevisionHistory(t *testing.T) {
+	defer InitRevisionHistory(10)
+	InitRevisionHistory(2)
+
+	newDeploy := func(image string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "vmsingle-main", Namespace: "default"},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: ptr.To[int32](1),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "vmsingle"}},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "vmsingle"}},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "vmsingle", Image: image}},
+					},
+				},
+			},
+		}
+	}
+	current := newDeploy("vmsingle:v0")
+	current.Status.Conditions = []appsv1.DeploymentCondition{
+		{Type: appsv1.DeploymentProgressing, Reason: "NewReplicaSetAvailable", Status: "True"},
+	}
+	rclient := k8stools.GetTestClientWithObjects([]runtime.Object{current})
+	parent := &vmv1beta1.VMSingle{ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"}}
+
+	// reconcile applies desired image with parent generation
+	reconcileDeploy := func(generation int64, image string) {
+		t.Helper()
+		parent.Generation = generation
+		ctx := events.AddToContext(context.Background(), parent)
+		if err := Deployment(ctx, rclient, newDeploy(image), newDeploy(image), false); err != nil {
+			t.Fatalf("cannot reconcile deployment: %s", err)
+		}
+	}
+	f := func(wantImage string, wantRevision int64, wantHistory []int64) {
+		t.Helper()
+		var got appsv1.Deployment
+		if err := rclient.Get(context.Background(), types.NamespacedName{Name: "vmsingle-main", Namespace: "default"}, &got); err != nil {
+			t.Fatalf("cannot get deployment: %s", err)
+		}
+		assert.Equal(t, wantImage, got.Spec.Template.Spec.Containers[0].Image)
+		assert.Equal(t, wantRevision, revision.Current(&got))
+		history, err := revision.History(context.Background(), rclient, &got)
+		if err != nil {
+			t.Fatalf("cannot get history: %s", err)
+		}
+		var gotHistory []int64
+		for _, h := range history {
+			gotHistory = append(gotHistory, h.Revision)
+		}
+		assert.Equal(t, wantHistory, gotHistory)
+	}
+
+	reconcileDeploy(1, "vmsingle:v1")
+	f("vmsingle:v1", 1, []int64{1})
+
+	// revision isn't changed without changes of pod template
+	reconcileDeploy(1, "vmsingle:v1")
+	f("vmsingle:v1", 1, []int64{1})
+
+	// history is limited
+	reconcileDeploy(2, "vmsingle:v2")
+	reconcileDeploy(3, "vmsingle:v3")
+	f("vmsingle:v3", 3, []int64{2, 3})
+
+	// rollback to the previous revision is kept until parent change
+	if err := revision.Rollback(context.Background(), rclient, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "vmsingle-main", Namespace: "default"}}, 0); err != nil {
+		t.Fatalf("cannot rollback deployment: %s", err)
+	}
+	f("vmsingle:v2", 3, []int64{2, 3})
+	reconcileDeploy(3, "vmsingle:v3")
+	f("vmsingle:v2", 3, []int64{2, 3})
+
+	reconcileDeploy(4, "vmsingle:v4")
+	f("vmsingle:v4", 4, []int64{3, 4})
+
+	// missing revision
+	if err := revision.Rollback(context.Background(), rclient, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "vmsingle-main", Namespace: "default"}}, 1); err == nil {
+		t.Fatalf("expected error for rollback to removed revision")
+	}
+}
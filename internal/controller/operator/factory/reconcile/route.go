@@ -0,0 +1,36 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Route creates or updates OpenShift Route
+func Route(ctx context.Context, rclient client.Client, newRoute *unstructured.Unstructured) error {
+	TrackChild(ctx, "Route", newRoute, newRoute.Object["spec"])
+	existRoute := &unstructured.Unstructured{}
+	existRoute.SetGroupVersionKind(newRoute.GroupVersionKind())
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: newRoute.GetNamespace(), Name: newRoute.GetName()}, existRoute); err != nil {
+		if errors.IsNotFound(err) {
+			return createChild(ctx, rclient, newRoute)
+		}
+		return err
+	}
+	// route host is generated by OpenShift router, if it's not set explicitly
+	if _, ok, _ := unstructured.NestedString(newRoute.Object, "spec", "host"); !ok {
+		if host, ok, _ := unstructured.NestedString(existRoute.Object, "spec", "host"); ok {
+			if err := unstructured.SetNestedField(newRoute.Object, host, "spec", "host"); err != nil {
+				return fmt.Errorf("cannot set route host: %w", err)
+			}
+		}
+	}
+	newRoute.SetAnnotations(labels.Merge(existRoute.GetAnnotations(), newRoute.GetAnnotations()))
+	newRoute.SetResourceVersion(existRoute.GetResourceVersion())
+	return rclient.Update(ctx, newRoute)
+}
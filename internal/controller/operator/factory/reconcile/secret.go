@@ -3,6 +3,7 @@ package reconcile
 import (
 	"context"
 
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
 	corev1 "k8s.io/api/core/v1"
@@ -15,18 +16,29 @@ import (
 
 // Secret reconciles secret object
 func Secret(ctx context.Context, rclient client.Client, s *corev1.Secret) error {
+	TrackChild(ctx, "Secret", s, s.Data)
 	var curSecret corev1.Secret
+	dataHash := secretDataHash(s.Data)
+	s.Annotations = labels.Merge(s.Annotations, map[string]string{secretDataHashAnnotation: dataHash})
 
 	if err := rclient.Get(ctx, types.NamespacedName{Namespace: s.Namespace, Name: s.Name}, &curSecret); err != nil {
 		if errors.IsNotFound(err) {
 			logger.WithContext(ctx).Info("creating new configuration secret")
-			return rclient.Create(ctx, s)
+			return createChild(ctx, rclient, s)
 		}
 		return err
 	}
 	if err := finalize.FreeIfNeeded(ctx, rclient, &curSecret); err != nil {
 		return err
 	}
+	// secret data was changed after the last update made by operator
+	appliedHash, ok := curSecret.Annotations[secretDataHashAnnotation]
+	hasDrift := ok && appliedHash == dataHash && appliedHash != secretDataHash(curSecret.Data)
+	reportDrift(ctx, "Secret", s, hasDrift)
+	if hasDrift && !driftAutoRevert {
+		// keep manual changes
+		s.Data = curSecret.Data
+	}
 	s.Annotations = labels.Merge(curSecret.Annotations, s.Annotations)
 	s.ResourceVersion = curSecret.ResourceVersion
 	// fast path
@@ -36,5 +48,11 @@ func Secret(ctx context.Context, rclient client.Client, s *corev1.Secret) error
 		return nil
 	}
 	logger.WithContext(ctx).Info("updating configuration secret")
-	return rclient.Update(ctx, s)
+	reportUpdateDiff(ctx, "Secret", s, append(secretDataDiff(curSecret.Data, s.Data),
+		objectDiff(diffView{Labels: curSecret.Labels, Annotations: curSecret.Annotations}, diffView{Labels: s.Labels, Annotations: s.Annotations})...))
+	if err := rclient.Update(ctx, s); err != nil {
+		return err
+	}
+	events.Normal(ctx, events.ReasonConfigUpdated, "configuration secret=%s updated", s.Name)
+	return nil
 }
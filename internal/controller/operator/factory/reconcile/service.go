@@ -24,6 +24,7 @@ import (
 // in case of spec.type= LoadBalancer or NodePort, clusterIP: None is not allowed,
 // its users responsibility to define it correctly.
 func Service(ctx context.Context, rclient client.Client, newService, prevService *corev1.Service) error {
+	TrackChild(ctx, "Service", newService, &newService.Spec)
 	svcForReconcile := newService.DeepCopy()
 	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		return reconcileService(ctx, rclient, svcForReconcile, prevService)
@@ -43,7 +44,7 @@ func reconcileService(ctx context.Context, rclient client.Client, newService, pr
 		if err := finalize.SafeDelete(ctx, rclient, svc); err != nil {
 			return fmt.Errorf("cannot delete service at recreate: %w", err)
 		}
-		if err := rclient.Create(ctx, newService); err != nil {
+		if err := createChild(ctx, rclient, newService); err != nil {
 			return fmt.Errorf("cannot create service at recreate: %w", err)
 		}
 		return nil
@@ -53,7 +54,7 @@ func reconcileService(ctx context.Context, rclient client.Client, newService, pr
 	if err != nil {
 		if errors.IsNotFound(err) {
 			// service not exists, creating it.
-			err := rclient.Create(ctx, newService)
+			err := createChild(ctx, rclient, newService)
 			if err != nil {
 				return fmt.Errorf("cannot create new service: %w", err)
 			}
@@ -105,7 +106,7 @@ func reconcileService(ctx context.Context, rclient client.Client, newService, pr
 		newService.ResourceVersion = existingService.ResourceVersion
 	}
 	newService.Annotations = labels.Merge(existingService.Annotations, newService.Annotations)
-	vmv1beta1.AddFinalizer(newService, existingService)
+	finalize.MergeFinalizers(newService, existingService)
 
 	rclient.Scheme().Default(newService)
 	isEqual := equality.Semantic.DeepDerivative(newService.Spec, existingService.Spec)
@@ -3,14 +3,18 @@ package reconcile
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
 
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
@@ -18,29 +22,114 @@ import (
 )
 
 // ServiceAccount creates service account or updates exist one
+// at OpenShift it also grants configured SecurityContextConstraints to the service account
 func ServiceAccount(ctx context.Context, rclient client.Client, sa *corev1.ServiceAccount) error {
+	TrackChild(ctx, "ServiceAccount", sa, sa.ImagePullSecrets)
+	if err := serviceAccount(ctx, rclient, sa); err != nil {
+		return err
+	}
+	if scc := k8stools.OpenShiftSCC(); scc != "" {
+		if err := RoleBinding(ctx, rclient, sccRoleBinding(sa, scc)); err != nil {
+			return fmt.Errorf("cannot reconcile SecurityContextConstraints rolebinding: %w", err)
+		}
+	}
+	return nil
+}
+
+// sccRoleBinding binds OpenShift ClusterRole, which allows to use SecurityContextConstraints with the given name
+func sccRoleBinding(sa *corev1.ServiceAccount, scc string) *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            k8stools.OpenShiftSCCRoleBindingName(sa.Name),
+			Namespace:       sa.Namespace,
+			Labels:          sa.Labels,
+			OwnerReferences: sa.OwnerReferences,
+			Finalizers:      sa.Finalizers,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     "system:openshift:scc:" + scc,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      sa.Name,
+				Namespace: sa.Namespace,
+			},
+		},
+	}
+}
+
+func serviceAccount(ctx context.Context, rclient client.Client, sa *corev1.ServiceAccount) error {
 	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		var existSA corev1.ServiceAccount
 		if err := rclient.Get(ctx, types.NamespacedName{Name: sa.Name, Namespace: sa.Namespace}, &existSA); err != nil {
 			if errors.IsNotFound(err) {
-				return rclient.Create(ctx, sa)
+				return createChild(ctx, rclient, sa)
 			}
 			return fmt.Errorf("cannot get ServiceAccount for given CRD Object=%q, err=%w", sa.Name, err)
 		}
 		if err := finalize.FreeIfNeeded(ctx, rclient, &existSA); err != nil {
 			return err
 		}
+		prevManagedSecrets := existSA.Annotations[vmv1beta1.ManagedImagePullSecretsAnnotation]
 		existSA.OwnerReferences = sa.OwnerReferences
 		existSA.Annotations = labels.Merge(existSA.Annotations, sa.Annotations)
+		if _, ok := sa.Annotations[vmv1beta1.ManagedImagePullSecretsAnnotation]; !ok {
+			delete(existSA.Annotations, vmv1beta1.ManagedImagePullSecretsAnnotation)
+		}
+		imagePullSecrets := mergeImagePullSecrets(existSA.ImagePullSecrets, sa.ImagePullSecrets, prevManagedSecrets)
 
 		if equality.Semantic.DeepEqual(sa.Labels, existSA.Labels) &&
-			equality.Semantic.DeepEqual(sa.Annotations, existSA.Annotations) {
+			equality.Semantic.DeepEqual(sa.Annotations, existSA.Annotations) &&
+			equality.Semantic.DeepEqual(imagePullSecrets, existSA.ImagePullSecrets) &&
+			prevManagedSecrets == sa.Annotations[vmv1beta1.ManagedImagePullSecretsAnnotation] {
 			return nil
 		}
 		existSA.Labels = sa.Labels
-		vmv1beta1.AddFinalizer(&existSA, &existSA)
+		existSA.ImagePullSecrets = imagePullSecrets
+		finalize.MergeFinalizers(&existSA, &existSA)
 		logger.WithContext(ctx).Info("updating ServiceAccount configuration")
 
 		return rclient.Update(ctx, &existSA)
 	})
 }
+
+// mergeImagePullSecrets returns imagePullSecrets for existing ServiceAccount.
+// Secrets previously attached by operator and not desired anymore are removed,
+// secrets attached by other controllers or users are kept in place.
+func mergeImagePullSecrets(exist, desired []corev1.LocalObjectReference, prevManaged string) []corev1.LocalObjectReference {
+	desiredNames := make(map[string]struct{}, len(desired))
+	for _, ref := range desired {
+		desiredNames[ref.Name] = struct{}{}
+	}
+	prevManagedNames := make(map[string]struct{})
+	for _, name := range strings.Split(prevManaged, ",") {
+		if name != "" {
+			prevManagedNames[name] = struct{}{}
+		}
+	}
+	var merged []corev1.LocalObjectReference
+	seen := make(map[string]struct{}, len(exist)+len(desired))
+	for _, ref := range exist {
+		if _, ok := seen[ref.Name]; ok {
+			continue
+		}
+		_, isDesired := desiredNames[ref.Name]
+		_, isPrevManaged := prevManagedNames[ref.Name]
+		if isPrevManaged && !isDesired {
+			continue
+		}
+		seen[ref.Name] = struct{}{}
+		merged = append(merged, ref)
+	}
+	for _, ref := range desired {
+		if _, ok := seen[ref.Name]; ok {
+			continue
+		}
+		seen[ref.Name] = struct{}{}
+		merged = append(merged, ref)
+	}
+	return merged
+}
@@ -0,0 +1,125 @@
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/go-test/deep"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestServiceAccountImagePullSecrets(t *testing.T) {
+	f := func(exist *corev1.ServiceAccount, desiredSecrets []string, want []corev1.LocalObjectReference) {
+		t.Helper()
+		ctx := context.Background()
+		var predefinedObjects []runtime.Object
+		if exist != nil {
+			predefinedObjects = append(predefinedObjects, exist)
+		}
+		fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		sa := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "vmsingle", Namespace: "default"},
+		}
+		for _, name := range desiredSecrets {
+			sa.ImagePullSecrets = append(sa.ImagePullSecrets, corev1.LocalObjectReference{Name: name})
+		}
+		if len(desiredSecrets) > 0 {
+			sa.Annotations = map[string]string{vmv1beta1.ManagedImagePullSecretsAnnotation: desiredSecrets[0]}
+			for _, name := range desiredSecrets[1:] {
+				sa.Annotations[vmv1beta1.ManagedImagePullSecretsAnnotation] += "," + name
+			}
+		}
+		if err := ServiceAccount(ctx, fclient, sa); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		var got corev1.ServiceAccount
+		if err := fclient.Get(ctx, types.NamespacedName{Name: sa.Name, Namespace: sa.Namespace}, &got); err != nil {
+			t.Fatalf("cannot get ServiceAccount: %s", err)
+		}
+		if diff := deep.Equal(got.ImagePullSecrets, want); len(diff) > 0 {
+			t.Fatalf("unexpected imagePullSecrets: %v", diff)
+		}
+		gotManaged, ok := got.Annotations[vmv1beta1.ManagedImagePullSecretsAnnotation]
+		if ok != (len(desiredSecrets) > 0) || gotManaged != sa.Annotations[vmv1beta1.ManagedImagePullSecretsAnnotation] {
+			t.Fatalf("unexpected managed secrets annotation: %q", gotManaged)
+		}
+	}
+
+	// new service account
+	f(nil, []string{"registry-creds"}, []corev1.LocalObjectReference{{Name: "registry-creds"}})
+
+	// rotated global secret keeps secrets attached by others
+	f(&corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "vmsingle",
+			Namespace:   "default",
+			Annotations: map[string]string{vmv1beta1.ManagedImagePullSecretsAnnotation: "registry-creds-old"},
+		},
+		ImagePullSecrets: []corev1.LocalObjectReference{{Name: "vmsingle-dockercfg"}, {Name: "registry-creds-old"}},
+	}, []string{"registry-creds"}, []corev1.LocalObjectReference{{Name: "vmsingle-dockercfg"}, {Name: "registry-creds"}})
+
+	// manually attached secret becomes managed
+	f(&corev1.ServiceAccount{
+		ObjectMeta:       metav1.ObjectMeta{Name: "vmsingle", Namespace: "default"},
+		ImagePullSecrets: []corev1.LocalObjectReference{{Name: "registry-creds"}, {Name: "other"}},
+	}, []string{"registry-creds"}, []corev1.LocalObjectReference{{Name: "registry-creds"}, {Name: "other"}})
+
+	// all managed secrets removed
+	f(&corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "vmsingle",
+			Namespace:   "default",
+			Annotations: map[string]string{vmv1beta1.ManagedImagePullSecretsAnnotation: "registry-creds,team-creds"},
+		},
+		ImagePullSecrets: []corev1.LocalObjectReference{{Name: "registry-creds"}, {Name: "team-creds"}},
+	}, nil, nil)
+}
+
+func TestServiceAccountOpenShiftSCC(t *testing.T) {
+	f := func(platform, scc string, wantRoleRef string) {
+		t.Helper()
+		if err := k8stools.SetPlatform(platform, scc, nil); err != nil {
+			t.Fatalf("cannot set platform: %s", err)
+		}
+		defer func() {
+			if err := k8stools.SetPlatform(k8stools.PlatformKubernetes, "", nil); err != nil {
+				t.Fatalf("cannot restore platform: %s", err)
+			}
+		}()
+		ctx := context.Background()
+		fclient := k8stools.GetTestClientWithObjects(nil)
+		sa := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "vmsingle", Namespace: "default"},
+		}
+		if err := ServiceAccount(ctx, fclient, sa); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		var got rbacv1.RoleBinding
+		err := fclient.Get(ctx, types.NamespacedName{Name: "vmsingle-scc", Namespace: sa.Namespace}, &got)
+		if wantRoleRef == "" {
+			if !errors.IsNotFound(err) {
+				t.Fatalf("rolebinding must not be created, got err: %v", err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("cannot get RoleBinding: %s", err)
+		}
+		if got.RoleRef.Name != wantRoleRef {
+			t.Fatalf("unexpected roleRef, got: %q, want: %q", got.RoleRef.Name, wantRoleRef)
+		}
+		if len(got.Subjects) != 1 || got.Subjects[0].Name != sa.Name {
+			t.Fatalf("unexpected subjects: %v", got.Subjects)
+		}
+	}
+	f(k8stools.PlatformOpenShift, "nonroot-v2", "system:openshift:scc:nonroot-v2")
+	f(k8stools.PlatformOpenShift, "", "")
+	f(k8stools.PlatformKubernetes, "nonroot-v2", "")
+}
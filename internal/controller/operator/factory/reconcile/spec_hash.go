@@ -0,0 +1,86 @@
+package reconcile
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"maps"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// SpecHashAnnotation holds hash of the desired state of object applied by operator
+// it allows to skip update calls, if desired state wasn't changed since the last update
+const SpecHashAnnotation = "operator.victoriametrics.com/spec-hash"
+
+var skippedUpdates = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "vm_operator_skipped_updates_total",
+	Help: "Counts update calls of objects managed by operator, which were skipped, since desired state wasn't changed",
+}, []string{"kind"})
+
+func init() {
+	metrics.Registry.MustRegister(skippedUpdates)
+}
+
+// SpecHash returns stable hash of the given object spec and metadata
+// SpecHashAnnotation value is ignored, empty hash is returned if spec cannot be encoded
+func SpecHash(obj client.Object, spec any) string {
+	annotations := obj.GetAnnotations()
+	if _, ok := annotations[SpecHashAnnotation]; ok {
+		annotations = maps.Clone(annotations)
+		delete(annotations, SpecHashAnnotation)
+	}
+	data, err := json.Marshal(struct {
+		Spec            any                     `json:"spec"`
+		Labels          map[string]string       `json:"labels,omitempty"`
+		Annotations     map[string]string       `json:"annotations,omitempty"`
+		OwnerReferences []metav1.OwnerReference `json:"ownerReferences,omitempty"`
+	}{
+		Spec:            spec,
+		Labels:          obj.GetLabels(),
+		Annotations:     annotations,
+		OwnerReferences: obj.GetOwnerReferences(),
+	})
+	if err != nil {
+		return ""
+	}
+	h := fnv.New64a()
+	h.Write(data)
+	return fmt.Sprintf("%x", h.Sum64())
+}
+
+// SetSpecHash stores the given hash at object annotations
+func SetSpecHash(obj client.Object, hash string) {
+	if hash == "" {
+		return
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[SpecHashAnnotation] = hash
+	obj.SetAnnotations(annotations)
+}
+
+// IsOwnedStateUnchanged checks if existing object has the same spec, labels and annotations as desired object.
+// Only annotations set by operator are compared, annotations added by other tools, e.g. GitOps controllers, are ignored.
+// Skipped update is counted for the given kind
+func IsOwnedStateUnchanged(kind string, existing, desired client.Object, existingSpec, desiredSpec any) bool {
+	if !equality.Semantic.DeepEqual(existingSpec, desiredSpec) ||
+		!equality.Semantic.DeepEqual(existing.GetLabels(), desired.GetLabels()) ||
+		!equality.Semantic.DeepEqual(existing.GetOwnerReferences(), desired.GetOwnerReferences()) {
+		return false
+	}
+	existingAnnotations := existing.GetAnnotations()
+	for k, v := range desired.GetAnnotations() {
+		if existingAnnotations[k] != v {
+			return false
+		}
+	}
+	skippedUpdates.WithLabelValues(kind).Inc()
+	return true
+}
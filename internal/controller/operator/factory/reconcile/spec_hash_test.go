@@ -0,0 +1,105 @@
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestSpecHash(t *testing.T) {
+	newScrape := func(port string, annotations map[string]string) *vmv1beta1.VMServiceScrape {
+		return &vmv1beta1.VMServiceScrape{
+			ObjectMeta: metav1.ObjectMeta{Name: "vmsingle", Namespace: "default", Annotations: annotations},
+			Spec:       vmv1beta1.VMServiceScrapeSpec{Endpoints: []vmv1beta1.Endpoint{{Port: port}}},
+		}
+	}
+	a := newScrape("http", nil)
+	hash := SpecHash(a, &a.Spec)
+	assert.NotEmpty(t, hash)
+
+	// hash annotation is ignored
+	SetSpecHash(a, hash)
+	assert.Equal(t, hash, SpecHash(a, &a.Spec))
+
+	// spec change
+	b := newScrape("metrics", nil)
+	assert.NotEqual(t, hash, SpecHash(b, &b.Spec))
+
+	// metadata change
+	c := newScrape("http", map[string]string{"team": "a"})
+	assert.NotEqual(t, hash, SpecHash(c, &c.Spec))
+}
+
+func TestVMServiceScrapeForCRDOwnedState(t *testing.T) {
+	ctx := context.Background()
+	f := func(exist *vmv1beta1.VMServiceScrape, wantUpdates int64) {
+		t.Helper()
+		rclient := k8stools.GetTestClientWithObjects([]runtime.Object{exist})
+		desired := &vmv1beta1.VMServiceScrape{
+			ObjectMeta: metav1.ObjectMeta{Name: exist.Name, Namespace: exist.Namespace, Annotations: map[string]string{"team": "a"}},
+			Spec:       vmv1beta1.VMServiceScrapeSpec{Endpoints: []vmv1beta1.Endpoint{{Port: "http"}}},
+		}
+		assert.NoError(t, VMServiceScrapeForCRD(ctx, rclient, desired))
+		assert.Equal(t, wantUpdates, rclient.(*k8stools.TestClientWithStatsTrack).UpdateCalls.Load())
+		var got vmv1beta1.VMServiceScrape
+		assert.NoError(t, rclient.Get(ctx, types.NamespacedName{Name: exist.Name, Namespace: exist.Namespace}, &got))
+		assert.Equal(t, desired.Spec, got.Spec)
+		assert.Equal(t, "a", got.Annotations["team"])
+	}
+	newScrape := func(port string, annotations map[string]string) *vmv1beta1.VMServiceScrape {
+		return &vmv1beta1.VMServiceScrape{
+			ObjectMeta: metav1.ObjectMeta{Name: "vmsingle", Namespace: "default", Annotations: annotations},
+			Spec:       vmv1beta1.VMServiceScrapeSpec{Endpoints: []vmv1beta1.Endpoint{{Port: port}}},
+		}
+	}
+
+	// update is skipped for the same desired state
+	f(newScrape("http", map[string]string{"team": "a"}), 0)
+
+	// annotations added by other tools don't trigger update
+	f(newScrape("http", map[string]string{"team": "a", "argocd.argoproj.io/tracking-id": "app"}), 0)
+
+	// changed operator annotation is updated
+	f(newScrape("http", map[string]string{"team": "b"}), 1)
+
+	// manual change of spec is reverted
+	f(newScrape("metrics", map[string]string{"team": "a"}), 1)
+}
+
+func TestVMRuleForCRDOwnedState(t *testing.T) {
+	ctx := context.Background()
+	newRule := func(expr string, annotations map[string]string) *vmv1beta1.VMRule {
+		return &vmv1beta1.VMRule{
+			ObjectMeta: metav1.ObjectMeta{Name: "vmalert", Namespace: "default", Annotations: annotations},
+			Spec: vmv1beta1.VMRuleSpec{Groups: []vmv1beta1.RuleGroup{{
+				Name:  "group",
+				Rules: []vmv1beta1.Rule{{Alert: "down", Expr: expr}},
+			}}},
+		}
+	}
+	f := func(exist *vmv1beta1.VMRule, wantUpdates int64) {
+		t.Helper()
+		rclient := k8stools.GetTestClientWithObjects([]runtime.Object{exist})
+		desired := newRule("up == 0", nil)
+		assert.NoError(t, VMRuleForCRD(ctx, rclient, desired))
+		assert.Equal(t, wantUpdates, rclient.(*k8stools.TestClientWithStatsTrack).UpdateCalls.Load())
+		var got vmv1beta1.VMRule
+		assert.NoError(t, rclient.Get(ctx, types.NamespacedName{Name: exist.Name, Namespace: exist.Namespace}, &got))
+		assert.Equal(t, desired.Spec, got.Spec)
+	}
+
+	// update is skipped for the same desired state
+	f(newRule("up == 0", nil), 0)
+
+	// annotations added by other tools don't trigger update
+	f(newRule("up == 0", map[string]string{"argocd.argoproj.io/tracking-id": "app"}), 0)
+
+	// manual change of spec is reverted
+	f(newRule("vector(1)", nil), 1)
+}
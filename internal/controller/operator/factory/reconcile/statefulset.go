@@ -3,19 +3,23 @@ package reconcile
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/tracing"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -27,16 +31,19 @@ import (
 const podRevisionLabel = "controller-revision-hash"
 
 // STSOptions options for StatefulSet update
-// HPA and UpdateReplicaCount optional
+// HPA, UpdateReplicaCount and UpdateStrategy optional
 type STSOptions struct {
 	HasClaim           bool
 	SelectorLabels     func() map[string]string
 	HPA                *vmv1beta1.EmbeddedHPA
 	UpdateReplicaCount func(count *int32)
+	UpdateStrategy     *vmv1beta1.StatefulSetUpdateStrategy
 }
 
-func waitForStatefulSetReady(ctx context.Context, rclient client.Client, newSts *appsv1.StatefulSet) error {
-	err := wait.PollUntilContextTimeout(ctx, podWaitReadyIntervalCheck, appWaitReadyDeadline, false, func(ctx context.Context) (done bool, err error) {
+func waitForStatefulSetReady(ctx context.Context, rclient client.Client, newSts *appsv1.StatefulSet) (err error) {
+	ctx, span := tracing.Start(ctx, tracing.SpanWaitReady, tracing.ObjectAttributes("StatefulSet", newSts.Namespace, newSts.Name)...)
+	defer func() { tracing.End(span, err) }()
+	err = wait.PollUntilContextTimeout(ctx, podWaitReadyIntervalCheck, appWaitReadyDeadline, false, func(ctx context.Context) (done bool, err error) {
 		// fast path
 		if newSts.Spec.Replicas == nil {
 			return true, nil
@@ -57,7 +64,10 @@ func waitForStatefulSetReady(ctx context.Context, rclient client.Client, newSts
 }
 
 // HandleSTSUpdate performs create and update operations for given statefulSet with STSOptions
-func HandleSTSUpdate(ctx context.Context, rclient client.Client, cr STSOptions, newSts, prevSts *appsv1.StatefulSet) error {
+func HandleSTSUpdate(ctx context.Context, rclient client.Client, cr STSOptions, newSts, prevSts *appsv1.StatefulSet) (err error) {
+	ctx, span := tracing.Start(ctx, tracing.SpanApply, tracing.ObjectAttributes("StatefulSet", newSts.Namespace, newSts.Name)...)
+	defer func() { tracing.End(span, err) }()
+	TrackChild(ctx, "StatefulSet", newSts, &newSts.Spec)
 	var isPrevEqual bool
 	if prevSts != nil {
 		isPrevEqual = equality.Semantic.DeepDerivative(prevSts.Spec, newSts.Spec)
@@ -68,9 +78,13 @@ func HandleSTSUpdate(ctx context.Context, rclient client.Client, cr STSOptions,
 		var currentSts appsv1.StatefulSet
 		if err := rclient.Get(ctx, types.NamespacedName{Name: newSts.Name, Namespace: newSts.Namespace}, &currentSts); err != nil {
 			if errors.IsNotFound(err) {
-				if err = rclient.Create(ctx, newSts); err != nil {
+				rev := setRevisionAnnotations(ctx, newSts, nil, true)
+				if err = createChild(ctx, rclient, newSts); err != nil {
 					return fmt.Errorf("cannot create new sts %s under namespace %s: %w", newSts.Name, newSts.Namespace, err)
 				}
+				if err := recordRevision(ctx, rclient, newSts, &newSts.Spec.Template, rev); err != nil {
+					return err
+				}
 				return waitForStatefulSetReady(ctx, rclient, newSts)
 			}
 			return fmt.Errorf("cannot get sts %s under namespace %s: %w", newSts.Name, newSts.Namespace, err)
@@ -92,17 +106,42 @@ func HandleSTSUpdate(ctx context.Context, rclient client.Client, cr STSOptions,
 		// hack for kubernetes 1.18
 		newSts.Status.Replicas = currentSts.Status.Replicas
 		newSts.Spec.Template.Annotations = labels.Merge(currentSts.Spec.Template.Annotations, newSts.Spec.Template.Annotations)
-		vmv1beta1.AddFinalizer(newSts, &currentSts)
+		finalize.MergeFinalizers(newSts, &currentSts)
+
+		rollbackActive := isRollbackActive(ctx, &currentSts)
+		var rev int64
+		if rollbackActive {
+			// keep rolled back revision until the next change of parent object
+			newSts.Spec = currentSts.Spec
+			keepRollbackAnnotations(newSts, &currentSts)
+		} else {
+			templateChanged := !equality.Semantic.DeepDerivative(newSts.Spec.Template, currentSts.Spec.Template)
+			rev = setRevisionAnnotations(ctx, newSts, &currentSts, templateChanged)
+			if cr.UpdateStrategy.IsPartitioned() {
+				setRolloutPartition(newSts, &currentSts, templateChanged, *cr.UpdateStrategy.PartitionStep)
+			}
+		}
 
 		stsRecreated, podMustRecreate, err := recreateSTSIfNeed(ctx, rclient, newSts, &currentSts)
 		if err != nil {
 			return err
 		}
+		rollingUpdateStarted := stsRecreated
 
 		// if sts wasn't recreated, update it first
 		// before making call for performRollingUpdateOnSts
 		if !stsRecreated {
 			isEqual := equality.Semantic.DeepDerivative(newSts.Spec, currentSts.Spec)
+			// desired state wasn't changed, but the current statefulset differs from it
+			hasDrift := !rollbackActive && isPrevEqual && !isEqual
+			reportDrift(ctx, "StatefulSet", newSts, hasDrift)
+			if hasDrift && !driftAutoRevert {
+				// keep manual changes
+				newSts.Spec = currentSts.Spec
+				isEqual = true
+				rev = 0
+				keepRollbackAnnotations(newSts, &currentSts)
+			}
 			shouldSkipUpdate := isPrevEqual &&
 				isEqual &&
 				equality.Semantic.DeepEqual(newSts.Labels, currentSts.Labels) &&
@@ -114,23 +153,48 @@ func HandleSTSUpdate(ctx context.Context, rclient client.Client, cr STSOptions,
 					"is_prev_equal", isPrevEqual,
 					"is_current_equal", isEqual,
 					"is_prev_nil", prevSts == nil)
+				reportUpdateDiff(ctx, "StatefulSet", newSts, objectDiff(
+					diffView{Labels: currentSts.Labels, Annotations: currentSts.Annotations, Spec: currentSts.Spec},
+					diffView{Labels: newSts.Labels, Annotations: newSts.Annotations, Spec: newSts.Spec}))
 				if err := rclient.Update(ctx, newSts); err != nil {
 					return fmt.Errorf("cannot perform update on sts: %s, err: %w", newSts.Name, err)
 				}
+				rollingUpdateStarted = true
+			}
+		}
+		if rollingUpdateStarted {
+			if err := recordRevision(ctx, rclient, newSts, &newSts.Spec.Template, rev); err != nil {
+				return err
 			}
 		}
 
+		if rollingUpdateStarted {
+			events.Normal(ctx, events.ReasonRollingUpdateStarted, "rolling update of statefulset=%s started", newSts.Name)
+		}
 		// perform manual update only with OnDelete policy, which is default.
-		if newSts.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		switch {
+		case newSts.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType:
 			if err := performRollingUpdateOnSts(ctx, podMustRecreate, rclient, newSts.Name, newSts.Namespace, cr.SelectorLabels()); err != nil {
 				return fmt.Errorf("cannot handle rolling-update on sts: %s, err: %w", newSts.Name, err)
 			}
-		} else {
+		case cr.UpdateStrategy.IsPartitioned():
+			finished, err := performPartitionedRollout(ctx, rclient, newSts.Name, newSts.Namespace, cr.UpdateStrategy)
+			if err != nil {
+				return fmt.Errorf("cannot handle partitioned rollout on sts: %s, err: %w", newSts.Name, err)
+			}
+			if !finished {
+				rollingUpdateStarted = false
+			}
+		default:
 			if err := waitForStatefulSetReady(ctx, rclient, newSts); err != nil {
 				return fmt.Errorf("cannot ensure that statefulset is ready with strategy=%q: %w", newSts.Spec.UpdateStrategy.Type, err)
 			}
 		}
 
+		if rollingUpdateStarted {
+			events.Normal(ctx, events.ReasonRollingUpdateFinished, "rolling update of statefulset=%s finished", newSts.Name)
+		}
+
 		// check if pvcs need to resize
 		if cr.HasClaim {
 			err = growSTSPVC(ctx, rclient, newSts)
@@ -173,6 +237,11 @@ func performRollingUpdateOnSts(ctx context.Context, podMustRecreate bool, rclien
 	if err := rclient.List(ctx, podList, listOps); err != nil {
 		return fmt.Errorf("cannot list pods for statefulset rolling update: %w", err)
 	}
+	// pods of other workload with the same labels exist during change of component workload type
+	podList.Items = slices.DeleteFunc(podList.Items, func(pod corev1.Pod) bool {
+		owner := metav1.GetControllerOf(&pod)
+		return owner != nil && (owner.Kind != "StatefulSet" || owner.Name != sts.Name)
+	})
 
 	if err := sortStsPodsByID(podList.Items); err != nil {
 		return fmt.Errorf("cannot sort statefulset pods: %w", err)
@@ -274,6 +343,89 @@ func performRollingUpdateOnSts(ctx context.Context, podMustRecreate bool, rclien
 	return nil
 }
 
+// setRolloutPartition sets partition for partition-based rollout of the given statefulset
+// rollout of the new pod template starts from the step of pods with the highest ordinals,
+// otherwise partition advanced by operator is kept
+func setRolloutPartition(newSts, currentSts *appsv1.StatefulSet, templateChanged bool, step int32) {
+	var partition int32
+	if ru := currentSts.Spec.UpdateStrategy.RollingUpdate; ru != nil {
+		partition = ptr.Deref(ru.Partition, 0)
+	}
+	if templateChanged {
+		partition = max(ptr.Deref(newSts.Spec.Replicas, 0)-step, 0)
+	}
+	if newSts.Spec.UpdateStrategy.RollingUpdate == nil {
+		newSts.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateStatefulSetStrategy{}
+	}
+	newSts.Spec.UpdateStrategy.RollingUpdate.Partition = &partition
+	// keep approval set by user until the next partition step
+	if v, ok := currentSts.Annotations[vmv1beta1.RolloutApprovedAnnotation]; ok {
+		if newSts.Annotations == nil {
+			newSts.Annotations = make(map[string]string)
+		}
+		newSts.Annotations[vmv1beta1.RolloutApprovedAnnotation] = v
+	}
+}
+
+// performPartitionedRollout lowers partition of statefulset by the given step
+// after pods of the current partition are updated and ready.
+// If approval is required, rollout is paused until RolloutApprovedAnnotation is set at statefulset.
+// It returns true if all pods are updated
+func performPartitionedRollout(ctx context.Context, rclient client.Client, stsName, ns string, us *vmv1beta1.StatefulSetUpdateStrategy) (bool, error) {
+	l := logger.WithContext(ctx).WithValues("controller", "sts.partitionedrollout", "sts.name", stsName)
+	for {
+		sts, err := waitForPartitionReady(ctx, rclient, stsName, ns)
+		if err != nil {
+			return false, err
+		}
+		partition := ptr.Deref(sts.Spec.UpdateStrategy.RollingUpdate.Partition, 0)
+		if partition == 0 {
+			return true, nil
+		}
+		if us.RequireApproval {
+			if _, ok := sts.Annotations[vmv1beta1.RolloutApprovedAnnotation]; !ok {
+				l.Info("rollout is paused, waiting for approval", "partition", partition)
+				events.Normal(ctx, events.ReasonRollingUpdatePaused, "rolling update of statefulset=%s is paused at partition=%d, set annotation %s at statefulset to continue", stsName, partition, vmv1beta1.RolloutApprovedAnnotation)
+				return false, nil
+			}
+			delete(sts.Annotations, vmv1beta1.RolloutApprovedAnnotation)
+		}
+		next := max(partition-*us.PartitionStep, 0)
+		sts.Spec.UpdateStrategy.RollingUpdate.Partition = &next
+		if err := rclient.Update(ctx, sts); err != nil {
+			return false, fmt.Errorf("cannot update partition: %w", err)
+		}
+		l.Info("rollout partition advanced", "partition", next)
+	}
+}
+
+// waitForPartitionReady waits until pods with ordinals above partition are updated and all pods are ready
+func waitForPartitionReady(ctx context.Context, rclient client.Client, stsName, ns string) (*appsv1.StatefulSet, error) {
+	var sts appsv1.StatefulSet
+	err := wait.PollUntilContextTimeout(ctx, podWaitReadyIntervalCheck, appWaitReadyDeadline, true, func(ctx context.Context) (done bool, err error) {
+		if err := rclient.Get(ctx, types.NamespacedName{Namespace: ns, Name: stsName}, &sts); err != nil {
+			return false, err
+		}
+		if sts.Spec.UpdateStrategy.RollingUpdate == nil {
+			return false, fmt.Errorf("BUG: rollingUpdate strategy must be set for partitioned rollout")
+		}
+		if sts.Status.ObservedGeneration < sts.Generation {
+			return false, nil
+		}
+		replicas := ptr.Deref(sts.Spec.Replicas, 0)
+		partition := ptr.Deref(sts.Spec.UpdateStrategy.RollingUpdate.Partition, 0)
+		return sts.Status.UpdatedReplicas >= replicas-partition && sts.Status.ReadyReplicas >= replicas, nil
+	})
+	if err != nil {
+		err = fmt.Errorf("cannot wait for statefulSet=%s partition to become ready: %w", stsName, err)
+		if sts.Spec.Selector == nil {
+			return nil, err
+		}
+		return nil, reportFirstNotReadyPodOnError(ctx, rclient, err, ns, labels.SelectorFromSet(sts.Spec.Selector.MatchLabels), sts.Spec.MinReadySeconds)
+	}
+	return &sts, nil
+}
+
 // PodIsReady check is pod is ready
 func PodIsReady(pod *corev1.Pod, minReadySeconds int32) bool {
 	if pod.ObjectMeta.DeletionTimestamp != nil {
@@ -294,7 +446,9 @@ func PodIsReady(pod *corev1.Pod, minReadySeconds int32) bool {
 	return false
 }
 
-func waitForPodReady(ctx context.Context, rclient client.Client, ns, podName string, minReadySeconds int32) error {
+func waitForPodReady(ctx context.Context, rclient client.Client, ns, podName string, minReadySeconds int32) (err error) {
+	ctx, span := tracing.Start(ctx, tracing.SpanWaitReady, tracing.ObjectAttributes("Pod", ns, podName)...)
+	defer func() { tracing.End(span, err) }()
 	var pod *corev1.Pod
 	if err := wait.PollUntilContextTimeout(ctx, podWaitReadyIntervalCheck, podWaitReadyTimeout, false, func(_ context.Context) (done bool, err error) {
 		pod = &corev1.Pod{}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 
 	"github.com/stretchr/testify/assert"
@@ -265,6 +266,52 @@ func Test_performRollingUpdateOnSts(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "pods of other workload are ignored",
+			args: args{
+				stsName:   "vmselect-sts",
+				ns:        "default",
+				podLabels: map[string]string{"app": "vmselect"},
+			},
+			predefinedObjects: []runtime.Object{
+				&appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "vmselect-sts",
+						Namespace: "default",
+						Labels:    map[string]string{"app": "vmselect"},
+					},
+					Spec: appsv1.StatefulSetSpec{Replicas: ptr.To[int32](1)},
+					Status: appsv1.StatefulSetStatus{
+						CurrentRevision: "rev1",
+						UpdateRevision:  "rev1",
+					},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "vmselect-sts-0",
+						Namespace:       "default",
+						Labels:          map[string]string{"app": "vmselect", podRevisionLabel: "rev1"},
+						OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "vmselect-sts", Controller: ptr.To(true)}},
+					},
+					Status: corev1.PodStatus{
+						Phase:      corev1.PodRunning,
+						Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: "True"}},
+					},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "vmselect-sts-5d8f9-x2b7q",
+						Namespace:       "default",
+						Labels:          map[string]string{"app": "vmselect"},
+						OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "vmselect-sts-5d8f9", Controller: ptr.To(true)}},
+					},
+					Status: corev1.PodStatus{
+						Phase:      corev1.PodRunning,
+						Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: "True"}},
+					},
+				},
+			},
+		},
 		{
 			name: "rolling update is timeout",
 			args: args{
@@ -344,6 +391,9 @@ func TestSortPodsByID(t *testing.T) {
 }
 
 func TestStatefulsetReconcileOk(t *testing.T) {
+	// api calls for revision history are checked at TestRevisionHistory
+	InitRevisionHistory(0)
+	defer InitRevisionHistory(10)
 	f := func(sts *appsv1.StatefulSet) {
 		//	t.Helper()
 		ctx := context.Background()
@@ -455,3 +505,86 @@ func TestStatefulsetReconcileOk(t *testing.T) {
 		},
 	})
 }
+
+func TestSetRolloutPartition(t *testing.T) {
+	f := func(currentPartition *int32, templateChanged bool, wantPartition int32) {
+		t.Helper()
+		current := &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{vmv1beta1.RolloutApprovedAnnotation: "true"},
+			},
+			Spec: appsv1.StatefulSetSpec{
+				UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+					Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+					RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: currentPartition},
+				},
+			},
+		}
+		newSts := &appsv1.StatefulSet{
+			Spec: appsv1.StatefulSetSpec{
+				Replicas:       ptr.To[int32](5),
+				UpdateStrategy: appsv1.StatefulSetUpdateStrategy{Type: appsv1.RollingUpdateStatefulSetStrategyType},
+			},
+		}
+		setRolloutPartition(newSts, current, templateChanged, 2)
+		assert.Equal(t, wantPartition, *newSts.Spec.UpdateStrategy.RollingUpdate.Partition)
+		assert.Equal(t, "true", newSts.Annotations[vmv1beta1.RolloutApprovedAnnotation])
+	}
+
+	// new revision starts from the highest ordinals
+	f(ptr.To[int32](0), true, 3)
+	f(ptr.To[int32](1), true, 3)
+
+	// partition advanced by operator is kept
+	f(ptr.To[int32](1), false, 1)
+	f(nil, false, 0)
+}
+
+func TestPerformPartitionedRollout(t *testing.T) {
+	f := func(partition int32, us *vmv1beta1.StatefulSetUpdateStrategy, annotations map[string]string, wantFinished bool, wantPartition int32) {
+		t.Helper()
+		ctx := context.Background()
+		sts := &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "vmstorage", Namespace: "default", Annotations: annotations},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: ptr.To[int32](4),
+				UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+					Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+					RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition},
+				},
+			},
+			Status: appsv1.StatefulSetStatus{ReadyReplicas: 4, UpdatedReplicas: 4},
+		}
+		rclient := k8stools.GetTestClientWithObjects([]runtime.Object{sts})
+		finished, err := performPartitionedRollout(ctx, rclient, sts.Name, sts.Namespace, us)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assert.Equal(t, wantFinished, finished)
+		var got appsv1.StatefulSet
+		if err := rclient.Get(ctx, types.NamespacedName{Name: sts.Name, Namespace: sts.Namespace}, &got); err != nil {
+			t.Fatalf("cannot get statefulset: %s", err)
+		}
+		assert.Equal(t, wantPartition, *got.Spec.UpdateStrategy.RollingUpdate.Partition)
+		assert.NotContains(t, got.Annotations, vmv1beta1.RolloutApprovedAnnotation)
+	}
+	strategy := func(step int32, requireApproval bool) *vmv1beta1.StatefulSetUpdateStrategy {
+		return &vmv1beta1.StatefulSetUpdateStrategy{
+			Type:            appsv1.RollingUpdateStatefulSetStrategyType,
+			PartitionStep:   ptr.To(step),
+			RequireApproval: requireApproval,
+		}
+	}
+
+	// rollout is finished
+	f(0, strategy(1, true), nil, true, 0)
+
+	// partition is advanced until all pods are updated
+	f(3, strategy(2, false), nil, true, 0)
+
+	// rollout is paused without approval
+	f(3, strategy(1, true), nil, false, 3)
+
+	// approval advances a single step
+	f(3, strategy(1, true), map[string]string{vmv1beta1.RolloutApprovedAnnotation: "true"}, false, 2)
+}
@@ -0,0 +1,43 @@
+package reconcile
+
+import (
+	"context"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// VMRuleForCRD creates or updates given object
+func VMRuleForCRD(ctx context.Context, rclient client.Client, rule *vmv1beta1.VMRule) error {
+	TrackChild(ctx, "VMRule", rule, &rule.Spec)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var existRule vmv1beta1.VMRule
+		err := rclient.Get(ctx, types.NamespacedName{Namespace: rule.Namespace, Name: rule.Name}, &existRule)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return createChild(ctx, rclient, rule)
+			}
+			return err
+		}
+		if err := finalize.FreeIfNeeded(ctx, rclient, &existRule); err != nil {
+			return err
+		}
+		if IsOwnedStateUnchanged("VMRule", &existRule, rule, &existRule.Spec, &rule.Spec) {
+			return nil
+		}
+		existRule.Annotations = labels.Merge(existRule.Annotations, rule.Annotations)
+		existRule.Spec = rule.Spec
+		existRule.Labels = rule.Labels
+		existRule.OwnerReferences = rule.OwnerReferences
+		logger.WithContext(ctx).Info("updating vmrule for CRD object")
+
+		return rclient.Update(ctx, &existRule)
+	})
+}
@@ -0,0 +1,105 @@
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+)
+
+// secretsManagerAPI defines subset of AWS Secrets Manager client used by operator
+type secretsManagerAPI interface {
+	GetSecretValue(ctx context.Context, params *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+	PutSecretValue(ctx context.Context, params *secretsmanager.PutSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.PutSecretValueOutput, error)
+	CreateSecret(ctx context.Context, params *secretsmanager.CreateSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.CreateSecretOutput, error)
+	DeleteSecret(ctx context.Context, params *secretsmanager.DeleteSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.DeleteSecretOutput, error)
+}
+
+// awsProvider stores credentials as JSON encoded secret strings at AWS Secrets Manager
+// AWS credentials are taken from default credentials chain, e.g. IRSA web identity token
+type awsProvider struct {
+	namePrefix string
+	c          secretsManagerAPI
+}
+
+func newAWSProvider(cfg AWSConfig) (*awsProvider, error) {
+	var opts []func(*config.LoadOptions) error
+	if cfg.Region != "" {
+		opts = append(opts, config.WithRegion(cfg.Region))
+	}
+	awsCfg, err := config.LoadDefaultConfig(context.Background(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load aws config: %w", err)
+	}
+	if awsCfg.Region == "" {
+		return nil, fmt.Errorf("aws region must be set with flag or AWS_REGION env var")
+	}
+	return &awsProvider{
+		namePrefix: cfg.NamePrefix,
+		c:          secretsmanager.NewFromConfig(awsCfg),
+	}, nil
+}
+
+// Get implements Provider interface
+func (ap *awsProvider) Get(ctx context.Context, key string) (map[string][]byte, error) {
+	resp, err := ap.c.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(ap.namePrefix + key),
+	})
+	if err != nil {
+		if isAWSNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var data map[string]string
+	if err := json.Unmarshal([]byte(aws.ToString(resp.SecretString)), &data); err != nil {
+		return nil, fmt.Errorf("cannot parse secret string: %w", err)
+	}
+	return toBytesMap(data), nil
+}
+
+// Put implements Provider interface
+func (ap *awsProvider) Put(ctx context.Context, key string, data map[string][]byte) error {
+	secretString, err := json.Marshal(toStringMap(data))
+	if err != nil {
+		return fmt.Errorf("cannot serialize secret string: %w", err)
+	}
+	name := ap.namePrefix + key
+	_, err = ap.c.PutSecretValue(ctx, &secretsmanager.PutSecretValueInput{
+		SecretId:     aws.String(name),
+		SecretString: aws.String(string(secretString)),
+	})
+	if err == nil || !isAWSNotFound(err) {
+		return err
+	}
+	_, err = ap.c.CreateSecret(ctx, &secretsmanager.CreateSecretInput{
+		Name:         aws.String(name),
+		SecretString: aws.String(string(secretString)),
+		Tags: []types.Tag{
+			{Key: aws.String("managed-by"), Value: aws.String("vm-operator")},
+		},
+	})
+	return err
+}
+
+// Delete implements Provider interface
+func (ap *awsProvider) Delete(ctx context.Context, key string) error {
+	_, err := ap.c.DeleteSecret(ctx, &secretsmanager.DeleteSecretInput{
+		SecretId:                   aws.String(ap.namePrefix + key),
+		ForceDeleteWithoutRecovery: aws.Bool(true),
+	})
+	if err != nil && !isAWSNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func isAWSNotFound(err error) bool {
+	var nfErr *types.ResourceNotFoundException
+	return errors.As(err, &nfErr)
+}
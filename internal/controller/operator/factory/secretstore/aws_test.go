@@ -0,0 +1,74 @@
+package secretstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSecretsManager struct {
+	storage map[string]string
+}
+
+func (f *fakeSecretsManager) notFound() error {
+	return &types.ResourceNotFoundException{Message: aws.String("secret not found")}
+}
+
+func (f *fakeSecretsManager) GetSecretValue(_ context.Context, in *secretsmanager.GetSecretValueInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+	v, ok := f.storage[aws.ToString(in.SecretId)]
+	if !ok {
+		return nil, f.notFound()
+	}
+	return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(v)}, nil
+}
+
+func (f *fakeSecretsManager) PutSecretValue(_ context.Context, in *secretsmanager.PutSecretValueInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.PutSecretValueOutput, error) {
+	if _, ok := f.storage[aws.ToString(in.SecretId)]; !ok {
+		return nil, f.notFound()
+	}
+	f.storage[aws.ToString(in.SecretId)] = aws.ToString(in.SecretString)
+	return &secretsmanager.PutSecretValueOutput{}, nil
+}
+
+func (f *fakeSecretsManager) CreateSecret(_ context.Context, in *secretsmanager.CreateSecretInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.CreateSecretOutput, error) {
+	f.storage[aws.ToString(in.Name)] = aws.ToString(in.SecretString)
+	return &secretsmanager.CreateSecretOutput{}, nil
+}
+
+func (f *fakeSecretsManager) DeleteSecret(_ context.Context, in *secretsmanager.DeleteSecretInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.DeleteSecretOutput, error) {
+	if _, ok := f.storage[aws.ToString(in.SecretId)]; !ok {
+		return nil, f.notFound()
+	}
+	delete(f.storage, aws.ToString(in.SecretId))
+	return &secretsmanager.DeleteSecretOutput{}, nil
+}
+
+func TestAWSProvider(t *testing.T) {
+	fake := &fakeSecretsManager{storage: map[string]string{}}
+	ap := &awsProvider{namePrefix: "vm-operator/", c: fake}
+	ctx := context.Background()
+	key := Key("default", "vmuser-test")
+
+	data, err := ap.Get(ctx, key)
+	assert.NoError(t, err)
+	assert.Nil(t, data)
+
+	// create
+	assert.NoError(t, ap.Put(ctx, key, map[string][]byte{"password": []byte("secret")}))
+	assert.Equal(t, `{"password":"secret"}`, fake.storage["vm-operator/default/vmuser-test"])
+
+	// update
+	assert.NoError(t, ap.Put(ctx, key, map[string][]byte{"password": []byte("new-secret")}))
+	data, err = ap.Get(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"password": []byte("new-secret")}, data)
+
+	assert.NoError(t, ap.Delete(ctx, key))
+	assert.Empty(t, fake.storage)
+	// missing key
+	assert.NoError(t, ap.Delete(ctx, key))
+}
@@ -0,0 +1,194 @@
+package secretstore
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+)
+
+// Supported backends for generated credentials
+const (
+	// BackendKubernetes stores generated credentials at cluster Secrets
+	BackendKubernetes = "kubernetes"
+	// BackendVault stores generated credentials at HashiCorp Vault KV v2 secrets engine
+	BackendVault = "vault"
+	// BackendAWS stores generated credentials at AWS Secrets Manager
+	BackendAWS = "aws"
+)
+
+// Provider stores credentials generated by operator, such as VMUser passwords, outside of kubernetes cluster
+type Provider interface {
+	// Get returns credentials stored with the given key
+	// nil data without error is returned if key doesn't exist
+	Get(ctx context.Context, key string) (map[string][]byte, error)
+	// Put creates or updates credentials stored with the given key
+	Put(ctx context.Context, key string, data map[string][]byte) error
+	// Delete removes credentials stored with the given key
+	// It must not return error if key doesn't exist
+	Delete(ctx context.Context, key string) error
+}
+
+// VaultConfig defines connection to HashiCorp Vault
+type VaultConfig struct {
+	// Address of Vault server, e.g. https://vault.vault.svc:8200
+	Address string
+	// MountPath of KV v2 secrets engine
+	MountPath string
+	// PathPrefix is prepended to keys of credentials
+	PathPrefix string
+	// TokenFile contains Vault token, it's re-read on each request
+	// VAULT_TOKEN env var is used if empty
+	TokenFile string
+}
+
+// AWSConfig defines connection to AWS Secrets Manager
+type AWSConfig struct {
+	// Region of AWS Secrets Manager, taken from AWS_REGION env var if empty
+	Region string
+	// NamePrefix is prepended to keys of credentials
+	NamePrefix string
+}
+
+var provider Provider
+
+type cachedCredentials struct {
+	data      map[string][]byte
+	expiresAt time.Time
+}
+
+// cache holds credentials read from backend for cacheTTL
+// operator is the only writer of stored credentials, so cached entries are updated on Put and Delete
+var cache = struct {
+	mu  sync.Mutex
+	ttl time.Duration
+	m   map[string]cachedCredentials
+}{m: make(map[string]cachedCredentials)}
+
+// Init configures backend for generated credentials
+// kubernetes backend keeps credentials at cluster Secrets and doesn't require any configuration
+// credentials read from external backend are cached for cacheTTL, zero value disables cache
+func Init(backend string, cacheTTL time.Duration, vault VaultConfig, aws AWSConfig) error {
+	cache.mu.Lock()
+	cache.ttl = cacheTTL
+	clear(cache.m)
+	cache.mu.Unlock()
+	switch backend {
+	case "", BackendKubernetes:
+		provider = nil
+	case BackendVault:
+		p, err := newVaultProvider(vault)
+		if err != nil {
+			return fmt.Errorf("cannot configure vault backend: %w", err)
+		}
+		provider = p
+	case BackendAWS:
+		p, err := newAWSProvider(aws)
+		if err != nil {
+			return fmt.Errorf("cannot configure aws backend: %w", err)
+		}
+		provider = p
+	default:
+		return fmt.Errorf("unsupported secret store backend=%q, supported values: %s, %s, %s", backend, BackendKubernetes, BackendVault, BackendAWS)
+	}
+	return nil
+}
+
+// IsEnabled checks if generated credentials are stored at external backend
+func IsEnabled() bool {
+	return provider != nil
+}
+
+// Key returns key of credentials for the given namespace and name
+func Key(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// Get returns credentials stored with the given key at configured backend
+func Get(ctx context.Context, key string) (map[string][]byte, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("BUG: external secret store isn't configured")
+	}
+	if data, ok := getCached(key); ok {
+		return data, nil
+	}
+	data, err := provider.Get(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get credentials=%q from secret store: %w", key, err)
+	}
+	setCached(key, data)
+	return maps.Clone(data), nil
+}
+
+// Put stores credentials with the given key at configured backend
+func Put(ctx context.Context, key string, data map[string][]byte) error {
+	if provider == nil {
+		return fmt.Errorf("BUG: external secret store isn't configured")
+	}
+	if err := provider.Put(ctx, key, data); err != nil {
+		deleteCached(key)
+		return fmt.Errorf("cannot put credentials=%q into secret store: %w", key, err)
+	}
+	setCached(key, data)
+	return nil
+}
+
+// Delete removes credentials with the given key from configured backend
+// It's no-op for kubernetes backend
+func Delete(ctx context.Context, key string) error {
+	if provider == nil {
+		return nil
+	}
+	deleteCached(key)
+	if err := provider.Delete(ctx, key); err != nil {
+		return fmt.Errorf("cannot delete credentials=%q from secret store: %w", key, err)
+	}
+	return nil
+}
+
+// getCached returns copy of not expired cached credentials
+func getCached(key string) (map[string][]byte, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cc, ok := cache.m[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(cc.expiresAt) {
+		delete(cache.m, key)
+		return nil, false
+	}
+	return maps.Clone(cc.data), true
+}
+
+func setCached(key string, data map[string][]byte) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if cache.ttl <= 0 {
+		return
+	}
+	cache.m[key] = cachedCredentials{data: maps.Clone(data), expiresAt: time.Now().Add(cache.ttl)}
+}
+
+func deleteCached(key string) {
+	cache.mu.Lock()
+	delete(cache.m, key)
+	cache.mu.Unlock()
+}
+
+func toStringMap(data map[string][]byte) map[string]string {
+	dst := make(map[string]string, len(data))
+	for k, v := range data {
+		dst[k] = string(v)
+	}
+	return dst
+}
+
+func toBytesMap(data map[string]string) map[string][]byte {
+	dst := make(map[string][]byte, len(data))
+	for k, v := range data {
+		dst[k] = []byte(v)
+	}
+	return dst
+}
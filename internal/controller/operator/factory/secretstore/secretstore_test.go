@@ -0,0 +1,103 @@
+package secretstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInit(t *testing.T) {
+	f := func(backend string, vault VaultConfig, aws AWSConfig, wantErr, wantEnabled bool) {
+		t.Helper()
+		defer func() { provider = nil }()
+		err := Init(backend, 0, vault, aws)
+		if wantErr {
+			assert.Error(t, err)
+			return
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, wantEnabled, IsEnabled())
+	}
+	t.Setenv("VAULT_TOKEN", "")
+	t.Setenv("AWS_REGION", "")
+
+	// default backend
+	f("", VaultConfig{}, AWSConfig{}, false, false)
+	f(BackendKubernetes, VaultConfig{}, AWSConfig{}, false, false)
+
+	// unsupported backend
+	f("gcp", VaultConfig{}, AWSConfig{}, true, false)
+
+	// vault without address
+	f(BackendVault, VaultConfig{TokenFile: "/var/run/vault/token"}, AWSConfig{}, true, false)
+
+	// vault without token
+	f(BackendVault, VaultConfig{Address: "http://vault:8200"}, AWSConfig{}, true, false)
+
+	// vault
+	f(BackendVault, VaultConfig{Address: "http://vault:8200", TokenFile: "/var/run/vault/token"}, AWSConfig{}, false, true)
+
+	// aws with region
+	f(BackendAWS, VaultConfig{}, AWSConfig{Region: "eu-west-1"}, false, true)
+}
+
+type countingProvider struct {
+	storage map[string]map[string][]byte
+	gets    int
+}
+
+func (p *countingProvider) Get(_ context.Context, key string) (map[string][]byte, error) {
+	p.gets++
+	return p.storage[key], nil
+}
+
+func (p *countingProvider) Put(_ context.Context, key string, data map[string][]byte) error {
+	p.storage[key] = data
+	return nil
+}
+
+func (p *countingProvider) Delete(_ context.Context, key string) error {
+	delete(p.storage, key)
+	return nil
+}
+
+func TestCache(t *testing.T) {
+	f := func(cacheTTL time.Duration, wantGets int) {
+		t.Helper()
+		assert.NoError(t, Init(BackendKubernetes, cacheTTL, VaultConfig{}, AWSConfig{}))
+		p := &countingProvider{storage: map[string]map[string][]byte{
+			"default/vmuser-test": {"password": []byte("stored")},
+		}}
+		provider = p
+		defer func() { provider = nil }()
+		ctx := context.Background()
+		key := Key("default", "vmuser-test")
+
+		for range 3 {
+			data, err := Get(ctx, key)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string][]byte{"password": []byte("stored")}, data)
+			// modification of returned data doesn't change cached value
+			data["password"] = []byte("modified")
+		}
+		// cache is updated on put
+		assert.NoError(t, Put(ctx, key, map[string][]byte{"password": []byte("updated")}))
+		data, err := Get(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string][]byte{"password": []byte("updated")}, data)
+		// and dropped on delete
+		assert.NoError(t, Delete(ctx, key))
+		data, err = Get(ctx, key)
+		assert.NoError(t, err)
+		assert.Nil(t, data)
+		assert.Equal(t, wantGets, p.gets)
+	}
+
+	// cache disabled
+	f(0, 5)
+
+	// cached credentials
+	f(time.Minute, 2)
+}
@@ -0,0 +1,135 @@
+package secretstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+// vaultProvider stores credentials at KV v2 secrets engine of HashiCorp Vault
+// See https://developer.hashicorp.com/vault/api-docs/secret/kv/kv-v2
+type vaultProvider struct {
+	address    string
+	mountPath  string
+	pathPrefix string
+	tokenFile  string
+	c          *http.Client
+}
+
+func newVaultProvider(cfg VaultConfig) (*vaultProvider, error) {
+	if cfg.Address == "" {
+		return nil, fmt.Errorf("vault address cannot be empty")
+	}
+	if _, err := url.Parse(cfg.Address); err != nil {
+		return nil, fmt.Errorf("cannot parse vault address=%q: %w", cfg.Address, err)
+	}
+	if cfg.TokenFile == "" && os.Getenv("VAULT_TOKEN") == "" {
+		return nil, fmt.Errorf("vault token file or VAULT_TOKEN env var must be set")
+	}
+	mountPath := cfg.MountPath
+	if mountPath == "" {
+		mountPath = "secret"
+	}
+	return &vaultProvider{
+		address:    strings.TrimSuffix(cfg.Address, "/"),
+		mountPath:  strings.Trim(mountPath, "/"),
+		pathPrefix: strings.Trim(cfg.PathPrefix, "/"),
+		tokenFile:  cfg.TokenFile,
+		c:          &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+type vaultKVResponse struct {
+	Data struct {
+		Data map[string]string `json:"data"`
+	} `json:"data"`
+}
+
+// Get implements Provider interface
+func (vp *vaultProvider) Get(ctx context.Context, key string) (map[string][]byte, error) {
+	resp, err := vp.do(ctx, http.MethodGet, vp.url("data", key), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+	var kv vaultKVResponse
+	if err := json.Unmarshal(resp, &kv); err != nil {
+		return nil, fmt.Errorf("cannot parse vault response: %w", err)
+	}
+	return toBytesMap(kv.Data.Data), nil
+}
+
+// Put implements Provider interface
+func (vp *vaultProvider) Put(ctx context.Context, key string, data map[string][]byte) error {
+	body, err := json.Marshal(map[string]any{"data": toStringMap(data)})
+	if err != nil {
+		return fmt.Errorf("cannot serialize vault request: %w", err)
+	}
+	_, err = vp.do(ctx, http.MethodPost, vp.url("data", key), body)
+	return err
+}
+
+// Delete implements Provider interface
+// It removes all versions and metadata of the key
+func (vp *vaultProvider) Delete(ctx context.Context, key string) error {
+	_, err := vp.do(ctx, http.MethodDelete, vp.url("metadata", key), nil)
+	return err
+}
+
+func (vp *vaultProvider) url(kind, key string) string {
+	return vp.address + "/" + path.Join("v1", vp.mountPath, kind, vp.pathPrefix, key)
+}
+
+func (vp *vaultProvider) token() (string, error) {
+	if vp.tokenFile == "" {
+		return os.Getenv("VAULT_TOKEN"), nil
+	}
+	token, err := os.ReadFile(vp.tokenFile)
+	if err != nil {
+		return "", fmt.Errorf("cannot read vault token file: %w", err)
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+// do performs request to vault API
+// it returns nil response body without error for missing key
+func (vp *vaultProvider) do(ctx context.Context, method, reqURL string, body []byte) ([]byte, error) {
+	token, err := vp.token()
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("cannot build vault request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := vp.c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("cannot perform vault request: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read vault response: %w", err)
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, nil
+	case resp.StatusCode >= 300:
+		return nil, fmt.Errorf("unexpected vault response status code=%d for %s %s: %s", resp.StatusCode, method, req.URL.Path, respBody)
+	}
+	return respBody, nil
+}
@@ -0,0 +1,92 @@
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestVaultServer emulates KV v2 secrets engine mounted at secret path
+func newTestVaultServer(t *testing.T, token string) *httptest.Server {
+	t.Helper()
+	var mu sync.Mutex
+	storage := make(map[string]map[string]string)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != token {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/v1/secret/data/"):
+			data, ok := storage[strings.TrimPrefix(r.URL.Path, "/v1/secret/data/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"data": data}})
+		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/v1/secret/data/"):
+			var req struct {
+				Data map[string]string `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			storage[strings.TrimPrefix(r.URL.Path, "/v1/secret/data/")] = req.Data
+			_, _ = w.Write([]byte(`{"data":{"version":1}}`))
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/v1/secret/metadata/"):
+			delete(storage, strings.TrimPrefix(r.URL.Path, "/v1/secret/metadata/"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestVaultProvider(t *testing.T) {
+	srv := newTestVaultServer(t, "test-token")
+	defer srv.Close()
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("test-token\n"), 0o600); err != nil {
+		t.Fatalf("cannot write token file: %s", err)
+	}
+	ctx := context.Background()
+
+	vp, err := newVaultProvider(VaultConfig{Address: srv.URL, PathPrefix: "/vm-operator/", TokenFile: tokenFile})
+	assert.NoError(t, err)
+	assert.Equal(t, srv.URL+"/v1/secret/data/vm-operator/default/vmuser-test", vp.url("data", Key("default", "vmuser-test")))
+
+	key := Key("default", "vmuser-test")
+	data, err := vp.Get(ctx, key)
+	assert.NoError(t, err)
+	assert.Nil(t, data)
+
+	assert.NoError(t, vp.Put(ctx, key, map[string][]byte{"username": []byte("test"), "password": []byte("secret")}))
+	data, err = vp.Get(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"username": []byte("test"), "password": []byte("secret")}, data)
+
+	assert.NoError(t, vp.Delete(ctx, key))
+	data, err = vp.Get(ctx, key)
+	assert.NoError(t, err)
+	assert.Nil(t, data)
+	// missing key
+	assert.NoError(t, vp.Delete(ctx, key))
+
+	// bad token
+	if err := os.WriteFile(tokenFile, []byte("bad-token"), 0o600); err != nil {
+		t.Fatalf("cannot write token file: %s", err)
+	}
+	_, err = vp.Get(ctx, key)
+	assert.Error(t, err)
+}
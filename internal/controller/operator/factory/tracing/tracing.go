@@ -0,0 +1,82 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName  = "github.com/VictoriaMetrics/operator"
+	serviceName = "vm-operator"
+)
+
+// Span names of reconcile pipeline stages
+const (
+	SpanReconcile = "reconcile"
+	SpanBuild     = "build"
+	SpanApply     = "apply"
+	SpanWaitReady = "wait-ready"
+)
+
+// Init configures export of spans to the given OTLP HTTP endpoint, e.g. http://otel-collector:4318/v1/traces.
+// Spans are sampled with the given ratio of reconciles.
+// Returned func must be called on shutdown in order to flush buffered spans.
+func Init(ctx context.Context, endpoint, version string, samplingRatio float64) (func(context.Context) error, error) {
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("cannot create OTLP exporter for endpoint=%q: %w", endpoint, err)
+	}
+	res := resource.NewSchemaless(
+		attribute.String("service.name", serviceName),
+		attribute.String("service.version", version),
+	)
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(samplingRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return provider.Shutdown, nil
+}
+
+// Start starts span with the given name as a child of span from context.
+// It's no-op until Init is called.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records the given error at span and ends it
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Build records building of the given component spec as span
+func Build[T any](ctx context.Context, component string, cb func() (T, error)) (T, error) {
+	_, span := Start(ctx, SpanBuild, attribute.String("component", component))
+	obj, err := cb()
+	End(span, err)
+	return obj, err
+}
+
+// ObjectAttributes returns span attributes for kubernetes object with the given kind, namespace and name
+func ObjectAttributes(kind, namespace, name string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("k8s.object.kind", kind),
+		attribute.String("k8s.namespace.name", namespace),
+		attribute.String("k8s.object.name", name),
+	}
+}
@@ -0,0 +1,50 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestBuild(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	prevProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	defer otel.SetTracerProvider(prevProvider)
+
+	f := func(buildErr error, wantStatus codes.Code) {
+		t.Helper()
+		exporter.Reset()
+		ctx, span := Start(context.Background(), SpanReconcile, ObjectAttributes("VMSingle", "default", "single")...)
+		_, err := Build(ctx, "vmsingle", func() (string, error) {
+			return "spec", buildErr
+		})
+		End(span, err)
+
+		spans := exporter.GetSpans()
+		if !assert.Len(t, spans, 2) {
+			return
+		}
+		build, reconcile := spans[0], spans[1]
+		assert.Equal(t, SpanBuild, build.Name)
+		assert.Equal(t, SpanReconcile, reconcile.Name)
+		assert.Equal(t, reconcile.SpanContext.SpanID(), build.Parent.SpanID())
+		assert.Contains(t, build.Attributes, attribute.String("component", "vmsingle"))
+		assert.Contains(t, reconcile.Attributes, attribute.String("k8s.object.name", "single"))
+		assert.Equal(t, wantStatus, build.Status.Code)
+		assert.Equal(t, wantStatus, reconcile.Status.Code)
+	}
+
+	// successful build
+	f(nil, codes.Unset)
+
+	// failed build
+	f(fmt.Errorf("cannot build spec"), codes.Error)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/tracing"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,7 +42,7 @@ func makeVLogsPvc(r *vmv1beta1.VLogs) *corev1.PersistentVolumeClaim {
 			Namespace:       r.Namespace,
 			Labels:          labels.Merge(r.Spec.StorageMetadata.Labels, r.SelectorLabels()),
 			Annotations:     r.Spec.StorageMetadata.Annotations,
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 			OwnerReferences: r.AsOwner(),
 		},
 		Spec: *r.Spec.Storage,
@@ -88,12 +89,20 @@ func CreateOrUpdateVLogs(ctx context.Context, r *vmv1beta1.VLogs, rclient client
 		if err != nil {
 			return fmt.Errorf("cannot generate prev deploy spec: %w", err)
 		}
+		if err := build.ApplyOverridePatches(prevCR.Spec.OverridePatches, prevDeploy); err != nil {
+			return fmt.Errorf("cannot apply override patches to prev object: %w", err)
+		}
 	}
 
-	newDeploy, err := newDeployForVLogs(r)
+	newDeploy, err := tracing.Build(ctx, "vlogs", func() (*appsv1.Deployment, error) {
+		return newDeployForVLogs(r)
+	})
 	if err != nil {
 		return fmt.Errorf("cannot generate new deploy for vlogs: %w", err)
 	}
+	if err := build.ApplyOverridePatches(r.Spec.OverridePatches, newDeploy); err != nil {
+		return err
+	}
 
 	return reconcile.Deployment(ctx, rclient, newDeploy, prevDeploy, false)
 }
@@ -111,7 +120,7 @@ func newDeployForVLogs(r *vmv1beta1.VLogs) (*appsv1.Deployment, error) {
 			Labels:          r.AllLabels(),
 			Annotations:     r.AnnotationsFiltered(),
 			OwnerReferences: r.AsOwner(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Spec: appsv1.DeploymentSpec{
 			Replicas: r.Spec.ReplicaCount,
@@ -232,6 +241,7 @@ func makeSpecForVLogs(r *vmv1beta1.VLogs) (*corev1.PodTemplateSpec, error) {
 		})
 	}
 
+	args = build.AddVersionedArgs("vlogs", r.Spec.Image.Tag, args, "-")
 	args = build.AddExtraArgsOverrideDefaults(args, r.Spec.ExtraArgs, "-")
 	sort.Strings(args)
 	vlogsContainer := corev1.Container{
@@ -0,0 +1,147 @@
+package vmagent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/VictoriaMetrics/VictoriaMetrics/lib/promrelabel"
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+)
+
+// knownScrapeConfigKeys contains scrape_config options supported by vmagent
+// See https://docs.victoriametrics.com/sd_configs/#scrape_configs
+var knownScrapeConfigKeys = map[string]struct{}{
+	"job_name": {}, "scrape_interval": {}, "scrape_timeout": {}, "metrics_path": {}, "honor_labels": {}, "honor_timestamps": {},
+	"scheme": {}, "params": {}, "proxy_url": {}, "relabel_configs": {}, "metric_relabel_configs": {}, "sample_limit": {},
+	"enable_compression": {}, "disable_compression": {}, "disable_keepalive": {}, "stream_parse": {},
+	"scrape_align_interval": {}, "scrape_offset": {}, "series_limit": {}, "no_stale_markers": {},
+	// http client options
+	"authorization": {}, "basic_auth": {}, "bearer_token": {}, "bearer_token_file": {}, "oauth2": {}, "tls_config": {},
+	"headers": {}, "follow_redirects": {},
+	// proxy client options
+	"proxy_authorization": {}, "proxy_basic_auth": {}, "proxy_bearer_token": {}, "proxy_bearer_token_file": {},
+	"proxy_oauth2": {}, "proxy_tls_config": {}, "proxy_headers": {},
+	// service discovery options
+	"azure_sd_configs": {}, "consul_sd_configs": {}, "consulagent_sd_configs": {}, "digitalocean_sd_configs": {},
+	"dns_sd_configs": {}, "docker_sd_configs": {}, "dockerswarm_sd_configs": {}, "ec2_sd_configs": {},
+	"eureka_sd_configs": {}, "file_sd_configs": {}, "gce_sd_configs": {}, "hetzner_sd_configs": {}, "http_sd_configs": {},
+	"kubernetes_sd_configs": {}, "kuma_sd_configs": {}, "nomad_sd_configs": {}, "openstack_sd_configs": {},
+	"static_configs": {}, "yandexcloud_sd_configs": {},
+}
+
+// lintAdditionalScrapeConfigs parses content of additionalScrapeConfigs secret.
+// Content may contain multiple yaml documents, each of them must be a list of scrape configs.
+// Documents, which cannot be parsed, and scrape configs with errors or job names
+// conflicting with generated scrape configs are excluded from configuration and reported as problems.
+func lintAdditionalScrapeConfigs(data []byte, generated []yaml.MapSlice) ([]yaml.MapSlice, []string) {
+	jobNames := make(map[string]struct{}, len(generated))
+	for _, sc := range generated {
+		if name, ok := scrapeConfigJobName(sc); ok {
+			jobNames[name] = struct{}{}
+		}
+	}
+	var valid []yaml.MapSlice
+	var problems []string
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.SetStrict(true)
+	for docIdx := 0; ; docIdx++ {
+		var doc []yaml.MapSlice
+		if err := dec.Decode(&doc); err != nil {
+			if stderrors.Is(err, io.EOF) {
+				break
+			}
+			problems = append(problems, fmt.Sprintf("document=%d: cannot parse scrape configs: %s", docIdx, err))
+			// decoder cannot continue after syntax error
+			break
+		}
+		for idx, sc := range doc {
+			name, err := lintScrapeConfig(sc)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("document=%d scrape_config=%d: %s", docIdx, idx, err))
+				continue
+			}
+			if _, ok := jobNames[name]; ok {
+				problems = append(problems, fmt.Sprintf("document=%d scrape_config=%d: duplicate job_name=%q", docIdx, idx, name))
+				continue
+			}
+			jobNames[name] = struct{}{}
+			valid = append(valid, sc)
+		}
+	}
+	return valid, problems
+}
+
+func scrapeConfigJobName(sc yaml.MapSlice) (string, bool) {
+	for _, item := range sc {
+		if item.Key == "job_name" {
+			name, ok := item.Value.(string)
+			return name, ok
+		}
+	}
+	return "", false
+}
+
+// lintScrapeConfig checks scrape config options and returns its job name
+func lintScrapeConfig(sc yaml.MapSlice) (string, error) {
+	for _, item := range sc {
+		key, ok := item.Key.(string)
+		if !ok {
+			return "", fmt.Errorf("unexpected key=%v", item.Key)
+		}
+		if _, ok := knownScrapeConfigKeys[key]; !ok {
+			return "", fmt.Errorf("unknown option=%q", key)
+		}
+		switch key {
+		case "relabel_configs", "metric_relabel_configs":
+			if err := parseRelabelConfigsOf(key, item.Value); err != nil {
+				return "", err
+			}
+		}
+	}
+	name, ok := scrapeConfigJobName(sc)
+	if !ok || name == "" {
+		return "", fmt.Errorf("job_name must be non-empty string")
+	}
+	return name, nil
+}
+
+// parseRelabelConfigsOf checks relabel configs defined at scrape config option with the given key
+func parseRelabelConfigsOf(key string, value interface{}) error {
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("cannot marshal %s: %w", key, err)
+	}
+	if _, err := promrelabel.ParseRelabelConfigsData(data); err != nil {
+		return fmt.Errorf("cannot parse %s: %w", key, err)
+	}
+	return nil
+}
+
+// updateAdditionalScrapeConfigsStatus stores problems of additionalScrapeConfigs secret at VMAgent status
+func updateAdditionalScrapeConfigsStatus(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent, problems []string) error {
+	if len(problems) > 0 {
+		logger.WithContext(ctx).Error(fmt.Errorf("found invalid additional scrape configs"), "excluding it from configuration", "secret", cr.Spec.AdditionalScrapeConfigs.Name, "problems", problems)
+	}
+	if reflect.DeepEqual(cr.Status.AdditionalScrapeConfigsErrors, problems) {
+		return nil
+	}
+	cr.Status.AdditionalScrapeConfigsErrors = problems
+	data, err := json.Marshal(map[string]interface{}{"status": map[string]interface{}{"additionalScrapeConfigsErrors": problems}})
+	if err != nil {
+		return fmt.Errorf("cannot marshal vmagent status: %w", err)
+	}
+	if err := rclient.Status().Patch(ctx, cr, client.RawPatch(types.MergePatchType, data)); err != nil {
+		return fmt.Errorf("cannot patch status of vmagent=%q: %w", cr.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package vmagent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestLintAdditionalScrapeConfigs(t *testing.T) {
+	f := func(data string, wantJobs []string, wantProblems []string) {
+		t.Helper()
+		generated := []yaml.MapSlice{{{Key: "job_name", Value: "serviceScrape/default/vmagent/0"}}}
+		got, problems := lintAdditionalScrapeConfigs([]byte(data), generated)
+		var gotJobs []string
+		for _, sc := range got {
+			name, _ := scrapeConfigJobName(sc)
+			gotJobs = append(gotJobs, name)
+		}
+		assert.Equal(t, wantJobs, gotJobs)
+		assert.Equal(t, wantProblems, problems)
+	}
+
+	// empty content
+	f("", nil, nil)
+
+	// multiple documents
+	f(`
+- job_name: first
+  static_configs:
+  - targets: [localhost:8428]
+---
+- job_name: second
+  metric_relabel_configs:
+  - action: drop
+    source_labels: [__name__]
+    regex: go_.*
+`, []string{"first", "second"}, nil)
+
+	// unknown option and missing job name
+	f(`
+- job_name: first
+  static_config:
+  - targets: [localhost:8428]
+- static_configs:
+  - targets: [localhost:8428]
+- job_name: valid
+`, []string{"valid"}, []string{
+		`document=0 scrape_config=0: unknown option="static_config"`,
+		`document=0 scrape_config=1: job_name must be non-empty string`,
+	})
+
+	// duplicate job names
+	f(`
+- job_name: serviceScrape/default/vmagent/0
+---
+- job_name: first
+- job_name: first
+`, []string{"first"}, []string{
+		`document=0 scrape_config=0: duplicate job_name="serviceScrape/default/vmagent/0"`,
+		`document=1 scrape_config=1: duplicate job_name="first"`,
+	})
+
+	// invalid relabeling
+	f(`
+- job_name: first
+  relabel_configs:
+  - action: unsupported
+`, nil, []string{
+		"document=0 scrape_config=0: cannot parse relabel_configs: error when parsing `relabel_config` #1: unknown `action` \"unsupported\"",
+	})
+
+	// broken document
+	f(`
+- job_name: first
+---
+job_name: second
+`, []string{"first"}, []string{
+		"document=1: cannot parse scrape configs: yaml: unmarshal errors:\n  line 4: cannot unmarshal !!map into []yaml.MapSlice",
+	})
+}
@@ -0,0 +1,36 @@
+package vmagent
+
+import (
+	"context"
+	"fmt"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/certmanager"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// createOrUpdateScrapeClientCertificate issues vmagent scrape client certificate with cert-manager
+func createOrUpdateScrapeClientCertificate(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent) error {
+	if cr.Spec.ScrapeClientCertManager == nil {
+		return nil
+	}
+	return certmanager.CreateOrUpdate(ctx, rclient, buildScrapeClientCertificate(cr))
+}
+
+func buildScrapeClientCertificate(cr *vmv1beta1.VMAgent) *certmanager.Certificate {
+	return &certmanager.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            cr.ScrapeClientCertSecretName(),
+			Namespace:       cr.Namespace,
+			Labels:          cr.AllLabels(),
+			Annotations:     cr.AnnotationsFiltered(),
+			OwnerReferences: cr.AsOwner(),
+		},
+		SecretName: cr.ScrapeClientCertSecretName(),
+		CommonName: fmt.Sprintf("%s.%s", cr.PrefixedName(), cr.Namespace),
+		Usages:     []string{certmanager.UsageClientAuth, certmanager.UsageDigitalSignature, certmanager.UsageKeyEncipherment},
+		Spec:       cr.Spec.ScrapeClientCertManager,
+	}
+}
@@ -0,0 +1,126 @@
+package vmagent
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+const (
+	// daemonSetNodeNameEnv holds name of the node, where vmagent pod is running in daemonSetMode
+	daemonSetNodeNameEnv = "KUBE_NODE_NAME"
+	// daemonSetNodeNamePlaceholder is expanded by vmagent from env var during config loading
+	daemonSetNodeNamePlaceholder = "%{" + daemonSetNodeNameEnv + "}"
+
+	daemonSetUnsupportedError = "not supported in daemonSetMode of VMAgent"
+)
+
+// filterDaemonSetModeScrapeObjects excludes scrape objects, which targets cannot be bound to node, from configuration
+// only VMServiceScrape, VMPodScrape and VMNodeScrape are supported in daemonSetMode
+func filterDaemonSetModeScrapeObjects(cr *vmv1beta1.VMAgent, sos *scrapeObjects) {
+	if !cr.Spec.DaemonSetMode {
+		return
+	}
+	sos.badObjects = append(sos.badObjects, markDaemonSetUnsupported(sos.prss)...)
+	sos.badObjects = append(sos.badObjects, markDaemonSetUnsupported(sos.stss)...)
+	sos.badObjects = append(sos.badObjects, markDaemonSetUnsupported(sos.scss)...)
+	sos.prss = nil
+	sos.stss = nil
+	sos.scss = nil
+}
+
+// returned objects have erased type
+func markDaemonSetUnsupported[T scrapeObjectWithStatus](src []T) []scrapeObjectWithStatus {
+	unsupported := make([]scrapeObjectWithStatus, 0, len(src))
+	for _, o := range src {
+		o.GetStatus().CurrentSyncError = daemonSetUnsupportedError
+		unsupported = append(unsupported, o)
+	}
+	return unsupported
+}
+
+// withNodeLocalDiscovery limits targets of the given scrape configs to the node of vmagent pod in daemonSetMode
+func withNodeLocalDiscovery(cr *vmv1beta1.VMAgent, scrapeConfigs []yaml.MapSlice) []yaml.MapSlice {
+	if !cr.Spec.DaemonSetMode {
+		return scrapeConfigs
+	}
+	for i := range scrapeConfigs {
+		scrapeConfigs[i] = addNodeLocalDiscovery(scrapeConfigs[i])
+	}
+	return scrapeConfigs
+}
+
+// addNodeLocalDiscovery limits targets of the given scrape config to the node of vmagent pod
+// pod and node roles use field selectors, while endpoints roles filter targets by the node of backing pod
+func addNodeLocalDiscovery(cfg yaml.MapSlice) yaml.MapSlice {
+	var filterByPodNode bool
+	for i := range cfg {
+		if cfg[i].Key != "kubernetes_sd_configs" {
+			continue
+		}
+		sdConfigs, ok := cfg[i].Value.([]yaml.MapSlice)
+		if !ok {
+			continue
+		}
+		for j, sdc := range sdConfigs {
+			role, _ := mapSliceValue(sdc, "role").(string)
+			switch role {
+			case kubernetesSDRolePod:
+				sdConfigs[j] = addFieldSelector(sdc, role, "spec.nodeName="+daemonSetNodeNamePlaceholder)
+			case kubernetesSDRoleNode:
+				sdConfigs[j] = addFieldSelector(sdc, role, "metadata.name="+daemonSetNodeNamePlaceholder)
+			case kubernetesSDRoleEndpoint, kubernetesSDRoleEndpointSlices:
+				filterByPodNode = true
+			}
+		}
+	}
+	if !filterByPodNode {
+		return cfg
+	}
+	keepNode := yaml.MapSlice{
+		{Key: "action", Value: "keep"},
+		{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_node_name"}},
+		{Key: "regex", Value: daemonSetNodeNamePlaceholder},
+	}
+	return prependScrapeConfigRelabelings(cfg, []yaml.MapSlice{keepNode})
+}
+
+// addFieldSelector merges field selector into the existing selector for the given role
+func addFieldSelector(sdc yaml.MapSlice, role, field string) yaml.MapSlice {
+	for i := range sdc {
+		if sdc[i].Key != "selectors" {
+			continue
+		}
+		selectors, _ := sdc[i].Value.([]yaml.MapSlice)
+		for j, s := range selectors {
+			if mapSliceValue(s, "role") != role {
+				continue
+			}
+			for k := range s {
+				if s[k].Key == "field" {
+					s[k].Value = fmt.Sprintf("%s,%s", s[k].Value, field)
+					return sdc
+				}
+			}
+			selectors[j] = append(s, yaml.MapItem{Key: "field", Value: field})
+			return sdc
+		}
+		sdc[i].Value = append(selectors, yaml.MapSlice{{Key: "role", Value: role}, {Key: "field", Value: field}})
+		return sdc
+	}
+	return append(sdc, yaml.MapItem{
+		Key:   "selectors",
+		Value: []yaml.MapSlice{{{Key: "role", Value: role}, {Key: "field", Value: field}}},
+	})
+}
+
+func mapSliceValue(ms yaml.MapSlice, key string) any {
+	for _, item := range ms {
+		if item.Key == key {
+			return item.Value
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package vmagent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+func TestFilterDaemonSetModeScrapeObjects(t *testing.T) {
+	f := func(daemonSetMode bool, wantErrors []string) {
+		t.Helper()
+		cr := &vmv1beta1.VMAgent{Spec: vmv1beta1.VMAgentSpec{DaemonSetMode: daemonSetMode}}
+		sos := &scrapeObjects{
+			sss:  []*vmv1beta1.VMServiceScrape{{ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "default"}}},
+			pss:  []*vmv1beta1.VMPodScrape{{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"}}},
+			nss:  []*vmv1beta1.VMNodeScrape{{ObjectMeta: metav1.ObjectMeta{Name: "node", Namespace: "default"}}},
+			prss: []*vmv1beta1.VMProbe{{ObjectMeta: metav1.ObjectMeta{Name: "probe", Namespace: "default"}}},
+			stss: []*vmv1beta1.VMStaticScrape{{ObjectMeta: metav1.ObjectMeta{Name: "static", Namespace: "default"}}},
+			scss: []*vmv1beta1.VMScrapeConfig{{ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "default"}}},
+		}
+		filterDaemonSetModeScrapeObjects(cr, sos)
+		assert.Len(t, sos.sss, 1)
+		assert.Len(t, sos.pss, 1)
+		assert.Len(t, sos.nss, 1)
+		var gotErrors []string
+		for _, bo := range sos.badObjects {
+			gotErrors = append(gotErrors, bo.GetName()+": "+bo.GetStatus().CurrentSyncError)
+		}
+		assert.Equal(t, wantErrors, gotErrors)
+		if daemonSetMode {
+			assert.Empty(t, sos.prss)
+			assert.Empty(t, sos.stss)
+			assert.Empty(t, sos.scss)
+		}
+	}
+
+	// regular mode
+	f(false, nil)
+
+	// daemonset mode
+	f(true, []string{
+		"probe: " + daemonSetUnsupportedError,
+		"static: " + daemonSetUnsupportedError,
+		"config: " + daemonSetUnsupportedError,
+	})
+}
+
+func TestAddNodeLocalDiscovery(t *testing.T) {
+	f := func(cfg yaml.MapSlice, want string) {
+		t.Helper()
+		got, err := yaml.Marshal(addNodeLocalDiscovery(cfg))
+		if err != nil {
+			t.Fatalf("cannot marshal config: %s", err)
+		}
+		assert.Equal(t, want, string(got))
+	}
+
+	// pod role without selectors
+	f(yaml.MapSlice{
+		{Key: "job_name", Value: "podScrape/default/app/0"},
+		{Key: "kubernetes_sd_configs", Value: []yaml.MapSlice{{{Key: "role", Value: "pod"}}}},
+	}, `job_name: podScrape/default/app/0
+kubernetes_sd_configs:
+- role: pod
+  selectors:
+  - role: pod
+    field: spec.nodeName=%{KUBE_NODE_NAME}
+`)
+
+	// pod role with label selector
+	f(yaml.MapSlice{
+		{Key: "job_name", Value: "podScrape/default/app/0"},
+		{Key: "kubernetes_sd_configs", Value: []yaml.MapSlice{{
+			{Key: "role", Value: "pod"},
+			{Key: "selectors", Value: []yaml.MapSlice{{
+				{Key: "role", Value: "pod"},
+				{Key: "label", Value: "app=vmagent"},
+			}}},
+		}}},
+	}, `job_name: podScrape/default/app/0
+kubernetes_sd_configs:
+- role: pod
+  selectors:
+  - role: pod
+    label: app=vmagent
+    field: spec.nodeName=%{KUBE_NODE_NAME}
+`)
+
+	// node role
+	f(yaml.MapSlice{
+		{Key: "job_name", Value: "nodeScrape/default/kubelet/0"},
+		{Key: "kubernetes_sd_configs", Value: []yaml.MapSlice{{{Key: "role", Value: "node"}}}},
+	}, `job_name: nodeScrape/default/kubelet/0
+kubernetes_sd_configs:
+- role: node
+  selectors:
+  - role: node
+    field: metadata.name=%{KUBE_NODE_NAME}
+`)
+
+	// endpoints role
+	f(yaml.MapSlice{
+		{Key: "job_name", Value: "serviceScrape/default/app/0"},
+		{Key: "kubernetes_sd_configs", Value: []yaml.MapSlice{{{Key: "role", Value: "endpoints"}}}},
+		{Key: "relabel_configs", Value: []yaml.MapSlice{{
+			{Key: "action", Value: "keep"},
+			{Key: "source_labels", Value: []string{"__meta_kubernetes_service_label_app"}},
+			{Key: "regex", Value: "app"},
+		}}},
+	}, `job_name: serviceScrape/default/app/0
+kubernetes_sd_configs:
+- role: endpoints
+relabel_configs:
+- action: keep
+  source_labels:
+  - __meta_kubernetes_pod_node_name
+  regex: '%{KUBE_NODE_NAME}'
+- action: keep
+  source_labels:
+  - __meta_kubernetes_service_label_app
+  regex: app
+`)
+}
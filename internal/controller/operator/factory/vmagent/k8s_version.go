@@ -0,0 +1,76 @@
+package vmagent
+
+import (
+	"context"
+	"fmt"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+)
+
+// filterUnsupportedScrapeObjects excludes scrape objects, which require kubernetes APIs
+// not served by the current kubernetes version, from configuration
+// VMServiceScrape with endpointslices discovery role falls back to endpoints role
+func filterUnsupportedScrapeObjects(ctx context.Context, sos *scrapeObjects) {
+	if !k8stools.IsEndpointSlicesSupported() {
+		for _, ss := range sos.sss {
+			if ss.Spec.DiscoveryRole == kubernetesSDRoleEndpointSlices {
+				logger.WithContext(ctx).Info(fmt.Sprintf("discovery.k8s.io/v1 API is not supported by kubernetes version=%d.%d, using %s discovery role instead of %s",
+					k8stools.ServerMajorVersion, k8stools.ServerMinorVersion, kubernetesSDRoleEndpoint, kubernetesSDRoleEndpointSlices),
+					"vmservicescrape", ss.Name, "namespace", ss.Namespace)
+				ss.Spec.DiscoveryRole = kubernetesSDRoleEndpoint
+			}
+		}
+	}
+	var tempBo []scrapeObjectWithStatus
+	sos.prss, tempBo = forEachCollectUnsupported(sos.prss, func(probe *vmv1beta1.VMProbe) error {
+		if probe.Spec.Targets.Ingress != nil {
+			return validateKubernetesSDRole(kubernetesSDRoleIngress)
+		}
+		return nil
+	})
+	sos.badObjects = append(sos.badObjects, tempBo...)
+	sos.scss, tempBo = forEachCollectUnsupported(sos.scss, func(sc *vmv1beta1.VMScrapeConfig) error {
+		for i, ksd := range sc.Spec.KubernetesSDConfigs {
+			if err := validateKubernetesSDRole(ksd.Role); err != nil {
+				return fmt.Errorf("incorrect kubernetesSDConfig at idx=%d: %w", i, err)
+			}
+		}
+		return nil
+	})
+	sos.badObjects = append(sos.badObjects, tempBo...)
+}
+
+// validateKubernetesSDRole checks if kubernetes API required by kubernetes_sd role is served
+// vmagent uses discovery.k8s.io/v1 for endpointslices and networking.k8s.io/v1 for ingresses
+func validateKubernetesSDRole(role string) error {
+	switch role {
+	case kubernetesSDRoleEndpointSlices, "endpointslice":
+		if !k8stools.IsEndpointSlicesSupported() {
+			return fmt.Errorf("role=%q requires discovery.k8s.io/v1 API, which is supported since kubernetes version 1.21", role)
+		}
+	case kubernetesSDRoleIngress:
+		if !k8stools.IsIngressNetworkingV1Supported() {
+			return fmt.Errorf("role=%q requires networking.k8s.io/v1 API, which is supported since kubernetes version 1.19", role)
+		}
+	}
+	return nil
+}
+
+// returned unsupported objects have erased type
+func forEachCollectUnsupported[T scrapeObjectWithStatus](src []T, validate func(s T) error) ([]T, []scrapeObjectWithStatus) {
+	var cnt int
+	var unsupported []scrapeObjectWithStatus
+	for _, o := range src {
+		if err := validate(o); err != nil {
+			st := o.GetStatus()
+			st.CurrentSyncError = fmt.Sprintf("unsupported by kubernetes version=%d.%d: %s", k8stools.ServerMajorVersion, k8stools.ServerMinorVersion, err)
+			unsupported = append(unsupported, o)
+			continue
+		}
+		src[cnt] = o
+		cnt++
+	}
+	return src[:cnt], unsupported
+}
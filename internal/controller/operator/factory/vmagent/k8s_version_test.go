@@ -0,0 +1,81 @@
+package vmagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestFilterUnsupportedScrapeObjects(t *testing.T) {
+	newScrapeObjects := func() *scrapeObjects {
+		return &scrapeObjects{
+			sss: []*vmv1beta1.VMServiceScrape{{
+				ObjectMeta: metav1.ObjectMeta{Name: "slices", Namespace: "default"},
+				Spec:       vmv1beta1.VMServiceScrapeSpec{DiscoveryRole: kubernetesSDRoleEndpointSlices},
+			}},
+			prss: []*vmv1beta1.VMProbe{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "ingress", Namespace: "default"},
+					Spec:       vmv1beta1.VMProbeSpec{Targets: vmv1beta1.VMProbeTargets{Ingress: &vmv1beta1.ProbeTargetIngress{}}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "static", Namespace: "default"},
+					Spec:       vmv1beta1.VMProbeSpec{Targets: vmv1beta1.VMProbeTargets{StaticConfig: &vmv1beta1.VMProbeTargetStaticConfig{Targets: []string{"example.com"}}}},
+				},
+			},
+			scss: []*vmv1beta1.VMScrapeConfig{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "slices", Namespace: "default"},
+					Spec:       vmv1beta1.VMScrapeConfigSpec{KubernetesSDConfigs: []vmv1beta1.KubernetesSDConfig{{Role: "pod"}, {Role: "endpointslice"}}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "pods", Namespace: "default"},
+					Spec:       vmv1beta1.VMScrapeConfigSpec{KubernetesSDConfigs: []vmv1beta1.KubernetesSDConfig{{Role: "pod"}}},
+				},
+			},
+		}
+	}
+	f := func(serverVersion version.Info, wantRole string, wantProbes, wantScrapeConfigs []string, wantErrors []string) {
+		t.Helper()
+		if err := k8stools.SetKubernetesVersionWithDefaults(&serverVersion, 0, 0); err != nil {
+			t.Fatalf("cannot set k8s version for testing: %q", err)
+		}
+		defer func() {
+			// return back defaults after test
+			restoreVersion := version.Info{Major: "0", Minor: "0"}
+			if err := k8stools.SetKubernetesVersionWithDefaults(&restoreVersion, 0, 0); err != nil {
+				t.Fatalf("cannot set k8s version for testing: %q", err)
+			}
+		}()
+		sos := newScrapeObjects()
+		filterUnsupportedScrapeObjects(context.Background(), sos)
+		assert.Equal(t, wantRole, sos.sss[0].Spec.DiscoveryRole)
+		var gotProbes, gotScrapeConfigs, gotErrors []string
+		for _, p := range sos.prss {
+			gotProbes = append(gotProbes, p.Name)
+		}
+		for _, sc := range sos.scss {
+			gotScrapeConfigs = append(gotScrapeConfigs, sc.Name)
+		}
+		for _, bo := range sos.badObjects {
+			gotErrors = append(gotErrors, bo.GetStatus().CurrentSyncError)
+		}
+		assert.Equal(t, wantProbes, gotProbes)
+		assert.Equal(t, wantScrapeConfigs, gotScrapeConfigs)
+		assert.Equal(t, wantErrors, gotErrors)
+	}
+	f(version.Info{Major: "1", Minor: "30"}, kubernetesSDRoleEndpointSlices, []string{"ingress", "static"}, []string{"slices", "pods"}, nil)
+	f(version.Info{Major: "1", Minor: "20"}, kubernetesSDRoleEndpoint, []string{"ingress", "static"}, []string{"pods"}, []string{
+		`unsupported by kubernetes version=1.20: incorrect kubernetesSDConfig at idx=1: role="endpointslice" requires discovery.k8s.io/v1 API, which is supported since kubernetes version 1.21`,
+	})
+	f(version.Info{Major: "1", Minor: "18"}, kubernetesSDRoleEndpoint, []string{"static"}, []string{"pods"}, []string{
+		`unsupported by kubernetes version=1.18: role="ingress" requires networking.k8s.io/v1 API, which is supported since kubernetes version 1.19`,
+		`unsupported by kubernetes version=1.18: incorrect kubernetesSDConfig at idx=1: role="endpointslice" requires discovery.k8s.io/v1 API, which is supported since kubernetes version 1.21`,
+	})
+}
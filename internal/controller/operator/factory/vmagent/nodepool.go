@@ -0,0 +1,188 @@
+package vmagent
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+)
+
+const (
+	// nodePoolShardEnv holds name of node pool shard served by vmagent pod
+	// it's empty for regular vmagent pods
+	nodePoolShardEnv         = "VMAGENT_NODE_POOL_SHARD"
+	nodePoolShardPlaceholder = "%{" + nodePoolShardEnv + "}"
+	nodePoolShardLabel       = "node-pool-shard"
+)
+
+// collectNodePoolShards returns sorted names of node pool shards defined by the given VMNodeScrapes
+// node pool shards aren't supported in daemonSetMode, since each pod scrapes only its node
+func collectNodePoolShards(cr *vmv1beta1.VMAgent, nss []*vmv1beta1.VMNodeScrape) []string {
+	if cr.Spec.DaemonSetMode {
+		return nil
+	}
+	uniq := make(map[string]struct{})
+	for _, ns := range nss {
+		if ns.Spec.NodePoolShard != "" {
+			uniq[ns.Spec.NodePoolShard] = struct{}{}
+		}
+	}
+	if len(uniq) == 0 {
+		return nil
+	}
+	shards := make([]string, 0, len(uniq))
+	for shard := range uniq {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+	return shards
+}
+
+// addNodePoolShardFilter keeps targets of the given scrape config only at vmagent pods of the given node pool shard
+// empty shard means regular vmagent pods
+func addNodePoolShardFilter(cfg yaml.MapSlice, shard string) yaml.MapSlice {
+	filter := []yaml.MapSlice{
+		{
+			{Key: "target_label", Value: "__tmp_node_pool_shard"},
+			{Key: "replacement", Value: nodePoolShardPlaceholder},
+		},
+		{
+			{Key: "action", Value: "keep"},
+			{Key: "source_labels", Value: []string{"__tmp_node_pool_shard"}},
+			{Key: "regex", Value: shard},
+		},
+	}
+	return prependScrapeConfigRelabelings(cfg, filter)
+}
+
+// prependScrapeConfigRelabelings adds given relabeling rules before relabel_configs of the scrape config
+// scrape config could be either generated or parsed from additional scrape configs
+func prependScrapeConfigRelabelings(cfg yaml.MapSlice, rcs []yaml.MapSlice) yaml.MapSlice {
+	for i := range cfg {
+		if cfg[i].Key != "relabel_configs" {
+			continue
+		}
+		switch relabelings := cfg[i].Value.(type) {
+		case []yaml.MapSlice:
+			cfg[i].Value = append(append([]yaml.MapSlice{}, rcs...), relabelings...)
+		case []any:
+			dst := make([]any, 0, len(rcs)+len(relabelings))
+			for _, rc := range rcs {
+				dst = append(dst, rc)
+			}
+			cfg[i].Value = append(dst, relabelings...)
+		default:
+			cfg[i].Value = rcs
+		}
+		return cfg
+	}
+	return append(cfg, yaml.MapItem{Key: "relabel_configs", Value: rcs})
+}
+
+// nodePoolShardEnvVar returns env var with node pool shard name for vmagent container
+func nodePoolShardEnvVar(shard string) corev1.EnvVar {
+	return corev1.EnvVar{Name: nodePoolShardEnv, Value: shard}
+}
+
+// newNodePoolShardForVMAgent builds dedicated deployment or statefulset for the given node pool shard
+// based on vmagent application object.
+// Node pool shard isn't a member of vmagent cluster and runs spec.replicaCount replicas.
+func newNodePoolShardForVMAgent(cr *vmv1beta1.VMAgent, base runtime.Object, shard string) runtime.Object {
+	dst := base.DeepCopyObject()
+	var podSpec *corev1.PodSpec
+	switch dst := dst.(type) {
+	case *appsv1.Deployment:
+		dst.Name = fmt.Sprintf("%s-pool-%s", dst.Name, shard)
+		dst.Spec.Selector.MatchLabels[nodePoolShardLabel] = shard
+		dst.Spec.Template.Labels[nodePoolShardLabel] = shard
+		podSpec = &dst.Spec.Template.Spec
+	case *appsv1.StatefulSet:
+		dst.Name = fmt.Sprintf("%s-pool-%s", dst.Name, shard)
+		dst.Spec.Selector.MatchLabels[nodePoolShardLabel] = shard
+		dst.Spec.Template.Labels[nodePoolShardLabel] = shard
+		dst.Spec.Replicas = ptr.To(ptr.Deref(cr.Spec.ReplicaCount, 1))
+		podSpec = &dst.Spec.Template.Spec
+	default:
+		return dst
+	}
+	for i := range podSpec.Containers {
+		container := &podSpec.Containers[i]
+		if container.Name != "vmagent" {
+			continue
+		}
+		args := container.Args[:0]
+		for _, arg := range container.Args {
+			if !strings.Contains(arg, "promscrape.cluster.") {
+				args = append(args, arg)
+			}
+		}
+		container.Args = args
+		for j := range container.Env {
+			if container.Env[j].Name == nodePoolShardEnv {
+				container.Env[j] = nodePoolShardEnvVar(shard)
+			}
+		}
+	}
+	return dst
+}
+
+// reconcileNodePoolShard creates or updates application object of the given node pool shard
+// names of reconciled objects are added to the given maps in order to keep them from orphans removal
+func reconcileNodePoolShard(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent, newApp, prevApp runtime.Object, shard string, deploymentNames, stsNames map[string]struct{}) error {
+	newObj := newNodePoolShardForVMAgent(cr, newApp, shard)
+	var prevObj runtime.Object
+	if prevApp != nil {
+		prevObj = newNodePoolShardForVMAgent(cr, prevApp, shard)
+	}
+	switch newObj := newObj.(type) {
+	case *appsv1.Deployment:
+		newDep, err := k8stools.RenderPlaceholders(newObj, defaultPlaceholders)
+		if err != nil {
+			return fmt.Errorf("cannot fill placeholders for deployment: %w", err)
+		}
+		var prevDep *appsv1.Deployment
+		if prev, ok := prevObj.(*appsv1.Deployment); ok {
+			prevDep, err = k8stools.RenderPlaceholders(prev, defaultPlaceholders)
+			if err != nil {
+				return fmt.Errorf("cannot fill placeholders for prev deployment: %w", err)
+			}
+		}
+		if err := reconcile.Deployment(ctx, rclient, newDep, prevDep, false); err != nil {
+			return err
+		}
+		deploymentNames[newDep.Name] = struct{}{}
+	case *appsv1.StatefulSet:
+		newSTS, err := k8stools.RenderPlaceholders(newObj, defaultPlaceholders)
+		if err != nil {
+			return fmt.Errorf("cannot fill placeholders for sts: %w", err)
+		}
+		var prevSTS *appsv1.StatefulSet
+		if prev, ok := prevObj.(*appsv1.StatefulSet); ok {
+			prevSTS, err = k8stools.RenderPlaceholders(prev, defaultPlaceholders)
+			if err != nil {
+				return fmt.Errorf("cannot fill placeholders for prev sts: %w", err)
+			}
+		}
+		stsOpts := reconcile.STSOptions{
+			HasClaim:       len(newSTS.Spec.VolumeClaimTemplates) > 0,
+			SelectorLabels: func() map[string]string { return newSTS.Spec.Selector.MatchLabels },
+			UpdateStrategy: cr.Spec.StatefulUpdateStrategy,
+		}
+		if err := reconcile.HandleSTSUpdate(ctx, rclient, stsOpts, newSTS, prevSTS); err != nil {
+			return err
+		}
+		stsNames[newSTS.Name] = struct{}{}
+	}
+	return nil
+}
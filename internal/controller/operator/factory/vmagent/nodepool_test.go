@@ -0,0 +1,182 @@
+package vmagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+func TestGenerateConfigWithNodePoolShards(t *testing.T) {
+	nodeScrape := func(name, shard string) *vmv1beta1.VMNodeScrape {
+		return &vmv1beta1.VMNodeScrape{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       vmv1beta1.VMNodeScrapeSpec{Port: "9100", NodePoolShard: shard},
+		}
+	}
+	f := func(daemonSetMode bool, nss []*vmv1beta1.VMNodeScrape, additionalScrapeConfigs string, wantShards []string, wantJobShards map[string]string) {
+		t.Helper()
+		cr := &vmv1beta1.VMAgent{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec:       vmv1beta1.VMAgentSpec{DaemonSetMode: daemonSetMode},
+		}
+		sos := &scrapeObjects{
+			sss: []*vmv1beta1.VMServiceScrape{{
+				ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "default"},
+				Spec:       vmv1beta1.VMServiceScrapeSpec{Endpoints: []vmv1beta1.Endpoint{{Port: "http"}}},
+			}},
+			nss: nss,
+		}
+		ssCache := &scrapesSecretsCache{}
+		data, _, err := generateConfig(context.Background(), cr, sos, ssCache, []byte(additionalScrapeConfigs), nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assert.Equal(t, wantShards, ssCache.nodePoolShards)
+		var cfg struct {
+			ScrapeConfigs []struct {
+				JobName        string `yaml:"job_name"`
+				RelabelConfigs []struct {
+					Action       string   `yaml:"action"`
+					SourceLabels []string `yaml:"source_labels"`
+					Regex        *string  `yaml:"regex"`
+				} `yaml:"relabel_configs"`
+			} `yaml:"scrape_configs"`
+		}
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			t.Fatalf("cannot parse generated config: %s", err)
+		}
+		gotJobShards := make(map[string]string)
+		for _, sc := range cfg.ScrapeConfigs {
+			for _, rc := range sc.RelabelConfigs {
+				if rc.Action == "keep" && len(rc.SourceLabels) == 1 && rc.SourceLabels[0] == "__tmp_node_pool_shard" {
+					gotJobShards[sc.JobName] = ptr.Deref(rc.Regex, "")
+				}
+			}
+		}
+		assert.Equal(t, wantJobShards, gotJobShards)
+	}
+
+	// no node pool shards
+	f(false, []*vmv1beta1.VMNodeScrape{nodeScrape("node-exporter", "")}, "", nil, map[string]string{})
+
+	// node pool shards
+	f(false, []*vmv1beta1.VMNodeScrape{
+		nodeScrape("node-exporter", ""),
+		nodeScrape("gpu-exporter", "gpu"),
+		nodeScrape("gpu-dcgm", "gpu"),
+		nodeScrape("arm-exporter", "arm"),
+	}, `
+- job_name: extra
+  static_configs:
+  - targets: [localhost:8429]
+  relabel_configs:
+  - target_label: env
+    replacement: dev
+`, []string{"arm", "gpu"}, map[string]string{
+		"serviceScrape/default/svc/0":        "",
+		"nodeScrape/default/node-exporter/0": "",
+		"nodeScrape/default/gpu-exporter/1":  "gpu",
+		"nodeScrape/default/gpu-dcgm/2":      "gpu",
+		"nodeScrape/default/arm-exporter/3":  "arm",
+		"extra":                              "",
+	})
+
+	// node pool shards are ignored in daemonSetMode
+	f(true, []*vmv1beta1.VMNodeScrape{nodeScrape("gpu-exporter", "gpu")}, "", nil, map[string]string{})
+}
+
+func TestPrependScrapeConfigRelabelings(t *testing.T) {
+	f := func(src, want string) {
+		t.Helper()
+		var cfg yaml.MapSlice
+		if err := yaml.Unmarshal([]byte(src), &cfg); err != nil {
+			t.Fatalf("cannot parse scrape config: %s", err)
+		}
+		got, err := yaml.Marshal(addNodePoolShardFilter(cfg, "gpu"))
+		if err != nil {
+			t.Fatalf("cannot marshal scrape config: %s", err)
+		}
+		assert.Equal(t, want, string(got))
+	}
+
+	// parsed config without relabelings
+	f(`job_name: extra`, `job_name: extra
+relabel_configs:
+- target_label: __tmp_node_pool_shard
+  replacement: '%{VMAGENT_NODE_POOL_SHARD}'
+- action: keep
+  source_labels:
+  - __tmp_node_pool_shard
+  regex: gpu
+`)
+
+	// parsed config with relabelings
+	f(`
+job_name: extra
+relabel_configs:
+- target_label: env
+  replacement: dev
+`, `job_name: extra
+relabel_configs:
+- target_label: __tmp_node_pool_shard
+  replacement: '%{VMAGENT_NODE_POOL_SHARD}'
+- action: keep
+  source_labels:
+  - __tmp_node_pool_shard
+  regex: gpu
+- target_label: env
+  replacement: dev
+`)
+}
+
+func TestNewNodePoolShardForVMAgent(t *testing.T) {
+	cr := &vmv1beta1.VMAgent{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"},
+		Spec: vmv1beta1.VMAgentSpec{
+			CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+				ReplicaCount: ptr.To[int32](2),
+			},
+		},
+	}
+	podSpec := corev1.PodSpec{
+		Containers: []corev1.Container{{
+			Name: "vmagent",
+			Args: []string{"-httpListenAddr=:8429", "-promscrape.cluster.membersCount=3", "-promscrape.cluster.memberNum=$(POD_NAME)"},
+			Env:  []corev1.EnvVar{nodePoolShardEnvVar("")},
+		}},
+	}
+	base := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "vmagent-agent", Namespace: "default"},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To[int32](3),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "vmagent"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "vmagent"}},
+				Spec:       podSpec,
+			},
+		},
+	}
+	got, ok := newNodePoolShardForVMAgent(cr, base, "gpu").(*appsv1.StatefulSet)
+	if !ok {
+		t.Fatalf("expected statefulset for node pool shard")
+	}
+	assert.Equal(t, "vmagent-agent-pool-gpu", got.Name)
+	assert.Equal(t, int32(2), *got.Spec.Replicas)
+	assert.Equal(t, map[string]string{"app": "vmagent", nodePoolShardLabel: "gpu"}, got.Spec.Selector.MatchLabels)
+	assert.Equal(t, map[string]string{"app": "vmagent", nodePoolShardLabel: "gpu"}, got.Spec.Template.Labels)
+	assert.Equal(t, []string{"-httpListenAddr=:8429"}, got.Spec.Template.Spec.Containers[0].Args)
+	assert.Equal(t, []corev1.EnvVar{nodePoolShardEnvVar("gpu")}, got.Spec.Template.Spec.Containers[0].Env)
+
+	// base object must not be modified
+	assert.Equal(t, "vmagent-agent", base.Name)
+	assert.Equal(t, map[string]string{"app": "vmagent"}, base.Spec.Selector.MatchLabels)
+	assert.Len(t, base.Spec.Template.Spec.Containers[0].Args, 3)
+}
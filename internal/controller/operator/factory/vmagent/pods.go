@@ -0,0 +1,79 @@
+package vmagent
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+// podsFetchConcurrency limits number of vmagent pods requested concurrently
+const podsFetchConcurrency = 8
+
+// podsFetchTimeout limits total duration of requests to vmagent pods, they're performed during reconcile
+var podsFetchTimeout = 3 * time.Second
+
+// podsHTTPClient is used for requests to vmagent pods.
+// vmagent serves certificate issued for service name, it cannot be verified for requests made by pod IP,
+// so pods with TLS cannot be requested, unless verification is disabled with Init
+var podsHTTPClient = newPodsHTTPClient(false)
+
+// scrapeTargetsStatus enables reporting of targets count at status.targets of scrape objects
+var scrapeTargetsStatus bool
+
+func newPodsHTTPClient(insecureSkipVerify bool) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: insecureSkipVerify}, // #nosec G402
+		},
+	}
+}
+
+// Init configures requests to vmagent pods
+// tlsInsecureSkipVerify disables TLS certificate verification, it's controlled by -vmagent.podsTLSInsecureSkipVerify flag
+// reportScrapeTargets enables targets count at status of scrape objects, it's controlled by -vmagent.scrapeTargetsStatus flag
+func Init(tlsInsecureSkipVerify, reportScrapeTargets bool) {
+	podsHTTPClient = newPodsHTTPClient(tlsInsecureSkipVerify)
+	scrapeTargetsStatus = reportScrapeTargets
+}
+
+// forEachPod concurrently calls fetch for running vmagent pods
+// at most podsFetchConcurrency pods are requested at once and all requests are limited by podsFetchTimeout,
+// so unreachable pods don't block reconcile
+func forEachPod(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent, fetch func(ctx context.Context, pod *corev1.Pod)) error {
+	var podList corev1.PodList
+	if err := rclient.List(ctx, &podList, &client.ListOptions{
+		Namespace:     cr.Namespace,
+		LabelSelector: labels.SelectorFromSet(cr.SelectorLabels()),
+	}); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, podsFetchTimeout)
+	defer cancel()
+	var wg sync.WaitGroup
+	limitCh := make(chan struct{}, podsFetchConcurrency)
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.DeletionTimestamp != nil || pod.Status.PodIP == "" {
+			continue
+		}
+		wg.Add(1)
+		limitCh <- struct{}{}
+		go func() {
+			defer func() {
+				<-limitCh
+				wg.Done()
+			}()
+			fetch(ctx, pod)
+		}()
+	}
+	wg.Wait()
+	return nil
+}
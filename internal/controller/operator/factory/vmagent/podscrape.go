@@ -30,6 +30,8 @@ func generatePodScrapeConfig(
 	if ep.AttachMetadata.Node == nil && m.Spec.AttachMetadata.Node != nil {
 		ep.AttachMetadata = m.Spec.AttachMetadata
 	}
+	sc, _ := vmagentCR.ScrapeClass(m.Spec.ScrapeClassName)
+	applyScrapeClass(sc, &ep.EndpointRelabelings, &ep.EndpointAuth, &ep.AttachMetadata)
 	cfg = append(cfg, generatePodK8SSDConfig(selectedNamespaces, m.Spec.Selector, apiserverConfig, ssCache, kubernetesSDRolePod, &ep.AttachMetadata))
 
 	// set defaults
@@ -212,6 +212,93 @@ relabel_configs:
   replacement: default/test-1
 - target_label: endpoint
   replacement: web
+`,
+		},
+		{
+			name: "with scrape class",
+			args: args{
+				cr: vmv1beta1.VMAgent{
+					Spec: vmv1beta1.VMAgentSpec{
+						ScrapeClasses: []vmv1beta1.ScrapeClass{
+							{
+								Name:           "default",
+								Default:        ptr.To(true),
+								TLSConfig:      &vmv1beta1.TLSConfig{CAFile: "/etc/ca.crt", CertFile: "/etc/tls.crt", KeyFile: "/etc/tls.key"},
+								AttachMetadata: &vmv1beta1.AttachMetadata{Node: ptr.To(true)},
+								RelabelConfigs: []*vmv1beta1.RelabelConfig{
+									{TargetLabel: "cluster", Replacement: "main"},
+								},
+								MetricRelabelConfigs: []*vmv1beta1.RelabelConfig{
+									{Action: "drop", SourceLabels: []string{"__name__"}, Regex: vmv1beta1.StringOrArray{"go_.*"}},
+								},
+							},
+						},
+					},
+				},
+				m: &vmv1beta1.VMPodScrape{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-1",
+						Namespace: "default",
+					},
+				},
+				ep: vmv1beta1.PodMetricsEndpoint{
+					Port: "web",
+					EndpointAuth: vmv1beta1.EndpointAuth{
+						TLSConfig: &vmv1beta1.TLSConfig{CAFile: "/etc/custom-ca.crt"},
+					},
+					EndpointRelabelings: vmv1beta1.EndpointRelabelings{
+						RelabelConfigs: []*vmv1beta1.RelabelConfig{
+							{TargetLabel: "team", Replacement: "a"},
+						},
+					},
+				},
+				ssCache: &scrapesSecretsCache{},
+			},
+			want: `job_name: podScrape/default/test-1/0
+kubernetes_sd_configs:
+- role: pod
+  attach_metadata:
+    node: true
+  namespaces:
+    names:
+    - default
+honor_labels: false
+relabel_configs:
+- action: drop
+  source_labels:
+  - __meta_kubernetes_pod_phase
+  regex: (Failed|Succeeded)
+- action: keep
+  source_labels:
+  - __meta_kubernetes_pod_container_port_name
+  regex: web
+- source_labels:
+  - __meta_kubernetes_namespace
+  target_label: namespace
+- source_labels:
+  - __meta_kubernetes_pod_container_name
+  target_label: container
+- source_labels:
+  - __meta_kubernetes_pod_name
+  target_label: pod
+- target_label: job
+  replacement: default/test-1
+- target_label: endpoint
+  replacement: web
+- target_label: cluster
+  replacement: main
+- target_label: team
+  replacement: a
+metric_relabel_configs:
+- source_labels:
+  - __name__
+  regex: go_.*
+  action: drop
+tls_config:
+  insecure_skip_verify: false
+  ca_file: /etc/custom-ca.crt
+  cert_file: /etc/tls.crt
+  key_file: /etc/tls.key
 `,
 		},
 	}
@@ -3,11 +3,35 @@ package vmagent
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/cespare/xxhash/v2"
 	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// proberHashSlots defines the number of hash slots used for distributing probe targets across probers.
+// Slots are assigned to probers with rendezvous hashing,
+// so adding or removing a prober moves only targets of its own slots.
+const proberHashSlots = 256
+
+// proberConfigError represents incorrect prober configuration of VMProbe
+type proberConfigError struct {
+	msg string
+}
+
+// Error implements interface
+func (pe *proberConfigError) Error() string {
+	return pe.msg
+}
+
 func generateProbeConfig(
 	ctx context.Context,
 	vmagentCR *vmv1beta1.VMAgent,
@@ -29,6 +53,9 @@ func generateProbeConfig(
 		cr.Spec.VMProberSpec.Path = "/probe"
 	}
 	cr.Spec.EndpointScrapeParams.Path = cr.Spec.VMProberSpec.Path
+	if cr.Spec.VMProberSpec.Scheme != "" {
+		cr.Spec.EndpointScrapeParams.Scheme = cr.Spec.VMProberSpec.Scheme
+	}
 
 	if len(cr.Spec.Module) > 0 {
 		if cr.Spec.Params == nil {
@@ -117,16 +144,18 @@ func generateProbeConfig(
 	}
 
 	// Relabelings for prober.
-	relabelings = append(relabelings, []yaml.MapSlice{
-		{
-			{Key: "source_labels", Value: []string{"__param_target"}},
-			{Key: "target_label", Value: "instance"},
-		},
-		{
+	relabelings = append(relabelings, yaml.MapSlice{
+		{Key: "source_labels", Value: []string{"__param_target"}},
+		{Key: "target_label", Value: "instance"},
+	})
+	if probers := ssCache.probers[cr.AsMapKey()]; len(probers) > 0 {
+		relabelings = append(relabelings, generateProberShardRelabelings(probers)...)
+	} else {
+		relabelings = append(relabelings, yaml.MapSlice{
 			{Key: "target_label", Value: "__address__"},
 			{Key: "replacement", Value: cr.Spec.VMProberSpec.URL},
-		},
-	}...)
+		})
+	}
 
 	for _, trc := range vmagentCR.Spec.ProbeScrapeRelabelTemplate {
 		relabelings = append(relabelings, generateRelabelConfig(trc))
@@ -143,3 +172,135 @@ func generateProbeConfig(
 
 	return cfg
 }
+
+// loadProberConfig validates probe module against prober configuration
+// and discovers prober services selected by VMProbe
+func loadProberConfig(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMProbe, ssCache *scrapesSecretsCache) error {
+	ps := &cr.Spec.VMProberSpec
+	if ps.URL == "" && ps.Selector == nil {
+		return &proberConfigError{msg: "either vmProberSpec.url or vmProberSpec.selector must be set"}
+	}
+	if ps.ConfigMap != nil {
+		if err := validateProbeModule(ctx, rclient, cr, ssCache); err != nil {
+			return err
+		}
+	}
+	if ps.Selector == nil {
+		return nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(ps.Selector)
+	if err != nil {
+		return &proberConfigError{msg: fmt.Sprintf("cannot parse vmProberSpec.selector: %s", err)}
+	}
+	var svcs corev1.ServiceList
+	if err := rclient.List(ctx, &svcs, &client.ListOptions{Namespace: cr.Namespace, LabelSelector: selector}); err != nil {
+		return fmt.Errorf("cannot list prober services for probe=%s/%s: %w", cr.Namespace, cr.Name, err)
+	}
+	var probers []string
+	for i := range svcs.Items {
+		svc := &svcs.Items[i]
+		port := findProberServicePort(svc, ps.Port)
+		if port == 0 {
+			continue
+		}
+		probers = append(probers, fmt.Sprintf("%s.%s.svc:%d", svc.Name, svc.Namespace, port))
+	}
+	if len(probers) == 0 {
+		return &proberConfigError{msg: fmt.Sprintf("vmProberSpec.selector doesn't match any service with port=%q", ps.Port)}
+	}
+	sort.Strings(probers)
+	ssCache.probers[cr.AsMapKey()] = probers
+	return nil
+}
+
+// findProberServicePort returns service port matching given name or number
+// or the first service port if name is empty
+func findProberServicePort(svc *corev1.Service, name string) int32 {
+	if len(svc.Spec.Ports) == 0 {
+		return 0
+	}
+	if name == "" {
+		return svc.Spec.Ports[0].Port
+	}
+	for _, p := range svc.Spec.Ports {
+		if p.Name == name || strconv.Itoa(int(p.Port)) == name {
+			return p.Port
+		}
+	}
+	return 0
+}
+
+// validateProbeModule checks if probe module is defined at prober configuration
+func validateProbeModule(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMProbe, ssCache *scrapesSecretsCache) error {
+	sel := cr.Spec.VMProberSpec.ConfigMap
+	cacheKey := fmt.Sprintf("%s/%s", cr.Namespace, sel.Name)
+	cm, ok := ssCache.nsCMCache[cacheKey]
+	if !ok {
+		cm = &corev1.ConfigMap{}
+		if err := rclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: sel.Name}, cm); err != nil {
+			if k8serrors.IsNotFound(err) {
+				return err
+			}
+			return fmt.Errorf("cannot get prober configmap=%s/%s: %w", cr.Namespace, sel.Name, err)
+		}
+		ssCache.nsCMCache[cacheKey] = cm
+	}
+	data, ok := cm.Data[sel.Key]
+	if !ok {
+		return &proberConfigError{msg: fmt.Sprintf("key=%q is missing at prober configmap=%s", sel.Key, sel.Name)}
+	}
+	var proberCfg struct {
+		Modules map[string]any `yaml:"modules"`
+	}
+	if err := yaml.Unmarshal([]byte(data), &proberCfg); err != nil {
+		return &proberConfigError{msg: fmt.Sprintf("cannot parse prober configuration from configmap=%s key=%q: %s", sel.Name, sel.Key, err)}
+	}
+	module := cr.Spec.Module
+	if module == "" {
+		return nil
+	}
+	if _, ok := proberCfg.Modules[module]; !ok {
+		return &proberConfigError{msg: fmt.Sprintf("module=%q is not defined at prober configmap=%s key=%q", module, sel.Name, sel.Key)}
+	}
+	return nil
+}
+
+// generateProberShardRelabelings distributes probe targets across given probers.
+// Each target is assigned to the hash slot, which is owned by a single prober
+func generateProberShardRelabelings(probers []string) []yaml.MapSlice {
+	if len(probers) == 1 {
+		return []yaml.MapSlice{{
+			{Key: "target_label", Value: "__address__"},
+			{Key: "replacement", Value: probers[0]},
+		}}
+	}
+	relabelings := []yaml.MapSlice{{
+		{Key: "source_labels", Value: []string{"__param_target"}},
+		{Key: "modulus", Value: proberHashSlots},
+		{Key: "target_label", Value: "__tmp_prober_slot"},
+		{Key: "action", Value: "hashmod"},
+	}}
+	slots := make([][]string, len(probers))
+	for slot := 0; slot < proberHashSlots; slot++ {
+		var owner int
+		var maxWeight uint64
+		for i, p := range probers {
+			if w := xxhash.Sum64String(p + "/" + strconv.Itoa(slot)); w > maxWeight {
+				owner, maxWeight = i, w
+			}
+		}
+		slots[owner] = append(slots[owner], strconv.Itoa(slot))
+	}
+	for i, p := range probers {
+		if len(slots[i]) == 0 {
+			continue
+		}
+		relabelings = append(relabelings, yaml.MapSlice{
+			{Key: "source_labels", Value: []string{"__tmp_prober_slot"}},
+			{Key: "regex", Value: strings.Join(slots[i], "|")},
+			{Key: "target_label", Value: "__address__"},
+			{Key: "replacement", Value: p},
+		})
+	}
+	return relabelings
+}
@@ -2,6 +2,7 @@ package vmagent
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
@@ -10,6 +11,7 @@ import (
 	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 )
 
@@ -145,6 +147,55 @@ relabel_configs:
 `,
 		},
 
+		{
+			name: "with prober scheme and path",
+			args: args{
+				ssCache: &scrapesSecretsCache{},
+				cr: &vmv1beta1.VMProbe{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "static-probe",
+					},
+					Spec: vmv1beta1.VMProbeSpec{
+						JobName: "blackbox",
+						Module:  "http_2xx",
+						VMProberSpec: vmv1beta1.VMProberSpec{
+							URL:    "blackbox-monitor:9115",
+							Scheme: "https",
+							Path:   "/blackbox/probe",
+						},
+						Targets: vmv1beta1.VMProbeTargets{
+							StaticConfig: &vmv1beta1.VMProbeTargetStaticConfig{
+								Targets: []string{"host-1"},
+							},
+						},
+					},
+				},
+				i: 0,
+			},
+			want: `job_name: probe/default/static-probe/0
+honor_labels: false
+metrics_path: /blackbox/probe
+params:
+  module:
+  - http_2xx
+scheme: https
+static_configs:
+- targets:
+  - host-1
+relabel_configs:
+- source_labels:
+  - __address__
+  target_label: __param_target
+- target_label: job
+  replacement: blackbox
+- source_labels:
+  - __param_target
+  target_label: instance
+- target_label: __address__
+  replacement: blackbox-monitor:9115
+`,
+		},
 		{
 			name: "generate with vm params",
 			args: args{
@@ -238,6 +289,52 @@ proxy_tls_config:
 bearer_token_file: /tmp/some_path
 basic_auth:
   password_file: /tmp/some-file-ba
+`,
+		},
+		{
+			name: "with selected prober",
+			args: args{
+				ssCache: &scrapesSecretsCache{
+					probers: map[string][]string{
+						"probeScrape/default/selected-probe": {"blackbox.default.svc:9115"},
+					},
+				},
+				cr: &vmv1beta1.VMProbe{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "selected-probe",
+					},
+					Spec: vmv1beta1.VMProbeSpec{
+						Module: "http",
+						VMProberSpec: vmv1beta1.VMProberSpec{Selector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"app": "blackbox"},
+						}},
+						Targets: vmv1beta1.VMProbeTargets{
+							StaticConfig: &vmv1beta1.VMProbeTargetStaticConfig{
+								Targets: []string{"host-1"},
+							},
+						},
+					},
+				},
+			},
+			want: `job_name: probe/default/selected-probe/0
+honor_labels: false
+metrics_path: /probe
+params:
+  module:
+  - http
+static_configs:
+- targets:
+  - host-1
+relabel_configs:
+- source_labels:
+  - __address__
+  target_label: __param_target
+- source_labels:
+  - __param_target
+  target_label: instance
+- target_label: __address__
+  replacement: blackbox.default.svc:9115
 `,
 		},
 	}
@@ -253,3 +350,108 @@ basic_auth:
 		})
 	}
 }
+
+func Test_generateProberShardRelabelings(t *testing.T) {
+	slotOwners := func(probers []string) map[string]string {
+		t.Helper()
+		rcs := generateProberShardRelabelings(probers)
+		assert.Len(t, rcs, len(probers)+1)
+		owners := make(map[string]string)
+		for _, rc := range rcs[1:] {
+			var regex, replacement string
+			for _, item := range rc {
+				switch item.Key {
+				case "regex":
+					regex = item.Value.(string)
+				case "replacement":
+					replacement = item.Value.(string)
+				}
+			}
+			for _, slot := range strings.Split(regex, "|") {
+				_, ok := owners[slot]
+				assert.False(t, ok, "slot=%s must have a single owner", slot)
+				owners[slot] = replacement
+			}
+		}
+		assert.Len(t, owners, proberHashSlots)
+		return owners
+	}
+
+	before := slotOwners([]string{"bb-0.default.svc:9115", "bb-1.default.svc:9115", "bb-2.default.svc:9115"})
+	after := slotOwners([]string{"bb-0.default.svc:9115", "bb-1.default.svc:9115", "bb-2.default.svc:9115", "bb-3.default.svc:9115"})
+	// only slots moved to the new prober must change owner
+	for slot, owner := range after {
+		if owner != "bb-3.default.svc:9115" {
+			assert.Equal(t, before[slot], owner)
+		}
+	}
+}
+
+func Test_loadProberConfig(t *testing.T) {
+	f := func(cr *vmv1beta1.VMProbe, predefinedObjects []runtime.Object, wantProbers []string, wantErr string) {
+		t.Helper()
+		fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		ssCache := &scrapesSecretsCache{
+			nsCMCache: map[string]*corev1.ConfigMap{},
+			probers:   map[string][]string{},
+		}
+		err := loadProberConfig(context.Background(), fclient, cr, ssCache)
+		if wantErr != "" {
+			assert.ErrorContains(t, err, wantErr)
+			return
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, wantProbers, ssCache.probers[cr.AsMapKey()])
+	}
+	newProbe := func(ps vmv1beta1.VMProberSpec) *vmv1beta1.VMProbe {
+		return &vmv1beta1.VMProbe{
+			ObjectMeta: metav1.ObjectMeta{Name: "probe", Namespace: "default"},
+			Spec:       vmv1beta1.VMProbeSpec{Module: "http_2xx", VMProberSpec: ps},
+		}
+	}
+	newService := func(name string, ports ...corev1.ServicePort) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"app": "blackbox"}},
+			Spec:       corev1.ServiceSpec{Ports: ports},
+		}
+	}
+	proberCM := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "blackbox", Namespace: "default"},
+		Data: map[string]string{"config.yml": `
+modules:
+  http_2xx:
+    prober: http
+`},
+	}
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "blackbox"}}
+
+	// static url
+	f(newProbe(vmv1beta1.VMProberSpec{URL: "blackbox:9115"}), nil, nil, "")
+
+	// missing url and selector
+	f(newProbe(vmv1beta1.VMProberSpec{}), nil, nil, "either vmProberSpec.url or vmProberSpec.selector must be set")
+
+	// selected services with named port
+	f(newProbe(vmv1beta1.VMProberSpec{Selector: selector, Port: "http"}), []runtime.Object{
+		newService("blackbox-b", corev1.ServicePort{Name: "metrics", Port: 8080}, corev1.ServicePort{Name: "http", Port: 9115}),
+		newService("blackbox-a", corev1.ServicePort{Name: "http", Port: 9115}),
+		newService("blackbox-c", corev1.ServicePort{Name: "metrics", Port: 8080}),
+	}, []string{"blackbox-a.default.svc:9115", "blackbox-b.default.svc:9115"}, "")
+
+	// no matched services
+	f(newProbe(vmv1beta1.VMProberSpec{Selector: selector}), nil, nil, "doesn't match any service")
+
+	// module defined at prober configmap
+	f(newProbe(vmv1beta1.VMProberSpec{URL: "blackbox:9115", ConfigMap: &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "blackbox"},
+		Key:                  "config.yml",
+	}}), []runtime.Object{proberCM}, nil, "")
+
+	// module missing at prober configmap
+	cr := newProbe(vmv1beta1.VMProberSpec{URL: "blackbox:9115", ConfigMap: &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "blackbox"},
+		Key:                  "config.yml",
+	}})
+	cr.Spec.Module = "tcp_connect"
+	f(cr, []runtime.Object{proberCM}, nil, `module="tcp_connect" is not defined`)
+}
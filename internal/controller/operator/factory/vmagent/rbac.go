@@ -140,6 +140,7 @@ func createVMAgentK8sAPIAccess(ctx context.Context, cr *vmv1beta1.VMAgent, rclie
 
 func ensureVMAgentCRExist(ctx context.Context, cr *vmv1beta1.VMAgent, rclient client.Client) error {
 	clusterRole := buildVMAgentClusterRole(cr)
+	reconcile.TrackChild(ctx, "ClusterRole", clusterRole, clusterRole.Rules)
 	var existsClusterRole rbacv1.ClusterRole
 
 	if err := rclient.Get(ctx, types.NamespacedName{Name: clusterRole.Name, Namespace: cr.Namespace}, &existsClusterRole); err != nil {
@@ -164,12 +165,16 @@ func ensureVMAgentCRExist(ctx context.Context, cr *vmv1beta1.VMAgent, rclient cl
 	existsClusterRole.Labels = clusterRole.Labels
 	existsClusterRole.Annotations = labels.Merge(existsClusterRole.Annotations, clusterRole.Annotations)
 	existsClusterRole.Rules = clusterRole.Rules
-	vmv1beta1.AddFinalizer(&existsClusterRole, &existsClusterRole)
+	finalize.MergeFinalizers(&existsClusterRole, &existsClusterRole)
 	return rclient.Update(ctx, &existsClusterRole)
 }
 
 func ensureVMAgentCRBExist(ctx context.Context, cr *vmv1beta1.VMAgent, rclient client.Client) error {
 	clusterRoleBinding := buildVMAgentClusterRoleBinding(cr)
+	reconcile.TrackChild(ctx, "ClusterRoleBinding", clusterRoleBinding, struct {
+		RoleRef  rbacv1.RoleRef
+		Subjects []rbacv1.Subject
+	}{clusterRoleBinding.RoleRef, clusterRoleBinding.Subjects})
 	var existsClusterRoleBinding rbacv1.ClusterRoleBinding
 
 	if err := rclient.Get(ctx, types.NamespacedName{Name: clusterRoleBinding.Name, Namespace: cr.Namespace}, &existsClusterRoleBinding); err != nil {
@@ -197,7 +202,7 @@ func ensureVMAgentCRBExist(ctx context.Context, cr *vmv1beta1.VMAgent, rclient c
 	existsClusterRoleBinding.Annotations = labels.Merge(existsClusterRoleBinding.Annotations, clusterRoleBinding.Annotations)
 	existsClusterRoleBinding.Subjects = clusterRoleBinding.Subjects
 	existsClusterRoleBinding.RoleRef = clusterRoleBinding.RoleRef
-	vmv1beta1.AddFinalizer(&existsClusterRoleBinding, &existsClusterRoleBinding)
+	finalize.MergeFinalizers(&existsClusterRoleBinding, &existsClusterRoleBinding)
 	return rclient.Update(ctx, &existsClusterRoleBinding)
 }
 
@@ -208,7 +213,7 @@ func buildVMAgentClusterRoleBinding(cr *vmv1beta1.VMAgent) *rbacv1.ClusterRoleBi
 			Namespace:       cr.GetNamespace(),
 			Labels:          cr.AllLabels(),
 			Annotations:     cr.AnnotationsFiltered(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 			OwnerReferences: cr.AsCRDOwner(),
 		},
 		Subjects: []rbacv1.Subject{
@@ -233,7 +238,7 @@ func buildVMAgentClusterRole(cr *vmv1beta1.VMAgent) *rbacv1.ClusterRole {
 			Namespace:       cr.GetNamespace(),
 			Labels:          cr.AllLabels(),
 			Annotations:     cr.AnnotationsFiltered(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 			OwnerReferences: cr.AsCRDOwner(),
 		},
 		Rules: clusterWidePolicyRules,
@@ -257,7 +262,7 @@ func buildVMAgentNamespaceRole(cr *vmv1beta1.VMAgent) *rbacv1.Role {
 			Namespace:       cr.GetNamespace(),
 			Labels:          cr.AllLabels(),
 			Annotations:     cr.AnnotationsFiltered(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 			OwnerReferences: cr.AsCRDOwner(),
 		},
 		Rules: singleNSPolicyRules,
@@ -271,7 +276,7 @@ func buildVMAgentNamespaceRoleBinding(cr *vmv1beta1.VMAgent) *rbacv1.RoleBinding
 			Namespace:       cr.GetNamespace(),
 			Labels:          cr.AllLabels(),
 			Annotations:     cr.AnnotationsFiltered(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 			OwnerReferences: cr.AsCRDOwner(),
 		},
 		Subjects: []rbacv1.Subject{
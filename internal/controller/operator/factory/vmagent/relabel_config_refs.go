@@ -0,0 +1,194 @@
+package vmagent
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+)
+
+// +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmrelabelconfigs,verbs=get;list;watch
+
+// resolveRelabelConfigRefs prepends relabeling rules of referenced VMRelabelConfig objects to endpoint relabelings of scrape objects
+// objects with references to missing VMRelabelConfig are excluded from configuration
+func resolveRelabelConfigRefs(ctx context.Context, rclient client.Client, sos *scrapeObjects) error {
+	if !sos.hasRelabelConfigRefs() {
+		return nil
+	}
+	var rcs map[string]*vmv1beta1.VMRelabelConfig
+	// VMRelabelConfig is cluster-scoped object, so references cannot be resolved with namespaced permissions
+	if config.IsClusterWideAccessAllowed() {
+		var l vmv1beta1.VMRelabelConfigList
+		if err := rclient.List(ctx, &l); err != nil {
+			return fmt.Errorf("cannot list VMRelabelConfigs: %w", err)
+		}
+		rcs = make(map[string]*vmv1beta1.VMRelabelConfig, len(l.Items))
+		for i := range l.Items {
+			rcs[l.Items[i].Name] = &l.Items[i]
+		}
+	}
+
+	var tempBo []scrapeObjectWithStatus
+	sos.sss, tempBo = forEachCollectUnresolvedRelabelConfigs(rcs, sos.sss, func(ss *vmv1beta1.VMServiceScrape) []*vmv1beta1.EndpointRelabelings {
+		ers := make([]*vmv1beta1.EndpointRelabelings, 0, len(ss.Spec.Endpoints))
+		for i := range ss.Spec.Endpoints {
+			ers = append(ers, &ss.Spec.Endpoints[i].EndpointRelabelings)
+		}
+		return ers
+	})
+	sos.badObjects = append(sos.badObjects, tempBo...)
+	sos.pss, tempBo = forEachCollectUnresolvedRelabelConfigs(rcs, sos.pss, func(ps *vmv1beta1.VMPodScrape) []*vmv1beta1.EndpointRelabelings {
+		ers := make([]*vmv1beta1.EndpointRelabelings, 0, len(ps.Spec.PodMetricsEndpoints))
+		for i := range ps.Spec.PodMetricsEndpoints {
+			ers = append(ers, &ps.Spec.PodMetricsEndpoints[i].EndpointRelabelings)
+		}
+		return ers
+	})
+	sos.badObjects = append(sos.badObjects, tempBo...)
+	sos.stss, tempBo = forEachCollectUnresolvedRelabelConfigs(rcs, sos.stss, func(sts *vmv1beta1.VMStaticScrape) []*vmv1beta1.EndpointRelabelings {
+		ers := make([]*vmv1beta1.EndpointRelabelings, 0, len(sts.Spec.TargetEndpoints))
+		for _, te := range sts.Spec.TargetEndpoints {
+			ers = append(ers, &te.EndpointRelabelings)
+		}
+		return ers
+	})
+	sos.badObjects = append(sos.badObjects, tempBo...)
+	sos.nss, tempBo = forEachCollectUnresolvedRelabelConfigs(rcs, sos.nss, func(ns *vmv1beta1.VMNodeScrape) []*vmv1beta1.EndpointRelabelings {
+		return []*vmv1beta1.EndpointRelabelings{&ns.Spec.EndpointRelabelings}
+	})
+	sos.badObjects = append(sos.badObjects, tempBo...)
+	sos.scss, tempBo = forEachCollectUnresolvedRelabelConfigs(rcs, sos.scss, func(sc *vmv1beta1.VMScrapeConfig) []*vmv1beta1.EndpointRelabelings {
+		return []*vmv1beta1.EndpointRelabelings{&sc.Spec.EndpointRelabelings}
+	})
+	sos.badObjects = append(sos.badObjects, tempBo...)
+	return nil
+}
+
+// hasRelabelConfigRefs checks if any of scrape objects references VMRelabelConfig
+func (sos *scrapeObjects) hasRelabelConfigRefs() bool {
+	for _, ss := range sos.sss {
+		for i := range ss.Spec.Endpoints {
+			if ss.Spec.Endpoints[i].HasRelabelConfigRefs() {
+				return true
+			}
+		}
+	}
+	for _, ps := range sos.pss {
+		for i := range ps.Spec.PodMetricsEndpoints {
+			if ps.Spec.PodMetricsEndpoints[i].HasRelabelConfigRefs() {
+				return true
+			}
+		}
+	}
+	for _, sts := range sos.stss {
+		for _, te := range sts.Spec.TargetEndpoints {
+			if te.HasRelabelConfigRefs() {
+				return true
+			}
+		}
+	}
+	for _, ns := range sos.nss {
+		if ns.Spec.HasRelabelConfigRefs() {
+			return true
+		}
+	}
+	for _, sc := range sos.scss {
+		if sc.Spec.HasRelabelConfigRefs() {
+			return true
+		}
+	}
+	return false
+}
+
+// returned objects with unresolved references have erased type
+func forEachCollectUnresolvedRelabelConfigs[T scrapeObjectWithStatus](rcs map[string]*vmv1beta1.VMRelabelConfig, src []T, endpointRelabelings func(s T) []*vmv1beta1.EndpointRelabelings) ([]T, []scrapeObjectWithStatus) {
+	var cnt int
+	var unresolved []scrapeObjectWithStatus
+OUTER:
+	for _, o := range src {
+		for _, er := range endpointRelabelings(o) {
+			if err := applyRelabelConfigRefs(rcs, er); err != nil {
+				st := o.GetStatus()
+				st.CurrentSyncError = fmt.Sprintf("cannot resolve relabel config references: %s", err)
+				unresolved = append(unresolved, o)
+				continue OUTER
+			}
+		}
+		src[cnt] = o
+		cnt++
+	}
+	return src[:cnt], unresolved
+}
+
+// applyRelabelConfigRefs prepends relabeling rules of referenced VMRelabelConfig objects to the given endpoint relabelings
+func applyRelabelConfigRefs(rcs map[string]*vmv1beta1.VMRelabelConfig, er *vmv1beta1.EndpointRelabelings) error {
+	if !er.HasRelabelConfigRefs() {
+		return nil
+	}
+	relabelConfigs, err := referencedRelabelConfigs(rcs, er.RelabelConfigRefs)
+	if err != nil {
+		return fmt.Errorf("bad relabelConfigRefs: %w", err)
+	}
+	metricRelabelConfigs, err := referencedRelabelConfigs(rcs, er.MetricRelabelConfigRefs)
+	if err != nil {
+		return fmt.Errorf("bad metricRelabelConfigRefs: %w", err)
+	}
+	er.RelabelConfigs = prependRelabelConfigs(relabelConfigs, er.RelabelConfigs)
+	er.MetricRelabelConfigs = prependRelabelConfigs(metricRelabelConfigs, er.MetricRelabelConfigs)
+	return nil
+}
+
+// referencedRelabelConfigs returns relabeling rules of the given VMRelabelConfig objects in order of references
+func referencedRelabelConfigs(rcs map[string]*vmv1beta1.VMRelabelConfig, refs []string) ([]*vmv1beta1.RelabelConfig, error) {
+	if len(refs) > 0 && rcs == nil {
+		return nil, fmt.Errorf("VMRelabelConfig is cluster-scoped object and requires cluster-wide access of operator")
+	}
+	var dst []*vmv1beta1.RelabelConfig
+	for _, name := range refs {
+		rc, ok := rcs[name]
+		if !ok {
+			return nil, fmt.Errorf("VMRelabelConfig=%q doesn't exist", name)
+		}
+		if rc.Spec.ParsingError != "" {
+			return nil, fmt.Errorf("cannot use VMRelabelConfig=%q: %s", name, rc.Spec.ParsingError)
+		}
+		dst = append(dst, rc.RelabelConfigPtrs()...)
+	}
+	return dst, nil
+}
+
+// withRemoteWriteRelabelConfigs returns copy of vmagent with relabeling rules of VMRelabelConfig objects
+// referenced by remoteWrite urlRelabelConfigRefs prepended to inlineUrlRelabelConfig
+func withRemoteWriteRelabelConfigs(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent) (*vmv1beta1.VMAgent, error) {
+	if !cr.HasRelabelConfigRefs() {
+		return cr, nil
+	}
+	if !config.IsClusterWideAccessAllowed() {
+		return nil, fmt.Errorf("remoteWrite.urlRelabelConfigRefs requires cluster-wide access of operator, VMRelabelConfig is cluster-scoped object")
+	}
+	cr = cr.DeepCopy()
+	for i := range cr.Spec.RemoteWrite {
+		rw := &cr.Spec.RemoteWrite[i]
+		if len(rw.UrlRelabelConfigRefs) == 0 {
+			continue
+		}
+		var rcs []vmv1beta1.RelabelConfig
+		for _, name := range rw.UrlRelabelConfigRefs {
+			var rc vmv1beta1.VMRelabelConfig
+			if err := rclient.Get(ctx, types.NamespacedName{Name: name}, &rc); err != nil {
+				return nil, fmt.Errorf("cannot get VMRelabelConfig=%q for remoteWrite at idx=%d: %w", name, i, err)
+			}
+			if rc.Spec.ParsingError != "" {
+				return nil, fmt.Errorf("cannot use VMRelabelConfig=%q for remoteWrite at idx=%d: %s", name, i, rc.Spec.ParsingError)
+			}
+			rcs = append(rcs, rc.Spec.RelabelConfigs...)
+		}
+		rw.InlineUrlRelabelConfig = append(rcs, rw.InlineUrlRelabelConfig...)
+		rw.UrlRelabelConfigRefs = nil
+	}
+	return cr, nil
+}
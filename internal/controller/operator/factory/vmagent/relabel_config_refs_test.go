@@ -0,0 +1,123 @@
+package vmagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestResolveRelabelConfigRefs(t *testing.T) {
+	predefinedObjects := []runtime.Object{
+		&vmv1beta1.VMRelabelConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "drop-heavy-metrics"},
+			Spec: vmv1beta1.VMRelabelConfigSpec{RelabelConfigs: []vmv1beta1.RelabelConfig{
+				{Action: "drop", SourceLabels: []string{"__name__"}, Regex: vmv1beta1.StringOrArray{"apiserver_request_duration_seconds_bucket"}},
+			}},
+		},
+		&vmv1beta1.VMRelabelConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "normalize-labels"},
+			Spec: vmv1beta1.VMRelabelConfigSpec{RelabelConfigs: []vmv1beta1.RelabelConfig{
+				{Action: "labelmap", Regex: vmv1beta1.StringOrArray{"__meta_kubernetes_pod_label_(.+)"}},
+			}},
+		},
+	}
+	sos := &scrapeObjects{
+		sss: []*vmv1beta1.VMServiceScrape{{
+			ObjectMeta: metav1.ObjectMeta{Name: "with-refs", Namespace: "default"},
+			Spec: vmv1beta1.VMServiceScrapeSpec{Endpoints: []vmv1beta1.Endpoint{{
+				EndpointRelabelings: vmv1beta1.EndpointRelabelings{
+					RelabelConfigRefs:       []string{"normalize-labels"},
+					MetricRelabelConfigRefs: []string{"drop-heavy-metrics", "normalize-labels"},
+					MetricRelabelConfigs:    []*vmv1beta1.RelabelConfig{{Action: "drop", SourceLabels: []string{"job"}}},
+				},
+			}}},
+		}},
+		pss: []*vmv1beta1.VMPodScrape{{
+			ObjectMeta: metav1.ObjectMeta{Name: "missing-ref", Namespace: "default"},
+			Spec: vmv1beta1.VMPodScrapeSpec{PodMetricsEndpoints: []vmv1beta1.PodMetricsEndpoint{{
+				EndpointRelabelings: vmv1beta1.EndpointRelabelings{RelabelConfigRefs: []string{"missing"}},
+			}}},
+		}},
+		nss: []*vmv1beta1.VMNodeScrape{{
+			ObjectMeta: metav1.ObjectMeta{Name: "without-refs", Namespace: "default"},
+		}},
+	}
+	fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+	if err := resolveRelabelConfigRefs(context.Background(), fclient, sos); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Empty(t, sos.pss)
+	assert.Len(t, sos.nss, 1)
+	if assert.Len(t, sos.sss, 1) {
+		er := sos.sss[0].Spec.Endpoints[0].EndpointRelabelings
+		assert.Equal(t, []*vmv1beta1.RelabelConfig{
+			{Action: "labelmap", Regex: vmv1beta1.StringOrArray{"__meta_kubernetes_pod_label_(.+)"}},
+		}, er.RelabelConfigs)
+		assert.Equal(t, []*vmv1beta1.RelabelConfig{
+			{Action: "drop", SourceLabels: []string{"__name__"}, UnderScoreSourceLabels: []string{"__name__"}, Regex: vmv1beta1.StringOrArray{"apiserver_request_duration_seconds_bucket"}},
+			{Action: "labelmap", Regex: vmv1beta1.StringOrArray{"__meta_kubernetes_pod_label_(.+)"}},
+			{Action: "drop", SourceLabels: []string{"job"}},
+		}, er.MetricRelabelConfigs)
+	}
+	if assert.Len(t, sos.badObjects, 1) {
+		assert.Equal(t, "missing-ref", sos.badObjects[0].GetName())
+		assert.Equal(t, `cannot resolve relabel config references: bad relabelConfigRefs: VMRelabelConfig="missing" doesn't exist`, sos.badObjects[0].GetStatus().CurrentSyncError)
+	}
+}
+
+func TestWithRemoteWriteRelabelConfigs(t *testing.T) {
+	f := func(remoteWrites []vmv1beta1.VMAgentRemoteWriteSpec, predefinedObjects []runtime.Object, want [][]vmv1beta1.RelabelConfig, wantErr bool) {
+		t.Helper()
+		cr := &vmv1beta1.VMAgent{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec:       vmv1beta1.VMAgentSpec{RemoteWrite: remoteWrites},
+		}
+		fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		resolved, err := withRemoteWriteRelabelConfigs(context.Background(), fclient, cr)
+		if (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+		if wantErr {
+			return
+		}
+		var got [][]vmv1beta1.RelabelConfig
+		for _, rw := range resolved.Spec.RemoteWrite {
+			got = append(got, rw.InlineUrlRelabelConfig)
+			assert.Empty(t, rw.UrlRelabelConfigRefs)
+		}
+		assert.Equal(t, want, got)
+		assert.Equal(t, remoteWrites, cr.Spec.RemoteWrite, "origin object must not be changed")
+	}
+
+	// missing relabel config
+	f([]vmv1beta1.VMAgentRemoteWriteSpec{{URL: "http://vminsert:8480", UrlRelabelConfigRefs: []string{"missing"}}}, nil, nil, true)
+
+	// relabel config is prepended to inline relabeling
+	f([]vmv1beta1.VMAgentRemoteWriteSpec{
+		{URL: "http://vmsingle:8428"},
+		{
+			URL:                    "http://vminsert:8480",
+			UrlRelabelConfigRefs:   []string{"drop-heavy-metrics"},
+			InlineUrlRelabelConfig: []vmv1beta1.RelabelConfig{{TargetLabel: "env", Replacement: "prod"}},
+		},
+	}, []runtime.Object{
+		&vmv1beta1.VMRelabelConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "drop-heavy-metrics"},
+			Spec: vmv1beta1.VMRelabelConfigSpec{RelabelConfigs: []vmv1beta1.RelabelConfig{
+				{Action: "drop", SourceLabels: []string{"__name__"}, Regex: vmv1beta1.StringOrArray{"etcd_request_duration_seconds_bucket"}},
+			}},
+		},
+	}, [][]vmv1beta1.RelabelConfig{
+		nil,
+		{
+			{Action: "drop", SourceLabels: []string{"__name__"}, UnderScoreSourceLabels: []string{"__name__"}, Regex: vmv1beta1.StringOrArray{"etcd_request_duration_seconds_bucket"}},
+			{TargetLabel: "env", Replacement: "prod"},
+		},
+	}, false)
+}
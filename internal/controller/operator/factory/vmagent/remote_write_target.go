@@ -0,0 +1,48 @@
+package vmagent
+
+import (
+	"context"
+	"fmt"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmremotewritetargets,verbs=get;list;watch
+
+// withRemoteWriteTargets returns copy of vmagent with remoteWrite targetRef resolved into endpoint settings of VMRemoteWriteTarget
+// VMRemoteWriteTarget is cluster-scoped object, so it cannot be referenced if operator watches only specific namespaces
+func withRemoteWriteTargets(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent) (*vmv1beta1.VMAgent, error) {
+	if !cr.HasRemoteWriteTargetRefs() {
+		return cr, nil
+	}
+	if !config.IsClusterWideAccessAllowed() {
+		return nil, fmt.Errorf("remoteWrite.targetRef requires cluster-wide access of operator, VMRemoteWriteTarget is cluster-scoped object")
+	}
+	cr = cr.DeepCopy()
+	for i := range cr.Spec.RemoteWrite {
+		rw := &cr.Spec.RemoteWrite[i]
+		if rw.TargetRef == "" {
+			continue
+		}
+		var target vmv1beta1.VMRemoteWriteTarget
+		if err := rclient.Get(ctx, types.NamespacedName{Name: rw.TargetRef}, &target); err != nil {
+			return nil, fmt.Errorf("cannot get VMRemoteWriteTarget=%q for remoteWrite at idx=%d: %w", rw.TargetRef, i, err)
+		}
+		if target.Spec.ParsingError != "" {
+			return nil, fmt.Errorf("cannot use VMRemoteWriteTarget=%q for remoteWrite at idx=%d: %s", rw.TargetRef, i, target.Spec.ParsingError)
+		}
+		target.ApplyTo(rw)
+	}
+	return cr, nil
+}
+
+// remoteWriteSecretsNamespace returns namespace of Secrets and ConfigMaps referenced by remoteWrite
+func remoteWriteSecretsNamespace(rw *vmv1beta1.VMAgentRemoteWriteSpec, vmagentNamespace string) string {
+	if rw.SecretsNamespace != "" {
+		return rw.SecretsNamespace
+	}
+	return vmagentNamespace
+}
@@ -0,0 +1,100 @@
+package vmagent
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestBuildRemoteWritesWithTargets(t *testing.T) {
+	f := func(remoteWrites []vmv1beta1.VMAgentRemoteWriteSpec, predefinedObjects []runtime.Object, want []string, wantErr bool) {
+		t.Helper()
+		ctx := context.Background()
+		cr := &vmv1beta1.VMAgent{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec:       vmv1beta1.VMAgentSpec{RemoteWrite: remoteWrites},
+		}
+		fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		resolved, err := withRemoteWriteTargets(ctx, fclient, cr)
+		if (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+		if wantErr {
+			return
+		}
+		ssCache, err := loadScrapeSecrets(ctx, fclient, &scrapeObjects{}, resolved.Namespace, false, resolved.Spec.APIServerConfig, resolved.Spec.RemoteWrite)
+		if err != nil {
+			t.Fatalf("cannot load scrape secrets: %s", err)
+		}
+		got := buildRemoteWrites(resolved, ssCache)
+		sort.Strings(got)
+		assert.Equal(t, want, got)
+		if cr.Spec.RemoteWrite[0].URL != remoteWrites[0].URL {
+			t.Fatalf("origin object must not be changed, got remoteWrite: %v", cr.Spec.RemoteWrite)
+		}
+	}
+
+	// missing target
+	f([]vmv1beta1.VMAgentRemoteWriteSpec{{TargetRef: "missing"}}, nil, nil, true)
+
+	// target with settings override
+	f([]vmv1beta1.VMAgentRemoteWriteSpec{
+		{URL: "http://local:8428/api/v1/write"},
+		{TargetRef: "region-b", SendTimeout: ptr.To("30s")},
+	}, []runtime.Object{
+		&vmv1beta1.VMRemoteWriteTarget{
+			ObjectMeta: metav1.ObjectMeta{Name: "region-b"},
+			Spec: vmv1beta1.VMRemoteWriteTargetSpec{
+				URL:         "https://region-b:8480/insert/0/prometheus/api/v1/write",
+				SendTimeout: ptr.To("10s"),
+				RateLimit:   ptr.To[int64](1000),
+			},
+		},
+	}, []string{
+		"-remoteWrite.rateLimit=0,1000",
+		"-remoteWrite.sendTimeout=,30s",
+		"-remoteWrite.url=http://local:8428/api/v1/write,https://region-b:8480/insert/0/prometheus/api/v1/write",
+	}, false)
+
+	// target with secrets at own namespace
+	f([]vmv1beta1.VMAgentRemoteWriteSpec{{TargetRef: "region-c"}}, []runtime.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "rw-token", Namespace: "monitoring"},
+			Data:       map[string][]byte{"token": []byte("secret-token")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "rw-tls", Namespace: "monitoring"},
+			Data:       map[string][]byte{"ca": []byte("ca-data")},
+		},
+		&vmv1beta1.VMRemoteWriteTarget{
+			ObjectMeta: metav1.ObjectMeta{Name: "region-c"},
+			Spec: vmv1beta1.VMRemoteWriteTargetSpec{
+				URL:              "https://region-c:8428/api/v1/write",
+				SecretsNamespace: "monitoring",
+				BearerTokenSecret: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "rw-token"},
+					Key:                  "token",
+				},
+				TLSConfig: &vmv1beta1.TLSConfig{
+					CA: vmv1beta1.SecretOrConfigMap{Secret: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "rw-tls"},
+						Key:                  "ca",
+					}},
+				},
+			},
+		},
+	}, []string{
+		`-remoteWrite.bearerTokenFile="/etc/vmagent/config/RWS_0-SECRET-BEARERTOKEN"`,
+		"-remoteWrite.tlsCAFile=/etc/vmagent-tls/certs/monitoring_rw-tls_ca",
+		"-remoteWrite.url=https://region-c:8428/api/v1/write",
+	}, false)
+}
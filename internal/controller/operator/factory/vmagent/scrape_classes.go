@@ -0,0 +1,89 @@
+package vmagent
+
+import (
+	"fmt"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+// filterScrapeObjectsByScrapeClass excludes scrape objects, which reference scrape class
+// not defined at VMAgent spec.scrapeClasses, from configuration
+func filterScrapeObjectsByScrapeClass(cr *vmv1beta1.VMAgent, sos *scrapeObjects) {
+	var tempBo []scrapeObjectWithStatus
+	sos.sss, tempBo = forEachCollectUnknownScrapeClass(cr, sos.sss, func(ss *vmv1beta1.VMServiceScrape) *string {
+		return ss.Spec.ScrapeClassName
+	})
+	sos.badObjects = append(sos.badObjects, tempBo...)
+	sos.pss, tempBo = forEachCollectUnknownScrapeClass(cr, sos.pss, func(ps *vmv1beta1.VMPodScrape) *string {
+		return ps.Spec.ScrapeClassName
+	})
+	sos.badObjects = append(sos.badObjects, tempBo...)
+}
+
+// returned objects with unknown scrape class have erased type
+func forEachCollectUnknownScrapeClass[T scrapeObjectWithStatus](cr *vmv1beta1.VMAgent, src []T, scrapeClassName func(s T) *string) ([]T, []scrapeObjectWithStatus) {
+	var cnt int
+	var unknown []scrapeObjectWithStatus
+	for _, o := range src {
+		name := scrapeClassName(o)
+		if _, ok := cr.ScrapeClass(name); !ok {
+			st := o.GetStatus()
+			st.CurrentSyncError = fmt.Sprintf("scrapeClass=%q is not defined at VMAgent spec.scrapeClasses", *name)
+			unknown = append(unknown, o)
+			continue
+		}
+		src[cnt] = o
+		cnt++
+	}
+	return src[:cnt], unknown
+}
+
+// applyScrapeClass merges scrape class settings into the given endpoint settings
+// class relabelings are prepended to the endpoint relabelings,
+// tls options and attach metadata are used only if endpoint doesn't define them
+func applyScrapeClass(sc *vmv1beta1.ScrapeClass, er *vmv1beta1.EndpointRelabelings, ea *vmv1beta1.EndpointAuth, am *vmv1beta1.AttachMetadata) {
+	if sc == nil {
+		return
+	}
+	er.RelabelConfigs = prependRelabelConfigs(sc.RelabelConfigs, er.RelabelConfigs)
+	er.MetricRelabelConfigs = prependRelabelConfigs(sc.MetricRelabelConfigs, er.MetricRelabelConfigs)
+	ea.TLSConfig = mergeScrapeClassTLSConfig(sc.TLSConfig, ea.TLSConfig)
+	if am.Node == nil && sc.AttachMetadata != nil {
+		*am = *sc.AttachMetadata
+	}
+}
+
+func prependRelabelConfigs(first, src []*vmv1beta1.RelabelConfig) []*vmv1beta1.RelabelConfig {
+	if len(first) == 0 {
+		return src
+	}
+	dst := make([]*vmv1beta1.RelabelConfig, 0, len(first)+len(src))
+	dst = append(dst, first...)
+	return append(dst, src...)
+}
+
+// mergeScrapeClassTLSConfig returns copy of endpoint tls config with options missing at it taken from scrape class
+func mergeScrapeClassTLSConfig(classTLS, epTLS *vmv1beta1.TLSConfig) *vmv1beta1.TLSConfig {
+	if classTLS == nil {
+		return epTLS
+	}
+	if epTLS == nil {
+		return classTLS.DeepCopy()
+	}
+	merged := epTLS.DeepCopy()
+	if merged.CAFile == "" && merged.CA.PrefixedName() == "" {
+		merged.CAFile = classTLS.CAFile
+	}
+	// client certificate and key must be taken from the same source
+	if merged.CertFile == "" && merged.Cert.PrefixedName() == "" && merged.KeyFile == "" && merged.KeySecret == nil {
+		merged.CertFile = classTLS.CertFile
+		merged.KeyFile = classTLS.KeyFile
+	}
+	if merged.ServerName == "" {
+		merged.ServerName = classTLS.ServerName
+	}
+	if !merged.InsecureSkipVerify {
+		merged.InsecureSkipVerify = classTLS.InsecureSkipVerify
+	}
+	return merged
+}
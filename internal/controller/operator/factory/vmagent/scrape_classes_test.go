@@ -0,0 +1,69 @@
+package vmagent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+func TestFilterScrapeObjectsByScrapeClass(t *testing.T) {
+	cr := &vmv1beta1.VMAgent{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"},
+		Spec: vmv1beta1.VMAgentSpec{
+			ScrapeClasses: []vmv1beta1.ScrapeClass{{Name: "tls"}},
+		},
+	}
+	sos := &scrapeObjects{
+		sss: []*vmv1beta1.VMServiceScrape{
+			{ObjectMeta: metav1.ObjectMeta{Name: "without-class", Namespace: "default"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "known-class", Namespace: "default"}, Spec: vmv1beta1.VMServiceScrapeSpec{ScrapeClassName: ptr.To("tls")}},
+		},
+		pss: []*vmv1beta1.VMPodScrape{
+			{ObjectMeta: metav1.ObjectMeta{Name: "unknown-class", Namespace: "default"}, Spec: vmv1beta1.VMPodScrapeSpec{ScrapeClassName: ptr.To("missing")}},
+		},
+	}
+	filterScrapeObjectsByScrapeClass(cr, sos)
+	assert.Len(t, sos.sss, 2)
+	assert.Empty(t, sos.pss)
+	if assert.Len(t, sos.badObjects, 1) {
+		assert.Equal(t, "unknown-class", sos.badObjects[0].GetName())
+		assert.Equal(t, `scrapeClass="missing" is not defined at VMAgent spec.scrapeClasses`, sos.badObjects[0].GetStatus().CurrentSyncError)
+	}
+}
+
+func TestMergeScrapeClassTLSConfig(t *testing.T) {
+	f := func(classTLS, epTLS, want *vmv1beta1.TLSConfig) {
+		t.Helper()
+		assert.Equal(t, want, mergeScrapeClassTLSConfig(classTLS, epTLS))
+	}
+	classTLS := &vmv1beta1.TLSConfig{CAFile: "/etc/ca.crt", CertFile: "/etc/tls.crt", KeyFile: "/etc/tls.key", ServerName: "example.com"}
+
+	// no class tls
+	f(nil, &vmv1beta1.TLSConfig{CAFile: "/etc/custom-ca.crt"}, &vmv1beta1.TLSConfig{CAFile: "/etc/custom-ca.crt"})
+
+	// no endpoint tls
+	f(classTLS, nil, classTLS)
+
+	// endpoint with ca secret
+	caSecret := vmv1beta1.SecretOrConfigMap{Secret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "ca"}, Key: "ca.crt"}}
+	f(classTLS, &vmv1beta1.TLSConfig{CA: caSecret, InsecureSkipVerify: true}, &vmv1beta1.TLSConfig{
+		CA:                 caSecret,
+		CertFile:           "/etc/tls.crt",
+		KeyFile:            "/etc/tls.key",
+		ServerName:         "example.com",
+		InsecureSkipVerify: true,
+	})
+
+	// endpoint with client certificate
+	f(classTLS, &vmv1beta1.TLSConfig{CertFile: "/etc/custom.crt", KeyFile: "/etc/custom.key", ServerName: "custom"}, &vmv1beta1.TLSConfig{
+		CAFile:     "/etc/ca.crt",
+		CertFile:   "/etc/custom.crt",
+		KeyFile:    "/etc/custom.key",
+		ServerName: "custom",
+	})
+}
@@ -0,0 +1,137 @@
+package vmagent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmscrapeglobalconfigs,verbs=get;list;watch
+
+// selectScrapeGlobalConfig returns VMScrapeGlobalConfig applied to the given VMAgent
+// and names of other matching configs, which are ignored.
+// VMScrapeGlobalConfig is cluster-scoped object, so it's not used if operator watches only specific namespaces
+func selectScrapeGlobalConfig(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent) (*vmv1beta1.VMScrapeGlobalConfig, []string, error) {
+	if !config.IsClusterWideAccessAllowed() {
+		return nil, nil, nil
+	}
+	var l vmv1beta1.VMScrapeGlobalConfigList
+	if err := rclient.List(ctx, &l); err != nil {
+		return nil, nil, fmt.Errorf("cannot list VMScrapeGlobalConfigs: %w", err)
+	}
+	sort.Slice(l.Items, func(i, j int) bool {
+		return l.Items[i].Name < l.Items[j].Name
+	})
+	var selected *vmv1beta1.VMScrapeGlobalConfig
+	var ignored []string
+	for i := range l.Items {
+		item := &l.Items[i]
+		if item.Spec.ParsingError != "" || !item.DeletionTimestamp.IsZero() {
+			continue
+		}
+		match, err := item.MatchesVMAgent(cr)
+		if err != nil {
+			logger.WithContext(ctx).Error(err, "skipping VMScrapeGlobalConfig")
+			continue
+		}
+		if !match {
+			continue
+		}
+		if selected == nil {
+			selected = item
+			continue
+		}
+		ignored = append(ignored, item.Name)
+	}
+	return selected, ignored, nil
+}
+
+// buildScrapeGlobalConfigStatus returns status of VMScrapeGlobalConfig usage.
+// It must be called before defaults are applied to VMAgent spec
+func buildScrapeGlobalConfigStatus(cr *vmv1beta1.VMAgent, gc *vmv1beta1.VMScrapeGlobalConfig, ignored []string) *vmv1beta1.VMAgentScrapeGlobalConfigStatus {
+	if gc == nil {
+		return nil
+	}
+	var overridden []string
+	if gc.Spec.ScrapeInterval != "" && cr.Spec.ScrapeInterval != "" {
+		overridden = append(overridden, "scrapeInterval")
+	}
+	if gc.Spec.ScrapeTimeout != "" && cr.Spec.ScrapeTimeout != "" {
+		overridden = append(overridden, "scrapeTimeout")
+	}
+	var labels []string
+	for k := range gc.Spec.ExternalLabels {
+		if _, ok := cr.Spec.ExternalLabels[k]; ok {
+			labels = append(labels, "externalLabels."+k)
+		}
+	}
+	sort.Strings(labels)
+	overridden = append(overridden, labels...)
+	return &vmv1beta1.VMAgentScrapeGlobalConfigStatus{
+		Name:       gc.Name,
+		Overridden: overridden,
+		Ignored:    ignored,
+	}
+}
+
+// addScrapeGlobalConfigDefaultsTo sets job limits defined at VMScrapeGlobalConfig
+// for generated jobs, which don't have its own value
+func addScrapeGlobalConfigDefaultsTo(scrapeConfigs []yaml.MapSlice, gc *vmv1beta1.VMScrapeGlobalConfig) {
+	if gc == nil {
+		return
+	}
+	var defaults yaml.MapSlice
+	if gc.Spec.SampleLimit > 0 {
+		defaults = append(defaults, yaml.MapItem{Key: "sample_limit", Value: gc.Spec.SampleLimit})
+	}
+	if gc.Spec.SeriesLimit > 0 {
+		defaults = append(defaults, yaml.MapItem{Key: "series_limit", Value: gc.Spec.SeriesLimit})
+	}
+	if gc.Spec.MaxScrapeSize != "" {
+		defaults = append(defaults, yaml.MapItem{Key: "max_scrape_size", Value: gc.Spec.MaxScrapeSize})
+	}
+	if len(defaults) == 0 {
+		return
+	}
+	for i, sc := range scrapeConfigs {
+		for _, d := range defaults {
+			if !hasScrapeConfigKey(sc, d.Key) {
+				sc = append(sc, d)
+			}
+		}
+		scrapeConfigs[i] = sc
+	}
+}
+
+func hasScrapeConfigKey(sc yaml.MapSlice, key interface{}) bool {
+	for _, item := range sc {
+		if item.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+func updateScrapeGlobalConfigStatus(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent, status *vmv1beta1.VMAgentScrapeGlobalConfigStatus) error {
+	if reflect.DeepEqual(cr.Status.ScrapeGlobalConfig, status) {
+		return nil
+	}
+	cr.Status.ScrapeGlobalConfig = status
+	data, err := json.Marshal(map[string]interface{}{"status": map[string]interface{}{"scrapeGlobalConfig": status}})
+	if err != nil {
+		return fmt.Errorf("cannot marshal vmagent status: %w", err)
+	}
+	if err := rclient.Status().Patch(ctx, cr, client.RawPatch(types.MergePatchType, data)); err != nil {
+		return fmt.Errorf("cannot patch status of vmagent=%q: %w", cr.Name, err)
+	}
+	return nil
+}
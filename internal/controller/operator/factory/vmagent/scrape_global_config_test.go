@@ -0,0 +1,162 @@
+package vmagent
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestCreateOrUpdateConfigurationSecretWithScrapeGlobalConfig(t *testing.T) {
+	f := func(cr *vmv1beta1.VMAgent, predefinedObjects []runtime.Object, wantConfig string, wantStatus *vmv1beta1.VMAgentScrapeGlobalConfigStatus) {
+		t.Helper()
+		ctx := context.Background()
+		predefinedObjects = append(predefinedObjects,
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+			&vmv1beta1.VMStaticScrape{
+				ObjectMeta: metav1.ObjectMeta{Name: "static", Namespace: "default"},
+				Spec: vmv1beta1.VMStaticScrapeSpec{
+					TargetEndpoints: []*vmv1beta1.TargetEndpoint{
+						{
+							Targets: []string{"host-1:8429"},
+						},
+						{
+							Targets: []string{"host-2:8429"},
+							EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{
+								MaxScrapeSize: "1MiB",
+							},
+						},
+					},
+				},
+			},
+			cr.DeepCopy(),
+		)
+		fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		if _, err := createOrUpdateConfigurationSecret(ctx, cr, fclient); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		var s corev1.Secret
+		if err := fclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.PrefixedName()}, &s); err != nil {
+			t.Fatalf("cannot get vmagent config secret: %s", err)
+		}
+		gr, err := gzip.NewReader(bytes.NewBuffer(s.Data[vmagentGzippedFilename]))
+		if err != nil {
+			t.Fatalf("cannot create gzip reader: %s", err)
+		}
+		data, err := io.ReadAll(gr)
+		if err != nil {
+			t.Fatalf("cannot read cfg: %s", err)
+		}
+		assert.Equal(t, wantConfig, string(data))
+
+		var got vmv1beta1.VMAgent
+		if err := fclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.Name}, &got); err != nil {
+			t.Fatalf("cannot get vmagent: %s", err)
+		}
+		assert.Equal(t, wantStatus, got.Status.ScrapeGlobalConfig)
+	}
+
+	newVMAgent := func(spec vmv1beta1.VMAgentSpec) *vmv1beta1.VMAgent {
+		spec.StaticScrapeSelector = &metav1.LabelSelector{}
+		spec.StaticScrapeNamespaceSelector = &metav1.LabelSelector{}
+		return &vmv1beta1.VMAgent{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "default",
+				Labels:    map[string]string{"env": "prod"},
+			},
+			Spec: spec,
+		}
+	}
+
+	// no global config
+	f(newVMAgent(vmv1beta1.VMAgentSpec{}), nil, `global:
+  scrape_interval: 30s
+  external_labels:
+    prometheus: default/test
+scrape_configs:
+- job_name: staticScrape/default/static/0
+  static_configs:
+  - targets:
+    - host-1:8429
+  honor_labels: false
+  relabel_configs: []
+- job_name: staticScrape/default/static/1
+  static_configs:
+  - targets:
+    - host-2:8429
+  honor_labels: false
+  max_scrape_size: 1MiB
+  relabel_configs: []
+`, nil)
+
+	// defaults from global config, vmagent spec has priority
+	f(newVMAgent(vmv1beta1.VMAgentSpec{
+		ScrapeTimeout:  "5s",
+		ExternalLabels: map[string]string{"cluster": "agent"},
+	}), []runtime.Object{
+		&vmv1beta1.VMScrapeGlobalConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "a-defaults"},
+			Spec: vmv1beta1.VMScrapeGlobalConfigSpec{
+				ScrapeInterval: "1m",
+				ScrapeTimeout:  "10s",
+				ExternalLabels: map[string]string{"cluster": "global", "region": "eu"},
+				SampleLimit:    1000,
+				MaxScrapeSize:  "16MiB",
+			},
+		},
+		&vmv1beta1.VMScrapeGlobalConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "b-defaults"},
+			Spec: vmv1beta1.VMScrapeGlobalConfigSpec{
+				ScrapeInterval: "2m",
+			},
+		},
+		&vmv1beta1.VMScrapeGlobalConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "0-dev"},
+			Spec: vmv1beta1.VMScrapeGlobalConfigSpec{
+				VMAgentSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}},
+				ScrapeInterval:  "5m",
+			},
+		},
+	}, `global:
+  scrape_interval: 1m
+  external_labels:
+    cluster: agent
+    prometheus: default/test
+    region: eu
+  scrape_timeout: 5s
+scrape_configs:
+- job_name: staticScrape/default/static/0
+  static_configs:
+  - targets:
+    - host-1:8429
+  honor_labels: false
+  scrape_timeout: 5s
+  relabel_configs: []
+  sample_limit: 1000
+  max_scrape_size: 16MiB
+- job_name: staticScrape/default/static/1
+  static_configs:
+  - targets:
+    - host-2:8429
+  honor_labels: false
+  scrape_timeout: 5s
+  max_scrape_size: 1MiB
+  relabel_configs: []
+  sample_limit: 1000
+`, &vmv1beta1.VMAgentScrapeGlobalConfigStatus{
+		Name:       "a-defaults",
+		Overridden: []string{"scrapeTimeout", "externalLabels.cluster"},
+		Ignored:    []string{"b-defaults"},
+	})
+}
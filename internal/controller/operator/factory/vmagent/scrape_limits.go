@@ -0,0 +1,211 @@
+package vmagent
+
+import (
+	"fmt"
+
+	"github.com/VictoriaMetrics/metricsql"
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var scrapeObjectsRejected = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "vm_operator_scrapeobjects_rejected",
+	Help: "Number of scrape objects excluded from VMAgent configuration due to spec.scrapeObjectLimits",
+}, []string{"namespace", "name", "kind"})
+
+var scrapeObjectKinds = []string{"VMServiceScrape", "VMPodScrape", "VMStaticScrape", "VMNodeScrape", "VMProbe", "VMScrapeConfig"}
+
+func init() {
+	metrics.Registry.MustRegister(scrapeObjectsRejected)
+}
+
+// deleteScrapeObjectsRejectedMetric removes rejected scrape objects series of the given VMAgent
+func deleteScrapeObjectsRejectedMetric(nsn types.NamespacedName) {
+	for _, kind := range scrapeObjectKinds {
+		scrapeObjectsRejected.DeleteLabelValues(nsn.Namespace, nsn.Name, kind)
+	}
+}
+
+// updateScrapeObjectsRejectedMetric reports number of scrape objects rejected by limits of the given VMAgent
+// it must be called only from reconcile, since dry run validation must not have side effects
+func updateScrapeObjectsRejectedMetric(cr *vmv1beta1.VMAgent, sos *scrapeObjects) {
+	if sos.rejectedByLimits == nil {
+		deleteScrapeObjectsRejectedMetric(types.NamespacedName{Namespace: cr.Namespace, Name: cr.Name})
+		return
+	}
+	for _, kind := range scrapeObjectKinds {
+		scrapeObjectsRejected.WithLabelValues(cr.Namespace, cr.Name, kind).Set(float64(sos.rejectedByLimits[kind]))
+	}
+}
+
+// filterScrapeObjectsByLimits excludes scrape objects, which exceed VMAgent spec.scrapeObjectLimits, from configuration
+// sampleLimit of endpoints without it is set to maxSeriesPerScrape
+// number of rejected objects per kind is stored at sos.rejectedByLimits
+func filterScrapeObjectsByLimits(cr *vmv1beta1.VMAgent, sos *scrapeObjects) {
+	sol := cr.Spec.ScrapeObjectLimits
+	if sol == nil {
+		return
+	}
+	sos.rejectedByLimits = make(map[string]int, len(scrapeObjectKinds))
+	var tempBo []scrapeObjectWithStatus
+	sos.sss, tempBo = forEachCollectRejected(sol, sos.sss, func(limits *scrapeLimitsValidator, ss *vmv1beta1.VMServiceScrape) error {
+		eps := make([]*vmv1beta1.EndpointScrapeParams, 0, len(ss.Spec.Endpoints))
+		for i := range ss.Spec.Endpoints {
+			eps = append(eps, &ss.Spec.Endpoints[i].EndpointScrapeParams)
+		}
+		return limits.validate(len(ss.Spec.Endpoints), &ss.Spec.SampleLimit, eps)
+	})
+	sos.rejectedByLimits["VMServiceScrape"] = len(tempBo)
+	sos.badObjects = append(sos.badObjects, tempBo...)
+
+	sos.pss, tempBo = forEachCollectRejected(sol, sos.pss, func(limits *scrapeLimitsValidator, ps *vmv1beta1.VMPodScrape) error {
+		eps := make([]*vmv1beta1.EndpointScrapeParams, 0, len(ps.Spec.PodMetricsEndpoints))
+		for i := range ps.Spec.PodMetricsEndpoints {
+			eps = append(eps, &ps.Spec.PodMetricsEndpoints[i].EndpointScrapeParams)
+		}
+		return limits.validate(len(ps.Spec.PodMetricsEndpoints), &ps.Spec.SampleLimit, eps)
+	})
+	sos.rejectedByLimits["VMPodScrape"] = len(tempBo)
+	sos.badObjects = append(sos.badObjects, tempBo...)
+
+	sos.stss, tempBo = forEachCollectRejected(sol, sos.stss, func(limits *scrapeLimitsValidator, sts *vmv1beta1.VMStaticScrape) error {
+		var targets int
+		eps := make([]*vmv1beta1.EndpointScrapeParams, 0, len(sts.Spec.TargetEndpoints))
+		for _, ep := range sts.Spec.TargetEndpoints {
+			targets += len(ep.Targets)
+			eps = append(eps, &ep.EndpointScrapeParams)
+		}
+		return limits.validate(targets, &sts.Spec.SampleLimit, eps)
+	})
+	sos.rejectedByLimits["VMStaticScrape"] = len(tempBo)
+	sos.badObjects = append(sos.badObjects, tempBo...)
+
+	sos.nss, tempBo = forEachCollectRejected(sol, sos.nss, func(limits *scrapeLimitsValidator, ns *vmv1beta1.VMNodeScrape) error {
+		return limits.validate(1, nil, []*vmv1beta1.EndpointScrapeParams{&ns.Spec.EndpointScrapeParams})
+	})
+	sos.rejectedByLimits["VMNodeScrape"] = len(tempBo)
+	sos.badObjects = append(sos.badObjects, tempBo...)
+
+	sos.prss, tempBo = forEachCollectRejected(sol, sos.prss, func(limits *scrapeLimitsValidator, probe *vmv1beta1.VMProbe) error {
+		targets := 1
+		if probe.Spec.Targets.StaticConfig != nil {
+			targets = len(probe.Spec.Targets.StaticConfig.Targets)
+		}
+		return limits.validate(targets, nil, []*vmv1beta1.EndpointScrapeParams{&probe.Spec.EndpointScrapeParams})
+	})
+	sos.rejectedByLimits["VMProbe"] = len(tempBo)
+	sos.badObjects = append(sos.badObjects, tempBo...)
+
+	sos.scss, tempBo = forEachCollectRejected(sol, sos.scss, func(limits *scrapeLimitsValidator, sc *vmv1beta1.VMScrapeConfig) error {
+		return limits.validate(scrapeConfigTargetsCount(sc), nil, []*vmv1beta1.EndpointScrapeParams{&sc.Spec.EndpointScrapeParams})
+	})
+	sos.rejectedByLimits["VMScrapeConfig"] = len(tempBo)
+	sos.badObjects = append(sos.badObjects, tempBo...)
+}
+
+// scrapeConfigTargetsCount returns the number of static targets and service discovery configs of VMScrapeConfig
+func scrapeConfigTargetsCount(sc *vmv1beta1.VMScrapeConfig) int {
+	var cnt int
+	for _, stc := range sc.Spec.StaticConfigs {
+		cnt += len(stc.Targets)
+	}
+	spec := &sc.Spec
+	cnt += len(spec.FileSDConfigs) + len(spec.HTTPSDConfigs) + len(spec.KubernetesSDConfigs) + len(spec.ConsulSDConfigs) +
+		len(spec.DNSSDConfigs) + len(spec.EC2SDConfigs) + len(spec.AzureSDConfigs) + len(spec.GCESDConfigs) +
+		len(spec.OpenStackSDConfigs) + len(spec.DigitalOceanSDConfigs) + len(spec.DockerSDConfigs) + len(spec.NomadSDConfigs) +
+		len(spec.PuppetDBSDConfigs) + len(spec.HetznerSDConfigs)
+	return cnt
+}
+
+type scrapeLimitsValidator struct {
+	limits              vmv1beta1.ScrapeLimits
+	minScrapeIntervalMs int64
+}
+
+// validate checks scrape object with the given number of targets and endpoints against limits
+// specSampleLimit is an optional object level sampleLimit, which is used by endpoints without sampleLimit
+func (slv *scrapeLimitsValidator) validate(targets int, specSampleLimit *uint64, eps []*vmv1beta1.EndpointScrapeParams) error {
+	if slv.limits.MaxTargets != nil && targets > int(*slv.limits.MaxTargets) {
+		return fmt.Errorf("targets count=%d exceeds maxTargets=%d", targets, *slv.limits.MaxTargets)
+	}
+	if maxSeries := slv.limits.MaxSeriesPerScrape; maxSeries != nil {
+		if specSampleLimit != nil && *specSampleLimit > *maxSeries {
+			return fmt.Errorf("sampleLimit=%d exceeds maxSeriesPerScrape=%d", *specSampleLimit, *maxSeries)
+		}
+		for i, ep := range eps {
+			if ep.SampleLimit > *maxSeries {
+				return fmt.Errorf("sampleLimit=%d of endpoint at idx=%d exceeds maxSeriesPerScrape=%d", ep.SampleLimit, i, *maxSeries)
+			}
+		}
+	}
+	if slv.limits.MinScrapeInterval != "" {
+		for i, ep := range eps {
+			interval := ep.ScrapeInterval
+			if interval == "" {
+				interval = ep.Interval
+			}
+			if interval == "" {
+				continue
+			}
+			intervalMs, err := metricsql.DurationValue(interval, 0)
+			if err != nil {
+				return fmt.Errorf("cannot parse scrape interval=%q of endpoint at idx=%d: %w", interval, i, err)
+			}
+			if intervalMs < slv.minScrapeIntervalMs {
+				return fmt.Errorf("scrape interval=%q of endpoint at idx=%d is lower than minScrapeInterval=%q", interval, i, slv.limits.MinScrapeInterval)
+			}
+		}
+	}
+
+	// object passed validation, enforce series limit for endpoints without it
+	if maxSeries := slv.limits.MaxSeriesPerScrape; maxSeries != nil {
+		if specSampleLimit != nil {
+			if *specSampleLimit == 0 {
+				*specSampleLimit = *maxSeries
+			}
+			return nil
+		}
+		for _, ep := range eps {
+			if ep.SampleLimit == 0 {
+				ep.SampleLimit = *maxSeries
+			}
+		}
+	}
+	return nil
+}
+
+// forEachCollectRejected validates scrape objects against limits of its namespace
+// returned rejected objects have erased type
+func forEachCollectRejected[T scrapeObjectWithStatus](sol *vmv1beta1.ScrapeObjectLimits, src []T, validate func(limits *scrapeLimitsValidator, s T) error) ([]T, []scrapeObjectWithStatus) {
+	var cnt int
+	var rejected []scrapeObjectWithStatus
+	for _, o := range src {
+		slv, err := newScrapeLimitsValidator(sol.ForNamespace(o.GetNamespace()))
+		if err == nil {
+			err = validate(slv, o)
+		}
+		if err != nil {
+			st := o.GetStatus()
+			st.CurrentSyncError = fmt.Sprintf("rejected by scrapeObjectLimits: %s", err)
+			rejected = append(rejected, o)
+			continue
+		}
+		src[cnt] = o
+		cnt++
+	}
+	return src[:cnt], rejected
+}
+
+func newScrapeLimitsValidator(limits vmv1beta1.ScrapeLimits) (*scrapeLimitsValidator, error) {
+	slv := &scrapeLimitsValidator{limits: limits}
+	if limits.MinScrapeInterval != "" {
+		ms, err := metricsql.DurationValue(limits.MinScrapeInterval, 0)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse minScrapeInterval=%q: %w", limits.MinScrapeInterval, err)
+		}
+		slv.minScrapeIntervalMs = ms
+	}
+	return slv, nil
+}
@@ -0,0 +1,134 @@
+package vmagent
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+func TestFilterScrapeObjectsByLimits(t *testing.T) {
+	newScrapeObjects := func() *scrapeObjects {
+		return &scrapeObjects{
+			sss: []*vmv1beta1.VMServiceScrape{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "small", Namespace: "default"},
+					Spec: vmv1beta1.VMServiceScrapeSpec{Endpoints: []vmv1beta1.Endpoint{
+						{Port: "http"},
+					}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "many-endpoints", Namespace: "team-a"},
+					Spec: vmv1beta1.VMServiceScrapeSpec{Endpoints: []vmv1beta1.Endpoint{
+						{Port: "http"}, {Port: "metrics"}, {Port: "admin"},
+					}},
+				},
+			},
+			pss: []*vmv1beta1.VMPodScrape{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "fast", Namespace: "team-a"},
+					Spec: vmv1beta1.VMPodScrapeSpec{PodMetricsEndpoints: []vmv1beta1.PodMetricsEndpoint{
+						{Port: "http", EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{Interval: "5s"}},
+					}},
+				},
+			},
+			stss: []*vmv1beta1.VMStaticScrape{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "high-sample-limit", Namespace: "default"},
+					Spec: vmv1beta1.VMStaticScrapeSpec{SampleLimit: 50000, TargetEndpoints: []*vmv1beta1.TargetEndpoint{
+						{Targets: []string{"host:9100"}},
+					}},
+				},
+			},
+			prss: []*vmv1beta1.VMProbe{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "probe", Namespace: "default"},
+					Spec:       vmv1beta1.VMProbeSpec{Targets: vmv1beta1.VMProbeTargets{StaticConfig: &vmv1beta1.VMProbeTargetStaticConfig{Targets: []string{"a", "b"}}}},
+				},
+			},
+		}
+	}
+	f := func(sol *vmv1beta1.ScrapeObjectLimits, wantErrors []string, wantRejected float64, check func(sos *scrapeObjects)) {
+		t.Helper()
+		cr := &vmv1beta1.VMAgent{
+			ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"},
+			Spec: vmv1beta1.VMAgentSpec{
+				VMAgentSecurityEnforcements: vmv1beta1.VMAgentSecurityEnforcements{ScrapeObjectLimits: sol},
+			},
+		}
+		scrapeObjectsRejected.Reset()
+		sos := newScrapeObjects()
+		filterScrapeObjectsByLimits(cr, sos)
+		// filtering is used by dry run validation and must not update metrics
+		assert.Equal(t, 0, testutil.CollectAndCount(scrapeObjectsRejected))
+		updateScrapeObjectsRejectedMetric(cr, sos)
+		var gotErrors []string
+		for _, bo := range sos.badObjects {
+			gotErrors = append(gotErrors, bo.GetStatus().CurrentSyncError)
+		}
+		assert.Equal(t, wantErrors, gotErrors)
+		if sol == nil {
+			assert.Equal(t, 0, testutil.CollectAndCount(scrapeObjectsRejected))
+		} else {
+			var gotRejected float64
+			for _, kind := range scrapeObjectKinds {
+				gotRejected += testutil.ToFloat64(scrapeObjectsRejected.WithLabelValues("default", "agent", kind))
+			}
+			assert.Equal(t, wantRejected, gotRejected)
+		}
+		if check != nil {
+			check(sos)
+		}
+		// series are removed on vmagent delete
+		OnDelete(types.NamespacedName{Namespace: cr.Namespace, Name: cr.Name})
+		assert.Equal(t, 0, testutil.CollectAndCount(scrapeObjectsRejected))
+	}
+
+	// no limits
+	f(nil, nil, 0, func(sos *scrapeObjects) {
+		assert.Len(t, sos.sss, 2)
+		assert.Len(t, sos.pss, 1)
+		assert.Zero(t, sos.sss[0].Spec.SampleLimit)
+	})
+
+	// global limits
+	f(&vmv1beta1.ScrapeObjectLimits{
+		ScrapeLimits: vmv1beta1.ScrapeLimits{
+			MaxTargets:         ptr.To[int32](2),
+			MaxSeriesPerScrape: ptr.To[uint64](10000),
+			MinScrapeInterval:  "10s",
+		},
+	}, []string{
+		"rejected by scrapeObjectLimits: targets count=3 exceeds maxTargets=2",
+		`rejected by scrapeObjectLimits: scrape interval="5s" of endpoint at idx=0 is lower than minScrapeInterval="10s"`,
+		"rejected by scrapeObjectLimits: sampleLimit=50000 exceeds maxSeriesPerScrape=10000",
+	}, 3, func(sos *scrapeObjects) {
+		assert.Len(t, sos.sss, 1)
+		assert.Empty(t, sos.pss)
+		assert.Empty(t, sos.stss)
+		assert.Len(t, sos.prss, 1)
+		assert.Equal(t, uint64(10000), sos.sss[0].Spec.SampleLimit)
+		assert.Equal(t, uint64(10000), sos.prss[0].Spec.SampleLimit)
+	})
+
+	// namespace limits override global
+	f(&vmv1beta1.ScrapeObjectLimits{
+		ScrapeLimits: vmv1beta1.ScrapeLimits{
+			MaxTargets: ptr.To[int32](1),
+		},
+		Namespaces: map[string]vmv1beta1.ScrapeLimits{
+			"team-a": {MaxTargets: ptr.To[int32](5), MinScrapeInterval: "1s"},
+		},
+	}, []string{
+		"rejected by scrapeObjectLimits: targets count=2 exceeds maxTargets=1",
+	}, 1, func(sos *scrapeObjects) {
+		assert.Len(t, sos.sss, 2)
+		assert.Len(t, sos.pss, 1)
+		assert.Empty(t, sos.prss)
+	})
+}
@@ -0,0 +1,107 @@
+package vmagent
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const scrapeSecretsVolumeName = "scrape-secrets"
+
+// secretMountError represents secret reference, which cannot be mounted into vmagent pod
+type secretMountError struct {
+	name             string
+	namespace        string
+	vmagentNamespace string
+}
+
+// Error implements interface
+func (me *secretMountError) Error() string {
+	return fmt.Sprintf("secret=%q at namespace=%q cannot be mounted into vmagent pod at namespace=%q", me.name, me.namespace, me.vmagentNamespace)
+}
+
+// basicAuthFiles contains paths to the mounted basicAuth credentials
+type basicAuthFiles struct {
+	usernameFile string
+	passwordFile string
+}
+
+// mountSecretKey checks that referenced secret key exists and registers it for mounting into vmagent pod
+// it returns path to the file with secret value
+func (ss *scrapesSecretsCache) mountSecretKey(ctx context.Context, rclient client.Client, namespace string, sel *corev1.SecretKeySelector) (string, error) {
+	if namespace != ss.mountSecretsNamespace {
+		return "", &secretMountError{name: sel.Name, namespace: namespace, vmagentNamespace: ss.mountSecretsNamespace}
+	}
+	if _, err := k8stools.GetCredFromSecret(ctx, rclient, namespace, sel, buildCacheKey(namespace, sel.Name), ss.nsSecretCache); err != nil {
+		return "", err
+	}
+	keys, ok := ss.mountedSecrets[sel.Name]
+	if !ok {
+		keys = make(map[string]struct{})
+		ss.mountedSecrets[sel.Name] = keys
+	}
+	keys[sel.Key] = struct{}{}
+	return path.Join(scrapeSecretsDir, sel.Name, sel.Key), nil
+}
+
+func (ss *scrapesSecretsCache) mountBasicAuth(ctx context.Context, rclient client.Client, basicAuth *vmv1beta1.BasicAuth, namespace string) (*basicAuthFiles, error) {
+	var files basicAuthFiles
+	var err error
+	if files.usernameFile, err = ss.mountSecretKey(ctx, rclient, namespace, &basicAuth.Username); err != nil {
+		return nil, err
+	}
+	if basicAuth.Password.Name != "" {
+		if files.passwordFile, err = ss.mountSecretKey(ctx, rclient, namespace, &basicAuth.Password); err != nil {
+			return nil, err
+		}
+	}
+	return &files, nil
+}
+
+// buildScrapeSecretsVolume returns projected volume with secrets, referenced by scrape objects at mount-and-reference mode
+func buildScrapeSecretsVolume(ssCache *scrapesSecretsCache) (corev1.Volume, corev1.VolumeMount, bool) {
+	if ssCache == nil || len(ssCache.mountedSecrets) == 0 {
+		return corev1.Volume{}, corev1.VolumeMount{}, false
+	}
+	names := make([]string, 0, len(ssCache.mountedSecrets))
+	for name := range ssCache.mountedSecrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sources := make([]corev1.VolumeProjection, 0, len(names))
+	for _, name := range names {
+		keys := make([]string, 0, len(ssCache.mountedSecrets[name]))
+		for key := range ssCache.mountedSecrets[name] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		items := make([]corev1.KeyToPath, 0, len(keys))
+		for _, key := range keys {
+			items = append(items, corev1.KeyToPath{Key: key, Path: path.Join(name, key)})
+		}
+		sources = append(sources, corev1.VolumeProjection{
+			Secret: &corev1.SecretProjection{
+				LocalObjectReference: corev1.LocalObjectReference{Name: name},
+				Items:                items,
+			},
+		})
+	}
+	vol := corev1.Volume{
+		Name: scrapeSecretsVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{Sources: sources},
+		},
+	}
+	vm := corev1.VolumeMount{
+		Name:      scrapeSecretsVolumeName,
+		ReadOnly:  true,
+		MountPath: scrapeSecretsDir,
+	}
+	return vol, vm, true
+}
@@ -0,0 +1,98 @@
+package vmagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestLoadScrapeSecretsMountMode(t *testing.T) {
+	newServiceScrape := func(name, namespace string) *vmv1beta1.VMServiceScrape {
+		return &vmv1beta1.VMServiceScrape{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: vmv1beta1.VMServiceScrapeSpec{Endpoints: []vmv1beta1.Endpoint{
+				{
+					Port: "http",
+					EndpointAuth: vmv1beta1.EndpointAuth{BasicAuth: &vmv1beta1.BasicAuth{
+						Username: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "scrape-auth"}, Key: "user"},
+						Password: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "scrape-auth"}, Key: "password"},
+					}},
+				},
+				{
+					Port: "metrics",
+					EndpointAuth: vmv1beta1.EndpointAuth{
+						BearerTokenSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "scrape-token"}, Key: "token"},
+					},
+				},
+			}},
+		}
+	}
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "scrape-auth", Namespace: "monitoring"},
+			Data:       map[string][]byte{"user": []byte("admin"), "password": []byte("secret")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "scrape-token", Namespace: "monitoring"},
+			Data:       map[string][]byte{"token": []byte("token-value")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "scrape-auth", Namespace: "default"},
+			Data:       map[string][]byte{"user": []byte("admin"), "password": []byte("secret")},
+		},
+	})
+	sos := &scrapeObjects{
+		sss: []*vmv1beta1.VMServiceScrape{
+			newServiceScrape("local", "monitoring"),
+			newServiceScrape("remote", "default"),
+		},
+	}
+	ssCache, err := loadScrapeSecrets(context.Background(), fclient, sos, "monitoring", true, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Len(t, sos.sss, 1)
+	assert.Len(t, sos.badObjects, 1)
+	assert.Equal(t, `cannot mount referenced secret: cannot mount basicAuth secret for=serviceScrape/default/remote/0: secret="scrape-auth" at namespace="default" cannot be mounted into vmagent pod at namespace="monitoring"`,
+		sos.badObjects[0].GetStatus().CurrentSyncError)
+	assert.Empty(t, ssCache.baSecrets)
+	assert.Empty(t, ssCache.bearerTokens)
+
+	ss := sos.sss[0]
+	got := addEndpointAuthTo(yaml.MapSlice{}, ss.Spec.Endpoints[0].EndpointAuth, ss.AsMapKey(0), ssCache)
+	got = addEndpointAuthTo(got, ss.Spec.Endpoints[1].EndpointAuth, ss.AsMapKey(1), ssCache)
+	gotYAML, err := yaml.Marshal(got)
+	if err != nil {
+		t.Fatalf("cannot marshal config: %s", err)
+	}
+	assert.Equal(t, `basic_auth:
+  username_file: /etc/vmagent-secrets/scrape-auth/user
+  password_file: /etc/vmagent-secrets/scrape-auth/password
+bearer_token_file: /etc/vmagent-secrets/scrape-token/token
+`, string(gotYAML))
+
+	vol, vm, ok := buildScrapeSecretsVolume(ssCache)
+	assert.True(t, ok)
+	assert.Equal(t, corev1.VolumeMount{Name: scrapeSecretsVolumeName, ReadOnly: true, MountPath: scrapeSecretsDir}, vm)
+	assert.Equal(t, []corev1.VolumeProjection{
+		{Secret: &corev1.SecretProjection{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "scrape-auth"},
+			Items:                []corev1.KeyToPath{{Key: "password", Path: "scrape-auth/password"}, {Key: "user", Path: "scrape-auth/user"}},
+		}},
+		{Secret: &corev1.SecretProjection{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "scrape-token"},
+			Items:                []corev1.KeyToPath{{Key: "token", Path: "scrape-token/token"}},
+		}},
+	}, vol.Projected.Sources)
+
+	_, _, ok = buildScrapeSecretsVolume(&scrapesSecretsCache{})
+	assert.False(t, ok)
+}
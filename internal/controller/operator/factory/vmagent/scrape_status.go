@@ -0,0 +1,275 @@
+package vmagent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+// selectedScrapeObjects indexes scrape objects marked as selected by VMAgent, keyed by VMAgent key and scrapeObjectKey.
+// It allows to prune status.selectedBy without listing all scrape objects at each config build.
+// Index of VMAgent is built from the full list of scrape objects at the first prune after operator start
+var selectedScrapeObjects = struct {
+	mu sync.Mutex
+	m  map[string]map[string]scrapeObjectWithStatus
+}{m: make(map[string]map[string]scrapeObjectWithStatus)}
+
+// RemoveScrapeObjectsSelection removes deleted VMAgent from status.selectedBy of scrape objects
+func RemoveScrapeObjectsSelection(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent) error {
+	vmagentKey := selectedByKey(cr)
+	if err := pruneScrapeObjectsSelectedBy(ctx, rclient, vmagentKey, &scrapeObjects{}); err != nil {
+		return err
+	}
+	dropSelectedScrapeObjects(vmagentKey)
+	return nil
+}
+
+func dropSelectedScrapeObjects(vmagentKey string) {
+	selectedScrapeObjects.mu.Lock()
+	delete(selectedScrapeObjects.m, vmagentKey)
+	selectedScrapeObjects.mu.Unlock()
+}
+
+// newScrapeObjectRef returns empty object of the same kind, name and namespace as the given scrape object
+func newScrapeObjectRef(so scrapeObjectWithStatus) scrapeObjectWithStatus {
+	var ref scrapeObjectWithStatus
+	switch so.(type) {
+	case *vmv1beta1.VMServiceScrape:
+		ref = &vmv1beta1.VMServiceScrape{}
+	case *vmv1beta1.VMPodScrape:
+		ref = &vmv1beta1.VMPodScrape{}
+	case *vmv1beta1.VMStaticScrape:
+		ref = &vmv1beta1.VMStaticScrape{}
+	case *vmv1beta1.VMNodeScrape:
+		ref = &vmv1beta1.VMNodeScrape{}
+	case *vmv1beta1.VMProbe:
+		ref = &vmv1beta1.VMProbe{}
+	case *vmv1beta1.VMScrapeConfig:
+		ref = &vmv1beta1.VMScrapeConfig{}
+	default:
+		panic(fmt.Sprintf("BUG: unexpected scrape object type %T", so))
+	}
+	ref.SetName(so.GetName())
+	ref.SetNamespace(so.GetNamespace())
+	return ref
+}
+
+func selectedByKey(cr *vmv1beta1.VMAgent) string {
+	return cr.Namespace + "/" + cr.Name
+}
+
+// withSelectedBy returns sorted copy of selectedBy with added or removed VMAgent
+func withSelectedBy(selectedBy []string, vmagentKey string, selected bool) []string {
+	var dst []string
+	for _, key := range selectedBy {
+		if key != vmagentKey {
+			dst = append(dst, key)
+		}
+	}
+	if selected {
+		dst = append(dst, vmagentKey)
+	}
+	sort.Strings(dst)
+	return dst
+}
+
+// withTargets returns copy of targets sorted by VMAgent with replaced or removed targets count of VMAgent
+func withTargets(targets []vmv1beta1.ScrapeObjectTargets, vmagentKey string, count *vmv1beta1.ScrapeObjectTargets) []vmv1beta1.ScrapeObjectTargets {
+	var dst []vmv1beta1.ScrapeObjectTargets
+	for _, t := range targets {
+		if t.VMAgent != vmagentKey {
+			dst = append(dst, t)
+		}
+	}
+	if count != nil {
+		dst = append(dst, *count)
+	}
+	sort.Slice(dst, func(i, j int) bool { return dst[i].VMAgent < dst[j].VMAgent })
+	return dst
+}
+
+// patchScrapeObjectStatus sets status of scrape object and adds or removes VMAgent from status.selectedBy.
+// Targets count of VMAgent at status.targets is replaced with the given one, it's removed if targets is nil or object isn't selected.
+// Empty desiredStatus keeps current status and lastSyncError.
+// The same object could be selected by multiple VMAgents, so patch is conditional on resourceVersion
+func patchScrapeObjectStatus(ctx context.Context, rclient client.Client, so scrapeObjectWithStatus, desiredStatus vmv1beta1.UpdateStatus, syncError, vmagentKey string, selected bool, targets *vmv1beta1.ScrapeObjectTargets) error {
+	if !selected {
+		targets = nil
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cs := so.GetStatus()
+		status, lastSyncError := desiredStatus, syncError
+		if status == "" {
+			status, lastSyncError = cs.Status, cs.LastSyncError
+		}
+		selectedBy := withSelectedBy(cs.SelectedBy, vmagentKey, selected)
+		targetsCount := withTargets(cs.Targets, vmagentKey, targets)
+		if cs.Status == status && cs.LastSyncError == lastSyncError && slices.Equal(cs.SelectedBy, selectedBy) && slices.Equal(cs.Targets, targetsCount) {
+			return nil
+		}
+		data, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{"resourceVersion": so.GetResourceVersion()},
+			"status":   map[string]interface{}{"status": status, "lastSyncError": lastSyncError, "selectedBy": selectedBy, "targets": targetsCount},
+		})
+		if err != nil {
+			return fmt.Errorf("cannot marshal status patch: %w", err)
+		}
+		err = rclient.Status().Patch(ctx, so, client.RawPatch(types.MergePatchType, data))
+		if errors.IsConflict(err) {
+			// refresh object for the next attempt
+			if err := rclient.Get(ctx, types.NamespacedName{Namespace: so.GetNamespace(), Name: so.GetName()}, so); err != nil {
+				return err
+			}
+		}
+		return err
+	})
+}
+
+func scrapeObjectKey(so client.Object) string {
+	return fmt.Sprintf("%T/%s/%s", so, so.GetNamespace(), so.GetName())
+}
+
+// pruneScrapeObjectsSelectedBy removes VMAgent from status.selectedBy of scrape objects,
+// which are not included into VMAgent configuration anymore.
+// Previously selected objects are taken from selectedScrapeObjects index, scrape objects are listed only if index is missing
+func pruneScrapeObjectsSelectedBy(ctx context.Context, rclient client.Client, vmagentKey string, sos *scrapeObjects) error {
+	selected := make(map[string]scrapeObjectWithStatus)
+	addSelected := func(sos ...scrapeObjectWithStatus) {
+		for _, so := range sos {
+			selected[scrapeObjectKey(so)] = newScrapeObjectRef(so)
+		}
+	}
+	for _, so := range sos.sss {
+		addSelected(so)
+	}
+	for _, so := range sos.pss {
+		addSelected(so)
+	}
+	for _, so := range sos.stss {
+		addSelected(so)
+	}
+	for _, so := range sos.nss {
+		addSelected(so)
+	}
+	for _, so := range sos.prss {
+		addSelected(so)
+	}
+	for _, so := range sos.scss {
+		addSelected(so)
+	}
+	// bad objects are already removed from selection
+	included := make(map[string]struct{}, len(selected)+len(sos.badObjects))
+	for key := range selected {
+		included[key] = struct{}{}
+	}
+	for _, so := range sos.badObjects {
+		included[scrapeObjectKey(so)] = struct{}{}
+	}
+
+	selectedScrapeObjects.mu.Lock()
+	prevSelected, ok := selectedScrapeObjects.m[vmagentKey]
+	selectedScrapeObjects.mu.Unlock()
+	var stale []scrapeObjectWithStatus
+	if ok {
+		for key, prev := range prevSelected {
+			if _, ok := included[key]; ok {
+				continue
+			}
+			so := newScrapeObjectRef(prev)
+			if err := rclient.Get(ctx, types.NamespacedName{Namespace: so.GetNamespace(), Name: so.GetName()}, so); err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return fmt.Errorf("cannot get previously selected scrape object=%q: %w", key, err)
+			}
+			if slices.Contains(so.GetStatus().SelectedBy, vmagentKey) {
+				stale = append(stale, so)
+			}
+		}
+	} else {
+		var err error
+		stale, err = listStaleScrapeObjects(ctx, rclient, vmagentKey, included)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, so := range stale {
+		if err := patchScrapeObjectStatus(ctx, rclient, so, "", "", vmagentKey, false, nil); err != nil {
+			return fmt.Errorf("failed to patch status of scrape object=%q: %w", so.GetName(), err)
+		}
+	}
+	selectedScrapeObjects.mu.Lock()
+	selectedScrapeObjects.m[vmagentKey] = selected
+	selectedScrapeObjects.mu.Unlock()
+	return nil
+}
+
+// listStaleScrapeObjects lists scrape objects at watched namespaces, which have VMAgent at status.selectedBy, but not included into its configuration
+func listStaleScrapeObjects(ctx context.Context, rclient client.Client, vmagentKey string, included map[string]struct{}) ([]scrapeObjectWithStatus, error) {
+	var stale []scrapeObjectWithStatus
+	collect := func(so scrapeObjectWithStatus) {
+		if _, ok := included[scrapeObjectKey(so)]; ok {
+			return
+		}
+		if slices.Contains(so.GetStatus().SelectedBy, vmagentKey) {
+			stale = append(stale, so)
+		}
+	}
+	nss := config.MustGetWatchNamespaces()
+	if err := k8stools.ListObjectsByNamespace(ctx, rclient, nss, func(list *vmv1beta1.VMServiceScrapeList) {
+		for _, item := range list.Items {
+			collect(&item)
+		}
+	}); err != nil {
+		return nil, err
+	}
+	if err := k8stools.ListObjectsByNamespace(ctx, rclient, nss, func(list *vmv1beta1.VMPodScrapeList) {
+		for _, item := range list.Items {
+			collect(&item)
+		}
+	}); err != nil {
+		return nil, err
+	}
+	if err := k8stools.ListObjectsByNamespace(ctx, rclient, nss, func(list *vmv1beta1.VMStaticScrapeList) {
+		for _, item := range list.Items {
+			collect(&item)
+		}
+	}); err != nil {
+		return nil, err
+	}
+	if err := k8stools.ListObjectsByNamespace(ctx, rclient, nss, func(list *vmv1beta1.VMNodeScrapeList) {
+		for _, item := range list.Items {
+			collect(&item)
+		}
+	}); err != nil {
+		return nil, err
+	}
+	if err := k8stools.ListObjectsByNamespace(ctx, rclient, nss, func(list *vmv1beta1.VMProbeList) {
+		for _, item := range list.Items {
+			collect(&item)
+		}
+	}); err != nil {
+		return nil, err
+	}
+	if err := k8stools.ListObjectsByNamespace(ctx, rclient, nss, func(list *vmv1beta1.VMScrapeConfigList) {
+		for _, item := range list.Items {
+			collect(&item)
+		}
+	}); err != nil {
+		return nil, err
+	}
+	return stale, nil
+}
@@ -0,0 +1,124 @@
+package vmagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestUpdateStatusesForScrapeObjects(t *testing.T) {
+	type wantStatus struct {
+		status     vmv1beta1.UpdateStatus
+		selectedBy []string
+	}
+	f := func(predefinedObjects []runtime.Object, included, bad []string, want map[string]wantStatus) {
+		t.Helper()
+		ctx := context.Background()
+		fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		cr := &vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: "vmagent", Namespace: "monitoring"}}
+		// emulate operator start
+		dropSelectedScrapeObjects(selectedByKey(cr))
+		sos := &scrapeObjects{}
+		for _, name := range included {
+			var ss vmv1beta1.VMServiceScrape
+			if err := fclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: name}, &ss); err != nil {
+				t.Fatalf("cannot get object: %s", err)
+			}
+			sos.sss = append(sos.sss, &ss)
+		}
+		for _, name := range bad {
+			var ss vmv1beta1.VMServiceScrape
+			if err := fclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: name}, &ss); err != nil {
+				t.Fatalf("cannot get object: %s", err)
+			}
+			ss.Status.CurrentSyncError = "bad object"
+			sos.badObjects = append(sos.badObjects, &ss)
+		}
+		if err := updateStatusesForScrapeObjects(ctx, fclient, cr, sos); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		got := make(map[string]wantStatus)
+		var list vmv1beta1.VMServiceScrapeList
+		if err := fclient.List(ctx, &list); err != nil {
+			t.Fatalf("cannot list objects: %s", err)
+		}
+		for _, item := range list.Items {
+			got[item.Name] = wantStatus{status: item.Status.Status, selectedBy: item.Status.SelectedBy}
+		}
+		assert.Equal(t, want, got)
+	}
+	serviceScrape := func(name string, status vmv1beta1.UpdateStatus, selectedBy ...string) *vmv1beta1.VMServiceScrape {
+		return &vmv1beta1.VMServiceScrape{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Status:     vmv1beta1.ScrapeObjectStatus{Status: status, SelectedBy: selectedBy},
+		}
+	}
+
+	// new objects
+	f([]runtime.Object{
+		serviceScrape("first", ""),
+		serviceScrape("second", ""),
+	}, []string{"first"}, []string{"second"}, map[string]wantStatus{
+		"first":  {status: vmv1beta1.UpdateStatusOperational, selectedBy: []string{"monitoring/vmagent"}},
+		"second": {status: vmv1beta1.UpdateStatusFailed},
+	})
+
+	// objects selected by multiple vmagents
+	f([]runtime.Object{
+		serviceScrape("first", vmv1beta1.UpdateStatusOperational, "default/other"),
+		serviceScrape("second", vmv1beta1.UpdateStatusOperational, "default/other", "monitoring/vmagent"),
+		serviceScrape("third", vmv1beta1.UpdateStatusOperational, "monitoring/vmagent"),
+	}, []string{"first"}, []string{"second"}, map[string]wantStatus{
+		"first":  {status: vmv1beta1.UpdateStatusOperational, selectedBy: []string{"default/other", "monitoring/vmagent"}},
+		"second": {status: vmv1beta1.UpdateStatusFailed, selectedBy: []string{"default/other"}},
+		"third":  {status: vmv1beta1.UpdateStatusOperational},
+	})
+}
+
+func TestPruneScrapeObjectsSelectedByIndex(t *testing.T) {
+	ctx := context.Background()
+	cr := &vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: "vmagent", Namespace: "monitoring"}}
+	vmagentKey := selectedByKey(cr)
+	dropSelectedScrapeObjects(vmagentKey)
+	defer dropSelectedScrapeObjects(vmagentKey)
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{
+		&vmv1beta1.VMServiceScrape{ObjectMeta: metav1.ObjectMeta{Name: "first", Namespace: "default"}},
+		&vmv1beta1.VMPodScrape{
+			ObjectMeta: metav1.ObjectMeta{Name: "stale", Namespace: "default"},
+			Status:     vmv1beta1.ScrapeObjectStatus{SelectedBy: []string{vmagentKey}},
+		},
+	})
+	clientStats := fclient.(*k8stools.TestClientWithStatsTrack)
+	getSelectedBy := func(so scrapeObjectWithStatus) []string {
+		t.Helper()
+		if err := fclient.Get(ctx, types.NamespacedName{Namespace: so.GetNamespace(), Name: so.GetName()}, so); err != nil {
+			t.Fatalf("cannot get object: %s", err)
+		}
+		return so.GetStatus().SelectedBy
+	}
+	var ss vmv1beta1.VMServiceScrape
+	ss.Name, ss.Namespace = "first", "default"
+	getSelectedBy(&ss)
+
+	// scrape objects are listed for the first prune after start
+	assert.NoError(t, updateStatusesForScrapeObjects(ctx, fclient, cr, &scrapeObjects{sss: []*vmv1beta1.VMServiceScrape{&ss}}))
+	assert.Equal(t, int64(6), clientStats.ListCalls.Load())
+	assert.Equal(t, []string{vmagentKey}, getSelectedBy(&vmv1beta1.VMServiceScrape{ObjectMeta: metav1.ObjectMeta{Name: "first", Namespace: "default"}}))
+	assert.Empty(t, getSelectedBy(&vmv1beta1.VMPodScrape{ObjectMeta: metav1.ObjectMeta{Name: "stale", Namespace: "default"}}))
+
+	// not selected object is pruned with index
+	assert.NoError(t, updateStatusesForScrapeObjects(ctx, fclient, cr, &scrapeObjects{}))
+	assert.Equal(t, int64(6), clientStats.ListCalls.Load())
+	assert.Empty(t, getSelectedBy(&vmv1beta1.VMServiceScrape{ObjectMeta: metav1.ObjectMeta{Name: "first", Namespace: "default"}}))
+
+	// index is dropped on vmagent delete
+	assert.NoError(t, RemoveScrapeObjectsSelection(ctx, fclient, cr))
+	assert.NotContains(t, selectedScrapeObjects.m, vmagentKey)
+}
@@ -0,0 +1,164 @@
+package vmagent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+)
+
+// scrapeTargetsCount holds number of active targets and targets with successful last scrape
+type scrapeTargetsCount struct {
+	active int32
+	up     int32
+}
+
+// fetchScrapeTargets returns number of targets discovered by vmagent pods keyed by scrapeTargetsKey of scrape object.
+// Replicas of the same shard discover the same targets, so the highest count is taken per shard and shards are summed.
+// Pods of DaemonSet discover node-local targets, so counts of all pods are summed.
+// It returns nil if reporting is disabled or targets cannot be fetched from any pod
+func fetchScrapeTargets(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent) map[string]scrapeTargetsCount {
+	if !scrapeTargetsStatus {
+		return nil
+	}
+	byShard := make(map[string]map[string]scrapeTargetsCount)
+	var mu sync.Mutex
+	err := forEachPod(ctx, rclient, cr, func(ctx context.Context, pod *corev1.Pod) {
+		counts, err := fetchPodScrapeTargets(ctx, cr, pod.Status.PodIP)
+		if err != nil {
+			logger.WithContext(ctx).Error(err, "cannot fetch scrape targets", "pod", pod.Name)
+			return
+		}
+		shard := pod.Labels["shard-num"]
+		if cr.Spec.DaemonSetMode {
+			shard = pod.Name
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		prev, ok := byShard[shard]
+		if !ok {
+			byShard[shard] = counts
+			return
+		}
+		for key, c := range counts {
+			p := prev[key]
+			prev[key] = scrapeTargetsCount{active: max(p.active, c.active), up: max(p.up, c.up)}
+		}
+	})
+	if err != nil {
+		logger.WithContext(ctx).Error(err, "cannot list vmagent pods for scrape targets status")
+		return nil
+	}
+	if len(byShard) == 0 {
+		return nil
+	}
+	total := make(map[string]scrapeTargetsCount)
+	for _, counts := range byShard {
+		for key, c := range counts {
+			t := total[key]
+			total[key] = scrapeTargetsCount{active: t.active + c.active, up: t.up + c.up}
+		}
+	}
+	return total
+}
+
+// fetchPodScrapeTargets returns number of active targets of vmagent pod keyed by scrapeTargetsKey
+func fetchPodScrapeTargets(ctx context.Context, cr *vmv1beta1.VMAgent, podIP string) (map[string]scrapeTargetsCount, error) {
+	baseURL, err := url.Parse(vmv1beta1.BuildReloadPathWithPort(cr.Spec.ExtraArgs, cr.Spec.Port))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse vmagent url: %w", err)
+	}
+	targetsURL := fmt.Sprintf("%s://%s%s?state=active", baseURL.Scheme, net.JoinHostPort(podIP, cr.Spec.Port), cr.TargetsPath())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, targetsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := podsHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status code=%d for %s: %s", resp.StatusCode, req.URL.Path, body)
+	}
+	return parseScrapeTargets(resp.Body)
+}
+
+// parseScrapeTargets parses response of vmagent /api/v1/targets
+func parseScrapeTargets(r io.Reader) (map[string]scrapeTargetsCount, error) {
+	var resp struct {
+		Data struct {
+			ActiveTargets []struct {
+				ScrapePool string `json:"scrapePool"`
+				Health     string `json:"health"`
+			} `json:"activeTargets"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(r).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("cannot parse vmagent targets response: %w", err)
+	}
+	counts := make(map[string]scrapeTargetsCount)
+	for _, t := range resp.Data.ActiveTargets {
+		key := scrapePoolKey(t.ScrapePool)
+		c := counts[key]
+		c.active++
+		if t.Health == "up" {
+			c.up++
+		}
+		counts[key] = c
+	}
+	return counts, nil
+}
+
+// scrapePoolKey returns scrapeTargetsKey of scrape object, which generated the given job.
+// Jobs have kind/namespace/name/endpoint_index format, except scrapeConfig/namespace/name
+func scrapePoolKey(jobName string) string {
+	if strings.Count(jobName, "/") == 3 {
+		jobName = jobName[:strings.LastIndexByte(jobName, '/')]
+	}
+	return jobName
+}
+
+// scrapeTargetsKey returns job name prefix of the given scrape object without endpoint index
+func scrapeTargetsKey(so scrapeObjectWithStatus) string {
+	var kind string
+	switch so.(type) {
+	case *vmv1beta1.VMServiceScrape:
+		kind = "serviceScrape"
+	case *vmv1beta1.VMPodScrape:
+		kind = "podScrape"
+	case *vmv1beta1.VMStaticScrape:
+		kind = "staticScrape"
+	case *vmv1beta1.VMNodeScrape:
+		kind = "nodeScrape"
+	case *vmv1beta1.VMProbe:
+		kind = "probe"
+	case *vmv1beta1.VMScrapeConfig:
+		kind = "scrapeConfig"
+	default:
+		panic(fmt.Sprintf("BUG: unexpected scrape object type %T", so))
+	}
+	return fmt.Sprintf("%s/%s/%s", kind, so.GetNamespace(), so.GetName())
+}
+
+// scrapeObjectTargets returns targets count of the given scrape object for status.targets
+// or nil if targets weren't fetched
+func scrapeObjectTargets(so scrapeObjectWithStatus, targets map[string]scrapeTargetsCount, vmagentKey string) *vmv1beta1.ScrapeObjectTargets {
+	if targets == nil {
+		return nil
+	}
+	c := targets[scrapeTargetsKey(so)]
+	return &vmv1beta1.ScrapeObjectTargets{VMAgent: vmagentKey, Active: c.active, Up: c.up}
+}
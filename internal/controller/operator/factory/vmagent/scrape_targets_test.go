@@ -0,0 +1,134 @@
+package vmagent
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestParseScrapeTargets(t *testing.T) {
+	f := func(data string, want map[string]scrapeTargetsCount) {
+		t.Helper()
+		got, err := parseScrapeTargets(strings.NewReader(data))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assert.Equal(t, want, got)
+	}
+
+	f(`{"status":"success","data":{"activeTargets":[]}}`, map[string]scrapeTargetsCount{})
+	f(`{"status":"success","data":{"activeTargets":[
+{"scrapePool":"serviceScrape/default/app/0","health":"up"},
+{"scrapePool":"serviceScrape/default/app/1","health":"down"},
+{"scrapePool":"scrapeConfig/default/app","health":"up"},
+{"scrapePool":"custom-job","health":"unknown"}
+]}}`, map[string]scrapeTargetsCount{
+		"serviceScrape/default/app": {active: 2, up: 1},
+		"scrapeConfig/default/app":  {active: 1, up: 1},
+		"custom-job":                {active: 1},
+	})
+}
+
+func TestScrapeTargetsStatus(t *testing.T) {
+	defer Init(false, false)
+	Init(false, true)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/targets" || r.URL.Query().Get("state") != "active" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"status":"success","data":{"activeTargets":[
+{"scrapePool":"serviceScrape/default/app/0","health":"up"},
+{"scrapePool":"serviceScrape/default/app/1","health":"down"}
+]}}`)
+	}))
+	defer srv.Close()
+	_, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatalf("cannot parse test server address: %s", err)
+	}
+
+	f := func(daemonSetMode bool, want []vmv1beta1.ScrapeObjectTargets) {
+		t.Helper()
+		ctx := context.Background()
+		cr := &vmv1beta1.VMAgent{
+			ObjectMeta: metav1.ObjectMeta{Name: "vmagent", Namespace: "monitoring"},
+			Spec: vmv1beta1.VMAgentSpec{
+				CommonDefaultableParams: vmv1beta1.CommonDefaultableParams{Port: port},
+				DaemonSetMode:           daemonSetMode,
+			},
+		}
+		dropSelectedScrapeObjects(selectedByKey(cr))
+		defer dropSelectedScrapeObjects(selectedByKey(cr))
+		objects := []runtime.Object{
+			&vmv1beta1.VMServiceScrape{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}},
+			&vmv1beta1.VMServiceScrape{ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "default"}},
+		}
+		// 2 shards with 2 replicas each
+		for i := range 4 {
+			labels := cr.SelectorLabels()
+			labels["shard-num"] = fmt.Sprintf("%d", i%2)
+			objects = append(objects, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("vmagent-%d", i), Namespace: "monitoring", Labels: labels},
+				Status:     corev1.PodStatus{PodIP: "127.0.0.1"},
+			})
+		}
+		fclient := k8stools.GetTestClientWithObjects(objects)
+		sos := &scrapeObjects{}
+		for _, name := range []string{"app", "new"} {
+			var ss vmv1beta1.VMServiceScrape
+			if err := fclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: name}, &ss); err != nil {
+				t.Fatalf("cannot get object: %s", err)
+			}
+			sos.sss = append(sos.sss, &ss)
+		}
+		if err := updateStatusesForScrapeObjects(ctx, fclient, cr, sos); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		var got []vmv1beta1.ScrapeObjectTargets
+		for _, name := range []string{"app", "new"} {
+			var ss vmv1beta1.VMServiceScrape
+			if err := fclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: name}, &ss); err != nil {
+				t.Fatalf("cannot get object: %s", err)
+			}
+			got = append(got, ss.Status.Targets...)
+		}
+		assert.Equal(t, want, got)
+
+		// targets are removed with selection
+		if err := updateStatusesForScrapeObjects(ctx, fclient, cr, &scrapeObjects{}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		var ss vmv1beta1.VMServiceScrape
+		if err := fclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "app"}, &ss); err != nil {
+			t.Fatalf("cannot get object: %s", err)
+		}
+		assert.Empty(t, ss.Status.Targets)
+	}
+
+	// replicas of shard discover the same targets
+	f(false, []vmv1beta1.ScrapeObjectTargets{
+		{VMAgent: "monitoring/vmagent", Active: 4, Up: 2},
+		{VMAgent: "monitoring/vmagent"},
+	})
+
+	// daemonset pods discover node-local targets
+	f(true, []vmv1beta1.ScrapeObjectTargets{
+		{VMAgent: "monitoring/vmagent", Active: 8, Up: 4},
+		{VMAgent: "monitoring/vmagent"},
+	})
+}
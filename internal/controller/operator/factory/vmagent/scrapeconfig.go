@@ -696,5 +696,241 @@ func generateScrapeConfig(
 			Value: configs,
 		})
 	}
+
+	// build dockerSDConfig
+	if len(sc.Spec.DockerSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.DockerSDConfigs))
+		for i, config := range sc.Spec.DockerSDConfigs {
+			configs[i] = append(configs[i], yaml.MapItem{
+				Key:   "host",
+				Value: config.Host,
+			})
+			if config.Port != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "port",
+					Value: config.Port,
+				})
+			}
+			if config.HostNetworkingHost != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "host_networking_host",
+					Value: config.HostNetworkingHost,
+				})
+			}
+			if config.MatchFirstNetwork != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "match_first_network",
+					Value: config.MatchFirstNetwork,
+				})
+			}
+			if len(config.Filters) > 0 {
+				filters := make([]yaml.MapSlice, 0, len(config.Filters))
+				for _, f := range config.Filters {
+					filters = append(filters, yaml.MapSlice{
+						{Key: "name", Value: f.Name},
+						{Key: "values", Value: f.Values},
+					})
+				}
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "filters",
+					Value: filters,
+				})
+			}
+			configs[i] = addSDHTTPClientConfigTo(configs[i], sc, "dockersd", i, dockerSDHTTPClient(&config), ssCache)
+		}
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "docker_sd_configs",
+			Value: configs,
+		})
+	}
+
+	// build nomadSDConfig
+	if len(sc.Spec.NomadSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.NomadSDConfigs))
+		for i, config := range sc.Spec.NomadSDConfigs {
+			configs[i] = append(configs[i], yaml.MapItem{
+				Key:   "server",
+				Value: config.Server,
+			})
+			if config.Namespace != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "namespace",
+					Value: config.Namespace,
+				})
+			}
+			if config.Region != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "region",
+					Value: config.Region,
+				})
+			}
+			if config.TagSeparator != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "tag_separator",
+					Value: config.TagSeparator,
+				})
+			}
+			if config.AllowStale != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "allow_stale",
+					Value: config.AllowStale,
+				})
+			}
+			configs[i] = addSDHTTPClientConfigTo(configs[i], sc, "nomadsd", i, nomadSDHTTPClient(&config), ssCache)
+		}
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "nomad_sd_configs",
+			Value: configs,
+		})
+	}
+
+	// build puppetDBSDConfig
+	if len(sc.Spec.PuppetDBSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.PuppetDBSDConfigs))
+		for i, config := range sc.Spec.PuppetDBSDConfigs {
+			configs[i] = []yaml.MapItem{
+				{
+					Key:   "url",
+					Value: config.URL,
+				},
+				{
+					Key:   "query",
+					Value: config.Query,
+				},
+			}
+			if config.IncludeParameters != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "include_parameters",
+					Value: config.IncludeParameters,
+				})
+			}
+			if config.Port != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "port",
+					Value: config.Port,
+				})
+			}
+			configs[i] = addSDHTTPClientConfigTo(configs[i], sc, "puppetdbsd", i, puppetDBSDHTTPClient(&config), ssCache)
+		}
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "puppetdb_sd_configs",
+			Value: configs,
+		})
+	}
+
+	// build hetznerSDConfig
+	if len(sc.Spec.HetznerSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.HetznerSDConfigs))
+		for i, config := range sc.Spec.HetznerSDConfigs {
+			configs[i] = append(configs[i], yaml.MapItem{
+				Key:   "role",
+				Value: config.Role,
+			})
+			if config.Port != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "port",
+					Value: config.Port,
+				})
+			}
+			configs[i] = addSDHTTPClientConfigTo(configs[i], sc, "hetznersd", i, hetznerSDHTTPClient(&config), ssCache)
+		}
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "hetzner_sd_configs",
+			Value: configs,
+		})
+	}
+
+	// build ovhcloudSDConfig
+	if len(sc.Spec.OVHCloudSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.OVHCloudSDConfigs))
+		for i, config := range sc.Spec.OVHCloudSDConfigs {
+			if config.Endpoint != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "endpoint",
+					Value: config.Endpoint,
+				})
+			}
+			configs[i] = append(configs[i], yaml.MapItem{
+				Key:   "application_key",
+				Value: config.ApplicationKey,
+			})
+			if s, ok := ssCache.authorizationSecrets[sc.AsMapKey("ovhcloudsd_app", i)]; ok {
+				configs[i] = append(configs[i], yaml.MapItem{Key: "application_secret", Value: s})
+			}
+			if s, ok := ssCache.authorizationSecrets[sc.AsMapKey("ovhcloudsd_consumer", i)]; ok {
+				configs[i] = append(configs[i], yaml.MapItem{Key: "consumer_key", Value: s})
+			}
+			configs[i] = append(configs[i], yaml.MapItem{
+				Key:   "service",
+				Value: config.Service,
+			})
+		}
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "ovhcloud_sd_configs",
+			Value: configs,
+		})
+	}
 	return cfg
 }
+
+// sdHTTPClient holds http client options shared by service discovery configs
+type sdHTTPClient struct {
+	basicAuth         *vmv1beta1.BasicAuth
+	authorization     *vmv1beta1.Authorization
+	oauth2            *vmv1beta1.OAuth2
+	proxyURL          *string
+	proxyClientConfig *vmv1beta1.ProxyAuth
+	followRedirects   *bool
+	tlsConfig         *vmv1beta1.TLSConfig
+}
+
+func dockerSDHTTPClient(c *vmv1beta1.DockerSDConfig) *sdHTTPClient {
+	return &sdHTTPClient{c.BasicAuth, c.Authorization, c.OAuth2, c.ProxyURL, c.ProxyClientConfig, c.FollowRedirects, c.TLSConfig}
+}
+
+func nomadSDHTTPClient(c *vmv1beta1.NomadSDConfig) *sdHTTPClient {
+	return &sdHTTPClient{c.BasicAuth, c.Authorization, c.OAuth2, c.ProxyURL, c.ProxyClientConfig, c.FollowRedirects, c.TLSConfig}
+}
+
+func puppetDBSDHTTPClient(c *vmv1beta1.PuppetDBSDConfig) *sdHTTPClient {
+	return &sdHTTPClient{c.BasicAuth, c.Authorization, c.OAuth2, c.ProxyURL, c.ProxyClientConfig, c.FollowRedirects, c.TLSConfig}
+}
+
+func hetznerSDHTTPClient(c *vmv1beta1.HetznerSDConfig) *sdHTTPClient {
+	return &sdHTTPClient{c.BasicAuth, c.Authorization, c.OAuth2, c.ProxyURL, c.ProxyClientConfig, c.FollowRedirects, c.TLSConfig}
+}
+
+// addSDHTTPClientConfigTo adds http client options of service discovery config
+// secret values must be loaded into cache by loadSDHTTPClientSecrets with the same sdName
+func addSDHTTPClientConfigTo(dst []yaml.MapItem, sc *vmv1beta1.VMScrapeConfig, sdName string, i int, hc *sdHTTPClient, ssCache *scrapesSecretsCache) []yaml.MapItem {
+	if hc.basicAuth != nil {
+		var bac yaml.MapSlice
+		if s, ok := ssCache.baSecrets[sc.AsMapKey(sdName, i)]; ok {
+			bac = append(bac,
+				yaml.MapItem{Key: "username", Value: s.Username},
+				yaml.MapItem{Key: "password", Value: s.Password},
+			)
+		}
+		if len(hc.basicAuth.PasswordFile) > 0 {
+			bac = append(bac, yaml.MapItem{Key: "password_file", Value: hc.basicAuth.PasswordFile})
+		}
+		if len(bac) > 0 {
+			dst = append(dst, yaml.MapItem{Key: "basic_auth", Value: bac})
+		}
+	}
+	dst = addAuthorizationConfigTo(dst, sc.AsMapKey(sdName, i), hc.authorization, ssCache.authorizationSecrets)
+	dst = addOAuth2ConfigTo(dst, sc.AsMapKey(sdName, i), hc.oauth2, ssCache.oauth2Secrets)
+	if hc.proxyURL != nil {
+		dst = append(dst, yaml.MapItem{Key: "proxy_url", Value: hc.proxyURL})
+	}
+	if hc.proxyClientConfig != nil {
+		dst = append(dst, buildProxyAuthConfig(sc.Namespace, sc.AsProxyKey(sdName, i), hc.proxyClientConfig, ssCache)...)
+	}
+	if hc.followRedirects != nil {
+		dst = append(dst, yaml.MapItem{Key: "follow_redirects", Value: hc.followRedirects})
+	}
+	if hc.tlsConfig != nil {
+		dst = addTLStoYaml(dst, sc.Namespace, hc.tlsConfig, false)
+	}
+	return dst
+}
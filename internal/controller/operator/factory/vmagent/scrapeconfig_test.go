@@ -478,6 +478,113 @@ kubernetes_sd_configs:
   authorization:
     type: Bearer
     credentials_file: file
+`,
+		},
+		{
+			name: "non-kubernetes service discovery configs",
+			args: args{
+				cr: vmv1beta1.VMAgent{},
+				m: &vmv1beta1.VMScrapeConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "sc-sd",
+						Namespace: "default",
+					},
+					Spec: vmv1beta1.VMScrapeConfigSpec{
+						DockerSDConfigs: []vmv1beta1.DockerSDConfig{
+							{
+								Host:              "unix:///var/run/docker.sock",
+								Port:              ptr.To(9100),
+								MatchFirstNetwork: ptr.To(true),
+								Filters: []vmv1beta1.DockerSDFilter{{
+									Name:   "label",
+									Values: []string{"monitoring=true"},
+								}},
+							},
+						},
+						NomadSDConfigs: []vmv1beta1.NomadSDConfig{
+							{
+								Server:    "localhost:4646",
+								Namespace: ptr.To("prod"),
+								Authorization: &vmv1beta1.Authorization{
+									Credentials: &corev1.SecretKeySelector{Key: "token"},
+								},
+							},
+						},
+						PuppetDBSDConfigs: []vmv1beta1.PuppetDBSDConfig{
+							{
+								URL:               "https://puppetdb:8081",
+								Query:             `resources { type = "Class" and title = "Prometheus::Node_exporter" }`,
+								IncludeParameters: ptr.To(true),
+								Port:              ptr.To(9100),
+								TLSConfig:         &vmv1beta1.TLSConfig{InsecureSkipVerify: true},
+							},
+						},
+						HetznerSDConfigs: []vmv1beta1.HetznerSDConfig{
+							{
+								Role: "robot",
+								BasicAuth: &vmv1beta1.BasicAuth{
+									Username: corev1.SecretKeySelector{Key: "user"},
+									Password: corev1.SecretKeySelector{Key: "password"},
+								},
+								FollowRedirects: ptr.To(false),
+							},
+						},
+						OVHCloudSDConfigs: []vmv1beta1.OVHCloudSDConfig{
+							{
+								ApplicationKey:    "app-key",
+								ApplicationSecret: &corev1.SecretKeySelector{Key: "app-secret"},
+								ConsumerKey:       &corev1.SecretKeySelector{Key: "consumer-key"},
+								Service:           "vps",
+							},
+						},
+					},
+				},
+				ssCache: &scrapesSecretsCache{
+					authorizationSecrets: map[string]string{
+						"scrapeConfig/default/sc-sd/nomadsd/0":             "nomad-token",
+						"scrapeConfig/default/sc-sd/ovhcloudsd_app/0":      "app-secret",
+						"scrapeConfig/default/sc-sd/ovhcloudsd_consumer/0": "consumer-key",
+					},
+					baSecrets: map[string]*k8stools.BasicAuthCredentials{
+						"scrapeConfig/default/sc-sd/hetznersd/0": {Username: "robot-user", Password: "robot-pass"},
+					},
+				},
+			},
+			want: `job_name: scrapeConfig/default/sc-sd
+honor_labels: false
+relabel_configs: []
+docker_sd_configs:
+- host: unix:///var/run/docker.sock
+  port: 9100
+  match_first_network: true
+  filters:
+  - name: label
+    values:
+    - monitoring=true
+nomad_sd_configs:
+- server: localhost:4646
+  namespace: prod
+  authorization:
+    type: Bearer
+    credentials: nomad-token
+puppetdb_sd_configs:
+- url: https://puppetdb:8081
+  query: resources { type = "Class" and title = "Prometheus::Node_exporter" }
+  include_parameters: true
+  port: 9100
+  tls_config:
+    insecure_skip_verify: true
+hetzner_sd_configs:
+- role: robot
+  basic_auth:
+    username: robot-user
+    password: robot-pass
+  follow_redirects: false
+ovhcloud_sd_configs:
+- application_key: app-key
+  application_secret: app-secret
+  consumer_key: consumer-key
+  service: vps
 `,
 		},
 	}
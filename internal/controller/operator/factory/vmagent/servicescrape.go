@@ -33,6 +33,8 @@ func generateServiceScrapeConfig(
 	if ep.AttachMetadata.Node == nil && m.Spec.AttachMetadata.Node != nil {
 		ep.AttachMetadata = m.Spec.AttachMetadata
 	}
+	sc, _ := vmagentCR.ScrapeClass(m.Spec.ScrapeClassName)
+	applyScrapeClass(sc, &ep.EndpointRelabelings, &ep.EndpointAuth, &ep.AttachMetadata)
 	cfg = append(cfg, generateK8SSDConfig(selectedNamespaces, apiserverConfig, ssCache, m.Spec.DiscoveryRole, &ep.AttachMetadata))
 
 	if ep.SampleLimit == 0 {
@@ -0,0 +1,261 @@
+package vmagent
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+)
+
+const (
+	// defaultMaxDiskUsagePerURL limits persistent queue size to 1GB
+	// most people do not care about this setting,
+	// but it may harmfully affect kubernetes cluster health
+	defaultMaxDiskUsagePerURL = 1073741824
+	// remoteWriteSamplesPerQueue is a number of samples per second, which a single remoteWrite queue is able to send
+	remoteWriteSamplesPerQueue = 100_000
+	remoteWriteMinQueues       = 2
+	remoteWriteMaxQueues       = 64
+	// remoteWriteBufferedSeconds is a duration of remote storage unavailability,
+	// which persistent queue must be able to buffer at throughputHint rate
+	remoteWriteBufferedSeconds = 3600
+	// remoteWriteBytesPerSample is an approximate size of a sample at persistent queue
+	remoteWriteBytesPerSample = 2
+
+	remoteWriteRowsPushedMetric = "vmagent_remotewrite_rows_pushed_after_relabel_total"
+	// throughputMinWindow is the minimal interval between samples used for throughput calculation
+	// vmagent pods aren't scraped more often, the last calculated throughput is reported instead
+	throughputMinWindow = time.Minute
+)
+
+// remoteWriteQueuesForThroughput returns -remoteWrite.queues value derived from the highest remoteWrite throughputHint
+// or zero if none of remoteWrite targets has it
+func remoteWriteQueuesForThroughput(cr *vmv1beta1.VMAgent) int64 {
+	var maxHint int64
+	for _, rw := range cr.Spec.RemoteWrite {
+		maxHint = max(maxHint, ptr.Deref(rw.ThroughputHint, 0))
+	}
+	if maxHint == 0 {
+		return 0
+	}
+	queues := (maxHint + remoteWriteSamplesPerQueue - 1) / remoteWriteSamplesPerQueue
+	return min(max(queues, remoteWriteMinQueues), remoteWriteMaxQueues)
+}
+
+// remoteWriteMaxDiskUsageForThroughput returns -remoteWrite.maxDiskUsagePerURL value
+// with per url limits derived from throughputHint, targets without hint use the default limit
+func remoteWriteMaxDiskUsageForThroughput(cr *vmv1beta1.VMAgent) string {
+	if cr.RemoteWriteThroughputHint() == 0 {
+		return strconv.Itoa(defaultMaxDiskUsagePerURL)
+	}
+	values := make([]string, 0, len(cr.Spec.RemoteWrite))
+	for _, rw := range cr.Spec.RemoteWrite {
+		limit := max(int64(defaultMaxDiskUsagePerURL), ptr.Deref(rw.ThroughputHint, 0)*remoteWriteBytesPerSample*remoteWriteBufferedSeconds)
+		values = append(values, strconv.FormatInt(limit, 10))
+	}
+	return strings.Join(values, ",")
+}
+
+type throughputSample struct {
+	value     float64
+	timestamp time.Time
+}
+
+// throughputState holds remoteWrite counters observed at the previous scrape of vmagent pods
+// and throughput calculated from them, samples are keyed by pod name and remoteWrite index
+type throughputState struct {
+	samples   map[string]throughputSample
+	observed  map[int]float64
+	fetchedAt time.Time
+}
+
+// throughputSamples holds throughput state keyed by vmagent namespaced name
+var throughputSamples = struct {
+	mu sync.Mutex
+	m  map[string]*throughputState
+}{m: make(map[string]*throughputState)}
+
+// dropThroughputSamples removes throughput state of the given vmagent
+func dropThroughputSamples(nsn types.NamespacedName) {
+	throughputSamples.mu.Lock()
+	delete(throughputSamples.m, nsn.String())
+	throughputSamples.mu.Unlock()
+}
+
+// remoteWriteThroughputStatus returns observed throughput of remoteWrite targets with throughputHint.
+// Throughput is calculated from counters of vmagent pods fetched at least throughputMinWindow apart
+func remoteWriteThroughputStatus(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent, now time.Time) []vmv1beta1.VMAgentRemoteWriteThroughputStatus {
+	nsn := types.NamespacedName{Namespace: cr.Namespace, Name: cr.Name}
+	if cr.RemoteWriteThroughputHint() == 0 {
+		dropThroughputSamples(nsn)
+		return nil
+	}
+
+	throughputSamples.mu.Lock()
+	prev := throughputSamples.m[nsn.String()]
+	throughputSamples.mu.Unlock()
+	if prev != nil && now.Sub(prev.fetchedAt) < throughputMinWindow {
+		return buildThroughputStatuses(cr, prev.observed)
+	}
+
+	current := fetchThroughputSamples(ctx, rclient, cr, now)
+	observed := make(map[int]float64)
+	if prev != nil {
+		for key, cs := range current {
+			ps, ok := prev.samples[key]
+			// counter resets at pod restart, rate cannot be calculated until the next scrape
+			if !ok || cs.value < ps.value || !cs.timestamp.After(ps.timestamp) {
+				continue
+			}
+			idx, _ := strconv.Atoi(key[strings.LastIndexByte(key, '/')+1:])
+			observed[idx] += (cs.value - ps.value) / cs.timestamp.Sub(ps.timestamp).Seconds()
+		}
+	}
+	throughputSamples.mu.Lock()
+	throughputSamples.m[nsn.String()] = &throughputState{samples: current, observed: observed, fetchedAt: now}
+	throughputSamples.mu.Unlock()
+
+	return buildThroughputStatuses(cr, observed)
+}
+
+// fetchThroughputSamples scrapes remoteWrite counters of vmagent pods
+func fetchThroughputSamples(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent, now time.Time) map[string]throughputSample {
+	current := make(map[string]throughputSample)
+	var mu sync.Mutex
+	err := forEachPod(ctx, rclient, cr, func(ctx context.Context, pod *corev1.Pod) {
+		counters, err := fetchRemoteWriteCounters(ctx, cr, pod.Status.PodIP)
+		if err != nil {
+			logger.WithContext(ctx).Error(err, "cannot fetch remoteWrite metrics for throughput calculation", "pod", pod.Name)
+			return
+		}
+		mu.Lock()
+		for idx, value := range counters {
+			current[fmt.Sprintf("%s/%d", pod.Name, idx)] = throughputSample{value: value, timestamp: now}
+		}
+		mu.Unlock()
+	})
+	if err != nil {
+		logger.WithContext(ctx).Error(err, "cannot list vmagent pods for remoteWrite throughput calculation")
+	}
+	return current
+}
+
+func buildThroughputStatuses(cr *vmv1beta1.VMAgent, observed map[int]float64) []vmv1beta1.VMAgentRemoteWriteThroughputStatus {
+	var statuses []vmv1beta1.VMAgentRemoteWriteThroughputStatus
+	for idx, rw := range cr.Spec.RemoteWrite {
+		if rw.ThroughputHint == nil {
+			continue
+		}
+		st := vmv1beta1.VMAgentRemoteWriteThroughputStatus{
+			URL:            redactURL(rw.URL),
+			ThroughputHint: *rw.ThroughputHint,
+		}
+		if rate, ok := observed[idx]; ok {
+			st.Observed = int64(rate)
+			if st.Observed > st.ThroughputHint {
+				st.Warning = fmt.Sprintf("observed throughput %d samples/s exceeds throughputHint %d samples/s, queues and persistent queue size may be insufficient", st.Observed, st.ThroughputHint)
+			}
+		}
+		statuses = append(statuses, st)
+	}
+	return statuses
+}
+
+// fetchRemoteWriteCounters returns number of samples pushed to remoteWrite targets by vmagent pod keyed by remoteWrite index
+func fetchRemoteWriteCounters(ctx context.Context, cr *vmv1beta1.VMAgent, podIP string) (map[int]float64, error) {
+	baseURL, err := url.Parse(vmv1beta1.BuildReloadPathWithPort(cr.Spec.ExtraArgs, cr.Spec.Port))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse vmagent url: %w", err)
+	}
+	metricsURL := fmt.Sprintf("%s://%s%s", baseURL.Scheme, net.JoinHostPort(podIP, cr.Spec.Port), cr.GetMetricPath())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metricsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := podsHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status code=%d for %s: %s", resp.StatusCode, req.URL.Path, body)
+	}
+	return parseRemoteWriteCounters(cr, resp.Body)
+}
+
+// parseRemoteWriteCounters parses remoteWrite counters from vmagent metrics.
+// url label has index based form N:secret-url, unless -remoteWrite.showURL is set
+func parseRemoteWriteCounters(cr *vmv1beta1.VMAgent, r io.Reader) (map[int]float64, error) {
+	counters := make(map[int]float64)
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line, ok := strings.CutPrefix(sc.Text(), remoteWriteRowsPushedMetric+"{")
+		if !ok {
+			continue
+		}
+		labelsEnd := strings.LastIndexByte(line, '}')
+		if labelsEnd < 0 {
+			continue
+		}
+		_, urlLabel, ok := strings.Cut(line[:labelsEnd], `url="`)
+		if !ok {
+			continue
+		}
+		urlLabel, _, _ = strings.Cut(urlLabel, `"`)
+		idx := remoteWriteIndexByLabel(cr, urlLabel)
+		if idx < 0 {
+			continue
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(line[labelsEnd+1:]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse value of %s metric: %w", remoteWriteRowsPushedMetric, err)
+		}
+		counters[idx] += value
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("cannot read vmagent metrics: %w", err)
+	}
+	return counters, nil
+}
+
+func remoteWriteIndexByLabel(cr *vmv1beta1.VMAgent, urlLabel string) int {
+	if n, ok := strings.CutSuffix(urlLabel, ":secret-url"); ok {
+		idx, err := strconv.Atoi(n)
+		if err != nil || idx < 1 || idx > len(cr.Spec.RemoteWrite) {
+			return -1
+		}
+		return idx - 1
+	}
+	for idx, rw := range cr.Spec.RemoteWrite {
+		if rw.RoutedURL() == urlLabel {
+			return idx
+		}
+	}
+	return -1
+}
+
+// redactURL removes credentials from remoteWrite url before exposing it at status
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.User == nil {
+		return rawURL
+	}
+	u.User = nil
+	return u.String()
+}
@@ -4,24 +4,32 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/config"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/build"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/certmanager"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/defaultrules"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/tracing"
 
 	"gopkg.in/yaml.v2"
 	appsv1 "k8s.io/api/apps/v1"
+	v2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/api/autoscaling/v2beta2"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,7 +47,9 @@ const (
 
 	shardNumPlaceholder    = "%SHARD_NUM%"
 	tlsAssetsDir           = "/etc/vmagent-tls/certs"
+	scrapeSecretsDir       = "/etc/vmagent-secrets"
 	vmagentGzippedFilename = "vmagent.yaml.gz"
+	vmagentConfigFilename  = "vmagent.yaml"
 	configEnvsubstFilename = "vmagent.env.yaml"
 )
 
@@ -49,7 +59,7 @@ var defaultPlaceholders = map[string]string{shardNumPlaceholder: "0"}
 func createOrUpdateVMAgentService(ctx context.Context, cr *vmv1beta1.VMAgent, rclient client.Client) (*corev1.Service, error) {
 
 	newService := build.Service(cr, cr.Spec.Port, func(svc *corev1.Service) {
-		if cr.Spec.StatefulMode {
+		if cr.IsStatefulMode() {
 			svc.Spec.ClusterIP = "None"
 		}
 		build.AppendInsertPortsToService(cr.Spec.InsertPorts, svc)
@@ -72,7 +82,7 @@ func createOrUpdateVMAgentService(ctx context.Context, cr *vmv1beta1.VMAgent, rc
 		prevCR := cr.DeepCopy()
 		prevCR.Spec = *cr.ParsedLastAppliedSpec
 		prevService = build.Service(prevCR, prevCR.Spec.Port, func(svc *corev1.Service) {
-			if prevCR.Spec.StatefulMode {
+			if prevCR.IsStatefulMode() {
 				svc.Spec.ClusterIP = "None"
 			}
 			build.AppendInsertPortsToService(prevCR.Spec.InsertPorts, svc)
@@ -89,6 +99,15 @@ func createOrUpdateVMAgentService(ctx context.Context, cr *vmv1beta1.VMAgent, rc
 // CreateOrUpdateVMAgent creates deployment for vmagent and configures it
 // waits for healthy state
 func CreateOrUpdateVMAgent(ctx context.Context, cr *vmv1beta1.VMAgent, rclient client.Client) error {
+	origin := cr
+	cr, err := withRemoteWriteTargets(ctx, rclient, cr)
+	if err != nil {
+		return err
+	}
+	cr, err = withRemoteWriteRelabelConfigs(ctx, rclient, withRemoteWriteMirror(cr))
+	if err != nil {
+		return err
+	}
 	if err := deletePrevStateResources(ctx, cr, rclient); err != nil {
 		return fmt.Errorf("cannot delete objects from prev state: %w", err)
 	}
@@ -108,6 +127,9 @@ func CreateOrUpdateVMAgent(ctx context.Context, cr *vmv1beta1.VMAgent, rclient c
 	if err != nil {
 		return err
 	}
+	if err := createOrUpdateScrapeClientCertificate(ctx, rclient, cr); err != nil {
+		return fmt.Errorf("cannot create or update cert-manager scrape client certificate for vmagent: %w", err)
+	}
 
 	if !ptr.Deref(cr.Spec.DisableSelfServiceScrape, false) {
 		err = reconcile.VMServiceScrapeForCRD(ctx, rclient, build.VMServiceScrapeForServiceWithSpec(svc, cr, "http"))
@@ -115,11 +137,23 @@ func CreateOrUpdateVMAgent(ctx context.Context, cr *vmv1beta1.VMAgent, rclient c
 			return fmt.Errorf("cannot create serviceScrape: %w", err)
 		}
 	}
+	var prevDefaultRules *vmv1beta1.DefaultRules
+	if cr.ParsedLastAppliedSpec != nil {
+		prevDefaultRules = cr.ParsedLastAppliedSpec.DefaultRules
+	}
+	if err := defaultrules.CreateOrUpdate(ctx, rclient, cr.Spec.DefaultRules, prevDefaultRules, defaultrules.ForVMAgent(cr)); err != nil {
+		return fmt.Errorf("cannot create or update default rules: %w", err)
+	}
 
-	ssCache, err := createOrUpdateConfigurationSecret(ctx, cr, rclient)
+	ssCache, err := tracing.Build(ctx, "vmagent-config", func() (*scrapesSecretsCache, error) {
+		return createOrUpdateConfigurationSecret(ctx, cr, rclient)
+	})
 	if err != nil {
 		return err
 	}
+	// status is persisted by caller with origin object, which may differ from the remoteWrite mirroring copy
+	origin.Status.AdditionalScrapeConfigsErrors = cr.Status.AdditionalScrapeConfigsErrors
+	origin.Status.ScrapeGlobalConfig = cr.Status.ScrapeGlobalConfig
 
 	if err := createOrUpdateRelabelConfigsAssets(ctx, cr, rclient); err != nil {
 		return fmt.Errorf("cannot update relabeling asset for vmagent: %w", err)
@@ -146,10 +180,26 @@ func CreateOrUpdateVMAgent(ctx context.Context, cr *vmv1beta1.VMAgent, rclient c
 			return fmt.Errorf("cannot build new deploy for vmagent: %w", err)
 		}
 	}
-	newDeploy, err := newDeployForVMAgent(cr, ssCache)
+	newDeploy, err := tracing.Build(ctx, "vmagent", func() (runtime.Object, error) {
+		return newDeployForVMAgent(cr, ssCache)
+	})
 	if err != nil {
 		return fmt.Errorf("cannot build new deploy for vmagent: %w", err)
 	}
+	if err := build.AddLicenseChecksumAnnotation(ctx, rclient, cr.Namespace, cr.Spec.License, newDeploy); err != nil {
+		return err
+	}
+	applyPatches := func(obj, prevObj runtime.Object) error {
+		if err := build.ApplyOverridePatches(cr.Spec.OverridePatches, obj); err != nil {
+			return err
+		}
+		if prevObj != nil {
+			if err := build.ApplyOverridePatches(cr.ParsedLastAppliedSpec.OverridePatches, prevObj); err != nil {
+				return fmt.Errorf("cannot apply override patches to prev object: %w", err)
+			}
+		}
+		return nil
+	}
 
 	deploymentNames := make(map[string]struct{})
 	stsNames := make(map[string]struct{})
@@ -164,6 +214,9 @@ func CreateOrUpdateVMAgent(ctx context.Context, cr *vmv1beta1.VMAgent, rclient c
 				prevShardedObject = prevObjectSpec.DeepCopyObject()
 				addShardSettingsToVMAgent(shardNum, shardsCount, prevShardedObject)
 			}
+			if err := applyPatches(shardedDeploy, prevShardedObject); err != nil {
+				return err
+			}
 			placeholders := map[string]string{shardNumPlaceholder: strconv.Itoa(shardNum)}
 			switch shardedDeploy := shardedDeploy.(type) {
 			case *appsv1.Deployment:
@@ -207,7 +260,8 @@ func CreateOrUpdateVMAgent(ctx context.Context, cr *vmv1beta1.VMAgent, rclient c
 					}
 				}
 				stsOpts := reconcile.STSOptions{
-					HasClaim: len(shardedDeploy.Spec.VolumeClaimTemplates) > 0,
+					HasClaim:       len(shardedDeploy.Spec.VolumeClaimTemplates) > 0,
+					UpdateStrategy: cr.Spec.StatefulUpdateStrategy,
 					SelectorLabels: func() map[string]string {
 						selectorLabels := cr.SelectorLabels()
 						selectorLabels["shard-num"] = strconv.Itoa(shardNum)
@@ -221,6 +275,9 @@ func CreateOrUpdateVMAgent(ctx context.Context, cr *vmv1beta1.VMAgent, rclient c
 			}
 		}
 	} else {
+		if err := applyPatches(newDeploy, prevObjectSpec); err != nil {
+			return err
+		}
 		switch newDeploy := newDeploy.(type) {
 		case *appsv1.Deployment:
 			var prevDeploy *appsv1.Deployment
@@ -239,7 +296,7 @@ func CreateOrUpdateVMAgent(ctx context.Context, cr *vmv1beta1.VMAgent, rclient c
 			if err != nil {
 				return fmt.Errorf("cannot fill placeholders for deployment in vmagent: %w", err)
 			}
-			if err := reconcile.Deployment(ctx, rclient, newDeploy, prevDeploy, false); err != nil {
+			if err := reconcile.Deployment(ctx, rclient, newDeploy, prevDeploy, cr.Spec.HPA != nil); err != nil {
 				return err
 			}
 			deploymentNames[newDeploy.Name] = struct{}{}
@@ -262,11 +319,42 @@ func CreateOrUpdateVMAgent(ctx context.Context, cr *vmv1beta1.VMAgent, rclient c
 			stsOpts := reconcile.STSOptions{
 				HasClaim:       len(newDeploy.Spec.VolumeClaimTemplates) > 0,
 				SelectorLabels: cr.SelectorLabels,
+				HPA:            cr.Spec.HPA,
+				UpdateStrategy: cr.Spec.StatefulUpdateStrategy,
 			}
 			if err := reconcile.HandleSTSUpdate(ctx, rclient, stsOpts, newDeploy, prevSTS); err != nil {
 				return err
 			}
 			stsNames[newDeploy.Name] = struct{}{}
+		case *appsv1.DaemonSet:
+			var prevDS *appsv1.DaemonSet
+			if prevObjectSpec != nil {
+				prevAppObject, ok := prevObjectSpec.(*appsv1.DaemonSet)
+				if ok {
+					prevDS = prevAppObject
+					prevDS, err = k8stools.RenderPlaceholders(prevDS, defaultPlaceholders)
+					if err != nil {
+						return fmt.Errorf("cannot fill placeholders for prev daemonset in vmagent: %w", err)
+					}
+				}
+			}
+			newDeploy, err = k8stools.RenderPlaceholders(newDeploy, defaultPlaceholders)
+			if err != nil {
+				return fmt.Errorf("cannot fill placeholders for daemonset in vmagent: %w", err)
+			}
+			if err := reconcile.DaemonSet(ctx, rclient, newDeploy, prevDS); err != nil {
+				return err
+			}
+		}
+		if err := createOrUpdateVMAgentHPA(ctx, rclient, cr); err != nil {
+			return fmt.Errorf("cannot update hpa for vmagent: %w", err)
+		}
+	}
+	if ssCache != nil {
+		for _, shard := range ssCache.nodePoolShards {
+			if err := reconcileNodePoolShard(ctx, rclient, cr, newDeploy, prevObjectSpec, shard, deploymentNames, stsNames); err != nil {
+				return fmt.Errorf("cannot reconcile node pool shard=%q: %w", shard, err)
+			}
 		}
 	}
 	if err := finalize.RemoveOrphanedDeployments(ctx, rclient, cr, deploymentNames); err != nil {
@@ -275,10 +363,34 @@ func CreateOrUpdateVMAgent(ctx context.Context, cr *vmv1beta1.VMAgent, rclient c
 	if err := finalize.RemoveOrphanedSTSs(ctx, rclient, cr, stsNames); err != nil {
 		return err
 	}
+	origin.Status.RemoteWriteThroughput = remoteWriteThroughputStatus(ctx, rclient, cr, time.Now())
 
 	return nil
 }
 
+// OnDelete drops in-memory state of the deleted vmagent
+func OnDelete(nsn types.NamespacedName) {
+	dropThroughputSamples(nsn)
+	dropSelectedScrapeObjects(nsn.String())
+	deleteScrapeObjectsRejectedMetric(nsn)
+}
+
+func createOrUpdateVMAgentHPA(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent) error {
+	if cr.Spec.HPA == nil {
+		return nil
+	}
+	targetRef := v2beta2.CrossVersionObjectReference{
+		Name:       cr.PrefixedName(),
+		Kind:       "Deployment",
+		APIVersion: "apps/v1",
+	}
+	if cr.IsStatefulMode() {
+		targetRef.Kind = "StatefulSet"
+	}
+	defaultHPA := build.HPA(targetRef, cr.Spec.HPA, cr.AsOwner(), cr.SelectorLabels(), cr.Namespace)
+	return reconcile.HPA(ctx, rclient, defaultHPA)
+}
+
 // newDeployForVMAgent builds vmagent deployment spec.
 func newDeployForVMAgent(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) (runtime.Object, error) {
 
@@ -288,8 +400,35 @@ func newDeployForVMAgent(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) (r
 	}
 	useStrictSecurity := ptr.Deref(cr.Spec.UseStrictSecurity, false)
 
+	if cr.Spec.DaemonSetMode {
+		dsSpec := &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            cr.PrefixedName(),
+				Namespace:       cr.Namespace,
+				Labels:          cr.AllLabels(),
+				Annotations:     cr.AnnotationsFiltered(),
+				OwnerReferences: cr.AsOwner(),
+				Finalizers:      finalize.ChildFinalizers(),
+			},
+			Spec: appsv1.DaemonSetSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: cr.SelectorLabels(),
+				},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels:      cr.PodLabels(),
+						Annotations: cr.PodAnnotations(),
+					},
+					Spec: *podSpec,
+				},
+			},
+		}
+		build.DaemonSetAddCommonParams(dsSpec, useStrictSecurity, &cr.Spec.CommonApplicationDeploymentParams)
+		return dsSpec, nil
+	}
+
 	// fast path, use sts
-	if cr.Spec.StatefulMode {
+	if cr.IsStatefulMode() {
 		stsSpec := &appsv1.StatefulSet{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:            cr.PrefixedName(),
@@ -297,15 +436,13 @@ func newDeployForVMAgent(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) (r
 				Labels:          cr.AllLabels(),
 				Annotations:     cr.AnnotationsFiltered(),
 				OwnerReferences: cr.AsOwner(),
-				Finalizers:      []string{vmv1beta1.FinalizerName},
+				Finalizers:      finalize.ChildFinalizers(),
 			},
 			Spec: appsv1.StatefulSetSpec{
 				Selector: &metav1.LabelSelector{
 					MatchLabels: cr.SelectorLabels(),
 				},
-				UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
-					Type: cr.Spec.StatefulRollingUpdateStrategy,
-				},
+				UpdateStrategy:      build.StatefulSetUpdateStrategy(cr.Spec.StatefulUpdateStrategy, cr.Spec.StatefulRollingUpdateStrategy),
 				PodManagementPolicy: appsv1.ParallelPodManagement,
 				ServiceName:         buildSTSServiceName(cr),
 				Template: corev1.PodTemplateSpec{
@@ -318,6 +455,14 @@ func newDeployForVMAgent(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) (r
 			},
 		}
 		build.StatefulSetAddCommonParams(stsSpec, useStrictSecurity, &cr.Spec.CommonApplicationDeploymentParams)
+		if cr.Spec.ClusterMode != nil {
+			// each member must have stable network identity,
+			// it's provided by headless vmagent service
+			stsSpec.Spec.Replicas = ptr.To(cr.Spec.ClusterMode.MembersCount)
+			if stsSpec.Spec.ServiceName == "" {
+				stsSpec.Spec.ServiceName = cr.PrefixedName()
+			}
+		}
 		cr.Spec.StatefulStorage.IntoSTSVolume(vmAgentPersistentQueueMountName, &stsSpec.Spec)
 		stsSpec.Spec.VolumeClaimTemplates = append(stsSpec.Spec.VolumeClaimTemplates, cr.Spec.ClaimTemplates...)
 		return stsSpec, nil
@@ -334,7 +479,7 @@ func newDeployForVMAgent(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) (r
 			Labels:          cr.AllLabels(),
 			Annotations:     cr.AnnotationsFiltered(),
 			OwnerReferences: cr.AsOwner(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Spec: appsv1.DeploymentSpec{
 			Selector: &metav1.LabelSelector{
@@ -389,6 +534,35 @@ func makeSpecForVMAgent(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) (*c
 	args = cr.Spec.License.MaybeAddToArgs(args, vmv1beta1.SecretsDir)
 
 	var envs []corev1.EnvVar
+	if cm := cr.Spec.ClusterMode; cm != nil {
+		// member num is parsed by vmagent from the statefulset pod name suffix
+		args = append(args,
+			fmt.Sprintf("-promscrape.cluster.membersCount=%d", cm.MembersCount),
+			"-promscrape.cluster.memberNum=$(POD_NAME)",
+		)
+		if cm.ReplicationFactor != nil {
+			args = append(args, fmt.Sprintf("-promscrape.cluster.replicationFactor=%d", *cm.ReplicationFactor))
+		}
+		envs = append(envs, corev1.EnvVar{
+			Name: "POD_NAME",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"},
+			},
+		})
+	}
+	if cr.Spec.DaemonSetMode {
+		// node name is referenced by scrape config in order to discover only node-local targets
+		envs = append(envs, corev1.EnvVar{
+			Name: daemonSetNodeNameEnv,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"},
+			},
+		})
+	}
+	if ssCache != nil && len(ssCache.nodePoolShards) > 0 {
+		// regular pods skip targets assigned to node pool shards
+		envs = append(envs, nodePoolShardEnvVar(""))
+	}
 	envs = append(envs, cr.Spec.ExtraEnvs...)
 
 	var ports []corev1.ContainerPort
@@ -399,7 +573,7 @@ func makeSpecForVMAgent(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) (*c
 	// mount data path any way, even if user changes its value
 	// we cannot rely on value of remoteWriteSettings.
 	pqMountPath := vmAgentPersistentQueueDir
-	if cr.Spec.StatefulMode {
+	if cr.IsStatefulMode() {
 		pqMountPath = vmAgentPersistentQueueSTSDir
 	}
 	agentVolumeMounts = append(agentVolumeMounts,
@@ -412,7 +586,7 @@ func makeSpecForVMAgent(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) (*c
 
 	var volumes []corev1.Volume
 	// in case for sts, we have to use persistentVolumeClaimTemplate instead
-	if !cr.Spec.StatefulMode {
+	if !cr.IsStatefulMode() {
 		volumes = append(volumes, corev1.Volume{
 			Name: vmAgentPersistentQueueMountName,
 			VolumeSource: corev1.VolumeSource{
@@ -458,12 +632,8 @@ func makeSpecForVMAgent(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) (*c
 		)
 		volumes = append(volumes,
 			corev1.Volume{
-				Name: "config",
-				VolumeSource: corev1.VolumeSource{
-					Secret: &corev1.SecretVolumeSource{
-						SecretName: cr.PrefixedName(),
-					},
-				},
+				Name:         "config",
+				VolumeSource: buildConfigVolumeSource(cr),
 			})
 		agentVolumeMounts = append(agentVolumeMounts,
 			corev1.VolumeMount{
@@ -471,6 +641,10 @@ func makeSpecForVMAgent(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) (*c
 				ReadOnly:  true,
 				MountPath: vmAgentConfDir,
 			})
+		if vol, vm, ok := buildScrapeSecretsVolume(ssCache); ok {
+			volumes = append(volumes, vol)
+			agentVolumeMounts = append(agentVolumeMounts, vm)
+		}
 	}
 	if cr.HasAnyStreamAggrRule() {
 		volumes = append(volumes, corev1.Volume{
@@ -530,6 +704,22 @@ func makeSpecForVMAgent(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) (*c
 			MountPath: path.Join(vmv1beta1.SecretsDir, s),
 		})
 	}
+	if cr.Spec.ScrapeClientCertManager != nil {
+		s := cr.ScrapeClientCertSecretName()
+		volumes = append(volumes, corev1.Volume{
+			Name: k8stools.SanitizeVolumeName("secret-" + s),
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: s,
+				},
+			},
+		})
+		agentVolumeMounts = append(agentVolumeMounts, corev1.VolumeMount{
+			Name:      k8stools.SanitizeVolumeName("secret-" + s),
+			ReadOnly:  true,
+			MountPath: path.Join(vmv1beta1.SecretsDir, s),
+		})
+	}
 
 	for _, c := range cr.Spec.ConfigMaps {
 		volumes = append(volumes, corev1.Volume{
@@ -578,7 +768,9 @@ func makeSpecForVMAgent(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) (*c
 	}
 
 	args = build.AppendArgsForInsertPorts(args, cr.Spec.InsertPorts)
+	args = build.AppendArgsForCardinalityLimits(args, cr.Spec.CardinalityLimits, "remoteWrite")
 
+	args = build.AddVersionedArgs("vmagent", cr.Spec.Image.Tag, args, "-")
 	args = build.AddExtraArgsOverrideDefaults(args, cr.Spec.ExtraArgs, "-")
 	sort.Strings(args)
 
@@ -606,7 +798,7 @@ func makeSpecForVMAgent(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) (*c
 		operatorContainers = append(operatorContainers, configReloader)
 		if !cr.Spec.IngestOnlyMode {
 			ic = append(ic,
-				buildInitConfigContainer(ptr.Deref(cr.Spec.UseVMConfigReloader, false), cr.Spec.ConfigReloaderImageTag, cr.Spec.ConfigReloaderResources, configReloader.Args)...)
+				buildInitConfigContainer(ptr.Deref(cr.Spec.UseVMConfigReloader, false), cr.Spec.ConfigReloaderImageTag, cr.Spec.ConfigReloaderResources, configReloader.Args, generatedConfigFilename(cr))...)
 			if len(cr.Spec.InitContainers) > 0 {
 				var err error
 				build.AddStrictSecuritySettingsToContainers(cr.Spec.SecurityContext, ic, useStrictSecurity)
@@ -740,10 +932,59 @@ func buildVMAgentRelabelingsAssets(ctx context.Context, cr *vmv1beta1.VMAgent, r
 				cfgCM.Data[fmt.Sprintf(urlRelabelingName, i)] += data
 			}
 		}
+		// tenant routing must be applied after user defined relabeling
+		if rw.TenantLabelRouting != nil {
+			rcs := addRelabelConfigs(nil, buildTenantRoutingRelabelConfigs(rw.TenantLabelRouting))
+			data, err := yaml.Marshal(rcs)
+			if err != nil {
+				return nil, fmt.Errorf("cannot serialize tenantLabelRouting as yaml: %w", err)
+			}
+			key := fmt.Sprintf(urlRelabelingName, i)
+			if prev := cfgCM.Data[key]; len(prev) > 0 && !strings.HasSuffix(prev, "\n") {
+				cfgCM.Data[key] += "\n"
+			}
+			cfgCM.Data[key] += string(data)
+		}
 	}
 	return cfgCM, nil
 }
 
+// buildTenantRoutingRelabelConfigs returns relabeling rules, which set vm_account_id and vm_project_id labels
+// for vminsert multitenant endpoint based on the value of routing label
+func buildTenantRoutingRelabelConfigs(tlr *vmv1beta1.TenantLabelRouting) []vmv1beta1.RelabelConfig {
+	setTenant := func(dst []vmv1beta1.RelabelConfig, tenant string, sourceLabels []string, regex string) []vmv1beta1.RelabelConfig {
+		accountID, projectID, _ := strings.Cut(tenant, ":")
+		if projectID == "" {
+			projectID = "0"
+		}
+		for _, t := range []struct{ label, value string }{{"vm_account_id", accountID}, {"vm_project_id", projectID}} {
+			rc := vmv1beta1.RelabelConfig{
+				SourceLabels: sourceLabels,
+				TargetLabel:  t.label,
+				Replacement:  t.value,
+			}
+			if regex != "" {
+				rc.Regex = vmv1beta1.StringOrArray{regex}
+			}
+			dst = append(dst, rc)
+		}
+		return dst
+	}
+	var rcs []vmv1beta1.RelabelConfig
+	if tlr.DefaultTenant != "" {
+		rcs = setTenant(rcs, tlr.DefaultTenant, nil, "")
+	}
+	values := make([]string, 0, len(tlr.Tenants))
+	for value := range tlr.Tenants {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	for _, value := range values {
+		rcs = setTenant(rcs, tlr.Tenants[value], []string{tlr.Label}, regexp.QuoteMeta(value))
+	}
+	return rcs
+}
+
 // createOrUpdateRelabelConfigsAssets builds relabeling configs for vmagent at separate configmap, serialized as yaml
 func createOrUpdateRelabelConfigsAssets(ctx context.Context, cr *vmv1beta1.VMAgent, rclient client.Client) error {
 	if !cr.HasAnyRelabellingConfigs() {
@@ -845,7 +1086,7 @@ func createOrUpdateTLSAssets(ctx context.Context, cr *vmv1beta1.VMAgent, rclient
 			Annotations:     cr.AnnotationsFiltered(),
 			OwnerReferences: cr.AsOwner(),
 			Namespace:       cr.Namespace,
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Data: map[string][]byte{},
 	}
@@ -950,12 +1191,15 @@ func buildRemoteWriteSettings(cr *vmv1beta1.VMAgent) []string {
 	if cr.Spec.RemoteWriteSettings == nil {
 		// fast path
 		pqMountPath := vmAgentPersistentQueueDir
-		if cr.Spec.StatefulMode {
+		if cr.IsStatefulMode() {
 			pqMountPath = vmAgentPersistentQueueSTSDir
 		}
 		args = append(args,
-			"-remoteWrite.maxDiskUsagePerURL=1073741824",
+			"-remoteWrite.maxDiskUsagePerURL="+remoteWriteMaxDiskUsageForThroughput(cr),
 			fmt.Sprintf("-remoteWrite.tmpDataPath=%s", pqMountPath))
+		if queues := remoteWriteQueuesForThroughput(cr); queues > 0 {
+			args = append(args, fmt.Sprintf("-remoteWrite.queues=%d", queues))
+		}
 		return args
 	}
 
@@ -966,21 +1210,21 @@ func buildRemoteWriteSettings(cr *vmv1beta1.VMAgent) []string {
 	if rws.MaxBlockSize != nil {
 		args = append(args, fmt.Sprintf("-remoteWrite.maxBlockSize=%d", *rws.MaxBlockSize))
 	}
-	// limit to 1GB
-	// most people do not care about this setting,
-	// but it may harmfully affect kubernetes cluster health
-	maxDiskUsage := "1073741824"
+	// explicit settings have priority over values derived from remoteWrite throughputHint
+	maxDiskUsage := remoteWriteMaxDiskUsageForThroughput(cr)
 	if rws.MaxDiskUsagePerURL != nil {
 		maxDiskUsage = fmt.Sprintf("%d", *rws.MaxDiskUsagePerURL)
 	}
 	if rws.Queues != nil {
 		args = append(args, fmt.Sprintf("-remoteWrite.queues=%d", *rws.Queues))
+	} else if queues := remoteWriteQueuesForThroughput(cr); queues > 0 {
+		args = append(args, fmt.Sprintf("-remoteWrite.queues=%d", queues))
 	}
 	if rws.ShowURL != nil {
 		args = append(args, fmt.Sprintf("-remoteWrite.showURL=%t", *rws.ShowURL))
 	}
 	pqMountPath := vmAgentPersistentQueueDir
-	if cr.Spec.StatefulMode {
+	if cr.IsStatefulMode() {
 		pqMountPath = vmAgentPersistentQueueSTSDir
 	}
 	if rws.TmpDataPath != nil {
@@ -1043,6 +1287,7 @@ func buildRemoteWrites(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) []st
 	bearerTokenFile := remoteFlag{flagSetting: "-remoteWrite.bearerTokenFile="}
 	urlRelabelConfig := remoteFlag{flagSetting: "-remoteWrite.urlRelabelConfig="}
 	sendTimeout := remoteFlag{flagSetting: "-remoteWrite.sendTimeout="}
+	rateLimit := remoteFlag{flagSetting: "-remoteWrite.rateLimit="}
 	tlsCAs := remoteFlag{flagSetting: "-remoteWrite.tlsCAFile="}
 	tlsCerts := remoteFlag{flagSetting: "-remoteWrite.tlsCertFile="}
 	tlsKeys := remoteFlag{flagSetting: "-remoteWrite.tlsKeyFile="}
@@ -1061,11 +1306,10 @@ func buildRemoteWrites(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) []st
 	streamAggrIgnoreFirstIntervals := remoteFlag{flagSetting: "-remoteWrite.streamAggr.ignoreFirstIntervals="}
 	streamAggrIgnoreOldSamples := remoteFlag{flagSetting: "-remoteWrite.streamAggr.ignoreOldSamples="}
 
-	pathPrefix := path.Join(tlsAssetsDir, cr.Namespace)
-
 	for i := range remoteTargets {
 		rws := remoteTargets[i]
-		url.flagSetting += fmt.Sprintf("%s,", rws.URL)
+		url.flagSetting += fmt.Sprintf("%s,", rws.RoutedURL())
+		pathPrefix := path.Join(tlsAssetsDir, remoteWriteSecretsNamespace(&rws, cr.Namespace))
 
 		var caPath, certPath, keyPath, ServerName string
 		var insecure bool
@@ -1139,7 +1383,7 @@ func buildRemoteWrites(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) []st
 
 		value = ""
 
-		if rws.UrlRelabelConfig != nil || len(rws.InlineUrlRelabelConfig) > 0 {
+		if rws.UrlRelabelConfig != nil || len(rws.InlineUrlRelabelConfig) > 0 || rws.TenantLabelRouting != nil {
 			urlRelabelConfig.isNotNull = true
 			value = path.Join(vmv1beta1.RelabelingConfigDir, fmt.Sprintf(urlRelabelingName, i))
 		}
@@ -1155,6 +1399,13 @@ func buildRemoteWrites(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) []st
 		}
 		sendTimeout.flagSetting += fmt.Sprintf("%s,", value)
 
+		var rateLimitVal int64
+		if rws.RateLimit != nil {
+			rateLimit.isNotNull = true
+			rateLimitVal = *rws.RateLimit
+		}
+		rateLimit.flagSetting += fmt.Sprintf("%d,", rateLimitVal)
+
 		value = ""
 		if len(rws.Headers) > 0 {
 			headers.isNotNull = true
@@ -1241,7 +1492,7 @@ func buildRemoteWrites(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) []st
 		streamAggrIgnoreFirstIntervals.flagSetting += fmt.Sprintf("%d,", ignoreFirstIntervalsVal)
 		streamAggrIgnoreOldSamples.flagSetting += fmt.Sprintf("%v,", ignoreOldSamples)
 	}
-	remoteArgs = append(remoteArgs, url, authUser, bearerTokenFile, urlRelabelConfig, tlsInsecure, sendTimeout)
+	remoteArgs = append(remoteArgs, url, authUser, bearerTokenFile, urlRelabelConfig, tlsInsecure, sendTimeout, rateLimit)
 	remoteArgs = append(remoteArgs, tlsServerName, tlsKeys, tlsCerts, tlsCAs)
 	remoteArgs = append(remoteArgs, oauth2ClientID, oauth2ClientSecretFile, oauth2Scopes, oauth2TokenURL)
 	remoteArgs = append(remoteArgs, headers, authPasswordFile)
@@ -1316,6 +1567,34 @@ func buildConfigReloaderContainer(cr *vmv1beta1.VMAgent) corev1.Container {
 	return cntr
 }
 
+// generatedConfigFilename returns name of generated scrape configuration file at config volume
+func generatedConfigFilename(cr *vmv1beta1.VMAgent) string {
+	if cr.HasGeneratedConfigAtConfigMap() {
+		return vmagentConfigFilename
+	}
+	return vmagentGzippedFilename
+}
+
+// buildConfigVolumeSource returns source of config volume
+// with generatedConfigStorage=configmap, plain text configuration from ConfigMap is projected together with remote write credentials from Secret
+func buildConfigVolumeSource(cr *vmv1beta1.VMAgent) corev1.VolumeSource {
+	if !cr.HasGeneratedConfigAtConfigMap() {
+		return corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: cr.PrefixedName(),
+			},
+		}
+	}
+	return corev1.VolumeSource{
+		Projected: &corev1.ProjectedVolumeSource{
+			Sources: []corev1.VolumeProjection{
+				{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: cr.PrefixedName()}}},
+				{ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: corev1.LocalObjectReference{Name: cr.PrefixedName()}}},
+			},
+		},
+	}
+}
+
 func buildConfigReloaderArgs(cr *vmv1beta1.VMAgent) []string {
 	// by default use watched-dir
 	// it should simplify parsing for latest and empty version tags.
@@ -1332,7 +1611,7 @@ func buildConfigReloaderArgs(cr *vmv1beta1.VMAgent) []string {
 			args = append(args, fmt.Sprintf("--config-secret-name=%s/%s", cr.Namespace, cr.PrefixedName()))
 			args = append(args, "--config-secret-key=vmagent.yaml.gz")
 		} else {
-			args = append(args, fmt.Sprintf("--config-file=%s", path.Join(vmAgentConfDir, vmagentGzippedFilename)))
+			args = append(args, fmt.Sprintf("--config-file=%s", path.Join(vmAgentConfDir, generatedConfigFilename(cr))))
 		}
 	}
 	if cr.HasAnyStreamAggrRule() {
@@ -1361,7 +1640,7 @@ func buildConfigReloaderArgs(cr *vmv1beta1.VMAgent) []string {
 	return args
 }
 
-func buildInitConfigContainer(useCustomConfigReloader bool, baseImage string, resources corev1.ResourceRequirements, configReloaderArgs []string) []corev1.Container {
+func buildInitConfigContainer(useCustomConfigReloader bool, baseImage string, resources corev1.ResourceRequirements, configReloaderArgs []string, configFilename string) []corev1.Container {
 	var initReloader corev1.Container
 	if useCustomConfigReloader {
 		initReloader = corev1.Container{
@@ -1378,6 +1657,10 @@ func buildInitConfigContainer(useCustomConfigReloader bool, baseImage string, re
 		}
 		return []corev1.Container{initReloader}
 	}
+	initCmd := fmt.Sprintf("gunzip -c %s > %s", path.Join(vmAgentConfDir, configFilename), path.Join(vmAgentConOfOutDir, configEnvsubstFilename))
+	if configFilename != vmagentGzippedFilename {
+		initCmd = fmt.Sprintf("cp %s %s", path.Join(vmAgentConfDir, configFilename), path.Join(vmAgentConOfOutDir, configEnvsubstFilename))
+	}
 	initReloader = corev1.Container{
 		Image: baseImage,
 		Name:  "config-init",
@@ -1386,7 +1669,7 @@ func buildInitConfigContainer(useCustomConfigReloader bool, baseImage string, re
 		},
 		Args: []string{
 			"-c",
-			fmt.Sprintf("gunzip -c %s > %s", path.Join(vmAgentConfDir, vmagentGzippedFilename), path.Join(vmAgentConOfOutDir, configEnvsubstFilename)),
+			initCmd,
 		},
 		VolumeMounts: []corev1.VolumeMount{
 			{
@@ -1414,17 +1697,48 @@ func deletePrevStateResources(ctx context.Context, cr *vmv1beta1.VMAgent, rclien
 		return fmt.Errorf("cannot remove additional service: %w", err)
 	}
 	objMeta := metav1.ObjectMeta{Name: cr.PrefixedName(), Namespace: cr.Namespace}
+	if !cr.Spec.DaemonSetMode && cr.ParsedLastAppliedSpec.DaemonSetMode {
+		if err := finalize.SafeDeleteWithFinalizer(ctx, rclient, &appsv1.DaemonSet{ObjectMeta: objMeta}); err != nil {
+			return fmt.Errorf("cannot delete DaemonSet from prev state: %w", err)
+		}
+	}
 	if cr.Spec.PodDisruptionBudget == nil && cr.ParsedLastAppliedSpec.PodDisruptionBudget != nil {
 		if err := finalize.SafeDeleteWithFinalizer(ctx, rclient, &policyv1.PodDisruptionBudget{ObjectMeta: objMeta}); err != nil {
 			return fmt.Errorf("cannot delete PDB from prev state: %w", err)
 		}
 	}
+	if cr.Spec.HPA == nil && cr.ParsedLastAppliedSpec.HPA != nil {
+		if err := finalize.SafeDeleteWithFinalizer(ctx, rclient, &v2.HorizontalPodAutoscaler{ObjectMeta: objMeta}); err != nil {
+			return fmt.Errorf("cannot delete HPA from prev state: %w", err)
+		}
+	}
 
 	if ptr.Deref(cr.Spec.DisableSelfServiceScrape, false) && !ptr.Deref(cr.ParsedLastAppliedSpec.DisableSelfServiceScrape, false) {
 		if err := finalize.SafeDeleteWithFinalizer(ctx, rclient, &vmv1beta1.VMServiceScrape{ObjectMeta: objMeta}); err != nil {
 			return fmt.Errorf("cannot remove serviceScrape: %w", err)
 		}
 	}
+	if cr.Spec.ScrapeClientCertManager == nil && cr.ParsedLastAppliedSpec.ScrapeClientCertManager != nil {
+		certMeta := metav1.ObjectMeta{Name: cr.ScrapeClientCertSecretName(), Namespace: cr.Namespace}
+		if err := certmanager.Delete(ctx, rclient, certMeta, cr.ScrapeClientCertSecretName()); err != nil {
+			return fmt.Errorf("cannot delete cert-manager scrape client certificate from prev state: %w", err)
+		}
+	}
 
 	return nil
 }
+
+// withRemoteWriteMirror returns copy of vmagent with remoteWriteMirror target
+// added to remoteWrite targets during mirroring window
+func withRemoteWriteMirror(cr *vmv1beta1.VMAgent) *vmv1beta1.VMAgent {
+	if cr.Spec.RemoteWriteMirror == nil {
+		return cr
+	}
+	targets := cr.RemoteWriteTargets(time.Now())
+	if len(targets) == len(cr.Spec.RemoteWrite) {
+		return cr
+	}
+	cr = cr.DeepCopy()
+	cr.Spec.RemoteWrite = targets
+	return cr
+}
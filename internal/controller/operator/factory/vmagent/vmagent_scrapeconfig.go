@@ -6,6 +6,7 @@ import (
 	"context"
 	stderrors "errors"
 	"fmt"
+	"io"
 	"path"
 	"reflect"
 	"regexp"
@@ -15,6 +16,7 @@ import (
 	"github.com/VictoriaMetrics/metricsql"
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
@@ -46,6 +48,15 @@ type scrapesSecretsCache struct {
 	nsSecretCache        map[string]*corev1.Secret
 	nsCMCache            map[string]*corev1.ConfigMap
 	tlsAssets            map[string]string
+	// probers contains addresses of prober services selected by VMProbe
+	probers map[string][]string
+	// nodePoolShards contains names of node pool shards defined by selected VMNodeScrapes
+	nodePoolShards []string
+	// mountSecretsNamespace is set at mount-and-reference mode of scrape secrets
+	mountSecretsNamespace string
+	mountedSecrets        map[string]map[string]struct{}
+	baFiles               map[string]*basicAuthFiles
+	bearerTokenFiles      map[string]string
 }
 
 type scrapeObjects struct {
@@ -56,11 +67,17 @@ type scrapeObjects struct {
 	prss       []*vmv1beta1.VMProbe
 	scss       []*vmv1beta1.VMScrapeConfig
 	badObjects []scrapeObjectWithStatus
+	// rejectedByLimits holds number of objects rejected by scrapeObjectLimits per kind
+	rejectedByLimits map[string]int
 }
 
 // CreateOrUpdateConfigurationSecret builds scrape configuration for VMAgent
 func CreateOrUpdateConfigurationSecret(ctx context.Context, cr *vmv1beta1.VMAgent, rclient client.Client) error {
-	if _, err := createOrUpdateConfigurationSecret(ctx, cr, rclient); err != nil {
+	cr, err := withRemoteWriteTargets(ctx, rclient, cr)
+	if err != nil {
+		return err
+	}
+	if _, err := createOrUpdateConfigurationSecret(ctx, withRemoteWriteMirror(cr), rclient); err != nil {
 		return err
 	}
 	return nil
@@ -68,47 +85,19 @@ func CreateOrUpdateConfigurationSecret(ctx context.Context, cr *vmv1beta1.VMAgen
 
 func createOrUpdateConfigurationSecret(ctx context.Context, cr *vmv1beta1.VMAgent, rclient client.Client) (*scrapesSecretsCache, error) {
 	if cr.Spec.IngestOnlyMode {
+		updateScrapeObjectsRejectedMetric(cr, &scrapeObjects{})
+		if err := pruneScrapeObjectsSelectedBy(ctx, rclient, selectedByKey(cr), &scrapeObjects{}); err != nil {
+			return nil, fmt.Errorf("cannot update statuses for not selected scrape objects: %w", err)
+		}
 		return nil, nil
 	}
-	sss, err := selectServiceScrapes(ctx, cr, rclient)
-	if err != nil {
-		return nil, fmt.Errorf("selecting ServiceScrapes failed: %w", err)
-	}
-
-	pScrapes, err := selectPodScrapes(ctx, cr, rclient)
-	if err != nil {
-		return nil, fmt.Errorf("selecting PodScrapes failed: %w", err)
-	}
-
-	probes, err := selectVMProbes(ctx, cr, rclient)
+	sos, err := selectScrapeObjects(ctx, cr, rclient)
 	if err != nil {
-		return nil, fmt.Errorf("selecting VMProbes failed: %w", err)
-	}
-
-	nodes, err := selectVMNodeScrapes(ctx, cr, rclient)
-	if err != nil {
-		return nil, fmt.Errorf("selecting VMNodeScrapes failed: %w", err)
-	}
-
-	statics, err := selectStaticScrapes(ctx, cr, rclient)
-	if err != nil {
-		return nil, fmt.Errorf("selecting PodScrapes failed: %w", err)
-	}
-
-	scrapeConfigs, err := selectScrapeConfig(ctx, cr, rclient)
-	if err != nil {
-		return nil, fmt.Errorf("selecting ScrapeConfigs failed: %w", err)
-	}
-	sos := &scrapeObjects{
-		sss:  sss,
-		pss:  pScrapes,
-		prss: probes,
-		nss:  nodes,
-		stss: statics,
-		scss: scrapeConfigs,
+		return nil, err
 	}
+	updateScrapeObjectsRejectedMetric(cr, sos)
 
-	ssCache, err := loadScrapeSecrets(ctx, rclient, sos, cr.Namespace, cr.Spec.APIServerConfig, cr.Spec.RemoteWrite)
+	ssCache, err := loadScrapeSecrets(ctx, rclient, sos, cr.Namespace, cr.Spec.MountScrapeSecrets, cr.Spec.APIServerConfig, cr.Spec.RemoteWrite)
 	if err != nil {
 		return nil, fmt.Errorf("cannot load scrape target secrets: %w", err)
 	}
@@ -122,13 +111,20 @@ func createOrUpdateConfigurationSecret(ctx context.Context, cr *vmv1beta1.VMAgen
 		return nil, fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
 	}
 
+	globalConfig, ignoredGlobalConfigs, err := selectScrapeGlobalConfig(ctx, rclient, cr)
+	if err != nil {
+		return nil, fmt.Errorf("selecting VMScrapeGlobalConfig failed: %w", err)
+	}
+	globalConfigStatus := buildScrapeGlobalConfigStatus(cr, globalConfig, ignoredGlobalConfigs)
+
 	// Update secret based on the most recent configuration.
-	generatedConfig, err := generateConfig(
+	generatedConfig, additionalScrapeConfigsProblems, err := generateConfig(
 		ctx,
 		cr,
 		sos,
 		ssCache,
 		additionalScrapeConfigs,
+		globalConfig,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("generating config for vmagent failed: %w", err)
@@ -139,68 +135,140 @@ func createOrUpdateConfigurationSecret(ctx context.Context, cr *vmv1beta1.VMAgen
 		"generated": "true",
 	}
 
-	// Compress config to avoid 1mb secret limit for a while
-	var buf bytes.Buffer
-	if err = gzipConfig(&buf, generatedConfig); err != nil {
-		return nil, fmt.Errorf("cannot gzip config for vmagent: %w", err)
+	if cr.HasGeneratedConfigAtConfigMap() {
+		if err := checkInlineCredentials(generatedConfig); err != nil {
+			return nil, fmt.Errorf("cannot store generated config at ConfigMap: %w", err)
+		}
+		delete(s.Data, vmagentGzippedFilename)
+		s.Data[vmagentConfigFilename] = generatedConfig
+	} else {
+		// Compress config to avoid 1mb secret limit for a while
+		var buf bytes.Buffer
+		if err = gzipConfig(&buf, generatedConfig); err != nil {
+			return nil, fmt.Errorf("cannot gzip config for vmagent: %w", err)
+		}
+		s.Data[vmagentGzippedFilename] = buf.Bytes()
+	}
+	s.Data, err = reconcile.ConfigRevision(ctx, rclient, cr, s.Name, s.Data)
+	if err != nil {
+		return nil, err
+	}
+	if err := reconcileConfigMapStorage(ctx, rclient, cr, s); err != nil {
+		return nil, err
 	}
-	s.Data[vmagentGzippedFilename] = buf.Bytes()
 	ctx = logger.AddToContext(ctx, logger.WithContext(ctx).WithValues("secret_for", "vmagent promscrape config"))
 
 	if err := reconcile.Secret(ctx, rclient, s); err != nil {
 		return nil, fmt.Errorf("cannot reconcile vmagent config secret: %w", err)
 	}
-	if err := updateStatusesForScrapeObjects(ctx, rclient, sos); err != nil {
+	if err := updateStatusesForScrapeObjects(ctx, rclient, cr, sos); err != nil {
+		return nil, err
+	}
+	if err := updateAdditionalScrapeConfigsStatus(ctx, rclient, cr, additionalScrapeConfigsProblems); err != nil {
+		return nil, err
+	}
+	if err := updateScrapeGlobalConfigStatus(ctx, rclient, cr, globalConfigStatus); err != nil {
 		return nil, err
 	}
 
 	return ssCache, nil
 }
 
-func updateStatusesForScrapeObjects(ctx context.Context, rclient client.Client, sos *scrapeObjects) error {
+// selectScrapeObjects returns scrape objects selected by the given VMAgent
+// unsupported objects and objects exceeding limits are filtered out
+func selectScrapeObjects(ctx context.Context, cr *vmv1beta1.VMAgent, rclient client.Client) (*scrapeObjects, error) {
+	sss, err := selectServiceScrapes(ctx, cr, rclient)
+	if err != nil {
+		return nil, fmt.Errorf("selecting ServiceScrapes failed: %w", err)
+	}
+
+	pScrapes, err := selectPodScrapes(ctx, cr, rclient)
+	if err != nil {
+		return nil, fmt.Errorf("selecting PodScrapes failed: %w", err)
+	}
+
+	probes, err := selectVMProbes(ctx, cr, rclient)
+	if err != nil {
+		return nil, fmt.Errorf("selecting VMProbes failed: %w", err)
+	}
+
+	nodes, err := selectVMNodeScrapes(ctx, cr, rclient)
+	if err != nil {
+		return nil, fmt.Errorf("selecting VMNodeScrapes failed: %w", err)
+	}
+
+	statics, err := selectStaticScrapes(ctx, cr, rclient)
+	if err != nil {
+		return nil, fmt.Errorf("selecting PodScrapes failed: %w", err)
+	}
+
+	scrapeConfigs, err := selectScrapeConfig(ctx, cr, rclient)
+	if err != nil {
+		return nil, fmt.Errorf("selecting ScrapeConfigs failed: %w", err)
+	}
+	sos := &scrapeObjects{
+		sss:  sss,
+		pss:  pScrapes,
+		prss: probes,
+		nss:  nodes,
+		stss: statics,
+		scss: scrapeConfigs,
+	}
+	filterUnsupportedScrapeObjects(ctx, sos)
+	filterDaemonSetModeScrapeObjects(cr, sos)
+	filterScrapeObjectsByScrapeClass(cr, sos)
+	if err := resolveRelabelConfigRefs(ctx, rclient, sos); err != nil {
+		return nil, err
+	}
+	filterScrapeObjectsByLimits(cr, sos)
+	return sos, nil
+}
+
+func updateStatusesForScrapeObjects(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent, sos *scrapeObjects) error {
 	if len(sos.badObjects) > 0 {
 		var errorContexts []string
 		for _, bo := range sos.badObjects {
-			vmagentSecretFetchErrsTotal.Inc()
 			errorContexts = append(errorContexts, fmt.Sprintf("object=%s/%s/%s: sync error: %s", bo.GetObjectKind().GroupVersionKind().Kind, bo.GetNamespace(), bo.GetName(), bo.GetStatus().CurrentSyncError))
 		}
-		logger.WithContext(ctx).Error(fmt.Errorf("found invalid secret references at objects"), "excluding it from configuration", "object_errors", strings.Join(errorContexts, ","))
+		logger.WithContext(ctx).Error(fmt.Errorf("found invalid scrape objects"), "excluding it from configuration", "object_errors", strings.Join(errorContexts, ","))
 	}
-	if err := updateStatusForEach(ctx, rclient, sos.badObjects, vmv1beta1.UpdateStatusFailed); err != nil {
+	vmagentKey := selectedByKey(cr)
+	targets := fetchScrapeTargets(ctx, rclient, cr)
+	if err := updateStatusForEach(ctx, rclient, sos.badObjects, vmv1beta1.UpdateStatusFailed, vmagentKey, targets); err != nil {
 		return fmt.Errorf("cannot update statuses for bad scrape objects: %w", err)
 	}
-	if err := updateStatusForEach(ctx, rclient, sos.sss, vmv1beta1.UpdateStatusOperational); err != nil {
+	if err := updateStatusForEach(ctx, rclient, sos.sss, vmv1beta1.UpdateStatusOperational, vmagentKey, targets); err != nil {
 		return fmt.Errorf("cannot update statuses for service scrape objects: %w", err)
 	}
-	if err := updateStatusForEach(ctx, rclient, sos.pss, vmv1beta1.UpdateStatusOperational); err != nil {
+	if err := updateStatusForEach(ctx, rclient, sos.pss, vmv1beta1.UpdateStatusOperational, vmagentKey, targets); err != nil {
 		return fmt.Errorf("cannot update statuses for pod scrape objects: %w", err)
 	}
-	if err := updateStatusForEach(ctx, rclient, sos.nss, vmv1beta1.UpdateStatusOperational); err != nil {
+	if err := updateStatusForEach(ctx, rclient, sos.nss, vmv1beta1.UpdateStatusOperational, vmagentKey, targets); err != nil {
 		return fmt.Errorf("cannot update statuses for node scrape objects: %w", err)
 	}
-	if err := updateStatusForEach(ctx, rclient, sos.prss, vmv1beta1.UpdateStatusOperational); err != nil {
+	if err := updateStatusForEach(ctx, rclient, sos.prss, vmv1beta1.UpdateStatusOperational, vmagentKey, targets); err != nil {
 		return fmt.Errorf("cannot update statuses for probe scrape objects: %w", err)
 	}
-	if err := updateStatusForEach(ctx, rclient, sos.stss, vmv1beta1.UpdateStatusOperational); err != nil {
+	if err := updateStatusForEach(ctx, rclient, sos.stss, vmv1beta1.UpdateStatusOperational, vmagentKey, targets); err != nil {
 		return fmt.Errorf("cannot update statuses for static scrape objects: %w", err)
 	}
-	if err := updateStatusForEach(ctx, rclient, sos.scss, vmv1beta1.UpdateStatusOperational); err != nil {
+	if err := updateStatusForEach(ctx, rclient, sos.scss, vmv1beta1.UpdateStatusOperational, vmagentKey, targets); err != nil {
 		return fmt.Errorf("cannot update statuses for scrapeconfig scrape objects: %w", err)
 	}
+	if err := pruneScrapeObjectsSelectedBy(ctx, rclient, vmagentKey, sos); err != nil {
+		return fmt.Errorf("cannot update statuses for not selected scrape objects: %w", err)
+	}
 
 	return nil
 }
 
-func updateStatusForEach[T scrapeObjectWithStatus](ctx context.Context, rclient client.Client, iterable []T, desiredStatus vmv1beta1.UpdateStatus) error {
+// updateStatusForEach sets desired status for each object
+// operational objects are marked as selected by VMAgent with targets count, failed objects are unmarked
+func updateStatusForEach[T scrapeObjectWithStatus](ctx context.Context, rclient client.Client, iterable []T, desiredStatus vmv1beta1.UpdateStatus, vmagentKey string, targets map[string]scrapeTargetsCount) error {
 	for _, so := range iterable {
-		cs := so.GetStatus()
-		if cs.Status != desiredStatus {
-			// patch update status
-			pt := client.RawPatch(types.MergePatchType,
-				[]byte(fmt.Sprintf(`{"status": {"lastSyncError":  %q , "status": %q} }`, cs.CurrentSyncError, desiredStatus)))
-			if err := rclient.Status().Patch(ctx, so, pt); err != nil {
-				return fmt.Errorf("failed to patch status of broken scrape object=%q: %w", so.GetName(), err)
-			}
+		selected := desiredStatus == vmv1beta1.UpdateStatusOperational
+		if err := patchScrapeObjectStatus(ctx, rclient, so, desiredStatus, so.GetStatus().CurrentSyncError, vmagentKey, selected, scrapeObjectTargets(so, targets, vmagentKey)); err != nil {
+			return fmt.Errorf("failed to patch status of scrape object=%q: %w", so.GetName(), err)
 		}
 	}
 	return nil
@@ -218,16 +286,21 @@ func forEachCollectSkipNotFound[T scrapeObjectWithStatus](src []T, apply func(s
 	for _, o := range src {
 		if err := apply(o); err != nil {
 			var ne *k8stools.KeyNotFoundError
+			var me *secretMountError
+			var pe *proberConfigError
+			st := o.GetStatus()
 			switch {
-			case stderrors.As(err, &ne):
-				notNotFoundLinks = append(notNotFoundLinks, o)
-			case errors.IsNotFound(err):
-				notNotFoundLinks = append(notNotFoundLinks, o)
+			case stderrors.As(err, &me):
+				st.CurrentSyncError = fmt.Sprintf("cannot mount referenced secret: %s", err)
+			case stderrors.As(err, &pe):
+				st.CurrentSyncError = fmt.Sprintf("incorrect prober configuration: %s", err)
+			case stderrors.As(err, &ne), errors.IsNotFound(err):
+				vmagentSecretFetchErrsTotal.Inc()
+				st.CurrentSyncError = fmt.Sprintf("cannot find refrenced object: %s", err)
 			default:
 				return nil, nil, err
 			}
-			st := o.GetStatus()
-			st.CurrentSyncError = fmt.Sprintf("cannot find refrenced object: %s", err)
+			notNotFoundLinks = append(notNotFoundLinks, o)
 			continue
 		}
 		src[cnt] = o
@@ -237,13 +310,60 @@ func forEachCollectSkipNotFound[T scrapeObjectWithStatus](src []T, apply func(s
 	return src, notNotFoundLinks, nil
 }
 
+// renderEachCollectInvalid renders scrape configs of each object and appends it to dst.
+// Objects with invalid rendered scrape configs are excluded from configuration,
+// returned invalid objects have erased type
+func renderEachCollectInvalid[T scrapeObjectWithStatus](src []T, dst []yaml.MapSlice, render func(s T, idx int) []yaml.MapSlice) ([]T, []yaml.MapSlice, []scrapeObjectWithStatus) {
+	var cnt int
+	var invalid []scrapeObjectWithStatus
+	for idx, o := range src {
+		scs := render(o, idx)
+		if err := validateRenderedScrapeConfigs(scs); err != nil {
+			st := o.GetStatus()
+			st.CurrentSyncError = fmt.Sprintf("cannot render scrape config: %s", err)
+			invalid = append(invalid, o)
+			continue
+		}
+		dst = append(dst, scs...)
+		src[cnt] = o
+		cnt++
+	}
+	return src[:cnt], dst, invalid
+}
+
+// validateRenderedScrapeConfigs checks options of generated scrape configs,
+// which vmagent fails to load the whole configuration with
+func validateRenderedScrapeConfigs(scs []yaml.MapSlice) error {
+	for _, sc := range scs {
+		for _, item := range sc {
+			key, _ := item.Key.(string)
+			switch key {
+			case "relabel_configs", "metric_relabel_configs":
+				if err := parseRelabelConfigsOf(key, item.Value); err != nil {
+					jobName, _ := scrapeConfigJobName(sc)
+					return fmt.Errorf("job_name=%q: %w", jobName, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func loadSecretsToCacheFrom(ctx context.Context, rclient client.Client, ep *vmv1beta1.EndpointAuth, cacheKey, namespace string, ss *scrapesSecretsCache) error {
 	if ep.BasicAuth != nil {
-		credentials, err := loadBasicAuthSecretFromAPI(ctx, rclient, ep.BasicAuth, namespace, ss.nsSecretCache)
-		if err != nil {
-			return fmt.Errorf("cannot load basicAuth secret for=%s: %w", cacheKey, err)
+		if ss.mountSecretsNamespace != "" {
+			files, err := ss.mountBasicAuth(ctx, rclient, ep.BasicAuth, namespace)
+			if err != nil {
+				return fmt.Errorf("cannot mount basicAuth secret for=%s: %w", cacheKey, err)
+			}
+			ss.baFiles[cacheKey] = files
+		} else {
+			credentials, err := loadBasicAuthSecretFromAPI(ctx, rclient, ep.BasicAuth, namespace, ss.nsSecretCache)
+			if err != nil {
+				return fmt.Errorf("cannot load basicAuth secret for=%s: %w", cacheKey, err)
+			}
+			ss.baSecrets[cacheKey] = credentials
 		}
-		ss.baSecrets[cacheKey] = credentials
 	}
 
 	if ep.OAuth2 != nil {
@@ -253,7 +373,13 @@ func loadSecretsToCacheFrom(ctx context.Context, rclient client.Client, ep *vmv1
 		}
 		ss.oauth2Secrets[cacheKey] = oauth2
 	}
-	if ep.BearerTokenSecret != nil && ep.BearerTokenSecret.Name != "" {
+	if ep.BearerTokenSecret != nil && ep.BearerTokenSecret.Name != "" && ss.mountSecretsNamespace != "" {
+		tokenFile, err := ss.mountSecretKey(ctx, rclient, namespace, ep.BearerTokenSecret)
+		if err != nil {
+			return fmt.Errorf("cannot mount bearer secret for=%s: %w", cacheKey, err)
+		}
+		ss.bearerTokenFiles[cacheKey] = tokenFile
+	} else if ep.BearerTokenSecret != nil && ep.BearerTokenSecret.Name != "" {
 		token, err := k8stools.GetCredFromSecret(ctx, rclient, namespace, ep.BearerTokenSecret, buildCacheKey(namespace, ep.BearerTokenSecret.Name), ss.nsSecretCache)
 		if err != nil {
 			return fmt.Errorf("cannot load bearer secret for=%s: %w", cacheKey, err)
@@ -280,6 +406,7 @@ func loadScrapeSecrets(
 	rclient client.Client,
 	sos *scrapeObjects,
 	vmagentCRNamespace string,
+	mountSecrets bool,
 	apiserverConfig *vmv1beta1.APIServerConfig,
 	remoteWriteSpecs []vmv1beta1.VMAgentRemoteWriteSpec,
 ) (*scrapesSecretsCache, error) {
@@ -291,6 +418,13 @@ func loadScrapeSecrets(
 		nsSecretCache:        map[string]*corev1.Secret{},
 		nsCMCache:            map[string]*corev1.ConfigMap{},
 		tlsAssets:            map[string]string{},
+		probers:              map[string][]string{},
+	}
+	if mountSecrets {
+		ssCache.mountSecretsNamespace = vmagentCRNamespace
+		ssCache.mountedSecrets = map[string]map[string]struct{}{}
+		ssCache.baFiles = map[string]*basicAuthFiles{}
+		ssCache.bearerTokenFiles = map[string]string{}
 	}
 	var err error
 	var badObjects []scrapeObjectWithStatus
@@ -375,6 +509,9 @@ func loadScrapeSecrets(
 	badObjects = append(badObjects, tempBo...)
 
 	sos.prss, tempBo, err = forEachCollectSkipNotFound(sos.prss, func(probe *vmv1beta1.VMProbe) error {
+		if err := loadProberConfig(ctx, rclient, probe, ssCache); err != nil {
+			return err
+		}
 		if err := loadSecretsToCacheFrom(ctx, rclient, &probe.Spec.EndpointAuth, probe.AsMapKey(), probe.Namespace, ssCache); err != nil {
 			return err
 		}
@@ -608,6 +745,42 @@ func loadScrapeSecrets(
 				ssCache.bearerTokens[scrapeConfig.AsProxyKey("digitaloceansd", i)] = token
 			}
 		}
+		for i, dc := range scrapeConfig.Spec.DockerSDConfigs {
+			if err := loadSDHTTPClientSecrets(ctx, rclient, scrapeConfig, "dockersd", i, dockerSDHTTPClient(&dc), ssCache); err != nil {
+				return fmt.Errorf("could not load secrets for dockerSDConfigs %d in VMScrapeConfig %s. %w", i, scrapeConfig.Name, err)
+			}
+		}
+		for i, nc := range scrapeConfig.Spec.NomadSDConfigs {
+			if err := loadSDHTTPClientSecrets(ctx, rclient, scrapeConfig, "nomadsd", i, nomadSDHTTPClient(&nc), ssCache); err != nil {
+				return fmt.Errorf("could not load secrets for nomadSDConfigs %d in VMScrapeConfig %s. %w", i, scrapeConfig.Name, err)
+			}
+		}
+		for i, pc := range scrapeConfig.Spec.PuppetDBSDConfigs {
+			if err := loadSDHTTPClientSecrets(ctx, rclient, scrapeConfig, "puppetdbsd", i, puppetDBSDHTTPClient(&pc), ssCache); err != nil {
+				return fmt.Errorf("could not load secrets for puppetDBSDConfigs %d in VMScrapeConfig %s. %w", i, scrapeConfig.Name, err)
+			}
+		}
+		for i, hc := range scrapeConfig.Spec.HetznerSDConfigs {
+			if err := loadSDHTTPClientSecrets(ctx, rclient, scrapeConfig, "hetznersd", i, hetznerSDHTTPClient(&hc), ssCache); err != nil {
+				return fmt.Errorf("could not load secrets for hetznerSDConfigs %d in VMScrapeConfig %s. %w", i, scrapeConfig.Name, err)
+			}
+		}
+		for i, oc := range scrapeConfig.Spec.OVHCloudSDConfigs {
+			if oc.ApplicationSecret != nil {
+				secretValue, err := k8stools.GetCredFromSecret(ctx, rclient, scrapeConfig.Namespace, oc.ApplicationSecret, buildCacheKey(scrapeConfig.Namespace, oc.ApplicationSecret.Name), ssCache.nsSecretCache)
+				if err != nil {
+					return fmt.Errorf("could not read applicationSecret for ovhcloudSDConfigs %d in VMScrapeConfig %s. %w", i, scrapeConfig.Name, err)
+				}
+				ssCache.authorizationSecrets[scrapeConfig.AsMapKey("ovhcloudsd_app", i)] = secretValue
+			}
+			if oc.ConsumerKey != nil {
+				secretValue, err := k8stools.GetCredFromSecret(ctx, rclient, scrapeConfig.Namespace, oc.ConsumerKey, buildCacheKey(scrapeConfig.Namespace, oc.ConsumerKey.Name), ssCache.nsSecretCache)
+				if err != nil {
+					return fmt.Errorf("could not read consumerKey for ovhcloudSDConfigs %d in VMScrapeConfig %s. %w", i, scrapeConfig.Name, err)
+				}
+				ssCache.authorizationSecrets[scrapeConfig.AsMapKey("ovhcloudsd_consumer", i)] = secretValue
+			}
+		}
 
 		return nil
 	})
@@ -644,33 +817,35 @@ func loadScrapeSecrets(
 	// no need to filter out misconfiguration
 	// it's VMAgent owner responsibility
 	for _, rws := range remoteWriteSpecs {
+		// secrets of VMRemoteWriteTarget could be placed at the other namespace
+		rwsNamespace := remoteWriteSecretsNamespace(&rws, vmagentCRNamespace)
 		if rws.BasicAuth != nil {
-			credentials, err := k8stools.LoadBasicAuthSecret(ctx, rclient, vmagentCRNamespace, rws.BasicAuth, ssCache.nsSecretCache)
+			credentials, err := k8stools.LoadBasicAuthSecret(ctx, rclient, rwsNamespace, rws.BasicAuth, ssCache.nsSecretCache)
 			if err != nil {
 				return nil, fmt.Errorf("could not generate basicAuth for remote write spec %s config. %w", rws.URL, err)
 			}
 			ssCache.baSecrets[rws.AsMapKey()] = &credentials
 		}
 		if rws.OAuth2 != nil {
-			oauth2, err := k8stools.LoadOAuthSecrets(ctx, rclient, rws.OAuth2, vmagentCRNamespace, ssCache.nsSecretCache, ssCache.nsCMCache)
+			oauth2, err := k8stools.LoadOAuthSecrets(ctx, rclient, rws.OAuth2, rwsNamespace, ssCache.nsSecretCache, ssCache.nsCMCache)
 			if err != nil {
-				return nil, fmt.Errorf("cannot load oauth2 creds for :%s, ns: %s, err: %w", "remoteWrite", vmagentCRNamespace, err)
+				return nil, fmt.Errorf("cannot load oauth2 creds for :%s, ns: %s, err: %w", "remoteWrite", rwsNamespace, err)
 			}
 			ssCache.oauth2Secrets[rws.AsMapKey()] = oauth2
 		}
 		if rws.BearerTokenSecret != nil && rws.BearerTokenSecret.Name != "" {
-			token, err := k8stools.GetCredFromSecret(ctx, rclient, vmagentCRNamespace, rws.BearerTokenSecret, buildCacheKey(vmagentCRNamespace, rws.BearerTokenSecret.Name), ssCache.nsSecretCache)
+			token, err := k8stools.GetCredFromSecret(ctx, rclient, rwsNamespace, rws.BearerTokenSecret, buildCacheKey(rwsNamespace, rws.BearerTokenSecret.Name), ssCache.nsSecretCache)
 			if err != nil {
 				return nil, fmt.Errorf("cannot get bearer token for remoteWrite: %w", err)
 			}
 			ssCache.bearerTokens[rws.AsMapKey()] = token
 		}
-		if err := addAssetsToCache(ctx, rclient, vmagentCRNamespace, rws.TLSConfig, ssCache); err != nil {
+		if err := addAssetsToCache(ctx, rclient, rwsNamespace, rws.TLSConfig, ssCache); err != nil {
 			return nil, fmt.Errorf("cannot add asset for remote write target: %w", err)
 		}
 
 	}
-	sos.badObjects = badObjects
+	sos.badObjects = append(sos.badObjects, badObjects...)
 
 	return ssCache, nil
 }
@@ -721,12 +896,56 @@ func loadProxySecrets(ctx context.Context, rclient client.Client, proxyCfg *vmv1
 	return
 }
 
+// loadSDHTTPClientSecrets loads secrets of service discovery http client into cache
+// values are stored with scrapeConfig map key for the given sd name and index
+func loadSDHTTPClientSecrets(ctx context.Context, rclient client.Client, scrapeConfig *vmv1beta1.VMScrapeConfig, sdName string, i int, hc *sdHTTPClient, ssCache *scrapesSecretsCache) error {
+	ns := scrapeConfig.Namespace
+	if hc.basicAuth != nil {
+		credentials, err := loadBasicAuthSecretFromAPI(ctx, rclient, hc.basicAuth, ns, ssCache.nsSecretCache)
+		if err != nil {
+			return fmt.Errorf("cannot load basicAuth: %w", err)
+		}
+		ssCache.baSecrets[scrapeConfig.AsMapKey(sdName, i)] = credentials
+	}
+	if hc.authorization != nil && hc.authorization.Credentials != nil {
+		secretValue, err := k8stools.GetCredFromSecret(ctx, rclient, ns, hc.authorization.Credentials, buildCacheKey(ns, hc.authorization.Credentials.Name), ssCache.nsSecretCache)
+		if err != nil {
+			return fmt.Errorf("cannot load authorization: %w", err)
+		}
+		ssCache.authorizationSecrets[scrapeConfig.AsMapKey(sdName, i)] = secretValue
+	}
+	if hc.oauth2 != nil {
+		oauth2, err := k8stools.LoadOAuthSecrets(ctx, rclient, hc.oauth2, ns, ssCache.nsSecretCache, ssCache.nsCMCache)
+		if err != nil {
+			return fmt.Errorf("cannot load oauth2: %w", err)
+		}
+		ssCache.oauth2Secrets[scrapeConfig.AsMapKey(sdName, i)] = oauth2
+	}
+	if hc.proxyClientConfig != nil {
+		ba, token, err := loadProxySecrets(ctx, rclient, hc.proxyClientConfig, ns, ssCache.nsSecretCache)
+		if err != nil {
+			return fmt.Errorf("cannot load proxy auth: %w", err)
+		}
+		if ba != nil {
+			ssCache.baSecrets[scrapeConfig.AsProxyKey(sdName, i)] = ba
+		}
+		ssCache.bearerTokens[scrapeConfig.AsProxyKey(sdName, i)] = token
+		if err := addAssetsToCache(ctx, rclient, ns, hc.proxyClientConfig.TLSConfig, ssCache); err != nil {
+			return fmt.Errorf("cannot add proxy tlsAsset: %w", err)
+		}
+	}
+	if err := addAssetsToCache(ctx, rclient, ns, hc.tlsConfig, ssCache); err != nil {
+		return fmt.Errorf("cannot add tlsAsset: %w", err)
+	}
+	return nil
+}
+
 func loadAdditionalScrapeConfigsSecret(ctx context.Context, rclient client.Client, additionalScrapeConfigs *corev1.SecretKeySelector, namespace string) ([]byte, error) {
 	if additionalScrapeConfigs != nil {
 		var s corev1.Secret
 		if err := rclient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: additionalScrapeConfigs.Name}, &s); err != nil {
 			if errors.IsNotFound(err) {
-				return nil, fmt.Errorf("cannot find secret with additional config for vmagent, secret: %s, namespace: %s", additionalScrapeConfigs.Name, namespace)
+				return nil, fmt.Errorf("cannot find secret with additional config for vmagent, secret: %s, namespace: %s: %w", additionalScrapeConfigs.Name, namespace, err)
 			}
 			return nil, err
 		}
@@ -734,7 +953,7 @@ func loadAdditionalScrapeConfigsSecret(ctx context.Context, rclient client.Clien
 			return c, nil
 		}
 		if additionalScrapeConfigs.Optional == nil || !*additionalScrapeConfigs.Optional {
-			return nil, fmt.Errorf("secret %v could not be found", additionalScrapeConfigs.Name)
+			return nil, fmt.Errorf("secret %v could not be found: %w", additionalScrapeConfigs.Name, k8stools.NewKeyNotFoundError(additionalScrapeConfigs.Key, additionalScrapeConfigs.Name, "secret"))
 		}
 	}
 	return nil, nil
@@ -777,6 +996,15 @@ func gzipConfig(buf *bytes.Buffer, conf []byte) error {
 	return nil
 }
 
+func gunzipConfig(data []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
 func setScrapeIntervalToWithLimit(ctx context.Context, dst *vmv1beta1.EndpointScrapeParams, vmagentCR *vmv1beta1.VMAgent) {
 	if dst.ScrapeInterval == "" {
 		dst.ScrapeInterval = dst.Interval
@@ -835,7 +1063,8 @@ func generateConfig(
 	sos *scrapeObjects,
 	secretsCache *scrapesSecretsCache,
 	additionalScrapeConfigs []byte,
-) ([]byte, error) {
+	globalConfig *vmv1beta1.VMScrapeGlobalConfig,
+) ([]byte, []string, error) {
 	cfg := yaml.MapSlice{}
 	if !config.IsClusterWideAccessAllowed() && cr.IsOwnsServiceAccount() {
 		logger.WithContext(ctx).Info("Setting discovery for the single namespace only." +
@@ -844,13 +1073,21 @@ func generateConfig(
 		cr.Spec.IgnoreNamespaceSelectors = true
 	}
 
+	if globalConfig != nil {
+		if cr.Spec.ScrapeInterval == "" {
+			cr.Spec.ScrapeInterval = globalConfig.Spec.ScrapeInterval
+		}
+		if cr.Spec.ScrapeTimeout == "" {
+			cr.Spec.ScrapeTimeout = globalConfig.Spec.ScrapeTimeout
+		}
+	}
 	if cr.Spec.ScrapeInterval == "" {
 		cr.Spec.ScrapeInterval = defaultScrapeInterval
 	}
 
 	globalItems := yaml.MapSlice{
 		{Key: "scrape_interval", Value: cr.Spec.ScrapeInterval},
-		{Key: "external_labels", Value: buildExternalLabels(cr)},
+		{Key: "external_labels", Value: buildExternalLabels(cr, globalConfig)},
 	}
 	if cr.Spec.ScrapeTimeout != "" {
 		globalItems = append(globalItems, yaml.MapItem{
@@ -864,9 +1101,11 @@ func generateConfig(
 	apiserverConfig := cr.Spec.APIServerConfig
 
 	var scrapeConfigs []yaml.MapSlice
-	for _, ss := range sos.sss {
+	var invalid []scrapeObjectWithStatus
+	sos.sss, scrapeConfigs, invalid = renderEachCollectInvalid(sos.sss, scrapeConfigs, func(ss *vmv1beta1.VMServiceScrape, _ int) []yaml.MapSlice {
+		var dst []yaml.MapSlice
 		for i, ep := range ss.Spec.Endpoints {
-			scrapeConfigs = append(scrapeConfigs,
+			dst = append(dst,
 				generateServiceScrapeConfig(
 					ctx,
 					cr,
@@ -877,10 +1116,14 @@ func generateConfig(
 					cr.Spec.VMAgentSecurityEnforcements,
 				))
 		}
-	}
-	for _, identifier := range sos.pss {
+		return withNodeLocalDiscovery(cr, dst)
+	})
+	sos.badObjects = append(sos.badObjects, invalid...)
+
+	sos.pss, scrapeConfigs, invalid = renderEachCollectInvalid(sos.pss, scrapeConfigs, func(identifier *vmv1beta1.VMPodScrape, _ int) []yaml.MapSlice {
+		var dst []yaml.MapSlice
 		for i, ep := range identifier.Spec.PodMetricsEndpoints {
-			scrapeConfigs = append(scrapeConfigs,
+			dst = append(dst,
 				generatePodScrapeConfig(
 					ctx,
 					cr,
@@ -890,10 +1133,12 @@ func generateConfig(
 					cr.Spec.VMAgentSecurityEnforcements,
 				))
 		}
-	}
+		return withNodeLocalDiscovery(cr, dst)
+	})
+	sos.badObjects = append(sos.badObjects, invalid...)
 
-	for i, identifier := range sos.prss {
-		scrapeConfigs = append(scrapeConfigs,
+	sos.prss, scrapeConfigs, invalid = renderEachCollectInvalid(sos.prss, scrapeConfigs, func(identifier *vmv1beta1.VMProbe, i int) []yaml.MapSlice {
+		return []yaml.MapSlice{
 			generateProbeConfig(
 				ctx,
 				cr,
@@ -902,24 +1147,35 @@ func generateConfig(
 				apiserverConfig,
 				secretsCache,
 				cr.Spec.VMAgentSecurityEnforcements,
-			))
-	}
-	for i, identifier := range sos.nss {
-		scrapeConfigs = append(scrapeConfigs,
-			generateNodeScrapeConfig(
-				ctx,
-				cr,
-				identifier,
-				i,
-				apiserverConfig,
-				secretsCache,
-				cr.Spec.VMAgentSecurityEnforcements,
-			))
-	}
+			),
+		}
+	})
+	sos.badObjects = append(sos.badObjects, invalid...)
+
+	secretsCache.nodePoolShards = collectNodePoolShards(cr, sos.nss)
+	nodeScrapesStart := len(scrapeConfigs)
+	sos.nss, scrapeConfigs, invalid = renderEachCollectInvalid(sos.nss, scrapeConfigs, func(identifier *vmv1beta1.VMNodeScrape, i int) []yaml.MapSlice {
+		cfg := generateNodeScrapeConfig(
+			ctx,
+			cr,
+			identifier,
+			i,
+			apiserverConfig,
+			secretsCache,
+			cr.Spec.VMAgentSecurityEnforcements,
+		)
+		if len(secretsCache.nodePoolShards) > 0 {
+			cfg = addNodePoolShardFilter(cfg, identifier.Spec.NodePoolShard)
+		}
+		return withNodeLocalDiscovery(cr, []yaml.MapSlice{cfg})
+	})
+	sos.badObjects = append(sos.badObjects, invalid...)
+	nodeScrapesEnd := len(scrapeConfigs)
 
-	for _, identifier := range sos.stss {
+	sos.stss, scrapeConfigs, invalid = renderEachCollectInvalid(sos.stss, scrapeConfigs, func(identifier *vmv1beta1.VMStaticScrape, _ int) []yaml.MapSlice {
+		var dst []yaml.MapSlice
 		for i, ep := range identifier.Spec.TargetEndpoints {
-			scrapeConfigs = append(scrapeConfigs,
+			dst = append(dst,
 				generateStaticScrapeConfig(
 					ctx,
 					cr,
@@ -929,37 +1185,217 @@ func generateConfig(
 					cr.Spec.VMAgentSecurityEnforcements,
 				))
 		}
-	}
+		return dst
+	})
+	sos.badObjects = append(sos.badObjects, invalid...)
 
-	for _, identifier := range sos.scss {
-		scrapeConfigs = append(scrapeConfigs,
+	sos.scss, scrapeConfigs, invalid = renderEachCollectInvalid(sos.scss, scrapeConfigs, func(identifier *vmv1beta1.VMScrapeConfig, _ int) []yaml.MapSlice {
+		return []yaml.MapSlice{
 			generateScrapeConfig(
 				ctx,
 				cr,
 				identifier,
 				secretsCache,
 				cr.Spec.VMAgentSecurityEnforcements,
-			))
-	}
+			),
+		}
+	})
+	sos.badObjects = append(sos.badObjects, invalid...)
 
-	var additionalScrapeConfigsYaml []yaml.MapSlice
-	if err := yaml.Unmarshal(additionalScrapeConfigs, &additionalScrapeConfigsYaml); err != nil {
-		return nil, fmt.Errorf("unmarshalling additional scrape configs failed: %w", err)
-	}
+	addScrapeGlobalConfigDefaultsTo(scrapeConfigs, globalConfig)
+
+	additionalScrapeConfigsYaml, problems := lintAdditionalScrapeConfigs(additionalScrapeConfigs, scrapeConfigs)
 
 	var inlineScrapeConfigsYaml []yaml.MapSlice
 	if len(cr.Spec.InlineScrapeConfig) > 0 {
 		if err := yaml.Unmarshal([]byte(cr.Spec.InlineScrapeConfig), &inlineScrapeConfigsYaml); err != nil {
-			return nil, fmt.Errorf("unmarshalling  inline additional scrape configs failed: %w", err)
+			return nil, nil, fmt.Errorf("unmarshalling  inline additional scrape configs failed: %w", err)
 		}
 	}
 	additionalScrapeConfigsYaml = append(additionalScrapeConfigsYaml, inlineScrapeConfigsYaml...)
+	if len(secretsCache.nodePoolShards) > 0 {
+		// node pool shards scrape only targets of VMNodeScrapes assigned to them
+		for i := range scrapeConfigs {
+			if i < nodeScrapesStart || i >= nodeScrapesEnd {
+				scrapeConfigs[i] = addNodePoolShardFilter(scrapeConfigs[i], "")
+			}
+		}
+		for i := range additionalScrapeConfigsYaml {
+			additionalScrapeConfigsYaml[i] = addNodePoolShardFilter(additionalScrapeConfigsYaml[i], "")
+		}
+	}
 	cfg = append(cfg, yaml.MapItem{
 		Key:   "scrape_configs",
 		Value: append(scrapeConfigs, additionalScrapeConfigsYaml...),
 	})
 
-	return yaml.Marshal(cfg)
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, problems, nil
+}
+
+// GeneratedConfig returns scrape configuration generated by operator for the given VMAgent
+func GeneratedConfig(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent) ([]byte, error) {
+	if cr.HasGeneratedConfigAtConfigMap() {
+		var cm corev1.ConfigMap
+		if err := rclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.PrefixedName()}, &cm); err != nil {
+			return nil, err
+		}
+		data, ok := cm.Data[vmagentConfigFilename]
+		if !ok {
+			return nil, fmt.Errorf("cannot find key=%q at configmap=%q", vmagentConfigFilename, cm.Name)
+		}
+		return []byte(data), nil
+	}
+	var s corev1.Secret
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.PrefixedName()}, &s); err != nil {
+		return nil, err
+	}
+	data, ok := s.Data[vmagentGzippedFilename]
+	if !ok {
+		return nil, fmt.Errorf("cannot find key=%q at secret=%q", vmagentGzippedFilename, s.Name)
+	}
+	conf, err := gunzipConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("cannot gunzip config of vmagent: %w", err)
+	}
+	return conf, nil
+}
+
+// ValidateConfig renders scrape configuration of the given VMAgent in memory without applying any changes
+// if allowMissingSecrets is set, not existing secrets and keys referenced by VMAgent are not rejected
+func ValidateConfig(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent, allowMissingSecrets bool) error {
+	skipMissing := func(err error) error {
+		if allowMissingSecrets && k8stools.IsMissingSecretError(err) {
+			return nil
+		}
+		return err
+	}
+	cr, err := withRemoteWriteTargets(ctx, rclient, cr)
+	if err != nil {
+		return err
+	}
+	// generateConfig modifies spec of the given object
+	cr = withRemoteWriteMirror(cr).DeepCopy()
+	sos := &scrapeObjects{}
+	if !cr.Spec.IngestOnlyMode {
+		sos, err = selectScrapeObjects(ctx, cr, rclient)
+		if err != nil {
+			return err
+		}
+	}
+	ssCache, err := loadScrapeSecrets(ctx, rclient, sos, cr.Namespace, cr.Spec.MountScrapeSecrets, cr.Spec.APIServerConfig, cr.Spec.RemoteWrite)
+	if err != nil {
+		return skipMissing(fmt.Errorf("cannot load scrape target secrets: %w", err))
+	}
+	if cr.Spec.IngestOnlyMode {
+		return nil
+	}
+	additionalScrapeConfigs, err := loadAdditionalScrapeConfigsSecret(ctx, rclient, cr.Spec.AdditionalScrapeConfigs, cr.Namespace)
+	if err != nil {
+		if err = skipMissing(err); err != nil {
+			return fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
+		}
+	}
+	globalConfig, _, err := selectScrapeGlobalConfig(ctx, rclient, cr)
+	if err != nil {
+		return fmt.Errorf("selecting VMScrapeGlobalConfig failed: %w", err)
+	}
+	generatedConfig, _, err := generateConfig(ctx, cr, sos, ssCache, additionalScrapeConfigs, globalConfig)
+	if err != nil {
+		return fmt.Errorf("generating config for vmagent failed: %w", err)
+	}
+	if cr.HasGeneratedConfigAtConfigMap() {
+		if err := checkInlineCredentials(generatedConfig); err != nil {
+			return fmt.Errorf("cannot store generated config at ConfigMap: %w", err)
+		}
+	}
+	return nil
+}
+
+// reconcileConfigMapStorage moves plain text configuration from the given secret data into ConfigMap
+// if generatedConfigStorage was switched back to secret, previously created ConfigMap is removed
+func reconcileConfigMapStorage(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent, s *corev1.Secret) error {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            cr.PrefixedName(),
+			Namespace:       cr.Namespace,
+			Annotations:     cr.AnnotationsFiltered(),
+			Labels:          cr.AllLabels(),
+			OwnerReferences: cr.AsOwner(),
+			Finalizers:      finalize.ChildFinalizers(),
+		},
+	}
+	if !cr.HasGeneratedConfigAtConfigMap() {
+		prevSpec := cr.ParsedLastAppliedSpec
+		if prevSpec != nil && prevSpec.GeneratedConfigStorage == vmv1beta1.GeneratedConfigStorageConfigMap {
+			if err := finalize.SafeDeleteWithFinalizer(ctx, rclient, cm); err != nil {
+				return fmt.Errorf("cannot remove vmagent config configmap: %w", err)
+			}
+		}
+		return nil
+	}
+	data, ok := s.Data[vmagentConfigFilename]
+	if !ok {
+		return fmt.Errorf("cannot find key=%q at generated config, configuration revision stored with generatedConfigStorage=secret cannot be applied", vmagentConfigFilename)
+	}
+	delete(s.Data, vmagentConfigFilename)
+	cm.Data = map[string]string{vmagentConfigFilename: string(data)}
+	if err := reconcile.ConfigMap(ctx, rclient, cm); err != nil {
+		return fmt.Errorf("cannot reconcile vmagent config configmap: %w", err)
+	}
+	return nil
+}
+
+// inlineCredentialKeys defines keys of generated configuration, which hold secret values
+var inlineCredentialKeys = map[string]struct{}{
+	"password":                      {},
+	"bearer_token":                  {},
+	"proxy_bearer_token":            {},
+	"credentials":                   {},
+	"client_secret":                 {},
+	"token":                         {},
+	"consul_token":                  {},
+	"secret_key":                    {},
+	"application_secret":            {},
+	"application_credential_secret": {},
+	"consumer_key":                  {},
+}
+
+// checkInlineCredentials returns error if generated configuration contains secret values
+// such configuration cannot be stored at ConfigMap
+func checkInlineCredentials(data []byte) error {
+	var cfg any
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return fmt.Errorf("cannot parse generated config: %w", err)
+	}
+	var check func(path string, v any) error
+	check = func(path string, v any) error {
+		switch v := v.(type) {
+		case map[any]any:
+			for k, item := range v {
+				key := fmt.Sprintf("%v", k)
+				if _, ok := inlineCredentialKeys[key]; ok {
+					if s, ok := item.(string); ok && s != "" {
+						return fmt.Errorf("generated config contains inline credentials at %s.%s, use mountScrapeSecrets or *_file options instead", path, key)
+					}
+				}
+				if err := check(path+"."+key, item); err != nil {
+					return err
+				}
+			}
+		case []any:
+			for i, item := range v {
+				if err := check(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	return check("config", cfg)
 }
 
 func makeConfigSecret(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) *corev1.Secret {
@@ -970,7 +1406,7 @@ func makeConfigSecret(cr *vmv1beta1.VMAgent, ssCache *scrapesSecretsCache) *core
 			Labels:          cr.AllLabels(),
 			Namespace:       cr.Namespace,
 			OwnerReferences: cr.AsOwner(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Data: map[string][]byte{
 			vmagentGzippedFilename: {},
@@ -1270,8 +1706,13 @@ func enforceNamespaceLabel(relabelings []yaml.MapSlice, namespace, enforcedNames
 	})
 }
 
-func buildExternalLabels(p *vmv1beta1.VMAgent) yaml.MapSlice {
+func buildExternalLabels(p *vmv1beta1.VMAgent, globalConfig *vmv1beta1.VMScrapeGlobalConfig) yaml.MapSlice {
 	m := map[string]string{}
+	if globalConfig != nil {
+		for n, v := range globalConfig.Spec.ExternalLabels {
+			m[n] = v
+		}
+	}
 
 	// Use "prometheus" external label name by default if field is missing.
 	// in case of migration from prometheus to vmagent, it helps to have same labels
@@ -1542,6 +1983,8 @@ func addEndpointAuthTo(cfg yaml.MapSlice, ac vmv1beta1.EndpointAuth, key string,
 	if ac.BearerTokenSecret != nil && ac.BearerTokenSecret.Name != "" {
 		if s, ok := ssCache.bearerTokens[key]; ok {
 			cfg = append(cfg, yaml.MapItem{Key: "bearer_token", Value: s})
+		} else if f, ok := ssCache.bearerTokenFiles[key]; ok {
+			cfg = append(cfg, yaml.MapItem{Key: "bearer_token_file", Value: f})
 		}
 	}
 	if ac.BasicAuth != nil {
@@ -1553,6 +1996,13 @@ func addEndpointAuthTo(cfg yaml.MapSlice, ac vmv1beta1.EndpointAuth, key string,
 			if len(s.Password) > 0 {
 				bac = append(bac, yaml.MapItem{Key: "password", Value: s.Password})
 			}
+		} else if f, ok := ssCache.baFiles[key]; ok {
+			bac = append(bac,
+				yaml.MapItem{Key: "username_file", Value: f.usernameFile},
+			)
+			if len(f.passwordFile) > 0 {
+				bac = append(bac, yaml.MapItem{Key: "password_file", Value: f.passwordFile})
+			}
 		}
 		if len(ac.BasicAuth.PasswordFile) > 0 {
 			bac = append(bac, yaml.MapItem{Key: "password_file", Value: ac.BasicAuth.PasswordFile})
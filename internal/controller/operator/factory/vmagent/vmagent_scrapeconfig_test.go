@@ -12,9 +12,11 @@ import (
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/config"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -1004,3 +1006,276 @@ scrape_configs:
 		})
 	}
 }
+
+func TestGenerateConfigWithInvalidScrapeObjects(t *testing.T) {
+	f := func(sos *scrapeObjects, wantJobs []string, wantBad map[string]string) {
+		t.Helper()
+		cr := &vmv1beta1.VMAgent{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		}
+		data, _, err := generateConfig(context.Background(), cr, sos, &scrapesSecretsCache{}, nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		var cfg struct {
+			ScrapeConfigs []struct {
+				JobName string `yaml:"job_name"`
+			} `yaml:"scrape_configs"`
+		}
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			t.Fatalf("cannot parse generated config: %s", err)
+		}
+		var gotJobs []string
+		for _, sc := range cfg.ScrapeConfigs {
+			gotJobs = append(gotJobs, sc.JobName)
+		}
+		assert.Equal(t, wantJobs, gotJobs)
+		gotBad := make(map[string]string)
+		for _, bo := range sos.badObjects {
+			gotBad[bo.GetName()] = bo.GetStatus().CurrentSyncError
+		}
+		assert.Equal(t, wantBad, gotBad)
+	}
+	serviceScrape := func(name string, metricRelabelConfigs ...*vmv1beta1.RelabelConfig) *vmv1beta1.VMServiceScrape {
+		return &vmv1beta1.VMServiceScrape{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: vmv1beta1.VMServiceScrapeSpec{
+				Endpoints: []vmv1beta1.Endpoint{{
+					Port:                "http",
+					EndpointRelabelings: vmv1beta1.EndpointRelabelings{MetricRelabelConfigs: metricRelabelConfigs},
+				}},
+			},
+		}
+	}
+
+	// all objects are valid
+	f(&scrapeObjects{
+		sss: []*vmv1beta1.VMServiceScrape{serviceScrape("first"), serviceScrape("second")},
+	}, []string{"serviceScrape/default/first/0", "serviceScrape/default/second/0"}, map[string]string{})
+
+	// object with bad regex is excluded
+	f(&scrapeObjects{
+		sss: []*vmv1beta1.VMServiceScrape{
+			serviceScrape("bad", &vmv1beta1.RelabelConfig{SourceLabels: []string{"__name__"}, Regex: vmv1beta1.StringOrArray{"go_("}, Action: "drop"}),
+			serviceScrape("good"),
+		},
+	}, []string{"serviceScrape/default/good/0"}, map[string]string{
+		"bad": `cannot render scrape config: job_name="serviceScrape/default/bad/0": cannot parse metric_relabel_configs: error when parsing ` + "`relabel_config` #1: cannot parse `regex`" + ` "^(?:go_()$": error parsing regexp: missing closing ): ` + "`^(?:go_()$`",
+	})
+}
+
+func TestValidateConfig(t *testing.T) {
+	f := func(cr *vmv1beta1.VMAgent, allowMissingSecrets, wantErr bool, predefinedObjects ...runtime.Object) {
+		t.Helper()
+		fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		scrapeObjectsRejected.Reset()
+		err := ValidateConfig(context.Background(), fclient, cr, allowMissingSecrets)
+		if (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+		// dry-run must not change any object
+		clientStats := fclient.(*k8stools.TestClientWithStatsTrack)
+		assert.Zero(t, clientStats.CreateCalls.Load())
+		assert.Zero(t, clientStats.UpdateCalls.Load())
+		// and must not update metrics
+		assert.Equal(t, 0, testutil.CollectAndCount(scrapeObjectsRejected))
+	}
+	vmagent := func(modify func(spec *vmv1beta1.VMAgentSpec)) *vmv1beta1.VMAgent {
+		cr := &vmv1beta1.VMAgent{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: vmv1beta1.VMAgentSpec{
+				RemoteWrite: []vmv1beta1.VMAgentRemoteWriteSpec{{URL: "http://some-url"}},
+			},
+		}
+		modify(&cr.Spec)
+		return cr
+	}
+	withRemoteWriteBasicAuth := func(spec *vmv1beta1.VMAgentSpec) {
+		spec.RemoteWrite[0].BasicAuth = &vmv1beta1.BasicAuth{
+			Username: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "rw-auth"},
+				Key:                  "username",
+			},
+		}
+	}
+	withAdditionalScrapeConfigs := func(spec *vmv1beta1.VMAgentSpec) {
+		spec.AdditionalScrapeConfigs = &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "additional"},
+			Key:                  "scrape.yaml",
+		}
+	}
+	rwAuthSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "rw-auth", Namespace: "default"},
+		Data:       map[string][]byte{"username": []byte("user")},
+	}
+
+	// valid config
+	f(vmagent(func(*vmv1beta1.VMAgentSpec) {}), false, false)
+
+	// remote write basic auth secret exists
+	f(vmagent(withRemoteWriteBasicAuth), false, false, rwAuthSecret)
+
+	// missing remote write basic auth secret
+	f(vmagent(withRemoteWriteBasicAuth), false, true)
+
+	// missing remote write basic auth secret allowed
+	f(vmagent(withRemoteWriteBasicAuth), true, false)
+
+	// missing additional scrape configs secret
+	f(vmagent(withAdditionalScrapeConfigs), false, true)
+
+	// missing additional scrape configs secret allowed
+	f(vmagent(withAdditionalScrapeConfigs), true, false)
+
+	// invalid inline scrape config
+	f(vmagent(func(spec *vmv1beta1.VMAgentSpec) {
+		spec.InlineScrapeConfig = "job_name: not-a-list"
+	}), true, true)
+
+	// scrape object rejected by limits
+	f(vmagent(func(spec *vmv1beta1.VMAgentSpec) {
+		spec.ServiceScrapeSelector = &metav1.LabelSelector{}
+		spec.ScrapeObjectLimits = &vmv1beta1.ScrapeObjectLimits{
+			ScrapeLimits: vmv1beta1.ScrapeLimits{MaxTargets: ptr.To[int32](1)},
+		}
+	}), false, false, &vmv1beta1.VMServiceScrape{
+		ObjectMeta: metav1.ObjectMeta{Name: "many-endpoints", Namespace: "default"},
+		Spec: vmv1beta1.VMServiceScrapeSpec{Endpoints: []vmv1beta1.Endpoint{
+			{Port: "http"}, {Port: "metrics"},
+		}},
+	})
+}
+
+func TestCheckInlineCredentials(t *testing.T) {
+	f := func(cfg string, wantErr bool) {
+		t.Helper()
+		err := checkInlineCredentials([]byte(cfg))
+		if (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+	}
+
+	// no credentials
+	f(`
+global:
+  scrape_interval: 30s
+scrape_configs:
+- job_name: test
+  static_configs:
+  - targets: [localhost:8429]
+`, false)
+
+	// credentials mounted from files
+	f(`
+scrape_configs:
+- job_name: test
+  basic_auth:
+    username: user
+    password_file: /etc/vmagent/secrets/auth/password
+  bearer_token_file: /var/run/secrets/token
+`, false)
+
+	// empty password
+	f(`
+scrape_configs:
+- job_name: test
+  basic_auth:
+    username: user
+    password: ""
+`, false)
+
+	// inline basic auth password
+	f(`
+scrape_configs:
+- job_name: test
+  basic_auth:
+    username: user
+    password: pass
+`, true)
+
+	// inline credentials at service discovery config
+	f(`
+scrape_configs:
+- job_name: test
+  consul_sd_configs:
+  - server: consul:8500
+    token: consul-token
+`, true)
+
+	// inline oauth2 client secret
+	f(`
+scrape_configs:
+- job_name: test
+  oauth2:
+    client_id: id
+    client_secret: secret
+`, true)
+}
+
+func TestCreateOrUpdateConfigurationAtConfigMap(t *testing.T) {
+	ctx := context.Background()
+	cr := &vmv1beta1.VMAgent{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: vmv1beta1.VMAgentSpec{
+			GeneratedConfigStorage: vmv1beta1.GeneratedConfigStorageConfigMap,
+			InlineScrapeConfig: `
+- job_name: inline
+  static_configs:
+  - targets: [localhost:8429]
+`,
+		},
+	}
+	fclient := k8stools.GetTestClientWithObjects(nil)
+	if _, err := createOrUpdateConfigurationSecret(ctx, cr, fclient); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	nn := types.NamespacedName{Namespace: cr.Namespace, Name: cr.PrefixedName()}
+	var cm corev1.ConfigMap
+	if err := fclient.Get(ctx, nn, &cm); err != nil {
+		t.Fatalf("cannot get config configmap: %s", err)
+	}
+	assert.Contains(t, cm.Data[vmagentConfigFilename], "job_name: inline")
+	var s corev1.Secret
+	if err := fclient.Get(ctx, nn, &s); err != nil {
+		t.Fatalf("cannot get config secret: %s", err)
+	}
+	assert.NotContains(t, s.Data, vmagentGzippedFilename)
+	assert.NotContains(t, s.Data, vmagentConfigFilename)
+	data, err := GeneratedConfig(ctx, fclient, cr)
+	if err != nil {
+		t.Fatalf("cannot get generated config: %s", err)
+	}
+	assert.Equal(t, cm.Data[vmagentConfigFilename], string(data))
+
+	// inline credentials cannot be stored at configmap
+	withCreds := cr.DeepCopy()
+	withCreds.Spec.InlineScrapeConfig = `
+- job_name: inline
+  basic_auth:
+    username: user
+    password: pass
+  static_configs:
+  - targets: [localhost:8429]
+`
+	if _, err := createOrUpdateConfigurationSecret(ctx, withCreds, fclient); err == nil {
+		t.Fatalf("expected error for inline credentials")
+	}
+	if err := ValidateConfig(ctx, fclient, withCreds, false); err == nil {
+		t.Fatalf("expected validation error for inline credentials")
+	}
+
+	// switch back to secret storage removes configmap
+	prevSpec := cr.Spec.DeepCopy()
+	cr.Spec.GeneratedConfigStorage = ""
+	cr.ParsedLastAppliedSpec = prevSpec
+	if _, err := createOrUpdateConfigurationSecret(ctx, cr, fclient); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := fclient.Get(ctx, nn, &cm); !k8serrors.IsNotFound(err) {
+		t.Fatalf("config configmap must be removed, got error: %v", err)
+	}
+	if err := fclient.Get(ctx, nn, &s); err != nil {
+		t.Fatalf("cannot get config secret: %s", err)
+	}
+	assert.Contains(t, s.Data, vmagentGzippedFilename)
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/yaml.v3"
 	appsv1 "k8s.io/api/apps/v1"
+	v2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/api/autoscaling/v2beta2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -671,6 +673,110 @@ func TestCreateOrUpdateVMAgent(t *testing.T) {
 	}
 }
 
+func TestCreateOrUpdateVMAgentWithHPA(t *testing.T) {
+	cr := &vmv1beta1.VMAgent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "example-agent",
+			Namespace: "default",
+		},
+		Spec: vmv1beta1.VMAgentSpec{
+			RemoteWrite: []vmv1beta1.VMAgentRemoteWriteSpec{
+				{URL: "http://remote-write"},
+			},
+			CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+				ReplicaCount: ptr.To[int32](1),
+			},
+			HPA: &vmv1beta1.EmbeddedHPA{
+				MinReplicas: ptr.To[int32](1),
+				MaxReplicas: 5,
+				Behaviour:   &v2beta2.HorizontalPodAutoscalerBehavior{},
+			},
+		},
+	}
+	// replicas were changed by HPA
+	existingDeploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cr.PrefixedName(),
+			Namespace: cr.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](3),
+		},
+		Status: appsv1.DeploymentStatus{
+			Conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentProgressing, Reason: "NewReplicaSetAvailable", Status: "True"},
+			},
+		},
+	}
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{existingDeploy})
+	ctx := context.TODO()
+	if err := CreateOrUpdateVMAgent(ctx, cr, fclient); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var got appsv1.Deployment
+	if err := fclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.PrefixedName()}, &got); err != nil {
+		t.Fatalf("cannot get deployment: %s", err)
+	}
+	if *got.Spec.Replicas != 3 {
+		t.Fatalf("operator must not change replicas managed by HPA, got: %d, want: 3", *got.Spec.Replicas)
+	}
+	var hpa v2.HorizontalPodAutoscaler
+	if err := fclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.PrefixedName()}, &hpa); err != nil {
+		t.Fatalf("cannot get hpa: %s", err)
+	}
+	if hpa.Spec.ScaleTargetRef.Kind != "Deployment" || hpa.Spec.ScaleTargetRef.Name != cr.PrefixedName() {
+		t.Fatalf("unexpected hpa scale target: %v", hpa.Spec.ScaleTargetRef)
+	}
+	if hpa.Spec.MaxReplicas != 5 {
+		t.Fatalf("unexpected hpa maxReplicas, got: %d, want: 5", hpa.Spec.MaxReplicas)
+	}
+}
+
+func TestCreateOrUpdateVMAgentShardsWithOverridePatches(t *testing.T) {
+	cr := &vmv1beta1.VMAgent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "example-agent",
+			Namespace: "default",
+		},
+		Spec: vmv1beta1.VMAgentSpec{
+			RemoteWrite: []vmv1beta1.VMAgentRemoteWriteSpec{
+				{URL: "http://remote-write"},
+			},
+			ShardCount: ptr.To(2),
+			CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+				OverridePatches: []vmv1beta1.OverridePatch{{Kind: "Deployment", Name: "vmagent-example-agent-1", Patch: `{"spec":{"minReadySeconds":10}}`}},
+			},
+		},
+	}
+	cr.ParsedLastAppliedSpec = cr.Spec.DeepCopy()
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{
+		k8stools.NewReadyDeployment("vmagent-example-agent-0", "default"),
+		k8stools.NewReadyDeployment("vmagent-example-agent-1", "default"),
+	})
+	ctx := context.TODO()
+	if err := CreateOrUpdateVMAgent(ctx, cr, fclient); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	f := func(name string, wantMinReadySeconds int32) {
+		t.Helper()
+		var got appsv1.Deployment
+		if err := fclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: name}, &got); err != nil {
+			t.Fatalf("cannot get deployment=%q: %s", name, err)
+		}
+		assert.Equal(t, wantMinReadySeconds, got.Spec.MinReadySeconds)
+	}
+	f("vmagent-example-agent-0", 0)
+	f("vmagent-example-agent-1", 10)
+
+	// patched shards with unchanged spec must not be updated
+	clientStats := fclient.(*k8stools.TestClientWithStatsTrack)
+	updateCalls := clientStats.UpdateCalls.Load()
+	if err := CreateOrUpdateVMAgent(ctx, cr, fclient); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, updateCalls, clientStats.UpdateCalls.Load())
+}
+
 func Test_loadTLSAssets(t *testing.T) {
 	type args struct {
 		servicescrapes []*vmv1beta1.VMServiceScrape
@@ -951,7 +1057,7 @@ func Test_loadTLSAssets(t *testing.T) {
 				nss:  tt.args.nodes,
 				stss: tt.args.statics,
 			}
-			got, err := loadScrapeSecrets(context.TODO(), fclient, sos, tt.args.cr.Namespace, tt.args.cr.Spec.APIServerConfig, tt.args.cr.Spec.RemoteWrite)
+			got, err := loadScrapeSecrets(context.TODO(), fclient, sos, tt.args.cr.Namespace, tt.args.cr.Spec.MountScrapeSecrets, tt.args.cr.Spec.APIServerConfig, tt.args.cr.Spec.RemoteWrite)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("loadTLSAssets() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1372,6 +1478,37 @@ func TestBuildRemoteWrites(t *testing.T) {
 	}
 }
 
+func TestBuildRemoteWritesWithMirror(t *testing.T) {
+	f := func(start, stop time.Time, want []string) {
+		t.Helper()
+		cr := &vmv1beta1.VMAgent{
+			Spec: vmv1beta1.VMAgentSpec{
+				RemoteWrite: []vmv1beta1.VMAgentRemoteWriteSpec{{URL: "http://old-storage:8428/api/v1/write"}},
+				RemoteWriteMirror: &vmv1beta1.VMAgentRemoteWriteMirror{
+					VMAgentRemoteWriteSpec: vmv1beta1.VMAgentRemoteWriteSpec{URL: "http://new-storage:8428/api/v1/write"},
+					StartTime:              &metav1.Time{Time: start},
+					StopTime:               metav1.Time{Time: stop},
+				},
+			},
+		}
+		got := buildRemoteWrites(withRemoteWriteMirror(cr), &scrapesSecretsCache{})
+		assert.Equal(t, want, got)
+		if len(cr.Spec.RemoteWrite) != 1 {
+			t.Fatalf("origin object must not be changed, got remoteWrite: %v", cr.Spec.RemoteWrite)
+		}
+	}
+	now := time.Now()
+
+	// pending mirror
+	f(now.Add(time.Hour), now.Add(2*time.Hour), []string{"-remoteWrite.url=http://old-storage:8428/api/v1/write"})
+
+	// active mirror
+	f(now.Add(-time.Hour), now.Add(time.Hour), []string{"-remoteWrite.url=http://old-storage:8428/api/v1/write,http://new-storage:8428/api/v1/write"})
+
+	// completed mirror
+	f(now.Add(-2*time.Hour), now.Add(-time.Hour), []string{"-remoteWrite.url=http://old-storage:8428/api/v1/write"})
+}
+
 func TestCreateOrUpdateVMAgentService(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -1642,6 +1779,73 @@ func TestCreateOrUpdateRelabelConfigsAssets(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "tenant label routing",
+			args: args{
+				ctx: context.TODO(),
+				cr: &vmv1beta1.VMAgent{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "vmag",
+						Namespace: "default",
+					},
+					Spec: vmv1beta1.VMAgentSpec{
+						RemoteWrite: []vmv1beta1.VMAgentRemoteWriteSpec{{
+							URL: "http://vminsert:8480/insert/0/prometheus/api/v1/write",
+							InlineUrlRelabelConfig: []vmv1beta1.RelabelConfig{
+								{
+									Action:       "drop",
+									SourceLabels: []string{"job"},
+									Regex:        []string{"internal"},
+								},
+							},
+							TenantLabelRouting: &vmv1beta1.TenantLabelRouting{
+								Label:         "namespace",
+								Tenants:       map[string]string{"team-b": "2:5", "team-a": "1"},
+								DefaultTenant: "100",
+							},
+						}},
+					},
+				},
+			},
+			validate: func(cm *corev1.ConfigMap) error {
+				data, ok := cm.Data[fmt.Sprintf(urlRelabelingName, 0)]
+				if !ok {
+					return fmt.Errorf("key: %s, not exists at map: %v", fmt.Sprintf(urlRelabelingName, 0), cm.Data)
+				}
+				want := `- source_labels:
+  - job
+  regex: internal
+  action: drop
+- target_label: vm_account_id
+  replacement: "100"
+- target_label: vm_project_id
+  replacement: "0"
+- source_labels:
+  - namespace
+  target_label: vm_account_id
+  regex: team-a
+  replacement: "1"
+- source_labels:
+  - namespace
+  target_label: vm_project_id
+  regex: team-a
+  replacement: "0"
+- source_labels:
+  - namespace
+  target_label: vm_account_id
+  regex: team-b
+  replacement: "2"
+- source_labels:
+  - namespace
+  target_label: vm_project_id
+  regex: team-b
+  replacement: "5"
+`
+				assert.Equal(t, want, data)
+				return nil
+			},
+			predefinedObjects: []runtime.Object{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2013,6 +2217,38 @@ func TestBuildRemoteWriteSettings(t *testing.T) {
 			},
 			want: []string{"-remoteWrite.maxDiskUsagePerURL=1000", "-remoteWrite.tmpDataPath=/tmp/my-path", "-remoteWrite.showURL=true", "-enableMultitenantHandlers=true"},
 		},
+		{
+			name: "with throughputHint",
+			args: args{
+				cr: &vmv1beta1.VMAgent{
+					Spec: vmv1beta1.VMAgentSpec{
+						RemoteWrite: []vmv1beta1.VMAgentRemoteWriteSpec{
+							{URL: "http://vminsert-1:8480", ThroughputHint: ptr.To[int64](500_000)},
+							{URL: "http://vminsert-2:8480"},
+							{URL: "http://vminsert-3:8480", ThroughputHint: ptr.To[int64](1000)},
+						},
+					},
+				},
+			},
+			want: []string{"-remoteWrite.maxDiskUsagePerURL=3600000000,1073741824,1073741824", "-remoteWrite.queues=5", "-remoteWrite.tmpDataPath=/tmp/vmagent-remotewrite-data"},
+		},
+		{
+			name: "with throughputHint and explicit remoteWriteSettings",
+			args: args{
+				cr: &vmv1beta1.VMAgent{
+					Spec: vmv1beta1.VMAgentSpec{
+						RemoteWrite: []vmv1beta1.VMAgentRemoteWriteSpec{
+							{URL: "http://vminsert-1:8480", ThroughputHint: ptr.To[int64](500_000)},
+						},
+						RemoteWriteSettings: &vmv1beta1.VMAgentRemoteWriteSettings{
+							Queues:             ptr.To[int32](8),
+							MaxDiskUsagePerURL: ptr.To(int64(1000)),
+						},
+					},
+				},
+			},
+			want: []string{"-remoteWrite.maxDiskUsagePerURL=1000", "-remoteWrite.queues=8", "-remoteWrite.tmpDataPath=/tmp/vmagent-remotewrite-data"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2314,3 +2550,93 @@ containers:
 serviceaccountname: vmagent-agent
 `)
 }
+
+func TestNewDeployForVMAgentClusterMode(t *testing.T) {
+	cr := &vmv1beta1.VMAgent{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"},
+		Spec: vmv1beta1.VMAgentSpec{
+			IngestOnlyMode: true,
+			CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+				ReplicaCount: ptr.To[int32](1),
+			},
+			ClusterMode: &vmv1beta1.VMAgentClusterMode{
+				MembersCount:      3,
+				ReplicationFactor: ptr.To[int32](2),
+			},
+		},
+	}
+	scheme := k8stools.GetTestClientWithObjects(nil).Scheme()
+	build.AddDefaults(scheme)
+	scheme.Default(cr)
+	got, err := newDeployForVMAgent(cr, &scrapesSecretsCache{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	sts, ok := got.(*appsv1.StatefulSet)
+	if !ok {
+		t.Fatalf("cluster mode must use statefulset, got: %T", got)
+	}
+	if *sts.Spec.Replicas != 3 {
+		t.Fatalf("unexpected replicas, got: %d, want: 3", *sts.Spec.Replicas)
+	}
+	if sts.Spec.ServiceName != cr.PrefixedName() {
+		t.Fatalf("unexpected serviceName, got: %q, want: %q", sts.Spec.ServiceName, cr.PrefixedName())
+	}
+	container := sts.Spec.Template.Spec.Containers[0]
+	for _, arg := range []string{
+		"-promscrape.cluster.membersCount=3",
+		"-promscrape.cluster.memberNum=$(POD_NAME)",
+		"-promscrape.cluster.replicationFactor=2",
+	} {
+		assert.Contains(t, container.Args, arg)
+	}
+	var hasPodNameEnv bool
+	for _, env := range container.Env {
+		if env.Name == "POD_NAME" && env.ValueFrom != nil && env.ValueFrom.FieldRef.FieldPath == "metadata.name" {
+			hasPodNameEnv = true
+		}
+	}
+	if !hasPodNameEnv {
+		t.Fatalf("POD_NAME env must be set for cluster mode, got: %v", container.Env)
+	}
+}
+
+func TestNewDeployForVMAgentDaemonSetMode(t *testing.T) {
+	cr := &vmv1beta1.VMAgent{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"},
+		Spec: vmv1beta1.VMAgentSpec{
+			DaemonSetMode: true,
+			CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+				ReplicaCount: ptr.To[int32](1),
+				Tolerations:  []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+			},
+		},
+	}
+	scheme := k8stools.GetTestClientWithObjects(nil).Scheme()
+	build.AddDefaults(scheme)
+	scheme.Default(cr)
+	got, err := newDeployForVMAgent(cr, &scrapesSecretsCache{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	ds, ok := got.(*appsv1.DaemonSet)
+	if !ok {
+		t.Fatalf("daemonset mode must use daemonset, got: %T", got)
+	}
+	assert.Equal(t, cr.Spec.Tolerations, ds.Spec.Template.Spec.Tolerations)
+	assert.Equal(t, cr.SelectorLabels(), ds.Spec.Selector.MatchLabels)
+	var hasNodeNameEnv bool
+	for _, c := range ds.Spec.Template.Spec.Containers {
+		if c.Name != "vmagent" {
+			continue
+		}
+		for _, env := range c.Env {
+			if env.Name == daemonSetNodeNameEnv && env.ValueFrom != nil && env.ValueFrom.FieldRef.FieldPath == "spec.nodeName" {
+				hasNodeNameEnv = true
+			}
+		}
+	}
+	if !hasNodeNameEnv {
+		t.Fatalf("%s env must be set for vmagent container in daemonset mode", daemonSetNodeNameEnv)
+	}
+}
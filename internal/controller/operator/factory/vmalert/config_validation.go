@@ -0,0 +1,146 @@
+package vmalert
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// validateRules checks changed rule files with vmalert -dryRun job
+// it returns nil, if rules weren't changed or passed validation
+func validateRules(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAlert, ruleFiles map[string]string, changed bool) error {
+	if !cr.Spec.ConfigValidation.IsEnabled() {
+		return reconcile.RemoveConfigValidation(ctx, rclient, cr.Namespace, configValidationName(cr))
+	}
+	if !changed {
+		return nil
+	}
+	// validation objects are short-lived and removed by garbage collector together with parent object
+	cms := makeRulesConfigMaps(cr, ruleFiles, configValidationName(cr))
+	cmNames := make([]string, 0, len(cms))
+	data := make(map[string][]byte, len(ruleFiles))
+	for i := range cms {
+		cm := &cms[i]
+		cm.Labels = configValidationLabels(cr)
+		cm.Finalizers = nil
+		if err := reconcile.ConfigMap(ctx, rclient, cm); err != nil {
+			return fmt.Errorf("cannot reconcile rules validation configmap=%q: %w", cm.Name, err)
+		}
+		cmNames = append(cmNames, cm.Name)
+		for k, v := range cm.Data {
+			data[cm.Name+"/"+k] = []byte(v)
+		}
+	}
+	return reconcile.ConfigValidation(ctx, rclient, buildConfigValidationJob(cr, cmNames), data)
+}
+
+func configValidationName(cr *vmv1beta1.VMAlert) string {
+	return cr.PrefixedName() + "-config-validation"
+}
+
+// configValidationLabels must not match selector of vmalert pods and rules configmaps
+func configValidationLabels(cr *vmv1beta1.VMAlert) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":      "vmalert-config-validation",
+		"app.kubernetes.io/instance":  cr.Name,
+		"app.kubernetes.io/component": "monitoring",
+		"managed-by":                  "vm-operator",
+	}
+}
+
+// buildConfigValidationJob builds job, which mounts rules and templates the same way as vmalert deployment
+func buildConfigValidationJob(cr *vmv1beta1.VMAlert, cmNames []string) *batchv1.Job {
+	args := []string{"-dryRun"}
+	if templates, ok := cr.Spec.ExtraArgs["rule.templates"]; ok {
+		args = append(args, fmt.Sprintf("-rule.templates=%s", templates))
+	}
+	var volumes []corev1.Volume
+	var volumeMounts []corev1.VolumeMount
+	for _, name := range cmNames {
+		args = append(args, fmt.Sprintf("-rule=%q", path.Join(vmAlertConfigDir, name, "*.yaml")))
+		volumes = append(volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: name},
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: path.Join(vmAlertConfigDir, name),
+			ReadOnly:  true,
+		})
+	}
+	for _, s := range cr.Spec.Secrets {
+		volumes = append(volumes, corev1.Volume{
+			Name: k8stools.SanitizeVolumeName("secret-" + s),
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: s},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      k8stools.SanitizeVolumeName("secret-" + s),
+			ReadOnly:  true,
+			MountPath: path.Join(vmv1beta1.SecretsDir, s),
+		})
+	}
+	for _, c := range cr.Spec.ConfigMaps {
+		volumes = append(volumes, corev1.Volume{
+			Name: k8stools.SanitizeVolumeName("configmap-" + c),
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: c},
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      k8stools.SanitizeVolumeName("configmap-" + c),
+			ReadOnly:  true,
+			MountPath: path.Join(vmv1beta1.ConfigMapsDir, c),
+		})
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            configValidationName(cr),
+			Namespace:       cr.Namespace,
+			Labels:          configValidationLabels(cr),
+			OwnerReferences: cr.AsOwner(),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To[int32](0),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: configValidationLabels(cr),
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: cr.GetServiceAccountName(),
+					ImagePullSecrets:   cr.Spec.ImagePullSecrets,
+					Containers: []corev1.Container{
+						{
+							Name:                     "vmalert",
+							Image:                    fmt.Sprintf("%s:%s", cr.Spec.Image.Repository, cr.Spec.Image.Tag),
+							ImagePullPolicy:          cr.Spec.Image.PullPolicy,
+							Args:                     args,
+							Resources:                cr.Spec.ConfigValidation.Resources,
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+							VolumeMounts:             volumeMounts,
+						},
+					},
+					Volumes: volumes,
+				},
+			},
+		},
+	}
+}
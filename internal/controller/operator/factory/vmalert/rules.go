@@ -4,14 +4,17 @@ import (
 	"context"
 	"fmt"
 	"hash/fnv"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
 	"github.com/ghodss/yaml"
 	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
@@ -70,8 +73,15 @@ func CreateOrUpdateRuleConfigMaps(ctx context.Context, cr *vmv1beta1.VMAlert, rc
 	if err != nil {
 		return nil, err
 	}
+	newRules, err = rulesRevision(ctx, rclient, cr, newRules)
+	if err != nil {
+		return nil, err
+	}
 
-	newConfigMaps := makeRulesConfigMaps(cr, newRules)
+	newConfigMaps, err := makeShardedRulesConfigMaps(cr, newRules)
+	if err != nil {
+		return nil, err
+	}
 	currentCMs := make([]corev1.ConfigMap, len(newConfigMaps))
 	for idx, cm := range newConfigMaps {
 		var existCM corev1.ConfigMap
@@ -87,6 +97,7 @@ func CreateOrUpdateRuleConfigMaps(ctx context.Context, cr *vmv1beta1.VMAlert, rc
 	newConfigMapNames := make([]string, 0, len(newConfigMaps))
 	for _, cm := range newConfigMaps {
 		newConfigMapNames = append(newConfigMapNames, cm.Name)
+		reconcile.TrackChild(ctx, "ConfigMap", &cm, cm.Data)
 	}
 
 	if len(currentCMs) == 0 {
@@ -111,6 +122,10 @@ func CreateOrUpdateRuleConfigMaps(ctx context.Context, cr *vmv1beta1.VMAlert, rc
 
 	// compute diff for current and needed rules configmaps.
 	toCreate, toUpdate := rulesCMDiff(currentCMs, newConfigMaps)
+	hasCurrent := slices.ContainsFunc(currentCMs, func(cm corev1.ConfigMap) bool { return cm.Name != "" })
+	if err := validateRules(ctx, rclient, cr, newRules, hasCurrent && (len(toCreate) > 0 || len(toUpdate) > 0)); err != nil {
+		return nil, err
+	}
 	for _, cm := range toCreate {
 		err = rclient.Create(ctx, &cm)
 		if err != nil {
@@ -129,6 +144,7 @@ func CreateOrUpdateRuleConfigMaps(ctx context.Context, cr *vmv1beta1.VMAlert, rc
 	}
 
 	if len(toCreate) > 0 || len(toUpdate) > 0 {
+		events.Normal(ctx, events.ReasonConfigUpdated, "rules configuration updated, created configmaps: %d, updated configmaps: %d", len(toCreate), len(toUpdate))
 		// trigger sync for configmap
 		logger.WithContext(ctx).Info("triggered pod config reload by changing annotation")
 
@@ -137,10 +153,51 @@ func CreateOrUpdateRuleConfigMaps(ctx context.Context, cr *vmv1beta1.VMAlert, rc
 			l.Error(err, "failed to update pod cm-sync annotation")
 		}
 	}
+	if err := removeStaleRulesConfigMaps(ctx, rclient, cr, newConfigMapNames); err != nil {
+		return nil, err
+	}
 
 	return newConfigMapNames, nil
 }
 
+// removeStaleRulesConfigMaps removes rules configmaps, which are not used by vmalert anymore
+// e.g. after change of shards count
+func removeStaleRulesConfigMaps(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAlert, keepNames []string) error {
+	var cmList corev1.ConfigMapList
+	if err := rclient.List(ctx, &cmList, cr.RulesConfigMapSelector()); err != nil {
+		return fmt.Errorf("cannot list rules configmaps: %w", err)
+	}
+	for i := range cmList.Items {
+		cm := &cmList.Items[i]
+		if slices.Contains(keepNames, cm.Name) {
+			continue
+		}
+		logger.WithContext(ctx).Info("removing stale rules configmap", "cm_name", cm.Name)
+		if err := finalize.SafeDeleteWithFinalizer(ctx, rclient, cm); err != nil {
+			return fmt.Errorf("cannot remove stale rules configmap=%q: %w", cm.Name, err)
+		}
+	}
+	return nil
+}
+
+// rulesRevision records rule files into configuration revision history
+// and returns rule files, which must be applied
+func rulesRevision(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAlert, ruleFiles map[string]string) (map[string]string, error) {
+	data := make(map[string][]byte, len(ruleFiles))
+	for k, v := range ruleFiles {
+		data[k] = []byte(v)
+	}
+	data, err := reconcile.ConfigRevision(ctx, rclient, cr, ruleConfigMapName(cr.Name), data)
+	if err != nil {
+		return nil, err
+	}
+	dst := make(map[string]string, len(data))
+	for k, v := range data {
+		dst[k] = string(v)
+	}
+	return dst, nil
+}
+
 // rulesCMDiff - calculates diff between existing at k8s (current) configmaps with rules
 // and generated by operator (new) configmaps.
 // Configmaps are grouped by operations, that must be performed over them.
@@ -158,7 +215,7 @@ func rulesCMDiff(currentCMs []corev1.ConfigMap, newCMs []corev1.ConfigMap) (toCr
 			if newCM.Name == currentCM.Name {
 				found = true
 				newCM.Annotations = labels.Merge(currentCM.Annotations, newCM.Annotations)
-				vmv1beta1.AddFinalizer(&newCM, &currentCM)
+				finalize.MergeFinalizers(&newCM, &currentCM)
 				if equality.Semantic.DeepEqual(newCM.Data, currentCM.Data) &&
 					equality.Semantic.DeepEqual(newCM.Labels, currentCM.Labels) &&
 					equality.Semantic.DeepEqual(newCM.Annotations, currentCM.Annotations) {
@@ -195,9 +252,18 @@ func selectRulesUpdateStatus(ctx context.Context, cr *vmv1beta1.VMAlert, rclient
 		logger.WithContext(ctx).Info("deduplicating vmalert rules")
 		vmRules = deduplicateRules(ctx, vmRules)
 	}
+	blockedRules, err := selectBlockedRules(ctx, rclient, vmRules)
+	if err != nil {
+		return nil, err
+	}
 	var badRules []*vmv1beta1.VMRule
 	var cnt int
 	for _, pRule := range vmRules {
+		if ruleTest, ok := blockedRules[pRule.Namespace+"/"+pRule.Name]; ok {
+			pRule.Status.CurrentSyncError = fmt.Sprintf("rule is blocked by failed tests at vmruletest=%s", ruleTest)
+			badRules = append(badRules, pRule)
+			continue
+		}
 		if err := pRule.Validate(); err != nil {
 			pRule.Status.CurrentSyncError = err.Error()
 			badRules = append(badRules, pRule)
@@ -231,9 +297,13 @@ func selectRulesUpdateStatus(ctx context.Context, cr *vmv1beta1.VMAlert, rclient
 		if bRule.Status.CurrentSyncError == bRule.Status.LastSyncError {
 			continue
 		}
+		status := vmv1beta1.UpdateStatusFailed
+		if _, ok := blockedRules[bRule.Namespace+"/"+bRule.Name]; ok {
+			status = vmv1beta1.UpdateStatusBlocked
+		}
 		// patch update status
 		pt := client.RawPatch(types.MergePatchType,
-			[]byte(fmt.Sprintf(`{"status": {"lastSyncError":  %q , "status": %q} }`, bRule.Status.CurrentSyncError, vmv1beta1.UpdateStatusFailed)))
+			[]byte(fmt.Sprintf(`{"status": {"lastSyncError":  %q , "status": %q} }`, bRule.Status.CurrentSyncError, status)))
 		if err := rclient.Status().Patch(ctx, bRule, pt); err != nil {
 			return nil, fmt.Errorf("failed to patch status of broken vmrule=%q: %w", bRule.Name, err)
 		}
@@ -286,7 +356,7 @@ func generateContent(promRule vmv1beta1.VMRuleSpec, enforcedNsLabel, ns string)
 // future this can be replaced by a more sophisticated algorithm, but for now
 // simplicity should be sufficient.
 // [1] https://en.wikipedia.org/wiki/Bin_packing_problem#First-fit_algorithm
-func makeRulesConfigMaps(cr *vmv1beta1.VMAlert, ruleFiles map[string]string) []corev1.ConfigMap {
+func makeRulesConfigMaps(cr *vmv1beta1.VMAlert, ruleFiles map[string]string, namePrefix string) []corev1.ConfigMap {
 	buckets := []map[string]string{
 		{},
 	}
@@ -312,13 +382,70 @@ func makeRulesConfigMaps(cr *vmv1beta1.VMAlert, ruleFiles map[string]string) []c
 	ruleFileConfigMaps := make([]corev1.ConfigMap, 0, len(buckets))
 	for i, bucket := range buckets {
 		cm := makeRulesConfigMap(cr, bucket)
-		cm.Name = cm.Name + "-" + strconv.Itoa(i)
+		cm.Name = namePrefix + "-" + strconv.Itoa(i)
 		ruleFileConfigMaps = append(ruleFileConfigMaps, cm)
 	}
 
 	return ruleFileConfigMaps
 }
 
+// makeShardedRulesConfigMaps returns rules configmaps with disjoint rule groups for each shard of vmalert
+// or configmaps with all rules, if vmalert isn't sharded
+func makeShardedRulesConfigMaps(cr *vmv1beta1.VMAlert, ruleFiles map[string]string) ([]corev1.ConfigMap, error) {
+	shardsCount := getShardsCount(cr)
+	if shardsCount == 1 {
+		return makeRulesConfigMaps(cr, ruleFiles, ruleConfigMapName(cr.Name)), nil
+	}
+	shards, err := shardRuleFiles(ruleFiles, shardsCount)
+	if err != nil {
+		return nil, err
+	}
+	var configMaps []corev1.ConfigMap
+	for shardNum, shardFiles := range shards {
+		cms := makeRulesConfigMaps(cr, shardFiles, shardRuleConfigMapNamePrefix(cr.Name, shardNum))
+		for i := range cms {
+			cms[i].Labels["shard-num"] = strconv.Itoa(shardNum)
+		}
+		configMaps = append(configMaps, cms...)
+	}
+	return configMaps, nil
+}
+
+// shardRuleFiles distributes rule groups across shards by hash of rule file and group names.
+// Shard without rule groups gets default rule, since vmalert cannot start without rules
+func shardRuleFiles(ruleFiles map[string]string, shardsCount int) ([]map[string]string, error) {
+	shards := make([]map[string]string, shardsCount)
+	for i := range shards {
+		shards[i] = make(map[string]string)
+	}
+	for filename, content := range ruleFiles {
+		var spec vmv1beta1.VMRuleSpec
+		if err := yaml.Unmarshal([]byte(content), &spec); err != nil {
+			return nil, fmt.Errorf("cannot parse rule file=%q: %w", filename, err)
+		}
+		groupsByShard := make(map[int][]vmv1beta1.RuleGroup)
+		for _, group := range spec.Groups {
+			h := fnv.New32a()
+			h.Write([]byte(filename + "/" + group.Name))
+			shardNum := int(h.Sum32() % uint32(shardsCount))
+			groupsByShard[shardNum] = append(groupsByShard[shardNum], group)
+		}
+		for shardNum, groups := range groupsByShard {
+			data, err := yaml.Marshal(vmv1beta1.VMRuleSpec{Groups: groups})
+			if err != nil {
+				return nil, fmt.Errorf("cannot marshal rule groups of file=%q for shard=%d: %w", filename, shardNum, err)
+			}
+			shards[shardNum][filename] = string(data)
+		}
+	}
+	for _, shard := range shards {
+		if len(shard) == 0 {
+			shard["default-vmalert.yaml"] = defAlert
+		}
+	}
+	return shards, nil
+}
+
 func bucketSize(bucket map[string]string) int {
 	totalSize := 0
 	for _, v := range bucket {
@@ -340,7 +467,7 @@ func makeRulesConfigMap(cr *vmv1beta1.VMAlert, ruleFiles map[string]string) core
 			Namespace:       cr.Namespace,
 			Labels:          ruleLabels,
 			OwnerReferences: cr.AsOwner(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Data: ruleFiles,
 	}
@@ -350,6 +477,22 @@ func ruleConfigMapName(vmName string) string {
 	return "vm-" + vmName + "-rulefiles"
 }
 
+func shardRuleConfigMapNamePrefix(vmName string, shardNum int) string {
+	return fmt.Sprintf("%s-shard-%d", ruleConfigMapName(vmName), shardNum)
+}
+
+// shardRuleConfigMapNames returns names of rules configmaps, which belong to the given shard
+func shardRuleConfigMapNames(cr *vmv1beta1.VMAlert, cmNames []string, shardNum int) []string {
+	prefix := shardRuleConfigMapNamePrefix(cr.Name, shardNum) + "-"
+	var dst []string
+	for _, name := range cmNames {
+		if strings.HasPrefix(name, prefix) {
+			dst = append(dst, name)
+		}
+	}
+	return dst
+}
+
 // deduplicateRules - takes list of vmRules and modifies it
 // by removing duplicates.
 // possible duplicates:
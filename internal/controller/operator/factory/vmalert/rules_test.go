@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/ghodss/yaml"
 	"github.com/go-logr/logr"
 	"github.com/go-test/deep"
 	"github.com/stretchr/testify/assert"
@@ -341,6 +342,24 @@ func TestCreateOrUpdateRuleConfigMaps(t *testing.T) {
 			}},
 			want: []string{"vm-base-vmalert-rulefiles-0"},
 		},
+		{
+			name: "sharded-rules-with-stale-configmap",
+			args: args{cr: &vmv1beta1.VMAlert{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "base-vmalert",
+				},
+				Spec: vmv1beta1.VMAlertSpec{SelectAllByDefault: true, ShardCount: ptr.To(2)},
+			}},
+			predefinedObjects: []runtime.Object{
+				&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "vm-base-vmalert-rulefiles-0",
+					Labels:    map[string]string{"vmalert-name": "base-vmalert"},
+				}},
+			},
+			want: []string{"vm-base-vmalert-rulefiles-shard-0-0", "vm-base-vmalert-rulefiles-shard-1-0"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -353,6 +372,17 @@ func TestCreateOrUpdateRuleConfigMaps(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("CreateOrUpdateRuleConfigMaps() got = %v, want %v", got, tt.want)
 			}
+			var cmList v1.ConfigMapList
+			if err := fclient.List(context.TODO(), &cmList, tt.args.cr.RulesConfigMapSelector()); err != nil {
+				t.Fatalf("cannot list rules configmaps: %s", err)
+			}
+			var gotCMNames []string
+			for _, cm := range cmList.Items {
+				gotCMNames = append(gotCMNames, cm.Name)
+			}
+			if !reflect.DeepEqual(gotCMNames, tt.want) {
+				t.Errorf("unexpected rules configmaps got = %v, want %v", gotCMNames, tt.want)
+			}
 		})
 	}
 }
@@ -630,3 +660,71 @@ func Test_rulesCMDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestShardRuleFiles(t *testing.T) {
+	ruleFiles := map[string]string{
+		"default-first.yaml": `groups:
+- name: group-1
+  rules:
+  - alert: first
+    expr: up == 0
+- name: group-2
+  rules:
+  - alert: second
+    expr: up == 0
+- name: group-3
+  rules:
+  - record: third
+    expr: sum(up)
+`,
+		"default-second.yaml": `groups:
+- name: group-1
+  rules:
+  - alert: fourth
+    expr: up == 0
+`,
+	}
+	f := func(shardsCount int) {
+		t.Helper()
+		shards, err := shardRuleFiles(ruleFiles, shardsCount)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assert.Len(t, shards, shardsCount)
+		groupsCount := make(map[string]int)
+		for _, shard := range shards {
+			assert.NotEmpty(t, shard)
+			for filename, content := range shard {
+				if filename == "default-vmalert.yaml" {
+					continue
+				}
+				var spec vmv1beta1.VMRuleSpec
+				if err := yaml.Unmarshal([]byte(content), &spec); err != nil {
+					t.Fatalf("cannot parse shard rule file=%q: %s", filename, err)
+				}
+				for _, g := range spec.Groups {
+					groupsCount[filename+"/"+g.Name]++
+				}
+			}
+		}
+		// each group must be evaluated by a single shard
+		assert.Equal(t, map[string]int{
+			"default-first.yaml/group-1":  1,
+			"default-first.yaml/group-2":  1,
+			"default-first.yaml/group-3":  1,
+			"default-second.yaml/group-1": 1,
+		}, groupsCount)
+
+		// sharding must be stable
+		shardsAgain, err := shardRuleFiles(ruleFiles, shardsCount)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assert.Equal(t, shards, shardsAgain)
+	}
+
+	f(2)
+	f(3)
+	// shards without groups get default rule
+	f(10)
+}
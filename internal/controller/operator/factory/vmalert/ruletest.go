@@ -0,0 +1,400 @@
+package vmalert
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+	"gopkg.in/yaml.v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	ruleTestsFileName      = "tests.yaml"
+	ruleTestsMountPath     = "/etc/vmalert-tool/tests"
+	ruleTestHashAnnotation = "operator.victoriametrics.com/vmruletest-hash"
+	ruleTestHashLabel      = "operator.victoriametrics.com/vmruletest-hash"
+	// termination message is limited by kubelet with 4096 bytes
+	maxRuleTestMessageLen = 4096
+)
+
+// ruleUnitTestFile defines vmalert-tool unit test file
+// See https://docs.victoriametrics.com/vmalert-tool/#test-file-format
+type ruleUnitTestFile struct {
+	RuleFiles          []string            `yaml:"rule_files"`
+	EvaluationInterval string              `yaml:"evaluation_interval,omitempty"`
+	GroupEvalOrder     []string            `yaml:"group_eval_order,omitempty"`
+	Tests              []ruleUnitTestGroup `yaml:"tests"`
+}
+
+type ruleUnitTestGroup struct {
+	Name               string                  `yaml:"name,omitempty"`
+	Interval           string                  `yaml:"interval,omitempty"`
+	InputSeries        []ruleUnitTestSeries    `yaml:"input_series,omitempty"`
+	AlertRuleTests     []ruleUnitTestAlert     `yaml:"alert_rule_test,omitempty"`
+	MetricsqlExprTests []ruleUnitTestMetricsql `yaml:"metricsql_expr_test,omitempty"`
+	ExternalLabels     map[string]string       `yaml:"external_labels,omitempty"`
+}
+
+type ruleUnitTestSeries struct {
+	Series string `yaml:"series"`
+	Values string `yaml:"values"`
+}
+
+type ruleUnitTestAlert struct {
+	EvalTime  string                 `yaml:"eval_time"`
+	GroupName string                 `yaml:"groupname"`
+	Alertname string                 `yaml:"alertname"`
+	ExpAlerts []ruleUnitTestExpAlert `yaml:"exp_alerts"`
+}
+
+type ruleUnitTestExpAlert struct {
+	ExpLabels      map[string]string `yaml:"exp_labels,omitempty"`
+	ExpAnnotations map[string]string `yaml:"exp_annotations,omitempty"`
+}
+
+type ruleUnitTestMetricsql struct {
+	Expr       string                  `yaml:"expr"`
+	EvalTime   string                  `yaml:"eval_time"`
+	ExpSamples []ruleUnitTestExpSample `yaml:"exp_samples"`
+}
+
+type ruleUnitTestExpSample struct {
+	Labels string  `yaml:"labels"`
+	Value  float64 `yaml:"value"`
+}
+
+// CreateOrUpdateRuleTest runs vmalert-tool unit tests for VMRules referenced by VMRuleTest
+// and updates VMRuleTest status with tests result.
+// It returns true, if tests run is finished for the current rules and tests content.
+func CreateOrUpdateRuleTest(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMRuleTest) (bool, error) {
+	cm, err := buildRuleTestConfigMap(ctx, rclient, cr)
+	if err != nil {
+		if patchErr := patchRuleTestStatus(ctx, rclient, cr, func(status *vmv1beta1.VMRuleTestStatus) {
+			status.Status = vmv1beta1.UpdateStatusFailed
+			status.LastSyncError = err.Error()
+		}); patchErr != nil {
+			return false, patchErr
+		}
+		return false, err
+	}
+	hash := cm.Annotations[ruleTestHashAnnotation]
+	if cr.Status.TestedHash == hash && cr.Status.Result != "" {
+		return true, nil
+	}
+	if err := reconcile.ConfigMap(ctx, rclient, cm); err != nil {
+		return false, fmt.Errorf("cannot reconcile tests configmap for vmruletest: %w", err)
+	}
+
+	var job batchv1.Job
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.PrefixedName()}, &job); err != nil {
+		if !errors.IsNotFound(err) {
+			return false, fmt.Errorf("cannot get tests job for vmruletest: %w", err)
+		}
+		logger.WithContext(ctx).Info("starting rule tests job", "job", cr.PrefixedName(), "hash", hash)
+		if err := rclient.Create(ctx, buildRuleTestJob(cr, hash)); err != nil {
+			return false, fmt.Errorf("cannot create tests job for vmruletest: %w", err)
+		}
+		return false, patchRuleTestStatus(ctx, rclient, cr, func(status *vmv1beta1.VMRuleTestStatus) {
+			status.Status = vmv1beta1.UpdateStatusExpanding
+			status.LastSyncError = ""
+		})
+	}
+	if !job.DeletionTimestamp.IsZero() {
+		// wait for previous tests run removal
+		return false, nil
+	}
+	if job.Annotations[ruleTestHashAnnotation] != hash {
+		logger.WithContext(ctx).Info("rules or tests changed, removing outdated tests job", "job", job.Name)
+		return false, deleteRuleTestJob(ctx, rclient, &job)
+	}
+
+	var result string
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			result = vmv1beta1.RuleTestPassed
+		case batchv1.JobFailed:
+			result = vmv1beta1.RuleTestFailed
+		}
+	}
+	if result == "" {
+		return false, nil
+	}
+	var message string
+	if result == vmv1beta1.RuleTestFailed {
+		message, err = ruleTestFailureMessage(ctx, rclient, cr, hash)
+		if err != nil {
+			return false, err
+		}
+		events.Warning(ctx, events.ReasonRuleTestFailed, "rule tests failed for vmruletest=%s", cr.Name)
+	} else {
+		events.Normal(ctx, events.ReasonRuleTestPassed, "rule tests passed for vmruletest=%s", cr.Name)
+	}
+	return true, patchRuleTestStatus(ctx, rclient, cr, func(status *vmv1beta1.VMRuleTestStatus) {
+		status.Status = vmv1beta1.UpdateStatusOperational
+		status.Result = result
+		status.Message = message
+		status.TestedHash = hash
+		status.LastSyncError = ""
+	})
+}
+
+// selectBlockedRules returns VMRules excluded from VMAlert configuration by failed VMRuleTests with strictMode
+// map key is namespace/name of VMRule and value is name of VMRuleTest
+func selectBlockedRules(ctx context.Context, rclient client.Client, vmRules []*vmv1beta1.VMRule) (map[string]string, error) {
+	namespaces := make(map[string]struct{})
+	for _, rule := range vmRules {
+		namespaces[rule.Namespace] = struct{}{}
+	}
+	blocked := make(map[string]string)
+	for ns := range namespaces {
+		var ruleTests vmv1beta1.VMRuleTestList
+		if err := rclient.List(ctx, &ruleTests, client.InNamespace(ns)); err != nil {
+			return nil, fmt.Errorf("cannot list vmruletests at namespace=%q: %w", ns, err)
+		}
+		for i := range ruleTests.Items {
+			rt := &ruleTests.Items[i]
+			for _, rule := range rt.Spec.Rules {
+				if rt.IsBlocksRule(rule) {
+					blocked[ns+"/"+rule] = rt.Name
+				}
+			}
+		}
+	}
+	return blocked, nil
+}
+
+// buildRuleTestConfigMap builds configmap with rule files and vmalert-tool tests file
+// configmap is annotated with hash of its content
+func buildRuleTestConfigMap(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMRuleTest) (*corev1.ConfigMap, error) {
+	data := make(map[string]string, len(cr.Spec.Rules)+1)
+	ruleFiles := make([]string, 0, len(cr.Spec.Rules))
+	for _, name := range cr.Spec.Rules {
+		var rule vmv1beta1.VMRule
+		if err := rclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: name}, &rule); err != nil {
+			if errors.IsNotFound(err) {
+				return nil, fmt.Errorf("cannot find vmrule=%q referenced by vmruletest", name)
+			}
+			return nil, fmt.Errorf("cannot get vmrule=%q: %w", name, err)
+		}
+		content, err := generateContent(rule.Spec, "", rule.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		fileName := fmt.Sprintf("%s-%s.yaml", rule.Namespace, rule.Name)
+		data[fileName] = content
+		ruleFiles = append(ruleFiles, fileName)
+	}
+	tests, err := buildRuleTestFile(cr, ruleFiles)
+	if err != nil {
+		return nil, err
+	}
+	data[ruleTestsFileName] = string(tests)
+
+	annotations := cr.AnnotationsFiltered()
+	annotations[ruleTestHashAnnotation] = ruleTestHash(cr, data)
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            cr.PrefixedName(),
+			Namespace:       cr.Namespace,
+			Labels:          cr.AllLabels(),
+			Annotations:     annotations,
+			OwnerReferences: cr.AsOwner(),
+			Finalizers:      finalize.ChildFinalizers(),
+		},
+		Data: data,
+	}, nil
+}
+
+func buildRuleTestFile(cr *vmv1beta1.VMRuleTest, ruleFiles []string) ([]byte, error) {
+	utf := ruleUnitTestFile{
+		RuleFiles:          ruleFiles,
+		EvaluationInterval: cr.Spec.EvaluationInterval,
+		GroupEvalOrder:     cr.Spec.GroupEvalOrder,
+	}
+	for _, tg := range cr.Spec.Tests {
+		group := ruleUnitTestGroup{
+			Name:           tg.Name,
+			Interval:       tg.Interval,
+			ExternalLabels: tg.ExternalLabels,
+		}
+		for _, is := range tg.InputSeries {
+			group.InputSeries = append(group.InputSeries, ruleUnitTestSeries{Series: is.Series, Values: is.Values})
+		}
+		for _, at := range tg.AlertRuleTests {
+			alertTest := ruleUnitTestAlert{
+				EvalTime:  at.EvalTime,
+				GroupName: at.GroupName,
+				Alertname: at.AlertName,
+			}
+			for _, ea := range at.ExpAlerts {
+				alertTest.ExpAlerts = append(alertTest.ExpAlerts, ruleUnitTestExpAlert{ExpLabels: ea.ExpLabels, ExpAnnotations: ea.ExpAnnotations})
+			}
+			group.AlertRuleTests = append(group.AlertRuleTests, alertTest)
+		}
+		for _, mt := range tg.MetricsqlExprTests {
+			exprTest := ruleUnitTestMetricsql{
+				Expr:     mt.Expr,
+				EvalTime: mt.EvalTime,
+			}
+			for _, es := range mt.ExpSamples {
+				v, err := strconv.ParseFloat(es.Value, 64)
+				if err != nil {
+					return nil, fmt.Errorf("cannot parse value=%q of expected sample=%q: %w", es.Value, es.Labels, err)
+				}
+				exprTest.ExpSamples = append(exprTest.ExpSamples, ruleUnitTestExpSample{Labels: es.Labels, Value: v})
+			}
+			group.MetricsqlExprTests = append(group.MetricsqlExprTests, exprTest)
+		}
+		utf.Tests = append(utf.Tests, group)
+	}
+	data, err := yaml.Marshal(utf)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal tests file: %w", err)
+	}
+	return data, nil
+}
+
+// ruleTestHash calculates hash of tests content and job settings
+func ruleTestHash(cr *vmv1beta1.VMRuleTest, data map[string]string) string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	h := sha256.New()
+	for _, k := range keys {
+		h.Write([]byte(k))       //nolint:errcheck
+		h.Write([]byte(data[k])) //nolint:errcheck
+		h.Write([]byte("\xff"))  //nolint:errcheck
+	}
+	h.Write([]byte(cr.Spec.Image.Repository))                           //nolint:errcheck
+	h.Write([]byte(cr.Spec.Image.Tag))                                  //nolint:errcheck
+	h.Write([]byte(strconv.FormatBool(cr.Spec.DisableAlertgroupLabel))) //nolint:errcheck
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+func buildRuleTestJob(cr *vmv1beta1.VMRuleTest, hash string) *batchv1.Job {
+	args := []string{"unittest", "--files=" + path.Join(ruleTestsMountPath, ruleTestsFileName)}
+	if cr.Spec.DisableAlertgroupLabel {
+		args = append(args, "--disableAlertgroupLabel")
+	}
+	podLabels := labels.Merge(cr.AllLabels(), map[string]string{ruleTestHashLabel: hash})
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            cr.PrefixedName(),
+			Namespace:       cr.Namespace,
+			Labels:          cr.AllLabels(),
+			Annotations:     labels.Merge(cr.AnnotationsFiltered(), map[string]string{ruleTestHashAnnotation: hash}),
+			OwnerReferences: cr.AsOwner(),
+			Finalizers:      finalize.ChildFinalizers(),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To[int32](0),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: podLabels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:                     "vmalert-tool",
+							Image:                    fmt.Sprintf("%s:%s", cr.Spec.Image.Repository, cr.Spec.Image.Tag),
+							ImagePullPolicy:          cr.Spec.Image.PullPolicy,
+							Args:                     args,
+							Resources:                cr.Spec.Resources,
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "tests",
+									MountPath: ruleTestsMountPath,
+									ReadOnly:  true,
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "tests",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{Name: cr.PrefixedName()},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// deleteRuleTestJob removes finalizer from job and deletes it with its pods
+func deleteRuleTestJob(ctx context.Context, rclient client.Client, job *batchv1.Job) error {
+	if err := finalize.RemoveFinalizer(ctx, rclient, job); err != nil {
+		return err
+	}
+	if err := rclient.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("cannot delete outdated tests job: %w", err)
+	}
+	return nil
+}
+
+// ruleTestFailureMessage returns termination message of failed vmalert-tool container
+func ruleTestFailureMessage(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMRuleTest, hash string) (string, error) {
+	var pods corev1.PodList
+	if err := rclient.List(ctx, &pods, client.InNamespace(cr.Namespace), client.MatchingLabels(labels.Merge(cr.SelectorLabels(), map[string]string{ruleTestHashLabel: hash}))); err != nil {
+		return "", fmt.Errorf("cannot list tests pods for vmruletest: %w", err)
+	}
+	for _, pod := range pods.Items {
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.State.Terminated != nil && cs.State.Terminated.Message != "" {
+				msg := strings.TrimSpace(cs.State.Terminated.Message)
+				if len(msg) > maxRuleTestMessageLen {
+					msg = msg[len(msg)-maxRuleTestMessageLen:]
+				}
+				return msg, nil
+			}
+		}
+	}
+	return "tests job failed, check logs of job=" + cr.PrefixedName(), nil
+}
+
+func patchRuleTestStatus(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMRuleTest, update func(status *vmv1beta1.VMRuleTestStatus)) error {
+	status := cr.Status.DeepCopy()
+	update(status)
+	if *status == cr.Status {
+		return nil
+	}
+	data, err := json.Marshal(map[string]interface{}{"status": status})
+	if err != nil {
+		return fmt.Errorf("cannot marshal vmruletest status: %w", err)
+	}
+	if err := rclient.Status().Patch(ctx, cr, client.RawPatch(types.MergePatchType, data)); err != nil {
+		return fmt.Errorf("cannot patch status of vmruletest=%q: %w", cr.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package vmalert
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestBuildRuleTestFile(t *testing.T) {
+	cr := &vmv1beta1.VMRuleTest{
+		ObjectMeta: metav1.ObjectMeta{Name: "node", Namespace: "default"},
+		Spec: vmv1beta1.VMRuleTestSpec{
+			Rules:              []string{"node-alerts"},
+			EvaluationInterval: "1m",
+			Tests: []vmv1beta1.RuleTestGroup{{
+				Name:        "node down",
+				Interval:    "1m",
+				InputSeries: []vmv1beta1.RuleTestInputSeries{{Series: `up{job="node"}`, Values: "0x10"}},
+				AlertRuleTests: []vmv1beta1.AlertRuleTestCase{
+					{
+						EvalTime:  "5m",
+						GroupName: "node",
+						AlertName: "NodeDown",
+						ExpAlerts: []vmv1beta1.RuleTestExpAlert{{ExpLabels: map[string]string{"job": "node"}}},
+					},
+					{
+						EvalTime:  "1m",
+						GroupName: "node",
+						AlertName: "NodeDown",
+					},
+				},
+				MetricsqlExprTests: []vmv1beta1.MetricsqlExprTestCase{{
+					Expr:       "up == 0",
+					EvalTime:   "5m",
+					ExpSamples: []vmv1beta1.RuleTestExpSample{{Labels: `up{job="node"}`, Value: "0"}},
+				}},
+			}},
+		},
+	}
+	got, err := buildRuleTestFile(cr, []string{"default-node-alerts.yaml"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, `rule_files:
+- default-node-alerts.yaml
+evaluation_interval: 1m
+tests:
+- name: node down
+  interval: 1m
+  input_series:
+  - series: up{job="node"}
+    values: "0x10"
+  alert_rule_test:
+  - eval_time: 5m
+    groupname: node
+    alertname: NodeDown
+    exp_alerts:
+    - exp_labels:
+        job: node
+  - eval_time: 1m
+    groupname: node
+    alertname: NodeDown
+    exp_alerts: []
+  metricsql_expr_test:
+  - expr: up == 0
+    eval_time: 5m
+    exp_samples:
+    - labels: up{job="node"}
+      value: 0
+`, string(got))
+}
+
+func TestCreateOrUpdateRuleTest(t *testing.T) {
+	ctx := context.Background()
+	rule := &vmv1beta1.VMRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-alerts", Namespace: "default"},
+		Spec: vmv1beta1.VMRuleSpec{Groups: []vmv1beta1.RuleGroup{{
+			Name:  "node",
+			Rules: []vmv1beta1.Rule{{Alert: "NodeDown", Expr: "up == 0"}},
+		}}},
+	}
+	cr := &vmv1beta1.VMRuleTest{
+		ObjectMeta: metav1.ObjectMeta{Name: "node", Namespace: "default"},
+		Spec: vmv1beta1.VMRuleTestSpec{
+			Rules:      []string{"node-alerts"},
+			StrictMode: true,
+			Image:      vmv1beta1.Image{Repository: "victoriametrics/vmalert-tool", Tag: "v1.103.0"},
+			Tests: []vmv1beta1.RuleTestGroup{{
+				InputSeries:    []vmv1beta1.RuleTestInputSeries{{Series: `up{job="node"}`, Values: "0x10"}},
+				AlertRuleTests: []vmv1beta1.AlertRuleTestCase{{EvalTime: "5m", GroupName: "node", AlertName: "NodeDown"}},
+			}},
+		},
+	}
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{rule, cr})
+
+	done, err := CreateOrUpdateRuleTest(ctx, fclient, cr)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.False(t, done)
+	assert.Equal(t, vmv1beta1.UpdateStatusExpanding, cr.Status.Status)
+
+	var cm corev1.ConfigMap
+	if err := fclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vmruletest-node"}, &cm); err != nil {
+		t.Fatalf("cannot get tests configmap: %s", err)
+	}
+	assert.Contains(t, cm.Data, "default-node-alerts.yaml")
+	assert.Contains(t, cm.Data, ruleTestsFileName)
+	hash := cm.Annotations[ruleTestHashAnnotation]
+
+	var job batchv1.Job
+	if err := fclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vmruletest-node"}, &job); err != nil {
+		t.Fatalf("cannot get tests job: %s", err)
+	}
+	assert.Equal(t, hash, job.Annotations[ruleTestHashAnnotation])
+	assert.Equal(t, []string{"unittest", "--files=/etc/vmalert-tool/tests/tests.yaml"}, job.Spec.Template.Spec.Containers[0].Args)
+	assert.Equal(t, "victoriametrics/vmalert-tool:v1.103.0", job.Spec.Template.Spec.Containers[0].Image)
+
+	// job is still running
+	done, err = CreateOrUpdateRuleTest(ctx, fclient, cr)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.False(t, done)
+
+	// job failed
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}
+	if err := fclient.Status().Update(ctx, &job); err != nil {
+		t.Fatalf("cannot update job status: %s", err)
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vmruletest-node-abcd",
+			Namespace: "default",
+			Labels:    map[string]string{"app.kubernetes.io/name": "vmruletest", "app.kubernetes.io/instance": "node", "app.kubernetes.io/component": "monitoring", "managed-by": "vm-operator", ruleTestHashLabel: hash},
+		},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+			Name:  "vmalert-tool",
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Message: "alertname: NodeDown, time: 5m0s, \n exp:[], \n got:[...]\n"}},
+		}}},
+	}
+	if err := fclient.Create(ctx, pod); err != nil {
+		t.Fatalf("cannot create tests pod: %s", err)
+	}
+	done, err = CreateOrUpdateRuleTest(ctx, fclient, cr)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.True(t, done)
+	assert.Equal(t, vmv1beta1.RuleTestFailed, cr.Status.Result)
+	assert.Equal(t, hash, cr.Status.TestedHash)
+	assert.Equal(t, "alertname: NodeDown, time: 5m0s, \n exp:[], \n got:[...]", cr.Status.Message)
+	assert.True(t, cr.IsBlocksRule("node-alerts"))
+
+	// rule changed, outdated job must be removed
+	if err := fclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "node-alerts"}, rule); err != nil {
+		t.Fatalf("cannot get rule: %s", err)
+	}
+	rule.Spec.Groups[0].Rules[0].For = "10m"
+	if err := fclient.Update(ctx, rule); err != nil {
+		t.Fatalf("cannot update rule: %s", err)
+	}
+	done, err = CreateOrUpdateRuleTest(ctx, fclient, cr)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.False(t, done)
+	assert.Equal(t, vmv1beta1.RuleTestFailed, cr.Status.Result)
+	var jobs batchv1.JobList
+	if err := fclient.List(ctx, &jobs); err != nil {
+		t.Fatalf("cannot list jobs: %s", err)
+	}
+	assert.Len(t, jobs.Items, 0)
+}
+
+func TestSelectRulesBlockedByRuleTest(t *testing.T) {
+	ctx := context.Background()
+	vmalert := &vmv1beta1.VMAlert{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-vm-alert", Namespace: "default"},
+		Spec:       vmv1beta1.VMAlertSpec{SelectAllByDefault: true},
+	}
+	newRule := func(name string) *vmv1beta1.VMRule {
+		return &vmv1beta1.VMRule{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: vmv1beta1.VMRuleSpec{Groups: []vmv1beta1.RuleGroup{{
+				Name:  name,
+				Rules: []vmv1beta1.Rule{{Alert: "NodeDown", Expr: "up == 0"}},
+			}}},
+		}
+	}
+	newRuleTest := func(name, rule string, strictMode bool) *vmv1beta1.VMRuleTest {
+		return &vmv1beta1.VMRuleTest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       vmv1beta1.VMRuleTestSpec{Rules: []string{rule}, StrictMode: strictMode},
+			Status:     vmv1beta1.VMRuleTestStatus{Result: vmv1beta1.RuleTestFailed},
+		}
+	}
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		newRule("blocked"),
+		newRule("not-strict"),
+		newRule("untested"),
+		newRuleTest("blocked-test", "blocked", true),
+		newRuleTest("not-strict-test", "not-strict", false),
+	})
+	got, err := selectRulesUpdateStatus(ctx, vmalert, fclient)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.NotContains(t, got, "default-blocked.yaml")
+	assert.Contains(t, got, "default-not-strict.yaml")
+	assert.Contains(t, got, "default-untested.yaml")
+
+	var blocked vmv1beta1.VMRule
+	if err := fclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "blocked"}, &blocked); err != nil {
+		t.Fatalf("cannot get rule: %s", err)
+	}
+	assert.Equal(t, vmv1beta1.UpdateStatusBlocked, blocked.Status.Status)
+	assert.Equal(t, "rule is blocked by failed tests at vmruletest=blocked-test", blocked.Status.LastSyncError)
+}
@@ -0,0 +1,65 @@
+package vmalert
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+// withStatePersistence returns copy of vmalert with remoteWrite and remoteRead
+// pointed to the storage object referenced by statePersistence
+func withStatePersistence(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAlert) (*vmv1beta1.VMAlert, error) {
+	sp := cr.Spec.StatePersistence
+	if sp == nil {
+		return cr, nil
+	}
+	writeURL, readURL, err := statePersistenceURLs(ctx, rclient, cr.Namespace, sp)
+	if err != nil {
+		return nil, err
+	}
+	cr = cr.DeepCopy()
+	cr.Spec.RemoteWrite = &vmv1beta1.VMAlertRemoteWriteSpec{URL: writeURL}
+	cr.Spec.RemoteRead = &vmv1beta1.VMAlertRemoteReadSpec{URL: readURL, Lookback: sp.Lookback}
+	return cr, nil
+}
+
+func statePersistenceURLs(ctx context.Context, rclient client.Client, namespace string, sp *vmv1beta1.VMAlertStatePersistence) (string, string, error) {
+	nsn := types.NamespacedName{Namespace: namespace, Name: sp.Name}
+	switch sp.Kind {
+	case "VMSingle":
+		var vmSingle vmv1beta1.VMSingle
+		if err := rclient.Get(ctx, nsn, &vmSingle); err != nil {
+			return "", "", statePersistenceGetError(sp, err)
+		}
+		url := vmSingle.AsURL()
+		return url, url, nil
+	case "VMCluster":
+		var vmCluster vmv1beta1.VMCluster
+		if err := rclient.Get(ctx, nsn, &vmCluster); err != nil {
+			return "", "", statePersistenceGetError(sp, err)
+		}
+		if vmCluster.Spec.VMInsert == nil || vmCluster.Spec.VMSelect == nil {
+			return "", "", fmt.Errorf("vmcluster=%q must have vminsert and vmselect for state persistence", vmCluster.Name)
+		}
+		tenant := sp.Tenant
+		if tenant == "" {
+			tenant = "0"
+		}
+		return fmt.Sprintf("%s/insert/%s/prometheus", vmCluster.VMInsertURL(), tenant),
+			fmt.Sprintf("%s/select/%s/prometheus", vmCluster.VMSelectURL(), tenant), nil
+	default:
+		return "", "", fmt.Errorf("unsupported statePersistence kind=%q", sp.Kind)
+	}
+}
+
+func statePersistenceGetError(sp *vmv1beta1.VMAlertStatePersistence, err error) error {
+	if errors.IsNotFound(err) {
+		return fmt.Errorf("cannot find %s=%q referenced by statePersistence", sp.Kind, sp.Name)
+	}
+	return fmt.Errorf("cannot get %s=%q: %w", sp.Kind, sp.Name, err)
+}
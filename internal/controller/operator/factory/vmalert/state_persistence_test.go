@@ -0,0 +1,76 @@
+package vmalert
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestWithStatePersistence(t *testing.T) {
+	predefinedObjects := []runtime.Object{
+		&vmv1beta1.VMSingle{ObjectMeta: metav1.ObjectMeta{Name: "single", Namespace: "default"}},
+		&vmv1beta1.VMCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"},
+			Spec: vmv1beta1.VMClusterSpec{
+				VMInsert: &vmv1beta1.VMInsert{},
+				VMSelect: &vmv1beta1.VMSelect{},
+			},
+		},
+		&vmv1beta1.VMCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "storage-only", Namespace: "default"},
+			Spec:       vmv1beta1.VMClusterSpec{VMStorage: &vmv1beta1.VMStorage{}},
+		},
+	}
+	f := func(sp *vmv1beta1.VMAlertStatePersistence, wantWrite, wantRead *string, wantErr bool) {
+		t.Helper()
+		rclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		cr := &vmv1beta1.VMAlert{
+			ObjectMeta: metav1.ObjectMeta{Name: "vmalert", Namespace: "default"},
+			Spec:       vmv1beta1.VMAlertSpec{StatePersistence: sp},
+		}
+		got, err := withStatePersistence(context.Background(), rclient, cr)
+		if wantErr {
+			assert.Error(t, err)
+			return
+		}
+		assert.NoError(t, err)
+		if wantWrite == nil {
+			assert.Nil(t, got.Spec.RemoteWrite)
+			assert.Nil(t, got.Spec.RemoteRead)
+			return
+		}
+		assert.Equal(t, *wantWrite, got.Spec.RemoteWrite.URL)
+		assert.Equal(t, *wantRead, got.Spec.RemoteRead.URL)
+		assert.Equal(t, sp.Lookback, got.Spec.RemoteRead.Lookback)
+		// original object must not be changed
+		assert.Nil(t, cr.Spec.RemoteWrite)
+	}
+
+	// not configured
+	f(nil, nil, nil, false)
+
+	// vmsingle
+	f(&vmv1beta1.VMAlertStatePersistence{Kind: "VMSingle", Name: "single", Lookback: ptr.To("2h")},
+		ptr.To("http://vmsingle-single.default.svc:8429"), ptr.To("http://vmsingle-single.default.svc:8429"), false)
+
+	// vmcluster with default tenant
+	f(&vmv1beta1.VMAlertStatePersistence{Kind: "VMCluster", Name: "cluster"},
+		ptr.To("http://vminsert-cluster.default.svc:8480/insert/0/prometheus"), ptr.To("http://vmselect-cluster.default.svc:8481/select/0/prometheus"), false)
+
+	// vmcluster with tenant
+	f(&vmv1beta1.VMAlertStatePersistence{Kind: "VMCluster", Name: "cluster", Tenant: "1:2"},
+		ptr.To("http://vminsert-cluster.default.svc:8480/insert/1:2/prometheus"), ptr.To("http://vmselect-cluster.default.svc:8481/select/1:2/prometheus"), false)
+
+	// vmcluster without vminsert and vmselect
+	f(&vmv1beta1.VMAlertStatePersistence{Kind: "VMCluster", Name: "storage-only"}, nil, nil, true)
+
+	// missing object
+	f(&vmv1beta1.VMAlertStatePersistence{Kind: "VMSingle", Name: "missing"}, nil, nil, true)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path"
 	"sort"
+	"strconv"
 	"strings"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
@@ -14,6 +15,7 @@ import (
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/tracing"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
@@ -129,6 +131,10 @@ func CreateOrUpdateVMAlert(ctx context.Context, cr *vmv1beta1.VMAlert, rclient c
 			return fmt.Errorf("failed create service account: %w", err)
 		}
 	}
+	cr, err := withStatePersistence(ctx, rclient, cr)
+	if err != nil {
+		return err
+	}
 	if err := discoverNotifierIfNeeded(ctx, rclient, cr); err != nil {
 		return fmt.Errorf("cannot discover additional notifiers: %w", err)
 	}
@@ -164,22 +170,79 @@ func CreateOrUpdateVMAlert(ctx context.Context, cr *vmv1beta1.VMAlert, rclient c
 	if err != nil {
 		return err
 	}
-	var prevDeploy *appsv1.Deployment
+	var prevCR *vmv1beta1.VMAlert
 	if cr.ParsedLastAppliedSpec != nil {
-		prevCR := cr.DeepCopy()
+		prevCR = cr.DeepCopy()
 		prevCR.Spec = *cr.ParsedLastAppliedSpec
-		prevDeploy, err = newDeployForVMAlert(prevCR, cmNames, remoteSecrets)
+		// previously referenced storage object could be already removed
+		if resolvedCR, err := withStatePersistence(ctx, rclient, prevCR); err == nil {
+			prevCR = resolvedCR
+		}
+	}
+
+	shardsCount := getShardsCount(cr)
+	if shardsCount > 1 {
+		logger.WithContext(ctx).Info("using sharded VMAlert with", "shards", shardsCount)
+	}
+	deploymentNames := make(map[string]struct{}, shardsCount)
+	for shardNum := 0; shardNum < shardsCount; shardNum++ {
+		shardCMNames := cmNames
+		if shardsCount > 1 {
+			shardCMNames = shardRuleConfigMapNames(cr, cmNames, shardNum)
+		}
+		var prevDeploy *appsv1.Deployment
+		if prevCR != nil {
+			prevDeploy, err = newDeployForVMAlert(prevCR, shardCMNames, remoteSecrets)
+			if err != nil {
+				return fmt.Errorf("cannot generate prev deploy spec: %w", err)
+			}
+		}
+
+		newDeploy, err := tracing.Build(ctx, "vmalert", func() (*appsv1.Deployment, error) {
+			return newDeployForVMAlert(cr, shardCMNames, remoteSecrets)
+		})
 		if err != nil {
-			return fmt.Errorf("cannot generate prev deploy spec: %w", err)
+			return fmt.Errorf("cannot generate new deploy for vmalert: %w", err)
+		}
+		if err := build.AddLicenseChecksumAnnotation(ctx, rclient, cr.Namespace, cr.Spec.License, newDeploy); err != nil {
+			return err
+		}
+		if shardsCount > 1 {
+			addShardSettingsToVMAlert(shardNum, newDeploy)
+			if prevDeploy != nil {
+				addShardSettingsToVMAlert(shardNum, prevDeploy)
+			}
+		}
+		// patches are applied to the shard, so they could target it by name
+		if err := build.ApplyOverridePatches(cr.Spec.OverridePatches, newDeploy); err != nil {
+			return err
+		}
+		if prevDeploy != nil {
+			if err := build.ApplyOverridePatches(prevCR.Spec.OverridePatches, prevDeploy); err != nil {
+				return fmt.Errorf("cannot apply override patches to prev object: %w", err)
+			}
 		}
+		if err := reconcile.Deployment(ctx, rclient, newDeploy, prevDeploy, false); err != nil {
+			return err
+		}
+		deploymentNames[newDeploy.Name] = struct{}{}
 	}
 
-	newDeploy, err := newDeployForVMAlert(cr, cmNames, remoteSecrets)
-	if err != nil {
-		return fmt.Errorf("cannot generate new deploy for vmalert: %w", err)
+	return finalize.RemoveOrphanedDeployments(ctx, rclient, cr, deploymentNames)
+}
+
+// getShardsCount returns number of vmalert shards, 1 is returned for not sharded vmalert
+func getShardsCount(cr *vmv1beta1.VMAlert) int {
+	if cr.Spec.ShardCount != nil && *cr.Spec.ShardCount > 1 {
+		return *cr.Spec.ShardCount
 	}
+	return 1
+}
 
-	return reconcile.Deployment(ctx, rclient, newDeploy, prevDeploy, false)
+func addShardSettingsToVMAlert(shardNum int, dep *appsv1.Deployment) {
+	dep.Name = fmt.Sprintf("%s-%d", dep.Name, shardNum)
+	dep.Spec.Selector.MatchLabels["shard-num"] = strconv.Itoa(shardNum)
+	dep.Spec.Template.Labels["shard-num"] = strconv.Itoa(shardNum)
 }
 
 // newDeployForCR returns a busybox pod with the same name/namespace as the cr
@@ -197,7 +260,7 @@ func newDeployForVMAlert(cr *vmv1beta1.VMAlert, ruleConfigMapNames []string, rem
 			Labels:          cr.AllLabels(),
 			Annotations:     cr.AnnotationsFiltered(),
 			OwnerReferences: cr.AsOwner(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Spec: *generatedSpec,
 	}
@@ -520,6 +583,7 @@ func buildVMAlertArgs(cr *vmv1beta1.VMAlert, ruleConfigMapNames []string, remote
 
 	args = cr.Spec.License.MaybeAddToArgs(args, vmv1beta1.SecretsDir)
 
+	args = build.AddVersionedArgs("vmalert", cr.Spec.Image.Tag, args, "-")
 	args = build.AddExtraArgsOverrideDefaults(args, cr.Spec.ExtraArgs, "-")
 	sort.Strings(args)
 	return args
@@ -617,7 +681,7 @@ func createOrUpdateTLSAssetsForVMAlert(ctx context.Context, cr *vmv1beta1.VMAler
 			Annotations:     cr.AnnotationsFiltered(),
 			OwnerReferences: cr.AsOwner(),
 			Namespace:       cr.Namespace,
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Data: map[string][]byte{},
 	}
@@ -983,6 +1047,10 @@ func discoverNotifierIfNeeded(ctx context.Context, rclient client.Client, cr *vm
 				continue
 			}
 			dsc := item.AsNotifiers()
+			for j := range dsc {
+				// discovered replicas share auth and tls settings of notifier with selector
+				n.HTTPAuth.DeepCopyInto(&dsc[j].HTTPAuth)
+			}
 			additionalNotifiers = append(additionalNotifiers, dsc...)
 		}
 	}
@@ -16,6 +16,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -672,6 +673,78 @@ func TestBuildNotifiers(t *testing.T) {
 	}
 }
 
+func TestDiscoverNotifiers(t *testing.T) {
+	f := func(cr *vmv1beta1.VMAlert, predefinedObjects []runtime.Object, want []vmv1beta1.VMAlertNotifierSpec) {
+		t.Helper()
+		fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		if err := discoverNotifierIfNeeded(context.Background(), fclient, cr); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assert.Equal(t, want, cr.Spec.Notifiers)
+	}
+	tlsCfg := &vmv1beta1.TLSConfig{
+		CAFile:   "/tmp/ca.cert",
+		CertFile: "/tmp/cert.pem",
+		KeyFile:  "/tmp/key.pem",
+	}
+	ams := []runtime.Object{
+		&vmv1beta1.VMAlertmanager{
+			ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "monitoring", Labels: map[string]string{"notify": "true"}},
+			Spec: vmv1beta1.VMAlertmanagerSpec{
+				CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+					ReplicaCount: ptr.To[int32](2),
+				},
+				RoutePrefix: "/alertmanager/",
+				WebConfig: &vmv1beta1.AlertmanagerWebConfig{
+					TLSServerConfig: &vmv1beta1.TLSServerConfig{},
+				},
+			},
+		},
+		&vmv1beta1.VMAlertmanager{
+			ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: "team-a", Labels: map[string]string{"notify": "true"}},
+		},
+		&vmv1beta1.VMAlertmanager{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "monitoring"},
+		},
+	}
+
+	// notifiers across namespaces with tls
+	f(&vmv1beta1.VMAlert{
+		ObjectMeta: metav1.ObjectMeta{Name: "vmalert", Namespace: "default"},
+		Spec: vmv1beta1.VMAlertSpec{
+			Notifiers: []vmv1beta1.VMAlertNotifierSpec{
+				{URL: "http://static-am:9093"},
+				{
+					Selector: &vmv1beta1.DiscoverySelector{
+						Namespace: &vmv1beta1.NamespaceSelector{Any: true},
+						Labels:    &metav1.LabelSelector{MatchLabels: map[string]string{"notify": "true"}},
+					},
+					HTTPAuth: vmv1beta1.HTTPAuth{TLSConfig: tlsCfg},
+				},
+			},
+		},
+	}, ams, []vmv1beta1.VMAlertNotifierSpec{
+		{URL: "http://static-am:9093"},
+		{URL: "https://vmalertmanager-main-1.vmalertmanager-main.monitoring.svc:9093/alertmanager", HTTPAuth: vmv1beta1.HTTPAuth{TLSConfig: tlsCfg}},
+		{URL: "https://vmalertmanager-main-0.vmalertmanager-main.monitoring.svc:9093/alertmanager", HTTPAuth: vmv1beta1.HTTPAuth{TLSConfig: tlsCfg}},
+		{URL: "http://vmalertmanager-team-0.vmalertmanager-team.team-a.svc:9093", HTTPAuth: vmv1beta1.HTTPAuth{TLSConfig: tlsCfg}},
+	})
+
+	// namespace selector
+	f(&vmv1beta1.VMAlert{
+		ObjectMeta: metav1.ObjectMeta{Name: "vmalert", Namespace: "default"},
+		Spec: vmv1beta1.VMAlertSpec{
+			Notifier: &vmv1beta1.VMAlertNotifierSpec{
+				Selector: &vmv1beta1.DiscoverySelector{
+					Namespace: &vmv1beta1.NamespaceSelector{MatchNames: []string{"team-a"}},
+				},
+			},
+		},
+	}, ams, []vmv1beta1.VMAlertNotifierSpec{
+		{URL: "http://vmalertmanager-team-0.vmalertmanager-team.team-a.svc:9093"},
+	})
+}
+
 func TestCreateOrUpdateVMAlertService(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -779,3 +852,64 @@ func Test_buildVMAlertArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateOrUpdateVMAlertShards(t *testing.T) {
+	cr := &vmv1beta1.VMAlert{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sharded",
+			Namespace: "default",
+		},
+		Spec: vmv1beta1.VMAlertSpec{
+			ShardCount: ptr.To(2),
+			Notifier:   &vmv1beta1.VMAlertNotifierSpec{URL: "http://some-alertmanager"},
+			Datasource: vmv1beta1.VMAlertDatasourceSpec{URL: "http://some-vm-datasource"},
+			CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+				OverridePatches: []vmv1beta1.OverridePatch{{Kind: "Deployment", Name: "vmalert-sharded-1", Patch: `{"spec":{"minReadySeconds":10}}`}},
+			},
+		},
+	}
+	readyDeployment := func(name string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: cr.SelectorLabels()},
+			Status: appsv1.DeploymentStatus{
+				Conditions: []appsv1.DeploymentCondition{{Reason: "NewReplicaSetAvailable", Type: appsv1.DeploymentProgressing, Status: "True"}},
+			},
+		}
+	}
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{
+		readyDeployment("vmalert-sharded"),
+		readyDeployment("vmalert-sharded-0"),
+		readyDeployment("vmalert-sharded-1"),
+	})
+	ctx := context.TODO()
+	cmNames := []string{"vm-sharded-rulefiles-shard-0-0", "vm-sharded-rulefiles-shard-1-0", "vm-sharded-rulefiles-shard-1-1"}
+	if err := CreateOrUpdateVMAlert(ctx, cr, fclient, cmNames); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	f := func(name, shardNum string, wantCMNames []string, wantMinReadySeconds int32) {
+		t.Helper()
+		var dep appsv1.Deployment
+		if err := fclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: name}, &dep); err != nil {
+			t.Fatalf("cannot get deployment=%q: %s", name, err)
+		}
+		assert.Equal(t, shardNum, dep.Spec.Selector.MatchLabels["shard-num"])
+		assert.Equal(t, shardNum, dep.Spec.Template.Labels["shard-num"])
+		assert.Equal(t, wantMinReadySeconds, dep.Spec.MinReadySeconds)
+		var gotCMNames []string
+		for _, v := range dep.Spec.Template.Spec.Volumes {
+			if v.ConfigMap != nil && strings.HasPrefix(v.ConfigMap.Name, "vm-sharded-rulefiles") {
+				gotCMNames = append(gotCMNames, v.ConfigMap.Name)
+			}
+		}
+		assert.Equal(t, wantCMNames, gotCMNames)
+	}
+	f("vmalert-sharded-0", "0", []string{"vm-sharded-rulefiles-shard-0-0"}, 0)
+	f("vmalert-sharded-1", "1", []string{"vm-sharded-rulefiles-shard-1-0", "vm-sharded-rulefiles-shard-1-1"}, 10)
+
+	// deployment of not sharded vmalert must be removed
+	var dep appsv1.Deployment
+	if err := fclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vmalert-sharded"}, &dep); err == nil {
+		t.Fatalf("expected not sharded deployment to be removed")
+	}
+}
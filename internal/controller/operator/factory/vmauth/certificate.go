@@ -0,0 +1,49 @@
+package vmauth
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/certmanager"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// createOrUpdateVMAuthCertificate issues vmauth serving certificate with cert-manager
+func createOrUpdateVMAuthCertificate(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAuth) error {
+	if cr.Spec.CertManager == nil {
+		return nil
+	}
+	return certmanager.CreateOrUpdate(ctx, rclient, buildVMAuthCertificate(cr))
+}
+
+func buildVMAuthCertificate(cr *vmv1beta1.VMAuth) *certmanager.Certificate {
+	dnsNames := certmanager.ServiceDNSNames(cr.PrefixedName(), cr.Namespace)
+	return &certmanager.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            cr.PrefixedName(),
+			Namespace:       cr.Namespace,
+			Labels:          cr.AllLabels(),
+			Annotations:     cr.AnnotationsFiltered(),
+			OwnerReferences: cr.AsOwner(),
+		},
+		SecretName: cr.CertManagerSecretName(),
+		CommonName: dnsNames[len(dnsNames)-1],
+		DNSNames:   dnsNames,
+		Usages:     []string{certmanager.UsageServerAuth, certmanager.UsageDigitalSignature, certmanager.UsageKeyEncipherment},
+		Spec:       cr.Spec.CertManager,
+	}
+}
+
+// buildVMAuthCertificateArgs returns vmauth flags for serving https with certificate issued by cert-manager
+func buildVMAuthCertificateArgs(cr *vmv1beta1.VMAuth) []string {
+	certDir := path.Join(vmv1beta1.SecretsDir, cr.CertManagerSecretName())
+	return []string{
+		"-tls=true",
+		fmt.Sprintf("-tlsCertFile=%s", path.Join(certDir, "tls.crt")),
+		fmt.Sprintf("-tlsKeyFile=%s", path.Join(certDir, "tls.key")),
+	}
+}
@@ -0,0 +1,57 @@
+package vmauth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+func TestMakeSpecForVMAuthWithCertManager(t *testing.T) {
+	f := func(extraArgs map[string]string, wantArgs []string, wantScheme corev1.URIScheme) {
+		t.Helper()
+		cr := &vmv1beta1.VMAuth{
+			ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"},
+			Spec: vmv1beta1.VMAuthSpec{
+				CommonDefaultableParams: vmv1beta1.CommonDefaultableParams{
+					Port:  "8427",
+					Image: vmv1beta1.Image{Repository: "victoriametrics/vmauth", Tag: "v1.103.0"},
+				},
+				CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+					ExtraArgs: extraArgs,
+				},
+				CertManager: &vmv1beta1.CertManagerCertificate{},
+			},
+		}
+		got, err := makeSpecForVMAuth(cr, cr.ConfigSecretName())
+		if err != nil {
+			t.Fatalf("cannot build pod spec: %s", err)
+		}
+		vmauth := got.Spec.Containers[0]
+		for _, arg := range wantArgs {
+			assert.Contains(t, vmauth.Args, arg)
+		}
+		assert.Contains(t, vmauth.VolumeMounts, corev1.VolumeMount{
+			Name:      "secret-vmauth-tls-main",
+			ReadOnly:  true,
+			MountPath: "/etc/vm/secrets/vmauth-tls-main",
+		})
+		assert.Equal(t, wantScheme, vmauth.ReadinessProbe.HTTPGet.Scheme)
+	}
+	f(nil, []string{
+		"-tls=true",
+		"-tlsCertFile=/etc/vm/secrets/vmauth-tls-main/tls.crt",
+		"-tlsKeyFile=/etc/vm/secrets/vmauth-tls-main/tls.key",
+	}, corev1.URISchemeHTTPS)
+
+	// user defined flags have priority
+	f(map[string]string{"tlsMinVersion": "TLS13", "tlsKeyFile": "/etc/custom/tls.key"}, []string{
+		"-tls=true",
+		"-tlsMinVersion=TLS13",
+		"-tlsCertFile=/etc/vm/secrets/vmauth-tls-main/tls.crt",
+		"-tlsKeyFile=/etc/custom/tls.key",
+	}, corev1.URISchemeHTTPS)
+}
@@ -0,0 +1,112 @@
+package vmauth
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// httpRouteGVK is Gateway API HTTPRoute
+// it's managed as unstructured object, since Gateway API isn't a part of kubernetes API
+var httpRouteGVK = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "HTTPRoute"}
+
+// createOrUpdateVMAuthHTTPRoute handles Gateway API HTTPRoute for vmauth.
+func createOrUpdateVMAuthHTTPRoute(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAuth) error {
+	if cr.Spec.HTTPRoute == nil {
+		return nil
+	}
+	newRoute, err := buildHTTPRouteConfig(cr)
+	if err != nil {
+		return err
+	}
+	reconcile.TrackChild(ctx, "HTTPRoute", newRoute, newRoute.Object["spec"])
+	existRoute := &unstructured.Unstructured{}
+	existRoute.SetGroupVersionKind(httpRouteGVK)
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: newRoute.GetNamespace(), Name: newRoute.GetName()}, existRoute); err != nil {
+		if errors.IsNotFound(err) {
+			return rclient.Create(ctx, newRoute)
+		}
+		return fmt.Errorf("cannot get httproute for vmauth: %w", err)
+	}
+	newRoute.SetAnnotations(labels.Merge(existRoute.GetAnnotations(), newRoute.GetAnnotations()))
+	newRoute.SetResourceVersion(existRoute.GetResourceVersion())
+	return rclient.Update(ctx, newRoute)
+}
+
+// deleteVMAuthHTTPRoute removes httproute created for vmauth
+func deleteVMAuthHTTPRoute(ctx context.Context, rclient client.Client, objMeta metav1.ObjectMeta) error {
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(httpRouteGVK)
+	route.SetName(objMeta.Name)
+	route.SetNamespace(objMeta.Namespace)
+	return finalize.SafeDelete(ctx, rclient, route)
+}
+
+// buildHTTPRouteConfig builds HTTPRoute, which forwards all requests to vmauth service
+func buildHTTPRouteConfig(cr *vmv1beta1.VMAuth) (*unstructured.Unstructured, error) {
+	port, err := strconv.ParseInt(cr.Spec.Port, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse vmauth port=%q: %w", cr.Spec.Port, err)
+	}
+	parentRefs := make([]any, 0, len(cr.Spec.HTTPRoute.ParentRefs))
+	for _, ref := range cr.Spec.HTTPRoute.ParentRefs {
+		parentRef := map[string]any{
+			"name": ref.Name,
+		}
+		if ref.Namespace != "" {
+			parentRef["namespace"] = ref.Namespace
+		}
+		if ref.SectionName != "" {
+			parentRef["sectionName"] = ref.SectionName
+		}
+		parentRefs = append(parentRefs, parentRef)
+	}
+	spec := map[string]any{
+		"parentRefs": parentRefs,
+		"rules": []any{
+			map[string]any{
+				"matches": []any{
+					map[string]any{
+						"path": map[string]any{
+							"type":  "PathPrefix",
+							"value": "/",
+						},
+					},
+				},
+				"backendRefs": []any{
+					map[string]any{
+						"name": cr.PrefixedName(),
+						"port": port,
+					},
+				},
+			},
+		},
+	}
+	if len(cr.Spec.HTTPRoute.Hostnames) > 0 {
+		hostnames := make([]any, 0, len(cr.Spec.HTTPRoute.Hostnames))
+		for _, h := range cr.Spec.HTTPRoute.Hostnames {
+			hostnames = append(hostnames, h)
+		}
+		spec["hostnames"] = hostnames
+	}
+	route := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	route.SetGroupVersionKind(httpRouteGVK)
+	route.SetName(cr.PrefixedName())
+	route.SetNamespace(cr.Namespace)
+	route.SetLabels(labels.Merge(cr.Spec.HTTPRoute.Labels, cr.SelectorLabels()))
+	route.SetAnnotations(cr.Spec.HTTPRoute.Annotations)
+	route.SetOwnerReferences(cr.AsOwner())
+	return route, nil
+}
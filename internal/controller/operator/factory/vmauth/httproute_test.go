@@ -0,0 +1,73 @@
+package vmauth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+func TestCreateOrUpdateVMAuthHTTPRoute(t *testing.T) {
+	f := func(httpRoute *vmv1beta1.EmbeddedHTTPRoute, wantSpec map[string]any) {
+		t.Helper()
+		ctx := context.Background()
+		rclient := fake.NewClientBuilder().Build()
+		cr := &vmv1beta1.VMAuth{
+			ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"},
+			Spec: vmv1beta1.VMAuthSpec{
+				CommonDefaultableParams: vmv1beta1.CommonDefaultableParams{Port: "8427"},
+				HTTPRoute:               httpRoute,
+			},
+		}
+		// create and update
+		for i := 0; i < 2; i++ {
+			if err := createOrUpdateVMAuthHTTPRoute(ctx, rclient, cr); err != nil {
+				t.Fatalf("cannot reconcile httproute: %s", err)
+			}
+		}
+		got := &unstructured.Unstructured{}
+		got.SetGroupVersionKind(httpRouteGVK)
+		if err := rclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: cr.PrefixedName()}, got); err != nil {
+			t.Fatalf("cannot get httproute: %s", err)
+		}
+		assert.Equal(t, wantSpec, got.Object["spec"])
+		assert.Equal(t, "team-a", got.GetLabels()["team"])
+		assert.Len(t, got.GetOwnerReferences(), 1)
+
+		if err := deleteVMAuthHTTPRoute(ctx, rclient, metav1.ObjectMeta{Namespace: "default", Name: cr.PrefixedName()}); err != nil {
+			t.Fatalf("cannot delete httproute: %s", err)
+		}
+	}
+	rules := []any{
+		map[string]any{
+			"matches": []any{
+				map[string]any{"path": map[string]any{"type": "PathPrefix", "value": "/"}},
+			},
+			"backendRefs": []any{
+				map[string]any{"name": "vmauth-main", "port": int64(8427)},
+			},
+		},
+	}
+	f(&vmv1beta1.EmbeddedHTTPRoute{
+		EmbeddedObjectMetadata: vmv1beta1.EmbeddedObjectMetadata{Labels: map[string]string{"team": "team-a"}},
+		ParentRefs:             []vmv1beta1.HTTPRouteParentRef{{Name: "public"}},
+	}, map[string]any{
+		"parentRefs": []any{map[string]any{"name": "public"}},
+		"rules":      rules,
+	})
+	f(&vmv1beta1.EmbeddedHTTPRoute{
+		EmbeddedObjectMetadata: vmv1beta1.EmbeddedObjectMetadata{Labels: map[string]string{"team": "team-a"}},
+		ParentRefs:             []vmv1beta1.HTTPRouteParentRef{{Name: "public", Namespace: "gateways", SectionName: "https"}},
+		Hostnames:              []string{"vmauth.example.com"},
+	}, map[string]any{
+		"parentRefs": []any{map[string]any{"name": "public", "namespace": "gateways", "sectionName": "https"}},
+		"hostnames":  []any{"vmauth.example.com"},
+		"rules":      rules,
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
 
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -40,7 +41,7 @@ func buildVMAuthRole(cr *vmv1beta1.VMAuth) *rbacv1.Role {
 			Namespace:       cr.Namespace,
 			Labels:          cr.AllLabels(),
 			Annotations:     cr.AnnotationsFiltered(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 			OwnerReferences: cr.AsOwner(),
 		},
 		Rules: []rbacv1.PolicyRule{
@@ -60,7 +61,7 @@ func buildVMAuthRoleBinding(cr *vmv1beta1.VMAuth) *rbacv1.RoleBinding {
 			Namespace:       cr.Namespace,
 			Labels:          cr.AllLabels(),
 			Annotations:     cr.AnnotationsFiltered(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 			OwnerReferences: cr.AsOwner(),
 		},
 		RoleRef: rbacv1.RoleRef{
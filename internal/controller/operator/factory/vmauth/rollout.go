@@ -0,0 +1,251 @@
+package vmauth
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/build"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// canaryConfigHashAnnotation is set at canary pod template
+// it forces canary restart on configuration change and distinguishes pods with the verified configuration
+const canaryConfigHashAnnotation = "operator.victoriametrics.com/canary-config-hash"
+
+const canaryReloadSuccessMetric = "vmauth_config_last_reload_successful"
+
+var canaryPollInterval = time.Second
+
+// canary serves certificate issued for service name, it cannot be verified for requests made by pod IP
+var canaryHTTPClient = &http.Client{
+	Timeout: 5 * time.Second,
+	Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // #nosec G402
+	},
+}
+
+// rolloutVMAuthConfig applies the given configuration secret in two phases.
+// At first, new configuration is loaded by canary replica and only after successful verification
+// it's written into the stable secret, which is used by the rest of replicas.
+// If canary rejects configuration, the stable secret keeps its previous revision.
+func rolloutVMAuthConfig(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAuth, s *corev1.Secret) error {
+	var prevSecret corev1.Secret
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: s.Namespace, Name: s.Name}, &prevSecret); err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("cannot get vmauth config secret: %w", err)
+		}
+		// there is no previous configuration to keep, apply it as is
+		return reconcile.Secret(ctx, rclient, s)
+	}
+	if equality.Semantic.DeepEqual(prevSecret.Data, s.Data) {
+		if err := reconcile.Secret(ctx, rclient, s); err != nil {
+			return err
+		}
+		return deleteVMAuthCanary(ctx, rclient, cr)
+	}
+	if err := verifyVMAuthCanary(ctx, rclient, cr, s); err != nil {
+		if err := deleteVMAuthCanary(ctx, rclient, cr); err != nil {
+			logger.WithContext(ctx).Error(err, "cannot delete vmauth canary after failed configuration rollout")
+		}
+		return fmt.Errorf("canary=%s rejected new configuration, previous configuration is kept: %w", cr.CanaryName(), err)
+	}
+	logger.WithContext(ctx).Info(fmt.Sprintf("canary=%s loaded new configuration, rolling it out to the rest of replicas", cr.CanaryName()))
+	if err := reconcile.Secret(ctx, rclient, s); err != nil {
+		return err
+	}
+	return deleteVMAuthCanary(ctx, rclient, cr)
+}
+
+// verifyVMAuthCanary starts canary replica with the given configuration
+// and waits until it reports successfully loaded configuration
+func verifyVMAuthCanary(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAuth, s *corev1.Secret) error {
+	canarySecret := s.DeepCopy()
+	canarySecret.Name = cr.CanaryConfigSecretName()
+	canarySecret.Finalizers = nil
+	if err := reconcile.Secret(ctx, rclient, canarySecret); err != nil {
+		return fmt.Errorf("cannot update canary config secret: %w", err)
+	}
+	configHash := configDataHash(s.Data)
+	canary, err := newCanaryDeployForVMAuth(cr, configHash)
+	if err != nil {
+		return fmt.Errorf("cannot build canary deployment: %w", err)
+	}
+	if err := reconcile.Deployment(ctx, rclient, canary, nil, false); err != nil {
+		return fmt.Errorf("canary deployment isn't ready: %w", err)
+	}
+
+	var lastErr error
+	err = wait.PollUntilContextTimeout(ctx, canaryPollInterval, cr.Spec.ConfigRollout.GetCanaryTimeout(), true, func(ctx context.Context) (bool, error) {
+		lastErr = probeVMAuthCanary(ctx, rclient, cr, configHash)
+		return lastErr == nil, nil
+	})
+	if err != nil {
+		if lastErr != nil {
+			return lastErr
+		}
+		return err
+	}
+	return nil
+}
+
+// probeVMAuthCanary triggers configuration reload at canary pod and checks its health and reload status
+func probeVMAuthCanary(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAuth, configHash string) error {
+	var podList corev1.PodList
+	if err := rclient.List(ctx, &podList, &client.ListOptions{
+		Namespace:     cr.Namespace,
+		LabelSelector: labels.SelectorFromSet(cr.CanarySelectorLabels()),
+	}); err != nil {
+		return fmt.Errorf("cannot list canary pods: %w", err)
+	}
+	var podIP string
+	for _, pod := range podList.Items {
+		if pod.DeletionTimestamp != nil || pod.Status.PodIP == "" || pod.Annotations[canaryConfigHashAnnotation] != configHash {
+			continue
+		}
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionTrue {
+				podIP = pod.Status.PodIP
+				break
+			}
+		}
+		if podIP != "" {
+			break
+		}
+	}
+	if podIP == "" {
+		return fmt.Errorf("cannot find ready canary pod with configuration hash=%s", configHash)
+	}
+
+	reloadURL, err := url.Parse(vmv1beta1.BuildReloadPathWithPort(cr.GetExtraArgs(), cr.Spec.Port))
+	if err != nil {
+		return fmt.Errorf("cannot parse canary reload url: %w", err)
+	}
+	reloadURL.Host = net.JoinHostPort(podIP, cr.Spec.Port)
+	if _, err := doCanaryRequest(ctx, http.MethodPost, reloadURL.String()); err != nil {
+		return fmt.Errorf("cannot reload canary configuration: %w", err)
+	}
+	baseURL := fmt.Sprintf("%s://%s", reloadURL.Scheme, reloadURL.Host)
+	if _, err := doCanaryRequest(ctx, http.MethodGet, baseURL+cr.ProbePath()); err != nil {
+		return fmt.Errorf("canary is not healthy: %w", err)
+	}
+	metrics, err := doCanaryRequest(ctx, http.MethodGet, baseURL+cr.GetMetricPath())
+	if err != nil {
+		return fmt.Errorf("cannot fetch canary metrics: %w", err)
+	}
+	sc := bufio.NewScanner(strings.NewReader(metrics))
+	for sc.Scan() {
+		value, ok := strings.CutPrefix(sc.Text(), canaryReloadSuccessMetric+" ")
+		if !ok {
+			continue
+		}
+		if strings.TrimSpace(value) != "1" {
+			return fmt.Errorf("canary failed to load configuration, %s=%s", canaryReloadSuccessMetric, value)
+		}
+		return nil
+	}
+	return fmt.Errorf("cannot find %s metric at canary", canaryReloadSuccessMetric)
+}
+
+func doCanaryRequest(ctx context.Context, method, reqURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := canaryHTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("cannot read response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return "", fmt.Errorf("unexpected status code=%d for %s %s: %s", resp.StatusCode, method, req.URL.Path, body)
+	}
+	return string(body), nil
+}
+
+func newCanaryDeployForVMAuth(cr *vmv1beta1.VMAuth, configHash string) (*appsv1.Deployment, error) {
+	// pod spec builder modifies config-reloader extra args
+	cr = cr.DeepCopy()
+	podSpec, err := makeSpecForVMAuth(cr, cr.CanaryConfigSecretName())
+	if err != nil {
+		return nil, err
+	}
+	podSpec.Labels = labels.Merge(podSpec.Labels, cr.CanarySelectorLabels())
+	podSpec.Annotations = labels.Merge(podSpec.Annotations, map[string]string{canaryConfigHashAnnotation: configHash})
+
+	canary := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            cr.CanaryName(),
+			Namespace:       cr.Namespace,
+			Labels:          labels.Merge(cr.AllLabels(), cr.CanarySelectorLabels()),
+			Annotations:     cr.AnnotationsFiltered(),
+			OwnerReferences: cr.AsOwner(),
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: cr.CanarySelectorLabels(),
+			},
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.RecreateDeploymentStrategyType,
+			},
+			Template: *podSpec,
+		},
+	}
+	build.DeploymentAddCommonParams(canary, ptr.Deref(cr.Spec.UseStrictSecurity, false), &cr.Spec.CommonApplicationDeploymentParams)
+	canary.Spec.Replicas = ptr.To[int32](1)
+	canary.Spec.MinReadySeconds = 0
+	return canary, nil
+}
+
+func deleteVMAuthCanary(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAuth) error {
+	objMeta := metav1.ObjectMeta{Name: cr.CanaryName(), Namespace: cr.Namespace}
+	if err := finalize.SafeDeleteWithFinalizer(ctx, rclient, &appsv1.Deployment{ObjectMeta: objMeta}); err != nil {
+		return fmt.Errorf("cannot delete canary deployment: %w", err)
+	}
+	if err := finalize.SafeDeleteWithFinalizer(ctx, rclient, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: cr.CanaryConfigSecretName(), Namespace: cr.Namespace}}); err != nil {
+		return fmt.Errorf("cannot delete canary config secret: %w", err)
+	}
+	return nil
+}
+
+// configDataHash returns hash of configuration secret data
+func configDataHash(data map[string][]byte) string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	h := fnv.New64a()
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write(data[k])
+	}
+	return fmt.Sprintf("%x", h.Sum64())
+}
@@ -0,0 +1,152 @@
+package vmauth
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func TestRolloutVMAuthConfig(t *testing.T) {
+	canaryPollInterval = 10 * time.Millisecond
+	newConfig := map[string][]byte{vmAuthConfigNameGz: []byte("new")}
+	prevConfig := map[string][]byte{vmAuthConfigNameGz: []byte("prev")}
+
+	f := func(prevData map[string][]byte, reloadSuccessful string, wantErr bool, wantData map[string][]byte) {
+		t.Helper()
+		var reloads int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/-/reload":
+				reloads++
+			case "/health":
+				fmt.Fprint(w, "OK")
+			case "/metrics":
+				fmt.Fprintf(w, "vmauth_config_last_reload_total 1\nvmauth_config_last_reload_successful %s\n", reloadSuccessful)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer srv.Close()
+		_, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+		if err != nil {
+			t.Fatalf("cannot parse test server address: %s", err)
+		}
+
+		cr := &vmv1beta1.VMAuth{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "default",
+			},
+			Spec: vmv1beta1.VMAuthSpec{
+				ConfigRollout: &vmv1beta1.VMAuthConfigRollout{
+					CanaryTimeout: "200ms",
+				},
+			},
+		}
+		cr.Spec.Port = port
+		s := makeVMAuthConfigSecret(cr)
+		s.Data = newConfig
+
+		predefinedObjects := []runtime.Object{
+			k8stools.NewReadyDeployment(cr.CanaryName(), cr.Namespace),
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        cr.CanaryName() + "-0",
+					Namespace:   cr.Namespace,
+					Labels:      cr.CanarySelectorLabels(),
+					Annotations: map[string]string{canaryConfigHashAnnotation: configDataHash(newConfig)},
+				},
+				Status: corev1.PodStatus{
+					PodIP: "127.0.0.1",
+					Conditions: []corev1.PodCondition{
+						{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+					},
+				},
+			},
+		}
+		if prevData != nil {
+			prevSecret := makeVMAuthConfigSecret(cr)
+			prevSecret.Data = prevData
+			predefinedObjects = append(predefinedObjects, prevSecret)
+		}
+		fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		ctx := context.Background()
+
+		err = rolloutVMAuthConfig(ctx, fclient, cr, s)
+		if wantErr {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+
+		var got corev1.Secret
+		assert.NoError(t, fclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.ConfigSecretName()}, &got))
+		assert.Equal(t, wantData, got.Data)
+
+		// canary must be removed after rollout
+		err = fclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.CanaryName()}, &appsv1.Deployment{})
+		if prevData == nil {
+			// canary isn't used for initial configuration
+			assert.NoError(t, err)
+			assert.Equal(t, 0, reloads)
+			return
+		}
+		assert.True(t, k8serrors.IsNotFound(err), "canary deployment must be deleted, got err: %v", err)
+		err = fclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.CanaryConfigSecretName()}, &corev1.Secret{})
+		assert.True(t, k8serrors.IsNotFound(err), "canary config secret must be deleted, got err: %v", err)
+	}
+
+	// initial configuration
+	f(nil, "1", false, newConfig)
+
+	// configuration wasn't changed
+	f(newConfig, "0", false, newConfig)
+
+	// canary loaded new configuration
+	f(prevConfig, "1", false, newConfig)
+
+	// canary rejected new configuration
+	f(prevConfig, "0", true, prevConfig)
+}
+
+func TestNewCanaryDeployForVMAuth(t *testing.T) {
+	cr := &vmv1beta1.VMAuth{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: vmv1beta1.VMAuthSpec{
+			ConfigRollout: &vmv1beta1.VMAuthConfigRollout{},
+		},
+	}
+	cr.Spec.ReplicaCount = ptr.To[int32](3)
+	canary, err := newCanaryDeployForVMAuth(cr, "hash")
+	assert.NoError(t, err)
+	assert.Equal(t, "vmauth-canary-test", canary.Name)
+	assert.Equal(t, int32(1), *canary.Spec.Replicas)
+	assert.Equal(t, appsv1.RecreateDeploymentStrategyType, canary.Spec.Strategy.Type)
+	assert.Equal(t, "canary", canary.Spec.Template.Labels["app.kubernetes.io/component"])
+	assert.Equal(t, "hash", canary.Spec.Template.Annotations[canaryConfigHashAnnotation])
+	var configSecretName string
+	for _, v := range canary.Spec.Template.Spec.Volumes {
+		if v.Name == vmAuthVolumeName {
+			configSecretName = v.Secret.SecretName
+		}
+	}
+	assert.Equal(t, "vmauth-config-canary-test", configSecretName)
+}
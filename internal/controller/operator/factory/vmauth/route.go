@@ -0,0 +1,28 @@
+package vmauth
+
+import (
+	"context"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/build"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// deleteVMAuthRoute removes route created for vmauth
+func deleteVMAuthRoute(ctx context.Context, rclient client.Client, objMeta metav1.ObjectMeta) error {
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(k8stools.RouteGVK)
+	route.SetName(objMeta.Name)
+	route.SetNamespace(objMeta.Namespace)
+	return finalize.SafeDelete(ctx, rclient, route)
+}
+
+// buildRouteConfig builds route from ingress configuration, it's used instead of ingress at OpenShift
+func buildRouteConfig(cr *vmv1beta1.VMAuth) *unstructured.Unstructured {
+	return build.Route(ingressObjectMeta(cr), cr.PrefixedName(), cr.Spec.Ingress)
+}
@@ -0,0 +1,71 @@
+package vmauth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestCreateOrUpdateVMAuthRoute(t *testing.T) {
+	f := func(ingress *vmv1beta1.EmbeddedIngress, wantSpec map[string]any) {
+		t.Helper()
+		if err := k8stools.SetPlatform(k8stools.PlatformOpenShift, "", nil); err != nil {
+			t.Fatalf("cannot set platform: %s", err)
+		}
+		defer func() {
+			if err := k8stools.SetPlatform(k8stools.PlatformKubernetes, "", nil); err != nil {
+				t.Fatalf("cannot restore platform: %s", err)
+			}
+		}()
+		ctx := context.Background()
+		rclient := fake.NewClientBuilder().Build()
+		cr := &vmv1beta1.VMAuth{
+			ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"},
+			Spec:       vmv1beta1.VMAuthSpec{Ingress: ingress},
+		}
+		// create and update
+		for i := 0; i < 2; i++ {
+			if err := createOrUpdateVMAuthIngress(ctx, rclient, cr); err != nil {
+				t.Fatalf("cannot reconcile route: %s", err)
+			}
+		}
+		got := &unstructured.Unstructured{}
+		got.SetGroupVersionKind(k8stools.RouteGVK)
+		if err := rclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: cr.PrefixedName()}, got); err != nil {
+			t.Fatalf("cannot get route: %s", err)
+		}
+		assert.Equal(t, wantSpec, got.Object["spec"])
+		assert.Len(t, got.GetOwnerReferences(), 1)
+		assert.Empty(t, got.GetFinalizers())
+
+		if err := deleteVMAuthRoute(ctx, rclient, metav1.ObjectMeta{Namespace: "default", Name: cr.PrefixedName()}); err != nil {
+			t.Fatalf("cannot delete route: %s", err)
+		}
+	}
+	f(&vmv1beta1.EmbeddedIngress{Host: "vmauth.example.com"}, map[string]any{
+		"host": "vmauth.example.com",
+		"path": "/",
+		"port": map[string]any{"targetPort": "http"},
+		"to":   map[string]any{"kind": "Service", "name": "vmauth-main"},
+	})
+	f(&vmv1beta1.EmbeddedIngress{TlsSecretName: "tls", TlsHosts: []string{"secure.example.com", "other.example.com"}}, map[string]any{
+		"host": "secure.example.com",
+		"path": "/",
+		"port": map[string]any{"targetPort": "http"},
+		"to":   map[string]any{"kind": "Service", "name": "vmauth-main"},
+		"tls":  map[string]any{"termination": "edge", "insecureEdgeTerminationPolicy": "Redirect"},
+	})
+	f(&vmv1beta1.EmbeddedIngress{}, map[string]any{
+		"path": "/",
+		"port": map[string]any{"targetPort": "http"},
+		"to":   map[string]any{"kind": "Service", "name": "vmauth-main"},
+	})
+}
@@ -5,24 +5,26 @@ import (
 	"compress/gzip"
 	"context"
 	"fmt"
+	"io"
 	"path"
 	"sort"
 	"strings"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/build"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/certmanager"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/grafana"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/tracing"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
@@ -60,12 +62,22 @@ func CreateOrUpdateVMAuth(ctx context.Context, cr *vmv1beta1.VMAuth, rclient cli
 	if err := createOrUpdateVMAuthIngress(ctx, rclient, cr); err != nil {
 		return fmt.Errorf("cannot create or update ingress for vmauth: %w", err)
 	}
+	if err := createOrUpdateVMAuthHTTPRoute(ctx, rclient, cr); err != nil {
+		return fmt.Errorf("cannot create or update httproute for vmauth: %w", err)
+	}
+	if err := createOrUpdateVMAuthCertificate(ctx, rclient, cr); err != nil {
+		return fmt.Errorf("cannot create or update cert-manager certificate for vmauth: %w", err)
+	}
 	if !ptr.Deref(cr.Spec.DisableSelfServiceScrape, false) {
 		if err := reconcile.VMServiceScrapeForCRD(ctx, rclient, build.VMServiceScrapeForServiceWithSpec(svc, cr)); err != nil {
 			return err
 		}
 	}
 
+	if err := grafana.CreateOrUpdate(ctx, rclient, buildGrafanaDatasource(cr)); err != nil {
+		return fmt.Errorf("cannot create grafana datasource for vmauth: %w", err)
+	}
+
 	if err := CreateOrUpdateVMAuthConfig(ctx, rclient, cr); err != nil {
 		return err
 	}
@@ -83,18 +95,29 @@ func CreateOrUpdateVMAuth(ctx context.Context, cr *vmv1beta1.VMAuth, rclient cli
 		if err != nil {
 			return fmt.Errorf("cannot generate prev deploy spec: %w", err)
 		}
+		if err := build.ApplyOverridePatches(prevCR.Spec.OverridePatches, prevDeploy); err != nil {
+			return fmt.Errorf("cannot apply override patches to prev object: %w", err)
+		}
 	}
 
-	newDeploy, err := newDeployForVMAuth(cr)
+	newDeploy, err := tracing.Build(ctx, "vmauth", func() (*appsv1.Deployment, error) {
+		return newDeployForVMAuth(cr)
+	})
 	if err != nil {
 		return fmt.Errorf("cannot build new deploy for vmauth: %w", err)
 	}
+	if err := build.AddLicenseChecksumAnnotation(ctx, rclient, cr.Namespace, cr.Spec.License, newDeploy); err != nil {
+		return err
+	}
+	if err := build.ApplyOverridePatches(cr.Spec.OverridePatches, newDeploy); err != nil {
+		return err
+	}
 	return reconcile.Deployment(ctx, rclient, newDeploy, prevDeploy, false)
 }
 
 func newDeployForVMAuth(cr *vmv1beta1.VMAuth) (*appsv1.Deployment, error) {
 
-	podSpec, err := makeSpecForVMAuth(cr)
+	podSpec, err := makeSpecForVMAuth(cr, cr.ConfigSecretName())
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +145,7 @@ func newDeployForVMAuth(cr *vmv1beta1.VMAuth) (*appsv1.Deployment, error) {
 	return depSpec, nil
 }
 
-func makeSpecForVMAuth(cr *vmv1beta1.VMAuth) (*corev1.PodTemplateSpec, error) {
+func makeSpecForVMAuth(cr *vmv1beta1.VMAuth, configSecretName string) (*corev1.PodTemplateSpec, error) {
 	var args []string
 	args = append(args, fmt.Sprintf("-auth.config=%s", path.Join(vmAuthConfigFolder, vmAuthConfigName)))
 
@@ -166,6 +189,23 @@ func makeSpecForVMAuth(cr *vmv1beta1.VMAuth) (*corev1.PodTemplateSpec, error) {
 			MountPath: path.Join(vmv1beta1.SecretsDir, s),
 		})
 	}
+	if cr.Spec.CertManager != nil {
+		s := cr.CertManagerSecretName()
+		volumes = append(volumes, corev1.Volume{
+			Name: k8stools.SanitizeVolumeName("secret-" + s),
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: s,
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      k8stools.SanitizeVolumeName("secret-" + s),
+			ReadOnly:  true,
+			MountPath: path.Join(vmv1beta1.SecretsDir, s),
+		})
+		args = append(args, buildVMAuthCertificateArgs(cr)...)
+	}
 
 	for _, c := range cr.Spec.ConfigMaps {
 		volumes = append(volumes, corev1.Volume{
@@ -187,6 +227,7 @@ func makeSpecForVMAuth(cr *vmv1beta1.VMAuth) (*corev1.PodTemplateSpec, error) {
 	volumes, volumeMounts = cr.Spec.License.MaybeAddToVolumes(volumes, volumeMounts, vmv1beta1.SecretsDir)
 	args = cr.Spec.License.MaybeAddToArgs(args, vmv1beta1.SecretsDir)
 
+	args = build.AddVersionedArgs("vmauth", cr.Spec.Image.Tag, args, "-")
 	args = build.AddExtraArgsOverrideDefaults(args, cr.Spec.ExtraArgs, "-")
 	sort.Strings(args)
 
@@ -220,7 +261,7 @@ func makeSpecForVMAuth(cr *vmv1beta1.VMAuth) (*corev1.PodTemplateSpec, error) {
 				Name: vmAuthVolumeName,
 				VolumeSource: corev1.VolumeSource{
 					Secret: &corev1.SecretVolumeSource{
-						SecretName: cr.ConfigSecretName(),
+						SecretName: configSecretName,
 					},
 				},
 			})
@@ -235,7 +276,7 @@ func makeSpecForVMAuth(cr *vmv1beta1.VMAuth) (*corev1.PodTemplateSpec, error) {
 		})
 		operatorContainers[0].VolumeMounts = volumeMounts
 
-		configReloader := buildVMAuthConfigReloaderContainer(cr)
+		configReloader := buildVMAuthConfigReloaderContainer(cr, configSecretName)
 		operatorContainers = append(operatorContainers, configReloader)
 		initContainers = append(initContainers,
 			buildInitConfigContainer(useCustomConfigReloader, cr.Spec.ConfigReloaderImageTag, cr.Spec.ConfigReloaderResources, configReloader.Args)...)
@@ -309,9 +350,29 @@ func CreateOrUpdateVMAuthConfig(ctx context.Context, rclient client.Client, cr *
 	}
 	s.Data[vmAuthConfigNameGz] = buf.Bytes()
 
+	if cr.Spec.ConfigRollout != nil {
+		return rolloutVMAuthConfig(ctx, rclient, cr, s)
+	}
 	return reconcile.Secret(ctx, rclient, s)
 }
 
+// GeneratedConfig returns auth configuration generated by operator for the given VMAuth
+func GeneratedConfig(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAuth) ([]byte, error) {
+	var s corev1.Secret
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: cr.ConfigSecretName()}, &s); err != nil {
+		return nil, err
+	}
+	data, ok := s.Data[vmAuthConfigNameGz]
+	if !ok {
+		return nil, fmt.Errorf("cannot find key=%q at secret=%q", vmAuthConfigNameGz, s.Name)
+	}
+	conf, err := gunzipConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("cannot gunzip config of vmauth: %w", err)
+	}
+	return conf, nil
+}
+
 func makeVMAuthConfigSecret(cr *vmv1beta1.VMAuth) *corev1.Secret {
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -322,9 +383,7 @@ func makeVMAuthConfigSecret(cr *vmv1beta1.VMAuth) *corev1.Secret {
 			},
 			Namespace:       cr.Namespace,
 			OwnerReferences: cr.AsOwner(),
-			Finalizers: []string{
-				vmv1beta1.FinalizerName,
-			},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Data: map[string][]byte{
 			vmAuthConfigNameGz: {},
@@ -337,89 +396,33 @@ func createOrUpdateVMAuthIngress(ctx context.Context, rclient client.Client, cr
 	if cr.Spec.Ingress == nil {
 		return nil
 	}
-	newIngress := buildIngressConfig(cr)
-	var existIngress networkingv1.Ingress
-	if err := rclient.Get(ctx, types.NamespacedName{Namespace: newIngress.Namespace, Name: newIngress.Name}, &existIngress); err != nil {
-		if errors.IsNotFound(err) {
-			return rclient.Create(ctx, newIngress)
-		}
-		return err
-	}
-	if err := finalize.FreeIfNeeded(ctx, rclient, &existIngress); err != nil {
-		return err
+	if k8stools.IsOpenShift() {
+		return reconcile.Route(ctx, rclient, buildRouteConfig(cr))
 	}
-	// TODO compare
-	newIngress.Annotations = labels.Merge(existIngress.Annotations, newIngress.Annotations)
-	vmv1beta1.AddFinalizer(newIngress, &existIngress)
-	return rclient.Update(ctx, newIngress)
+	return reconcile.Ingress(ctx, rclient, buildIngressConfig(cr))
 }
 
-var defaultPt = networkingv1.PathTypePrefix
-
 func buildIngressConfig(cr *vmv1beta1.VMAuth) *networkingv1.Ingress {
-	defaultRule := networkingv1.IngressRule{
-		Host: cr.Spec.Ingress.Host,
-		IngressRuleValue: networkingv1.IngressRuleValue{
-			HTTP: &networkingv1.HTTPIngressRuleValue{
-				Paths: []networkingv1.HTTPIngressPath{
-					{
-						Path: "/",
-						Backend: networkingv1.IngressBackend{
-							Service: &networkingv1.IngressServiceBackend{
-								Name: cr.PrefixedName(),
-								Port: networkingv1.ServiceBackendPort{Name: "http"},
-							},
-						},
-						PathType: &defaultPt,
-					},
-				},
-			},
-		},
-	}
-	spec := networkingv1.IngressSpec{
-		Rules:            []networkingv1.IngressRule{},
-		IngressClassName: cr.Spec.Ingress.ClassName,
-	}
-	if cr.Spec.Ingress.TlsSecretName != "" {
-		spec.TLS = []networkingv1.IngressTLS{
-			{
-				SecretName: cr.Spec.Ingress.TlsSecretName,
-				Hosts:      cr.Spec.Ingress.TlsHosts,
-			},
-		}
-		for _, host := range cr.Spec.Ingress.TlsHosts {
-			hostRule := defaultRule.DeepCopy()
-			hostRule.Host = host
-			spec.Rules = append(spec.Rules, *hostRule)
-		}
-	} else {
-		spec.Rules = append(spec.Rules, defaultRule)
-	}
-	// add user defined routes.
-	spec.Rules = append(spec.Rules, cr.Spec.Ingress.ExtraRules...)
-	spec.TLS = append(spec.TLS, cr.Spec.Ingress.ExtraTLS...)
-	lbls := labels.Merge(cr.Spec.Ingress.Labels, cr.SelectorLabels())
-	return &networkingv1.Ingress{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:            cr.PrefixedName(),
-			Namespace:       cr.Namespace,
-			Labels:          lbls,
-			Annotations:     cr.Spec.Ingress.Annotations,
-			OwnerReferences: cr.AsOwner(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
-		},
-		Spec: spec,
+	return build.Ingress(ingressObjectMeta(cr), cr.PrefixedName(), cr.Spec.Ingress)
+}
+
+func ingressObjectMeta(cr *vmv1beta1.VMAuth) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:            cr.PrefixedName(),
+		Namespace:       cr.Namespace,
+		Labels:          cr.SelectorLabels(),
+		OwnerReferences: cr.AsOwner(),
 	}
 }
 
-func buildVMAuthConfigReloaderContainer(cr *vmv1beta1.VMAuth) corev1.Container {
+func buildVMAuthConfigReloaderContainer(cr *vmv1beta1.VMAuth, configSecretName string) corev1.Container {
 	configReloaderArgs := []string{
-		fmt.Sprintf("--reload-url=%s", vmv1beta1.BuildReloadPathWithPort(cr.Spec.ExtraArgs, cr.Spec.Port)),
+		fmt.Sprintf("--reload-url=%s", vmv1beta1.BuildReloadPathWithPort(cr.GetExtraArgs(), cr.Spec.Port)),
 		fmt.Sprintf("--config-envsubst-file=%s", path.Join(vmAuthConfigFolder, vmAuthConfigName)),
 	}
 	useCustomConfigReloader := ptr.Deref(cr.Spec.UseVMConfigReloader, false)
 	if useCustomConfigReloader {
-		configReloaderArgs = append(configReloaderArgs, fmt.Sprintf("--config-secret-name=%s/%s", cr.Namespace, cr.ConfigSecretName()))
+		configReloaderArgs = append(configReloaderArgs, fmt.Sprintf("--config-secret-name=%s/%s", cr.Namespace, configSecretName))
 		configReloaderArgs = vmv1beta1.MaybeEnableProxyProtocol(configReloaderArgs, cr.Spec.ExtraArgs)
 	} else {
 		configReloaderArgs = append(configReloaderArgs, fmt.Sprintf("--config-file=%s", path.Join(vmAuthConfigMountGz, vmAuthConfigNameGz)))
@@ -528,6 +531,15 @@ func gzipConfig(buf *bytes.Buffer, conf []byte) error {
 	return nil
 }
 
+func gunzipConfig(data []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
 // createOrUpdateVMAuthService creates service for VMAuth
 func createOrUpdateVMAuthService(ctx context.Context, cr *vmv1beta1.VMAuth, rclient client.Client) (*corev1.Service, error) {
 	newService := build.Service(cr, cr.Spec.Port, nil)
@@ -572,10 +584,30 @@ func deletePrevStateResources(ctx context.Context, cr *vmv1beta1.VMAuth, rclient
 	}
 
 	if cr.Spec.Ingress == nil && cr.ParsedLastAppliedSpec.Ingress != nil {
+		if k8stools.IsOpenShift() {
+			if err := deleteVMAuthRoute(ctx, rclient, objMeta); err != nil {
+				return fmt.Errorf("cannot delete route from prev state: %w", err)
+			}
+		}
 		if err := finalize.SafeDeleteWithFinalizer(ctx, rclient, &networkingv1.Ingress{ObjectMeta: objMeta}); err != nil {
 			return fmt.Errorf("cannot delete ingress from prev state: %w", err)
 		}
 	}
+	if cr.Spec.HTTPRoute == nil && cr.ParsedLastAppliedSpec.HTTPRoute != nil {
+		if err := deleteVMAuthHTTPRoute(ctx, rclient, objMeta); err != nil {
+			return fmt.Errorf("cannot delete httproute from prev state: %w", err)
+		}
+	}
+	if cr.Spec.CertManager == nil && cr.ParsedLastAppliedSpec.CertManager != nil {
+		if err := certmanager.Delete(ctx, rclient, objMeta, cr.CertManagerSecretName()); err != nil {
+			return fmt.Errorf("cannot delete cert-manager certificate from prev state: %w", err)
+		}
+	}
+	if cr.Spec.ConfigRollout == nil && cr.ParsedLastAppliedSpec.ConfigRollout != nil {
+		if err := deleteVMAuthCanary(ctx, rclient, cr); err != nil {
+			return fmt.Errorf("cannot delete config rollout canary from prev state: %w", err)
+		}
+	}
 	if ptr.Deref(cr.Spec.DisableSelfServiceScrape, false) && !ptr.Deref(cr.ParsedLastAppliedSpec.DisableSelfServiceScrape, false) {
 		if err := finalize.SafeDeleteWithFinalizer(ctx, rclient, &vmv1beta1.VMServiceScrape{ObjectMeta: objMeta}); err != nil {
 			return fmt.Errorf("cannot remove serviceScrape: %w", err)
@@ -584,3 +616,18 @@ func deletePrevStateResources(ctx context.Context, cr *vmv1beta1.VMAuth, rclient
 
 	return nil
 }
+
+func buildGrafanaDatasource(cr *vmv1beta1.VMAuth) *grafana.Datasource {
+	return &grafana.Datasource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            cr.PrefixedName(),
+			Namespace:       cr.Namespace,
+			Labels:          cr.AllLabels(),
+			Annotations:     cr.AnnotationsFiltered(),
+			OwnerReferences: cr.AsOwner(),
+		},
+		DisplayName: fmt.Sprintf("vmauth/%s/%s", cr.Namespace, cr.Name),
+		URL:         cr.AsURL(),
+		DashboardID: grafana.DashboardVMAuth,
+	}
+}
@@ -174,7 +174,7 @@ func TestMakeSpecForAuthOk(t *testing.T) {
 		if err != nil {
 			t.Fatalf("BUG: cannot parse as yaml: %q", err)
 		}
-		got, err := makeSpecForVMAuth(cr)
+		got, err := makeSpecForVMAuth(cr, cr.ConfigSecretName())
 		if err != nil {
 			t.Fatalf("not expected error=%q", err)
 		}
@@ -0,0 +1,96 @@
+package vmauth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/secretstore"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var timeNow = time.Now
+
+// RotateVMUserPassword regenerates password generated for the given VMUser according to its spec.passwordRotation
+// and returns duration until the next rotation. Zero duration is returned if rotation isn't configured.
+// Updated password is applied to vmauth configuration at the next config build.
+func RotateVMUserPassword(ctx context.Context, rclient client.Client, user *vmv1beta1.VMUser) (time.Duration, error) {
+	rotation := user.Spec.PasswordRotation
+	if rotation == nil || !user.Spec.GeneratePassword || user.Spec.Password != nil || user.Spec.PasswordRef != nil || user.Spec.DisableSecretCreation {
+		return 0, nil
+	}
+	interval := rotation.GetInterval()
+	if interval <= 0 {
+		return 0, fmt.Errorf("BUG: unexpected spec.passwordRotation.interval=%q, it must be validated by webhook", rotation.Interval)
+	}
+	now := timeNow()
+	last := user.Status.LastPasswordRotationTime
+	if last == nil {
+		// password is generated at the first build of vmauth config
+		return interval, patchLastPasswordRotationTime(ctx, rclient, user, now)
+	}
+	if next := last.Add(interval); now.Before(next) {
+		return next.Sub(now), nil
+	}
+	pwd, err := genPassword()
+	if err != nil {
+		return 0, fmt.Errorf("cannot generate password for vmuser=%q: %w", user.Name, err)
+	}
+	if err := storeRotatedPassword(ctx, rclient, user, pwd); err != nil {
+		return 0, err
+	}
+	logger.WithContext(ctx).Info(fmt.Sprintf("rotated generated password of vmuser=%s", user.Name))
+	events.Normal(ctx, events.ReasonConfigUpdated, "generated password of vmuser=%s rotated", user.Name)
+	return interval, patchLastPasswordRotationTime(ctx, rclient, user, now)
+}
+
+// storeRotatedPassword replaces password at VMUser secret or secret store
+// password isn't stored if credentials weren't created yet, they'll be created with a new password at vmauth config build
+func storeRotatedPassword(ctx context.Context, rclient client.Client, user *vmv1beta1.VMUser, pwd string) error {
+	if secretstore.IsEnabled() {
+		key := secretstore.Key(user.Namespace, user.SecretName())
+		data, err := secretstore.Get(ctx, key)
+		if err != nil || data == nil {
+			return err
+		}
+		data["password"] = []byte(pwd)
+		return secretstore.Put(ctx, key, data)
+	}
+	var s corev1.Secret
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: user.Namespace, Name: user.SecretName()}, &s); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("cannot get vmuser secret: %w", err)
+	}
+	if s.Data == nil {
+		s.Data = make(map[string][]byte)
+	}
+	s.Data["password"] = []byte(pwd)
+	if err := rclient.Update(ctx, &s); err != nil {
+		return fmt.Errorf("cannot update password at vmuser secret: %w", err)
+	}
+	return nil
+}
+
+func patchLastPasswordRotationTime(ctx context.Context, rclient client.Client, user *vmv1beta1.VMUser, ts time.Time) error {
+	rotatedAt := metav1.NewTime(ts)
+	data, err := rotatedAt.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("cannot serialize password rotation time: %w", err)
+	}
+	pt := client.RawPatch(types.MergePatchType, []byte(fmt.Sprintf(`{"status": {"lastPasswordRotationTime": %s } }`, data)))
+	if err := rclient.Status().Patch(ctx, user, pt); err != nil {
+		return fmt.Errorf("failed to patch password rotation time of vmuser=%q: %w", user.Name, err)
+	}
+	user.Status.LastPasswordRotationTime = &rotatedAt
+	return nil
+}
@@ -0,0 +1,83 @@
+package vmauth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func TestRotateVMUserPassword(t *testing.T) {
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	f := func(spec vmv1beta1.VMUserSpec, lastRotation *time.Time, wantRequeue time.Duration, wantRotated bool) {
+		t.Helper()
+		user := &vmv1beta1.VMUser{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec:       spec,
+		}
+		if lastRotation != nil {
+			user.Status.LastPasswordRotationTime = ptr.To(metav1.NewTime(*lastRotation))
+		}
+		userSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: user.SecretName(), Namespace: user.Namespace},
+			Data:       map[string][]byte{"username": []byte("test"), "password": []byte("initial")},
+		}
+		fclient := k8stools.GetTestClientWithObjects([]runtime.Object{user.DeepCopy(), userSecret})
+		ctx := context.Background()
+
+		requeue, err := RotateVMUserPassword(ctx, fclient, user)
+		assert.NoError(t, err)
+		assert.Equal(t, wantRequeue, requeue)
+
+		var gotSecret corev1.Secret
+		assert.NoError(t, fclient.Get(ctx, types.NamespacedName{Namespace: user.Namespace, Name: user.SecretName()}, &gotSecret))
+		assert.Equal(t, "test", string(gotSecret.Data["username"]))
+		if wantRotated {
+			assert.NotEqual(t, "initial", string(gotSecret.Data["password"]))
+			assert.Len(t, gotSecret.Data["password"], passwordLength)
+		} else {
+			assert.Equal(t, "initial", string(gotSecret.Data["password"]))
+		}
+
+		var gotUser vmv1beta1.VMUser
+		assert.NoError(t, fclient.Get(ctx, types.NamespacedName{Namespace: user.Namespace, Name: user.Name}, &gotUser))
+		if wantRequeue == 0 {
+			assert.Nil(t, gotUser.Status.LastPasswordRotationTime)
+			return
+		}
+		wantLastRotation := now
+		if lastRotation != nil && !wantRotated {
+			wantLastRotation = *lastRotation
+		}
+		if assert.NotNil(t, gotUser.Status.LastPasswordRotationTime) {
+			assert.True(t, wantLastRotation.Equal(gotUser.Status.LastPasswordRotationTime.Time))
+		}
+	}
+	rotation := &vmv1beta1.VMUserPasswordRotation{Interval: "24h"}
+
+	// rotation isn't configured
+	f(vmv1beta1.VMUserSpec{GeneratePassword: true}, nil, 0, false)
+
+	// password isn't generated
+	f(vmv1beta1.VMUserSpec{Password: ptr.To("initial"), PasswordRotation: rotation}, nil, 0, false)
+
+	// first reconcile records rotation time
+	f(vmv1beta1.VMUserSpec{GeneratePassword: true, PasswordRotation: rotation}, nil, 24*time.Hour, false)
+
+	// interval isn't passed yet
+	f(vmv1beta1.VMUserSpec{GeneratePassword: true, PasswordRotation: rotation}, ptr.To(now.Add(-20*time.Hour)), 4*time.Hour, false)
+
+	// interval passed
+	f(vmv1beta1.VMUserSpec{GeneratePassword: true, PasswordRotation: rotation}, ptr.To(now.Add(-25*time.Hour)), 24*time.Hour, true)
+}
@@ -14,8 +14,10 @@ import (
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/build"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/secretstore"
 	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -234,7 +236,15 @@ func addAuthCredentialsBuildSecrets(ctx context.Context, rclient client.Client,
 			user.Spec.BearerToken = ptr.To(v)
 		}
 
-		if !user.Spec.DisableSecretCreation {
+		switch {
+		case user.Spec.DisableSecretCreation:
+		case secretstore.IsEnabled():
+			if err := syncVMUserStoredCredentials(ctx, rclient, user); err != nil {
+				resultErr = err
+				sus.stopIter = true
+				return true
+			}
+		default:
 			var vmus corev1.Secret
 			if err := rclient.Get(ctx, types.NamespacedName{Namespace: user.Namespace, Name: user.SecretName()}, &vmus); err != nil {
 				if !errors.IsNotFound(err) {
@@ -272,6 +282,37 @@ func addAuthCredentialsBuildSecrets(ctx context.Context, rclient client.Client,
 	return
 }
 
+// syncVMUserStoredCredentials creates or updates credentials of the given VMUser at external secret store
+// Credentials of VMUser Secret created by operator previously are used for initial sync,
+// it keeps generated password unchanged after secret store backend change.
+func syncVMUserStoredCredentials(ctx context.Context, rclient client.Client, user *vmv1beta1.VMUser) error {
+	key := secretstore.Key(user.Namespace, user.SecretName())
+	data, err := secretstore.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		var vmus corev1.Secret
+		if err := rclient.Get(ctx, types.NamespacedName{Namespace: user.Namespace, Name: user.SecretName()}, &vmus); err != nil {
+			if !errors.IsNotFound(err) {
+				return fmt.Errorf("cannot get secret from API=%w", err)
+			}
+			userSecret, err := buildVMUserSecret(user)
+			if err != nil {
+				return fmt.Errorf("cannot build user credentials: %w", err)
+			}
+			return secretstore.Put(ctx, key, userSecret.Data)
+		}
+		injectAuthSettings(&vmus, user)
+		return secretstore.Put(ctx, key, vmus.Data)
+	}
+	s := &corev1.Secret{Data: data}
+	if injectAuthSettings(s, user) {
+		return secretstore.Put(ctx, key, s.Data)
+	}
+	return nil
+}
+
 func injectBackendAuthHeader(ctx context.Context, rclient client.Client, user *vmv1beta1.VMUser, nsCache map[string]*corev1.Secret) error {
 	for j := range user.Spec.TargetRefs {
 		ref := &user.Spec.TargetRefs[j]
@@ -390,8 +431,9 @@ func fetchCRDRefURLs(ctx context.Context, rclient client.Client, sus *skipableVM
 	crdCacheURLCache := make(map[string]string)
 	var resultErr error
 	sus.visitAll(func(user *vmv1beta1.VMUser) bool {
-		for j := range user.Spec.TargetRefs {
-			ref := user.Spec.TargetRefs[j]
+		refs := expandClusterTargetRefs(user.Spec.TargetRefs)
+		for j := range refs {
+			ref := refs[j]
 			if ref.CRD == nil {
 				continue
 			}
@@ -457,31 +499,16 @@ func generateVMAuthConfig(cr *vmv1beta1.VMAuth, sus *skipableVMUsers, crdCache m
 		}
 	}
 
-	var unAuthorizedAccess []yaml.MapSlice
-	for _, uc := range cr.Spec.UnauthorizedAccessConfig {
-		urlMap := appendIfNotNull(uc.SrcPaths, "src_paths", yaml.MapSlice{})
-		urlMap = appendIfNotNull(uc.SrcHosts, "src_hosts", urlMap)
-		urlMap = appendIfNotNull(uc.URLPrefix, "url_prefix", urlMap)
-
-		urlMap = addURLMapCommonToYaml(urlMap, uc.URLMapCommon, false)
-		unAuthorizedAccess = append(unAuthorizedAccess, urlMap)
-	}
-	var unAuthorizedAccessOpt []yaml.MapItem
-	var err error
-	unAuthorizedAccessOpt, err = addUserConfigOptionToYaml(unAuthorizedAccessOpt, cr.Spec.UserConfigOption, cb)
+	unAuthorizedAccessValue, err := genUnauthorizedUserCfg(cr, cb)
 	if err != nil {
 		return nil, err
 	}
-	var unAuthorizedAccessValue []yaml.MapItem
-	if len(unAuthorizedAccess) > 0 {
-		unAuthorizedAccessValue = append(unAuthorizedAccessValue, yaml.MapItem{Key: "url_map", Value: unAuthorizedAccess})
-	}
-	if len(unAuthorizedAccessOpt) > 0 {
-		unAuthorizedAccessValue = append(unAuthorizedAccessValue, unAuthorizedAccessOpt...)
-	}
 	if len(unAuthorizedAccessValue) > 0 {
 		cfg = append(cfg, yaml.MapItem{Key: "unauthorized_user", Value: unAuthorizedAccessValue})
 	}
+	if cr.Spec.GlobalIPFilters != nil {
+		cfg = addIPFiltersToYaml(cfg, *cr.Spec.GlobalIPFilters)
+	}
 	ac, err := yaml.Marshal(cfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to serialize configuration to yaml: %w", err)
@@ -489,6 +516,40 @@ func generateVMAuthConfig(cr *vmv1beta1.VMAuth, sus *skipableVMUsers, crdCache m
 	return ac, nil
 }
 
+// genUnauthorizedUserCfg builds unauthorized_user section
+// from unauthorizedUserAccessSpec or from deprecated unauthorizedAccessConfig and inline options
+func genUnauthorizedUserCfg(cr *vmv1beta1.VMAuth, cb build.TLSConfigBuilder) (yaml.MapSlice, error) {
+	urlMapsSrc := cr.Spec.UnauthorizedAccessConfig
+	opts := cr.Spec.UserConfigOption
+	var dst yaml.MapSlice
+	uas := cr.Spec.UnauthorizedUserAccessSpec
+	if uas != nil {
+		urlMapsSrc = uas.URLMap
+		opts = uas.UserConfigOption
+		dst = appendIfNotNull(uas.URLPrefix, "url_prefix", dst)
+	}
+	var urlMaps []yaml.MapSlice
+	for _, uc := range urlMapsSrc {
+		urlMap := appendIfNotNull(uc.SrcPaths, "src_paths", yaml.MapSlice{})
+		urlMap = appendIfNotNull(uc.SrcHosts, "src_hosts", urlMap)
+		urlMap = appendIfNotNull(uc.URLPrefix, "url_prefix", urlMap)
+
+		urlMap = addURLMapCommonToYaml(urlMap, uc.URLMapCommon, false)
+		urlMaps = append(urlMaps, urlMap)
+	}
+	if len(urlMaps) > 0 {
+		dst = append(dst, yaml.MapItem{Key: "url_map", Value: urlMaps})
+	}
+	dst, err := addUserConfigOptionToYaml(dst, opts, cb)
+	if err != nil {
+		return nil, err
+	}
+	if uas != nil && len(uas.MetricLabels) > 0 {
+		dst = append(dst, yaml.MapItem{Key: "metric_labels", Value: uas.MetricLabels})
+	}
+	return dst, nil
+}
+
 func appendIfNotNull(src []string, key string, origin yaml.MapSlice) yaml.MapSlice {
 	if len(src) > 0 {
 		return append(origin, yaml.MapItem{
@@ -633,6 +694,16 @@ func genURLMaps(userName string, refs []vmv1beta1.TargetRef, result yaml.MapSlic
 			if urlPrefix == "" {
 				return nil, fmt.Errorf("cannot find crdRef target: %q, for user: %s", ref.CRD.AsKey(), userName)
 			}
+			if ref.CRD.TenantID != "" {
+				switch ref.CRD.Kind {
+				case "VMCluster/vminsert":
+					urlPrefix += "/insert/" + ref.CRD.TenantID + "/prometheus"
+				case "VMCluster/vmselect":
+					urlPrefix += "/select/" + ref.CRD.TenantID + "/prometheus"
+				default:
+					return nil, fmt.Errorf("tenantID is not supported for crdRef target: %q, for user: %s", ref.CRD.AsKey(), userName)
+				}
+			}
 			urlPrefixes = append(urlPrefixes, urlPrefix)
 		case len(ref.Static.URL) > 0:
 			urlPrefixes = append(urlPrefixes, ref.Static.URL)
@@ -713,6 +784,10 @@ func genURLMaps(userName string, refs []vmv1beta1.TargetRef, result yaml.MapSlic
 			// special case for
 			// https://github.com/VictoriaMetrics/operator/issues/379
 			switch {
+			case len(refs) > 1 && ref.CRD != nil && ref.CRD.Kind == "VMCluster/vminsert" && ref.CRD.TenantID != "":
+				paths = addVMInsertTenantPaths(paths)
+			case len(refs) > 1 && ref.CRD != nil && ref.CRD.Kind == "VMCluster/vmselect" && ref.CRD.TenantID != "":
+				paths = addVMSelectTenantPaths(paths)
 			case len(refs) > 1 && ref.CRD != nil && ref.CRD.Kind == "VMCluster/vminsert":
 				paths = addVMInsertPaths(paths)
 			case len(refs) > 1 && ref.CRD != nil && ref.CRD.Kind == "VMCluster/vmselect":
@@ -775,7 +850,7 @@ func genURLMaps(userName string, refs []vmv1beta1.TargetRef, result yaml.MapSlic
 func genUserCfg(user *vmv1beta1.VMUser, crdURLCache map[string]string, cb build.TLSConfigBuilder) (yaml.MapSlice, error) {
 	var r yaml.MapSlice
 
-	r, err := genURLMaps(user.Name, user.Spec.TargetRefs, r, crdURLCache)
+	r, err := genURLMaps(user.Name, expandClusterTargetRefs(user.Spec.TargetRefs), r, crdURLCache)
 	if err != nil {
 		return nil, fmt.Errorf("cannot generate urlMaps for user: %w", err)
 	}
@@ -897,9 +972,7 @@ func buildVMUserSecret(src *vmv1beta1.VMUser) (*corev1.Secret, error) {
 			Labels:          src.AllLabels(),
 			Annotations:     src.AnnotationsFiltered(),
 			OwnerReferences: src.AsOwner(),
-			Finalizers: []string{
-				vmv1beta1.FinalizerName,
-			},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Data: map[string][]byte{},
 	}
@@ -959,3 +1032,64 @@ func addVMSelectPaths(src []string) []string {
 		"/prometheus/api/v1/admin/.*",
 	)
 }
+
+// expandClusterTargetRefs replaces refs with VMCluster kind by vminsert and vmselect refs of the same tenant
+// vminsert ref is omitted for readOnly ref and vmselect ref is omitted for writeOnly ref
+func expandClusterTargetRefs(refs []vmv1beta1.TargetRef) []vmv1beta1.TargetRef {
+	var hasClusterRefs bool
+	for _, ref := range refs {
+		if ref.CRD != nil && ref.CRD.Kind == "VMCluster" {
+			hasClusterRefs = true
+			break
+		}
+	}
+	if !hasClusterRefs {
+		return refs
+	}
+	expanded := make([]vmv1beta1.TargetRef, 0, len(refs)+1)
+	for _, ref := range refs {
+		if ref.CRD == nil || ref.CRD.Kind != "VMCluster" {
+			expanded = append(expanded, ref)
+			continue
+		}
+		if !ref.CRD.ReadOnly {
+			expanded = append(expanded, clusterComponentTargetRef(ref, "VMCluster/vminsert"))
+		}
+		if !ref.CRD.WriteOnly {
+			expanded = append(expanded, clusterComponentTargetRef(ref, "VMCluster/vmselect"))
+		}
+	}
+	return expanded
+}
+
+func clusterComponentTargetRef(ref vmv1beta1.TargetRef, kind string) vmv1beta1.TargetRef {
+	crd := *ref.CRD
+	crd.Kind = kind
+	crd.ReadOnly = false
+	crd.WriteOnly = false
+	ref.CRD = &crd
+	return ref
+}
+
+// addVMInsertTenantPaths adds write paths relative to /insert/<tenantID>/prometheus url prefix
+func addVMInsertTenantPaths(src []string) []string {
+	return append(src,
+		"/api/v1/write",
+		"/api/v1/import.*",
+	)
+}
+
+// addVMSelectTenantPaths adds read paths relative to /select/<tenantID>/prometheus url prefix
+func addVMSelectTenantPaths(src []string) []string {
+	return append(src,
+		"/api/v1/query.*",
+		"/api/v1/label.*",
+		"/api/v1/series.*",
+		"/api/v1/export.*",
+		"/api/v1/status/.*",
+		"/api/v1/metadata",
+		"/api/v1/rules",
+		"/api/v1/alerts",
+		"/federate",
+	)
+}
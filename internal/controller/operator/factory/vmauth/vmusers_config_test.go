@@ -2,6 +2,9 @@ package vmauth
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -9,6 +12,7 @@ import (
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/build"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/secretstore"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
@@ -1470,6 +1474,88 @@ unauthorized_user:
   max_concurrent_requests: 150
   load_balancing_policy: least_loaded
   drop_src_path_prefix_parts: 2
+`,
+		},
+		{
+			name: "with unauthorized user access spec and global ip_filters",
+			args: args{
+				vmauth: &vmv1beta1.VMAuth{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-vmauth",
+						Namespace: "default",
+					},
+					Spec: vmv1beta1.VMAuthSpec{
+						SelectAllByDefault: true,
+						UnauthorizedUserAccessSpec: &vmv1beta1.VMAuthUnauthorizedUserAccessSpec{
+							URLPrefix: []string{"http://vmsingle-default:8428"},
+							URLMap: []vmv1beta1.UnauthorizedAccessConfigURLMap{
+								{
+									SrcPaths:  []string{"/app1/.*"},
+									URLPrefix: []string{"http://app1-backend/"},
+									URLMapCommon: vmv1beta1.URLMapCommon{
+										DropSrcPathPrefixParts: ptr.To(1),
+									},
+								},
+							},
+							UserConfigOption: vmv1beta1.UserConfigOption{
+								IPFilters: vmv1beta1.VMUserIPFilters{
+									AllowList: []string{"10.0.0.0/8"},
+								},
+								MaxConcurrentRequests: ptr.To(10),
+							},
+							MetricLabels: map[string]string{"zone": "public"},
+						},
+						GlobalIPFilters: &vmv1beta1.VMUserIPFilters{
+							AllowList: []string{"10.0.0.0/8", "192.168.0.0/16"},
+							DenyList:  []string{"10.0.0.42"},
+						},
+					},
+				},
+			},
+			predefinedObjects: []runtime.Object{
+				&vmv1beta1.VMUser{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "user-1",
+						Namespace: "default",
+					},
+					Spec: vmv1beta1.VMUserSpec{
+						Name:        ptr.To("user1"),
+						BearerToken: ptr.To("bearer"),
+						TargetRefs: []vmv1beta1.TargetRef{
+							{
+								Static: &vmv1beta1.StaticRef{URL: "http://some-static"},
+								Paths:  []string{"/"},
+							},
+						},
+					},
+				},
+			},
+			want: `users:
+- url_prefix:
+  - http://some-static
+  name: user1
+  bearer_token: bearer
+unauthorized_user:
+  url_prefix:
+  - http://vmsingle-default:8428
+  url_map:
+  - src_paths:
+    - /app1/.*
+    url_prefix:
+    - http://app1-backend/
+    drop_src_path_prefix_parts: 1
+  ip_filters:
+    allow_list:
+    - 10.0.0.0/8
+  max_concurrent_requests: 10
+  metric_labels:
+    zone: public
+ip_filters:
+  allow_list:
+  - 10.0.0.0/8
+  - 192.168.0.0/16
+  deny_list:
+  - 10.0.0.42
 `,
 		},
 		{
@@ -1966,6 +2052,118 @@ unauthorized_user:
 - url_prefix:
   - http://vmagent-test.default.svc:8429
   bearer_token: bearer-token-2
+`,
+		},
+		{
+			name: "vmcluster tenant refs",
+			args: args{
+				vmauth: &vmv1beta1.VMAuth{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-vmauth",
+						Namespace: "default",
+					},
+					Spec: vmv1beta1.VMAuthSpec{
+						SelectAllByDefault: true,
+					},
+				},
+			},
+			predefinedObjects: []runtime.Object{
+				&vmv1beta1.VMUser{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "tenant-rw",
+						Namespace: "default",
+					},
+					Spec: vmv1beta1.VMUserSpec{
+						BearerToken: ptr.To("bearer-rw"),
+						TargetRefs: []vmv1beta1.TargetRef{
+							{
+								CRD: &vmv1beta1.CRDRef{
+									Kind:      "VMCluster",
+									Name:      "main",
+									Namespace: "default",
+									TenantID:  "1:5",
+								},
+							},
+						},
+					},
+				},
+				&vmv1beta1.VMUser{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "tenant-ro",
+						Namespace: "default",
+					},
+					Spec: vmv1beta1.VMUserSpec{
+						BearerToken: ptr.To("bearer-ro"),
+						TargetRefs: []vmv1beta1.TargetRef{
+							{
+								CRD: &vmv1beta1.CRDRef{
+									Kind:      "VMCluster",
+									Name:      "main",
+									Namespace: "default",
+									TenantID:  "2",
+									ReadOnly:  true,
+								},
+							},
+						},
+					},
+				},
+				&vmv1beta1.VMUser{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "tenant-wo",
+						Namespace: "default",
+					},
+					Spec: vmv1beta1.VMUserSpec{
+						BearerToken: ptr.To("bearer-wo"),
+						TargetRefs: []vmv1beta1.TargetRef{
+							{
+								CRD: &vmv1beta1.CRDRef{
+									Kind:      "VMCluster",
+									Name:      "main",
+									Namespace: "default",
+									TenantID:  "3",
+									WriteOnly: true,
+								},
+							},
+						},
+					},
+				},
+				&vmv1beta1.VMCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "main",
+						Namespace: "default",
+					},
+					Spec: vmv1beta1.VMClusterSpec{
+						VMSelect: &vmv1beta1.VMSelect{},
+						VMInsert: &vmv1beta1.VMInsert{},
+					},
+				},
+			},
+			want: `users:
+- url_prefix:
+  - http://vmselect-main.default.svc:8481/select/2/prometheus
+  bearer_token: bearer-ro
+- url_map:
+  - url_prefix:
+    - http://vminsert-main.default.svc:8480/insert/1:5/prometheus
+    src_paths:
+    - /api/v1/write
+    - /api/v1/import.*
+  - url_prefix:
+    - http://vmselect-main.default.svc:8481/select/1:5/prometheus
+    src_paths:
+    - /api/v1/query.*
+    - /api/v1/label.*
+    - /api/v1/series.*
+    - /api/v1/export.*
+    - /api/v1/status/.*
+    - /api/v1/metadata
+    - /api/v1/rules
+    - /api/v1/alerts
+    - /federate
+  bearer_token: bearer-rw
+- url_prefix:
+  - http://vminsert-main.default.svc:8480/insert/3/prometheus
+  bearer_token: bearer-wo
 `,
 		},
 	}
@@ -1991,3 +2189,81 @@ unauthorized_user:
 		})
 	}
 }
+
+func TestSyncVMUserStoredCredentials(t *testing.T) {
+	// emulates KV v2 secrets engine of vault
+	storage := make(map[string]map[string]string)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/v1/secret/data/vm-operator/")
+		switch r.Method {
+		case http.MethodGet:
+			data, ok := storage[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"data": data}})
+		case http.MethodPost:
+			var req struct {
+				Data map[string]string `json:"data"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			storage[key] = req.Data
+		}
+	}))
+	defer srv.Close()
+	t.Setenv("VAULT_TOKEN", "test-token")
+	if err := secretstore.Init(secretstore.BackendVault, 0, secretstore.VaultConfig{Address: srv.URL, PathPrefix: "vm-operator"}, secretstore.AWSConfig{}); err != nil {
+		t.Fatalf("cannot init secret store: %s", err)
+	}
+	defer func() {
+		_ = secretstore.Init(secretstore.BackendKubernetes, 0, secretstore.VaultConfig{}, secretstore.AWSConfig{})
+	}()
+
+	f := func(user *vmv1beta1.VMUser, predefinedObjects []runtime.Object, stored, wantStored map[string]string, wantPassword string) {
+		t.Helper()
+		clear(storage)
+		if stored != nil {
+			storage["default/vmuser-test"] = stored
+		}
+		fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		assert.NoError(t, syncVMUserStoredCredentials(context.Background(), fclient, user))
+		got := storage["default/vmuser-test"]
+		if wantPassword == "" {
+			// generated password
+			assert.Len(t, got["password"], passwordLength)
+			wantStored["password"] = got["password"]
+			wantPassword = got["password"]
+		}
+		assert.Equal(t, wantStored, got)
+		assert.Equal(t, wantPassword, ptr.Deref(user.Spec.Password, ""))
+	}
+	newUser := func(spec vmv1beta1.VMUserSpec) *vmv1beta1.VMUser {
+		return &vmv1beta1.VMUser{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec:       spec,
+		}
+	}
+
+	// generate new password
+	f(newUser(vmv1beta1.VMUserSpec{UserName: ptr.To("user-1"), GeneratePassword: true}), nil, nil,
+		map[string]string{"username": "user-1"}, "")
+
+	// keep previously generated password
+	f(newUser(vmv1beta1.VMUserSpec{UserName: ptr.To("user-1"), GeneratePassword: true}), nil,
+		map[string]string{"username": "user-1", "password": "stored"},
+		map[string]string{"username": "user-1", "password": "stored"}, "stored")
+
+	// update changed username
+	f(newUser(vmv1beta1.VMUserSpec{UserName: ptr.To("user-2"), Password: ptr.To("pass")}), nil,
+		map[string]string{"username": "user-1", "password": "pass"},
+		map[string]string{"username": "user-2", "password": "pass"}, "pass")
+
+	// migrate password from vmuser secret
+	f(newUser(vmv1beta1.VMUserSpec{UserName: ptr.To("user-1"), GeneratePassword: true}), []runtime.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "vmuser-test", Namespace: "default"},
+			Data:       map[string][]byte{"username": []byte("user-1"), "password": []byte("from-secret")},
+		},
+	}, nil, map[string]string{"username": "user-1", "password": "from-secret"}, "from-secret")
+}
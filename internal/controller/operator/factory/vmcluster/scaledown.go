@@ -0,0 +1,103 @@
+package vmcluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var nowFunc = time.Now
+
+// reconcileVMStorageScaleDown orchestrates decrease of vmstorage replicas.
+//
+// Scale down is refused without spec.vmstorage.allowScaleDown.
+// Otherwise removed nodes are drained with the following sequence:
+//  1. nodes are excluded from vminsert routing, but kept available for vmselect
+//  2. operator waits for drain period, which defaults to retentionPeriod
+//  3. nodes are removed from statefulset
+//
+// Drain progress is reported at status.storageDrain.
+// During draining, it mutates in-memory spec of the given cr, so statefulset keeps drained pods
+// and returns time left until drain finish. Object must be reconciled again after it.
+func reconcileVMStorageScaleDown(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMCluster) (time.Duration, error) {
+	vms := cr.Spec.VMStorage
+	if vms == nil || vms.ReplicaCount == nil {
+		cr.Status.StorageDrain = nil
+		return 0, nil
+	}
+	var currentSts appsv1.StatefulSet
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: cr.Namespace, Name: vms.GetNameWithPrefix(cr.Name)}, &currentSts); err != nil {
+		if errors.IsNotFound(err) {
+			cr.Status.StorageDrain = nil
+			return 0, nil
+		}
+		return 0, fmt.Errorf("cannot get vmstorage statefulset: %w", err)
+	}
+	desired := *vms.ReplicaCount
+	current := ptr.Deref(currentSts.Spec.Replicas, 1)
+
+	drain := cr.Status.StorageDrain
+	if drain != nil && desired >= drain.FromReplicas {
+		logger.WithContext(ctx).Info("vmstorage scale down was cancelled", "replicas", desired)
+		events.Normal(ctx, events.ReasonStorageDrainCancelled, "vmstorage scale down from %d to %d replicas was cancelled", drain.FromReplicas, drain.ToReplicas)
+		cr.Status.StorageDrain = nil
+		return 0, nil
+	}
+	if drain == nil {
+		if desired >= current {
+			return 0, nil
+		}
+		if !vms.AllowScaleDown {
+			return 0, fmt.Errorf("vmstorage replicaCount cannot be decreased from %d to %d without spec.vmstorage.allowScaleDown, it leads to data loss", current, desired)
+		}
+		period, err := cr.VMStorageScaleDownDrainPeriod()
+		if err != nil {
+			return 0, err
+		}
+		now := nowFunc()
+		drain = &vmv1beta1.VMStorageDrainStatus{
+			FromReplicas: current,
+			ToReplicas:   desired,
+			StartedAt:    metav1.NewTime(now),
+			FinishAt:     metav1.NewTime(now.Add(period)),
+		}
+		cr.Status.StorageDrain = drain
+		logger.WithContext(ctx).Info("starting vmstorage drain", "from_replicas", current, "to_replicas", desired, "finish_at", drain.FinishAt.String())
+		events.Normal(ctx, events.ReasonStorageDrainStarted, "starting vmstorage drain from %d to %d replicas, nodes will be removed at %s", current, desired, drain.FinishAt.UTC().Format(time.RFC3339))
+	}
+	if desired < drain.ToReplicas {
+		// additional nodes must be drained during the whole period
+		period, err := cr.VMStorageScaleDownDrainPeriod()
+		if err != nil {
+			return 0, err
+		}
+		drain.FinishAt = metav1.NewTime(nowFunc().Add(period))
+		events.Normal(ctx, events.ReasonStorageDrainStarted, "extending vmstorage drain to %d replicas, nodes will be removed at %s", desired, drain.FinishAt.UTC().Format(time.RFC3339))
+	}
+	drain.ToReplicas = desired
+
+	left := drain.FinishAt.Sub(nowFunc())
+	if left <= 0 {
+		logger.WithContext(ctx).Info("vmstorage drain finished, removing drained nodes", "replicas", desired)
+		events.Normal(ctx, events.ReasonStorageDrainFinished, "vmstorage drain finished, scaling down from %d to %d replicas", drain.FromReplicas, desired)
+		cr.Status.StorageDrain = nil
+		return 0, nil
+	}
+
+	// keep drained nodes available for vmselect and exclude them from vminsert routing
+	vms.ReplicaCount = ptr.To(drain.FromReplicas)
+	for id := desired; id < drain.FromReplicas; id++ {
+		vms.MaintenanceInsertNodeIDs = append(vms.MaintenanceInsertNodeIDs, id)
+	}
+	return left, nil
+}
@@ -0,0 +1,149 @@
+package vmcluster
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func TestReconcileVMStorageScaleDown(t *testing.T) {
+	now := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	nowFunc = func() time.Time { return now }
+	defer func() { nowFunc = time.Now }()
+
+	newCR := func(replicas int32, allowScaleDown bool, drain *vmv1beta1.VMStorageDrainStatus) *vmv1beta1.VMCluster {
+		return &vmv1beta1.VMCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"},
+			Spec: vmv1beta1.VMClusterSpec{
+				RetentionPeriod: "2d",
+				VMStorage: &vmv1beta1.VMStorage{
+					CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+						ReplicaCount: ptr.To(replicas),
+					},
+					AllowScaleDown: allowScaleDown,
+				},
+			},
+			Status: vmv1beta1.VMClusterStatus{StorageDrain: drain},
+		}
+	}
+	storageSts := func(replicas int32) []runtime.Object {
+		return []runtime.Object{&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "vmstorage-cluster", Namespace: "default"},
+			Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To(replicas)},
+		}}
+	}
+	type opts struct {
+		cr                *vmv1beta1.VMCluster
+		predefinedObjects []runtime.Object
+		wantErr           bool
+		wantDrain         *vmv1beta1.VMStorageDrainStatus
+		wantReplicas      int32
+		wantInsertNodes   []int32
+		wantLeft          time.Duration
+	}
+	f := func(o opts) {
+		t.Helper()
+		fclient := k8stools.GetTestClientWithObjects(o.predefinedObjects)
+		left, err := reconcileVMStorageScaleDown(context.Background(), fclient, o.cr)
+		if (err != nil) != o.wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, o.wantErr)
+		}
+		if o.wantErr {
+			return
+		}
+		if left != o.wantLeft {
+			t.Fatalf("unexpected time left until drain finish, got: %s, want: %s", left, o.wantLeft)
+		}
+		if !reflect.DeepEqual(o.cr.Status.StorageDrain, o.wantDrain) {
+			t.Fatalf("unexpected drain status\ngot:  %v\nwant: %v", o.cr.Status.StorageDrain, o.wantDrain)
+		}
+		if got := *o.cr.Spec.VMStorage.ReplicaCount; got != o.wantReplicas {
+			t.Fatalf("unexpected replicas, got: %d, want: %d", got, o.wantReplicas)
+		}
+		if got := o.cr.AvailableStorageNodeIDs("insert"); !reflect.DeepEqual(got, o.wantInsertNodes) {
+			t.Fatalf("unexpected insert nodes, got: %v, want: %v", got, o.wantInsertNodes)
+		}
+	}
+
+	// statefulset doesn't exist yet
+	f(opts{
+		cr:              newCR(1, false, nil),
+		wantReplicas:    1,
+		wantInsertNodes: []int32{0},
+	})
+
+	// scale up
+	f(opts{
+		cr:                newCR(3, false, nil),
+		predefinedObjects: storageSts(2),
+		wantReplicas:      3,
+		wantInsertNodes:   []int32{0, 1, 2},
+	})
+
+	// scale down is not allowed
+	f(opts{
+		cr:                newCR(1, false, nil),
+		predefinedObjects: storageSts(3),
+		wantErr:           true,
+	})
+
+	// start drain
+	f(opts{
+		cr:                newCR(1, true, nil),
+		predefinedObjects: storageSts(3),
+		wantDrain: &vmv1beta1.VMStorageDrainStatus{
+			FromReplicas: 3,
+			ToReplicas:   1,
+			StartedAt:    metav1.NewTime(now),
+			FinishAt:     metav1.NewTime(now.Add(48 * time.Hour)),
+		},
+		wantReplicas:    3,
+		wantInsertNodes: []int32{0},
+		wantLeft:        48 * time.Hour,
+	})
+
+	// drain in progress
+	inProgress := &vmv1beta1.VMStorageDrainStatus{
+		FromReplicas: 3,
+		ToReplicas:   2,
+		StartedAt:    metav1.NewTime(now.Add(-time.Hour)),
+		FinishAt:     metav1.NewTime(now.Add(time.Hour)),
+	}
+	f(opts{
+		cr:                newCR(2, true, inProgress.DeepCopy()),
+		predefinedObjects: storageSts(3),
+		wantDrain:         inProgress,
+		wantReplicas:      3,
+		wantInsertNodes:   []int32{0, 1},
+		wantLeft:          time.Hour,
+	})
+
+	// drain finished
+	f(opts{
+		cr: newCR(2, true, &vmv1beta1.VMStorageDrainStatus{
+			FromReplicas: 3,
+			ToReplicas:   2,
+			StartedAt:    metav1.NewTime(now.Add(-2 * time.Hour)),
+			FinishAt:     metav1.NewTime(now.Add(-time.Hour)),
+		}),
+		predefinedObjects: storageSts(3),
+		wantReplicas:      2,
+		wantInsertNodes:   []int32{0, 1},
+	})
+
+	// drain cancelled
+	f(opts{
+		cr:                newCR(3, true, inProgress.DeepCopy()),
+		predefinedObjects: storageSts(3),
+		wantReplicas:      3,
+		wantInsertNodes:   []int32{0, 1, 2},
+	})
+}
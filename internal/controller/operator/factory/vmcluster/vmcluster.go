@@ -6,19 +6,25 @@ import (
 	"path"
 	"sort"
 	"strings"
+	"time"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/build"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/defaultrules"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/grafana"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/tracing"
 	appsv1 "k8s.io/api/apps/v1"
 	v2 "k8s.io/api/autoscaling/v2"
 	"k8s.io/api/autoscaling/v2beta2"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -26,101 +32,215 @@ import (
 
 // CreateOrUpdateVMCluster reconciled cluster object with order
 // first we check status of vmStorage and waiting for its readiness
-// then vmSelect and wait for it readiness as well
-// and last one is vmInsert
+// then vmSelect and vmInsert are updated and waited for readiness concurrently
 // we manually handle statefulsets rolling updates
 // needed in update checked by revesion status
 // its controlled by k8s controller-manager
-func CreateOrUpdateVMCluster(ctx context.Context, cr *vmv1beta1.VMCluster, rclient client.Client) error {
+//
+// returned duration is non-zero if cluster must be reconciled again after it to finish vmstorage drain
+func CreateOrUpdateVMCluster(ctx context.Context, cr *vmv1beta1.VMCluster, rclient client.Client) (time.Duration, error) {
 	if cr.IsOwnsServiceAccount() {
 		if err := reconcile.ServiceAccount(ctx, rclient, build.ServiceAccount(cr)); err != nil {
-			return fmt.Errorf("failed create service account: %w", err)
+			return 0, fmt.Errorf("failed create service account: %w", err)
 		}
 	}
 
 	if err := deletePrevStateResources(ctx, cr, rclient); err != nil {
-		return fmt.Errorf("failed to remove objects from previous cluster state: %w", err)
+		return 0, fmt.Errorf("failed to remove objects from previous cluster state: %w", err)
+	}
+	drainLeft, err := reconcileVMStorageScaleDown(ctx, rclient, cr)
+	if err != nil {
+		return 0, err
 	}
 	if cr.Spec.VMStorage != nil {
 		if cr.Spec.VMStorage.PodDisruptionBudget != nil {
 			err := createOrUpdatePodDisruptionBudgetForVMStorage(ctx, cr, rclient)
 			if err != nil {
-				return err
+				return 0, err
 			}
 		}
 		if err := createOrUpdateVMStorage(ctx, cr, rclient); err != nil {
-			return err
+			return 0, err
 		}
 
 		storageSvc, err := createOrUpdateVMStorageService(ctx, cr, rclient)
 		if err != nil {
-			return err
+			return 0, err
 		}
 		if !ptr.Deref(cr.Spec.VMStorage.DisableSelfServiceScrape, false) {
-			err := reconcile.VMServiceScrapeForCRD(ctx, rclient, build.VMServiceScrapeForServiceWithSpec(storageSvc, cr.Spec.VMStorage, "http"))
+			svs := build.VMServiceScrapeForServiceWithSpec(storageSvc, cr.Spec.VMStorage, "http")
+			build.AddVMBackupEndpoint(svs, cr.Spec.VMStorage.VMBackup)
+			err := reconcile.VMServiceScrapeForCRD(ctx, rclient, svs)
 			if err != nil {
 				logger.WithContext(ctx).Error(err, "cannot create VMServiceScrape for vmStorage")
 			}
 		}
 	}
+	var prevDefaultRules *vmv1beta1.DefaultRules
+	if cr.ParsedLastAppliedSpec != nil {
+		prevDefaultRules = cr.ParsedLastAppliedSpec.DefaultRules
+	}
+	if err := defaultrules.CreateOrUpdate(ctx, rclient, cr.Spec.DefaultRules, prevDefaultRules, defaultrules.ForVMCluster(cr)); err != nil {
+		return 0, fmt.Errorf("cannot create or update default rules: %w", err)
+	}
 
+	// vmselect and vminsert don't depend on each other
+	// each task gets own copy of cr, since replica count of component could be updated by HPA
+	var tasks []func(ctx context.Context) error
+	var selectCR, insertCR *vmv1beta1.VMCluster
 	if cr.Spec.VMSelect != nil {
-		if cr.Spec.VMSelect.PodDisruptionBudget != nil {
-			if err := createOrUpdatePodDisruptionBudgetForVMSelect(ctx, cr, rclient); err != nil {
-				return err
-			}
-		}
-		if err := createOrUpdateVMSelect(ctx, cr, rclient); err != nil {
-			return err
+		selectCR = cr.DeepCopy()
+		tasks = append(tasks, func(ctx context.Context) error {
+			return reconcileVMSelect(ctx, selectCR, rclient)
+		})
+	}
+	if cr.Spec.VMInsert != nil {
+		insertCR = cr.DeepCopy()
+		tasks = append(tasks, func(ctx context.Context) error {
+			return reconcileVMInsert(ctx, insertCR, rclient)
+		})
+	}
+	err = reconcile.Parallel(ctx, tasks...)
+	if selectCR != nil {
+		cr.Spec.VMSelect.ReplicaCount = selectCR.Spec.VMSelect.ReplicaCount
+	}
+	if insertCR != nil {
+		cr.Spec.VMInsert.ReplicaCount = insertCR.Spec.VMInsert.ReplicaCount
+	}
+	if err != nil {
+		return 0, err
+	}
+	if cr.Spec.VMSelect != nil {
+		if err := updateVMSelectScaleStatus(ctx, rclient, cr); err != nil {
+			return 0, err
 		}
+	}
+	return drainLeft, nil
+}
 
-		if err := createOrUpdateVMSelectHPA(ctx, rclient, cr); err != nil {
-			return err
+// updateVMSelectScaleStatus sets observed replicas of vmselect workload and its selector, used by scale subresource
+func updateVMSelectScaleStatus(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMCluster) error {
+	nsn := types.NamespacedName{Namespace: cr.Namespace, Name: cr.Spec.VMSelect.GetNameWithPrefix(cr.Name)}
+	var replicas int32
+	if cr.Spec.VMSelect.IsDeployment() {
+		var dep appsv1.Deployment
+		if err := rclient.Get(ctx, nsn, &dep); err != nil {
+			return fmt.Errorf("cannot get vmselect deployment for scale status: %w", err)
 		}
-		// create vmselect service
-		selectSvc, err := createOrUpdateVMSelectService(ctx, cr, rclient)
-		if err != nil {
-			return err
-		}
-		if !ptr.Deref(cr.Spec.VMSelect.DisableSelfServiceScrape, false) {
-			err := reconcile.VMServiceScrapeForCRD(ctx, rclient, build.VMServiceScrapeForServiceWithSpec(selectSvc, cr.Spec.VMSelect, "http"))
-			if err != nil {
-				logger.WithContext(ctx).Error(err, "cannot create VMServiceScrape for vmSelect")
-			}
+		replicas = dep.Status.Replicas
+	} else {
+		var sts appsv1.StatefulSet
+		if err := rclient.Get(ctx, nsn, &sts); err != nil {
+			return fmt.Errorf("cannot get vmselect statefulset for scale status: %w", err)
 		}
+		replicas = sts.Status.Replicas
 	}
+	cr.Status.VMSelectReplicas = replicas
+	cr.Status.VMSelectSelector = labels.SelectorFromSet(cr.VMSelectSelectorLabels()).String()
+	return nil
+}
 
-	if cr.Spec.VMInsert != nil {
-		if cr.Spec.VMInsert.PodDisruptionBudget != nil {
-			if err := createOrUpdatePodDisruptionBudgetForVMInsert(ctx, cr, rclient); err != nil {
-				return err
-			}
-		}
-		if err := createOrUpdateVMInsert(ctx, cr, rclient); err != nil {
+func reconcileVMSelect(ctx context.Context, cr *vmv1beta1.VMCluster, rclient client.Client) error {
+	if cr.Spec.VMSelect.PodDisruptionBudget != nil {
+		if err := createOrUpdatePodDisruptionBudgetForVMSelect(ctx, cr, rclient); err != nil {
 			return err
 		}
-		insertSvc, err := createOrUpdateVMInsertService(ctx, cr, rclient)
+	}
+	if err := createOrUpdateVMSelect(ctx, cr, rclient); err != nil {
+		return err
+	}
+
+	if err := createOrUpdateVMSelectHPA(ctx, rclient, cr); err != nil {
+		return err
+	}
+	// create vmselect service
+	selectSvc, err := createOrUpdateVMSelectService(ctx, cr, rclient)
+	if err != nil {
+		return err
+	}
+	if err := createOrUpdateVMSelectIngress(ctx, rclient, cr); err != nil {
+		return fmt.Errorf("cannot create ingress for vmselect: %w", err)
+	}
+	if !ptr.Deref(cr.Spec.VMSelect.DisableSelfServiceScrape, false) {
+		err := reconcile.VMServiceScrapeForCRD(ctx, rclient, build.VMServiceScrapeForServiceWithSpec(selectSvc, cr.Spec.VMSelect, "http"))
 		if err != nil {
-			return err
+			logger.WithContext(ctx).Error(err, "cannot create VMServiceScrape for vmSelect")
 		}
-		if err := createOrUpdateVMInsertHPA(ctx, rclient, cr); err != nil {
+	}
+	if err := grafana.CreateOrUpdate(ctx, rclient, buildVMSelectGrafanaDatasource(cr)); err != nil {
+		return fmt.Errorf("cannot create grafana datasource for vmselect: %w", err)
+	}
+	return nil
+}
+
+func buildVMSelectGrafanaDatasource(cr *vmv1beta1.VMCluster) *grafana.Datasource {
+	return &grafana.Datasource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            cr.Spec.VMSelect.GetNameWithPrefix(cr.Name),
+			Namespace:       cr.Namespace,
+			Labels:          cr.FinalLabels(cr.VMSelectSelectorLabels()),
+			Annotations:     cr.AnnotationsFiltered(),
+			OwnerReferences: cr.AsOwner(),
+		},
+		DisplayName: fmt.Sprintf("vmcluster/%s/%s", cr.Namespace, cr.Name),
+		URL:         cr.VMSelectURL() + "/select/0/prometheus",
+		DashboardID: grafana.DashboardVMCluster,
+	}
+}
+
+func reconcileVMInsert(ctx context.Context, cr *vmv1beta1.VMCluster, rclient client.Client) error {
+	if cr.Spec.VMInsert.PodDisruptionBudget != nil {
+		if err := createOrUpdatePodDisruptionBudgetForVMInsert(ctx, cr, rclient); err != nil {
 			return err
 		}
-		if !ptr.Deref(cr.Spec.VMInsert.DisableSelfServiceScrape, false) {
-			err := reconcile.VMServiceScrapeForCRD(ctx, rclient, build.VMServiceScrapeForServiceWithSpec(insertSvc, cr.Spec.VMInsert, "http"))
-			if err != nil {
-				logger.WithContext(ctx).Error(err, "cannot create VMServiceScrape for vmInsert")
-			}
+	}
+	if err := createOrUpdateVMInsert(ctx, cr, rclient); err != nil {
+		return err
+	}
+	insertSvc, err := createOrUpdateVMInsertService(ctx, cr, rclient)
+	if err != nil {
+		return err
+	}
+	if err := createOrUpdateVMInsertHPA(ctx, rclient, cr); err != nil {
+		return err
+	}
+	if !ptr.Deref(cr.Spec.VMInsert.DisableSelfServiceScrape, false) {
+		err := reconcile.VMServiceScrapeForCRD(ctx, rclient, build.VMServiceScrapeForServiceWithSpec(insertSvc, cr.Spec.VMInsert, "http"))
+		if err != nil {
+			logger.WithContext(ctx).Error(err, "cannot create VMServiceScrape for vmInsert")
 		}
-
 	}
 	return nil
 }
 
 func createOrUpdateVMSelect(ctx context.Context, cr *vmv1beta1.VMCluster, rclient client.Client) error {
+	if cr.Spec.VMSelect.IsDeployment() {
+		if err := createOrUpdateVMSelectDeployment(ctx, cr, rclient); err != nil {
+			return err
+		}
+	} else if err := createOrUpdateVMSelectStatefulSet(ctx, cr, rclient); err != nil {
+		return err
+	}
+	// workload of previous type is removed only after the new one becomes ready,
+	// it keeps vmselect available during workloadType change
+	prevSpec := cr.ParsedLastAppliedSpec
+	if prevSpec == nil || prevSpec.VMSelect == nil || prevSpec.VMSelect.IsDeployment() == cr.Spec.VMSelect.IsDeployment() {
+		return nil
+	}
+	objMeta := metav1.ObjectMeta{Namespace: cr.Namespace, Name: prevSpec.VMSelect.GetNameWithPrefix(cr.Name)}
+	var prevWorkload client.Object = &appsv1.StatefulSet{ObjectMeta: objMeta}
+	if prevSpec.VMSelect.IsDeployment() {
+		prevWorkload = &appsv1.Deployment{ObjectMeta: objMeta}
+	}
+	if err := finalize.SafeDeleteWithFinalizer(ctx, rclient, prevWorkload); err != nil {
+		return fmt.Errorf("cannot remove %T workload from prev select: %w", prevWorkload, err)
+	}
+	return nil
+}
 
+func createOrUpdateVMSelectStatefulSet(ctx context.Context, cr *vmv1beta1.VMCluster, rclient client.Client) error {
 	var prevSts *appsv1.StatefulSet
-	if cr.ParsedLastAppliedSpec != nil && cr.ParsedLastAppliedSpec.VMSelect != nil {
+	if cr.ParsedLastAppliedSpec != nil && cr.ParsedLastAppliedSpec.VMSelect != nil && !cr.ParsedLastAppliedSpec.VMSelect.IsDeployment() {
 		prevCR := cr.DeepCopy()
 		prevCR.Spec = *cr.ParsedLastAppliedSpec
 		var err error
@@ -128,16 +248,28 @@ func createOrUpdateVMSelect(ctx context.Context, cr *vmv1beta1.VMCluster, rclien
 		if err != nil {
 			return fmt.Errorf("cannot build prev storage spec: %w", err)
 		}
+		if err := build.ApplyOverridePatches(prevCR.Spec.VMSelect.OverridePatches, prevSts); err != nil {
+			return fmt.Errorf("cannot apply override patches to prev object: %w", err)
+		}
 	}
-	newSts, err := genVMSelectSpec(cr)
+	newSts, err := tracing.Build(ctx, "vmselect", func() (*appsv1.StatefulSet, error) {
+		return genVMSelectSpec(cr)
+	})
 	if err != nil {
 		return err
 	}
+	if err := build.AddLicenseChecksumAnnotation(ctx, rclient, cr.Namespace, cr.Spec.License, newSts); err != nil {
+		return err
+	}
+	if err := build.ApplyOverridePatches(cr.Spec.VMSelect.OverridePatches, newSts); err != nil {
+		return err
+	}
 
 	stsOpts := reconcile.STSOptions{
 		HasClaim:       len(newSts.Spec.VolumeClaimTemplates) > 0,
 		SelectorLabels: cr.VMSelectSelectorLabels,
 		HPA:            cr.Spec.VMSelect.HPA,
+		UpdateStrategy: cr.Spec.VMSelect.UpdateStrategy,
 		UpdateReplicaCount: func(count *int32) {
 			if cr.Spec.VMSelect.HPA != nil && count != nil {
 				cr.Spec.VMSelect.ReplicaCount = count
@@ -147,6 +279,35 @@ func createOrUpdateVMSelect(ctx context.Context, cr *vmv1beta1.VMCluster, rclien
 	return reconcile.HandleSTSUpdate(ctx, rclient, stsOpts, newSts, prevSts)
 }
 
+func createOrUpdateVMSelectDeployment(ctx context.Context, cr *vmv1beta1.VMCluster, rclient client.Client) error {
+	var prevDeploy *appsv1.Deployment
+	if cr.ParsedLastAppliedSpec != nil && cr.ParsedLastAppliedSpec.VMSelect != nil && cr.ParsedLastAppliedSpec.VMSelect.IsDeployment() {
+		prevCR := cr.DeepCopy()
+		prevCR.Spec = *cr.ParsedLastAppliedSpec
+		var err error
+		prevDeploy, err = genVMSelectDeploymentSpec(prevCR)
+		if err != nil {
+			return fmt.Errorf("cannot generate prev deploy spec: %w", err)
+		}
+		if err := build.ApplyOverridePatches(prevCR.Spec.VMSelect.OverridePatches, prevDeploy); err != nil {
+			return fmt.Errorf("cannot apply override patches to prev object: %w", err)
+		}
+	}
+	newDeployment, err := tracing.Build(ctx, "vmselect", func() (*appsv1.Deployment, error) {
+		return genVMSelectDeploymentSpec(cr)
+	})
+	if err != nil {
+		return err
+	}
+	if err := build.AddLicenseChecksumAnnotation(ctx, rclient, cr.Namespace, cr.Spec.License, newDeployment); err != nil {
+		return err
+	}
+	if err := build.ApplyOverridePatches(cr.Spec.VMSelect.OverridePatches, newDeployment); err != nil {
+		return err
+	}
+	return reconcile.Deployment(ctx, rclient, newDeployment, prevDeploy, cr.Spec.VMSelect.HPA != nil)
+}
+
 func createOrUpdateVMSelectService(ctx context.Context, cr *vmv1beta1.VMCluster, rclient client.Client) (*corev1.Service, error) {
 
 	t := &clusterSvcBuilder{
@@ -211,10 +372,58 @@ func createOrUpdateVMSelectService(ctx context.Context, cr *vmv1beta1.VMCluster,
 	return newHeadless, nil
 }
 
+// createOrUpdateVMSelectIngress handles ingress for vmselect service.
+// Route is created instead of Ingress at OpenShift platform
+func createOrUpdateVMSelectIngress(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMCluster) error {
+	if cr.Spec.VMSelect.Ingress == nil {
+		return nil
+	}
+	objMeta := metav1.ObjectMeta{
+		Name:            cr.Spec.VMSelect.GetNameWithPrefix(cr.Name),
+		Namespace:       cr.Namespace,
+		Labels:          cr.VMSelectSelectorLabels(),
+		OwnerReferences: cr.AsOwner(),
+	}
+	if k8stools.IsOpenShift() {
+		return reconcile.Route(ctx, rclient, build.Route(objMeta, objMeta.Name, cr.Spec.VMSelect.Ingress))
+	}
+	return reconcile.Ingress(ctx, rclient, build.Ingress(objMeta, objMeta.Name, cr.Spec.VMSelect.Ingress))
+}
+
 func createOrUpdateVMInsert(ctx context.Context, cr *vmv1beta1.VMCluster, rclient client.Client) error {
+	if cr.Spec.VMInsert.IsStatefulSet() {
+		if err := createOrUpdateVMInsertStatefulSet(ctx, cr, rclient); err != nil {
+			return err
+		}
+	} else if err := createOrUpdateVMInsertDeployment(ctx, cr, rclient); err != nil {
+		return err
+	}
+	// workload of previous type is removed only after the new one becomes ready,
+	// it keeps vminsert available during workloadType change
+	prevSpec := cr.ParsedLastAppliedSpec
+	if prevSpec == nil || prevSpec.VMInsert == nil || prevSpec.VMInsert.IsStatefulSet() == cr.Spec.VMInsert.IsStatefulSet() {
+		return nil
+	}
+	objMeta := metav1.ObjectMeta{Namespace: cr.Namespace, Name: prevSpec.VMInsert.GetNameWithPrefix(cr.Name)}
+	prevObjs := []client.Object{&appsv1.Deployment{ObjectMeta: objMeta}}
+	if prevSpec.VMInsert.IsStatefulSet() {
+		prevObjs = []client.Object{
+			&appsv1.StatefulSet{ObjectMeta: objMeta},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: cr.Namespace, Name: prevSpec.VMInsert.GetHeadlessServiceName(cr.Name)}},
+		}
+	}
+	for _, obj := range prevObjs {
+		if err := finalize.SafeDeleteWithFinalizer(ctx, rclient, obj); err != nil {
+			return fmt.Errorf("cannot remove %T from prev insert: %w", obj, err)
+		}
+	}
+	return nil
+}
+
+func createOrUpdateVMInsertDeployment(ctx context.Context, cr *vmv1beta1.VMCluster, rclient client.Client) error {
 	var prevDeploy *appsv1.Deployment
 
-	if cr.ParsedLastAppliedSpec != nil && cr.ParsedLastAppliedSpec.VMInsert != nil {
+	if cr.ParsedLastAppliedSpec != nil && cr.ParsedLastAppliedSpec.VMInsert != nil && !cr.ParsedLastAppliedSpec.VMInsert.IsStatefulSet() {
 		prevCR := cr.DeepCopy()
 		prevCR.Spec = *cr.ParsedLastAppliedSpec
 		var err error
@@ -222,14 +431,82 @@ func createOrUpdateVMInsert(ctx context.Context, cr *vmv1beta1.VMCluster, rclien
 		if err != nil {
 			return fmt.Errorf("cannot generate prev deploy spec: %w", err)
 		}
+		if err := build.ApplyOverridePatches(prevCR.Spec.VMInsert.OverridePatches, prevDeploy); err != nil {
+			return fmt.Errorf("cannot apply override patches to prev object: %w", err)
+		}
 	}
-	newDeployment, err := genVMInsertSpec(cr)
+	newDeployment, err := tracing.Build(ctx, "vminsert", func() (*appsv1.Deployment, error) {
+		return genVMInsertSpec(cr)
+	})
 	if err != nil {
 		return err
 	}
+	if err := build.AddLicenseChecksumAnnotation(ctx, rclient, cr.Namespace, cr.Spec.License, newDeployment); err != nil {
+		return err
+	}
+	if err := build.ApplyOverridePatches(cr.Spec.VMInsert.OverridePatches, newDeployment); err != nil {
+		return err
+	}
 	return reconcile.Deployment(ctx, rclient, newDeployment, prevDeploy, cr.Spec.VMInsert.HPA != nil)
 }
 
+func createOrUpdateVMInsertStatefulSet(ctx context.Context, cr *vmv1beta1.VMCluster, rclient client.Client) error {
+	// headless service must exist before pods, it provides DNS names for them
+	if err := reconcile.Service(ctx, rclient, buildVMInsertHeadlessService(cr), nil); err != nil {
+		return fmt.Errorf("cannot reconcile vminsert headless service: %w", err)
+	}
+	var prevSts *appsv1.StatefulSet
+	if cr.ParsedLastAppliedSpec != nil && cr.ParsedLastAppliedSpec.VMInsert != nil && cr.ParsedLastAppliedSpec.VMInsert.IsStatefulSet() {
+		prevCR := cr.DeepCopy()
+		prevCR.Spec = *cr.ParsedLastAppliedSpec
+		var err error
+		prevSts, err = genVMInsertStatefulSetSpec(prevCR)
+		if err != nil {
+			return fmt.Errorf("cannot generate prev sts spec: %w", err)
+		}
+		if err := build.ApplyOverridePatches(prevCR.Spec.VMInsert.OverridePatches, prevSts); err != nil {
+			return fmt.Errorf("cannot apply override patches to prev object: %w", err)
+		}
+	}
+	newSts, err := tracing.Build(ctx, "vminsert", func() (*appsv1.StatefulSet, error) {
+		return genVMInsertStatefulSetSpec(cr)
+	})
+	if err != nil {
+		return err
+	}
+	if err := build.AddLicenseChecksumAnnotation(ctx, rclient, cr.Namespace, cr.Spec.License, newSts); err != nil {
+		return err
+	}
+	if err := build.ApplyOverridePatches(cr.Spec.VMInsert.OverridePatches, newSts); err != nil {
+		return err
+	}
+	stsOpts := reconcile.STSOptions{
+		SelectorLabels: cr.VMInsertSelectorLabels,
+		HPA:            cr.Spec.VMInsert.HPA,
+		UpdateReplicaCount: func(count *int32) {
+			if cr.Spec.VMInsert.HPA != nil && count != nil {
+				cr.Spec.VMInsert.ReplicaCount = count
+			}
+		},
+	}
+	return reconcile.HandleSTSUpdate(ctx, rclient, stsOpts, newSts, prevSts)
+}
+
+// buildVMInsertHeadlessService builds service, which is governing service of vminsert StatefulSet.
+// Default vminsert service has clusterIP and cannot provide DNS names for pods
+func buildVMInsertHeadlessService(cr *vmv1beta1.VMCluster) *corev1.Service {
+	t := &clusterSvcBuilder{
+		cr,
+		cr.Spec.VMInsert.GetHeadlessServiceName(cr.Name),
+		cr.FinalLabels(cr.VMInsertSelectorLabels()),
+		cr.VMInsertSelectorLabels(),
+		nil,
+	}
+	return build.Service(t, cr.Spec.VMInsert.Port, func(svc *corev1.Service) {
+		svc.Spec.ClusterIP = corev1.ClusterIPNone
+	})
+}
+
 func createOrUpdateVMInsertService(ctx context.Context, cr *vmv1beta1.VMCluster, rclient client.Client) (*corev1.Service, error) {
 	t := &clusterSvcBuilder{
 		cr,
@@ -305,15 +582,27 @@ func createOrUpdateVMStorage(ctx context.Context, cr *vmv1beta1.VMCluster, rclie
 		if err != nil {
 			return fmt.Errorf("cannot build prev storage spec: %w", err)
 		}
+		if err := build.ApplyOverridePatches(prevCR.Spec.VMStorage.OverridePatches, prevSts); err != nil {
+			return fmt.Errorf("cannot apply override patches to prev object: %w", err)
+		}
 	}
-	newSts, err := buildVMStorageSpec(ctx, cr)
+	newSts, err := tracing.Build(ctx, "vmstorage", func() (*appsv1.StatefulSet, error) {
+		return buildVMStorageSpec(ctx, cr)
+	})
 	if err != nil {
 		return err
 	}
+	if err := build.AddLicenseChecksumAnnotation(ctx, rclient, cr.Namespace, cr.Spec.License, newSts); err != nil {
+		return err
+	}
+	if err := build.ApplyOverridePatches(cr.Spec.VMStorage.OverridePatches, newSts); err != nil {
+		return err
+	}
 
 	stsOpts := reconcile.STSOptions{
 		HasClaim:       len(newSts.Spec.VolumeClaimTemplates) > 0,
 		SelectorLabels: cr.VMStorageSelectorLabels,
+		UpdateStrategy: cr.Spec.VMStorage.UpdateStrategy,
 	}
 	return reconcile.HandleSTSUpdate(ctx, rclient, stsOpts, newSts, prevSts)
 }
@@ -423,17 +712,15 @@ func genVMSelectSpec(cr *vmv1beta1.VMCluster) (*appsv1.StatefulSet, error) {
 			Labels:          cr.FinalLabels(cr.VMSelectSelectorLabels()),
 			Annotations:     cr.AnnotationsFiltered(),
 			OwnerReferences: cr.AsOwner(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Spec: appsv1.StatefulSetSpec{
 			Selector: &metav1.LabelSelector{
 				MatchLabels: cr.VMSelectSelectorLabels(),
 			},
-			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
-				Type: cr.Spec.VMSelect.RollingUpdateStrategy,
-			},
-			Template:    *podSpec,
-			ServiceName: cr.Spec.VMSelect.GetNameWithPrefix(cr.Name),
+			UpdateStrategy: build.StatefulSetUpdateStrategy(cr.Spec.VMSelect.UpdateStrategy, cr.Spec.VMSelect.RollingUpdateStrategy),
+			Template:       *podSpec,
+			ServiceName:    cr.Spec.VMSelect.GetNameWithPrefix(cr.Name),
 		},
 	}
 	build.StatefulSetAddCommonParams(stsSpec, ptr.Deref(cr.Spec.VMSelect.UseStrictSecurity, false), &cr.Spec.VMSelect.CommonApplicationDeploymentParams)
@@ -449,6 +736,50 @@ func genVMSelectSpec(cr *vmv1beta1.VMCluster) (*appsv1.StatefulSet, error) {
 	return stsSpec, nil
 }
 
+// genVMSelectDeploymentSpec builds stateless vmselect, cache is stored at emptyDir volume
+func genVMSelectDeploymentSpec(cr *vmv1beta1.VMCluster) (*appsv1.Deployment, error) {
+	podSpec, err := makePodSpecForVMSelect(cr)
+	if err != nil {
+		return nil, err
+	}
+
+	depSpec := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            cr.Spec.VMSelect.GetNameWithPrefix(cr.Name),
+			Namespace:       cr.Namespace,
+			Labels:          cr.FinalLabels(cr.VMSelectSelectorLabels()),
+			Annotations:     cr.AnnotationsFiltered(),
+			OwnerReferences: cr.AsOwner(),
+			Finalizers:      finalize.ChildFinalizers(),
+		},
+		Spec: appsv1.DeploymentSpec{
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
+			},
+			Selector: &metav1.LabelSelector{
+				MatchLabels: cr.VMSelectSelectorLabels(),
+			},
+			Template: *podSpec,
+		},
+	}
+	build.DeploymentAddCommonParams(depSpec, ptr.Deref(cr.Spec.VMSelect.UseStrictSecurity, false), &cr.Spec.VMSelect.CommonApplicationDeploymentParams)
+	if cr.Spec.VMSelect.CacheMountPath != "" {
+		emptyDir := &corev1.EmptyDirVolumeSource{}
+		for _, ss := range []*vmv1beta1.StorageSpec{cr.Spec.VMSelect.Storage, cr.Spec.VMSelect.StorageSpec} {
+			if ss != nil && ss.EmptyDir != nil {
+				emptyDir = ss.EmptyDir
+			}
+		}
+		depSpec.Spec.Template.Spec.Volumes = append(depSpec.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name: cr.Spec.VMSelect.GetCacheMountVolumeName(),
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: emptyDir,
+			},
+		})
+	}
+	return depSpec, nil
+}
+
 func makePodSpecForVMSelect(cr *vmv1beta1.VMCluster) (*corev1.PodTemplateSpec, error) {
 	args := []string{
 		fmt.Sprintf("-httpListenAddr=:%s", cr.Spec.VMSelect.Port),
@@ -462,6 +793,23 @@ func makePodSpecForVMSelect(cr *vmv1beta1.VMCluster) (*corev1.PodTemplateSpec, e
 	if cr.Spec.VMSelect.LogFormat != "" {
 		args = append(args, fmt.Sprintf("-loggerFormat=%s", cr.Spec.VMSelect.LogFormat))
 	}
+	for _, dp := range cr.Spec.DownsamplingPeriods {
+		args = append(args, fmt.Sprintf("-downsampling.period=%s", dp))
+	}
+	if c := cr.Spec.VMSelect.Cache; c != nil {
+		if c.Disabled {
+			args = append(args, "-search.disableCache=true")
+		}
+		if c.TimestampOffset != "" {
+			args = append(args, fmt.Sprintf("-search.cacheTimestampOffset=%s", c.TimestampOffset))
+		}
+		if c.ResetOnStartup {
+			args = append(args, "-search.resetRollupResultCacheOnStartup=true")
+		}
+		if c.MemoryAllowedPercent != nil {
+			args = append(args, fmt.Sprintf("-memory.allowedPercent=%d", *c.MemoryAllowedPercent))
+		}
+	}
 	if cr.Spec.ReplicationFactor != nil && *cr.Spec.ReplicationFactor > 1 {
 		var replicationFactorIsSet bool
 		var dedupIsSet bool
@@ -493,7 +841,8 @@ func makePodSpecForVMSelect(cr *vmv1beta1.VMCluster) (*corev1.PodTemplateSpec, e
 	}
 	// selectNode arg add for deployments without HPA
 	// HPA leads to rolling restart for vmselect statefulset in case of replicas count changes
-	if cr.Spec.VMSelect.HPA == nil && cr.Spec.VMSelect.ReplicaCount != nil {
+	// pods of Deployment have no stable network identity
+	if cr.Spec.VMSelect.HPA == nil && cr.Spec.VMSelect.ReplicaCount != nil && !cr.Spec.VMSelect.IsDeployment() {
 		selectArg := "-selectNode="
 		vmselectCount := *cr.Spec.VMSelect.ReplicaCount
 		for i := int32(0); i < vmselectCount; i++ {
@@ -568,6 +917,7 @@ func makePodSpecForVMSelect(cr *vmv1beta1.VMCluster) (*corev1.PodTemplateSpec, e
 	volumes, vmMounts = cr.Spec.License.MaybeAddToVolumes(volumes, vmMounts, vmv1beta1.SecretsDir)
 	args = cr.Spec.License.MaybeAddToArgs(args, vmv1beta1.SecretsDir)
 
+	args = build.AddVersionedArgs("vmselect", cr.Spec.VMSelect.Image.Tag, args, "-")
 	args = build.AddExtraArgsOverrideDefaults(args, cr.Spec.VMSelect.ExtraArgs, "-")
 	sort.Strings(args)
 	vmselectContainer := corev1.Container{
@@ -624,7 +974,7 @@ func createOrUpdatePodDisruptionBudgetForVMSelect(ctx context.Context, cr *vmv1b
 			Labels:          cr.FinalLabels(cr.VMSelectSelectorLabels()),
 			OwnerReferences: cr.AsOwner(),
 			Namespace:       cr.Namespace,
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Spec: policyv1.PodDisruptionBudgetSpec{
 			MinAvailable:   cr.Spec.VMSelect.PodDisruptionBudget.MinAvailable,
@@ -655,7 +1005,7 @@ func genVMInsertSpec(cr *vmv1beta1.VMCluster) (*appsv1.Deployment, error) {
 			Labels:          cr.FinalLabels(cr.VMInsertSelectorLabels()),
 			Annotations:     cr.AnnotationsFiltered(),
 			OwnerReferences: cr.AsOwner(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Spec: appsv1.DeploymentSpec{
 			Replicas:             cr.Spec.VMInsert.ReplicaCount,
@@ -675,6 +1025,38 @@ func genVMInsertSpec(cr *vmv1beta1.VMCluster) (*appsv1.Deployment, error) {
 	return stsSpec, nil
 }
 
+// genVMInsertStatefulSetSpec builds vminsert with stable network identity of pods
+func genVMInsertStatefulSetSpec(cr *vmv1beta1.VMCluster) (*appsv1.StatefulSet, error) {
+	podSpec, err := makePodSpecForVMInsert(cr)
+	if err != nil {
+		return nil, err
+	}
+
+	stsSpec := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            cr.Spec.VMInsert.GetNameWithPrefix(cr.Name),
+			Namespace:       cr.Namespace,
+			Labels:          cr.FinalLabels(cr.VMInsertSelectorLabels()),
+			Annotations:     cr.AnnotationsFiltered(),
+			OwnerReferences: cr.AsOwner(),
+			Finalizers:      finalize.ChildFinalizers(),
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: cr.VMInsertSelectorLabels(),
+			},
+			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type: appsv1.RollingUpdateStatefulSetStrategyType,
+			},
+			PodManagementPolicy: appsv1.ParallelPodManagement,
+			Template:            *podSpec,
+			ServiceName:         cr.Spec.VMInsert.GetHeadlessServiceName(cr.Name),
+		},
+	}
+	build.StatefulSetAddCommonParams(stsSpec, ptr.Deref(cr.Spec.VMInsert.UseStrictSecurity, false), &cr.Spec.VMInsert.CommonApplicationDeploymentParams)
+	return stsSpec, nil
+}
+
 func makePodSpecForVMInsert(cr *vmv1beta1.VMCluster) (*corev1.PodTemplateSpec, error) {
 	args := []string{
 		fmt.Sprintf("-httpListenAddr=:%s", cr.Spec.VMInsert.Port),
@@ -774,6 +1156,7 @@ func makePodSpecForVMInsert(cr *vmv1beta1.VMCluster) (*corev1.PodTemplateSpec, e
 	volumes, vmMounts = cr.Spec.License.MaybeAddToVolumes(volumes, vmMounts, vmv1beta1.SecretsDir)
 	args = cr.Spec.License.MaybeAddToArgs(args, vmv1beta1.SecretsDir)
 
+	args = build.AddVersionedArgs("vminsert", cr.Spec.VMInsert.Image.Tag, args, "-")
 	args = build.AddExtraArgsOverrideDefaults(args, cr.Spec.VMInsert.ExtraArgs, "-")
 	sort.Strings(args)
 
@@ -829,7 +1212,7 @@ func createOrUpdatePodDisruptionBudgetForVMInsert(ctx context.Context, cr *vmv1b
 			Labels:          cr.FinalLabels(cr.VMInsertSelectorLabels()),
 			OwnerReferences: cr.AsOwner(),
 			Namespace:       cr.Namespace,
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Spec: policyv1.PodDisruptionBudgetSpec{
 			MinAvailable:   cr.Spec.VMInsert.PodDisruptionBudget.MinAvailable,
@@ -856,17 +1239,15 @@ func buildVMStorageSpec(ctx context.Context, cr *vmv1beta1.VMCluster) (*appsv1.S
 			Labels:          cr.FinalLabels(cr.VMStorageSelectorLabels()),
 			Annotations:     cr.AnnotationsFiltered(),
 			OwnerReferences: cr.AsOwner(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Spec: appsv1.StatefulSetSpec{
 			Selector: &metav1.LabelSelector{
 				MatchLabels: cr.VMStorageSelectorLabels(),
 			},
-			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
-				Type: cr.Spec.VMStorage.RollingUpdateStrategy,
-			},
-			Template:    *podSpec,
-			ServiceName: cr.Spec.VMStorage.GetNameWithPrefix(cr.Name),
+			UpdateStrategy: build.StatefulSetUpdateStrategy(cr.Spec.VMStorage.UpdateStrategy, cr.Spec.VMStorage.RollingUpdateStrategy),
+			Template:       *podSpec,
+			ServiceName:    cr.Spec.VMStorage.GetNameWithPrefix(cr.Name),
 		},
 	}
 	build.StatefulSetAddCommonParams(stsSpec, ptr.Deref(cr.Spec.VMStorage.UseStrictSecurity, false), &cr.Spec.VMStorage.CommonApplicationDeploymentParams)
@@ -890,6 +1271,12 @@ func makePodSpecForVMStorage(ctx context.Context, cr *vmv1beta1.VMCluster) (*cor
 	if cr.Spec.VMStorage.LogFormat != "" {
 		args = append(args, fmt.Sprintf("-loggerFormat=%s", cr.Spec.VMStorage.LogFormat))
 	}
+	for _, rf := range cr.Spec.RetentionFilters {
+		args = append(args, fmt.Sprintf("-retentionFilter=%s", rf))
+	}
+	for _, dp := range cr.Spec.DownsamplingPeriods {
+		args = append(args, fmt.Sprintf("-downsampling.period=%s", dp))
+	}
 
 	if len(cr.Spec.VMStorage.ExtraEnvs) > 0 {
 		args = append(args, "-envflag.enable=true")
@@ -990,6 +1377,8 @@ func makePodSpecForVMStorage(ctx context.Context, cr *vmv1beta1.VMCluster) (*cor
 	volumes, vmMounts = cr.Spec.License.MaybeAddToVolumes(volumes, vmMounts, vmv1beta1.SecretsDir)
 	args = cr.Spec.License.MaybeAddToArgs(args, vmv1beta1.SecretsDir)
 
+	args = build.AppendArgsForCardinalityLimits(args, cr.Spec.VMStorage.CardinalityLimits, "storage")
+	args = build.AddVersionedArgs("vmstorage", cr.Spec.VMStorage.Image.Tag, args, "-")
 	args = build.AddExtraArgsOverrideDefaults(args, cr.Spec.VMStorage.ExtraArgs, "-")
 	sort.Strings(args)
 	vmstorageContainer := corev1.Container{
@@ -1073,7 +1462,7 @@ func createOrUpdatePodDisruptionBudgetForVMStorage(ctx context.Context, cr *vmv1
 			Labels:          cr.FinalLabels(cr.VMStorageSelectorLabels()),
 			OwnerReferences: cr.AsOwner(),
 			Namespace:       cr.Namespace,
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Spec: policyv1.PodDisruptionBudgetSpec{
 			MinAvailable:   cr.Spec.VMStorage.PodDisruptionBudget.MinAvailable,
@@ -1095,6 +1484,9 @@ func createOrUpdateVMInsertHPA(ctx context.Context, rclient client.Client, clust
 		Kind:       "Deployment",
 		APIVersion: "apps/v1",
 	}
+	if cluster.Spec.VMInsert.IsStatefulSet() {
+		targetRef.Kind = "StatefulSet"
+	}
 	defaultHPA := build.HPA(targetRef, cluster.Spec.VMInsert.HPA, cluster.AsOwner(), cluster.VMInsertSelectorLabels(), cluster.Namespace)
 	return reconcile.HPA(ctx, rclient, defaultHPA)
 }
@@ -1108,6 +1500,9 @@ func createOrUpdateVMSelectHPA(ctx context.Context, rclient client.Client, clust
 		Kind:       "StatefulSet",
 		APIVersion: "apps/v1",
 	}
+	if cluster.Spec.VMSelect.IsDeployment() {
+		targetRef.Kind = "Deployment"
+	}
 	defaultHPA := build.HPA(targetRef, cluster.Spec.VMSelect.HPA, cluster.AsOwner(), cluster.VMSelectSelectorLabels(), cluster.Namespace)
 	return reconcile.HPA(ctx, rclient, defaultHPA)
 }
@@ -1180,6 +1575,9 @@ func deletePrevStateResources(ctx context.Context, cr *vmv1beta1.VMCluster, rcli
 			if err := finalize.OnVMSelectDelete(ctx, rclient, cr, prevSe); err != nil {
 				return fmt.Errorf("cannot remove select from prev state: %w", err)
 			}
+			if err := grafana.Delete(ctx, rclient, metav1.ObjectMeta{Namespace: cr.Namespace, Name: prevSe.GetNameWithPrefix(cr.Name)}); err != nil {
+				return fmt.Errorf("cannot remove grafana datasource of select from prev state: %w", err)
+			}
 		} else {
 			commonObjMeta := metav1.ObjectMeta{
 				Namespace: cr.Namespace, Name: prevSe.GetNameWithPrefix(cr.Name)}
@@ -1193,6 +1591,11 @@ func deletePrevStateResources(ctx context.Context, cr *vmv1beta1.VMCluster, rcli
 					return fmt.Errorf("cannot remove HPA from prev select: %w", err)
 				}
 			}
+			if vmse.Ingress == nil && prevSe.Ingress != nil {
+				if err := finalize.OnVMSelectIngressDelete(ctx, rclient, commonObjMeta); err != nil {
+					return fmt.Errorf("cannot remove ingress from prev select: %w", err)
+				}
+			}
 			if ptr.Deref(vmse.DisableSelfServiceScrape, false) && !ptr.Deref(prevSe.DisableSelfServiceScrape, false) {
 				if err := finalize.SafeDeleteWithFinalizer(ctx, rclient, &vmv1beta1.VMServiceScrape{ObjectMeta: commonObjMeta}); err != nil {
 					return fmt.Errorf("cannot remove serviceScrape from prev select: %w", err)
@@ -2,6 +2,8 @@ package vmcluster
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -12,9 +14,13 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"gopkg.in/yaml.v3"
 	appsv1 "k8s.io/api/apps/v1"
+	v2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -293,6 +299,92 @@ func TestCreateOrUpdateVMCluster(t *testing.T) {
 			},
 			want: string(vmv1beta1.UpdateStatusExpanding),
 		},
+		{
+			name: "enterprise retention and vmselect cache",
+			args: args{
+				cr: &vmv1beta1.VMCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "cluster-1",
+					},
+					Spec: vmv1beta1.VMClusterSpec{
+						RetentionPeriod:     "2",
+						License:             &vmv1beta1.License{Key: ptr.To("license-key")},
+						RetentionFilters:    []string{`{team="dev"}:7d`},
+						DownsamplingPeriods: []string{"30d:5m"},
+						VMStorage: &vmv1beta1.VMStorage{
+							CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+								ReplicaCount: ptr.To(int32(1)),
+							},
+						},
+						VMSelect: &vmv1beta1.VMSelect{
+							Cache: &vmv1beta1.VMSelectCache{
+								Disabled:             true,
+								TimestampOffset:      "10m",
+								MemoryAllowedPercent: ptr.To(int32(40)),
+							},
+							CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+								ReplicaCount: ptr.To(int32(1)),
+							},
+						},
+					},
+				},
+			},
+			want: string(vmv1beta1.UpdateStatusExpanding),
+			validate: func(vminsert *appsv1.Deployment, vmselect, vmstorage *appsv1.StatefulSet) error {
+				hasArgs := func(c corev1.Container, want ...string) error {
+					for _, w := range want {
+						var found bool
+						for _, arg := range c.Args {
+							if arg == w {
+								found = true
+								break
+							}
+						}
+						if !found {
+							return fmt.Errorf("container=%q missing arg=%q, got: %v", c.Name, w, c.Args)
+						}
+					}
+					return nil
+				}
+				if err := hasArgs(vmstorage.Spec.Template.Spec.Containers[0], `-retentionFilter={team="dev"}:7d`, "-downsampling.period=30d:5m", "-license=license-key"); err != nil {
+					return err
+				}
+				return hasArgs(vmselect.Spec.Template.Spec.Containers[0], "-downsampling.period=30d:5m",
+					"-search.disableCache=true", "-search.cacheTimestampOffset=10m", "-memory.allowedPercent=40")
+			},
+		},
+		{
+			name: "vmstorage cardinality limits with default rules",
+			args: args{
+				cr: &vmv1beta1.VMCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "cluster-1",
+					},
+					Spec: vmv1beta1.VMClusterSpec{
+						RetentionPeriod: "2",
+						DefaultRules:    &vmv1beta1.DefaultRules{Enabled: true},
+						VMStorage: &vmv1beta1.VMStorage{
+							CardinalityLimits: &vmv1beta1.CardinalityLimits{MaxHourlySeries: 1000, MaxDailySeries: 5000},
+							CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+								ReplicaCount: ptr.To(int32(1)),
+							},
+						},
+					},
+				},
+			},
+			want: string(vmv1beta1.UpdateStatusExpanding),
+			validate: func(vminsert *appsv1.Deployment, vmselect, vmstorage *appsv1.StatefulSet) error {
+				args := strings.Join(vmstorage.Spec.Template.Spec.Containers[0].Args, " ")
+				for _, want := range []string{"-storage.maxHourlySeries=1000", "-storage.maxDailySeries=5000"} {
+					if !strings.Contains(args, want) {
+						return fmt.Errorf("vmstorage args missing %q, got: %s", want, args)
+					}
+				}
+				return nil
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -370,7 +462,7 @@ func TestCreateOrUpdateVMCluster(t *testing.T) {
 				})
 			}
 
-			err := CreateOrUpdateVMCluster(ctx, tt.args.cr, fclient)
+			_, err := CreateOrUpdateVMCluster(ctx, tt.args.cr, fclient)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("CreateOrUpdateVMCluster() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -398,6 +490,12 @@ func TestCreateOrUpdateVMCluster(t *testing.T) {
 					t.Fatalf("validation for cluster failed: %v", err)
 				}
 			}
+			if tt.args.cr.Spec.DefaultRules.IsEnabled() {
+				var rule vmv1beta1.VMRule
+				if err := fclient.Get(ctx, types.NamespacedName{Name: tt.args.cr.PrefixedName(), Namespace: tt.args.cr.Namespace}, &rule); err != nil {
+					t.Fatalf("cannot get default rules: %v", err)
+				}
+			}
 		})
 	}
 }
@@ -860,3 +958,330 @@ spec:
 		},
 	})
 }
+
+func TestVMClusterWorkloadType(t *testing.T) {
+	f := func(selectType, insertType vmv1beta1.WorkloadType, wantSelectKind, wantInsertKind string) {
+		t.Helper()
+		cr := &vmv1beta1.VMCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: vmv1beta1.VMClusterSpec{
+				VMSelect: &vmv1beta1.VMSelect{
+					CacheMountPath: "/cache",
+					WorkloadType:   selectType,
+					HPA:            &vmv1beta1.EmbeddedHPA{MaxReplicas: 3},
+					CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+						ReplicaCount: ptr.To(int32(2)),
+					},
+				},
+				VMInsert: &vmv1beta1.VMInsert{
+					WorkloadType: insertType,
+					HPA:          &vmv1beta1.EmbeddedHPA{MaxReplicas: 3},
+				},
+			},
+		}
+		if cr.Spec.VMSelect.IsDeployment() {
+			deploy, err := genVMSelectDeploymentSpec(cr)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(deploy.Spec.Template.Spec.Volumes) != 1 || deploy.Spec.Template.Spec.Volumes[0].EmptyDir == nil {
+				t.Fatalf("expected emptyDir cache volume, got: %v", deploy.Spec.Template.Spec.Volumes)
+			}
+			for _, arg := range deploy.Spec.Template.Spec.Containers[0].Args {
+				if strings.HasPrefix(arg, "-selectNode") {
+					t.Fatalf("unexpected -selectNode arg for Deployment: %s", arg)
+				}
+			}
+		}
+		if cr.Spec.VMInsert.IsStatefulSet() {
+			sts, err := genVMInsertStatefulSetSpec(cr)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if sts.Spec.ServiceName != "vminsert-test-headless" {
+				t.Fatalf("unexpected service name: %q", sts.Spec.ServiceName)
+			}
+		}
+
+		ctx := context.Background()
+		fclient := k8stools.GetTestClientWithObjects(nil)
+		if err := createOrUpdateVMSelectHPA(ctx, fclient, cr); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if err := createOrUpdateVMInsertHPA(ctx, fclient, cr); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		var hpa v2.HorizontalPodAutoscaler
+		if err := fclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vmselect-test"}, &hpa); err != nil {
+			t.Fatalf("cannot get vmselect hpa: %s", err)
+		}
+		if hpa.Spec.ScaleTargetRef.Kind != wantSelectKind {
+			t.Fatalf("unexpected vmselect hpa target kind, got: %q, want: %q", hpa.Spec.ScaleTargetRef.Kind, wantSelectKind)
+		}
+		if err := fclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vminsert-test"}, &hpa); err != nil {
+			t.Fatalf("cannot get vminsert hpa: %s", err)
+		}
+		if hpa.Spec.ScaleTargetRef.Kind != wantInsertKind {
+			t.Fatalf("unexpected vminsert hpa target kind, got: %q, want: %q", hpa.Spec.ScaleTargetRef.Kind, wantInsertKind)
+		}
+	}
+
+	// defaults
+	f("", "", "StatefulSet", "Deployment")
+
+	// switched workloads
+	f(vmv1beta1.WorkloadTypeDeployment, vmv1beta1.WorkloadTypeStatefulSet, "Deployment", "StatefulSet")
+}
+
+func TestVMClusterWorkloadTypeChange(t *testing.T) {
+	type opts struct {
+		prev, cr          *vmv1beta1.VMCluster
+		predefinedObjects []runtime.Object
+		// newWorkloadReady defines if workload of the new type becomes ready
+		newWorkloadReady bool
+		wantRemoved      []client.Object
+		wantExist        []client.Object
+	}
+	f := func(o opts) {
+		t.Helper()
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		var fclient client.Client = k8stools.GetTestClientWithObjects(o.predefinedObjects)
+		if o.newWorkloadReady {
+			fclient = &readyWorkloadClient{Client: fclient}
+		}
+		o.cr.ParsedLastAppliedSpec = &o.prev.Spec
+		if err := deletePrevStateResources(ctx, o.cr, fclient); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		selectErr := createOrUpdateVMSelect(ctx, o.cr, fclient)
+		insertErr := createOrUpdateVMInsert(ctx, o.cr, fclient)
+		if o.newWorkloadReady && (selectErr != nil || insertErr != nil) {
+			t.Fatalf("unexpected error, vmselect: %v, vminsert: %v", selectErr, insertErr)
+		}
+		if !o.newWorkloadReady && (selectErr == nil || insertErr == nil) {
+			t.Fatalf("expected not ready error, vmselect: %v, vminsert: %v", selectErr, insertErr)
+		}
+		getCtx := context.Background()
+		for _, obj := range o.wantRemoved {
+			if err := fclient.Get(getCtx, types.NamespacedName{Namespace: "default", Name: obj.GetName()}, obj); !errors.IsNotFound(err) {
+				t.Fatalf("expected %T %q to be removed, got: %v", obj, obj.GetName(), err)
+			}
+		}
+		for _, obj := range o.wantExist {
+			if err := fclient.Get(getCtx, types.NamespacedName{Namespace: "default", Name: obj.GetName()}, obj); err != nil {
+				t.Fatalf("expected %T %q to exist, got: %v", obj, obj.GetName(), err)
+			}
+		}
+	}
+	objMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: "default"}
+	}
+	newCR := func(selectType, insertType vmv1beta1.WorkloadType) *vmv1beta1.VMCluster {
+		return &vmv1beta1.VMCluster{
+			ObjectMeta: objMeta("test"),
+			Spec: vmv1beta1.VMClusterSpec{
+				VMSelect: &vmv1beta1.VMSelect{WorkloadType: selectType},
+				VMInsert: &vmv1beta1.VMInsert{WorkloadType: insertType},
+			},
+		}
+	}
+
+	// switch to non default workloads
+	f(opts{
+		prev: newCR("", ""),
+		cr:   newCR(vmv1beta1.WorkloadTypeDeployment, vmv1beta1.WorkloadTypeStatefulSet),
+		predefinedObjects: []runtime.Object{
+			&appsv1.StatefulSet{ObjectMeta: objMeta("vmselect-test")},
+			&appsv1.Deployment{ObjectMeta: objMeta("vminsert-test")},
+		},
+		newWorkloadReady: true,
+		wantRemoved: []client.Object{
+			&appsv1.StatefulSet{ObjectMeta: objMeta("vmselect-test")},
+			&appsv1.Deployment{ObjectMeta: objMeta("vminsert-test")},
+		},
+		wantExist: []client.Object{
+			&appsv1.Deployment{ObjectMeta: objMeta("vmselect-test")},
+			&appsv1.StatefulSet{ObjectMeta: objMeta("vminsert-test")},
+			&corev1.Service{ObjectMeta: objMeta("vminsert-test-headless")},
+		},
+	})
+
+	// switch back to default workloads
+	f(opts{
+		prev: newCR(vmv1beta1.WorkloadTypeDeployment, vmv1beta1.WorkloadTypeStatefulSet),
+		cr:   newCR("", ""),
+		predefinedObjects: []runtime.Object{
+			&appsv1.Deployment{ObjectMeta: objMeta("vmselect-test")},
+			&appsv1.StatefulSet{ObjectMeta: objMeta("vminsert-test")},
+			&corev1.Service{ObjectMeta: objMeta("vminsert-test-headless")},
+		},
+		newWorkloadReady: true,
+		wantRemoved: []client.Object{
+			&appsv1.Deployment{ObjectMeta: objMeta("vmselect-test")},
+			&appsv1.StatefulSet{ObjectMeta: objMeta("vminsert-test")},
+			&corev1.Service{ObjectMeta: objMeta("vminsert-test-headless")},
+		},
+		wantExist: []client.Object{
+			&appsv1.StatefulSet{ObjectMeta: objMeta("vmselect-test")},
+			&appsv1.Deployment{ObjectMeta: objMeta("vminsert-test")},
+		},
+	})
+
+	// workloads of previous type are kept until new workloads become ready
+	f(opts{
+		prev: newCR("", ""),
+		cr:   newCR(vmv1beta1.WorkloadTypeDeployment, vmv1beta1.WorkloadTypeStatefulSet),
+		predefinedObjects: []runtime.Object{
+			&appsv1.StatefulSet{ObjectMeta: objMeta("vmselect-test")},
+			&appsv1.Deployment{ObjectMeta: objMeta("vminsert-test")},
+		},
+		wantExist: []client.Object{
+			&appsv1.StatefulSet{ObjectMeta: objMeta("vmselect-test")},
+			&appsv1.Deployment{ObjectMeta: objMeta("vminsert-test")},
+			&appsv1.Deployment{ObjectMeta: objMeta("vmselect-test")},
+			&appsv1.StatefulSet{ObjectMeta: objMeta("vminsert-test")},
+		},
+	})
+}
+
+func TestCreateOrUpdateVMClusterHPAReplicas(t *testing.T) {
+	cr := &vmv1beta1.VMCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: vmv1beta1.VMClusterSpec{
+			VMSelect: &vmv1beta1.VMSelect{
+				HPA: &vmv1beta1.EmbeddedHPA{MaxReplicas: 10},
+				CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+					ReplicaCount: ptr.To(int32(2)),
+				},
+			},
+			VMInsert: &vmv1beta1.VMInsert{
+				WorkloadType: vmv1beta1.WorkloadTypeStatefulSet,
+				HPA:          &vmv1beta1.EmbeddedHPA{MaxReplicas: 10},
+				CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+					ReplicaCount: ptr.To(int32(2)),
+				},
+			},
+		},
+	}
+	cr.ParsedLastAppliedSpec = cr.Spec.DeepCopy()
+	// replicas of statefulsets were changed by HPA
+	scaledSts := func(name string, replicas int32) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To(replicas)},
+			Status:     appsv1.StatefulSetStatus{Replicas: replicas, ReadyReplicas: replicas, UpdatedReplicas: replicas},
+		}
+	}
+	predefinedObjects := []runtime.Object{
+		scaledSts("vmselect-test", 5),
+		scaledSts("vminsert-test", 4),
+	}
+	readyPods := func(name string, replicas int, selectorLabels map[string]string) {
+		for i := range replicas {
+			predefinedObjects = append(predefinedObjects, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-%d", name, i), Namespace: "default", Labels: selectorLabels},
+				Status:     corev1.PodStatus{Phase: corev1.PodRunning, Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: "True"}}},
+			})
+		}
+	}
+	readyPods("vmselect-test", 5, cr.VMSelectSelectorLabels())
+	readyPods("vminsert-test", 4, cr.VMInsertSelectorLabels())
+	fclient := &readyWorkloadClient{Client: k8stools.GetTestClientWithObjects(predefinedObjects)}
+	ctx := context.Background()
+	if _, err := CreateOrUpdateVMCluster(ctx, cr, fclient); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := ptr.Deref(cr.Spec.VMSelect.ReplicaCount, 0); got != 5 {
+		t.Fatalf("unexpected vmselect replicas, got: %d, want: 5", got)
+	}
+	if got := ptr.Deref(cr.Spec.VMInsert.ReplicaCount, 0); got != 4 {
+		t.Fatalf("unexpected vminsert replicas, got: %d, want: 4", got)
+	}
+	// scale subresource reports observed replicas of vmselect
+	if got := cr.Status.VMSelectReplicas; got != 5 {
+		t.Fatalf("unexpected vmselect status replicas, got: %d, want: 5", got)
+	}
+	if got, want := cr.Status.VMSelectSelector, labels.SelectorFromSet(cr.VMSelectSelectorLabels()).String(); got != want {
+		t.Fatalf("unexpected vmselect status selector, got: %q, want: %q", got, want)
+	}
+}
+
+// readyWorkloadClient marks created and updated workloads as ready, like kubernetes controllers do
+type readyWorkloadClient struct {
+	client.Client
+}
+
+func (c *readyWorkloadClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	markWorkloadReady(obj)
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func (c *readyWorkloadClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	markWorkloadReady(obj)
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+func markWorkloadReady(obj client.Object) {
+	switch w := obj.(type) {
+	case *appsv1.StatefulSet:
+		if w.Spec.Replicas != nil {
+			w.Status.ReadyReplicas = *w.Spec.Replicas
+			w.Status.UpdatedReplicas = *w.Spec.Replicas
+		}
+	case *appsv1.Deployment:
+		w.Status.Conditions = []appsv1.DeploymentCondition{{
+			Type:   appsv1.DeploymentProgressing,
+			Reason: "NewReplicaSetAvailable",
+			Status: corev1.ConditionTrue,
+		}}
+	}
+}
+
+func TestCreateOrUpdateVMSelectIngress(t *testing.T) {
+	f := func(platform string, newObj func() client.Object) {
+		t.Helper()
+		if err := k8stools.SetPlatform(platform, "", nil); err != nil {
+			t.Fatalf("cannot set platform: %s", err)
+		}
+		defer func() {
+			if err := k8stools.SetPlatform(k8stools.PlatformKubernetes, "", nil); err != nil {
+				t.Fatalf("cannot restore platform: %s", err)
+			}
+		}()
+		ctx := context.Background()
+		fclient := k8stools.GetTestClientWithObjects(nil)
+		cr := &vmv1beta1.VMCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: vmv1beta1.VMClusterSpec{
+				VMSelect: &vmv1beta1.VMSelect{
+					Ingress: &vmv1beta1.EmbeddedIngress{Host: "vmselect.example.com"},
+				},
+			},
+		}
+		if err := createOrUpdateVMSelectIngress(ctx, fclient, cr); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		nsn := types.NamespacedName{Namespace: "default", Name: "vmselect-test"}
+		if err := fclient.Get(ctx, nsn, newObj()); err != nil {
+			t.Fatalf("cannot get created object: %s", err)
+		}
+
+		// ingress is removed with spec.vmselect.ingress
+		cr.ParsedLastAppliedSpec = cr.Spec.DeepCopy()
+		cr.Spec.VMSelect.Ingress = nil
+		if err := deletePrevStateResources(ctx, cr, fclient); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if err := fclient.Get(ctx, nsn, newObj()); !errors.IsNotFound(err) {
+			t.Fatalf("expected object to be removed, got err: %v", err)
+		}
+	}
+
+	f(k8stools.PlatformKubernetes, func() client.Object { return &networkingv1.Ingress{} })
+	f(k8stools.PlatformOpenShift, func() client.Object {
+		route := &unstructured.Unstructured{}
+		route.SetGroupVersionKind(k8stools.RouteGVK)
+		return route
+	})
+}
@@ -0,0 +1,100 @@
+package vmsingle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/build"
+)
+
+// updateRestoreStatus reports state of the latest backup restore performed by init container of VMSingle pod
+func updateRestoreStatus(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMSingle) error {
+	var desired *vmv1beta1.VMSingleRestoreStatus
+	if cr.Spec.VMBackup.IsRestoreFromLatestBackup() {
+		var pods corev1.PodList
+		if err := rclient.List(ctx, &pods, client.InNamespace(cr.Namespace), client.MatchingLabels(cr.SelectorLabels())); err != nil {
+			return fmt.Errorf("cannot list vmsingle pods: %w", err)
+		}
+		desired = restoreStatusFromPods(pods.Items)
+	}
+	if equality.Semantic.DeepEqual(cr.Status.Restore, desired) {
+		return nil
+	}
+	cr.Status.Restore = desired
+	// merge patch keeps omitted fields, so all of them must be set explicitly
+	var restore interface{}
+	if desired != nil {
+		restore = map[string]interface{}{
+			"phase":          desired.Phase,
+			"message":        desired.Message,
+			"pod":            desired.Pod,
+			"startTime":      desired.StartTime,
+			"completionTime": desired.CompletionTime,
+		}
+	}
+	data, err := json.Marshal(map[string]interface{}{"status": map[string]interface{}{"restore": restore}})
+	if err != nil {
+		return fmt.Errorf("cannot marshal vmsingle status: %w", err)
+	}
+	return rclient.Status().Patch(ctx, cr, client.RawPatch(types.MergePatchType, data))
+}
+
+// restoreStatusFromPods builds restore status from init container state of the most recent pod
+func restoreStatusFromPods(pods []corev1.Pod) *vmv1beta1.VMSingleRestoreStatus {
+	var pod *corev1.Pod
+	for i := range pods {
+		p := &pods[i]
+		if !p.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if pod == nil || pod.CreationTimestamp.Before(&p.CreationTimestamp) {
+			pod = p
+		}
+	}
+	if pod == nil {
+		return &vmv1beta1.VMSingleRestoreStatus{Phase: vmv1beta1.RestorePending, Message: "waiting for vmsingle pod"}
+	}
+	st := &vmv1beta1.VMSingleRestoreStatus{Phase: vmv1beta1.RestorePending, Pod: pod.Name}
+	for _, cs := range pod.Status.InitContainerStatuses {
+		if cs.Name != build.VMRestoreLatestContainerName {
+			continue
+		}
+		switch {
+		case cs.State.Running != nil:
+			st.Phase = vmv1beta1.RestoreRunning
+			st.StartTime = &cs.State.Running.StartedAt
+		case cs.State.Terminated != nil:
+			t := cs.State.Terminated
+			st.StartTime = &t.StartedAt
+			st.CompletionTime = &t.FinishedAt
+			st.Message = strings.TrimSpace(t.Message)
+			switch {
+			case t.ExitCode != 0:
+				st.Phase = vmv1beta1.RestoreFailed
+				if st.Message == "" {
+					st.Message = fmt.Sprintf("vmrestore exited with code=%d, reason=%s", t.ExitCode, t.Reason)
+				}
+			case st.Message == build.VMRestoreSkippedMessage:
+				st.Phase = vmv1beta1.RestoreSkipped
+			default:
+				st.Phase = vmv1beta1.RestoreSucceeded
+			}
+		case cs.State.Waiting != nil:
+			st.Message = cs.State.Waiting.Reason
+			if t := cs.LastTerminationState.Terminated; t != nil && t.ExitCode != 0 {
+				// failed init container is restarted with backoff
+				st.Phase = vmv1beta1.RestoreFailed
+				st.Message = fmt.Sprintf("%s: %s", cs.State.Waiting.Reason, strings.TrimSpace(t.Message))
+			}
+		}
+	}
+	return st
+}
@@ -0,0 +1,150 @@
+package vmsingle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/build"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestRestoreStatusFromPods(t *testing.T) {
+	f := func(pods []corev1.Pod, want *vmv1beta1.VMSingleRestoreStatus) {
+		t.Helper()
+		got := restoreStatusFromPods(pods)
+		assert.Equal(t, want, got)
+	}
+	startedAt := metav1.NewTime(time.Date(2024, 10, 1, 10, 0, 0, 0, time.UTC))
+	finishedAt := metav1.NewTime(time.Date(2024, 10, 1, 11, 0, 0, 0, time.UTC))
+	pod := func(name string, created time.Time, state corev1.ContainerState, lastState corev1.ContainerState) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)},
+			Status: corev1.PodStatus{
+				InitContainerStatuses: []corev1.ContainerStatus{
+					{Name: build.VMRestoreLatestContainerName, State: state, LastTerminationState: lastState},
+				},
+			},
+		}
+	}
+
+	// no pods
+	f(nil, &vmv1beta1.VMSingleRestoreStatus{Phase: vmv1beta1.RestorePending, Message: "waiting for vmsingle pod"})
+
+	// restore in progress at the most recent pod
+	f([]corev1.Pod{
+		pod("vmsingle-old", startedAt.Add(-time.Hour), corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: build.VMRestoreSkippedMessage}}, corev1.ContainerState{}),
+		pod("vmsingle-new", startedAt.Time, corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: startedAt}}, corev1.ContainerState{}),
+	}, &vmv1beta1.VMSingleRestoreStatus{Phase: vmv1beta1.RestoreRunning, Pod: "vmsingle-new", StartTime: &startedAt})
+
+	// restore completed
+	f([]corev1.Pod{
+		pod("vmsingle", startedAt.Time, corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+			StartedAt:  startedAt,
+			FinishedAt: finishedAt,
+			Message:    "restored from s3://backups/latest\n",
+		}}, corev1.ContainerState{}),
+	}, &vmv1beta1.VMSingleRestoreStatus{
+		Phase:          vmv1beta1.RestoreSucceeded,
+		Message:        "restored from s3://backups/latest",
+		Pod:            "vmsingle",
+		StartTime:      &startedAt,
+		CompletionTime: &finishedAt,
+	})
+
+	// storage already contains data
+	f([]corev1.Pod{
+		pod("vmsingle", startedAt.Time, corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+			StartedAt:  startedAt,
+			FinishedAt: startedAt,
+			Message:    build.VMRestoreSkippedMessage + "\n",
+		}}, corev1.ContainerState{}),
+	}, &vmv1beta1.VMSingleRestoreStatus{
+		Phase:          vmv1beta1.RestoreSkipped,
+		Message:        build.VMRestoreSkippedMessage,
+		Pod:            "vmsingle",
+		StartTime:      &startedAt,
+		CompletionTime: &startedAt,
+	})
+
+	// failed restore is restarted with backoff
+	f([]corev1.Pod{
+		pod("vmsingle", startedAt.Time,
+			corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Message: "cannot find backup"}}),
+	}, &vmv1beta1.VMSingleRestoreStatus{
+		Phase:   vmv1beta1.RestoreFailed,
+		Message: "CrashLoopBackOff: cannot find backup",
+		Pod:     "vmsingle",
+	})
+}
+
+func TestCreateOrUpdateVMSingleWithRestore(t *testing.T) {
+	startedAt := metav1.NewTime(time.Date(2024, 10, 1, 10, 0, 0, 0, time.UTC))
+	cr := &vmv1beta1.VMSingle{
+		ObjectMeta: metav1.ObjectMeta{Name: "vmsingle-base", Namespace: "default"},
+		Spec: vmv1beta1.VMSingleSpec{
+			CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{ReplicaCount: ptr.To(int32(1))},
+			VMBackup: &vmv1beta1.VMBackup{
+				AcceptEULA:  true,
+				Destination: "s3://backups/vmsingle/",
+				Image:       vmv1beta1.Image{Repository: "victoriametrics/vmbackupmanager", Tag: "v1.103.0-enterprise"},
+				Restore: &vmv1beta1.VMRestore{OnStart: &vmv1beta1.VMRestoreOnStartConfig{
+					FromLatestBackup: true,
+					Image:            vmv1beta1.Image{Repository: "victoriametrics/vmrestore", Tag: "v1.103.0-enterprise"},
+				}},
+			},
+		},
+	}
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{
+		cr,
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "vmsingle-0", Labels: cr.SelectorLabels()},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				InitContainerStatuses: []corev1.ContainerStatus{
+					{Name: build.VMRestoreLatestContainerName, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: startedAt}}},
+				},
+			},
+		},
+		k8stools.NewReadyDeployment("vmsingle-vmsingle-base", "default"),
+	})
+	ctx := context.Background()
+	if err := CreateOrUpdateVMSingle(ctx, cr, fclient); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var dep appsv1.Deployment
+	if err := fclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vmsingle-vmsingle-base"}, &dep); err != nil {
+		t.Fatalf("cannot get deployment: %s", err)
+	}
+	initContainers := dep.Spec.Template.Spec.InitContainers
+	if len(initContainers) != 1 || initContainers[0].Name != build.VMRestoreLatestContainerName {
+		t.Fatalf("expected vmrestore init container, got: %v", initContainers)
+	}
+	assert.Equal(t, "victoriametrics/vmrestore:v1.103.0-enterprise", initContainers[0].Image)
+	assert.Equal(t, []string{`if [ -d /victoria-metrics-data/data ]; then
+  echo "storage data path already contains data, restore is skipped" > /dev/termination-log
+  exit 0
+fi
+/vmrestore-prod '-eula' '-src=s3://backups/vmsingle/latest' '-storageDataPath=/victoria-metrics-data' && echo "restored from s3://backups/vmsingle/latest" > /dev/termination-log`}, initContainers[0].Args)
+
+	var got vmv1beta1.VMSingle
+	if err := fclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vmsingle-base"}, &got); err != nil {
+		t.Fatalf("cannot get vmsingle: %s", err)
+	}
+	if got.Status.Restore == nil {
+		t.Fatalf("expected restore status to be set")
+	}
+	assert.Equal(t, vmv1beta1.RestoreRunning, got.Status.Restore.Phase)
+	assert.Equal(t, "vmsingle-0", got.Status.Restore.Pod)
+	assert.True(t, startedAt.Equal(got.Status.Restore.StartTime))
+}
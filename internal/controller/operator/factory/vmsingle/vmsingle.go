@@ -9,10 +9,13 @@ import (
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/build"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/defaultrules"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/grafana"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/tracing"
 	"gopkg.in/yaml.v2"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -44,7 +47,7 @@ func makeVMSinglePvc(cr *vmv1beta1.VMSingle) *corev1.PersistentVolumeClaim {
 			Namespace:       cr.Namespace,
 			Labels:          labels.Merge(cr.Spec.StorageMetadata.Labels, cr.SelectorLabels()),
 			Annotations:     cr.Spec.StorageMetadata.Annotations,
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 			OwnerReferences: cr.AsOwner(),
 		},
 		Spec: *cr.Spec.Storage,
@@ -80,11 +83,23 @@ func CreateOrUpdateVMSingle(ctx context.Context, cr *vmv1beta1.VMSingle, rclient
 	}
 
 	if !ptr.Deref(cr.Spec.DisableSelfServiceScrape, false) {
-		err := reconcile.VMServiceScrapeForCRD(ctx, rclient, build.VMServiceScrapeForServiceWithSpec(svc, cr))
+		svs := build.VMServiceScrapeForServiceWithSpec(svc, cr, "http")
+		build.AddVMBackupEndpoint(svs, cr.Spec.VMBackup)
+		err := reconcile.VMServiceScrapeForCRD(ctx, rclient, svs)
 		if err != nil {
 			return fmt.Errorf("cannot create serviceScrape for vmsingle: %w", err)
 		}
 	}
+	var prevDefaultRules *vmv1beta1.DefaultRules
+	if cr.ParsedLastAppliedSpec != nil {
+		prevDefaultRules = cr.ParsedLastAppliedSpec.DefaultRules
+	}
+	if err := defaultrules.CreateOrUpdate(ctx, rclient, cr.Spec.DefaultRules, prevDefaultRules, defaultrules.ForVMSingle(cr)); err != nil {
+		return fmt.Errorf("cannot create or update default rules for vmsingle: %w", err)
+	}
+	if err := grafana.CreateOrUpdate(ctx, rclient, buildGrafanaDatasource(cr)); err != nil {
+		return fmt.Errorf("cannot create grafana datasource for vmsingle: %w", err)
+	}
 	var prevDeploy *appsv1.Deployment
 	if cr.ParsedLastAppliedSpec != nil {
 		prevCR := cr.DeepCopy()
@@ -93,13 +108,29 @@ func CreateOrUpdateVMSingle(ctx context.Context, cr *vmv1beta1.VMSingle, rclient
 		if err != nil {
 			return fmt.Errorf("cannot generate prev deploy spec: %w", err)
 		}
+		if err := build.ApplyOverridePatches(prevCR.Spec.OverridePatches, prevDeploy); err != nil {
+			return fmt.Errorf("cannot apply override patches to prev object: %w", err)
+		}
 	}
-	newDeploy, err := newDeployForVMSingle(ctx, cr)
+	newDeploy, err := tracing.Build(ctx, "vmsingle", func() (*appsv1.Deployment, error) {
+		return newDeployForVMSingle(ctx, cr)
+	})
 	if err != nil {
 		return fmt.Errorf("cannot generate new deploy for vmsingle: %w", err)
 	}
+	if err := build.AddLicenseChecksumAnnotation(ctx, rclient, cr.Namespace, cr.Spec.License, newDeploy); err != nil {
+		return err
+	}
+	if err := build.ApplyOverridePatches(cr.Spec.OverridePatches, newDeploy); err != nil {
+		return err
+	}
 
-	return reconcile.Deployment(ctx, rclient, newDeploy, prevDeploy, false)
+	err = reconcile.Deployment(ctx, rclient, newDeploy, prevDeploy, false)
+	// restore may block deployment rollout for a long time, so its status is reported regardless of rollout error
+	if serr := updateRestoreStatus(ctx, rclient, cr); serr != nil {
+		return fmt.Errorf("cannot update restore status: %w", serr)
+	}
+	return err
 }
 
 func newDeployForVMSingle(ctx context.Context, cr *vmv1beta1.VMSingle) (*appsv1.Deployment, error) {
@@ -116,7 +147,7 @@ func newDeployForVMSingle(ctx context.Context, cr *vmv1beta1.VMSingle) (*appsv1.
 			Labels:          cr.AllLabels(),
 			Annotations:     cr.AnnotationsFiltered(),
 			OwnerReferences: cr.AsOwner(),
-			Finalizers:      []string{vmv1beta1.FinalizerName},
+			Finalizers:      finalize.ChildFinalizers(),
 		},
 		Spec: appsv1.DeploymentSpec{
 			Selector: &metav1.LabelSelector{
@@ -283,6 +314,7 @@ func makeSpecForVMSingle(ctx context.Context, cr *vmv1beta1.VMSingle) (*corev1.P
 	volumes, vmMounts = cr.Spec.License.MaybeAddToVolumes(volumes, vmMounts, vmv1beta1.SecretsDir)
 	args = cr.Spec.License.MaybeAddToArgs(args, vmv1beta1.SecretsDir)
 
+	args = build.AddVersionedArgs("vmsingle", cr.Spec.Image.Tag, args, "-")
 	args = build.AddExtraArgsOverrideDefaults(args, cr.Spec.ExtraArgs, "-")
 	sort.Strings(args)
 	vmsingleContainer := corev1.Container{
@@ -310,6 +342,9 @@ func makeSpecForVMSingle(ctx context.Context, cr *vmv1beta1.VMSingle) (*corev1.P
 		if vmBackupManagerContainer != nil {
 			operatorContainers = append(operatorContainers, *vmBackupManagerContainer)
 		}
+		if cr.Spec.VMBackup.IsRestoreFromLatestBackup() {
+			initContainers = append(initContainers, *build.VMRestoreFromLatestBackup(cr.Spec.VMBackup, storagePath, vmDataVolumeName))
+		}
 		if cr.Spec.VMBackup.Restore != nil &&
 			cr.Spec.VMBackup.Restore.OnStart != nil &&
 			cr.Spec.VMBackup.Restore.OnStart.Enabled {
@@ -461,3 +496,18 @@ func deletePrevStateResources(ctx context.Context, cr *vmv1beta1.VMSingle, rclie
 
 	return nil
 }
+
+func buildGrafanaDatasource(cr *vmv1beta1.VMSingle) *grafana.Datasource {
+	return &grafana.Datasource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            cr.PrefixedName(),
+			Namespace:       cr.Namespace,
+			Labels:          cr.AllLabels(),
+			Annotations:     cr.AnnotationsFiltered(),
+			OwnerReferences: cr.AsOwner(),
+		},
+		DisplayName: fmt.Sprintf("vmsingle/%s/%s", cr.Namespace, cr.Name),
+		URL:         cr.AsURL(),
+		DashboardID: grafana.DashboardVMSingle,
+	}
+}
@@ -12,6 +12,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 )
 
@@ -209,3 +210,44 @@ func TestCreateOrUpdateVMSingleService(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateOrUpdateVMSingleWithOverridePatches(t *testing.T) {
+	cr := &vmv1beta1.VMSingle{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vmsingle-patched",
+			Namespace: "default",
+		},
+		Spec: vmv1beta1.VMSingleSpec{
+			CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+				ReplicaCount: ptr.To(int32(1)),
+				OverridePatches: []vmv1beta1.OverridePatch{{Kind: "Deployment", Patch: `
+spec:
+  minReadySeconds: 15
+`}},
+			},
+		},
+	}
+	cr.ParsedLastAppliedSpec = cr.Spec.DeepCopy()
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{k8stools.NewReadyDeployment("vmsingle-vmsingle-patched", "default")})
+	ctx := context.TODO()
+	if err := CreateOrUpdateVMSingle(ctx, cr, fclient); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var got appsv1.Deployment
+	if err := fclient.Get(ctx, types.NamespacedName{Name: "vmsingle-vmsingle-patched", Namespace: "default"}, &got); err != nil {
+		t.Fatalf("cannot get deployment: %s", err)
+	}
+	if got.Spec.MinReadySeconds != 15 {
+		t.Fatalf("override patch wasn't applied, got minReadySeconds=%d", got.Spec.MinReadySeconds)
+	}
+
+	// unchanged spec with patches must not trigger update
+	clientStats := fclient.(*k8stools.TestClientWithStatsTrack)
+	updateCalls := clientStats.UpdateCalls.Load()
+	if err := CreateOrUpdateVMSingle(ctx, cr, fclient); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls := clientStats.UpdateCalls.Load() - updateCalls; calls != 0 {
+		t.Fatalf("unexpected update calls for unchanged spec: %d", calls)
+	}
+}
@@ -0,0 +1,222 @@
+package operator
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var (
+	namespaceQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "operator_controller_namespace_queue_depth",
+		Help: "Number of queued reconcile requests per namespace. It's reported only with -controller.namespaceFairness",
+	}, []string{"controller", "namespace"})
+	namespaceReconcilesInflight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "operator_controller_namespace_reconciles_inflight",
+		Help: "Number of reconciles in progress per namespace. It's reported only with -controller.namespaceFairness",
+	}, []string{"controller", "namespace"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(namespaceQueueDepth, namespaceReconcilesInflight)
+}
+
+// newNamespaceFairQueue returns rate limiting queue for controller,
+// which hands out reconcile requests in round-robin order by namespace
+func newNamespaceFairQueue(controllerName string, rl ratelimiter.RateLimiter) workqueue.RateLimitingInterface {
+	return workqueue.NewRateLimitingQueueWithConfig(rl, workqueue.RateLimitingQueueConfig{
+		Name: controllerName,
+		DelayingQueue: workqueue.NewDelayingQueueWithConfig(workqueue.DelayingQueueConfig{
+			Name:  controllerName,
+			Queue: newFairQueue(controllerName, *maxConcurrencyPerNamespace),
+		}),
+	})
+}
+
+// fairQueue implements workqueue.Interface with per-namespace fairness.
+// Queued items are grouped by namespace and namespaces are served in round-robin order,
+// so namespace with many changing objects cannot delay reconciliation of objects from other namespaces.
+// It keeps workqueue.Type guarantees: the same item is never processed concurrently
+// and item added during processing is queued again after Done.
+type fairQueue struct {
+	controller string
+	// maxInflight limits concurrent processing of items from the same namespace, 0 means no limit
+	maxInflight int
+
+	cond *sync.Cond
+	// queues holds pending items by namespace
+	queues map[string][]interface{}
+	// order holds namespaces with pending items in the serving order
+	order      []string
+	queued     int
+	dirty      map[interface{}]struct{}
+	processing map[interface{}]struct{}
+	inflight   map[string]int
+
+	shuttingDown bool
+	drain        bool
+}
+
+func newFairQueue(controller string, maxInflight int) *fairQueue {
+	return &fairQueue{
+		controller:  controller,
+		maxInflight: maxInflight,
+		cond:        sync.NewCond(&sync.Mutex{}),
+		queues:      make(map[string][]interface{}),
+		dirty:       make(map[interface{}]struct{}),
+		processing:  make(map[interface{}]struct{}),
+		inflight:    make(map[string]int),
+	}
+}
+
+func itemNamespace(item interface{}) string {
+	if req, ok := item.(reconcile.Request); ok {
+		return req.Namespace
+	}
+	return ""
+}
+
+// Add implements workqueue.Interface
+func (q *fairQueue) Add(item interface{}) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	if q.shuttingDown {
+		return
+	}
+	if _, ok := q.dirty[item]; ok {
+		return
+	}
+	q.dirty[item] = struct{}{}
+	if _, ok := q.processing[item]; ok {
+		// item will be queued again after Done
+		return
+	}
+	q.push(item)
+	q.cond.Signal()
+}
+
+// Len implements workqueue.Interface
+func (q *fairQueue) Len() int {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	return q.queued
+}
+
+// Get implements workqueue.Interface
+// It blocks until an item from namespace below inflight limit is available
+func (q *fairQueue) Get() (interface{}, bool) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	for {
+		if item, ok := q.pop(); ok {
+			delete(q.dirty, item)
+			q.processing[item] = struct{}{}
+			ns := itemNamespace(item)
+			q.inflight[ns]++
+			namespaceReconcilesInflight.WithLabelValues(q.controller, ns).Set(float64(q.inflight[ns]))
+			return item, false
+		}
+		if q.shuttingDown {
+			return nil, true
+		}
+		q.cond.Wait()
+	}
+}
+
+// Done implements workqueue.Interface
+func (q *fairQueue) Done(item interface{}) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	delete(q.processing, item)
+	ns := itemNamespace(item)
+	q.inflight[ns]--
+	if q.inflight[ns] <= 0 {
+		delete(q.inflight, ns)
+	}
+	namespaceReconcilesInflight.WithLabelValues(q.controller, ns).Set(float64(q.inflight[ns]))
+	if _, ok := q.dirty[item]; ok {
+		q.push(item)
+	}
+	q.cleanupMetrics(ns)
+	// wake up workers waiting for namespace inflight limit and drain
+	q.cond.Broadcast()
+}
+
+// ShutDown implements workqueue.Interface
+func (q *fairQueue) ShutDown() {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	q.drain = false
+	q.shuttingDown = true
+	q.cond.Broadcast()
+}
+
+// ShutDownWithDrain implements workqueue.Interface
+// It waits until all items in processing are done
+func (q *fairQueue) ShutDownWithDrain() {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	q.drain = true
+	q.shuttingDown = true
+	q.cond.Broadcast()
+	for len(q.processing) > 0 && q.drain {
+		q.cond.Wait()
+	}
+}
+
+// ShuttingDown implements workqueue.Interface
+func (q *fairQueue) ShuttingDown() bool {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	return q.shuttingDown
+}
+
+func (q *fairQueue) push(item interface{}) {
+	ns := itemNamespace(item)
+	if len(q.queues[ns]) == 0 {
+		q.order = append(q.order, ns)
+	}
+	q.queues[ns] = append(q.queues[ns], item)
+	q.queued++
+	namespaceQueueDepth.WithLabelValues(q.controller, ns).Set(float64(len(q.queues[ns])))
+}
+
+// pop returns the first item of the next namespace in order, which is below inflight limit
+func (q *fairQueue) pop() (interface{}, bool) {
+	for i := 0; i < len(q.order); i++ {
+		ns := q.order[0]
+		q.order = q.order[1:]
+		if q.maxInflight > 0 && q.inflight[ns] >= q.maxInflight {
+			// namespace keeps its pending items and waits for the next round
+			q.order = append(q.order, ns)
+			continue
+		}
+		items := q.queues[ns]
+		item := items[0]
+		items[0] = nil
+		items = items[1:]
+		if len(items) > 0 {
+			q.queues[ns] = items
+			q.order = append(q.order, ns)
+		} else {
+			delete(q.queues, ns)
+		}
+		q.queued--
+		namespaceQueueDepth.WithLabelValues(q.controller, ns).Set(float64(len(items)))
+		return item, true
+	}
+	return nil, false
+}
+
+// cleanupMetrics removes metrics of idle namespace
+func (q *fairQueue) cleanupMetrics(ns string) {
+	if len(q.queues[ns]) > 0 || q.inflight[ns] > 0 {
+		return
+	}
+	namespaceQueueDepth.DeleteLabelValues(q.controller, ns)
+	namespaceReconcilesInflight.DeleteLabelValues(q.controller, ns)
+}
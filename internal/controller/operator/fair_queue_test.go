@@ -0,0 +1,97 @@
+package operator
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func newRequest(ns, name string) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: ns, Name: name}}
+}
+
+func TestFairQueueOrder(t *testing.T) {
+	q := newFairQueue("test", 0)
+	// namespace with many objects is queued first
+	for _, name := range []string{"a", "b", "c", "d"} {
+		q.Add(newRequest("busy", name))
+	}
+	q.Add(newRequest("quiet-1", "a"))
+	q.Add(newRequest("quiet-2", "a"))
+	// duplicates are ignored
+	q.Add(newRequest("busy", "a"))
+	if q.Len() != 6 {
+		t.Fatalf("unexpected queue len, got: %d, want: 6", q.Len())
+	}
+
+	want := []reconcile.Request{
+		newRequest("busy", "a"),
+		newRequest("quiet-1", "a"),
+		newRequest("quiet-2", "a"),
+		newRequest("busy", "b"),
+		newRequest("busy", "c"),
+		newRequest("busy", "d"),
+	}
+	for i, w := range want {
+		item, shutdown := q.Get()
+		if shutdown {
+			t.Fatalf("unexpected shutdown")
+		}
+		if item != w {
+			t.Fatalf("unexpected item at position=%d, got: %v, want: %v", i, item, w)
+		}
+		q.Done(item)
+	}
+	if q.Len() != 0 {
+		t.Fatalf("queue must be empty, got len: %d", q.Len())
+	}
+}
+
+func TestFairQueueProcessing(t *testing.T) {
+	q := newFairQueue("test", 1)
+	q.Add(newRequest("busy", "a"))
+	q.Add(newRequest("busy", "b"))
+	q.Add(newRequest("quiet", "a"))
+
+	first, _ := q.Get()
+	if first != newRequest("busy", "a") {
+		t.Fatalf("unexpected first item: %v", first)
+	}
+	// item added during processing is queued after Done
+	q.Add(first)
+	// busy namespace reached inflight limit
+	second, _ := q.Get()
+	if second != newRequest("quiet", "a") {
+		t.Fatalf("unexpected second item: %v", second)
+	}
+	q.Done(second)
+
+	got := make(chan interface{})
+	go func() {
+		item, _ := q.Get()
+		got <- item
+	}()
+	select {
+	case item := <-got:
+		t.Fatalf("item=%v must not be served before busy namespace is below inflight limit", item)
+	case <-time.After(50 * time.Millisecond):
+	}
+	q.Done(first)
+	third := <-got
+	if third != newRequest("busy", "b") {
+		t.Fatalf("unexpected third item: %v", third)
+	}
+	q.Done(third)
+	// re-added item is served after the pending one
+	if item, _ := q.Get(); item != first {
+		t.Fatalf("unexpected re-added item: %v", item)
+	}
+
+	q.ShutDown()
+	q.Add(newRequest("quiet", "b"))
+	if _, shutdown := q.Get(); !shutdown {
+		t.Fatalf("expected shutdown")
+	}
+}
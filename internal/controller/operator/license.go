@@ -0,0 +1,41 @@
+package operator
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+)
+
+var licenseExpiresAt = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "vm_license_expires_at",
+	Help: "Unix timestamp of license key expiration defined at spec.license.expiresAt of object",
+}, []string{"controller", "namespace", "name"})
+
+func init() {
+	metrics.Registry.MustRegister(licenseExpiresAt)
+}
+
+// checkLicenseExpiration exposes expiration time of object license at vm_license_expires_at metric
+// and emits warning events if license is expired or expires within VM_LICENSEEXPIRATIONWARNINGPERIOD
+func checkLicenseExpiration(ctx context.Context, object client.Object, controller string, l *vmv1beta1.License) {
+	if !object.GetDeletionTimestamp().IsZero() || l == nil || l.ExpiresAt == nil {
+		licenseExpiresAt.DeleteLabelValues(controller, object.GetNamespace(), object.GetName())
+		return
+	}
+	expiresAt := l.ExpiresAt.UTC()
+	licenseExpiresAt.WithLabelValues(controller, object.GetNamespace(), object.GetName()).Set(float64(expiresAt.Unix()))
+	untilExpiration := time.Until(expiresAt)
+	switch {
+	case untilExpiration <= 0:
+		events.Warning(ctx, events.ReasonLicenseExpired, "license key expired at %s, enterprise features are not available", expiresAt.Format(time.RFC3339))
+	case untilExpiration <= config.MustGetBaseConfig().LicenseExpirationWarningPeriod:
+		events.Warning(ctx, events.ReasonLicenseExpiresSoon, "license key expires at %s, renew it in order to keep enterprise features available", expiresAt.Format(time.RFC3339))
+	}
+}
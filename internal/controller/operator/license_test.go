@@ -0,0 +1,72 @@
+package operator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+)
+
+func TestCheckLicenseExpiration(t *testing.T) {
+	defer events.Init(nil)
+	f := func(expiresIn *time.Duration, deleted bool, wantEvent string) {
+		t.Helper()
+		recorder := record.NewFakeRecorder(10)
+		events.Init(recorder)
+		cr := &vmv1beta1.VMSingle{
+			ObjectMeta: metav1.ObjectMeta{Name: "single", Namespace: "default", UID: "single-uid"},
+			Spec: vmv1beta1.VMSingleSpec{
+				License: &vmv1beta1.License{},
+			},
+		}
+		var expiresAt time.Time
+		if expiresIn != nil {
+			expiresAt = time.Now().Add(*expiresIn).Truncate(time.Second)
+			cr.Spec.License.ExpiresAt = &metav1.Time{Time: expiresAt}
+		}
+		if deleted {
+			cr.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		}
+		checkLicenseExpiration(events.AddToContext(context.Background(), cr), cr, "vmsingle", cr.Spec.License)
+		if expiresIn == nil || deleted {
+			assert.Equal(t, 0, testutil.CollectAndCount(licenseExpiresAt))
+		} else {
+			assert.Equal(t, float64(expiresAt.Unix()), testutil.ToFloat64(licenseExpiresAt.WithLabelValues("vmsingle", "default", "single")))
+		}
+		var gotEvent string
+		select {
+		case gotEvent = <-recorder.Events:
+		default:
+		}
+		if wantEvent == "" {
+			assert.Empty(t, gotEvent)
+			return
+		}
+		assert.Contains(t, gotEvent, wantEvent)
+	}
+	ptrDuration := func(d time.Duration) *time.Duration {
+		return &d
+	}
+
+	// expiration time isn't set
+	f(nil, false, "")
+
+	// license is valid
+	f(ptrDuration(365*24*time.Hour), false, "")
+
+	// license expires soon
+	f(ptrDuration(7*24*time.Hour), false, "Warning LicenseExpiresSoon license key expires at")
+
+	// license expired
+	f(ptrDuration(-time.Hour), false, "Warning LicenseExpired license key expired at")
+
+	// metric is removed for deleted object
+	f(ptrDuration(time.Hour), true, "")
+}
@@ -0,0 +1,107 @@
+package operator
+
+import (
+	"context"
+
+	"github.com/VictoriaMetrics/operator/internal/config"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var namespaceReader client.Reader
+
+// InitWatchNamespaceSelector configures reader for namespaces matched by -watchNamespaceSelector
+// reader must be backed by manager cache, since namespace is checked on each reconcile
+func InitWatchNamespaceSelector(reader client.Reader) {
+	namespaceReader = reader
+}
+
+// isNamespaceWatched checks if namespace labels match -watchNamespaceSelector
+func isNamespaceWatched(ctx context.Context, namespace string) bool {
+	selector := config.GetWatchNamespaceSelector()
+	if selector == nil || namespace == "" {
+		return true
+	}
+	if namespaceReader == nil {
+		return false
+	}
+	var ns corev1.Namespace
+	if err := namespaceReader.Get(ctx, types.NamespacedName{Name: namespace}, &ns); err != nil {
+		log.Error(err, "cannot get namespace for watch namespace selector, skipping reconcile", "namespace", namespace)
+		return false
+	}
+	return selector.Matches(labels.Set(ns.Labels))
+}
+
+// namespaceLabelsChanged passes created namespaces and namespaces with updated labels
+var namespaceLabelsChanged = predicate.Funcs{
+	CreateFunc: func(event.CreateEvent) bool { return true },
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return !labels.Equals(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+	},
+	DeleteFunc:  func(event.DeleteEvent) bool { return false },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
+
+// watchSelectedNamespaces enqueues objects of the given list type from namespaces with changed labels
+// it allows to reconcile objects from namespaces labeled at runtime to match -watchNamespaceSelector.
+// Parents of the given indexes are enqueued as well, since child objects could be selected from namespace, which started or stopped matching selector
+func watchSelectedNamespaces[T any, PT interface {
+	*T
+	client.ObjectList
+}](b *builder.Builder, rclient client.Client, parents ...*parentIndex) *builder.Builder {
+	if config.GetWatchNamespaceSelector() == nil {
+		return b
+	}
+	return b.Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		return namespaceRequests[T, PT](ctx, rclient, obj.GetName(), parents...)
+	}), builder.WithPredicates(namespaceLabelsChanged))
+}
+
+// namespaceRequests returns objects of watched namespace and parents, which select child objects outside of own namespace
+func namespaceRequests[T any, PT interface {
+	*T
+	client.ObjectList
+}](ctx context.Context, rclient client.Client, namespace string, parents ...*parentIndex) []reconcile.Request {
+	var requests []reconcile.Request
+	for _, pi := range parents {
+		for _, parent := range pi.crossNamespaceParents() {
+			requests = append(requests, reconcile.Request{NamespacedName: parent})
+		}
+	}
+	if isNamespaceWatched(ctx, namespace) {
+		requests = append(requests, objectsAtNamespace[T, PT](ctx, rclient, namespace)...)
+	}
+	return requests
+}
+
+func objectsAtNamespace[T any, PT interface {
+	*T
+	client.ObjectList
+}](ctx context.Context, rclient client.Client, namespace string) []reconcile.Request {
+	objects := PT(new(T))
+	if err := rclient.List(ctx, objects, client.InNamespace(namespace)); err != nil {
+		log.Error(err, "cannot list objects for watched namespace", "namespace", namespace)
+		return nil
+	}
+	var requests []reconcile.Request
+	if err := meta.EachListItem(objects, func(o runtime.Object) error {
+		if item, ok := o.(client.Object); ok {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: item.GetName(), Namespace: item.GetNamespace()}})
+		}
+		return nil
+	}); err != nil {
+		log.Error(err, "cannot iterate objects for watched namespace", "namespace", namespace)
+		return nil
+	}
+	return requests
+}
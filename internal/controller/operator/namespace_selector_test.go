@@ -0,0 +1,93 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestIsNamespaceWatched(t *testing.T) {
+	defer func() {
+		assert.NoError(t, config.SetWatchNamespaceSelector(""))
+		InitWatchNamespaceSelector(nil)
+	}()
+	InitWatchNamespaceSelector(k8stools.GetTestClientWithObjects([]runtime.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "observability", Labels: map[string]string{"team": "observability"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+	}))
+	f := func(selector, namespace string, want bool) {
+		t.Helper()
+		assert.NoError(t, config.SetWatchNamespaceSelector(selector))
+		ctx := context.Background()
+		assert.Equal(t, want, isNamespaceWatched(ctx, namespace))
+		assert.Equal(t, want, isNamespaceOwned(ctx, namespace))
+	}
+
+	// selector is not set
+	f("", "default", true)
+
+	// matched namespace
+	f("team=observability", "observability", true)
+
+	// not matched namespace
+	f("team=observability", "default", false)
+
+	// missing namespace
+	f("team=observability", "missing", false)
+}
+
+func TestObjectsAtNamespace(t *testing.T) {
+	rclient := k8stools.GetTestClientWithObjects([]runtime.Object{
+		&vmv1beta1.VMSingle{ObjectMeta: metav1.ObjectMeta{Name: "single", Namespace: "observability"}},
+		&vmv1beta1.VMSingle{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}},
+	})
+	got := objectsAtNamespace[vmv1beta1.VMSingleList](context.Background(), rclient, "observability")
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "single", Namespace: "observability"}}}, got)
+}
+
+func TestNamespaceRequests(t *testing.T) {
+	defer func() {
+		assert.NoError(t, config.SetWatchNamespaceSelector(""))
+		InitWatchNamespaceSelector(nil)
+	}()
+	assert.NoError(t, config.SetWatchNamespaceSelector("team=observability"))
+	rclient := k8stools.GetTestClientWithObjects([]runtime.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "observability", Labels: map[string]string{"team": "observability"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		&vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "observability"}},
+		&vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}},
+	})
+	InitWatchNamespaceSelector(rclient)
+	pi := newParentIndex()
+	pi.set(types.NamespacedName{Namespace: "observability", Name: "all"}, vmAgentChildSelectors(&vmv1beta1.VMAgent{
+		Spec: vmv1beta1.VMAgentSpec{SelectAllByDefault: true},
+	}))
+	pi.set(types.NamespacedName{Namespace: "observability", Name: "same-namespace"}, vmAgentChildSelectors(&vmv1beta1.VMAgent{
+		Spec: vmv1beta1.VMAgentSpec{ServiceScrapeSelector: &metav1.LabelSelector{}},
+	}))
+	f := func(namespace string, want []reconcile.Request) {
+		t.Helper()
+		got := namespaceRequests[vmv1beta1.VMAgentList](context.Background(), rclient, namespace, pi)
+		assert.Equal(t, want, got)
+	}
+
+	// namespace matches selector
+	f("observability", []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "observability", Name: "all"}},
+		{NamespacedName: types.NamespacedName{Namespace: "observability", Name: "agent"}},
+	})
+
+	// namespace stopped matching selector
+	f("default", []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "observability", Name: "all"}},
+	})
+}
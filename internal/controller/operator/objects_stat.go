@@ -41,13 +41,23 @@ func (oc *objectCollector) countByController(controller string) float64 {
 	return float64(len(objects))
 }
 
+func (oc *objectCollector) count() int {
+	oc.mu.Lock()
+	defer oc.mu.Unlock()
+	var total int
+	for _, objects := range oc.objectsByController {
+		total += len(objects)
+	}
+	return total
+}
+
 func newCollector() *objectCollector {
 	oc := &objectCollector{
 		objectsByController: map[string]map[string]struct{}{},
 	}
 	registeredObjects := []string{
 		"vmagent", "vmalert", "vmsingle", "vmcluster", "vmalertmanager", "vmauth", "vlogs",
-		"vmalertmanagerconfig", "vmrule", "vmuser", "vmservicescrape", "vmstaticscrape", "vmnodescrape", "vmpodscrape", "vmprobescrape", "vmscrapeconfig",
+		"vmalertmanagerconfig", "vmalertmanagertemplate", "vmrule", "vmruletest", "vmmaintenancetask", "vmdatamigration", "vmuser", "vmservicescrape", "vmstaticscrape", "vmnodescrape", "vmpodscrape", "vmprobescrape", "vmscrapeconfig", "vmscrapeglobalconfig",
 	}
 	for _, controller := range registeredObjects {
 		oc.objectsByController[controller] = map[string]struct{}{}
@@ -95,3 +105,11 @@ func RegisterObjectStat(obj client.Object, controller string) {
 	}
 	deregisterObjectByCollector(obj.GetName(), obj.GetNamespace(), controller)
 }
+
+// ManagedObjectsCount returns number of CR objects registered by all controllers
+func ManagedObjectsCount() int {
+	initCollector.Do(func() {
+		collector = newCollector()
+	})
+	return collector.count()
+}
@@ -0,0 +1,95 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// deferredError occurs if object spec has changes outside of maintenance windows
+// such changes are applied at the start of the next window
+type deferredError struct {
+	nextWindow time.Time
+}
+
+func (de *deferredError) Error() string {
+	return fmt.Sprintf("spec changes are deferred until the next maintenance window at %s", de.nextWindow.UTC().Format(time.RFC3339))
+}
+
+// +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmoperatorsettings,verbs=get;list;watch
+
+// getOperatorSettings returns VMOperatorSettings applied to objects at the given namespace.
+// If namespace has multiple settings, the first one ordered by name is used.
+// It returns nil if namespace has no settings
+func getOperatorSettings(ctx context.Context, rclient client.Client, namespace string) (*vmv1beta1.VMOperatorSettings, error) {
+	var l vmv1beta1.VMOperatorSettingsList
+	if err := rclient.List(ctx, &l, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("cannot list VMOperatorSettings at namespace=%q: %w", namespace, err)
+	}
+	var settings *vmv1beta1.VMOperatorSettings
+	for i := range l.Items {
+		item := &l.Items[i]
+		if item.Spec.ParsingError != "" || !item.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if settings == nil || item.Name < settings.Name {
+			settings = item
+		}
+	}
+	return settings, nil
+}
+
+// applyOperatorSettings merges VMOperatorSettings of object namespace into the given object.
+// Fields defined at object have priority over settings.
+// It must be called before object defaulting, since global defaults have the lowest priority
+func applyOperatorSettings(ctx context.Context, rclient client.Client, obj client.Object) error {
+	settings, err := getOperatorSettings(ctx, rclient, obj.GetNamespace())
+	if err != nil {
+		return err
+	}
+	if settings == nil {
+		vmv1beta1.SetNamespacePropagationPolicy(obj.GetNamespace(), nil)
+		return nil
+	}
+	vmv1beta1.SetNamespacePropagationPolicy(obj.GetNamespace(), settings.Spec.Propagation)
+
+	var reloader *vmv1beta1.CommonConfigReloaderParams
+	switch cr := obj.(type) {
+	case *vmv1beta1.VMAgent:
+		reloader = &cr.Spec.CommonConfigReloaderParams
+	case *vmv1beta1.VMAlert:
+		reloader = &cr.Spec.CommonConfigReloaderParams
+	case *vmv1beta1.VMAlertmanager:
+		reloader = &cr.Spec.CommonConfigReloaderParams
+	case *vmv1beta1.VMAuth:
+		reloader = &cr.Spec.CommonConfigReloaderParams
+	}
+	if reloader != nil && settings.Spec.ConfigReloaderResources != nil &&
+		reloader.ConfigReloaderResources.Limits == nil && reloader.ConfigReloaderResources.Requests == nil {
+		settings.Spec.ConfigReloaderResources.DeepCopyInto(&reloader.ConfigReloaderResources)
+	}
+	return nil
+}
+
+// checkMaintenanceWindow returns deferredError, if changes of the given object
+// cannot be applied at the current time according to VMOperatorSettings of object namespace
+func checkMaintenanceWindow(ctx context.Context, rclient client.Client, obj client.Object) error {
+	settings, err := getOperatorSettings(ctx, rclient, obj.GetNamespace())
+	if err != nil {
+		return err
+	}
+	if settings == nil {
+		return nil
+	}
+	allowed, nextWindow, err := settings.Spec.NextMaintenanceWindow(time.Now())
+	if err != nil {
+		return fmt.Errorf("cannot check maintenance windows of VMOperatorSettings=%s: %w", settings.AsKey(), err)
+	}
+	if allowed {
+		return nil
+	}
+	return &deferredError{nextWindow: nextWindow}
+}
@@ -0,0 +1,151 @@
+package operator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestApplyOperatorSettings(t *testing.T) {
+	ctx := context.Background()
+	defer vmv1beta1.SetNamespacePropagationPolicy("default", nil)
+	settingsResources := corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("50Mi")},
+	}
+	predefinedObjects := []runtime.Object{
+		&vmv1beta1.VMOperatorSettings{
+			ObjectMeta: metav1.ObjectMeta{Name: "b-settings", Namespace: "default"},
+			Spec:       vmv1beta1.VMOperatorSettingsSpec{},
+		},
+		&vmv1beta1.VMOperatorSettings{
+			ObjectMeta: metav1.ObjectMeta{Name: "a-settings", Namespace: "default"},
+			Spec: vmv1beta1.VMOperatorSettingsSpec{
+				ConfigReloaderResources: &settingsResources,
+				Propagation:             &vmv1beta1.PropagationPolicy{FilterLabelPrefixes: []string{"team/"}},
+			},
+		},
+	}
+	fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+
+	// object without resources uses settings
+	cr := &vmv1beta1.VMAgent{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default", Labels: map[string]string{"team/owner": "a"}},
+	}
+	if err := applyOperatorSettings(ctx, fclient, cr); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := cr.Spec.ConfigReloaderResources.Limits.Memory().String(); got != "50Mi" {
+		t.Fatalf("unexpected config-reloader memory limit, got: %s, want: 50Mi", got)
+	}
+	if _, ok := cr.AllLabels()["team/owner"]; ok {
+		t.Fatalf("label must be filtered by namespace propagation policy: %v", cr.AllLabels())
+	}
+
+	// object resources have priority over settings
+	cr = &vmv1beta1.VMAgent{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"},
+		Spec: vmv1beta1.VMAgentSpec{
+			CommonConfigReloaderParams: vmv1beta1.CommonConfigReloaderParams{
+				ConfigReloaderResources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10m")},
+				},
+			},
+		},
+	}
+	if err := applyOperatorSettings(ctx, fclient, cr); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if cr.Spec.ConfigReloaderResources.Limits != nil {
+		t.Fatalf("object resources must not be changed, got: %v", cr.Spec.ConfigReloaderResources)
+	}
+
+	// namespace without settings removes propagation policy
+	cr.Namespace = "other"
+	cr.Labels = map[string]string{"team/owner": "a"}
+	vmv1beta1.SetNamespacePropagationPolicy("other", &vmv1beta1.PropagationPolicy{FilterLabelPrefixes: []string{"team/"}})
+	if err := applyOperatorSettings(ctx, fclient, cr); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if cr.AllLabels()["team/owner"] != "a" {
+		t.Fatalf("label must be propagated without settings: %v", cr.AllLabels())
+	}
+}
+
+func TestReconcileDeferredByMaintenanceWindow(t *testing.T) {
+	ctx := context.Background()
+	// daily window, which starts in 2 hours
+	windowStart := time.Now().UTC().Add(2 * time.Hour)
+	settings := &vmv1beta1.VMOperatorSettings{
+		ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "default"},
+		Spec: vmv1beta1.VMOperatorSettingsSpec{
+			MaintenanceWindows: []vmv1beta1.MaintenanceWindow{{Start: windowStart.Format("15:04"), Duration: "1h"}},
+		},
+	}
+	f := func(cr *vmv1beta1.VMSingle, wantDeferred bool) {
+		t.Helper()
+		fclient := k8stools.GetTestClientWithObjects([]runtime.Object{settings, cr})
+		var called bool
+		_, err := reconcileAndTrackStatus(ctx, fclient, cr, func(_ context.Context) (ctrl.Result, error) {
+			called = true
+			return ctrl.Result{}, nil
+		})
+		var de *deferredError
+		if errors.As(err, &de) != wantDeferred {
+			t.Fatalf("unexpected error: %v, wantDeferred: %v", err, wantDeferred)
+		}
+		if called == wantDeferred {
+			t.Fatalf("unexpected reconcile call: %v, wantDeferred: %v", called, wantDeferred)
+		}
+		if !wantDeferred {
+			return
+		}
+		result, err := handleReconcileErr(ctx, fclient, cr, ctrl.Result{}, err)
+		if err != nil {
+			t.Fatalf("deferred changes must not return error: %s", err)
+		}
+		if result.RequeueAfter <= time.Hour || result.RequeueAfter > 2*time.Hour {
+			t.Fatalf("reconcile must be requeued at the window start, got: %s", result.RequeueAfter)
+		}
+	}
+
+	// new object
+	f(&vmv1beta1.VMSingle{
+		ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "default"},
+		Spec:       vmv1beta1.VMSingleSpec{RetentionPeriod: "1"},
+	}, false)
+
+	// changed object
+	f(&vmv1beta1.VMSingle{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "changed",
+			Namespace: "default",
+			Annotations: map[string]string{
+				"operator.victoriametrics/last-applied-spec": `{"retentionPeriod":"1"}`,
+			},
+		},
+		Spec: vmv1beta1.VMSingleSpec{RetentionPeriod: "2"},
+	}, true)
+
+	// object without changes
+	unchanged := &vmv1beta1.VMSingle{
+		ObjectMeta: metav1.ObjectMeta{Name: "unchanged", Namespace: "default"},
+		Spec:       vmv1beta1.VMSingleSpec{RetentionPeriod: "1"},
+	}
+	lastAppliedSpec, err := json.Marshal(unchanged.Spec)
+	if err != nil {
+		t.Fatalf("cannot marshal spec: %s", err)
+	}
+	unchanged.Annotations = map[string]string{"operator.victoriametrics/last-applied-spec": string(lastAppliedSpec)}
+	f(unchanged, false)
+}
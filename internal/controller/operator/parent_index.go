@@ -0,0 +1,149 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+var (
+	vmAgentParents = newParentIndex()
+	vmAlertParents = newParentIndex()
+)
+
+// childSelector defines how parent object selects child objects of the given kind
+type childSelector struct {
+	selectAll         bool
+	selector          *metav1.LabelSelector
+	namespaceSelector *metav1.LabelSelector
+}
+
+// parentIndex keeps child selectors of parent objects.
+// It's updated on parent reconcile and allows to find parents of changed child object
+// without listing all parent objects across watched namespaces on every child event.
+type parentIndex struct {
+	mu        sync.Mutex
+	selectors map[types.NamespacedName]map[string]childSelector
+}
+
+func newParentIndex() *parentIndex {
+	return &parentIndex{selectors: make(map[types.NamespacedName]map[string]childSelector)}
+}
+
+// set replaces child selectors of the given parent
+func (pi *parentIndex) set(parent types.NamespacedName, selectors map[string]childSelector) {
+	pi.mu.Lock()
+	defer pi.mu.Unlock()
+	pi.selectors[parent] = selectors
+}
+
+// delete removes parent from index
+func (pi *parentIndex) delete(parent types.NamespacedName) {
+	pi.mu.Lock()
+	defer pi.mu.Unlock()
+	delete(pi.selectors, parent)
+}
+
+// parentsOf returns sorted parents, which select given child object of the kind
+// deleted child is matched by all parents, since its labels could be changed before deletion
+func (pi *parentIndex) parentsOf(ctx context.Context, rclient client.Client, kind string, child client.Object) ([]types.NamespacedName, error) {
+	pi.mu.Lock()
+	candidates := make(map[types.NamespacedName]childSelector, len(pi.selectors))
+	for parent, selectors := range pi.selectors {
+		if cs, ok := selectors[kind]; ok {
+			candidates[parent] = cs
+		}
+	}
+	pi.mu.Unlock()
+
+	var parents []types.NamespacedName
+	for parent, cs := range candidates {
+		if child.GetDeletionTimestamp().IsZero() && !cs.selectAll {
+			target := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: parent.Name, Namespace: parent.Namespace}}
+			match, err := isSelectorsMatchesTargetCRD(ctx, rclient, child, target, cs.selector, cs.namespaceSelector)
+			if err != nil {
+				return nil, fmt.Errorf("cannot match %s with parent=%s: %w", kind, parent, err)
+			}
+			if !match {
+				continue
+			}
+		}
+		parents = append(parents, parent)
+	}
+	sort.Slice(parents, func(i, j int) bool {
+		return parents[i].String() < parents[j].String()
+	})
+	return parents, nil
+}
+
+// crossNamespaceParents returns sorted parents, which could select child objects outside of own namespace
+// child objects are selected only from own namespace, if object selector is defined without namespace selector
+func (pi *parentIndex) crossNamespaceParents() []types.NamespacedName {
+	pi.mu.Lock()
+	defer pi.mu.Unlock()
+	var parents []types.NamespacedName
+	for parent, selectors := range pi.selectors {
+		for _, cs := range selectors {
+			if cs.namespaceSelector != nil || (cs.selectAll && cs.selector == nil) {
+				parents = append(parents, parent)
+				break
+			}
+		}
+	}
+	sort.Slice(parents, func(i, j int) bool {
+		return parents[i].String() < parents[j].String()
+	})
+	return parents
+}
+
+// vmAgentChildSelectors returns selectors of scrape objects defined at VMAgent
+func vmAgentChildSelectors(cr *vmv1beta1.VMAgent) map[string]childSelector {
+	selectAll := cr.Spec.SelectAllByDefault
+	return map[string]childSelector{
+		"vmservicescrape": {selectAll: selectAll, selector: cr.Spec.ServiceScrapeSelector, namespaceSelector: cr.Spec.ServiceScrapeNamespaceSelector},
+		"vmpodscrape":     {selectAll: selectAll, selector: cr.Spec.PodScrapeSelector, namespaceSelector: cr.Spec.PodScrapeNamespaceSelector},
+		"vmprobe":         {selectAll: selectAll, selector: cr.Spec.ProbeSelector, namespaceSelector: cr.Spec.ProbeNamespaceSelector},
+		"vmnodescrape":    {selectAll: selectAll, selector: cr.Spec.NodeScrapeSelector, namespaceSelector: cr.Spec.NodeScrapeNamespaceSelector},
+		"vmstaticscrape":  {selectAll: selectAll, selector: cr.Spec.StaticScrapeSelector, namespaceSelector: cr.Spec.StaticScrapeNamespaceSelector},
+		"vmscrapeconfig":  {selectAll: selectAll, selector: cr.Spec.ScrapeConfigSelector, namespaceSelector: cr.Spec.ScrapeConfigNamespaceSelector},
+	}
+}
+
+// vmAlertChildSelectors returns selectors of rules defined at VMAlert
+func vmAlertChildSelectors(cr *vmv1beta1.VMAlert) map[string]childSelector {
+	return map[string]childSelector{
+		"vmrule": {selectAll: cr.Spec.SelectAllByDefault, selector: cr.Spec.RuleSelector, namespaceSelector: cr.Spec.RuleNamespaceSelector},
+	}
+}
+
+// selectedVMAgents returns managed VMAgents, which select given scrape object of the kind
+func selectedVMAgents(ctx context.Context, rclient client.Client, kind string, child client.Object) ([]*vmv1beta1.VMAgent, error) {
+	parents, err := vmAgentParents.parentsOf(ctx, rclient, kind, child)
+	if err != nil {
+		return nil, err
+	}
+	var vmagents []*vmv1beta1.VMAgent
+	for _, parent := range parents {
+		var vmagent vmv1beta1.VMAgent
+		if err := rclient.Get(ctx, parent, &vmagent); err != nil {
+			if errors.IsNotFound(err) {
+				vmAgentParents.delete(parent)
+				continue
+			}
+			return nil, fmt.Errorf("cannot get parent vmagent=%s: %w", parent, err)
+		}
+		if !isNamespaceOwned(ctx, vmagent.Namespace) || !vmagent.DeletionTimestamp.IsZero() || vmagent.Spec.ParsingError != "" || vmagent.IsUnmanaged() || vmagent.Paused() {
+			continue
+		}
+		vmagents = append(vmagents, &vmagent)
+	}
+	return vmagents, nil
+}
@@ -0,0 +1,134 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestParentIndexParentsOf(t *testing.T) {
+	f := func(child client.Object, want []types.NamespacedName) {
+		t.Helper()
+		fclient := k8stools.GetTestClientWithObjects([]runtime.Object{
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"monitored": "true"}}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+		})
+		pi := newParentIndex()
+		pi.set(types.NamespacedName{Namespace: "default", Name: "all"}, vmAgentChildSelectors(&vmv1beta1.VMAgent{
+			Spec: vmv1beta1.VMAgentSpec{SelectAllByDefault: true},
+		}))
+		pi.set(types.NamespacedName{Namespace: "default", Name: "same-namespace"}, vmAgentChildSelectors(&vmv1beta1.VMAgent{}))
+		pi.set(types.NamespacedName{Namespace: "default", Name: "by-namespace"}, vmAgentChildSelectors(&vmv1beta1.VMAgent{
+			Spec: vmv1beta1.VMAgentSpec{
+				ServiceScrapeNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"monitored": "true"}},
+			},
+		}))
+		pi.set(types.NamespacedName{Namespace: "default", Name: "removed"}, vmAgentChildSelectors(&vmv1beta1.VMAgent{
+			Spec: vmv1beta1.VMAgentSpec{SelectAllByDefault: true},
+		}))
+		pi.delete(types.NamespacedName{Namespace: "default", Name: "removed"})
+		got, err := pi.parentsOf(context.Background(), fclient, "vmservicescrape", child)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assert.Equal(t, want, got)
+	}
+
+	// object at parent namespace
+	f(&vmv1beta1.VMServiceScrape{ObjectMeta: metav1.ObjectMeta{Name: "scrape", Namespace: "default"}}, []types.NamespacedName{
+		{Namespace: "default", Name: "all"},
+		{Namespace: "default", Name: "same-namespace"},
+	})
+
+	// object at selected namespace
+	f(&vmv1beta1.VMServiceScrape{ObjectMeta: metav1.ObjectMeta{Name: "scrape", Namespace: "team-a"}}, []types.NamespacedName{
+		{Namespace: "default", Name: "all"},
+		{Namespace: "default", Name: "by-namespace"},
+	})
+
+	// object at not selected namespace
+	f(&vmv1beta1.VMServiceScrape{ObjectMeta: metav1.ObjectMeta{Name: "scrape", Namespace: "team-b"}}, []types.NamespacedName{
+		{Namespace: "default", Name: "all"},
+	})
+
+	// deleted object is matched by all parents
+	f(&vmv1beta1.VMServiceScrape{ObjectMeta: metav1.ObjectMeta{
+		Name:              "scrape",
+		Namespace:         "team-b",
+		DeletionTimestamp: ptr.To(metav1.Now()),
+		Finalizers:        []string{vmv1beta1.FinalizerName},
+	}}, []types.NamespacedName{
+		{Namespace: "default", Name: "all"},
+		{Namespace: "default", Name: "by-namespace"},
+		{Namespace: "default", Name: "same-namespace"},
+	})
+}
+
+func TestSelectedVMAgents(t *testing.T) {
+	origin := vmAgentParents
+	defer func() { vmAgentParents = origin }()
+	vmAgentParents = newParentIndex()
+
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{
+		&vmv1beta1.VMAgent{
+			ObjectMeta: metav1.ObjectMeta{Name: "active", Namespace: "default"},
+			Spec:       vmv1beta1.VMAgentSpec{SelectAllByDefault: true},
+		},
+		&vmv1beta1.VMAgent{
+			ObjectMeta: metav1.ObjectMeta{Name: "paused", Namespace: "default"},
+			Spec:       vmv1beta1.VMAgentSpec{SelectAllByDefault: true, CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{Paused: true}},
+		},
+	})
+	for _, name := range []string{"active", "paused", "missing"} {
+		vmAgentParents.set(types.NamespacedName{Namespace: "default", Name: name}, vmAgentChildSelectors(&vmv1beta1.VMAgent{}))
+	}
+	got, err := selectedVMAgents(context.Background(), fclient, "vmpodscrape", &vmv1beta1.VMPodScrape{ObjectMeta: metav1.ObjectMeta{Name: "scrape", Namespace: "default"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(got) != 1 || got[0].Name != "active" {
+		t.Fatalf("unexpected vmagents: %v", got)
+	}
+	if _, ok := vmAgentParents.selectors[types.NamespacedName{Namespace: "default", Name: "missing"}]; ok {
+		t.Fatalf("missing vmagent must be removed from index")
+	}
+}
+
+func TestParentIndexCrossNamespaceParents(t *testing.T) {
+	pi := newParentIndex()
+	pi.set(types.NamespacedName{Namespace: "default", Name: "all"}, vmAgentChildSelectors(&vmv1beta1.VMAgent{
+		Spec: vmv1beta1.VMAgentSpec{SelectAllByDefault: true},
+	}))
+	pi.set(types.NamespacedName{Namespace: "default", Name: "same-namespace"}, vmAgentChildSelectors(&vmv1beta1.VMAgent{
+		Spec: vmv1beta1.VMAgentSpec{
+			SelectAllByDefault:    true,
+			ServiceScrapeSelector: &metav1.LabelSelector{},
+			PodScrapeSelector:     &metav1.LabelSelector{},
+			ProbeSelector:         &metav1.LabelSelector{},
+			NodeScrapeSelector:    &metav1.LabelSelector{},
+			StaticScrapeSelector:  &metav1.LabelSelector{},
+			ScrapeConfigSelector:  &metav1.LabelSelector{},
+		},
+	}))
+	pi.set(types.NamespacedName{Namespace: "default", Name: "by-namespace"}, vmAgentChildSelectors(&vmv1beta1.VMAgent{
+		Spec: vmv1beta1.VMAgentSpec{
+			ProbeNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"monitored": "true"}},
+		},
+	}))
+	pi.set(types.NamespacedName{Namespace: "default", Name: "nothing"}, vmAgentChildSelectors(&vmv1beta1.VMAgent{}))
+	assert.Equal(t, []types.NamespacedName{
+		{Namespace: "default", Name: "all"},
+		{Namespace: "default", Name: "by-namespace"},
+	}, pi.crossNamespaceParents())
+}
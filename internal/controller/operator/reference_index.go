@@ -0,0 +1,152 @@
+package operator
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+var (
+	vmAgentReferences = newReferenceIndex()
+	vmAlertReferences = newReferenceIndex()
+	vmAuthReferences  = newReferenceIndex()
+)
+
+const (
+	referenceKindSecret    = "Secret"
+	referenceKindConfigMap = "ConfigMap"
+)
+
+// referencedObject is a Secret or ConfigMap read during reconcile of parent object
+type referencedObject struct {
+	kind string
+	types.NamespacedName
+}
+
+// referenceIndex keeps Secrets and ConfigMaps referenced by parent objects.
+// It's updated on parent reconcile and allows to requeue parents on change of referenced object,
+// e.g. on rotation of basicAuth, TLS or oauth2 credentials.
+type referenceIndex struct {
+	mu   sync.Mutex
+	refs map[types.NamespacedName]map[referencedObject]struct{}
+}
+
+func newReferenceIndex() *referenceIndex {
+	return &referenceIndex{refs: make(map[types.NamespacedName]map[referencedObject]struct{})}
+}
+
+// set replaces referenced objects of the given parent
+func (ri *referenceIndex) set(parent types.NamespacedName, refs map[referencedObject]struct{}) {
+	ri.mu.Lock()
+	defer ri.mu.Unlock()
+	if len(refs) == 0 {
+		delete(ri.refs, parent)
+		return
+	}
+	ri.refs[parent] = refs
+}
+
+// delete removes parent from index
+func (ri *referenceIndex) delete(parent types.NamespacedName) {
+	ri.mu.Lock()
+	defer ri.mu.Unlock()
+	delete(ri.refs, parent)
+}
+
+// parentsOf returns sorted parents, which reference given object
+func (ri *referenceIndex) parentsOf(ref referencedObject) []types.NamespacedName {
+	ri.mu.Lock()
+	var parents []types.NamespacedName
+	for parent, refs := range ri.refs {
+		if _, ok := refs[ref]; ok {
+			parents = append(parents, parent)
+		}
+	}
+	ri.mu.Unlock()
+	sort.Slice(parents, func(i, j int) bool {
+		return parents[i].String() < parents[j].String()
+	})
+	return parents
+}
+
+// referenceRecorder is a client, which records Secrets and ConfigMaps fetched during reconcile.
+// Missing objects are recorded as well, so their creation triggers reconcile of parent.
+type referenceRecorder struct {
+	client.Client
+	mu   sync.Mutex
+	refs map[referencedObject]struct{}
+}
+
+func newReferenceRecorder(rclient client.Client) *referenceRecorder {
+	return &referenceRecorder{Client: rclient, refs: make(map[referencedObject]struct{})}
+}
+
+// Get implements client.Reader interface
+func (rr *referenceRecorder) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	var kind string
+	switch obj.(type) {
+	case *corev1.Secret:
+		kind = referenceKindSecret
+	case *corev1.ConfigMap:
+		kind = referenceKindConfigMap
+	}
+	if kind != "" {
+		rr.mu.Lock()
+		rr.refs[referencedObject{kind: kind, NamespacedName: key}] = struct{}{}
+		rr.mu.Unlock()
+	}
+	return rr.Client.Get(ctx, key, obj, opts...)
+}
+
+// references returns recorded objects
+func (rr *referenceRecorder) references() map[referencedObject]struct{} {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+	refs := make(map[referencedObject]struct{}, len(rr.refs))
+	for ref := range rr.refs {
+		refs[ref] = struct{}{}
+	}
+	return refs
+}
+
+// watchReferencedObjects requeues parents from the given index on changes of referenced Secrets and ConfigMaps
+// objects are watched with metadata only informers, since content of objects is fetched during reconcile
+func watchReferencedObjects(b *builder.Builder, ri *referenceIndex) *builder.Builder {
+	if !*watchReferences {
+		return b
+	}
+	mapFunc := func(kind string) handler.MapFunc {
+		return func(_ context.Context, o client.Object) []reconcile.Request {
+			return referencingParents(ri, kind, o)
+		}
+	}
+	return b.
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(mapFunc(referenceKindSecret)), builder.OnlyMetadata).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(mapFunc(referenceKindConfigMap)), builder.OnlyMetadata)
+}
+
+// referencingParents returns reconcile requests for parents of the given referenced object
+// objects owned by operator objects are ignored, since they're managed by operator itself
+func referencingParents(ri *referenceIndex, kind string, o client.Object) []reconcile.Request {
+	for _, ref := range o.GetOwnerReferences() {
+		if gv, err := schema.ParseGroupVersion(ref.APIVersion); err == nil && gv.Group == vmv1beta1.GroupVersion.Group {
+			return nil
+		}
+	}
+	parents := ri.parentsOf(referencedObject{kind: kind, NamespacedName: types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()}})
+	requests := make([]reconcile.Request, 0, len(parents))
+	for _, parent := range parents {
+		requests = append(requests, reconcile.Request{NamespacedName: parent})
+	}
+	return requests
+}
@@ -0,0 +1,85 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestReferenceRecorder(t *testing.T) {
+	ctx := context.Background()
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "basic-auth", Namespace: "default"}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "vmagent", Namespace: "default"}},
+	})
+	rr := newReferenceRecorder(fclient)
+	if err := rr.Get(ctx, types.NamespacedName{Namespace: "default", Name: "basic-auth"}, &corev1.Secret{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// missing objects must be recorded, since their creation must trigger reconcile
+	if err := rr.Get(ctx, types.NamespacedName{Namespace: "team-a", Name: "tls-ca"}, &corev1.ConfigMap{}); err == nil {
+		t.Fatalf("expected not found error")
+	}
+	if err := rr.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vmagent"}, &corev1.Service{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, map[referencedObject]struct{}{
+		{kind: referenceKindSecret, NamespacedName: types.NamespacedName{Namespace: "default", Name: "basic-auth"}}: {},
+		{kind: referenceKindConfigMap, NamespacedName: types.NamespacedName{Namespace: "team-a", Name: "tls-ca"}}:   {},
+	}, rr.references())
+}
+
+func TestReferencingParents(t *testing.T) {
+	secretRef := func(ns, name string) referencedObject {
+		return referencedObject{kind: referenceKindSecret, NamespacedName: types.NamespacedName{Namespace: ns, Name: name}}
+	}
+	ri := newReferenceIndex()
+	ri.set(types.NamespacedName{Namespace: "default", Name: "b"}, map[referencedObject]struct{}{
+		secretRef("default", "basic-auth"): {},
+		secretRef("team-a", "oauth2"):      {},
+	})
+	ri.set(types.NamespacedName{Namespace: "default", Name: "a"}, map[referencedObject]struct{}{
+		secretRef("default", "basic-auth"): {},
+	})
+	ri.set(types.NamespacedName{Namespace: "default", Name: "removed"}, map[referencedObject]struct{}{
+		secretRef("default", "basic-auth"): {},
+	})
+	ri.delete(types.NamespacedName{Namespace: "default", Name: "removed"})
+	ri.set(types.NamespacedName{Namespace: "default", Name: "no-refs"}, nil)
+	assert.Len(t, ri.refs, 2)
+
+	f := func(kind string, o *metav1.PartialObjectMetadata, want []reconcile.Request) {
+		t.Helper()
+		assert.Equal(t, want, referencingParents(ri, kind, o))
+	}
+
+	// secret referenced by multiple parents
+	f(referenceKindSecret, &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "basic-auth", Namespace: "default"}}, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "a"}},
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "b"}},
+	})
+
+	// secret from other namespace
+	f(referenceKindSecret, &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "oauth2", Namespace: "team-a"}}, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "b"}},
+	})
+
+	// configmap with the same name as referenced secret
+	f(referenceKindConfigMap, &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "basic-auth", Namespace: "default"}}, []reconcile.Request{})
+
+	// secret managed by operator
+	f(referenceKindSecret, &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{
+		Name:            "basic-auth",
+		Namespace:       "default",
+		OwnerReferences: []metav1.OwnerReference{{APIVersion: vmv1beta1.GroupVersion.String(), Kind: "VMUser", Name: "user"}},
+	}}, nil)
+}
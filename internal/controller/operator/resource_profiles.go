@@ -0,0 +1,74 @@
+package operator
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+)
+
+// applyResourceProfiles merges operator resource profiles selected with spec.profile into the given object.
+// Resources and extraArgs defined at object have priority over profile.
+// It must be called before object defaulting, since global defaults have the lowest priority
+func applyResourceProfiles(obj client.Object) error {
+	cfg := config.MustGetBaseConfig()
+	apply := func(component string, dp *vmv1beta1.CommonDefaultableParams, extraArgs *map[string]string) error {
+		if len(dp.Profile) == 0 {
+			return nil
+		}
+		p, err := cfg.ResourceProfile(dp.Profile, component)
+		if err != nil {
+			return fmt.Errorf("cannot apply profile to %s: %w", component, err)
+		}
+		if p == nil {
+			return nil
+		}
+		if dp.Resources.Requests == nil && dp.Resources.Limits == nil {
+			p.Resources.DeepCopyInto(&dp.Resources)
+		}
+		for k, v := range p.ExtraArgs {
+			if _, ok := (*extraArgs)[k]; ok {
+				continue
+			}
+			if *extraArgs == nil {
+				*extraArgs = make(map[string]string, len(p.ExtraArgs))
+			}
+			(*extraArgs)[k] = v
+		}
+		return nil
+	}
+
+	switch cr := obj.(type) {
+	case *vmv1beta1.VMAgent:
+		return apply("vmagent", &cr.Spec.CommonDefaultableParams, &cr.Spec.ExtraArgs)
+	case *vmv1beta1.VMAlert:
+		return apply("vmalert", &cr.Spec.CommonDefaultableParams, &cr.Spec.ExtraArgs)
+	case *vmv1beta1.VMAlertmanager:
+		return apply("vmalertmanager", &cr.Spec.CommonDefaultableParams, &cr.Spec.ExtraArgs)
+	case *vmv1beta1.VMAuth:
+		return apply("vmauth", &cr.Spec.CommonDefaultableParams, &cr.Spec.ExtraArgs)
+	case *vmv1beta1.VMSingle:
+		return apply("vmsingle", &cr.Spec.CommonDefaultableParams, &cr.Spec.ExtraArgs)
+	case *vmv1beta1.VLogs:
+		return apply("vlogs", &cr.Spec.CommonDefaultableParams, &cr.Spec.ExtraArgs)
+	case *vmv1beta1.VMCluster:
+		if cr.Spec.VMStorage != nil {
+			if err := apply("vmstorage", &cr.Spec.VMStorage.CommonDefaultableParams, &cr.Spec.VMStorage.ExtraArgs); err != nil {
+				return err
+			}
+		}
+		if cr.Spec.VMSelect != nil {
+			if err := apply("vmselect", &cr.Spec.VMSelect.CommonDefaultableParams, &cr.Spec.VMSelect.ExtraArgs); err != nil {
+				return err
+			}
+		}
+		if cr.Spec.VMInsert != nil {
+			if err := apply("vminsert", &cr.Spec.VMInsert.CommonDefaultableParams, &cr.Spec.VMInsert.ExtraArgs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package operator
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+func TestApplyResourceProfiles(t *testing.T) {
+	// object without profile is not changed
+	agent := &vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"}}
+	if err := applyResourceProfiles(agent); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if agent.Spec.Resources.Limits != nil || agent.Spec.ExtraArgs != nil {
+		t.Fatalf("object without profile must not be changed: %v", agent.Spec)
+	}
+
+	// profile fills resources and extraArgs
+	agent.Spec.Profile = "medium"
+	if err := applyResourceProfiles(agent); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := agent.Spec.Resources.Limits.Memory().String(); got != "1Gi" {
+		t.Fatalf("unexpected memory limit, got: %s, want: 1Gi", got)
+	}
+	if got := agent.Spec.ExtraArgs["memory.allowedPercent"]; got != "60" {
+		t.Fatalf("unexpected memory.allowedPercent, got: %q, want: 60", got)
+	}
+
+	// object values have priority over profile
+	cluster := &vmv1beta1.VMCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"},
+		Spec: vmv1beta1.VMClusterSpec{
+			VMStorage: &vmv1beta1.VMStorage{
+				CommonDefaultableParams: vmv1beta1.CommonDefaultableParams{
+					Profile: "large",
+					Resources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("10Gi")},
+					},
+				},
+				CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{
+					ExtraArgs: map[string]string{"memory.allowedPercent": "50"},
+				},
+			},
+			VMSelect: &vmv1beta1.VMSelect{
+				CommonDefaultableParams: vmv1beta1.CommonDefaultableParams{Profile: "small"},
+			},
+		},
+	}
+	if err := applyResourceProfiles(cluster); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	storage := cluster.Spec.VMStorage
+	if got := storage.Resources.Limits.Memory().String(); got != "10Gi" {
+		t.Fatalf("unexpected vmstorage memory limit, got: %s, want: 10Gi", got)
+	}
+	if storage.Resources.Requests != nil {
+		t.Fatalf("profile resources must not be merged with object resources: %v", storage.Resources)
+	}
+	if got := storage.ExtraArgs["memory.allowedPercent"]; got != "50" {
+		t.Fatalf("unexpected vmstorage memory.allowedPercent, got: %q, want: 50", got)
+	}
+	if got := storage.ExtraArgs["storage.cacheSizeStorageTSID"]; got != "1GB" {
+		t.Fatalf("unexpected vmstorage storage.cacheSizeStorageTSID, got: %q, want: 1GB", got)
+	}
+	if got := cluster.Spec.VMSelect.Resources.Limits.Memory().String(); got != "512Mi" {
+		t.Fatalf("unexpected vmselect memory limit, got: %s, want: 512Mi", got)
+	}
+
+	// unknown profile
+	agent = &vmv1beta1.VMAgent{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"},
+		Spec: vmv1beta1.VMAgentSpec{
+			CommonDefaultableParams: vmv1beta1.CommonDefaultableParams{Profile: "huge"},
+		},
+	}
+	if err := applyResourceProfiles(agent); err == nil {
+		t.Fatalf("expected error for unknown profile")
+	}
+}
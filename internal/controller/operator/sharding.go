@@ -0,0 +1,91 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"k8s.io/utils/ptr"
+)
+
+var (
+	shardsCount = ptr.To(1)
+	shardNum    = ptr.To("0")
+
+	currentShard int
+)
+
+// InitSharding validates sharding flags and must be called after flags parsing
+func InitSharding() error {
+	if *shardsCount < 1 {
+		return fmt.Errorf("-controller.shardsCount must be greater than 0, got: %d", *shardsCount)
+	}
+	n, err := parseShardNum(*shardNum)
+	if err != nil {
+		return fmt.Errorf("cannot parse -controller.shardNum=%q: %w", *shardNum, err)
+	}
+	if n >= *shardsCount {
+		return fmt.Errorf("-controller.shardNum=%d must be lower than -controller.shardsCount=%d", n, *shardsCount)
+	}
+	currentShard = n
+	return nil
+}
+
+// IsShardingEnabled checks if namespaces are split between operator replicas
+func IsShardingEnabled() bool {
+	return *shardsCount > 1
+}
+
+// CurrentShard returns shard number of operator replica
+func CurrentShard() int {
+	return currentShard
+}
+
+// parseShardNum accepts number or statefulset pod name with ordinal suffix
+func parseShardNum(s string) (int, error) {
+	if idx := strings.LastIndexByte(s, '-'); idx >= 0 {
+		s = s[idx+1:]
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("shard number cannot be negative")
+	}
+	return n, nil
+}
+
+// IsNamespaceOwned checks if objects at the given namespace are reconciled by operator replica
+func IsNamespaceOwned(ctx context.Context, namespace string) bool {
+	return isNamespaceOwned(ctx, namespace)
+}
+
+// isNamespaceOwned checks if objects at the given namespace are reconciled by operator replica
+// namespace must be matched by -watchNamespaceSelector if it's set
+func isNamespaceOwned(ctx context.Context, namespace string) bool {
+	if !isNamespaceWatched(ctx, namespace) {
+		return false
+	}
+	if !IsShardingEnabled() {
+		return true
+	}
+	return namespaceShard(namespace, *shardsCount) == currentShard
+}
+
+// namespaceShard returns shard of the given namespace with jump consistent hash.
+// It moves only 1/n of namespaces on shards count change, see https://arxiv.org/abs/1406.2294
+func namespaceShard(namespace string, shards int) int {
+	h := fnv.New64a()
+	h.Write([]byte(namespace))
+	key := h.Sum64()
+	var b, j int64 = -1, 0
+	for j < int64(shards) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
@@ -0,0 +1,91 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestParseShardNum(t *testing.T) {
+	f := func(s string, want int, wantErr bool) {
+		t.Helper()
+		got, err := parseShardNum(s)
+		if (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+		if got != want {
+			t.Fatalf("unexpected shard num, got: %d, want: %d", got, want)
+		}
+	}
+	f("0", 0, false)
+	f("3", 3, false)
+	f("vm-operator-2", 2, false)
+	f("vm-operator", 0, true)
+	f("", 0, true)
+}
+
+func TestInitSharding(t *testing.T) {
+	defer func() {
+		*shardsCount = 1
+		*shardNum = "0"
+		currentShard = 0
+	}()
+	f := func(count int, num string, wantErr bool) {
+		t.Helper()
+		*shardsCount = count
+		*shardNum = num
+		if err := InitSharding(); (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+	}
+	f(1, "0", false)
+	f(3, "vm-operator-2", false)
+	f(3, "3", true)
+	f(0, "0", true)
+	f(2, "bad", true)
+}
+
+func TestNamespaceShard(t *testing.T) {
+	namespaces := make([]string, 1000)
+	for i := range namespaces {
+		namespaces[i] = fmt.Sprintf("namespace-%d", i)
+	}
+	// all namespaces are distributed between shards
+	perShard := make([]int, 4)
+	for _, ns := range namespaces {
+		perShard[namespaceShard(ns, 4)]++
+	}
+	for shard, cnt := range perShard {
+		if cnt < 150 {
+			t.Fatalf("unexpected distribution for shard=%d, got %d namespaces of %d", shard, cnt, len(namespaces))
+		}
+	}
+	// adding a shard only moves namespaces to the new shard
+	for _, ns := range namespaces {
+		prev, next := namespaceShard(ns, 4), namespaceShard(ns, 5)
+		if prev != next && next != 4 {
+			t.Fatalf("namespace=%q moved from shard=%d to shard=%d", ns, prev, next)
+		}
+	}
+}
+
+func TestIsNamespaceOwned(t *testing.T) {
+	defer func() {
+		*shardsCount = 1
+		currentShard = 0
+	}()
+	if !isNamespaceOwned(context.Background(), "default") {
+		t.Fatalf("all namespaces must be owned without sharding")
+	}
+	*shardsCount = 3
+	var owners int
+	for shard := 0; shard < 3; shard++ {
+		currentShard = shard
+		if isNamespaceOwned(context.Background(), "default") {
+			owners++
+		}
+	}
+	if owners != 1 {
+		t.Fatalf("namespace must be owned by exactly one shard, got: %d", owners)
+	}
+}
@@ -0,0 +1,238 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+var (
+	stateStoreConfigMap     = ptr.To("")
+	stateStoreFlushInterval = ptr.To(30 * time.Second)
+
+	stateStore *operatorStateStore
+)
+
+// operatorStateStore keeps results of expensive computations between operator restarts.
+// State is persisted into ConfigMap, it allows to skip regeneration of configs
+// for objects, which were not changed since the last operator run.
+type operatorStateStore struct {
+	rclient client.Client
+	key     types.NamespacedName
+
+	mu    sync.Mutex
+	data  map[string]string
+	dirty bool
+}
+
+// IsStateStoreEnabled checks if operator state must be persisted between restarts
+func IsStateStoreEnabled() bool {
+	return *stateStoreConfigMap != ""
+}
+
+// InitStateStore loads persisted operator state, must be called after flags parsing
+// returned runnable periodically flushes state changes into ConfigMap
+func InitStateStore(ctx context.Context, rclient client.Client) (manager.Runnable, error) {
+	namespace, name, ok := strings.Cut(*stateStoreConfigMap, "/")
+	if !ok || namespace == "" || name == "" {
+		return nil, fmt.Errorf("-controller.stateStoreConfigMap=%q must be in format namespace/name", *stateStoreConfigMap)
+	}
+	if *stateStoreFlushInterval <= 0 {
+		return nil, fmt.Errorf("-controller.stateStoreFlushInterval must be greater than 0, got: %s", *stateStoreFlushInterval)
+	}
+	if IsShardingEnabled() {
+		// each shard persists state of its own namespaces
+		name = fmt.Sprintf("%s-shard-%d", name, CurrentShard())
+	}
+	ss := &operatorStateStore{
+		rclient: rclient,
+		key:     types.NamespacedName{Namespace: namespace, Name: name},
+		data:    make(map[string]string),
+	}
+	if err := ss.load(ctx); err != nil {
+		return nil, err
+	}
+	stateStore = ss
+	return manager.RunnableFunc(func(ctx context.Context) error {
+		return ss.run(ctx, *stateStoreFlushInterval)
+	}), nil
+}
+
+func (ss *operatorStateStore) load(ctx context.Context) error {
+	var cm corev1.ConfigMap
+	if err := ss.rclient.Get(ctx, ss.key, &cm); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("cannot load operator state from configmap=%s: %w", ss.key, err)
+	}
+	for k, v := range cm.Data {
+		ss.data[k] = v
+	}
+	return nil
+}
+
+func (ss *operatorStateStore) run(ctx context.Context, interval time.Duration) error {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// use fresh context for the final flush, since manager context is already canceled
+			flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := ss.flush(flushCtx); err != nil {
+				log.Error(err, "cannot flush operator state at shutdown")
+			}
+			return nil
+		case <-t.C:
+			if err := ss.flush(ctx); err != nil {
+				log.Error(err, "cannot flush operator state")
+			}
+		}
+	}
+}
+
+// flush writes state into ConfigMap if it was changed since the last flush
+func (ss *operatorStateStore) flush(ctx context.Context) error {
+	ss.mu.Lock()
+	if !ss.dirty {
+		ss.mu.Unlock()
+		return nil
+	}
+	data := make(map[string]string, len(ss.data))
+	for k, v := range ss.data {
+		data[k] = v
+	}
+	ss.dirty = false
+	ss.mu.Unlock()
+
+	err := ss.write(ctx, data)
+	if err != nil {
+		ss.mu.Lock()
+		ss.dirty = true
+		ss.mu.Unlock()
+	}
+	return err
+}
+
+func (ss *operatorStateStore) write(ctx context.Context, data map[string]string) error {
+	var cm corev1.ConfigMap
+	if err := ss.rclient.Get(ctx, ss.key, &cm); err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("cannot get operator state configmap=%s: %w", ss.key, err)
+		}
+		cm = corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ss.key.Name,
+				Namespace: ss.key.Namespace,
+				Labels:    map[string]string{"app.kubernetes.io/managed-by": "vm-operator"},
+			},
+			Data: data,
+		}
+		if err := ss.rclient.Create(ctx, &cm); err != nil {
+			return fmt.Errorf("cannot create operator state configmap=%s: %w", ss.key, err)
+		}
+		return nil
+	}
+	cm.Data = data
+	if err := ss.rclient.Update(ctx, &cm); err != nil {
+		return fmt.Errorf("cannot update operator state configmap=%s: %w", ss.key, err)
+	}
+	return nil
+}
+
+func (ss *operatorStateStore) get(key string) (string, bool) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	v, ok := ss.data[key]
+	return v, ok
+}
+
+func (ss *operatorStateStore) set(key, value string) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	if ss.data[key] == value {
+		return
+	}
+	ss.data[key] = value
+	ss.dirty = true
+}
+
+func (ss *operatorStateStore) delete(key string) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	if _, ok := ss.data[key]; !ok {
+		return
+	}
+	delete(ss.data, key)
+	ss.dirty = true
+}
+
+// objectStateKey returns ConfigMap compatible key for the given object
+// underscore cannot be used at kubernetes object names, so key is unambiguous
+func objectStateKey(kind string, o client.Object) string {
+	return fmt.Sprintf("%s_%s_%s", kind, o.GetNamespace(), o.GetName())
+}
+
+// deleteObjectState removes persisted state of the object, which is not found at reconcile
+// objects without finalizers are removed without reconcile with deletionTimestamp set
+func deleteObjectState(kind string, nsn types.NamespacedName) {
+	if stateStore == nil {
+		return
+	}
+	stateStore.delete(objectStateKey(kind, &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: nsn.Name, Namespace: nsn.Namespace}}))
+}
+
+// objectStateValue identifies object revision, which affects generated configs
+// uid protects from collision with re-created object with the same name
+func objectStateValue(o client.Object) string {
+	keys := make([]string, 0, len(o.GetLabels()))
+	for k := range o.GetLabels() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	h := fnv.New64a()
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write([]byte(o.GetLabels()[k]))
+		h.Write([]byte{0})
+	}
+	return fmt.Sprintf("%s/%d/%x", o.GetUID(), o.GetGeneration(), h.Sum64())
+}
+
+// isObjectStateUnchanged checks if object was already processed with the same generation and labels
+// configs of parent objects are built at its own reconciliation, so the repeated processing can be skipped
+func isObjectStateUnchanged(kind string, o client.Object) bool {
+	if stateStore == nil || !o.GetDeletionTimestamp().IsZero() {
+		return false
+	}
+	v, ok := stateStore.get(objectStateKey(kind, o))
+	return ok && v == objectStateValue(o)
+}
+
+// storeObjectState persists processed state of the given object
+func storeObjectState(kind string, o client.Object) {
+	if stateStore == nil {
+		return
+	}
+	key := objectStateKey(kind, o)
+	if !o.GetDeletionTimestamp().IsZero() {
+		stateStore.delete(key)
+		return
+	}
+	stateStore.set(key, objectStateValue(o))
+}
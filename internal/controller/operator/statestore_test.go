@@ -0,0 +1,111 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestInitStateStore(t *testing.T) {
+	defer func() {
+		*stateStoreConfigMap = ""
+		stateStore = nil
+	}()
+	f := func(cmName string, wantErr bool) {
+		t.Helper()
+		*stateStoreConfigMap = cmName
+		fclient := k8stools.GetTestClientWithObjects(nil)
+		if _, err := InitStateStore(context.Background(), fclient); (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+	}
+	f("default/vm-operator-state", false)
+	f("vm-operator-state", true)
+	f("default/", true)
+}
+
+func TestStateStoreObjectState(t *testing.T) {
+	ctx := context.Background()
+	defer func() {
+		*stateStoreConfigMap = ""
+		stateStore = nil
+	}()
+	sc := &vmv1beta1.VMServiceScrape{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "scrape",
+			Namespace:  "default",
+			UID:        "uid-1",
+			Generation: 1,
+			Labels:     map[string]string{"app": "example"},
+		},
+	}
+	// disabled store never skips objects
+	storeObjectState("vmservicescrape", sc)
+	if isObjectStateUnchanged("vmservicescrape", sc) {
+		t.Fatalf("object must not be skipped with disabled state store")
+	}
+
+	*stateStoreConfigMap = "default/vm-operator-state"
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "vm-operator-state", Namespace: "default"},
+			Data:       map[string]string{objectStateKey("vmservicescrape", sc): objectStateValue(sc)},
+		},
+	})
+	if _, err := InitStateStore(ctx, fclient); err != nil {
+		t.Fatalf("cannot init state store: %s", err)
+	}
+	// state is loaded from configmap
+	if !isObjectStateUnchanged("vmservicescrape", sc) {
+		t.Fatalf("persisted object must be skipped")
+	}
+	// labels change
+	sc.Labels["app"] = "changed"
+	if isObjectStateUnchanged("vmservicescrape", sc) {
+		t.Fatalf("object with changed labels must not be skipped")
+	}
+	storeObjectState("vmservicescrape", sc)
+	if !isObjectStateUnchanged("vmservicescrape", sc) {
+		t.Fatalf("stored object must be skipped")
+	}
+	// re-created object with the same name
+	sc.UID = "uid-2"
+	if isObjectStateUnchanged("vmservicescrape", sc) {
+		t.Fatalf("re-created object must not be skipped")
+	}
+	storeObjectState("vmservicescrape", sc)
+	if err := stateStore.flush(ctx); err != nil {
+		t.Fatalf("cannot flush state: %s", err)
+	}
+	var cm corev1.ConfigMap
+	if err := fclient.Get(ctx, types.NamespacedName{Name: "vm-operator-state", Namespace: "default"}, &cm); err != nil {
+		t.Fatalf("cannot get state configmap: %s", err)
+	}
+	if got, want := cm.Data[objectStateKey("vmservicescrape", sc)], objectStateValue(sc); got != want {
+		t.Fatalf("unexpected persisted state, got: %q, want: %q", got, want)
+	}
+	// deleted object is removed from state
+	sc.DeletionTimestamp = ptr.To(metav1.Now())
+	if isObjectStateUnchanged("vmservicescrape", sc) {
+		t.Fatalf("deleted object must not be skipped")
+	}
+	storeObjectState("vmservicescrape", sc)
+	if _, ok := stateStore.get(objectStateKey("vmservicescrape", sc)); ok {
+		t.Fatalf("deleted object must be removed from state")
+	}
+	// object removed without finalizer is removed from state by its name
+	sc.DeletionTimestamp = nil
+	storeObjectState("vmservicescrape", sc)
+	deleteObjectState("vmservicescrape", types.NamespacedName{Namespace: sc.Namespace, Name: sc.Name})
+	if _, ok := stateStore.get(objectStateKey("vmservicescrape", sc)); ok {
+		t.Fatalf("not found object must be removed from state")
+	}
+}
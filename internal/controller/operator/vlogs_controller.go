@@ -25,8 +25,10 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/tracing"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -57,13 +59,20 @@ func (r *VLogsReconciler) Scheme() *runtime.Scheme {
 // +kubebuilder:rbac:groups=apps,resources=replicasets,verbs=*
 // +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=*
 func (r *VLogsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	if !isNamespaceOwned(ctx, req.Namespace) {
+		return
+	}
 	reqLogger := r.Log.WithValues("vlogs", req.Name, "namespace", req.Namespace)
 	ctx = logger.AddToContext(ctx, reqLogger)
+	ctx, span := tracing.Start(ctx, tracing.SpanReconcile, tracing.ObjectAttributes("vlogs", req.Namespace, req.Name)...)
+	defer func() { tracing.End(span, err) }()
 	instance := &vmv1beta1.VLogs{}
+	ctx = events.AddToContext(ctx, instance)
 
 	defer func() {
 		result, err = handleReconcileErr(ctx, r.Client, instance, result, err)
 	}()
+	defer recoverReconcilePanic("vlogs", &err)
 
 	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
 		return result, &getError{err, "vlogs", req}
@@ -82,9 +91,18 @@ func (r *VLogsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (resu
 	if err := finalize.AddFinalizer(ctx, r.Client, instance); err != nil {
 		return result, err
 	}
+	if err := applyOperatorSettings(ctx, r.Client, instance); err != nil {
+		return result, err
+	}
+	if err := applyResourceProfiles(instance); err != nil {
+		return result, err
+	}
 	r.Client.Scheme().Default(instance)
 
-	result, err = reconcileAndTrackStatus(ctx, r.Client, instance, func() (ctrl.Result, error) {
+	result, err = reconcileAndTrackStatus(ctx, r.Client, instance, func(ctx context.Context) (ctrl.Result, error) {
+		if err := warnUnknownImageVersions(ctx, r.Client, instance, instance.Spec.Image.Tag); err != nil {
+			return result, err
+		}
 		if instance.Spec.Storage != nil && instance.Spec.StorageDataPath == "" {
 			err = vlogs.CreateVLogsStorage(ctx, instance, r)
 			if err != nil {
@@ -99,17 +117,18 @@ func (r *VLogsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (resu
 		return result, nil
 	})
 
-	result.RequeueAfter = r.BaseConf.ResyncAfterDuration()
+	result.RequeueAfter = requeueAfter(r.BaseConf)
 
 	return
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *VLogsReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&vmv1beta1.VLogs{}).
 		Owns(&appsv1.Deployment{}).
-		Owns(&corev1.ServiceAccount{}).
-		WithOptions(getDefaultOptions()).
+		Owns(&corev1.ServiceAccount{})
+	b = watchSelectedNamespaces[vmv1beta1.VLogsList](b, r.Client)
+	return b.WithOptions(getDefaultOptions()).
 		Complete(r)
 }
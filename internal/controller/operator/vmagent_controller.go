@@ -18,21 +18,29 @@ package operator
 
 import (
 	"context"
+	"fmt"
 	"sync"
+	"time"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/limiter"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/tracing"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/vmagent"
 
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 var (
@@ -52,6 +60,7 @@ type VMAgentReconciler struct {
 // +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmagents,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmagents/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmagents/finalizers,verbs=*
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=*
 // +kubebuilder:rbac:groups="",resources=pods,verbs=*
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;watch;list
 // +kubebuilder:rbac:groups="",resources=nodes/proxy,verbs=get;watch;list
@@ -67,27 +76,50 @@ type VMAgentReconciler struct {
 // +kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=clusterroles,verbs=get;create,update;list
 // +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;create,update;list
 func (r *VMAgentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	if !isNamespaceOwned(ctx, req.Namespace) {
+		return
+	}
 	reqLogger := r.Log.WithValues("vmagent", req.Name, "namespace", req.Namespace)
 	ctx = logger.AddToContext(ctx, reqLogger)
+	ctx, span := tracing.Start(ctx, tracing.SpanReconcile, tracing.ObjectAttributes("vmagent", req.Namespace, req.Name)...)
+	defer func() { tracing.End(span, err) }()
 	instance := &vmv1beta1.VMAgent{}
+	ctx = events.AddToContext(ctx, instance)
 
 	defer func() {
 		result, err = handleReconcileErr(ctx, r.Client, instance, result, err)
 	}()
+	defer recoverReconcilePanic("vmagent", &err)
 	// Fetch the VMAgent instance
 	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			vmAgentParents.delete(req.NamespacedName)
+			vmAgentReferences.delete(req.NamespacedName)
+			vmagent.OnDelete(req.NamespacedName)
+			if config.IsFinalizersDisabled() {
+				if err := finalize.OnVMAgentDeleted(ctx, r.Client, req.NamespacedName); err != nil {
+					return result, fmt.Errorf("cannot remove cluster-scoped objects of deleted vmagent: %w", err)
+				}
+			}
+		}
 		return result, &getError{origin: err, controller: "vmagent", requestObject: req}
 	}
+	vmAgentParents.set(req.NamespacedName, vmAgentChildSelectors(instance))
 	if !instance.IsUnmanaged() {
 		vmAgentSync.Lock()
 		defer vmAgentSync.Unlock()
 	}
 
 	RegisterObjectStat(instance, "vmagent")
+	checkLicenseExpiration(ctx, instance, "vmagent", instance.Spec.License)
 	if !instance.DeletionTimestamp.IsZero() {
+		if err := vmagent.RemoveScrapeObjectsSelection(ctx, r.Client, instance); err != nil {
+			return result, err
+		}
 		if err := finalize.OnVMAgentDelete(ctx, r.Client, instance); err != nil {
 			return result, err
 		}
+		vmagent.OnDelete(req.NamespacedName)
 		return
 	}
 
@@ -98,19 +130,39 @@ func (r *VMAgentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 	if err := finalize.AddFinalizer(ctx, r.Client, instance); err != nil {
 		return result, err
 	}
+	if err := applyOperatorSettings(ctx, r.Client, instance); err != nil {
+		return result, err
+	}
+	if err := applyResourceProfiles(instance); err != nil {
+		return result, err
+	}
 	r.Client.Scheme().Default(instance)
 
-	result, err = reconcileAndTrackStatus(ctx, r.Client, instance, func() (ctrl.Result, error) {
-		if err = vmagent.CreateOrUpdateVMAgent(ctx, instance, r); err != nil {
+	refs := newReferenceRecorder(r.Client)
+	result, err = reconcileAndTrackStatus(ctx, r.Client, instance, func(ctx context.Context) (ctrl.Result, error) {
+		if err := warnUnknownImageVersions(ctx, r.Client, instance, instance.Spec.Image.Tag); err != nil {
+			return result, err
+		}
+		if err = vmagent.CreateOrUpdateVMAgent(ctx, instance, refs); err != nil {
 			return result, err
 		}
 
 		return result, nil
 	})
+	vmAgentReferences.set(req.NamespacedName, refs.references())
 	if err != nil {
 		return
 	}
-	result.RequeueAfter = r.BaseConf.ResyncAfterDuration()
+	result.RequeueAfter = requeueAfter(r.BaseConf)
+	if m := instance.Spec.RemoteWriteMirror; m != nil {
+		// reconcile at the start and stop of mirroring window
+		if _, next := m.Phase(time.Now()); !next.IsZero() {
+			untilNext := time.Until(next) + time.Second
+			if result.RequeueAfter == 0 || untilNext < result.RequeueAfter {
+				result.RequeueAfter = untilNext
+			}
+		}
+	}
 
 	return
 }
@@ -120,13 +172,53 @@ func (r *VMAgentReconciler) Scheme() *runtime.Scheme {
 	return r.OriginScheme
 }
 
+// vmagentsForRemoteWriteTarget returns VMAgents, which reference given VMRemoteWriteTarget
+// it allows to update remoteWrite settings of all referencing vmagents on target change
+func (r *VMAgentReconciler) vmagentsForRemoteWriteTarget(ctx context.Context, obj client.Object) []reconcile.Request {
+	var vmagents vmv1beta1.VMAgentList
+	if err := r.List(ctx, &vmagents); err != nil {
+		r.Log.Error(err, "cannot list vmagents for vmremotewritetarget", "vmremotewritetarget", obj.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, vmagent := range vmagents.Items {
+		if vmagent.ReferencesRemoteWriteTarget(obj.GetName()) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: vmagent.Namespace, Name: vmagent.Name}})
+		}
+	}
+	return requests
+}
+
+// vmagentsForRelabelConfig returns all VMAgents on VMRelabelConfig change
+// it could be referenced by any scrape object selected by vmagent, so referencing vmagents cannot be filtered cheaply
+func (r *VMAgentReconciler) vmagentsForRelabelConfig(ctx context.Context, obj client.Object) []reconcile.Request {
+	var vmagents vmv1beta1.VMAgentList
+	if err := r.List(ctx, &vmagents); err != nil {
+		r.Log.Error(err, "cannot list vmagents for vmrelabelconfig", "vmrelabelconfig", obj.GetName())
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(vmagents.Items))
+	for _, vmagent := range vmagents.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: vmagent.Namespace, Name: vmagent.Name}})
+	}
+	return requests
+}
+
 // SetupWithManager general setup method
 func (r *VMAgentReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&vmv1beta1.VMAgent{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&appsv1.StatefulSet{}).
-		Owns(&v1.ServiceAccount{}).
-		WithOptions(getDefaultOptions()).
+		Owns(&appsv1.DaemonSet{}).
+		Owns(&v1.ServiceAccount{})
+	// VMRemoteWriteTarget and VMRelabelConfig are cluster-scoped and cannot be watched with namespaced permissions
+	if config.IsClusterWideAccessAllowed() {
+		b = b.Watches(&vmv1beta1.VMRemoteWriteTarget{}, handler.EnqueueRequestsFromMapFunc(r.vmagentsForRemoteWriteTarget)).
+			Watches(&vmv1beta1.VMRelabelConfig{}, handler.EnqueueRequestsFromMapFunc(r.vmagentsForRelabelConfig))
+	}
+	b = watchSelectedNamespaces[vmv1beta1.VMAgentList](b, r.Client, vmAgentParents)
+	b = watchReferencedObjects(b, vmAgentReferences)
+	return b.WithOptions(getDefaultOptions()).
 		Complete(r)
 }
@@ -21,21 +21,27 @@ import (
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
-	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/limiter"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/tracing"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/vmalert"
 
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
-var vmAlertRateLimiter = limiter.NewRateLimiter("vmalert", 5)
-
 // VMAlertReconciler reconciles a VMAlert object
 type VMAlertReconciler struct {
 	client.Client
@@ -54,19 +60,32 @@ func (r *VMAlertReconciler) Scheme() *runtime.Scheme {
 // +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmalerts/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmalerts/finalizers,verbs=*
 func (r *VMAlertReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, resultErr error) {
+	if !isNamespaceOwned(ctx, req.Namespace) {
+		return
+	}
 	reqLogger := r.Log.WithValues("vmalert", req.Name, "namespace", req.Namespace)
 	ctx = logger.AddToContext(ctx, reqLogger)
+	ctx, span := tracing.Start(ctx, tracing.SpanReconcile, tracing.ObjectAttributes("vmalert", req.Namespace, req.Name)...)
+	defer func() { tracing.End(span, resultErr) }()
 	instance := &vmv1beta1.VMAlert{}
+	ctx = events.AddToContext(ctx, instance)
 
 	defer func() {
 		result, resultErr = handleReconcileErr(ctx, r.Client, instance, result, resultErr)
 	}()
+	defer recoverReconcilePanic("vmalert", &resultErr)
 
 	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			vmAlertParents.delete(req.NamespacedName)
+			vmAlertReferences.delete(req.NamespacedName)
+		}
 		return result, &getError{err, "vmalert", req}
 	}
+	vmAlertParents.set(req.NamespacedName, vmAlertChildSelectors(instance))
 
 	RegisterObjectStat(instance, "vmalert")
+	checkLicenseExpiration(ctx, instance, "vmalert", instance.Spec.License)
 
 	if !instance.DeletionTimestamp.IsZero() {
 		if err := finalize.OnVMAlertDelete(ctx, r.Client, instance); err != nil {
@@ -82,34 +101,122 @@ func (r *VMAlertReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 	if err := finalize.AddFinalizer(ctx, r.Client, instance); err != nil {
 		return result, err
 	}
+	if err := applyOperatorSettings(ctx, r.Client, instance); err != nil {
+		return result, err
+	}
+	if err := applyResourceProfiles(instance); err != nil {
+		return result, err
+	}
 	r.Client.Scheme().Default(instance)
 
-	result, resultErr = reconcileAndTrackStatus(ctx, r.Client, instance, func() (ctrl.Result, error) {
-		maps, err := vmalert.CreateOrUpdateRuleConfigMaps(ctx, instance, r)
+	refs := newReferenceRecorder(r.Client)
+	result, resultErr = reconcileAndTrackStatus(ctx, r.Client, instance, func(ctx context.Context) (ctrl.Result, error) {
+		if err := warnUnknownImageVersions(ctx, r.Client, instance, instance.Spec.Image.Tag); err != nil {
+			return result, err
+		}
+		maps, err := vmalert.CreateOrUpdateRuleConfigMaps(ctx, instance, refs)
 		if err != nil {
 			return result, err
 		}
 		reqLogger.Info("found configmaps for vmalert", " len ", len(maps), "map names", maps)
 
-		if err := vmalert.CreateOrUpdateVMAlert(ctx, instance, r, maps); err != nil {
+		if err := vmalert.CreateOrUpdateVMAlert(ctx, instance, refs, maps); err != nil {
 			return result, err
 		}
 
 		return result, nil
 	})
+	vmAlertReferences.set(req.NamespacedName, refs.references())
 	if resultErr != nil {
 		return
 	}
-	result.RequeueAfter = r.BaseConf.ResyncAfterDuration()
+	result.RequeueAfter = requeueAfter(r.BaseConf)
 	return
 }
 
+// vmalertsForAlertmanager returns VMAlerts, which discover given VMAlertmanager with notifier selectors
+// it allows to update notifiers on alertmanager replicas and web config changes
+func (r *VMAlertReconciler) vmalertsForAlertmanager(ctx context.Context, obj client.Object) []reconcile.Request {
+	var vmalerts vmv1beta1.VMAlertList
+	if err := r.List(ctx, &vmalerts); err != nil {
+		r.Log.Error(err, "cannot list vmalerts for vmalertmanager", "vmalertmanager", obj.GetName(), "namespace", obj.GetNamespace())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, vma := range vmalerts.Items {
+		notifiers := vma.Spec.Notifiers
+		if vma.Spec.Notifier != nil {
+			notifiers = append(notifiers, *vma.Spec.Notifier)
+		}
+		for _, n := range notifiers {
+			if isNotifierSelectorMatch(n.Selector, obj) {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: vma.Namespace, Name: vma.Name}})
+				break
+			}
+		}
+	}
+	return requests
+}
+
+// vmalertsForRule returns VMAlerts, which select given VMRule
+// requests for the same VMAlert are deduplicated by workqueue, so bursts of VMRule changes
+// are coalesced into a single VMAlert reconcile without dropping any of them.
+// Update events call it for both old and new object, it allows to unselect VMRule on labels change.
+func (r *VMAlertReconciler) vmalertsForRule(ctx context.Context, obj client.Object) []reconcile.Request {
+	parents, err := vmAlertParents.parentsOf(ctx, r.Client, "vmrule", obj)
+	if err != nil {
+		r.Log.Error(err, "cannot select vmalerts for vmrule", "vmrule", obj.GetName(), "namespace", obj.GetNamespace())
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(parents))
+	for _, parent := range parents {
+		var vma vmv1beta1.VMAlert
+		if err := r.Get(ctx, parent, &vma); err != nil {
+			if apierrors.IsNotFound(err) {
+				vmAlertParents.delete(parent)
+				continue
+			}
+			r.Log.Error(err, "cannot get parent vmalert for vmrule", "vmalert", parent.String(), "vmrule", obj.GetName(), "namespace", obj.GetNamespace())
+			continue
+		}
+		if !isNamespaceOwned(ctx, vma.Namespace) || !vma.DeletionTimestamp.IsZero() || vma.Spec.ParsingError != "" || vma.Paused() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: parent})
+	}
+	return requests
+}
+
+// isNotifierSelectorMatch checks if object is matched by notifier discovery selector
+// nil namespace selector matches objects at any namespace
+func isNotifierSelectorMatch(ds *vmv1beta1.DiscoverySelector, obj client.Object) bool {
+	if ds == nil {
+		return false
+	}
+	if ds.Namespace != nil && !ds.Namespace.IsMatch(obj) {
+		return false
+	}
+	if ds.Labels == nil {
+		return true
+	}
+	s, err := metav1.LabelSelectorAsSelector(ds.Labels)
+	if err != nil {
+		return false
+	}
+	return s.Matches(labels.Set(obj.GetLabels()))
+}
+
 // SetupWithManager general setup method
 func (r *VMAlertReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&vmv1beta1.VMAlert{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&v1.ServiceAccount{}).
-		WithOptions(getDefaultOptions()).
+		Owns(&batchv1.Job{}).
+		Watches(&vmv1beta1.VMAlertmanager{}, handler.EnqueueRequestsFromMapFunc(r.vmalertsForAlertmanager)).
+		Watches(&vmv1beta1.VMRule{}, handler.EnqueueRequestsFromMapFunc(r.vmalertsForRule))
+	b = watchSelectedNamespaces[vmv1beta1.VMAlertList](b, r.Client, vmAlertParents)
+	b = watchReferencedObjects(b, vmAlertReferences)
+	return b.WithOptions(getDefaultOptions()).
 		Complete(r)
 }
@@ -22,11 +22,14 @@ import (
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/config"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/alertmanager"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/tracing"
 
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -54,13 +57,20 @@ func (r *VMAlertmanagerReconciler) Scheme() *runtime.Scheme {
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=*
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=*
 func (r *VMAlertmanagerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	if !isNamespaceOwned(ctx, req.Namespace) {
+		return
+	}
 	reqLogger := r.Log.WithValues("vmalertmanager", req.Name, "namespace", req.Namespace)
 	ctx = logger.AddToContext(ctx, reqLogger)
+	ctx, span := tracing.Start(ctx, tracing.SpanReconcile, tracing.ObjectAttributes("vmalertmanager", req.Namespace, req.Name)...)
+	defer func() { tracing.End(span, err) }()
 	instance := &vmv1beta1.VMAlertmanager{}
+	ctx = events.AddToContext(ctx, instance)
 
 	defer func() {
 		result, err = handleReconcileErr(ctx, r.Client, instance, result, err)
 	}()
+	defer recoverReconcilePanic("vmalertmanager", &err)
 	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
 		return result, &getError{err, "vmalertmanager", req}
 	}
@@ -79,9 +89,18 @@ func (r *VMAlertmanagerReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	if err := finalize.AddFinalizer(ctx, r.Client, instance); err != nil {
 		return result, err
 	}
+	if err := applyOperatorSettings(ctx, r.Client, instance); err != nil {
+		return result, err
+	}
+	if err := applyResourceProfiles(instance); err != nil {
+		return result, err
+	}
 	r.Client.Scheme().Default(instance)
 
-	result, err = reconcileAndTrackStatus(ctx, r.Client, instance, func() (ctrl.Result, error) {
+	result, err = reconcileAndTrackStatus(ctx, r.Client, instance, func(ctx context.Context) (ctrl.Result, error) {
+		if err := warnUnknownImageVersions(ctx, r.Client, instance, instance.Spec.Image.Tag); err != nil {
+			return result, err
+		}
 		if err := alertmanager.CreateAMConfig(ctx, instance, r.Client); err != nil {
 			return result, err
 		}
@@ -96,16 +115,18 @@ func (r *VMAlertmanagerReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return
 	}
 
-	result.RequeueAfter = r.BaseConf.ResyncAfterDuration()
+	result.RequeueAfter = requeueAfter(r.BaseConf)
 	return
 }
 
 // SetupWithManager general setup method
 func (r *VMAlertmanagerReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&vmv1beta1.VMAlertmanager{}).
 		Owns(&appsv1.StatefulSet{}).
 		Owns(&v1.ServiceAccount{}).
-		WithOptions(getDefaultOptions()).
+		Owns(&batchv1.Job{})
+	b = watchSelectedNamespaces[vmv1beta1.VMAlertmanagerList](b, r.Client)
+	return b.WithOptions(getDefaultOptions()).
 		Complete(r)
 }
@@ -23,6 +23,7 @@ import (
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/config"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/alertmanager"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/limiter"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
@@ -55,6 +56,7 @@ func (r *VMAlertmanagerConfigReconciler) Reconcile(ctx context.Context, req ctrl
 	defer func() {
 		result, resultErr = handleReconcileErr(ctx, r.Client, nil, result, resultErr)
 	}()
+	defer recoverReconcilePanic("vmalertmanagerconfig", &resultErr)
 
 	var instance vmv1beta1.VMAlertmanagerConfig
 
@@ -77,12 +79,13 @@ func (r *VMAlertmanagerConfigReconciler) Reconcile(ctx context.Context, req ctrl
 
 	for _, item := range objects.Items {
 		am := &item
-		if !am.DeletionTimestamp.IsZero() || am.Spec.ParsingError != "" || am.IsUnmanaged() {
+		if !isNamespaceOwned(ctx, am.Namespace) || !am.DeletionTimestamp.IsZero() || am.Spec.ParsingError != "" || am.IsUnmanaged() || am.Paused() {
 			continue
 		}
 
 		l := l.WithValues("parent_alertmanager", am.Name, "parent_namespace", am.Namespace)
 		ctx := logger.AddToContext(ctx, l)
+		ctx = events.AddToContext(ctx, am)
 
 		// only check selector when deleting, since labels can be changed when updating and we can't tell if it was selected before.
 		if instance.DeletionTimestamp.IsZero() && !am.Spec.SelectAllByDefault {
@@ -0,0 +1,111 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"context"
+	"fmt"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/alertmanager"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// VMAlertmanagerTemplateReconciler reconciles a VMAlertmanagerTemplate object
+type VMAlertmanagerTemplateReconciler struct {
+	client.Client
+	Log          logr.Logger
+	OriginScheme *runtime.Scheme
+	BaseConf     *config.BaseOperatorConf
+}
+
+// Scheme implements interface.
+func (r *VMAlertmanagerTemplateReconciler) Scheme() *runtime.Scheme {
+	return r.OriginScheme
+}
+
+// Reconcile implements interface
+// +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmalertmanagertemplates,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmalertmanagertemplates/status,verbs=get;update;patch
+func (r *VMAlertmanagerTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, resultErr error) {
+	l := r.Log.WithValues("vmalertmanagertemplate", req.Name, "namespace", req.Namespace)
+	defer func() {
+		result, resultErr = handleReconcileErr(ctx, r.Client, nil, result, resultErr)
+	}()
+	defer recoverReconcilePanic("vmalertmanagertemplate", &resultErr)
+
+	var instance vmv1beta1.VMAlertmanagerTemplate
+
+	if err := r.Get(ctx, req.NamespacedName, &instance); err != nil {
+		return result, &getError{err, "vmalertmanagertemplate", req}
+	}
+
+	RegisterObjectStat(&instance, "vmalertmanagertemplate")
+
+	if vmaConfigRateLimiter.MustThrottleReconcile() {
+		return
+	}
+
+	var objects vmv1beta1.VMAlertmanagerList
+	if err := k8stools.ListObjectsByNamespace(ctx, r.Client, config.MustGetWatchNamespaces(), func(dst *vmv1beta1.VMAlertmanagerList) {
+		objects.Items = append(objects.Items, dst.Items...)
+	}); err != nil {
+		return result, fmt.Errorf("cannot list vmalertmanagers for vmalertmanagertemplate: %w", err)
+	}
+
+	for _, item := range objects.Items {
+		am := &item
+		if !isNamespaceOwned(ctx, am.Namespace) || !am.DeletionTimestamp.IsZero() || am.Spec.ParsingError != "" || am.IsTemplatesUnmanaged() || am.Paused() {
+			continue
+		}
+
+		l := l.WithValues("parent_alertmanager", am.Name, "parent_namespace", am.Namespace)
+		ctx := logger.AddToContext(ctx, l)
+		ctx = events.AddToContext(ctx, am)
+
+		// only check selector when deleting, since labels can be changed when updating and we can't tell if it was selected before.
+		if instance.DeletionTimestamp.IsZero() && !am.Spec.SelectAllByDefault {
+			match, err := isSelectorsMatchesTargetCRD(ctx, r.Client, &instance, am, am.Spec.TemplateSelector, am.Spec.TemplateNamespaceSelector)
+			if err != nil {
+				l.Error(err, "cannot match alertmanager against selector, probably bug")
+				continue
+			}
+			if !match {
+				continue
+			}
+		}
+		if err := alertmanager.CreateAMConfig(ctx, am, r.Client); err != nil {
+			continue
+		}
+	}
+	return
+}
+
+// SetupWithManager configures reconcile
+func (r *VMAlertmanagerTemplateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vmv1beta1.VMAlertmanagerTemplate{}).
+		WithOptions(getDefaultOptions()).
+		Complete(r)
+}
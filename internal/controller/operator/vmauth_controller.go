@@ -22,13 +22,16 @@ import (
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/tracing"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/vmauth"
 
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -50,20 +53,33 @@ func (r *VMAuthReconciler) Scheme() *runtime.Scheme {
 // Reconcile implements interface
 // +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmauths,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmauths/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=route.openshift.io,resources=routes;routes/custom-host,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;update;patch;delete
 func (r *VMAuthReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	if !isNamespaceOwned(ctx, req.Namespace) {
+		return
+	}
 	l := r.Log.WithValues("vmauth", req.Name, "namespace", req.Namespace)
 	ctx = logger.AddToContext(ctx, l)
+	ctx, span := tracing.Start(ctx, tracing.SpanReconcile, tracing.ObjectAttributes("vmauth", req.Namespace, req.Name)...)
+	defer func() { tracing.End(span, err) }()
 	instance := &vmv1beta1.VMAuth{}
+	ctx = events.AddToContext(ctx, instance)
 
 	defer func() {
 		result, err = handleReconcileErr(ctx, r.Client, instance, result, err)
 	}()
+	defer recoverReconcilePanic("vmauth", &err)
 
 	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			vmAuthReferences.delete(req.NamespacedName)
+		}
 		return result, &getError{err, "vmauth", req}
 	}
 
 	RegisterObjectStat(instance, "vmauth")
+	checkLicenseExpiration(ctx, instance, "vmauth", instance.Spec.License)
 
 	if !instance.DeletionTimestamp.IsZero() {
 		if err := finalize.OnVMAuthDelete(ctx, r, instance); err != nil {
@@ -78,29 +94,42 @@ func (r *VMAuthReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res
 	if err := finalize.AddFinalizer(ctx, r.Client, instance); err != nil {
 		return result, err
 	}
+	if err := applyOperatorSettings(ctx, r.Client, instance); err != nil {
+		return result, err
+	}
+	if err := applyResourceProfiles(instance); err != nil {
+		return result, err
+	}
 	r.Client.Scheme().Default(instance)
 
-	result, err = reconcileAndTrackStatus(ctx, r.Client, instance, func() (ctrl.Result, error) {
-		if err := vmauth.CreateOrUpdateVMAuth(ctx, instance, r); err != nil {
+	refs := newReferenceRecorder(r.Client)
+	result, err = reconcileAndTrackStatus(ctx, r.Client, instance, func(ctx context.Context) (ctrl.Result, error) {
+		if err := warnUnknownImageVersions(ctx, r.Client, instance, instance.Spec.Image.Tag); err != nil {
+			return result, err
+		}
+		if err := vmauth.CreateOrUpdateVMAuth(ctx, instance, refs); err != nil {
 			return result, fmt.Errorf("cannot create or update vmauth deploy: %w", err)
 		}
 
 		return result, nil
 	})
+	vmAuthReferences.set(req.NamespacedName, refs.references())
 	if err != nil {
 		return
 	}
-	result.RequeueAfter = r.BaseConf.ResyncAfterDuration()
+	result.RequeueAfter = requeueAfter(r.BaseConf)
 
 	return
 }
 
 // SetupWithManager inits object.
 func (r *VMAuthReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&vmv1beta1.VMAuth{}).
 		Owns(&appsv1.Deployment{}).
-		Owns(&corev1.ServiceAccount{}).
-		WithOptions(getDefaultOptions()).
+		Owns(&corev1.ServiceAccount{})
+	b = watchSelectedNamespaces[vmv1beta1.VMAuthList](b, r.Client)
+	b = watchReferencedObjects(b, vmAuthReferences)
+	return b.WithOptions(getDefaultOptions()).
 		Complete(r)
 }
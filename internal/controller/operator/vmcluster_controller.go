@@ -3,11 +3,15 @@ package operator
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/tracing"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/vmcluster"
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
@@ -38,19 +42,27 @@ func (r *VMClusterReconciler) Scheme() *runtime.Scheme {
 // +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmclusters/finalizers,verbs=*
 // +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=*
 func (r *VMClusterReconciler) Reconcile(ctx context.Context, request ctrl.Request) (result ctrl.Result, err error) {
+	if !isNamespaceOwned(ctx, request.Namespace) {
+		return
+	}
 	reqLogger := log.WithValues("vmcluster", request.Name, "namespace", request.Namespace)
 	ctx = logger.AddToContext(ctx, reqLogger)
+	ctx, span := tracing.Start(ctx, tracing.SpanReconcile, tracing.ObjectAttributes("vmcluster", request.Namespace, request.Name)...)
+	defer func() { tracing.End(span, err) }()
 	instance := &vmv1beta1.VMCluster{}
+	ctx = events.AddToContext(ctx, instance)
 
 	defer func() {
 		result, err = handleReconcileErr(ctx, r.Client, instance, result, err)
 	}()
+	defer recoverReconcilePanic("vmcluster", &err)
 
 	if err := r.Client.Get(ctx, request.NamespacedName, instance); err != nil {
 		return result, &getError{err, "vmcluster", request}
 	}
 
 	RegisterObjectStat(instance, "vmcluster")
+	checkLicenseExpiration(ctx, instance, "vmcluster", instance.Spec.License)
 
 	if !instance.DeletionTimestamp.IsZero() {
 		if err := finalize.OnVMClusterDelete(ctx, r.Client, instance); err != nil {
@@ -64,10 +76,43 @@ func (r *VMClusterReconciler) Reconcile(ctx context.Context, request ctrl.Reques
 	if err := finalize.AddFinalizer(ctx, r.Client, instance); err != nil {
 		return result, err
 	}
+	if err := applyOperatorSettings(ctx, r.Client, instance); err != nil {
+		return result, err
+	}
+	if err := applyResourceProfiles(instance); err != nil {
+		return result, err
+	}
 	r.Client.Scheme().Default(instance)
 
-	result, err = reconcileAndTrackStatus(ctx, r.Client, instance, func() (ctrl.Result, error) {
-		err = vmcluster.CreateOrUpdateVMCluster(ctx, instance, r.Client)
+	if !instance.Paused() {
+		changes, err := instance.DestructiveChanges()
+		if err != nil {
+			return result, err
+		}
+		if len(changes) > 0 {
+			if !vmv1beta1.IsDestructiveChangeConfirmed(instance) {
+				return result, &blockedError{changes: changes, controller: "vmcluster", generation: instance.Generation}
+			}
+			events.Normal(ctx, events.ReasonDestructiveChangeConfirmed, "applying confirmed destructive changes: %s", strings.Join(changes, "; "))
+		}
+	}
+
+	var drainLeft time.Duration
+	result, err = reconcileAndTrackStatus(ctx, r.Client, instance, func(ctx context.Context) (ctrl.Result, error) {
+		var imageTags []string
+		if instance.Spec.VMSelect != nil {
+			imageTags = append(imageTags, instance.Spec.VMSelect.Image.Tag)
+		}
+		if instance.Spec.VMInsert != nil {
+			imageTags = append(imageTags, instance.Spec.VMInsert.Image.Tag)
+		}
+		if instance.Spec.VMStorage != nil {
+			imageTags = append(imageTags, instance.Spec.VMStorage.Image.Tag)
+		}
+		if err := warnUnknownImageVersions(ctx, r.Client, instance, imageTags...); err != nil {
+			return result, err
+		}
+		drainLeft, err = vmcluster.CreateOrUpdateVMCluster(ctx, instance, r.Client)
 		if err != nil {
 			return result, fmt.Errorf("failed create or update vmcluster: %w", err)
 		}
@@ -77,16 +122,18 @@ func (r *VMClusterReconciler) Reconcile(ctx context.Context, request ctrl.Reques
 		return
 	}
 
-	result.RequeueAfter = r.BaseConf.ResyncAfterDuration()
+	// vmstorage drain must be finished even if periodic reconcile is disabled
+	result.RequeueAfter = requeueBefore(r.BaseConf, drainLeft)
 	return
 }
 
 // SetupWithManager general setup method
 func (r *VMClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&vmv1beta1.VMCluster{}).
 		Owns(&appsv1.Deployment{}).
-		Owns(&appsv1.StatefulSet{}).
-		WithOptions(getDefaultOptions()).
+		Owns(&appsv1.StatefulSet{})
+	b = watchSelectedNamespaces[vmv1beta1.VMClusterList](b, r.Client)
+	return b.WithOptions(getDefaultOptions()).
 		Complete(r)
 }
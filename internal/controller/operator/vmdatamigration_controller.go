@@ -0,0 +1,96 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"context"
+	"fmt"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/datamigration"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// VMDataMigrationReconciler reconciles a VMDataMigration object
+type VMDataMigrationReconciler struct {
+	client.Client
+	Log          logr.Logger
+	OriginScheme *runtime.Scheme
+}
+
+// Scheme implements interface.
+func (r *VMDataMigrationReconciler) Scheme() *runtime.Scheme {
+	return r.OriginScheme
+}
+
+// Reconcile implements interface
+// +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmdatamigrations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmdatamigrations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+func (r *VMDataMigrationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	l := r.Log.WithValues("vmdatamigration", req.Name, "namespace", req.Namespace)
+	ctx = logger.AddToContext(ctx, l)
+
+	defer func() {
+		result, err = handleReconcileErr(ctx, r.Client, nil, result, err)
+	}()
+	defer recoverReconcilePanic("vmdatamigration", &err)
+
+	var instance vmv1beta1.VMDataMigration
+	if err := r.Get(ctx, req.NamespacedName, &instance); err != nil {
+		return result, &getError{err, "vmdatamigration", req}
+	}
+	RegisterObjectStat(&instance, "vmdatamigration")
+
+	// migrations are executed only by the shard, which owns vmdatamigration namespace
+	if !isNamespaceOwned(ctx, instance.Namespace) {
+		return
+	}
+	ctx = events.AddToContext(ctx, &instance)
+	if !instance.DeletionTimestamp.IsZero() {
+		if err := finalize.OnVMDataMigrationDelete(ctx, r, &instance); err != nil {
+			return result, fmt.Errorf("cannot remove finalizer for vmdatamigration: %w", err)
+		}
+		return
+	}
+	if instance.Spec.ParsingError != "" {
+		return result, &parsingError{instance.Spec.ParsingError, "vmdatamigration"}
+	}
+	if err := finalize.AddFinalizer(ctx, r.Client, &instance); err != nil {
+		return result, err
+	}
+	if _, err := datamigration.CreateOrUpdateDataMigration(ctx, r, &instance); err != nil {
+		return result, fmt.Errorf("cannot run data migration: %w", err)
+	}
+	return
+}
+
+// SetupWithManager general setup method
+func (r *VMDataMigrationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vmv1beta1.VMDataMigration{}).
+		Owns(&batchv1.Job{}).
+		WithOptions(getDefaultOptions()).
+		Complete(r)
+}
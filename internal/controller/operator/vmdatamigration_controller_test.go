@@ -0,0 +1,100 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+var _ = Describe("VMDataMigration Controller", func() {
+	Context("When reconciling a resource", func() {
+		const resourceName = "test-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+		vmdatamigration := &vmv1beta1.VMDataMigration{}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind VMDataMigration")
+			err := k8sClient.Get(ctx, typeNamespacedName, vmdatamigration)
+			if err != nil && errors.IsNotFound(err) {
+				single := &vmv1beta1.VMSingle{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      resourceName,
+						Namespace: "default",
+					},
+				}
+				Expect(k8sClient.Create(ctx, single)).To(Succeed())
+				resource := &vmv1beta1.VMDataMigration{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      resourceName,
+						Namespace: "default",
+					},
+					Spec: vmv1beta1.VMDataMigrationSpec{
+						Source: vmv1beta1.DataMigrationSource{
+							Prometheus: &vmv1beta1.PrometheusMigrationSource{ClaimName: "prometheus-data", SnapshotPath: "snapshots/20240102"},
+						},
+						Destination: vmv1beta1.DataMigrationDestination{Kind: "VMSingle", Name: resourceName},
+						TimeRange: vmv1beta1.DataMigrationTimeRange{
+							Start: metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			}
+		})
+
+		AfterEach(func() {
+			resource := &vmv1beta1.VMDataMigration{}
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Cleanup the specific resource instance VMDataMigration")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			single := &vmv1beta1.VMSingle{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, single)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, single)).To(Succeed())
+		})
+		It("should successfully reconcile the resource", func() {
+			By("Reconciling the created resource")
+			controllerReconciler := &VMDataMigrationReconciler{
+				Client:       k8sClient,
+				OriginScheme: k8sClient.Scheme(),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
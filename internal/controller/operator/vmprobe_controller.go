@@ -21,11 +21,11 @@ import (
 	"fmt"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
-	"github.com/VictoriaMetrics/operator/internal/config"
-	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/vmagent"
 	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -52,13 +52,21 @@ func (r *VMProbeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 	defer func() {
 		result, err = handleReconcileErr(ctx, r.Client, nil, result, err)
 	}()
+	defer recoverReconcilePanic("vmprobescrape", &err)
 	// Fetch the VMPodScrape instance
 	instance := &vmv1beta1.VMProbe{}
 	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			deleteObjectState("vmprobe", req.NamespacedName)
+		}
 		return result, &getError{err, "vmprobescrape", req}
 	}
 
 	RegisterObjectStat(instance, "vmprobescrape")
+	if isObjectStateUnchanged("vmprobe", instance) {
+		// fast path, object was already processed
+		return
+	}
 	if vmAgentReconcileLimit.MustThrottleReconcile() {
 		// fast path, rate limited
 		return
@@ -67,37 +75,25 @@ func (r *VMProbeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 	vmAgentSync.Lock()
 	defer vmAgentSync.Unlock()
 
-	var objects vmv1beta1.VMAgentList
-	if err := k8stools.ListObjectsByNamespace(ctx, r.Client, config.MustGetWatchNamespaces(), func(dst *vmv1beta1.VMAgentList) {
-		objects.Items = append(objects.Items, dst.Items...)
-	}); err != nil {
-		return result, fmt.Errorf("cannot list vmauths for vmuser: %w", err)
+	vmagents, err := selectedVMAgents(ctx, r.Client, "vmprobe", instance)
+	if err != nil {
+		return result, fmt.Errorf("cannot select parent vmagents: %w", err)
 	}
 
-	for _, vmagentItem := range objects.Items {
-		if !vmagentItem.DeletionTimestamp.IsZero() || vmagentItem.Spec.ParsingError != "" || vmagentItem.IsUnmanaged() {
-			continue
-		}
-		currentVMagent := &vmagentItem
+	var isFailed bool
+	for _, currentVMagent := range vmagents {
 		reqLogger := reqLogger.WithValues("parent_vmagent", currentVMagent.Name, "parent_namespace", currentVMagent.Namespace)
 		ctx := logger.AddToContext(ctx, reqLogger)
-
-		// only check selector when deleting, since labels can be changed when updating and we can't tell if it was selected before.
-		if instance.DeletionTimestamp.IsZero() && !currentVMagent.Spec.SelectAllByDefault {
-			match, err := isSelectorsMatchesTargetCRD(ctx, r.Client, instance, currentVMagent, currentVMagent.Spec.ProbeSelector, currentVMagent.Spec.ProbeNamespaceSelector)
-			if err != nil {
-				reqLogger.Error(err, "cannot match vmagent and vmProbe")
-				continue
-			}
-			if !match {
-				continue
-			}
-		}
+		ctx = events.AddToContext(ctx, currentVMagent)
 
 		if err := vmagent.CreateOrUpdateConfigurationSecret(ctx, currentVMagent, r); err != nil {
+			isFailed = true
 			continue
 		}
 	}
+	if !isFailed {
+		storeObjectState("vmprobe", instance)
+	}
 	return
 }
 
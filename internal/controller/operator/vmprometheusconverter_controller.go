@@ -3,21 +3,29 @@ package operator
 import (
 	"context"
 	"fmt"
+	"runtime/debug"
+	"sync"
 	"time"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/config"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/converter"
 	converterv1alpha1 "github.com/VictoriaMetrics/operator/internal/controller/operator/converter/v1alpha1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	promv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -60,212 +68,458 @@ type ConverterController struct {
 	ctx             context.Context
 	baseClient      *kubernetes.Clientset
 	rclient         client.WithWatch
-	ruleInf         cache.SharedInformer
-	podInf          cache.SharedInformer
-	serviceInf      cache.SharedInformer
-	amConfigInf     cache.SharedInformer
-	probeInf        cache.SharedIndexInformer
-	scrapeConfigInf cache.SharedIndexInformer
-	baseConf        *config.BaseOperatorConf
+	ruleInf         *crdInformer
+	podInf          *crdInformer
+	serviceInf      *crdInformer
+	amConfigInf     *crdInformer
+	probeInf        *crdInformer
+	scrapeConfigInf *crdInformer
+
+	settingsMu sync.Mutex
+	settings   *converterSettings
+}
+
+// converterSettings holds prometheus converter settings parsed from operator configuration
+type converterSettings struct {
+	nsSelectorStr      string
+	transformRulesFile string
+	nsSelector         labels.Selector
+	transformRules     *converter.TransformRules
+}
+
+func newConverterSettings(baseConf *config.BaseOperatorConf) (*converterSettings, error) {
+	nsSelector, err := labels.Parse(baseConf.PrometheusConverterNamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse prometheus converter namespace selector: %w", err)
+	}
+	s := &converterSettings{
+		nsSelectorStr:      baseConf.PrometheusConverterNamespaceSelector,
+		transformRulesFile: baseConf.PrometheusConverterTransformRulesFile,
+		nsSelector:         nsSelector,
+	}
+	if baseConf.PrometheusConverterTransformRulesFile != "" {
+		trs, err := converter.LoadTransformRules(baseConf.PrometheusConverterTransformRulesFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load prometheus converter transform rules: %w", err)
+		}
+		s.transformRules = trs
+	}
+	return s, nil
 }
 
 // NewConverterController builder for vmprometheusconverter service
-func NewConverterController(ctx context.Context, baseClient *kubernetes.Clientset, rclient client.WithWatch, resyncPeriod time.Duration, baseConf *config.BaseOperatorConf) (*ConverterController, error) {
+func NewConverterController(ctx context.Context, baseClient *kubernetes.Clientset, rclient client.WithWatch, resyncPeriod time.Duration) (*ConverterController, error) {
 	c := &ConverterController{
 		ctx:        ctx,
 		baseClient: baseClient,
 		rclient:    rclient,
-		baseConf:   baseConf,
 	}
+	settings, err := newConverterSettings(config.MustGetBaseConfig())
+	if err != nil {
+		return nil, err
+	}
+	c.settings = settings
 
-	c.ruleInf = cache.NewSharedIndexInformer(
-		&cache.ListWatch{
-			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
-				var objects promv1.PrometheusRuleList
-				if err := k8stools.ListObjectsByNamespace(ctx, rclient, config.MustGetWatchNamespaces(), func(dst *promv1.PrometheusRuleList) {
-					objects.Items = append(objects.Items, dst.Items...)
-				}); err != nil {
-					return nil, fmt.Errorf("cannot list prometheus_rules: %w", err)
-				}
-				return &objects, nil
+	c.ruleInf = newCRDInformer(promv1.PrometheusRuleName+"."+promv1.SchemeGroupVersion.Group, func() (cache.SharedIndexInformer, error) {
+		inf := cache.NewSharedIndexInformer(
+			&cache.ListWatch{
+				ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+					var objects promv1.PrometheusRuleList
+					if err := k8stools.ListObjectsByNamespace(ctx, rclient, config.MustGetWatchNamespaces(), func(dst *promv1.PrometheusRuleList) {
+						objects.Items = append(objects.Items, dst.Items...)
+					}); err != nil {
+						return nil, fmt.Errorf("cannot list prometheus_rules: %w", err)
+					}
+					return &objects, nil
+				},
+				WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+					return k8stools.NewObjectWatcherForNamespaces[promv1.PrometheusRuleList](ctx, rclient, "prometheus_rules", config.MustGetWatchNamespaces())
+				},
 			},
-			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
-				return k8stools.NewObjectWatcherForNamespaces[promv1.PrometheusRuleList](ctx, rclient, "prometheus_rules", config.MustGetWatchNamespaces())
+			&promv1.PrometheusRule{},
+			resyncPeriod,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		)
+		if _, err := inf.AddEventHandler(c.withShardFilter(withoutExportedObjects(c.withNamespaceSelector(c.withPanicRecovery("prometheus_rule", cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.CreatePrometheusRule,
+			UpdateFunc: c.UpdatePrometheusRule,
+		}))))); err != nil {
+			return nil, fmt.Errorf("cannot add prometheus_rule handler: %w", err)
+		}
+		return inf, nil
+	})
+	c.podInf = newCRDInformer(promv1.PodMonitorName+"."+promv1.SchemeGroupVersion.Group, func() (cache.SharedIndexInformer, error) {
+		inf := cache.NewSharedIndexInformer(
+			&cache.ListWatch{
+				ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+					var objects promv1.PodMonitorList
+					if err := k8stools.ListObjectsByNamespace(ctx, rclient, config.MustGetWatchNamespaces(), func(dst *promv1.PodMonitorList) {
+						objects.Items = append(objects.Items, dst.Items...)
+					}); err != nil {
+						return nil, fmt.Errorf("cannot list pod_monitors: %w", err)
+					}
+					return &objects, nil
+				},
+				WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+					return k8stools.NewObjectWatcherForNamespaces[promv1.PodMonitorList](ctx, rclient, "pod_monitors", config.MustGetWatchNamespaces())
+				},
 			},
-		},
-		&promv1.PrometheusRule{},
-		resyncPeriod,
-		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
-	)
-	if _, err := c.ruleInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    c.CreatePrometheusRule,
-		UpdateFunc: c.UpdatePrometheusRule,
-	}); err != nil {
-		return nil, fmt.Errorf("cannot add prometheus_rule handler: %w", err)
-	}
-	c.podInf = cache.NewSharedIndexInformer(
-		&cache.ListWatch{
-			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
-				var objects promv1.PodMonitorList
-				if err := k8stools.ListObjectsByNamespace(ctx, rclient, config.MustGetWatchNamespaces(), func(dst *promv1.PodMonitorList) {
-					objects.Items = append(objects.Items, dst.Items...)
-				}); err != nil {
-					return nil, fmt.Errorf("cannot list pod_monitors: %w", err)
-				}
-				return &objects, nil
+			&promv1.PodMonitor{},
+			resyncPeriod,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		)
+		if _, err := inf.AddEventHandler(c.withShardFilter(c.withNamespaceSelector(c.withPanicRecovery("pod_monitor", cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.CreatePodMonitor,
+			UpdateFunc: c.UpdatePodMonitor,
+		})))); err != nil {
+			return nil, fmt.Errorf("cannot add pod_monitor handler: %w", err)
+		}
+		return inf, nil
+	})
+	c.serviceInf = newCRDInformer(promv1.ServiceMonitorName+"."+promv1.SchemeGroupVersion.Group, func() (cache.SharedIndexInformer, error) {
+		inf := cache.NewSharedIndexInformer(
+			&cache.ListWatch{
+				ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+					var objects promv1.ServiceMonitorList
+					if err := k8stools.ListObjectsByNamespace(ctx, rclient, config.MustGetWatchNamespaces(), func(dst *promv1.ServiceMonitorList) {
+						objects.Items = append(objects.Items, dst.Items...)
+					}); err != nil {
+						return nil, fmt.Errorf("cannot list service_monitors: %w", err)
+					}
+					return &objects, nil
+				},
+				WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+					return k8stools.NewObjectWatcherForNamespaces[promv1.ServiceMonitorList](ctx, rclient, "service_monitors", config.MustGetWatchNamespaces())
+				},
 			},
-			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
-				return k8stools.NewObjectWatcherForNamespaces[promv1.PodMonitorList](ctx, rclient, "pod_monitors", config.MustGetWatchNamespaces())
+			&promv1.ServiceMonitor{},
+			resyncPeriod,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		)
+		if _, err := inf.AddEventHandler(c.withShardFilter(withoutExportedObjects(c.withNamespaceSelector(c.withPanicRecovery("service_monitor", cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.CreateServiceMonitor,
+			UpdateFunc: c.UpdateServiceMonitor,
+		}))))); err != nil {
+			return nil, fmt.Errorf("cannot add service_monitor handler: %w", err)
+		}
+		return inf, nil
+	})
+
+	c.amConfigInf = newCRDInformer(promv1alpha1.AlertmanagerConfigName+"."+promv1alpha1.SchemeGroupVersion.Group, func() (cache.SharedIndexInformer, error) {
+		inf := cache.NewSharedIndexInformer(
+			&cache.ListWatch{
+				ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+					var objects promv1alpha1.AlertmanagerConfigList
+					if err := k8stools.ListObjectsByNamespace(ctx, rclient, config.MustGetWatchNamespaces(), func(dst *promv1alpha1.AlertmanagerConfigList) {
+						objects.Items = append(objects.Items, dst.Items...)
+					}); err != nil {
+						return nil, fmt.Errorf("cannot list alertmanager_configs: %w", err)
+					}
+					return &objects, nil
+				},
+				WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+					return k8stools.NewObjectWatcherForNamespaces[promv1alpha1.AlertmanagerConfigList](ctx, rclient, "alertmanager_configs", config.MustGetWatchNamespaces())
+				},
 			},
-		},
-		&promv1.PodMonitor{},
-		resyncPeriod,
-		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
-	)
-	if _, err := c.podInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    c.CreatePodMonitor,
-		UpdateFunc: c.UpdatePodMonitor,
-	}); err != nil {
-		return nil, fmt.Errorf("cannot add pod_monitor handler: %w", err)
-	}
-	c.serviceInf = cache.NewSharedIndexInformer(
+			&promv1alpha1.AlertmanagerConfig{},
+			resyncPeriod,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		)
+		if _, err := inf.AddEventHandler(c.withShardFilter(c.withNamespaceSelector(c.withPanicRecovery("alertmanager_config", cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.CreateAlertmanagerConfig,
+			UpdateFunc: c.UpdateAlertmanagerConfig,
+		})))); err != nil {
+			return nil, fmt.Errorf("cannot add alertmanager_config handler: %w", err)
+		}
+		return inf, nil
+	})
 
-		&cache.ListWatch{
-			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
-				var objects promv1.ServiceMonitorList
-				if err := k8stools.ListObjectsByNamespace(ctx, rclient, config.MustGetWatchNamespaces(), func(dst *promv1.ServiceMonitorList) {
-					objects.Items = append(objects.Items, dst.Items...)
-				}); err != nil {
-					return nil, fmt.Errorf("cannot list service_monitors: %w", err)
-				}
-				return &objects, nil
+	c.probeInf = newCRDInformer(promv1.ProbeName+"."+promv1.SchemeGroupVersion.Group, func() (cache.SharedIndexInformer, error) {
+		inf := cache.NewSharedIndexInformer(
+			&cache.ListWatch{
+				ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+					var objects promv1.ProbeList
+					if err := k8stools.ListObjectsByNamespace(ctx, rclient, config.MustGetWatchNamespaces(), func(dst *promv1.ProbeList) {
+						objects.Items = append(objects.Items, dst.Items...)
+					}); err != nil {
+						return nil, fmt.Errorf("cannot list probes: %w", err)
+					}
+					return &objects, nil
+				},
+				WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+					return k8stools.NewObjectWatcherForNamespaces[promv1.ProbeList](ctx, rclient, "probes", config.MustGetWatchNamespaces())
+				},
 			},
-			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
-				return k8stools.NewObjectWatcherForNamespaces[promv1.ServiceMonitorList](ctx, rclient, "service_monitors", config.MustGetWatchNamespaces())
+			&promv1.Probe{},
+			resyncPeriod,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		)
+		if _, err := inf.AddEventHandler(c.withShardFilter(c.withNamespaceSelector(c.withPanicRecovery("probe", cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.CreateProbe,
+			UpdateFunc: c.UpdateProbe,
+		})))); err != nil {
+			return nil, fmt.Errorf("cannot add probe handler: %w", err)
+		}
+		return inf, nil
+	})
+	c.scrapeConfigInf = newCRDInformer(promv1alpha1.ScrapeConfigName+"."+promv1alpha1.SchemeGroupVersion.Group, func() (cache.SharedIndexInformer, error) {
+		inf := cache.NewSharedIndexInformer(
+			&cache.ListWatch{
+				ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+					var objects promv1alpha1.ScrapeConfigList
+					if err := k8stools.ListObjectsByNamespace(ctx, rclient, config.MustGetWatchNamespaces(), func(dst *promv1alpha1.ScrapeConfigList) {
+						objects.Items = append(objects.Items, dst.Items...)
+					}); err != nil {
+						return nil, fmt.Errorf("cannot list scrapeConfig: %w", err)
+					}
+					return &objects, nil
+				},
+				WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+					return k8stools.NewObjectWatcherForNamespaces[promv1alpha1.ScrapeConfigList](ctx, rclient, "scrape_configs", config.MustGetWatchNamespaces())
+				},
 			},
+			&promv1alpha1.ScrapeConfig{},
+			resyncPeriod,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		)
+		if _, err := inf.AddEventHandler(c.withShardFilter(c.withNamespaceSelector(c.withPanicRecovery("scrape_config", cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.CreateScrapeConfig,
+			UpdateFunc: c.UpdateScrapeConfig,
+		})))); err != nil {
+			return nil, fmt.Errorf("cannot add scrapeConfig handler: %w", err)
+		}
+		return inf, nil
+	})
+	return c, nil
+}
+
+// getSettings returns converter settings for the given configuration.
+// Settings are rebuilt only if namespace selector or transform rules file were changed by configuration reload,
+// previous settings are kept if new ones are invalid
+func (c *ConverterController) getSettings(baseConf *config.BaseOperatorConf) *converterSettings {
+	c.settingsMu.Lock()
+	defer c.settingsMu.Unlock()
+	prev := c.settings
+	if prev != nil && prev.nsSelectorStr == baseConf.PrometheusConverterNamespaceSelector && prev.transformRulesFile == baseConf.PrometheusConverterTransformRulesFile {
+		return prev
+	}
+	settings, err := newConverterSettings(baseConf)
+	if err != nil {
+		log.Error(err, "cannot apply reloaded prometheus converter configuration, using previous one")
+		if prev == nil {
+			prev = &converterSettings{nsSelector: labels.Everything()}
+		}
+		// do not retry it on each event until configuration is changed again
+		settings = &converterSettings{
+			nsSelectorStr:      baseConf.PrometheusConverterNamespaceSelector,
+			transformRulesFile: baseConf.PrometheusConverterTransformRulesFile,
+			nsSelector:         prev.nsSelector,
+			transformRules:     prev.transformRules,
+		}
+	}
+	c.settings = settings
+	return settings
+}
+
+// withShardFilter skips objects from namespaces owned by other operator replicas
+func (c *ConverterController) withShardFilter(h cache.ResourceEventHandler) cache.ResourceEventHandler {
+	return cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			o, ok := obj.(client.Object)
+			return !ok || isNamespaceOwned(c.ctx, o.GetNamespace())
 		},
-		&promv1.ServiceMonitor{},
-		resyncPeriod,
-		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
-	)
-	if _, err := c.serviceInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    c.CreateServiceMonitor,
-		UpdateFunc: c.UpdateServiceMonitor,
-	}); err != nil {
-		return nil, fmt.Errorf("cannot add service_monitor handler: %w", err)
+		Handler: h,
 	}
+}
 
-	amConfigInf := cache.NewSharedIndexInformer(
-		&cache.ListWatch{
-			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
-				var objects promv1alpha1.AlertmanagerConfigList
-				if err := k8stools.ListObjectsByNamespace(ctx, rclient, config.MustGetWatchNamespaces(), func(dst *promv1alpha1.AlertmanagerConfigList) {
-					objects.Items = append(objects.Items, dst.Items...)
-				}); err != nil {
-					return nil, fmt.Errorf("cannot list alertmanager_configs: %w", err)
-				}
-				return &objects, nil
-			},
-			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
-				return k8stools.NewObjectWatcherForNamespaces[promv1alpha1.AlertmanagerConfigList](ctx, rclient, "alertmanager_configs", config.MustGetWatchNamespaces())
-			},
+// withoutExportedObjects skips prometheus objects exported from VictoriaMetrics objects,
+// it prevents conversion loops with enabled EnabledPrometheusExportConverter
+func withoutExportedObjects(h cache.ResourceEventHandler) cache.ResourceEventHandler {
+	return cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			o, ok := obj.(client.Object)
+			return !ok || !converter.IsExported(o)
 		},
-		&promv1alpha1.AlertmanagerConfig{},
-		resyncPeriod,
-		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
-	)
-	if _, err := amConfigInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    c.CreateAlertmanagerConfig,
-		UpdateFunc: c.UpdateAlertmanagerConfig,
-	}); err != nil {
-		return nil, fmt.Errorf("cannot add alertmanager_config handler: %w", err)
+		Handler: h,
 	}
-	c.amConfigInf = amConfigInf
+}
 
-	c.probeInf = cache.NewSharedIndexInformer(
-		&cache.ListWatch{
-			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
-				var objects promv1.ProbeList
-				if err := k8stools.ListObjectsByNamespace(ctx, rclient, config.MustGetWatchNamespaces(), func(dst *promv1.ProbeList) {
-					objects.Items = append(objects.Items, dst.Items...)
-				}); err != nil {
-					return nil, fmt.Errorf("cannot list probes: %w", err)
-				}
-				return &objects, nil
-			},
-			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
-				return k8stools.NewObjectWatcherForNamespaces[promv1.ProbeList](ctx, rclient, "probes", config.MustGetWatchNamespaces())
-			},
+// withNamespaceSelector skips objects from namespaces not matched by prometheus converter namespace selector
+// selector and namespace labels are checked on each event, so namespaces opted in later are converted on the next resync
+func (c *ConverterController) withNamespaceSelector(h cache.ResourceEventHandler) cache.ResourceEventHandler {
+	return cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			o, ok := obj.(client.Object)
+			if !ok {
+				return true
+			}
+			nsSelector := c.getSettings(config.MustGetBaseConfig()).nsSelector
+			return nsSelector.Empty() || c.isNamespaceSelected(nsSelector, o.GetNamespace())
 		},
-		&promv1.Probe{},
-		resyncPeriod,
-		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
-	)
-	if _, err := c.probeInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    c.CreateProbe,
-		UpdateFunc: c.UpdateProbe,
-	}); err != nil {
-		return nil, fmt.Errorf("cannot add probe handler: %w", err)
+		Handler: h,
+	}
+}
+
+func (c *ConverterController) isNamespaceSelected(nsSelector labels.Selector, namespace string) bool {
+	var ns corev1.Namespace
+	if err := c.rclient.Get(c.ctx, types.NamespacedName{Name: namespace}, &ns); err != nil {
+		log.Error(err, "cannot get namespace for prometheus converter namespace selector, skipping conversion", "namespace", namespace)
+		return false
 	}
-	c.scrapeConfigInf = cache.NewSharedIndexInformer(
+	return nsSelector.Matches(labels.Set(ns.Labels))
+}
+
+// withPanicRecovery isolates panics of informer event handlers,
+// so a single malformed object cannot stop processing of other objects.
+// It also records duration of event handling to operator_prometheus_converter_event_duration_seconds
+func (c *ConverterController) withPanicRecovery(informer string, h cache.ResourceEventHandlerFuncs) cache.ResourceEventHandlerFuncs {
+	duration := converterEventDuration.WithLabelValues(informer)
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			defer observeDuration(duration, time.Now())
+			defer c.recoverEventHandlerPanic(informer, obj)
+			h.AddFunc(obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			defer observeDuration(duration, time.Now())
+			defer c.recoverEventHandlerPanic(informer, newObj)
+			h.UpdateFunc(oldObj, newObj)
+		},
+	}
+}
+
+func observeDuration(o prometheus.Observer, startedAt time.Time) {
+	o.Observe(time.Since(startedAt).Seconds())
+}
+
+// recoverEventHandlerPanic must be deferred by informer event handler
+// status of prometheus objects is not managed by operator, so object is marked with Degraded event
+func (c *ConverterController) recoverEventHandlerPanic(informer string, obj interface{}) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	pe := &panicError{origin: r, controller: informer, stack: debug.Stack()}
+	panicsRecoveredTotal.WithLabelValues(informer).Inc()
+	l := log.WithValues("informer", informer)
+	if o, ok := obj.(client.Object); ok {
+		l = l.WithValues("name", o.GetName(), "namespace", o.GetNamespace())
+		events.Warning(events.AddToContext(c.ctx, o), events.ReasonDegraded, "%s", pe.Error())
+	}
+	l.Error(pe, "recovered panic at event handler", "stack", string(pe.stack))
+}
+
+// crdInformer runs informer for prometheus-operator objects only while the corresponding CRD is established
+// stopped informer cannot be started again, so it's re-created on each start
+type crdInformer struct {
+	crdName     string
+	newInformer func() (cache.SharedIndexInformer, error)
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+}
+
+func newCRDInformer(crdName string, newInformer func() (cache.SharedIndexInformer, error)) *crdInformer {
+	return &crdInformer{crdName: crdName, newInformer: newInformer}
+}
+
+// start runs new informer, if it's not running yet
+func (ci *crdInformer) start(ctx context.Context) {
+	ci.mu.Lock()
+	defer ci.mu.Unlock()
+	if ci.cancel != nil {
+		return
+	}
+	inf, err := ci.newInformer()
+	if err != nil {
+		log.Error(err, "cannot create informer", "crd", ci.crdName)
+		return
+	}
+	log.Info("starting informer, CRD is established", "crd", ci.crdName)
+	infCtx, cancel := context.WithCancel(ctx)
+	ci.cancel = cancel
+	go inf.Run(infCtx.Done())
+}
+
+// stop stops running informer
+func (ci *crdInformer) stop() {
+	ci.mu.Lock()
+	defer ci.mu.Unlock()
+	if ci.cancel == nil {
+		return
+	}
+	log.Info("stopping informer, CRD is not available", "crd", ci.crdName)
+	ci.cancel()
+	ci.cancel = nil
+}
+
+func (ci *crdInformer) isRunning() bool {
+	ci.mu.Lock()
+	defer ci.mu.Unlock()
+	return ci.cancel != nil
+}
+
+// sync starts or stops informer according to the given CRD state
+func (ci *crdInformer) sync(ctx context.Context, obj interface{}) {
+	crd, ok := obj.(*apiextensionsv1.CustomResourceDefinition)
+	if !ok || crd.Name != ci.crdName {
+		return
+	}
+	if crd.DeletionTimestamp.IsZero() && isCRDEstablished(crd) {
+		ci.start(ctx)
+		return
+	}
+	ci.stop()
+}
+
+func isCRDEstablished(crd *apiextensionsv1.CustomResourceDefinition) bool {
+	for _, cond := range crd.Status.Conditions {
+		if cond.Type == apiextensionsv1.Established {
+			return cond.Status == apiextensionsv1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// runInformerWithCRDWatch watches for the CRD of informer objects
+// informer is started after CRD creation and stopped after CRD deletion
+// it allows to install prometheus-operator CRDs without operator restart
+func (c *ConverterController) runInformerWithCRDWatch(ctx context.Context, ci *crdInformer) error {
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", ci.crdName)
+	crdInf := cache.NewSharedIndexInformer(
 		&cache.ListWatch{
 			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
-				var objects promv1alpha1.ScrapeConfigList
-				if err := k8stools.ListObjectsByNamespace(ctx, rclient, config.MustGetWatchNamespaces(), func(dst *promv1alpha1.ScrapeConfigList) {
-					objects.Items = append(objects.Items, dst.Items...)
-				}); err != nil {
-					return nil, fmt.Errorf("cannot list scrapeConfig: %w", err)
+				var objects apiextensionsv1.CustomResourceDefinitionList
+				if err := c.rclient.List(ctx, &objects, &client.ListOptions{FieldSelector: fieldSelector}); err != nil {
+					return nil, fmt.Errorf("cannot list customresourcedefinitions: %w", err)
 				}
 				return &objects, nil
 			},
 			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
-				return k8stools.NewObjectWatcherForNamespaces[promv1alpha1.ScrapeConfigList](ctx, rclient, "scrape_configs", config.MustGetWatchNamespaces())
+				options.FieldSelector = fieldSelector.String()
+				return c.rclient.Watch(ctx, &apiextensionsv1.CustomResourceDefinitionList{}, &client.ListOptions{Raw: &options})
 			},
 		},
-		&promv1alpha1.ScrapeConfig{},
-		resyncPeriod,
-		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		&apiextensionsv1.CustomResourceDefinition{},
+		0,
+		cache.Indexers{},
 	)
-	if _, err := c.scrapeConfigInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    c.CreateScrapeConfig,
-		UpdateFunc: c.UpdateScrapeConfig,
+	if _, err := crdInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			ci.sync(ctx, obj)
+		},
+		UpdateFunc: func(_, new interface{}) {
+			ci.sync(ctx, new)
+		},
+		DeleteFunc: func(obj interface{}) {
+			ci.stop()
+		},
 	}); err != nil {
-		return nil, fmt.Errorf("cannot add scrapeConfig handler: %w", err)
-	}
-	return c, nil
-}
-
-func waitForAPIResource(ctx context.Context, client discovery.DiscoveryInterface, apiGroupVersion, kind string) {
-	l := log.WithValues("group", apiGroupVersion, "kind", kind)
-	l.Info("waiting for api resource")
-	tick := time.NewTicker(time.Second * 5)
-	for {
-		select {
-		case <-tick.C:
-			api, err := client.ServerResourcesForGroupVersion(apiGroupVersion)
-			if err != nil {
-				if !errors.IsNotFound(err) {
-					l.Error(err, "cannot get server resource for api group version")
-				}
-				continue
-			}
-			for _, r := range api.APIResources {
-				if r.Kind != kind {
-					continue
-				}
-				l.Info("api resource is ready")
-				return
-			}
-		case <-ctx.Done():
-			l.Info("context was canceled")
-			return
-		}
+		return fmt.Errorf("cannot add customresourcedefinition handler for crd=%q: %w", ci.crdName, err)
 	}
-}
-
-func (c *ConverterController) runInformerWithDiscovery(ctx context.Context, group, kind string, runInformer func(<-chan struct{})) error {
-	waitForAPIResource(ctx, c.baseClient, group, kind)
-	runInformer(ctx.Done())
+	log.Info("waiting for CRD to be established", "crd", ci.crdName)
+	crdInf.Run(ctx.Done())
+	ci.stop()
 	return nil
 }
 
@@ -284,37 +538,38 @@ func (c *ConverterController) Start(ctx context.Context) error {
 	return nil
 }
 
-// Run - starts vmprometheusconverter with background discovery process for each prometheus api object
+// Run - starts vmprometheusconverter with background CRD watch process for each prometheus api object
 func (c *ConverterController) Run(ctx context.Context, group *errgroup.Group) {
-	if c.baseConf.EnabledPrometheusConverter.ServiceScrape {
+	baseConf := config.MustGetBaseConfig()
+	if baseConf.EnabledPrometheusConverter.ServiceScrape {
 		group.Go(func() error {
-			return c.runInformerWithDiscovery(ctx, promv1.SchemeGroupVersion.String(), promv1.ServiceMonitorsKind, c.serviceInf.Run)
+			return c.runInformerWithCRDWatch(ctx, c.serviceInf)
 		})
 	}
-	if c.baseConf.EnabledPrometheusConverter.PodMonitor {
+	if baseConf.EnabledPrometheusConverter.PodMonitor {
 		group.Go(func() error {
-			return c.runInformerWithDiscovery(ctx, promv1.SchemeGroupVersion.String(), promv1.PodMonitorsKind, c.podInf.Run)
+			return c.runInformerWithCRDWatch(ctx, c.podInf)
 		})
 	}
-	if c.baseConf.EnabledPrometheusConverter.PrometheusRule {
+	if baseConf.EnabledPrometheusConverter.PrometheusRule {
 		group.Go(func() error {
-			return c.runInformerWithDiscovery(ctx, promv1.SchemeGroupVersion.String(), promv1.PrometheusRuleKind, c.ruleInf.Run)
+			return c.runInformerWithCRDWatch(ctx, c.ruleInf)
 		})
 	}
-	if c.baseConf.EnabledPrometheusConverter.Probe {
+	if baseConf.EnabledPrometheusConverter.Probe {
 		group.Go(func() error {
-			return c.runInformerWithDiscovery(ctx, promv1.SchemeGroupVersion.String(), promv1.ProbesKind, c.probeInf.Run)
+			return c.runInformerWithCRDWatch(ctx, c.probeInf)
 		})
 	}
 
-	if c.baseConf.EnabledPrometheusConverter.AlertmanagerConfig {
+	if baseConf.EnabledPrometheusConverter.AlertmanagerConfig {
 		group.Go(func() error {
-			return c.runInformerWithDiscovery(ctx, promv1alpha1.SchemeGroupVersion.String(), promv1alpha1.AlertmanagerConfigKind, c.amConfigInf.Run)
+			return c.runInformerWithCRDWatch(ctx, c.amConfigInf)
 		})
 	}
-	if c.baseConf.EnabledPrometheusConverter.ScrapeConfig {
+	if baseConf.EnabledPrometheusConverter.ScrapeConfig {
 		group.Go(func() error {
-			return c.runInformerWithDiscovery(ctx, promv1alpha1.SchemeGroupVersion.String(), promv1alpha1.ScrapeConfigsKind, c.scrapeConfigInf.Run)
+			return c.runInformerWithCRDWatch(ctx, c.scrapeConfigInf)
 		})
 	}
 }
@@ -323,8 +578,9 @@ func (c *ConverterController) Run(ctx context.Context, group *errgroup.Group) {
 func (c *ConverterController) CreatePrometheusRule(rule interface{}) {
 	promRule := rule.(*promv1.PrometheusRule)
 	l := log.WithValues("kind", "alertRule", "name", promRule.Name, "ns", promRule.Namespace)
-	cr := converter.ConvertPromRule(promRule, c.baseConf)
+	cr := converter.ConvertPromRule(promRule, config.MustGetBaseConfig())
 
+	reconcile.SetSpecHash(cr, reconcile.SpecHash(cr, &cr.Spec))
 	err := c.rclient.Create(context.Background(), cr)
 	if err != nil {
 		if errors.IsAlreadyExists(err) {
@@ -340,9 +596,11 @@ func (c *ConverterController) CreatePrometheusRule(rule interface{}) {
 func (c *ConverterController) UpdatePrometheusRule(_old, new interface{}) {
 	promRuleNew := new.(*promv1.PrometheusRule)
 	l := log.WithValues("kind", "VMRule", "name", promRuleNew.Name, "ns", promRuleNew.Namespace)
-	vmRule := converter.ConvertPromRule(promRuleNew, c.baseConf)
+	vmRule := converter.ConvertPromRule(promRuleNew, config.MustGetBaseConfig())
 	ctx := context.Background()
 	existingVMRule := &vmv1beta1.VMRule{}
+	specHash := reconcile.SpecHash(vmRule, &vmRule.Spec)
+	reconcile.SetSpecHash(vmRule, specHash)
 	err := c.rclient.Get(ctx, types.NamespacedName{Name: vmRule.Name, Namespace: vmRule.Namespace}, existingVMRule)
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -357,9 +615,13 @@ func (c *ConverterController) UpdatePrometheusRule(_old, new interface{}) {
 		l.Info("syncing for object was disabled by annotation", "annotation", IgnoreConversionLabel)
 		return
 	}
+	if reconcile.IsOwnedStateUnchanged("VMRule", existingVMRule, vmRule, &existingVMRule.Spec, &vmRule.Spec) {
+		return
+	}
 	metaMergeStrategy := getMetaMergeStrategy(existingVMRule.Annotations)
 	vmRule.Annotations = mergeLabelsWithStrategy(existingVMRule.Annotations, vmRule.Annotations, metaMergeStrategy)
 	vmRule.Labels = mergeLabelsWithStrategy(existingVMRule.Labels, vmRule.Labels, metaMergeStrategy)
+	reconcile.SetSpecHash(vmRule, specHash)
 
 	if equality.Semantic.DeepEqual(vmRule.Spec, existingVMRule.Spec) &&
 		isMetaEqual(vmRule, existingVMRule) {
@@ -381,7 +643,12 @@ func (c *ConverterController) UpdatePrometheusRule(_old, new interface{}) {
 func (c *ConverterController) CreateServiceMonitor(service interface{}) {
 	serviceMon := service.(*promv1.ServiceMonitor)
 	l := log.WithValues("kind", "vmServiceScrape", "name", serviceMon.Name, "ns", serviceMon.Namespace)
-	vmServiceScrape := converter.ConvertServiceMonitor(serviceMon, c.baseConf)
+	baseConf := config.MustGetBaseConfig()
+	vmServiceScrape := converter.ConvertServiceMonitor(serviceMon, baseConf)
+	if !c.getSettings(baseConf).transformRules.TransformServiceScrape(serviceMon, vmServiceScrape) {
+		return
+	}
+	reconcile.SetSpecHash(vmServiceScrape, reconcile.SpecHash(vmServiceScrape, &vmServiceScrape.Spec))
 	err := c.rclient.Create(context.Background(), vmServiceScrape)
 	if err != nil {
 		if errors.IsAlreadyExists(err) {
@@ -397,9 +664,15 @@ func (c *ConverterController) CreateServiceMonitor(service interface{}) {
 func (c *ConverterController) UpdateServiceMonitor(_, new interface{}) {
 	serviceMonNew := new.(*promv1.ServiceMonitor)
 	l := log.WithValues("kind", "vmServiceScrape", "name", serviceMonNew.Name, "ns", serviceMonNew.Namespace)
-	vmServiceScrape := converter.ConvertServiceMonitor(serviceMonNew, c.baseConf)
+	baseConf := config.MustGetBaseConfig()
+	vmServiceScrape := converter.ConvertServiceMonitor(serviceMonNew, baseConf)
+	if !c.getSettings(baseConf).transformRules.TransformServiceScrape(serviceMonNew, vmServiceScrape) {
+		return
+	}
 	existingVMServiceScrape := &vmv1beta1.VMServiceScrape{}
 	ctx := context.Background()
+	specHash := reconcile.SpecHash(vmServiceScrape, &vmServiceScrape.Spec)
+	reconcile.SetSpecHash(vmServiceScrape, specHash)
 	err := c.rclient.Get(ctx, types.NamespacedName{Name: vmServiceScrape.Name, Namespace: vmServiceScrape.Namespace}, existingVMServiceScrape)
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -415,10 +688,14 @@ func (c *ConverterController) UpdateServiceMonitor(_, new interface{}) {
 		l.Info("syncing for object was disabled by annotation", "annotation", IgnoreConversionLabel)
 		return
 	}
+	if reconcile.IsOwnedStateUnchanged("VMServiceScrape", existingVMServiceScrape, vmServiceScrape, &existingVMServiceScrape.Spec, &vmServiceScrape.Spec) {
+		return
+	}
 
 	metaMergeStrategy := getMetaMergeStrategy(existingVMServiceScrape.Annotations)
 	vmServiceScrape.Annotations = mergeLabelsWithStrategy(existingVMServiceScrape.Annotations, vmServiceScrape.Annotations, metaMergeStrategy)
 	vmServiceScrape.Labels = mergeLabelsWithStrategy(existingVMServiceScrape.Labels, vmServiceScrape.Labels, metaMergeStrategy)
+	reconcile.SetSpecHash(vmServiceScrape, specHash)
 	if equality.Semantic.DeepEqual(vmServiceScrape.Spec, existingVMServiceScrape.Spec) &&
 		isMetaEqual(vmServiceScrape, existingVMServiceScrape) {
 		return
@@ -439,7 +716,12 @@ func (c *ConverterController) UpdateServiceMonitor(_, new interface{}) {
 func (c *ConverterController) CreatePodMonitor(pod interface{}) {
 	podMonitor := pod.(*promv1.PodMonitor)
 	l := log.WithValues("kind", "podScrape", "name", podMonitor.Name, "ns", podMonitor.Namespace)
-	podScrape := converter.ConvertPodMonitor(podMonitor, c.baseConf)
+	baseConf := config.MustGetBaseConfig()
+	podScrape := converter.ConvertPodMonitor(podMonitor, baseConf)
+	if !c.getSettings(baseConf).transformRules.TransformPodScrape(podMonitor, podScrape) {
+		return
+	}
+	reconcile.SetSpecHash(podScrape, reconcile.SpecHash(podScrape, &podScrape.Spec))
 	err := c.rclient.Create(c.ctx, podScrape)
 	if err != nil {
 		if errors.IsAlreadyExists(err) {
@@ -455,9 +737,15 @@ func (c *ConverterController) CreatePodMonitor(pod interface{}) {
 func (c *ConverterController) UpdatePodMonitor(_, new interface{}) {
 	podMonitorNew := new.(*promv1.PodMonitor)
 	l := log.WithValues("kind", "podScrape", "name", podMonitorNew.Name, "ns", podMonitorNew.Namespace)
-	podScrape := converter.ConvertPodMonitor(podMonitorNew, c.baseConf)
+	baseConf := config.MustGetBaseConfig()
+	podScrape := converter.ConvertPodMonitor(podMonitorNew, baseConf)
+	if !c.getSettings(baseConf).transformRules.TransformPodScrape(podMonitorNew, podScrape) {
+		return
+	}
 	ctx := context.Background()
 	existingVMPodScrape := &vmv1beta1.VMPodScrape{}
+	specHash := reconcile.SpecHash(podScrape, &podScrape.Spec)
+	reconcile.SetSpecHash(podScrape, specHash)
 	err := c.rclient.Get(ctx, types.NamespacedName{Name: podScrape.Name, Namespace: podScrape.Namespace}, existingVMPodScrape)
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -472,10 +760,14 @@ func (c *ConverterController) UpdatePodMonitor(_, new interface{}) {
 		l.Info("syncing for object was disabled by annotation", "annotation", IgnoreConversionLabel)
 		return
 	}
+	if reconcile.IsOwnedStateUnchanged("VMPodScrape", existingVMPodScrape, podScrape, &existingVMPodScrape.Spec, &podScrape.Spec) {
+		return
+	}
 
 	mergeStrategy := getMetaMergeStrategy(existingVMPodScrape.Annotations)
 	podScrape.Annotations = mergeLabelsWithStrategy(existingVMPodScrape.Annotations, podScrape.Annotations, mergeStrategy)
 	podScrape.Labels = mergeLabelsWithStrategy(existingVMPodScrape.Labels, podScrape.Labels, mergeStrategy)
+	reconcile.SetSpecHash(podScrape, specHash)
 	if equality.Semantic.DeepEqual(podScrape.Spec, existingVMPodScrape.Spec) &&
 		isMetaEqual(podScrape, existingVMPodScrape) {
 		return
@@ -498,7 +790,7 @@ func (c *ConverterController) CreateAlertmanagerConfig(amc interface{}) {
 	var err error
 	switch promAMc := amc.(type) {
 	case *promv1alpha1.AlertmanagerConfig:
-		vmAMc, err = converterv1alpha1.ConvertAlertmanagerConfig(promAMc, c.baseConf)
+		vmAMc, err = converterv1alpha1.ConvertAlertmanagerConfig(promAMc, config.MustGetBaseConfig())
 	default:
 		err = fmt.Errorf("scrape config of type %t is not supported", promAMc)
 	}
@@ -507,6 +799,7 @@ func (c *ConverterController) CreateAlertmanagerConfig(amc interface{}) {
 		return
 	}
 	l := log.WithValues("kind", "vmAlertmanagerConfig", "name", vmAMc.Name, "ns", vmAMc.Namespace)
+	reconcile.SetSpecHash(vmAMc, reconcile.SpecHash(vmAMc, &vmAMc.Spec))
 	if err := c.rclient.Create(context.Background(), vmAMc); err != nil {
 		if errors.IsAlreadyExists(err) {
 			c.UpdateAlertmanagerConfig(nil, vmAMc)
@@ -523,7 +816,7 @@ func (c *ConverterController) UpdateAlertmanagerConfig(_, new interface{}) {
 	var err error
 	switch promAMc := new.(type) {
 	case *promv1alpha1.AlertmanagerConfig:
-		vmAMc, err = converterv1alpha1.ConvertAlertmanagerConfig(promAMc, c.baseConf)
+		vmAMc, err = converterv1alpha1.ConvertAlertmanagerConfig(promAMc, config.MustGetBaseConfig())
 	default:
 		err = fmt.Errorf("alertmanager config of type %t is not supported", new)
 	}
@@ -534,6 +827,8 @@ func (c *ConverterController) UpdateAlertmanagerConfig(_, new interface{}) {
 	l := log.WithValues("kind", "vmAlertmanagerConfig", "name", vmAMc.Name, "ns", vmAMc.Namespace)
 	existAlertmanagerConfig := &vmv1beta1.VMAlertmanagerConfig{}
 	ctx := context.Background()
+	specHash := reconcile.SpecHash(vmAMc, &vmAMc.Spec)
+	reconcile.SetSpecHash(vmAMc, specHash)
 	if err := c.rclient.Get(ctx, types.NamespacedName{Name: vmAMc.Name, Namespace: vmAMc.Namespace}, existAlertmanagerConfig); err != nil {
 		if errors.IsNotFound(err) {
 			if err = c.rclient.Create(ctx, vmAMc); err == nil {
@@ -548,10 +843,14 @@ func (c *ConverterController) UpdateAlertmanagerConfig(_, new interface{}) {
 		l.Info("syncing for object was disabled by annotation", "annotation", IgnoreConversionLabel)
 		return
 	}
+	if reconcile.IsOwnedStateUnchanged("VMAlertmanagerConfig", existAlertmanagerConfig, vmAMc, &existAlertmanagerConfig.Spec, &vmAMc.Spec) {
+		return
+	}
 
 	metaMergeStrategy := getMetaMergeStrategy(existAlertmanagerConfig.Annotations)
 	vmAMc.Annotations = mergeLabelsWithStrategy(existAlertmanagerConfig.Annotations, vmAMc.Annotations, metaMergeStrategy)
 	vmAMc.Labels = mergeLabelsWithStrategy(existAlertmanagerConfig.Labels, vmAMc.Labels, metaMergeStrategy)
+	reconcile.SetSpecHash(vmAMc, specHash)
 	if equality.Semantic.DeepEqual(vmAMc.Spec, existAlertmanagerConfig.Spec) &&
 		isMetaEqual(vmAMc, existAlertmanagerConfig) {
 		return
@@ -612,7 +911,8 @@ func getMetaMergeStrategy(vmMeta map[string]string) string {
 func (c *ConverterController) CreateProbe(obj interface{}) {
 	probe := obj.(*promv1.Probe)
 	l := log.WithValues("kind", "vmProbe", "name", probe.Name, "ns", probe.Namespace)
-	vmProbe := converter.ConvertProbe(probe, c.baseConf)
+	vmProbe := converter.ConvertProbe(probe, config.MustGetBaseConfig())
+	reconcile.SetSpecHash(vmProbe, reconcile.SpecHash(vmProbe, &vmProbe.Spec))
 	err := c.rclient.Create(c.ctx, vmProbe)
 	if err != nil {
 		if errors.IsAlreadyExists(err) {
@@ -628,9 +928,11 @@ func (c *ConverterController) CreateProbe(obj interface{}) {
 func (c *ConverterController) UpdateProbe(_, new interface{}) {
 	probeNew := new.(*promv1.Probe)
 	l := log.WithValues("kind", "vmProbe", "name", probeNew.Name, "ns", probeNew.Namespace)
-	vmProbe := converter.ConvertProbe(probeNew, c.baseConf)
+	vmProbe := converter.ConvertProbe(probeNew, config.MustGetBaseConfig())
 	ctx := context.Background()
 	existingVMProbe := &vmv1beta1.VMProbe{}
+	specHash := reconcile.SpecHash(vmProbe, &vmProbe.Spec)
+	reconcile.SetSpecHash(vmProbe, specHash)
 	err := c.rclient.Get(ctx, types.NamespacedName{Name: vmProbe.Name, Namespace: vmProbe.Namespace}, existingVMProbe)
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -645,10 +947,14 @@ func (c *ConverterController) UpdateProbe(_, new interface{}) {
 		l.Info("syncing for object was disabled by annotation", "annotation", IgnoreConversionLabel)
 		return
 	}
+	if reconcile.IsOwnedStateUnchanged("VMProbe", existingVMProbe, vmProbe, &existingVMProbe.Spec, &vmProbe.Spec) {
+		return
+	}
 
 	mergeStrategy := getMetaMergeStrategy(existingVMProbe.Annotations)
 	vmProbe.Annotations = mergeLabelsWithStrategy(existingVMProbe.Annotations, vmProbe.Annotations, mergeStrategy)
 	vmProbe.Labels = mergeLabelsWithStrategy(existingVMProbe.Labels, vmProbe.Labels, mergeStrategy)
+	reconcile.SetSpecHash(vmProbe, specHash)
 	if equality.Semantic.DeepEqual(vmProbe.Spec, existingVMProbe.Spec) &&
 		isMetaEqual(vmProbe, existingVMProbe) {
 		return
@@ -671,13 +977,14 @@ func (c *ConverterController) CreateScrapeConfig(scrapeConfig interface{}) {
 	var err error
 	switch promScrapeConfig := scrapeConfig.(type) {
 	case *promv1alpha1.ScrapeConfig:
-		vmScrapeConfig = converterv1alpha1.ConvertScrapeConfig(promScrapeConfig, c.baseConf)
+		vmScrapeConfig = converterv1alpha1.ConvertScrapeConfig(promScrapeConfig, config.MustGetBaseConfig())
 	default:
 		err = fmt.Errorf("scrape config of type %t is not supported", promScrapeConfig)
 		log.Error(err, "cannot parse vmScrapeConfig")
 		return
 	}
 	l := log.WithValues("kind", "vmScrapeConfig", "name", vmScrapeConfig.Name, "ns", vmScrapeConfig.Namespace)
+	reconcile.SetSpecHash(vmScrapeConfig, reconcile.SpecHash(vmScrapeConfig, &vmScrapeConfig.Spec))
 	err = c.rclient.Create(context.Background(), vmScrapeConfig)
 	if err != nil {
 		if errors.IsAlreadyExists(err) {
@@ -695,7 +1002,7 @@ func (c *ConverterController) UpdateScrapeConfig(_, new interface{}) {
 	var err error
 	switch promScrapeConfig := new.(type) {
 	case *promv1alpha1.ScrapeConfig:
-		vmScrapeConfig = converterv1alpha1.ConvertScrapeConfig(promScrapeConfig, c.baseConf)
+		vmScrapeConfig = converterv1alpha1.ConvertScrapeConfig(promScrapeConfig, config.MustGetBaseConfig())
 	default:
 		err = fmt.Errorf("scrape config of type %t is not supported", promScrapeConfig)
 		log.Error(err, "cannot parse vmScrapeConfig")
@@ -704,6 +1011,8 @@ func (c *ConverterController) UpdateScrapeConfig(_, new interface{}) {
 	l := log.WithValues("kind", "vmScrapeConfig", "name", vmScrapeConfig.Name, "ns", vmScrapeConfig.Namespace)
 	existingVMScrapeConfig := &vmv1beta1.VMScrapeConfig{}
 	ctx := context.Background()
+	specHash := reconcile.SpecHash(vmScrapeConfig, &vmScrapeConfig.Spec)
+	reconcile.SetSpecHash(vmScrapeConfig, specHash)
 	err = c.rclient.Get(ctx, types.NamespacedName{Name: vmScrapeConfig.Name, Namespace: vmScrapeConfig.Namespace}, existingVMScrapeConfig)
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -719,9 +1028,13 @@ func (c *ConverterController) UpdateScrapeConfig(_, new interface{}) {
 		l.Info("syncing for object was disabled by annotation", "annotation", IgnoreConversionLabel)
 		return
 	}
+	if reconcile.IsOwnedStateUnchanged("VMScrapeConfig", existingVMScrapeConfig, vmScrapeConfig, &existingVMScrapeConfig.Spec, &vmScrapeConfig.Spec) {
+		return
+	}
 	metaMergeStrategy := getMetaMergeStrategy(existingVMScrapeConfig.Annotations)
 	vmScrapeConfig.Annotations = mergeLabelsWithStrategy(existingVMScrapeConfig.Annotations, vmScrapeConfig.Annotations, metaMergeStrategy)
 	vmScrapeConfig.Labels = mergeLabelsWithStrategy(existingVMScrapeConfig.Labels, vmScrapeConfig.Labels, metaMergeStrategy)
+	reconcile.SetSpecHash(vmScrapeConfig, specHash)
 
 	if equality.Semantic.DeepEqual(vmScrapeConfig.Spec, existingVMScrapeConfig.Spec) &&
 		isMetaEqual(vmScrapeConfig, existingVMScrapeConfig) {
@@ -1,8 +1,23 @@
 package operator
 
 import (
+	"context"
 	"reflect"
 	"testing"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/stretchr/testify/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func Test_mergeLabelsWithStrategy(t *testing.T) {
@@ -79,3 +94,123 @@ func Test_mergeLabelsWithStrategy(t *testing.T) {
 		})
 	}
 }
+
+func TestCRDInformerSync(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var created int
+	ci := newCRDInformer("servicemonitors.monitoring.coreos.com", func() (cache.SharedIndexInformer, error) {
+		created++
+		return cache.NewSharedIndexInformer(&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				return &promv1.ServiceMonitorList{}, nil
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return watch.NewFake(), nil
+			},
+		}, &promv1.ServiceMonitor{}, 0, cache.Indexers{}), nil
+	})
+	newCRD := func(name string, established apiextensionsv1.ConditionStatus) *apiextensionsv1.CustomResourceDefinition {
+		return &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: apiextensionsv1.CustomResourceDefinitionStatus{
+				Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{{Type: apiextensionsv1.Established, Status: established}},
+			},
+		}
+	}
+	f := func(obj interface{}, wantRunning bool, wantCreated int) {
+		t.Helper()
+		ci.sync(ctx, obj)
+		assert.Equal(t, wantRunning, ci.isRunning())
+		assert.Equal(t, wantCreated, created)
+	}
+
+	// CRD is not established yet
+	f(newCRD(ci.crdName, apiextensionsv1.ConditionFalse), false, 0)
+
+	// CRD of other kind
+	f(newCRD("podmonitors.monitoring.coreos.com", apiextensionsv1.ConditionTrue), false, 0)
+
+	// CRD is established
+	f(newCRD(ci.crdName, apiextensionsv1.ConditionTrue), true, 1)
+
+	// informer is not re-created on CRD update
+	f(newCRD(ci.crdName, apiextensionsv1.ConditionTrue), true, 1)
+
+	// CRD is being deleted
+	deleted := newCRD(ci.crdName, apiextensionsv1.ConditionTrue)
+	deleted.DeletionTimestamp = ptr.To(metav1.Now())
+	f(deleted, false, 1)
+
+	// CRD is installed again
+	f(newCRD(ci.crdName, apiextensionsv1.ConditionTrue), true, 2)
+
+	ci.stop()
+	assert.False(t, ci.isRunning())
+}
+
+func TestConverterRevertsManualChanges(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	assert.NoError(t, vmv1beta1.AddToScheme(scheme))
+	rclient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	c := &ConverterController{rclient: rclient}
+	promRule := &promv1.PrometheusRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "rule", Namespace: "default"},
+		Spec: promv1.PrometheusRuleSpec{Groups: []promv1.RuleGroup{{
+			Name:  "group",
+			Rules: []promv1.Rule{{Alert: "down", Expr: intstr.FromString("up == 0")}},
+		}}},
+	}
+	c.CreatePrometheusRule(promRule)
+	nsn := types.NamespacedName{Name: "rule", Namespace: "default"}
+	var got vmv1beta1.VMRule
+	assert.NoError(t, rclient.Get(ctx, nsn, &got))
+	want := *got.Spec.DeepCopy()
+
+	// manual change of converted object spec is reverted
+	got.Spec.Groups[0].Rules[0].Expr = "vector(1)"
+	assert.NoError(t, rclient.Update(ctx, &got))
+	c.UpdatePrometheusRule(nil, promRule)
+	assert.NoError(t, rclient.Get(ctx, nsn, &got))
+	assert.Equal(t, want, got.Spec)
+
+	// update of unchanged object is skipped
+	rv := got.ResourceVersion
+	c.UpdatePrometheusRule(nil, promRule)
+	assert.NoError(t, rclient.Get(ctx, nsn, &got))
+	assert.Equal(t, rv, got.ResourceVersion)
+}
+
+func TestConverterUsesReloadedConfig(t *testing.T) {
+	defer func() {
+		if _, err := config.ReloadBaseConfig(nil); err != nil {
+			t.Fatalf("cannot restore configuration: %s", err)
+		}
+	}()
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	assert.NoError(t, vmv1beta1.AddToScheme(scheme))
+	rclient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	c := &ConverterController{rclient: rclient}
+	promRule := &promv1.PrometheusRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "rule", Namespace: "default", Labels: map[string]string{"team": "a", "helm.sh/chart": "rules"}},
+		Spec: promv1.PrometheusRuleSpec{Groups: []promv1.RuleGroup{{
+			Name:  "group",
+			Rules: []promv1.Rule{{Alert: "down", Expr: intstr.FromString("up == 0")}},
+		}}},
+	}
+	c.CreatePrometheusRule(promRule)
+	nsn := types.NamespacedName{Name: "rule", Namespace: "default"}
+	var got vmv1beta1.VMRule
+	assert.NoError(t, rclient.Get(ctx, nsn, &got))
+	assert.Equal(t, map[string]string{"team": "a", "helm.sh/chart": "rules"}, got.Labels)
+
+	// label prefixes filter is applied without restart
+	if _, err := config.ReloadBaseConfig(map[string]string{"VM_FILTERPROMETHEUSCONVERTERLABELPREFIXES": "helm.sh"}); err != nil {
+		t.Fatalf("cannot reload configuration: %s", err)
+	}
+	c.UpdatePrometheusRule(nil, promRule)
+	assert.NoError(t, rclient.Get(ctx, nsn, &got))
+	assert.Equal(t, map[string]string{"team": "a"}, got.Labels)
+}
@@ -0,0 +1,150 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/converter"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/go-logr/logr"
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// VMServiceScrapeExportReconciler exports VMServiceScrape into ServiceMonitor
+type VMServiceScrapeExportReconciler struct {
+	client.Client
+	Log          logr.Logger
+	OriginScheme *runtime.Scheme
+}
+
+// Scheme implements interface.
+func (r *VMServiceScrapeExportReconciler) Scheme() *runtime.Scheme {
+	return r.OriginScheme
+}
+
+// Reconcile general reconcile method for controller
+// exported ServiceMonitor is removed by garbage collector with owner reference to VMServiceScrape
+func (r *VMServiceScrapeExportReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	reqLogger := r.Log.WithValues("vmservicescrape", req.Name, "namespace", req.Namespace)
+	ctx = logger.AddToContext(ctx, reqLogger)
+	defer func() {
+		result, err = handleReconcileErr(ctx, r.Client, nil, result, err)
+	}()
+	defer recoverReconcilePanic("vmservicescrape_export", &err)
+
+	instance := &vmv1beta1.VMServiceScrape{}
+	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
+		return result, &getError{err, "vmservicescrape_export", req}
+	}
+	if !instance.DeletionTimestamp.IsZero() || converter.IsConvertedFromPrometheus(instance) {
+		return
+	}
+	exported := converter.ExportVMServiceScrape(instance)
+	var existing promv1.ServiceMonitor
+	if err := r.Get(ctx, types.NamespacedName{Name: exported.Name, Namespace: exported.Namespace}, &existing); err != nil {
+		if errors.IsNotFound(err) {
+			if err = r.Create(ctx, exported); err != nil {
+				return result, fmt.Errorf("cannot create exported ServiceMonitor: %w", err)
+			}
+			return result, nil
+		}
+		return result, fmt.Errorf("cannot get exported ServiceMonitor: %w", err)
+	}
+	if !converter.IsExported(&existing) {
+		reqLogger.Info("skipping export, ServiceMonitor with the same name is not managed by operator")
+		return
+	}
+	if equality.Semantic.DeepEqual(exported.Spec, existing.Spec) && isMetaEqual(exported, &existing) {
+		return
+	}
+	existing.Labels = exported.Labels
+	existing.Annotations = exported.Annotations
+	existing.OwnerReferences = exported.OwnerReferences
+	existing.Spec = exported.Spec
+	if err := r.Update(ctx, &existing); err != nil {
+		return result, fmt.Errorf("cannot update exported ServiceMonitor: %w", err)
+	}
+	return
+}
+
+// SetupWithManager general setup method
+func (r *VMServiceScrapeExportReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("vmservicescrape_export").
+		For(&vmv1beta1.VMServiceScrape{}).
+		WithOptions(getDefaultOptions()).
+		Complete(r)
+}
+
+// VMRuleExportReconciler exports VMRule into PrometheusRule
+type VMRuleExportReconciler struct {
+	client.Client
+	Log          logr.Logger
+	OriginScheme *runtime.Scheme
+}
+
+// Scheme implements interface.
+func (r *VMRuleExportReconciler) Scheme() *runtime.Scheme {
+	return r.OriginScheme
+}
+
+// Reconcile general reconcile method for controller
+// exported PrometheusRule is removed by garbage collector with owner reference to VMRule
+func (r *VMRuleExportReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	reqLogger := r.Log.WithValues("vmrule", req.Name, "namespace", req.Namespace)
+	ctx = logger.AddToContext(ctx, reqLogger)
+	defer func() {
+		result, err = handleReconcileErr(ctx, r.Client, nil, result, err)
+	}()
+	defer recoverReconcilePanic("vmrule_export", &err)
+
+	instance := &vmv1beta1.VMRule{}
+	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
+		return result, &getError{err, "vmrule_export", req}
+	}
+	if !instance.DeletionTimestamp.IsZero() || converter.IsConvertedFromPrometheus(instance) {
+		return
+	}
+	exported := converter.ExportVMRule(instance)
+	var existing promv1.PrometheusRule
+	if err := r.Get(ctx, types.NamespacedName{Name: exported.Name, Namespace: exported.Namespace}, &existing); err != nil {
+		if errors.IsNotFound(err) {
+			if err = r.Create(ctx, exported); err != nil {
+				return result, fmt.Errorf("cannot create exported PrometheusRule: %w", err)
+			}
+			return result, nil
+		}
+		return result, fmt.Errorf("cannot get exported PrometheusRule: %w", err)
+	}
+	if !converter.IsExported(&existing) {
+		reqLogger.Info("skipping export, PrometheusRule with the same name is not managed by operator")
+		return
+	}
+	if equality.Semantic.DeepEqual(exported.Spec, existing.Spec) && isMetaEqual(exported, &existing) {
+		return
+	}
+	existing.Labels = exported.Labels
+	existing.Annotations = exported.Annotations
+	existing.OwnerReferences = exported.OwnerReferences
+	existing.Spec = exported.Spec
+	if err := r.Update(ctx, &existing); err != nil {
+		return result, fmt.Errorf("cannot update exported PrometheusRule: %w", err)
+	}
+	return
+}
+
+// SetupWithManager general setup method
+func (r *VMRuleExportReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("vmrule_export").
+		For(&vmv1beta1.VMRule{}).
+		WithOptions(getDefaultOptions()).
+		Complete(r)
+}
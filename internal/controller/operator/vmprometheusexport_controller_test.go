@@ -0,0 +1,90 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/converter"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestVMServiceScrapeExportReconcile(t *testing.T) {
+	ctx := context.Background()
+	nsn := types.NamespacedName{Name: "example", Namespace: "default"}
+	f := func(cr *vmv1beta1.VMServiceScrape, predefined []runtime.Object, wantExported bool, wantPort string) {
+		t.Helper()
+		fclient := k8stools.GetTestClientWithObjects(append(predefined, cr))
+		r := &VMServiceScrapeExportReconciler{Client: fclient, Log: ctrl.Log}
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: nsn})
+		assert.NoError(t, err)
+		var sm promv1.ServiceMonitor
+		if err := fclient.Get(ctx, nsn, &sm); err != nil {
+			assert.False(t, wantExported, "exported ServiceMonitor must exist: %s", err)
+			return
+		}
+		assert.Equal(t, wantExported, converter.IsExported(&sm))
+		if assert.Len(t, sm.Spec.Endpoints, 1) {
+			assert.Equal(t, wantPort, sm.Spec.Endpoints[0].Port)
+		}
+	}
+	cr := &vmv1beta1.VMServiceScrape{
+		ObjectMeta: metav1.ObjectMeta{Name: nsn.Name, Namespace: nsn.Namespace},
+		Spec: vmv1beta1.VMServiceScrapeSpec{
+			Endpoints: []vmv1beta1.Endpoint{{Port: "http"}},
+		},
+	}
+
+	// create exported object
+	f(cr.DeepCopy(), nil, true, "http")
+
+	// update previously exported object
+	f(cr.DeepCopy(), []runtime.Object{
+		&promv1.ServiceMonitor{
+			ObjectMeta: metav1.ObjectMeta{Name: nsn.Name, Namespace: nsn.Namespace, Annotations: map[string]string{converter.ExportedFromAnnotation: "VMServiceScrape/default/example"}},
+			Spec:       promv1.ServiceMonitorSpec{Endpoints: []promv1.Endpoint{{Port: "old"}}},
+		},
+	}, true, "http")
+
+	// keep ServiceMonitor not managed by operator
+	f(cr.DeepCopy(), []runtime.Object{
+		&promv1.ServiceMonitor{
+			ObjectMeta: metav1.ObjectMeta{Name: nsn.Name, Namespace: nsn.Namespace},
+			Spec:       promv1.ServiceMonitorSpec{Endpoints: []promv1.Endpoint{{Port: "user"}}},
+		},
+	}, false, "user")
+
+	// object converted from prometheus is not exported
+	converted := cr.DeepCopy()
+	converted.Annotations = map[string]string{converter.OriginAnnotation: "ServiceMonitor/default/example"}
+	f(converted, nil, false, "")
+}
+
+func TestVMRuleExportReconcile(t *testing.T) {
+	ctx := context.Background()
+	nsn := types.NamespacedName{Name: "rules", Namespace: "default"}
+	cr := &vmv1beta1.VMRule{
+		ObjectMeta: metav1.ObjectMeta{Name: nsn.Name, Namespace: nsn.Namespace},
+		Spec: vmv1beta1.VMRuleSpec{
+			Groups: []vmv1beta1.RuleGroup{{Name: "group", Rules: []vmv1beta1.Rule{{Alert: "Down", Expr: "up == 0"}}}},
+		},
+	}
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{cr})
+	r := &VMRuleExportReconciler{Client: fclient, Log: ctrl.Log}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: nsn})
+	assert.NoError(t, err)
+
+	var pr promv1.PrometheusRule
+	assert.NoError(t, fclient.Get(ctx, nsn, &pr))
+	assert.True(t, converter.IsExported(&pr))
+	if assert.Len(t, pr.Spec.Groups, 1) {
+		assert.Equal(t, "group", pr.Spec.Groups[0].Name)
+	}
+}
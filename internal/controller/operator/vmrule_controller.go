@@ -18,13 +18,9 @@ package operator
 
 import (
 	"context"
-	"fmt"
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
-	"github.com/VictoriaMetrics/operator/internal/config"
-	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
-	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/vmalert"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -44,6 +40,7 @@ func (r *VMRuleReconciler) Scheme() *runtime.Scheme {
 }
 
 // Reconcile general reconcile method for controller
+// VMRule changes are delivered to VMAlerts by VMAlertReconciler watch
 // +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmrules,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmrules/status,verbs=get;update;patch
 func (r *VMRuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
@@ -53,6 +50,7 @@ func (r *VMRuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res
 	defer func() {
 		result, err = handleReconcileErr(ctx, r.Client, nil, result, err)
 	}()
+	defer recoverReconcilePanic("vmrule", &err)
 
 	// Fetch the VMRule instance
 	instance := &vmv1beta1.VMRule{}
@@ -61,45 +59,6 @@ func (r *VMRuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res
 	}
 
 	RegisterObjectStat(instance, "vmrule")
-
-	if vmAlertRateLimiter.MustThrottleReconcile() {
-		// fast path
-		return ctrl.Result{}, nil
-	}
-
-	var objects vmv1beta1.VMAlertList
-	if err := k8stools.ListObjectsByNamespace(ctx, r.Client, config.MustGetWatchNamespaces(), func(dst *vmv1beta1.VMAlertList) {
-		objects.Items = append(objects.Items, dst.Items...)
-	}); err != nil {
-		return result, fmt.Errorf("cannot list vmauths for vmuser: %w", err)
-	}
-
-	for _, vmalertItem := range objects.Items {
-		if vmalertItem.DeletionTimestamp != nil || vmalertItem.Spec.ParsingError != "" {
-			continue
-		}
-		currVMAlert := &vmalertItem
-		reqLogger := reqLogger.WithValues("parent_vmalert", currVMAlert.Name, "parent_namespace", currVMAlert.Namespace)
-		ctx := logger.AddToContext(ctx, reqLogger)
-
-		// only check selector when deleting, since labels can be changed when updating and we can't tell if it was selected before.
-		if instance.DeletionTimestamp.IsZero() && !currVMAlert.Spec.SelectAllByDefault {
-			match, err := isSelectorsMatchesTargetCRD(ctx, r.Client, instance, currVMAlert, currVMAlert.Spec.RuleSelector, currVMAlert.Spec.RuleNamespaceSelector)
-			if err != nil {
-				reqLogger.Error(err, "cannot match vmalert and vmRule")
-				continue
-			}
-			if !match {
-				continue
-			}
-		}
-
-		_, err := vmalert.CreateOrUpdateRuleConfigMaps(ctx, currVMAlert, r)
-		if err != nil {
-			return ctrl.Result{}, fmt.Errorf("cannot update rules configmaps: %w", err)
-		}
-
-	}
 	return
 }
 
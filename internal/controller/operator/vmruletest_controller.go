@@ -0,0 +1,187 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"context"
+	"fmt"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/vmalert"
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// VMRuleTestReconciler reconciles a VMRuleTest object
+type VMRuleTestReconciler struct {
+	client.Client
+	Log          logr.Logger
+	OriginScheme *runtime.Scheme
+}
+
+// Scheme implements interface.
+func (r *VMRuleTestReconciler) Scheme() *runtime.Scheme {
+	return r.OriginScheme
+}
+
+// Reconcile implements interface
+// +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmruletests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmruletests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+func (r *VMRuleTestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	l := r.Log.WithValues("vmruletest", req.Name, "namespace", req.Namespace)
+	ctx = logger.AddToContext(ctx, l)
+
+	defer func() {
+		result, err = handleReconcileErr(ctx, r.Client, nil, result, err)
+	}()
+	defer recoverReconcilePanic("vmruletest", &err)
+
+	var instance vmv1beta1.VMRuleTest
+	if err := r.Get(ctx, req.NamespacedName, &instance); err != nil {
+		return result, &getError{err, "vmruletest", req}
+	}
+	RegisterObjectStat(&instance, "vmruletest")
+
+	// tests are executed only by the shard, which owns vmruletest namespace
+	if !isNamespaceOwned(ctx, instance.Namespace) {
+		return
+	}
+	ctx = events.AddToContext(ctx, &instance)
+	if !instance.DeletionTimestamp.IsZero() {
+		if err := finalize.OnVMRuleTestDelete(ctx, r, &instance); err != nil {
+			return result, fmt.Errorf("cannot remove finalizer for vmruletest: %w", err)
+		}
+		if instance.Spec.StrictMode && instance.Status.Result == vmv1beta1.RuleTestFailed {
+			// rules are not blocked by deleted tests anymore
+			return result, r.syncVMAlerts(ctx, &instance)
+		}
+		return
+	}
+	if instance.Spec.ParsingError != "" {
+		return result, &parsingError{instance.Spec.ParsingError, "vmruletest"}
+	}
+	if err := finalize.AddFinalizer(ctx, r.Client, &instance); err != nil {
+		return result, err
+	}
+
+	prevResult := instance.Status.Result
+	if _, err := vmalert.CreateOrUpdateRuleTest(ctx, r, &instance); err != nil {
+		return result, fmt.Errorf("cannot run tests for vmruletest: %w", err)
+	}
+	if instance.Spec.StrictMode && instance.Status.Result != prevResult {
+		l.Info("rule tests result changed, updating vmalert rules", "result", instance.Status.Result)
+		if err := r.syncVMAlerts(ctx, &instance); err != nil {
+			return result, err
+		}
+	}
+	return
+}
+
+// syncVMAlerts updates rules configuration of VMAlerts, which select rules referenced by VMRuleTest
+func (r *VMRuleTestReconciler) syncVMAlerts(ctx context.Context, instance *vmv1beta1.VMRuleTest) error {
+	var rules []*vmv1beta1.VMRule
+	for _, name := range instance.Spec.Rules {
+		var rule vmv1beta1.VMRule
+		if err := r.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: name}, &rule); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("cannot get vmrule=%q: %w", name, err)
+		}
+		rules = append(rules, &rule)
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	var objects vmv1beta1.VMAlertList
+	if err := k8stools.ListObjectsByNamespace(ctx, r.Client, config.MustGetWatchNamespaces(), func(dst *vmv1beta1.VMAlertList) {
+		objects.Items = append(objects.Items, dst.Items...)
+	}); err != nil {
+		return fmt.Errorf("cannot list vmalerts for vmruletest: %w", err)
+	}
+	for i := range objects.Items {
+		currVMAlert := &objects.Items[i]
+		if !isNamespaceOwned(ctx, currVMAlert.Namespace) || !currVMAlert.DeletionTimestamp.IsZero() || currVMAlert.Spec.ParsingError != "" {
+			continue
+		}
+		l := logger.WithContext(ctx).WithValues("parent_vmalert", currVMAlert.Name, "parent_namespace", currVMAlert.Namespace)
+		ctx := logger.AddToContext(ctx, l)
+		ctx = events.AddToContext(ctx, currVMAlert)
+
+		match := currVMAlert.Spec.SelectAllByDefault
+		for _, rule := range rules {
+			if match {
+				break
+			}
+			var err error
+			match, err = isSelectorsMatchesTargetCRD(ctx, r.Client, rule, currVMAlert, currVMAlert.Spec.RuleSelector, currVMAlert.Spec.RuleNamespaceSelector)
+			if err != nil {
+				l.Error(err, "cannot match vmalert and vmRule")
+			}
+		}
+		if !match {
+			continue
+		}
+		if _, err := vmalert.CreateOrUpdateRuleConfigMaps(ctx, currVMAlert, r); err != nil {
+			return fmt.Errorf("cannot update rules configmaps: %w", err)
+		}
+	}
+	return nil
+}
+
+// ruleTestsForRule returns requests for VMRuleTests, which reference given VMRule
+func (r *VMRuleTestReconciler) ruleTestsForRule(ctx context.Context, obj client.Object) []reconcile.Request {
+	var ruleTests vmv1beta1.VMRuleTestList
+	if err := r.List(ctx, &ruleTests, client.InNamespace(obj.GetNamespace())); err != nil {
+		r.Log.Error(err, "cannot list vmruletests for vmrule", "vmrule", obj.GetName(), "namespace", obj.GetNamespace())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, rt := range ruleTests.Items {
+		for _, name := range rt.Spec.Rules {
+			if name == obj.GetName() {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: rt.Namespace, Name: rt.Name}})
+				break
+			}
+		}
+	}
+	return requests
+}
+
+// SetupWithManager general setup method
+func (r *VMRuleTestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vmv1beta1.VMRuleTest{}).
+		Owns(&batchv1.Job{}).
+		Watches(&vmv1beta1.VMRule{}, handler.EnqueueRequestsFromMapFunc(r.ruleTestsForRule)).
+		WithOptions(getDefaultOptions()).
+		Complete(r)
+}
@@ -0,0 +1,103 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+var _ = Describe("VMRuleTest Controller", func() {
+	Context("When reconciling a resource", func() {
+		const resourceName = "test-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+		vmruletest := &vmv1beta1.VMRuleTest{}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind VMRuleTest")
+			err := k8sClient.Get(ctx, typeNamespacedName, vmruletest)
+			if err != nil && errors.IsNotFound(err) {
+				rule := &vmv1beta1.VMRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      resourceName,
+						Namespace: "default",
+					},
+					Spec: vmv1beta1.VMRuleSpec{
+						Groups: []vmv1beta1.RuleGroup{{
+							Name:  "node",
+							Rules: []vmv1beta1.Rule{{Alert: "NodeDown", Expr: "up == 0"}},
+						}},
+					},
+				}
+				Expect(k8sClient.Create(ctx, rule)).To(Succeed())
+				resource := &vmv1beta1.VMRuleTest{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      resourceName,
+						Namespace: "default",
+					},
+					Spec: vmv1beta1.VMRuleTestSpec{
+						Rules: []string{resourceName},
+						Tests: []vmv1beta1.RuleTestGroup{{
+							InputSeries:    []vmv1beta1.RuleTestInputSeries{{Series: `up{job="node"}`, Values: "0x10"}},
+							AlertRuleTests: []vmv1beta1.AlertRuleTestCase{{EvalTime: "5m", GroupName: "node", AlertName: "NodeDown"}},
+						}},
+					},
+				}
+				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			}
+		})
+
+		AfterEach(func() {
+			resource := &vmv1beta1.VMRuleTest{}
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Cleanup the specific resource instance VMRuleTest")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			rule := &vmv1beta1.VMRule{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, rule)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, rule)).To(Succeed())
+		})
+		It("should successfully reconcile the resource", func() {
+			By("Reconciling the created resource")
+			controllerReconciler := &VMRuleTestReconciler{
+				Client:       k8sClient,
+				OriginScheme: k8sClient.Scheme(),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,130 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"context"
+	"fmt"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/vmagent"
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// VMScrapeGlobalConfigReconciler reconciles a VMScrapeGlobalConfig object
+type VMScrapeGlobalConfigReconciler struct {
+	client.Client
+	Log          logr.Logger
+	OriginScheme *runtime.Scheme
+}
+
+// Scheme implements interface.
+func (r *VMScrapeGlobalConfigReconciler) Scheme() *runtime.Scheme {
+	return r.OriginScheme
+}
+
+// Reconcile general reconcile method for controller
+// +kubebuilder:rbac:groups=operator.victoriametrics.com,resources=vmscrapeglobalconfigs,verbs=get;list;watch
+func (r *VMScrapeGlobalConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	reqLogger := r.Log.WithValues("vmscrapeglobalconfig", req.Name)
+	ctx = logger.AddToContext(ctx, reqLogger)
+	defer func() {
+		result, err = handleReconcileErr(ctx, r.Client, nil, result, err)
+	}()
+	defer recoverReconcilePanic("vmscrapeglobalconfig", &err)
+	// Fetch the VMScrapeGlobalConfig instance
+	instance := &vmv1beta1.VMScrapeGlobalConfig{}
+	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			deleteObjectState("vmscrapeglobalconfig", req.NamespacedName)
+		}
+		return result, &getError{err, "vmscrapeglobalconfig", req}
+	}
+
+	RegisterObjectStat(instance, "vmscrapeglobalconfig")
+	if !config.IsClusterWideAccessAllowed() {
+		// cluster-scoped config is ignored for namespaced operator
+		return
+	}
+	if isObjectStateUnchanged("vmscrapeglobalconfig", instance) {
+		// fast path, object was already processed
+		return
+	}
+	if vmAgentReconcileLimit.MustThrottleReconcile() {
+		// fast path, rate limited
+		return
+	}
+
+	vmAgentSync.Lock()
+	defer vmAgentSync.Unlock()
+	var objects vmv1beta1.VMAgentList
+	if err := k8stools.ListObjectsByNamespace(ctx, r.Client, config.MustGetWatchNamespaces(), func(dst *vmv1beta1.VMAgentList) {
+		objects.Items = append(objects.Items, dst.Items...)
+	}); err != nil {
+		return result, fmt.Errorf("cannot list vmagents for vmscrapeglobalconfig: %w", err)
+	}
+
+	var isFailed bool
+	for _, vmagentItem := range objects.Items {
+		if !isNamespaceOwned(ctx, vmagentItem.Namespace) || !vmagentItem.DeletionTimestamp.IsZero() || vmagentItem.Spec.ParsingError != "" || vmagentItem.IsUnmanaged() || vmagentItem.Paused() {
+			continue
+		}
+		currentVMagent := &vmagentItem
+		reqLogger := reqLogger.WithValues("parent_vmagent", currentVMagent.Name, "parent_namespace", currentVMagent.Namespace)
+		ctx := logger.AddToContext(ctx, reqLogger)
+		ctx = events.AddToContext(ctx, currentVMagent)
+
+		// vmagent selector could be changed, so vmagents with previously applied config must be updated as well
+		if instance.DeletionTimestamp.IsZero() &&
+			(currentVMagent.Status.ScrapeGlobalConfig == nil || currentVMagent.Status.ScrapeGlobalConfig.Name != instance.Name) {
+			match, err := instance.MatchesVMAgent(currentVMagent)
+			if err != nil {
+				reqLogger.Error(err, "cannot match vmagent and vmScrapeGlobalConfig")
+				isFailed = true
+				continue
+			}
+			if !match {
+				continue
+			}
+		}
+
+		if err := vmagent.CreateOrUpdateConfigurationSecret(ctx, currentVMagent, r); err != nil {
+			isFailed = true
+			continue
+		}
+	}
+	if !isFailed {
+		storeObjectState("vmscrapeglobalconfig", instance)
+	}
+	return
+}
+
+// SetupWithManager general setup method
+func (r *VMScrapeGlobalConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vmv1beta1.VMScrapeGlobalConfig{}).
+		WithOptions(getDefaultOptions()).
+		Complete(r)
+}
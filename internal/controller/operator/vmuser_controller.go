@@ -22,6 +22,7 @@ import (
 
 	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/finalize"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/limiter"
@@ -29,6 +30,7 @@ import (
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/vmauth"
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -58,22 +60,37 @@ func (r *VMUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res
 	defer func() {
 		result, err = handleReconcileErr(ctx, r.Client, nil, result, err)
 	}()
+	defer recoverReconcilePanic("vmuser", &err)
 	var instance vmv1beta1.VMUser
 
 	if err := r.Get(ctx, req.NamespacedName, &instance); err != nil {
+		if apierrors.IsNotFound(err) && config.IsFinalizersDisabled() && isNamespaceOwned(ctx, req.Namespace) {
+			if err := finalize.OnVMUserDeleted(ctx, r.Client, req.NamespacedName); err != nil {
+				return result, fmt.Errorf("cannot remove generated credentials of deleted vmuser: %w", err)
+			}
+		}
 		return result, &getError{err, "vmuser", req}
 	}
 	RegisterObjectStat(&instance, "vmuser")
 
-	if !instance.DeletionTimestamp.IsZero() {
+	// vmuser is selected by vmauths from any shard, but finalizer is managed only by its own shard
+	switch {
+	case !isNamespaceOwned(ctx, instance.Namespace):
+	case !instance.DeletionTimestamp.IsZero():
 		// need to remove finalizer and delete related resources.
 		if err := finalize.OnVMUserDelete(ctx, r, &instance); err != nil {
 			return result, fmt.Errorf("cannot remove finalizer for vmuser: %w", err)
 		}
-	} else {
+	default:
 		if err := finalize.AddFinalizer(ctx, r.Client, &instance); err != nil {
 			return result, err
 		}
+		rotateAfter, err := vmauth.RotateVMUserPassword(events.AddToContext(ctx, &instance), r.Client, &instance)
+		if err != nil {
+			return result, fmt.Errorf("cannot rotate generated password for vmuser: %w", err)
+		}
+		// schedule the next password rotation
+		result.RequeueAfter = rotateAfter
 	}
 
 	if vmauthRateLimiter.MustThrottleReconcile() {
@@ -87,13 +104,14 @@ func (r *VMUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res
 	}
 
 	for _, vmauthItem := range vmauthes.Items {
-		if !vmauthItem.DeletionTimestamp.IsZero() || vmauthItem.Spec.ParsingError != "" || vmauthItem.IsUnmanaged() {
+		if !isNamespaceOwned(ctx, vmauthItem.Namespace) || !vmauthItem.DeletionTimestamp.IsZero() || vmauthItem.Spec.ParsingError != "" || vmauthItem.IsUnmanaged() || vmauthItem.Paused() {
 			continue
 		}
 		// reconcile users for given vmauth.
 		currentVMAuth := &vmauthItem
 		l = l.WithValues("parent_vmauth", currentVMAuth.Name, "parent_namespace", currentVMAuth.Namespace)
 		ctx := logger.AddToContext(ctx, l)
+		ctx = events.AddToContext(ctx, currentVMAuth)
 
 		// only check selector when deleting, since labels can be changed when updating and we can't tell if it was selected before.
 		if instance.DeletionTimestamp.IsZero() && !currentVMAuth.Spec.SelectAllByDefault {
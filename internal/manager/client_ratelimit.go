@@ -0,0 +1,157 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/VictoriaMetrics/operator/internal/config"
+	vmcontroller "github.com/VictoriaMetrics/operator/internal/controller/operator"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/flowcontrol"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+var (
+	clientAutoTune         = managerFlags.Bool("client.autoTune", false, "enables automatic scaling of -client.qps and -client.burst with the number of managed objects and namespaces. Limits are bounded by -client.maxQPS and -client.maxBurst")
+	clientMaxQPS           = managerFlags.Int("client.maxQPS", 100, "defines upper bound of K8s client QPS for -client.autoTune")
+	clientMaxBurst         = managerFlags.Int("client.maxBurst", 200, "defines upper bound of K8s client burst for -client.autoTune")
+	clientAutoTuneInterval = managerFlags.Duration("client.autoTuneInterval", time.Minute, "defines how often K8s client limits are recalculated for -client.autoTune")
+)
+
+const (
+	// each objectsPerQPS managed objects add 1 QPS to the client limit
+	objectsPerQPS = 10
+	// each namespacesPerQPS namespaces add 1 QPS to the client limit
+	namespacesPerQPS = 20
+)
+
+// clientRateLimiter implements flowcontrol.RateLimiter
+// in contrast to flowcontrol.NewTokenBucketRateLimiter, its limits could be changed at runtime
+type clientRateLimiter struct {
+	l *rate.Limiter
+
+	baseQPS   int
+	baseBurst int
+	qps       prometheus.Gauge
+	burst     prometheus.Gauge
+}
+
+var _ flowcontrol.RateLimiter = (*clientRateLimiter)(nil)
+
+func newClientRateLimiter(qps, burst int) *clientRateLimiter {
+	rl := &clientRateLimiter{
+		l:         rate.NewLimiter(rate.Limit(qps), burst),
+		baseQPS:   qps,
+		baseBurst: burst,
+		qps: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "operator_client_qps_limit",
+			Help: "Current QPS limit of K8s client",
+		}),
+		burst: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "operator_client_burst_limit",
+			Help: "Current burst limit of K8s client",
+		}),
+	}
+	rl.qps.Set(float64(qps))
+	rl.burst.Set(float64(burst))
+	return rl
+}
+
+// TryAccept implements flowcontrol.RateLimiter interface
+func (rl *clientRateLimiter) TryAccept() bool {
+	return rl.l.Allow()
+}
+
+// Accept implements flowcontrol.RateLimiter interface
+func (rl *clientRateLimiter) Accept() {
+	_ = rl.l.Wait(context.Background())
+}
+
+// Stop implements flowcontrol.RateLimiter interface
+func (rl *clientRateLimiter) Stop() {}
+
+// QPS implements flowcontrol.RateLimiter interface
+func (rl *clientRateLimiter) QPS() float32 {
+	return float32(rl.l.Limit())
+}
+
+// Wait implements flowcontrol.RateLimiter interface
+func (rl *clientRateLimiter) Wait(ctx context.Context) error {
+	return rl.l.Wait(ctx)
+}
+
+// tune sets limits according to the number of managed objects and namespaces
+func (rl *clientRateLimiter) tune(objects, namespaces int) {
+	qps, burst := computeClientLimits(rl.baseQPS, rl.baseBurst, *clientMaxQPS, *clientMaxBurst, objects, namespaces)
+	if rate.Limit(qps) == rl.l.Limit() && burst == rl.l.Burst() {
+		return
+	}
+	setupLog.Info("changing K8s client limits", "qps", qps, "burst", burst, "objects", objects, "namespaces", namespaces)
+	rl.l.SetLimit(rate.Limit(qps))
+	rl.l.SetBurst(burst)
+	rl.qps.Set(float64(qps))
+	rl.burst.Set(float64(burst))
+}
+
+// computeClientLimits scales base limits with the number of objects and namespaces
+// result is bounded by base and max values
+func computeClientLimits(baseQPS, baseBurst, maxQPS, maxBurst, objects, namespaces int) (int, int) {
+	qps := baseQPS + objects/objectsPerQPS + namespaces/namespacesPerQPS
+	if qps > maxQPS {
+		qps = maxQPS
+	}
+	if qps < baseQPS {
+		qps = baseQPS
+	}
+	// keep the same ratio between burst and qps as configured by user
+	burst := qps * baseBurst / max(baseQPS, 1)
+	if burst > maxBurst {
+		burst = maxBurst
+	}
+	if burst < baseBurst {
+		burst = baseBurst
+	}
+	return qps, burst
+}
+
+// addClientAutoTune registers runnable, which periodically tunes client limits
+func addClientAutoTune(mgr ctrl.Manager, rl *clientRateLimiter) error {
+	if !*clientAutoTune {
+		return nil
+	}
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		t := time.NewTicker(*clientAutoTuneInterval)
+		defer t.Stop()
+		for {
+			namespaces, err := countNamespaces(ctx, mgr.GetAPIReader())
+			if err != nil {
+				setupLog.Error(err, "cannot count namespaces for K8s client limits")
+			} else {
+				rl.tune(vmcontroller.ManagedObjectsCount(), namespaces)
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-t.C:
+			}
+		}
+	}))
+}
+
+func countNamespaces(ctx context.Context, rclient client.Reader) (int, error) {
+	if watchNss := config.MustGetWatchNamespaces(); len(watchNss) > 0 {
+		return len(watchNss), nil
+	}
+	var nss metav1.PartialObjectMetadataList
+	nss.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("NamespaceList"))
+	if err := rclient.List(ctx, &nss); err != nil {
+		return 0, fmt.Errorf("cannot list namespaces: %w", err)
+	}
+	return len(nss.Items), nil
+}
@@ -0,0 +1,21 @@
+package manager
+
+import "testing"
+
+func TestComputeClientLimits(t *testing.T) {
+	f := func(objects, namespaces, wantQPS, wantBurst int) {
+		t.Helper()
+		qps, burst := computeClientLimits(5, 10, 100, 150, objects, namespaces)
+		if qps != wantQPS || burst != wantBurst {
+			t.Fatalf("unexpected limits for objects=%d namespaces=%d, got qps=%d burst=%d, want qps=%d burst=%d", objects, namespaces, qps, burst, wantQPS, wantBurst)
+		}
+	}
+	// small install keeps base limits
+	f(0, 0, 5, 10)
+	f(9, 19, 5, 10)
+	// limits grow with objects and namespaces
+	f(100, 40, 17, 34)
+	// limits are bounded by max values
+	f(600, 100, 70, 140)
+	f(10000, 1000, 100, 150)
+}
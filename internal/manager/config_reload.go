@@ -0,0 +1,132 @@
+package manager
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/VictoriaMetrics/VictoriaMetrics/lib/buildinfo"
+	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+)
+
+var (
+	configFile = managerFlags.String("configFile", "", "Optional path to file with operator configuration variables in KEY=VALUE format, e.g. mounted ConfigMap. "+
+		"Values from file take precedence over env variables. File is re-read on SIGHUP and every -configFile.checkInterval, so configuration is changed without operator restart")
+	configFileCheckInterval = managerFlags.Duration("configFile.checkInterval", 30*time.Second, "Interval for checking changes of -configFile. Zero value disables periodic checks, file is re-read only on SIGHUP")
+)
+
+var (
+	configReloadsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "operator_config_reloads_total",
+		Help: "Number of operator configuration reloads from -configFile",
+	})
+	configReloadErrorsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "operator_config_reload_errors_total",
+		Help: "Number of failed operator configuration reloads from -configFile",
+	})
+)
+
+// configFileReloader applies operator configuration from -configFile on its change
+type configFileReloader struct {
+	path string
+	data []byte
+	// onReload is called after configuration was replaced
+	onReload func(c *config.BaseOperatorConf)
+}
+
+// reload re-reads configuration file and applies it if file content was changed
+func (r *configFileReloader) reload() (bool, error) {
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return false, fmt.Errorf("cannot read config file=%q: %w", r.path, err)
+	}
+	if r.data != nil && bytes.Equal(data, r.data) {
+		return false, nil
+	}
+	overrides, err := config.ParseConfigFile(data)
+	if err != nil {
+		return false, fmt.Errorf("cannot parse config file=%q: %w", r.path, err)
+	}
+	changed, err := config.ReloadBaseConfig(overrides)
+	if err != nil {
+		return false, fmt.Errorf("incorrect configuration at file=%q: %w", r.path, err)
+	}
+	r.data = data
+	if changed && r.onReload != nil {
+		r.onReload(config.MustGetBaseConfig())
+	}
+	return changed, nil
+}
+
+// Start implements manager.Runnable
+func (r *configFileReloader) Start(ctx context.Context) error {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	defer signal.Stop(sighup)
+	var tickerC <-chan time.Time
+	if *configFileCheckInterval > 0 {
+		t := time.NewTicker(*configFileCheckInterval)
+		defer t.Stop()
+		tickerC = t.C
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sighup:
+			setupLog.Info("received SIGHUP, reloading operator configuration", "file", r.path)
+		case <-tickerC:
+		}
+		changed, err := r.reload()
+		if err != nil {
+			configReloadErrorsTotal.Inc()
+			setupLog.Error(err, "cannot reload operator configuration, previous configuration is used")
+			continue
+		}
+		if changed {
+			configReloadsTotal.Inc()
+			setupLog.Info("operator configuration reloaded, changes are applied at the next reconcile of objects", "file", r.path)
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable
+// configuration is used by webhooks, which are served by each operator replica
+func (r *configFileReloader) NeedLeaderElection() bool {
+	return false
+}
+
+// initConfigFile applies configuration from -configFile before start of operator
+func initConfigFile() (*configFileReloader, error) {
+	if *configFile == "" {
+		return nil, nil
+	}
+	r := &configFileReloader{path: *configFile}
+	if _, err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// addConfigFileReloader registers reloader of -configFile
+// reloaded configuration is applied to global label and annotation filters and served at effective config endpoint
+func addConfigFileReloader(mgr ctrl.Manager, r *configFileReloader) error {
+	if r == nil {
+		return nil
+	}
+	metrics.Registry.MustRegister(configReloadsTotal, configReloadErrorsTotal)
+	r.onReload = func(c *config.BaseOperatorConf) {
+		vmv1beta1.SetLabelAndAnnotationPrefixes(c.FilterChildLabelPrefixes, c.FilterChildAnnotationPrefixes)
+		updateEffectiveConfig(newEffectiveConfig(buildinfo.Version, c, managerFlags))
+	}
+	return mgr.Add(r)
+}
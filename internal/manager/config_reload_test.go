@@ -0,0 +1,54 @@
+package manager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/VictoriaMetrics/operator/internal/config"
+)
+
+func TestConfigFileReloader(t *testing.T) {
+	defer func() {
+		if _, err := config.ReloadBaseConfig(nil); err != nil {
+			t.Fatalf("cannot restore configuration: %s", err)
+		}
+	}()
+	path := filepath.Join(t.TempDir(), "operator.env")
+	var reloads int
+	r := &configFileReloader{path: path, onReload: func(_ *config.BaseOperatorConf) { reloads++ }}
+	f := func(data string, wantChanged, wantErr bool) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatalf("cannot write config file: %s", err)
+		}
+		changed, err := r.reload()
+		if (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+		if changed != wantChanged {
+			t.Fatalf("unexpected changed, got: %v, want: %v", changed, wantChanged)
+		}
+	}
+
+	f("VM_FILTERCHILDLABELPREFIXES=team.example.com/", true, false)
+	if got := config.MustGetBaseConfig().FilterChildLabelPrefixes; len(got) != 1 || got[0] != "team.example.com/" {
+		t.Fatalf("unexpected FilterChildLabelPrefixes: %v", got)
+	}
+
+	// file isn't changed
+	f("VM_FILTERCHILDLABELPREFIXES=team.example.com/", false, false)
+
+	// broken file keeps the previous configuration
+	f("VM_FILTERCHILDLABELPREFIXES", false, true)
+	if got := config.MustGetBaseConfig().FilterChildLabelPrefixes; len(got) != 1 {
+		t.Fatalf("unexpected FilterChildLabelPrefixes after failed reload: %v", got)
+	}
+
+	// file content is changed, but configuration is the same
+	f("# comment\nVM_FILTERCHILDLABELPREFIXES=team.example.com/", false, false)
+
+	if reloads != 1 {
+		t.Fatalf("unexpected number of reloads: %d", reloads)
+	}
+}
@@ -0,0 +1,86 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+var (
+	consolePluginService = managerFlags.String("openshift.consolePlugin.service", "", "namespace/name of Service, which serves OpenShift console plugin assets. "+
+		"Operator registers ConsolePlugin for it with -openshift.consolePlugin.name. Works only at OpenShift platform. Disabled by default")
+	consolePluginName        = managerFlags.String("openshift.consolePlugin.name", "victoriametrics-console-plugin", "Name of ConsolePlugin registered for -openshift.consolePlugin.service")
+	consolePluginPort        = managerFlags.Int("openshift.consolePlugin.port", 9443, "Port of -openshift.consolePlugin.service, which serves console plugin assets over https")
+	consolePluginDisplayName = managerFlags.String("openshift.consolePlugin.displayName", "VictoriaMetrics", "Display name of ConsolePlugin registered for -openshift.consolePlugin.service")
+)
+
+// consolePluginGVK is OpenShift ConsolePlugin, it's managed as unstructured object, since its API isn't served by generic kubernetes
+var consolePluginGVK = schema.GroupVersionKind{Group: "console.openshift.io", Version: "v1", Kind: "ConsolePlugin"}
+
+// +kubebuilder:rbac:groups=console.openshift.io,resources=consoleplugins,verbs=get;create;update
+
+// addConsolePlugin registers runnable, which creates or updates OpenShift ConsolePlugin for -openshift.consolePlugin.service
+// it must be called after platform configuration
+func addConsolePlugin(mgr ctrl.Manager) error {
+	if *consolePluginService == "" || !k8stools.IsOpenShift() {
+		return nil
+	}
+	ns, name, ok := strings.Cut(*consolePluginService, "/")
+	if !ok || ns == "" || name == "" {
+		return fmt.Errorf("-openshift.consolePlugin.service=%q must be in namespace/name format", *consolePluginService)
+	}
+	cp := buildConsolePlugin(*consolePluginName, *consolePluginDisplayName, types.NamespacedName{Namespace: ns, Name: name}, *consolePluginPort)
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		if err := createOrUpdateConsolePlugin(ctx, mgr.GetClient(), cp); err != nil {
+			return fmt.Errorf("cannot reconcile OpenShift console plugin: %w", err)
+		}
+		setupLog.Info("registered OpenShift console plugin", "name", cp.GetName(), "service", *consolePluginService)
+		return nil
+	}))
+}
+
+// buildConsolePlugin builds ConsolePlugin, which serves plugin assets from the given Service
+func buildConsolePlugin(name, displayName string, svc types.NamespacedName, port int) *unstructured.Unstructured {
+	cp := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"displayName": displayName,
+			"backend": map[string]any{
+				"type": "Service",
+				"service": map[string]any{
+					"name":      svc.Name,
+					"namespace": svc.Namespace,
+					"port":      int64(port),
+					"basePath":  "/",
+				},
+			},
+		},
+	}}
+	cp.SetGroupVersionKind(consolePluginGVK)
+	cp.SetName(name)
+	return cp
+}
+
+func createOrUpdateConsolePlugin(ctx context.Context, rclient client.Client, cp *unstructured.Unstructured) error {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(consolePluginGVK)
+	if err := rclient.Get(ctx, types.NamespacedName{Name: cp.GetName()}, existing); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return rclient.Create(ctx, cp)
+		}
+		return err
+	}
+	cp.SetLabels(existing.GetLabels())
+	cp.SetAnnotations(existing.GetAnnotations())
+	cp.SetResourceVersion(existing.GetResourceVersion())
+	return rclient.Update(ctx, cp)
+}
@@ -0,0 +1,44 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCreateOrUpdateConsolePlugin(t *testing.T) {
+	ctx := context.Background()
+	rclient := fake.NewClientBuilder().Build()
+	svc := types.NamespacedName{Namespace: "vm", Name: "console-plugin"}
+	f := func(port int, wantBackend map[string]any) {
+		t.Helper()
+		cp := buildConsolePlugin("victoriametrics-console-plugin", "VictoriaMetrics", svc, port)
+		if err := createOrUpdateConsolePlugin(ctx, rclient, cp); err != nil {
+			t.Fatalf("cannot reconcile console plugin: %s", err)
+		}
+		got := &unstructured.Unstructured{}
+		got.SetGroupVersionKind(consolePluginGVK)
+		if err := rclient.Get(ctx, types.NamespacedName{Name: "victoriametrics-console-plugin"}, got); err != nil {
+			t.Fatalf("cannot get console plugin: %s", err)
+		}
+		backend, _, _ := unstructured.NestedMap(got.Object, "spec", "backend")
+		assert.Equal(t, wantBackend, backend)
+		displayName, _, _ := unstructured.NestedString(got.Object, "spec", "displayName")
+		assert.Equal(t, "VictoriaMetrics", displayName)
+	}
+
+	// create
+	f(9443, map[string]any{
+		"type":    "Service",
+		"service": map[string]any{"name": "console-plugin", "namespace": "vm", "port": int64(9443), "basePath": "/"},
+	})
+	// update
+	f(8443, map[string]any{
+		"type":    "Service",
+		"service": map[string]any{"name": "console-plugin", "namespace": "vm", "port": int64(8443), "basePath": "/"},
+	})
+}
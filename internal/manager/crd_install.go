@@ -0,0 +1,179 @@
+package manager
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	goversion "github.com/hashicorp/go-version"
+	"github.com/prometheus/client_golang/prometheus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/VictoriaMetrics/operator/config/crd/overlay"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/certmanager"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+)
+
+var crdInstall = managerFlags.Bool("crd.install", false, "Whether to install and update operator CustomResourceDefinitions at start with server-side apply. "+
+	"CRDs are applied from manifests embedded into operator binary, CRDs installed by newer operator version are not downgraded. "+
+	"Operator requires create and patch permissions for customresourcedefinitions")
+
+const (
+	crdFieldOwner         = "vm-operator"
+	crdVersionAnnotation  = "operator.victoriametrics.com/crd-version"
+	crdInjectCAAnnotation = "cert-manager.io/inject-ca-from"
+)
+
+var crdVersionSkew = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "operator_crd_version_skew",
+	Help: "Whether CRD at cluster was installed by newer operator version and was not updated by -crd.install",
+}, []string{"crd"})
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;patch
+
+// crdInstallConfig defines how embedded CRDs are prepared before apply
+type crdInstallConfig struct {
+	// operatorVersion is written to CRD annotation and compared with installed CRD version
+	// empty value disables downgrade check, e.g. for development builds
+	operatorVersion string
+	// conversionService is namespace/name of operator webhook Service used for conversion webhook
+	// conversion strategy is set to None if empty
+	conversionService string
+	// injectCA adds cert-manager annotation for conversion webhook caBundle injection
+	injectCA bool
+}
+
+// loadEmbeddedCRDs parses CRD manifests embedded into operator binary
+func loadEmbeddedCRDs(data []byte) ([]*unstructured.Unstructured, error) {
+	var crds []*unstructured.Unstructured
+	dec := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		var obj map[string]any
+		if err := dec.Decode(&obj); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("cannot parse embedded CRDs: %w", err)
+		}
+		if len(obj) == 0 {
+			continue
+		}
+		crd := &unstructured.Unstructured{Object: obj}
+		if crd.GetKind() != "CustomResourceDefinition" {
+			return nil, fmt.Errorf("unexpected object kind=%q name=%q at embedded CRDs", crd.GetKind(), crd.GetName())
+		}
+		crds = append(crds, crd)
+	}
+	return crds, nil
+}
+
+// prepareCRD sets conversion and version annotation for embedded CRD
+// status is removed, since it's owned by API server
+func prepareCRD(crd *unstructured.Unstructured, cfg crdInstallConfig) error {
+	unstructured.RemoveNestedField(crd.Object, "status")
+	unstructured.RemoveNestedField(crd.Object, "metadata", "creationTimestamp")
+	annotations := crd.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	delete(annotations, crdInjectCAAnnotation)
+	if cfg.operatorVersion != "" {
+		annotations[crdVersionAnnotation] = cfg.operatorVersion
+	}
+	var conversion map[string]any
+	if cfg.conversionService != "" {
+		ns, name, ok := strings.Cut(cfg.conversionService, "/")
+		if !ok || ns == "" || name == "" {
+			return fmt.Errorf("conversion webhook service=%q must be in namespace/name format", cfg.conversionService)
+		}
+		conversion = map[string]any{
+			"strategy": "Webhook",
+			"webhook": map[string]any{
+				"clientConfig": map[string]any{
+					"service": map[string]any{
+						"namespace": ns,
+						"name":      name,
+						"path":      "/convert",
+					},
+				},
+				"conversionReviewVersions": []any{"v1"},
+			},
+		}
+		if cfg.injectCA {
+			// certificate is issued with the same name as webhook service
+			annotations[crdInjectCAAnnotation] = cfg.conversionService
+		}
+	} else {
+		conversion = map[string]any{"strategy": "None"}
+	}
+	crd.SetAnnotations(annotations)
+	return unstructured.SetNestedMap(crd.Object, conversion, "spec", "conversion")
+}
+
+// shouldUpdateCRD checks if CRD installed by given operator version could be replaced by current version
+func shouldUpdateCRD(installed, current string) bool {
+	if installed == "" || current == "" {
+		return true
+	}
+	installedV, err := goversion.NewVersion(installed)
+	if err != nil {
+		return true
+	}
+	currentV, err := goversion.NewVersion(current)
+	if err != nil {
+		return true
+	}
+	return !installedV.GreaterThan(currentV)
+}
+
+// installCRDs applies given CRDs with server-side apply
+// fields of CRDs managed by other field managers, such as caBundle injected by cert-manager, are preserved
+func installCRDs(ctx context.Context, rclient client.Client, crds []*unstructured.Unstructured, cfg crdInstallConfig) error {
+	l := logger.WithContext(ctx)
+	for _, crd := range crds {
+		if err := prepareCRD(crd, cfg); err != nil {
+			return fmt.Errorf("cannot prepare CRD=%q: %w", crd.GetName(), err)
+		}
+		var existing unstructured.Unstructured
+		existing.SetGroupVersionKind(crd.GroupVersionKind())
+		if err := rclient.Get(ctx, types.NamespacedName{Name: crd.GetName()}, &existing); err != nil {
+			if !k8serrors.IsNotFound(err) {
+				return fmt.Errorf("cannot get CRD=%q: %w", crd.GetName(), err)
+			}
+		} else if installed := existing.GetAnnotations()[crdVersionAnnotation]; !shouldUpdateCRD(installed, cfg.operatorVersion) {
+			l.Info("skipping update of CRD installed by newer operator version", "crd", crd.GetName(), "installed_version", installed, "operator_version", cfg.operatorVersion)
+			crdVersionSkew.WithLabelValues(crd.GetName()).Set(1)
+			continue
+		}
+		if err := rclient.Patch(ctx, crd, client.Apply, client.FieldOwner(crdFieldOwner), client.ForceOwnership); err != nil {
+			return fmt.Errorf("cannot apply CRD=%q: %w", crd.GetName(), err)
+		}
+		crdVersionSkew.WithLabelValues(crd.GetName()).Set(0)
+	}
+	l.Info("installed operator CRDs", "count", len(crds))
+	return nil
+}
+
+// installEmbeddedCRDs applies CRDs embedded into operator binary
+// it must be called before CRD ownership init, since CRD UIDs are cached there
+func installEmbeddedCRDs(ctx context.Context, rclient client.Client, operatorVersion string) error {
+	metrics.Registry.MustRegister(crdVersionSkew)
+	crds, err := loadEmbeddedCRDs(overlay.CRDs)
+	if err != nil {
+		return err
+	}
+	cfg := crdInstallConfig{operatorVersion: operatorVersion}
+	if *enableWebhooks && certmanager.IsEnabled() {
+		cfg.conversionService = *certManagerWebhookSvc
+		cfg.injectCA = true
+	}
+	return installCRDs(ctx, rclient, crds, cfg)
+}
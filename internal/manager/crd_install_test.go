@@ -0,0 +1,157 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/VictoriaMetrics/operator/config/crd/overlay"
+)
+
+func TestLoadEmbeddedCRDs(t *testing.T) {
+	crds, err := loadEmbeddedCRDs(overlay.CRDs)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(crds) == 0 {
+		t.Fatalf("expected embedded CRDs")
+	}
+	names := make(map[string]bool)
+	for _, crd := range crds {
+		group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+		if group != "operator.victoriametrics.com" {
+			t.Fatalf("unexpected group=%q of CRD=%q", group, crd.GetName())
+		}
+		names[crd.GetName()] = true
+	}
+	if !names["vmagents.operator.victoriametrics.com"] {
+		t.Fatalf("vmagents CRD is missing at embedded CRDs")
+	}
+
+	if _, err := loadEmbeddedCRDs([]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: test\n")); err == nil {
+		t.Fatalf("expected error for non CRD object")
+	}
+}
+
+func TestPrepareCRD(t *testing.T) {
+	f := func(cfg crdInstallConfig, wantStrategy string, wantAnnotations map[string]string, wantErr bool) {
+		t.Helper()
+		crds, err := loadEmbeddedCRDs(overlay.CRDs)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		crd := crds[0]
+		// annotation from previous install must be removed
+		annotations := crd.GetAnnotations()
+		annotations[crdInjectCAAnnotation] = "system/webhook-service"
+		crd.SetAnnotations(annotations)
+		err = prepareCRD(crd, cfg)
+		if wantErr {
+			if err == nil {
+				t.Fatalf("expected error")
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		strategy, _, _ := unstructured.NestedString(crd.Object, "spec", "conversion", "strategy")
+		if strategy != wantStrategy {
+			t.Fatalf("unexpected conversion strategy, got=%q, want=%q", strategy, wantStrategy)
+		}
+		if _, ok, _ := unstructured.NestedMap(crd.Object, "status"); ok {
+			t.Fatalf("status must be removed")
+		}
+		got := crd.GetAnnotations()
+		if len(got) != len(wantAnnotations) {
+			t.Fatalf("unexpected annotations, got=%v, want=%v", got, wantAnnotations)
+		}
+		for k, v := range wantAnnotations {
+			if got[k] != v {
+				t.Fatalf("unexpected annotation=%q value, got=%q, want=%q", k, got[k], v)
+			}
+		}
+		if strategy == "Webhook" {
+			svc, _, _ := unstructured.NestedString(crd.Object, "spec", "conversion", "webhook", "clientConfig", "service", "name")
+			if svc != "vm-operator" {
+				t.Fatalf("unexpected conversion webhook service=%q", svc)
+			}
+		}
+	}
+
+	// conversion is not needed
+	f(crdInstallConfig{operatorVersion: "v0.49.0"}, "None", map[string]string{
+		"controller-gen.kubebuilder.io/version": "v0.15.0",
+		crdVersionAnnotation:                    "v0.49.0",
+	}, false)
+
+	// development build
+	f(crdInstallConfig{}, "None", map[string]string{
+		"controller-gen.kubebuilder.io/version": "v0.15.0",
+	}, false)
+
+	// conversion webhook with cert-manager
+	f(crdInstallConfig{operatorVersion: "v0.49.0", conversionService: "vm/vm-operator", injectCA: true}, "Webhook", map[string]string{
+		"controller-gen.kubebuilder.io/version": "v0.15.0",
+		crdVersionAnnotation:                    "v0.49.0",
+		crdInjectCAAnnotation:                   "vm/vm-operator",
+	}, false)
+
+	// incorrect service
+	f(crdInstallConfig{conversionService: "vm-operator"}, "", nil, true)
+}
+
+func TestShouldUpdateCRD(t *testing.T) {
+	f := func(installed, current string, want bool) {
+		t.Helper()
+		if got := shouldUpdateCRD(installed, current); got != want {
+			t.Fatalf("unexpected result for installed=%q, current=%q, got=%v, want=%v", installed, current, got, want)
+		}
+	}
+
+	f("", "v0.49.0", true)
+	f("v0.49.0", "", true)
+	f("v0.48.2", "v0.49.0", true)
+	f("v0.49.0", "v0.49.0", true)
+	f("v0.50.0", "v0.49.0", false)
+	f("v0.50.0-enterprise", "v0.49.0", false)
+	f("incorrect", "v0.49.0", true)
+}
+
+func TestInstallCRDsSkipsNewerVersion(t *testing.T) {
+	crds, err := loadEmbeddedCRDs(overlay.CRDs)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	crd := crds[0]
+	s := runtime.NewScheme()
+	if err := apiextensionsv1.AddToScheme(s); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	existing := &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{
+		Name:        crd.GetName(),
+		Annotations: map[string]string{crdVersionAnnotation: "v0.50.0"},
+	}}
+	rclient := fake.NewClientBuilder().WithScheme(s).WithObjects(existing).Build()
+	ctx := context.Background()
+	if err := installCRDs(ctx, rclient, crds[:1], crdInstallConfig{operatorVersion: "v0.49.0"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := testutil.ToFloat64(crdVersionSkew.WithLabelValues(crd.GetName())); got != 1 {
+		t.Fatalf("unexpected version skew value=%v", got)
+	}
+	var got apiextensionsv1.CustomResourceDefinition
+	if err := rclient.Get(ctx, client.ObjectKeyFromObject(existing), &got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got.Annotations[crdVersionAnnotation] != "v0.50.0" {
+		t.Fatalf("CRD installed by newer version must not be updated")
+	}
+}
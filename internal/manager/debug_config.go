@@ -0,0 +1,147 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/alertmanager"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/vmagent"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/vmauth"
+	"gopkg.in/yaml.v2"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// debugConfigPath serves configuration generated by operator at -metrics-bind-address
+const debugConfigPath = "/debug/config/"
+
+var debugConfigEnable = managerFlags.Bool("debugConfig.enable", false, "enables GET "+debugConfigPath+"<kind>/<namespace>/<name> endpoint at -metrics-bind-address. "+
+	"It serves configuration generated by operator for vmagent, vmalertmanager and vmauth objects with redacted credentials. Requires -mtls.enable")
+
+// generatedConfigLoaders returns generated configuration of object by lower-cased kind
+var generatedConfigLoaders = map[string]func(ctx context.Context, rclient client.Client, nsn types.NamespacedName) ([]byte, error){
+	"vmagent": func(ctx context.Context, rclient client.Client, nsn types.NamespacedName) ([]byte, error) {
+		var cr vmv1beta1.VMAgent
+		if err := rclient.Get(ctx, nsn, &cr); err != nil {
+			return nil, err
+		}
+		return vmagent.GeneratedConfig(ctx, rclient, &cr)
+	},
+	"vmalertmanager": func(ctx context.Context, rclient client.Client, nsn types.NamespacedName) ([]byte, error) {
+		var cr vmv1beta1.VMAlertmanager
+		if err := rclient.Get(ctx, nsn, &cr); err != nil {
+			return nil, err
+		}
+		return alertmanager.GeneratedConfig(ctx, rclient, &cr)
+	},
+	"vmauth": func(ctx context.Context, rclient client.Client, nsn types.NamespacedName) ([]byte, error) {
+		var cr vmv1beta1.VMAuth
+		if err := rclient.Get(ctx, nsn, &cr); err != nil {
+			return nil, err
+		}
+		return vmauth.GeneratedConfig(ctx, rclient, &cr)
+	},
+}
+
+// addDebugConfig registers generated configuration endpoint at metrics server
+// endpoint exposes configuration of any object, so it's allowed only for clients with verified certificates
+func addDebugConfig(mgr ctrl.Manager) error {
+	if !*debugConfigEnable {
+		return nil
+	}
+	if !*mtlsEnable {
+		return fmt.Errorf("-debugConfig.enable requires -mtls.enable flag")
+	}
+	return mgr.AddMetricsServerExtraHandler(debugConfigPath, &debugConfig{rclient: mgr.GetClient()})
+}
+
+// debugConfig serves configuration generated by operator for the given object
+type debugConfig struct {
+	rclient client.Client
+}
+
+// ServeHTTP implements http.Handler
+func (dc *debugConfig) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "only GET method is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, debugConfigPath), "/")
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		http.Error(w, fmt.Sprintf("path must be in form of %s<kind>/<namespace>/<name>", debugConfigPath), http.StatusBadRequest)
+		return
+	}
+	kind := strings.ToLower(parts[0])
+	load, ok := generatedConfigLoaders[kind]
+	if !ok {
+		http.Error(w, fmt.Sprintf("unsupported kind=%q, supported kinds: vmagent, vmalertmanager, vmauth", parts[0]), http.StatusBadRequest)
+		return
+	}
+	ctx := r.Context()
+	data, err := load(ctx, dc.rclient, types.NamespacedName{Namespace: parts[1], Name: parts[2]})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("cannot load generated config: %s", err), http.StatusInternalServerError)
+		return
+	}
+	data, err = redactConfig(data)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("cannot redact generated config: %s", err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	if _, err := w.Write(data); err != nil {
+		logger.WithContext(ctx).Error(err, "cannot write debug config response")
+	}
+}
+
+// sensitiveConfigKeyRegex matches keys of credentials at generated configuration
+// references to files with credentials are kept as is
+var sensitiveConfigKeyRegex = regexp.MustCompile(`(?i)(password|secret|token|credentials|_key)$`)
+
+// redactConfig hides credentials of the given yaml configuration
+func redactConfig(data []byte) ([]byte, error) {
+	var cfg yaml.MapSlice
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(redactValue("", cfg))
+}
+
+func redactValue(key string, v any) any {
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		for i := range v {
+			v[i].Value = redactValue(fmt.Sprint(v[i].Key), v[i].Value)
+		}
+		return v
+	case []any:
+		for i := range v {
+			v[i] = redactValue(key, v[i])
+		}
+		return v
+	case string:
+		if sensitiveConfigKeyRegex.MatchString(key) {
+			return config.RedactedValue
+		}
+		// hide passwords of urls with user info
+		return config.RedactValue("", v)
+	default:
+		if v != nil && sensitiveConfigKeyRegex.MatchString(key) {
+			return config.RedactedValue
+		}
+		return v
+	}
+}
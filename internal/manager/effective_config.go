@@ -0,0 +1,96 @@
+package manager
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"sort"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/VictoriaMetrics/operator/internal/config"
+)
+
+// effectiveConfigPath serves resolved operator configuration at -metrics-bind-address
+const effectiveConfigPath = "/api/v1/config"
+
+var configInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "operator_config_info",
+	Help: "Hash of effective operator configuration served at " + effectiveConfigPath,
+}, []string{"hash"})
+
+// effectiveConfig is the resolved operator configuration from env variables and command-line flags
+type effectiveConfig struct {
+	Version string            `json:"version"`
+	Hash    string            `json:"hash"`
+	Env     map[string]string `json:"env"`
+	Flags   map[string]string `json:"flags"`
+}
+
+// newEffectiveConfig builds configuration with redacted sensitive values
+// hash is calculated from redacted values, so it could be compared with the served configuration
+func newEffectiveConfig(version string, baseConfig *config.BaseOperatorConf, fs *flag.FlagSet) *effectiveConfig {
+	ec := &effectiveConfig{
+		Version: version,
+		Env:     baseConfig.EffectiveValues(),
+		Flags:   make(map[string]string),
+	}
+	fs.VisitAll(func(f *flag.Flag) {
+		ec.Flags[f.Name] = config.RedactValue(f.Name, f.Value.String())
+	})
+	h := fnv.New64a()
+	for _, m := range []map[string]string{ec.Env, ec.Flags} {
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(h, "%s=%s\n", k, m[k])
+		}
+	}
+	ec.Hash = fmt.Sprintf("%x", h.Sum64())
+	return ec
+}
+
+// ServeHTTP implements http.Handler
+func (ec *effectiveConfig) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "only GET method is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(ec); err != nil {
+		setupLog.Error(err, "cannot write effective config response")
+	}
+}
+
+// currentEffectiveConfig is served at effectiveConfigPath, it's replaced after reload of -configFile
+var currentEffectiveConfig atomic.Pointer[effectiveConfig]
+
+// addEffectiveConfig registers effective configuration endpoint at metrics server
+// and exposes hash of configuration with info metric
+func addEffectiveConfig(mgr ctrl.Manager, ec *effectiveConfig) error {
+	updateEffectiveConfig(ec)
+	if err := metrics.Registry.Register(configInfo); err != nil {
+		return fmt.Errorf("cannot register config info metric: %w", err)
+	}
+	return mgr.AddMetricsServerExtraHandler(effectiveConfigPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		currentEffectiveConfig.Load().ServeHTTP(w, r)
+	}))
+}
+
+// updateEffectiveConfig replaces served configuration and its hash at info metric
+func updateEffectiveConfig(ec *effectiveConfig) {
+	configInfo.Reset()
+	configInfo.WithLabelValues(ec.Hash).Set(1)
+	currentEffectiveConfig.Store(ec)
+}
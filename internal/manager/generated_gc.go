@@ -0,0 +1,195 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+)
+
+var (
+	generatedGCInterval = managerFlags.Duration("generatedObjectsGC.interval", 0, "Interval for search of orphaned Secrets and ConfigMaps generated by operator, e.g. left after rename of custom resource. "+
+		"Found objects are logged and counted at vm_operator_orphaned_objects metric. Disabled by default")
+	generatedGCDelete = managerFlags.Bool("generatedObjectsGC.delete", false, "Whether to delete orphaned objects found by garbage collector. By default, garbage collector works in dry-run mode and only reports found objects. Works only with -generatedObjectsGC.interval")
+)
+
+var (
+	orphanedObjects = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vm_operator_orphaned_objects",
+		Help: "Number of orphaned objects generated by operator found at the last garbage collection",
+	}, []string{"kind"})
+	orphanedObjectsDeleted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "vm_operator_orphaned_objects_deleted_total",
+		Help: "Number of orphaned objects generated by operator deleted by garbage collector",
+	}, []string{"kind"})
+)
+
+// generatedObjectKinds defines kinds of objects checked by garbage collector
+var generatedObjectKinds = []string{"Secret", "ConfigMap"}
+
+// ownerKindByName maps app.kubernetes.io/name label of generated object to kind of its owner
+var ownerKindByName = map[string]string{
+	"vlogs":             "VLogs",
+	"vmagent":           "VMAgent",
+	"vmalert":           "VMAlert",
+	"vmalertmanager":    "VMAlertmanager",
+	"vmauth":            "VMAuth",
+	"vmcluster":         "VMCluster",
+	"vmselect":          "VMCluster",
+	"vminsert":          "VMCluster",
+	"vmstorage":         "VMCluster",
+	"vmdatamigration":   "VMDataMigration",
+	"vmmaintenancetask": "VMMaintenanceTask",
+	"vmruletest":        "VMRuleTest",
+	"vmsingle":          "VMSingle",
+	"vmuser":            "VMUser",
+}
+
+// generatedObjectsGC searches Secrets and ConfigMaps generated by operator, which owner doesn't exist anymore
+type generatedObjectsGC struct {
+	reader     client.Reader
+	writer     client.Writer
+	namespaces []string
+	delete     bool
+}
+
+// addGeneratedObjectsGC registers runnable, which periodically searches and optionally deletes orphaned generated objects
+func addGeneratedObjectsGC(mgr ctrl.Manager) error {
+	if *generatedGCInterval <= 0 {
+		if *generatedGCDelete {
+			return fmt.Errorf("-generatedObjectsGC.delete flag requires -generatedObjectsGC.interval to be set")
+		}
+		return nil
+	}
+	metrics.Registry.MustRegister(orphanedObjects, orphanedObjectsDeleted)
+	gc := &generatedObjectsGC{
+		reader:     mgr.GetAPIReader(),
+		writer:     mgr.GetClient(),
+		namespaces: config.MustGetWatchNamespaces(),
+		delete:     *generatedGCDelete,
+	}
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		t := time.NewTicker(*generatedGCInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-t.C:
+			}
+			gc.run(ctx)
+		}
+	}))
+}
+
+func (gc *generatedObjectsGC) run(ctx context.Context) {
+	for _, kind := range generatedObjectKinds {
+		orphans, err := gc.findOrphans(ctx, kind)
+		if err != nil {
+			setupLog.Error(err, "cannot search orphaned objects generated by operator", "kind", kind)
+			continue
+		}
+		orphanedObjects.WithLabelValues(kind).Set(float64(len(orphans)))
+		for _, o := range orphans {
+			if !gc.delete {
+				setupLog.Info("found orphaned object generated by operator, it could be removed with -generatedObjectsGC.delete flag", "kind", kind, "name", o.Name, "namespace", o.Namespace)
+				continue
+			}
+			// object could be re-created with the same name since the search
+			if err := gc.writer.Delete(ctx, o, client.Preconditions{UID: &o.UID}); err != nil {
+				if !k8serrors.IsNotFound(err) && !k8serrors.IsConflict(err) {
+					setupLog.Error(err, "cannot delete orphaned object generated by operator", "kind", kind, "name", o.Name, "namespace", o.Namespace)
+				}
+				continue
+			}
+			orphanedObjectsDeleted.WithLabelValues(kind).Inc()
+			setupLog.Info("deleted orphaned object generated by operator", "kind", kind, "name", o.Name, "namespace", o.Namespace)
+		}
+	}
+}
+
+// findOrphans returns objects of the given kind with operator labels, which owner doesn't exist
+func (gc *generatedObjectsGC) findOrphans(ctx context.Context, kind string) ([]*metav1.PartialObjectMetadata, error) {
+	namespaces := gc.namespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{""}
+	}
+	var orphans []*metav1.PartialObjectMetadata
+	for _, ns := range namespaces {
+		var l metav1.PartialObjectMetadataList
+		l.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind(kind + "List"))
+		if err := gc.reader.List(ctx, &l, client.InNamespace(ns), client.MatchingLabels{"managed-by": "vm-operator"}); err != nil {
+			return nil, fmt.Errorf("cannot list objects: %w", err)
+		}
+		for i := range l.Items {
+			o := &l.Items[i]
+			if o.DeletionTimestamp != nil {
+				continue
+			}
+			orphaned, err := gc.isOrphaned(ctx, o)
+			if err != nil {
+				return nil, fmt.Errorf("cannot check owner of object=%s/%s: %w", o.Namespace, o.Name, err)
+			}
+			if orphaned {
+				o.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind(kind))
+				orphans = append(orphans, o)
+			}
+		}
+	}
+	return orphans, nil
+}
+
+type generatedObjectOwner struct {
+	gvk  schema.GroupVersionKind
+	name string
+}
+
+// isOrphaned checks if none of operator owners of the given object exists
+// owner is resolved from owner references or from labels, if object has no owner references
+// objects owned by non operator objects or with unknown owner are never orphaned
+func (gc *generatedObjectsGC) isOrphaned(ctx context.Context, o *metav1.PartialObjectMetadata) (bool, error) {
+	var owners []generatedObjectOwner
+	for _, ref := range o.OwnerReferences {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil || gv.Group != vmv1beta1.GroupVersion.Group {
+			continue
+		}
+		owners = append(owners, generatedObjectOwner{gvk: gv.WithKind(ref.Kind), name: ref.Name})
+	}
+	if len(owners) == 0 {
+		if len(o.OwnerReferences) > 0 {
+			return false, nil
+		}
+		kind, ok := ownerKindByName[o.Labels["app.kubernetes.io/name"]]
+		instance := o.Labels["app.kubernetes.io/instance"]
+		if !ok || instance == "" {
+			return false, nil
+		}
+		owners = append(owners, generatedObjectOwner{gvk: vmv1beta1.GroupVersion.WithKind(kind), name: instance})
+	}
+	for _, owner := range owners {
+		var obj metav1.PartialObjectMetadata
+		obj.SetGroupVersionKind(owner.gvk)
+		err := gc.reader.Get(ctx, types.NamespacedName{Namespace: o.Namespace, Name: owner.name}, &obj)
+		if err == nil {
+			return false, nil
+		}
+		if !k8serrors.IsNotFound(err) {
+			return false, err
+		}
+	}
+	return true, nil
+}
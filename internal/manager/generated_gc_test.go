@@ -0,0 +1,107 @@
+package manager
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestGeneratedObjectsGC(t *testing.T) {
+	generatedLabels := func(name, instance string) map[string]string {
+		return map[string]string{
+			"app.kubernetes.io/name":     name,
+			"app.kubernetes.io/instance": instance,
+			"managed-by":                 "vm-operator",
+		}
+	}
+	ownerRef := func(apiVersion, kind, name string) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{APIVersion: apiVersion, Kind: kind, Name: name}}
+	}
+	predefinedObjects := []runtime.Object{
+		&vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: "exist", Namespace: "default"}},
+		// owner exists
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "vmagent-exist", Namespace: "default", Labels: generatedLabels("vmagent", "exist")}},
+		// owner resolved from labels is missing
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "vmagent-renamed", Namespace: "default", Labels: generatedLabels("vmagent", "renamed"), UID: "1"}},
+		// owner at the other namespace is missing
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "vmagent-exist", Namespace: "other", Labels: generatedLabels("vmagent", "exist"), UID: "2"}},
+		// owner reference is resolved before labels
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name: "vmuser-exist", Namespace: "default", Labels: generatedLabels("vmuser", "missing"),
+			OwnerReferences: ownerRef("operator.victoriametrics.com/v1beta1", "VMAgent", "exist"),
+		}},
+		// owned by non operator object
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name: "owned-by-service", Namespace: "default", Labels: generatedLabels("vmagent", "missing"),
+			OwnerReferences: ownerRef("v1", "Service", "missing"),
+		}},
+		// unknown owner
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "vm-operator-tls", Namespace: "default", Labels: generatedLabels("vm-operator", "operator")}},
+		// not generated by operator
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "user-secret", Namespace: "default", Labels: map[string]string{"app.kubernetes.io/name": "vmagent", "app.kubernetes.io/instance": "missing"}}},
+		// owner reference is missing
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name: "vmalert-missing", Namespace: "default", Labels: generatedLabels("vmalert", "missing"), UID: "3",
+			OwnerReferences: ownerRef("operator.victoriametrics.com/v1beta1", "VMAlert", "missing"),
+		}},
+	}
+	ctx := context.Background()
+	f := func(namespaces []string, kind string, want []string) {
+		t.Helper()
+		rclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		gc := &generatedObjectsGC{reader: rclient, writer: rclient, namespaces: namespaces}
+		orphans, err := gc.findOrphans(ctx, kind)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		var got []string
+		for _, o := range orphans {
+			got = append(got, o.Namespace+"/"+o.Name)
+		}
+		sort.Strings(got)
+		assert.Equal(t, want, got)
+	}
+
+	// all namespaces
+	f(nil, "Secret", []string{"default/vmagent-renamed", "other/vmagent-exist"})
+	f(nil, "ConfigMap", []string{"default/vmalert-missing"})
+
+	// watched namespaces
+	f([]string{"default"}, "Secret", []string{"default/vmagent-renamed"})
+	f([]string{"default"}, "ConfigMap", []string{"default/vmalert-missing"})
+
+	// dry-run mode
+	rclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+	gc := &generatedObjectsGC{reader: rclient, writer: rclient}
+	gc.run(ctx)
+	var s corev1.Secret
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vmagent-renamed"}, &s); err != nil {
+		t.Fatalf("orphaned secret must not be deleted at dry-run mode: %s", err)
+	}
+
+	// deletion
+	gc.delete = true
+	gc.run(ctx)
+	for _, nn := range []types.NamespacedName{{Namespace: "default", Name: "vmagent-renamed"}, {Namespace: "other", Name: "vmagent-exist"}} {
+		if err := rclient.Get(ctx, nn, &s); !k8serrors.IsNotFound(err) {
+			t.Fatalf("orphaned secret=%s must be deleted, got error: %v", nn, err)
+		}
+	}
+	var cm corev1.ConfigMap
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vmalert-missing"}, &cm); !k8serrors.IsNotFound(err) {
+		t.Fatalf("orphaned configmap must be deleted, got error: %v", err)
+	}
+	if err := rclient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "vmagent-exist"}, &s); err != nil {
+		t.Fatalf("owned secret must not be deleted: %s", err)
+	}
+}
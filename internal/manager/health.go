@@ -0,0 +1,191 @@
+package manager
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+	vmcontroller "github.com/VictoriaMetrics/operator/internal/controller/operator"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const componentsHealthPath = "/health/components"
+
+// componentsHealthConcurrency limits number of concurrent component health probes
+const componentsHealthConcurrency = 8
+
+var (
+	deepHealthChecks                  = managerFlags.Bool("health.deepChecks", false, "enables additional health checks. Readiness endpoint checks that kubernetes API is reachable within -health.deepChecksTimeout, the check is available separately at /ready/kubernetes-api. Health of managed VMAgent, VMAlert and VMAlertmanager is reported at /health/components endpoint of metrics server, it does not affect operator readiness")
+	deepHealthChecksTimeout           = managerFlags.Duration("health.deepChecksTimeout", 5*time.Second, "defines deadline for each deep health check")
+	componentsHealthTLSInsecureVerify = managerFlags.Bool("health.componentsTLSInsecureSkipVerify", false, "whether to skip TLS certificate verification for components health probes at /health/components endpoint. Components with TLS usually serve certificate issued for service name by CA unknown to operator. Probes don't send any credentials and only check response status code")
+)
+
+func newComponentsHealthHTTPClient(insecureSkipVerify bool) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: insecureSkipVerify}, // #nosec G402
+		},
+	}
+}
+
+// addDeepHealthChecks registers optional health checks,
+// which allows to distinguish alive operator from functional one
+func addDeepHealthChecks(mgr ctrl.Manager) error {
+	if !*deepHealthChecks {
+		return nil
+	}
+	kclient, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("cannot build kubernetes client for health checks: %w", err)
+	}
+	if err := mgr.AddReadyzCheck("kubernetes-api", func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), *deepHealthChecksTimeout)
+		defer cancel()
+		if err := kclient.Discovery().RESTClient().Get().AbsPath("/readyz").Do(ctx).Error(); err != nil {
+			return fmt.Errorf("kubernetes API is not reachable: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("cannot register kubernetes-api ready check: %w", err)
+	}
+	// unhealthy component of a single tenant must not make operator unready,
+	// so components health is served by metrics server instead of readiness endpoint
+	hc := newComponentsHealthHTTPClient(*componentsHealthTLSInsecureVerify)
+	probe := func(ctx context.Context, healthURL string) error {
+		return probeHealthURL(ctx, hc, healthURL)
+	}
+	return mgr.AddMetricsServerExtraHandler(componentsHealthPath, componentsHealthHandler(mgr.GetClient(), *deepHealthChecksTimeout, probe))
+}
+
+// componentsHealthHandler reports health of components with config reload.
+// It responds with 503 status code if any of components is unhealthy
+func componentsHealthHandler(rclient client.Client, timeout time.Duration, probe func(ctx context.Context, healthURL string) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		results, err := checkComponentsHealth(ctx, rclient, probe)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		names := make([]string, 0, len(results))
+		healthy := true
+		for name, err := range results {
+			names = append(names, name)
+			if err != nil {
+				healthy = false
+			}
+		}
+		sort.Strings(names)
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if !healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		for _, name := range names {
+			if err := results[name]; err != nil {
+				fmt.Fprintf(w, "%s: %s\n", name, err)
+				continue
+			}
+			fmt.Fprintf(w, "%s: ok\n", name)
+		}
+	})
+}
+
+// checkComponentsHealth probes health endpoints of components with config reload.
+// It returns probe error keyed by kind/namespace/name, nil error means healthy component
+// at most componentsHealthConcurrency probes are performed concurrently
+func checkComponentsHealth(ctx context.Context, rclient client.Client, probe func(ctx context.Context, healthURL string) error) (map[string]error, error) {
+	urls, err := listComponentsHealthURLs(ctx, rclient)
+	if err != nil {
+		return nil, err
+	}
+	results := make(map[string]error, len(urls))
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	limitCh := make(chan struct{}, componentsHealthConcurrency)
+	for name, healthURL := range urls {
+		wg.Add(1)
+		limitCh <- struct{}{}
+		go func(name, healthURL string) {
+			defer func() {
+				<-limitCh
+				wg.Done()
+			}()
+			err := probe(ctx, healthURL)
+			mu.Lock()
+			results[name] = err
+			mu.Unlock()
+		}(name, healthURL)
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// listComponentsHealthURLs returns health urls of not paused components, keyed by kind/namespace/name
+// only components at namespaces owned by operator are listed
+func listComponentsHealthURLs(ctx context.Context, rclient client.Client) (map[string]string, error) {
+	urls := make(map[string]string)
+	nss := config.MustGetWatchNamespaces()
+	if err := k8stools.ListObjectsByNamespace(ctx, rclient, nss, func(objects *vmv1beta1.VMAgentList) {
+		for i := range objects.Items {
+			cr := &objects.Items[i]
+			if cr.Paused() || !vmcontroller.IsNamespaceOwned(ctx, cr.Namespace) {
+				continue
+			}
+			urls[fmt.Sprintf("vmagent/%s/%s", cr.Namespace, cr.Name)] = cr.AsURL() + cr.ProbePath()
+		}
+	}); err != nil {
+		return nil, fmt.Errorf("cannot list vmagents: %w", err)
+	}
+	if err := k8stools.ListObjectsByNamespace(ctx, rclient, nss, func(objects *vmv1beta1.VMAlertList) {
+		for i := range objects.Items {
+			cr := &objects.Items[i]
+			if cr.Paused() || !vmcontroller.IsNamespaceOwned(ctx, cr.Namespace) {
+				continue
+			}
+			urls[fmt.Sprintf("vmalert/%s/%s", cr.Namespace, cr.Name)] = cr.AsURL() + cr.ProbePath()
+		}
+	}); err != nil {
+		return nil, fmt.Errorf("cannot list vmalerts: %w", err)
+	}
+	if err := k8stools.ListObjectsByNamespace(ctx, rclient, nss, func(objects *vmv1beta1.VMAlertmanagerList) {
+		for i := range objects.Items {
+			cr := &objects.Items[i]
+			if cr.Paused() || !vmcontroller.IsNamespaceOwned(ctx, cr.Namespace) {
+				continue
+			}
+			urls[fmt.Sprintf("vmalertmanager/%s/%s", cr.Namespace, cr.Name)] = cr.AsURL() + cr.ProbePath()
+		}
+	}); err != nil {
+		return nil, fmt.Errorf("cannot list vmalertmanagers: %w", err)
+	}
+	return urls, nil
+}
+
+func probeHealthURL(ctx context.Context, hc *http.Client, healthURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, healthURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected status code=%d for %s: %s", resp.StatusCode, req.URL.Path, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+	vmcontroller "github.com/VictoriaMetrics/operator/internal/controller/operator"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestListComponentsHealthURLs(t *testing.T) {
+	f := func(predefinedObjects []runtime.Object, want map[string]string) {
+		t.Helper()
+		fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		got, err := listComponentsHealthURLs(context.Background(), fclient)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assert.Equal(t, want, got)
+	}
+
+	// no components
+	f(nil, map[string]string{})
+
+	// health paths with prefixes
+	f([]runtime.Object{
+		&vmv1beta1.VMAgent{
+			ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"},
+			Spec:       vmv1beta1.VMAgentSpec{CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{ExtraArgs: map[string]string{"http.pathPrefix": "/agent"}}},
+		},
+		&vmv1beta1.VMAlert{
+			ObjectMeta: metav1.ObjectMeta{Name: "alert", Namespace: "default"},
+		},
+		&vmv1beta1.VMAlertmanager{
+			ObjectMeta: metav1.ObjectMeta{Name: "am", Namespace: "monitoring"},
+			Spec:       vmv1beta1.VMAlertmanagerSpec{RoutePrefix: "/alertmanager"},
+		},
+	}, map[string]string{
+		"vmagent/default/agent":        "http://vmagent-agent.default.svc:8429/agent/health",
+		"vmalert/default/alert":        "http://vmalert-alert.default.svc:8080/health",
+		"vmalertmanager/monitoring/am": "http://vmalertmanager-am.monitoring.svc:9093/alertmanager/-/healthy",
+	})
+
+	// paused components are skipped
+	f([]runtime.Object{
+		&vmv1beta1.VMAgent{
+			ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"},
+			Spec:       vmv1beta1.VMAgentSpec{CommonApplicationDeploymentParams: vmv1beta1.CommonApplicationDeploymentParams{Paused: true}},
+		},
+	}, map[string]string{})
+}
+
+func TestListComponentsHealthURLsNamespaceSelector(t *testing.T) {
+	defer func() {
+		assert.NoError(t, config.SetWatchNamespaceSelector(""))
+		vmcontroller.InitWatchNamespaceSelector(nil)
+	}()
+	fclient := k8stools.GetTestClientWithObjects([]runtime.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "watched", Labels: map[string]string{"team": "monitoring"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+		&vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "watched"}},
+		&vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "other"}},
+		&vmv1beta1.VMAlert{ObjectMeta: metav1.ObjectMeta{Name: "alert", Namespace: "other"}},
+	})
+	assert.NoError(t, config.SetWatchNamespaceSelector("team=monitoring"))
+	vmcontroller.InitWatchNamespaceSelector(fclient)
+
+	got, err := listComponentsHealthURLs(context.Background(), fclient)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, map[string]string{
+		"vmagent/watched/agent": "http://vmagent-agent.watched.svc:8429/health",
+	}, got)
+}
+
+func TestCheckComponentsHealthConcurrency(t *testing.T) {
+	var objects []runtime.Object
+	for i := 0; i < componentsHealthConcurrency*3; i++ {
+		objects = append(objects, &vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("agent-%d", i), Namespace: "default"}})
+	}
+	fclient := k8stools.GetTestClientWithObjects(objects)
+	var inflight, maxInflight atomic.Int32
+	probe := func(_ context.Context, _ string) error {
+		n := inflight.Add(1)
+		defer inflight.Add(-1)
+		for {
+			m := maxInflight.Load()
+			if n <= m || maxInflight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	}
+	results, err := checkComponentsHealth(context.Background(), fclient, probe)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Len(t, results, len(objects))
+	assert.LessOrEqual(t, maxInflight.Load(), int32(componentsHealthConcurrency))
+}
+
+func TestProbeHealthURL(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			http.Error(w, "unhealthy", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("OK"))
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+
+	hc := newComponentsHealthHTTPClient(false)
+
+	// healthy component
+	assert.NoError(t, probeHealthURL(ctx, hc, srv.URL+"/health"))
+
+	// unhealthy component
+	assert.EqualError(t, probeHealthURL(ctx, hc, srv.URL+"/-/healthy"), "unexpected status code=503 for /-/healthy: unhealthy")
+}
+
+func TestComponentsHealthHandler(t *testing.T) {
+	f := func(unhealthy map[string]bool, wantCode int, wantBody string) {
+		t.Helper()
+		fclient := k8stools.GetTestClientWithObjects([]runtime.Object{
+			&vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"}},
+			&vmv1beta1.VMAlert{ObjectMeta: metav1.ObjectMeta{Name: "alert", Namespace: "default"}},
+		})
+		probe := func(_ context.Context, healthURL string) error {
+			if unhealthy[healthURL] {
+				return fmt.Errorf("connection refused")
+			}
+			return nil
+		}
+		rec := httptest.NewRecorder()
+		componentsHealthHandler(fclient, time.Second, probe).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, componentsHealthPath, nil))
+		assert.Equal(t, wantCode, rec.Code)
+		assert.Equal(t, wantBody, rec.Body.String())
+	}
+
+	// all components are healthy
+	f(nil, http.StatusOK, "vmagent/default/agent: ok\nvmalert/default/alert: ok\n")
+
+	// unhealthy component
+	f(map[string]bool{"http://vmalert-alert.default.svc:8080/health": true}, http.StatusServiceUnavailable,
+		"vmagent/default/agent: ok\nvmalert/default/alert: connection refused\n")
+}
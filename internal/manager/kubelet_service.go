@@ -0,0 +1,304 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+)
+
+var (
+	kubeletService = managerFlags.String("kubelet.service", "", "namespace/name of Service and Endpoints with kubelet addresses of cluster nodes managed by operator, e.g. kube-system/kubelet. "+
+		"It allows scraping kubelet, cadvisor and probes metrics with VMServiceScrape. Disabled by default")
+	kubeletNodeSelector          = managerFlags.String("kubelet.nodeSelector", "", "Label selector for nodes added to -kubelet.service Endpoints. By default, all nodes are added")
+	kubeletNodeAddressPriority   = managerFlags.String("kubelet.nodeAddressPriority", "internal", "Preferred type of node address for -kubelet.service Endpoints. Supported values: internal, external")
+	kubeletSyncInterval          = managerFlags.Duration("kubelet.syncInterval", 3*time.Minute, "Interval for synchronization of -kubelet.service Endpoints with cluster nodes")
+	kubeletServiceScrape         = managerFlags.Bool("kubelet.serviceScrape", false, "Whether to create VMServiceScrape for kubelet, cadvisor and probes metrics with the same name as -kubelet.service")
+	kubeletTLSInsecureSkipVerify = managerFlags.Bool("kubelet.tlsInsecureSkipVerify", true, "Whether to skip verification of kubelet serving certificate at VMServiceScrape created with -kubelet.serviceScrape. "+
+		"Kubelet certificates are usually self-signed, unless serving certificates are issued by cluster CA")
+	kubeletTLSCAFile = managerFlags.String("kubelet.tlsCAFile", kubeletServiceAccountDir+"/ca.crt", "Path to CA file at vmagent pod for verification of kubelet serving certificate. Works only with -kubelet.tlsInsecureSkipVerify=false")
+)
+
+const (
+	kubeletServiceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+	kubeletHTTPSPort         = 10250
+	kubeletHTTPPort          = 10255
+	kubeletCAdvisorPort      = 4194
+)
+
+// kubeletSync maintains Service and Endpoints with kubelet addresses of cluster nodes
+type kubeletSync struct {
+	rclient        client.Client
+	service        types.NamespacedName
+	nodeSelector   labels.Selector
+	addressTypes   []corev1.NodeAddressType
+	serviceScrape  bool
+	tlsInsecure    bool
+	tlsCAFile      string
+	lastNodesCount int
+}
+
+// addKubeletService registers runnable, which periodically synchronizes kubelet Endpoints with cluster nodes
+func addKubeletService(mgr ctrl.Manager) error {
+	if *kubeletService == "" {
+		if *kubeletServiceScrape {
+			return fmt.Errorf("-kubelet.serviceScrape flag requires -kubelet.service to be set")
+		}
+		return nil
+	}
+	ks, err := newKubeletSync(mgr.GetClient())
+	if err != nil {
+		return err
+	}
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		t := time.NewTicker(*kubeletSyncInterval)
+		defer t.Stop()
+		for {
+			if err := ks.sync(ctx); err != nil {
+				setupLog.Error(err, "cannot synchronize kubelet service", "service", ks.service.String())
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-t.C:
+			}
+		}
+	}))
+}
+
+func newKubeletSync(rclient client.Client) (*kubeletSync, error) {
+	ns, name, ok := strings.Cut(*kubeletService, "/")
+	if !ok || ns == "" || name == "" {
+		return nil, fmt.Errorf("-kubelet.service=%q must be in namespace/name format", *kubeletService)
+	}
+	selector, err := labels.Parse(*kubeletNodeSelector)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse -kubelet.nodeSelector=%q: %w", *kubeletNodeSelector, err)
+	}
+	var addressTypes []corev1.NodeAddressType
+	switch *kubeletNodeAddressPriority {
+	case "internal":
+		addressTypes = []corev1.NodeAddressType{corev1.NodeInternalIP, corev1.NodeExternalIP}
+	case "external":
+		addressTypes = []corev1.NodeAddressType{corev1.NodeExternalIP, corev1.NodeInternalIP}
+	default:
+		return nil, fmt.Errorf("unsupported -kubelet.nodeAddressPriority=%q, supported values: internal, external", *kubeletNodeAddressPriority)
+	}
+	return &kubeletSync{
+		rclient:       rclient,
+		service:       types.NamespacedName{Namespace: ns, Name: name},
+		nodeSelector:  selector,
+		addressTypes:  addressTypes,
+		serviceScrape: *kubeletServiceScrape,
+		tlsInsecure:   *kubeletTLSInsecureSkipVerify,
+		tlsCAFile:     *kubeletTLSCAFile,
+	}, nil
+}
+
+func (ks *kubeletSync) sync(ctx context.Context) error {
+	var nodes corev1.NodeList
+	if err := ks.rclient.List(ctx, &nodes, client.MatchingLabelsSelector{Selector: ks.nodeSelector}); err != nil {
+		return fmt.Errorf("cannot list nodes: %w", err)
+	}
+	svc := ks.buildService()
+	if err := reconcile.Service(ctx, ks.rclient, svc, nil); err != nil {
+		return fmt.Errorf("cannot reconcile kubelet service: %w", err)
+	}
+	if err := ks.reconcileEndpoints(ctx, ks.buildEndpoints(nodes.Items)); err != nil {
+		return fmt.Errorf("cannot reconcile kubelet endpoints: %w", err)
+	}
+	if ks.serviceScrape {
+		if err := reconcile.VMServiceScrapeForCRD(ctx, ks.rclient, ks.buildServiceScrape()); err != nil {
+			return fmt.Errorf("cannot reconcile kubelet VMServiceScrape: %w", err)
+		}
+	}
+	// log only changes, since sync is performed periodically
+	if len(nodes.Items) != ks.lastNodesCount {
+		setupLog.Info("synchronized kubelet service with cluster nodes", "service", ks.service.String(), "nodes", len(nodes.Items))
+		ks.lastNodesCount = len(nodes.Items)
+	}
+	return nil
+}
+
+func kubeletLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name": "kubelet",
+		"k8s-app":                "kubelet",
+		"managed-by":             "vm-operator",
+	}
+}
+
+func kubeletPorts() []corev1.ServicePort {
+	return []corev1.ServicePort{
+		{Name: "https-metrics", Port: kubeletHTTPSPort},
+		{Name: "http-metrics", Port: kubeletHTTPPort},
+		{Name: "cadvisor", Port: kubeletCAdvisorPort},
+	}
+}
+
+// buildService builds headless Service without selector, its Endpoints are managed by operator
+func (ks *kubeletSync) buildService() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ks.service.Name,
+			Namespace: ks.service.Namespace,
+			Labels:    kubeletLabels(),
+		},
+		Spec: corev1.ServiceSpec{
+			Type:      corev1.ServiceTypeClusterIP,
+			ClusterIP: corev1.ClusterIPNone,
+			Ports:     kubeletPorts(),
+		},
+	}
+}
+
+// buildEndpoints builds Endpoints with preferred address of each node
+// nodes without Ready condition are added as not ready addresses
+func (ks *kubeletSync) buildEndpoints(nodes []corev1.Node) *corev1.Endpoints {
+	var ready, notReady []corev1.EndpointAddress
+	for i := range nodes {
+		node := &nodes[i]
+		addr := ks.nodeAddress(node)
+		if addr == "" {
+			setupLog.Info("skipping node without address for kubelet service", "node", node.Name)
+			continue
+		}
+		ea := corev1.EndpointAddress{
+			IP:       addr,
+			NodeName: ptr.To(node.Name),
+			TargetRef: &corev1.ObjectReference{
+				Kind:       "Node",
+				Name:       node.Name,
+				UID:        node.UID,
+				APIVersion: "v1",
+			},
+		}
+		if isNodeReady(node) {
+			ready = append(ready, ea)
+		} else {
+			notReady = append(notReady, ea)
+		}
+	}
+	sortAddresses := func(src []corev1.EndpointAddress) {
+		sort.Slice(src, func(i, j int) bool {
+			return src[i].TargetRef.Name < src[j].TargetRef.Name
+		})
+	}
+	sortAddresses(ready)
+	sortAddresses(notReady)
+
+	eps := &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ks.service.Name,
+			Namespace: ks.service.Namespace,
+			Labels:    kubeletLabels(),
+		},
+	}
+	if len(ready) == 0 && len(notReady) == 0 {
+		return eps
+	}
+	subset := corev1.EndpointSubset{
+		Addresses:         ready,
+		NotReadyAddresses: notReady,
+	}
+	for _, p := range kubeletPorts() {
+		subset.Ports = append(subset.Ports, corev1.EndpointPort{Name: p.Name, Port: p.Port, Protocol: corev1.ProtocolTCP})
+	}
+	eps.Subsets = []corev1.EndpointSubset{subset}
+	return eps
+}
+
+func (ks *kubeletSync) nodeAddress(node *corev1.Node) string {
+	for _, t := range ks.addressTypes {
+		for _, addr := range node.Status.Addresses {
+			if addr.Type == t && addr.Address != "" {
+				return addr.Address
+			}
+		}
+	}
+	return ""
+}
+
+func isNodeReady(node *corev1.Node) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func (ks *kubeletSync) reconcileEndpoints(ctx context.Context, newEps *corev1.Endpoints) error {
+	var existing corev1.Endpoints
+	if err := ks.rclient.Get(ctx, types.NamespacedName{Namespace: newEps.Namespace, Name: newEps.Name}, &existing); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ks.rclient.Create(ctx, newEps)
+		}
+		return err
+	}
+	if equality.Semantic.DeepEqual(existing.Subsets, newEps.Subsets) &&
+		equality.Semantic.DeepEqual(existing.Labels, newEps.Labels) {
+		return nil
+	}
+	existing.Labels = newEps.Labels
+	existing.Subsets = newEps.Subsets
+	return ks.rclient.Update(ctx, &existing)
+}
+
+// buildServiceScrape builds VMServiceScrape for kubelet, cadvisor and probes metrics
+// vmagent authenticates with its service account token, which requires nodes/metrics permission
+func (ks *kubeletSync) buildServiceScrape() *vmv1beta1.VMServiceScrape {
+	tlsConfig := &vmv1beta1.TLSConfig{InsecureSkipVerify: ks.tlsInsecure}
+	if !ks.tlsInsecure {
+		tlsConfig.CAFile = ks.tlsCAFile
+	}
+	relabelings := []*vmv1beta1.RelabelConfig{
+		{SourceLabels: []string{"__meta_kubernetes_endpoint_address_target_name"}, TargetLabel: "node"},
+		{SourceLabels: []string{"__metrics_path__"}, TargetLabel: "metrics_path"},
+	}
+	var endpoints []vmv1beta1.Endpoint
+	for _, path := range []string{"/metrics", "/metrics/cadvisor", "/metrics/probes"} {
+		ep := vmv1beta1.Endpoint{
+			Port: "https-metrics",
+			EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{
+				Path:        path,
+				Scheme:      "https",
+				HonorLabels: true,
+			},
+			EndpointAuth: vmv1beta1.EndpointAuth{
+				BearerTokenFile: kubeletServiceAccountDir + "/token",
+				TLSConfig:       tlsConfig.DeepCopy(),
+			},
+			EndpointRelabelings: vmv1beta1.EndpointRelabelings{
+				RelabelConfigs: relabelings,
+			},
+		}
+		endpoints = append(endpoints, ep)
+	}
+	return &vmv1beta1.VMServiceScrape{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ks.service.Name,
+			Namespace: ks.service.Namespace,
+			Labels:    kubeletLabels(),
+		},
+		Spec: vmv1beta1.VMServiceScrapeSpec{
+			Selector:  metav1.LabelSelector{MatchLabels: kubeletLabels()},
+			Endpoints: endpoints,
+		},
+	}
+}
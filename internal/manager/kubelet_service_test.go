@@ -0,0 +1,140 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+)
+
+func TestKubeletSync(t *testing.T) {
+	newNode := func(name string, ready bool, nodeLabels map[string]string, addresses ...corev1.NodeAddress) *corev1.Node {
+		status := corev1.ConditionFalse
+		if ready {
+			status = corev1.ConditionTrue
+		}
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: nodeLabels},
+			Status: corev1.NodeStatus{
+				Addresses:  addresses,
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}},
+			},
+		}
+	}
+	internal := func(ip string) corev1.NodeAddress {
+		return corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: ip}
+	}
+	external := func(ip string) corev1.NodeAddress {
+		return corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: ip}
+	}
+	predefinedObjects := []runtime.Object{
+		newNode("node-b", true, map[string]string{"pool": "default"}, internal("10.0.0.2"), external("1.1.1.2")),
+		newNode("node-a", true, map[string]string{"pool": "default"}, internal("10.0.0.1"), external("1.1.1.1")),
+		newNode("node-c", false, map[string]string{"pool": "gpu"}, external("1.1.1.3")),
+		newNode("node-d", true, map[string]string{"pool": "gpu"}),
+	}
+	type opts struct {
+		nodeSelector      string
+		addressTypes      []corev1.NodeAddressType
+		serviceScrape     bool
+		wantReady         []string
+		wantNotReady      []string
+		wantServiceScrape bool
+	}
+	f := func(o opts) {
+		t.Helper()
+		ctx := context.Background()
+		rclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		selector, err := labels.Parse(o.nodeSelector)
+		if err != nil {
+			t.Fatalf("cannot parse selector: %s", err)
+		}
+		ks := &kubeletSync{
+			rclient:       rclient,
+			service:       types.NamespacedName{Namespace: "kube-system", Name: "kubelet"},
+			nodeSelector:  selector,
+			addressTypes:  o.addressTypes,
+			serviceScrape: o.serviceScrape,
+			tlsInsecure:   true,
+		}
+		// second sync must be no-op for already synchronized objects
+		for i := 0; i < 2; i++ {
+			if err := ks.sync(ctx); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+		var svc corev1.Service
+		if err := rclient.Get(ctx, ks.service, &svc); err != nil {
+			t.Fatalf("cannot get kubelet service: %s", err)
+		}
+		assert.Equal(t, corev1.ClusterIPNone, svc.Spec.ClusterIP)
+		assert.Empty(t, svc.Spec.Selector)
+
+		var eps corev1.Endpoints
+		if err := rclient.Get(ctx, ks.service, &eps); err != nil {
+			t.Fatalf("cannot get kubelet endpoints: %s", err)
+		}
+		var gotReady, gotNotReady []string
+		for _, ss := range eps.Subsets {
+			for _, addr := range ss.Addresses {
+				gotReady = append(gotReady, addr.TargetRef.Name+"="+addr.IP)
+			}
+			for _, addr := range ss.NotReadyAddresses {
+				gotNotReady = append(gotNotReady, addr.TargetRef.Name+"="+addr.IP)
+			}
+		}
+		assert.Equal(t, o.wantReady, gotReady)
+		assert.Equal(t, o.wantNotReady, gotNotReady)
+
+		var vss vmv1beta1.VMServiceScrape
+		err = rclient.Get(ctx, ks.service, &vss)
+		if !o.wantServiceScrape {
+			if err == nil {
+				t.Fatalf("unexpected VMServiceScrape for kubelet")
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("cannot get kubelet VMServiceScrape: %s", err)
+		}
+		var paths []string
+		for _, ep := range vss.Spec.Endpoints {
+			assert.Equal(t, "https-metrics", ep.Port)
+			assert.True(t, ep.TLSConfig.InsecureSkipVerify)
+			paths = append(paths, ep.Path)
+		}
+		assert.Equal(t, []string{"/metrics", "/metrics/cadvisor", "/metrics/probes"}, paths)
+		assert.Equal(t, svc.Labels, vss.Spec.Selector.MatchLabels)
+	}
+
+	// all nodes with internal address priority
+	f(opts{
+		addressTypes: []corev1.NodeAddressType{corev1.NodeInternalIP, corev1.NodeExternalIP},
+		wantReady:    []string{"node-a=10.0.0.1", "node-b=10.0.0.2"},
+		wantNotReady: []string{"node-c=1.1.1.3"},
+	})
+
+	// external address priority with service scrape
+	f(opts{
+		addressTypes:      []corev1.NodeAddressType{corev1.NodeExternalIP, corev1.NodeInternalIP},
+		serviceScrape:     true,
+		wantReady:         []string{"node-a=1.1.1.1", "node-b=1.1.1.2"},
+		wantNotReady:      []string{"node-c=1.1.1.3"},
+		wantServiceScrape: true,
+	})
+
+	// selected nodes only
+	f(opts{
+		nodeSelector: "pool=default",
+		addressTypes: []corev1.NodeAddressType{corev1.NodeInternalIP, corev1.NodeExternalIP},
+		wantReady:    []string{"node-a=10.0.0.1", "node-b=10.0.0.2"},
+	})
+}
@@ -20,9 +20,16 @@ import (
 	"github.com/VictoriaMetrics/operator/internal/config"
 	vmcontroller "github.com/VictoriaMetrics/operator/internal/controller/operator"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/build"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/certmanager"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/defaultrules"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/grafana"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
 	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/secretstore"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/tracing"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/vmagent"
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	"github.com/prometheus/client_golang/prometheus"
@@ -37,7 +44,6 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	restmetrics "k8s.io/client-go/tools/metrics"
-	"k8s.io/client-go/util/flowcontrol"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -74,6 +80,7 @@ var (
 	enableWebhooks      = managerFlags.Bool("webhook.enable", false, "adds webhook server, you must mount cert and key or use cert-manager")
 	webhookPort         = managerFlags.Int("webhook.port", defaultWebhookPort, "port to start webhook server on")
 	disableCRDOwnership = managerFlags.Bool("controller.disableCRDOwnership", false, "disables CRD ownership add to cluster wide objects, must be disabled for clusters, lower than v1.16.0")
+	disableFinalizers   = managerFlags.Bool("controller.disableFinalizers", false, "disables finalizers for operator objects and its children. Operator removes exist finalizers at the next reconcile. Cluster-scoped children and generated credentials of deleted objects are removed after object deletion")
 	webhooksDir         = managerFlags.String("webhook.certDir", "/tmp/k8s-webhook-server/serving-certs/", "root directory for webhook cert and key")
 	webhookCertName     = managerFlags.String("webhook.certName", "tls.crt", "name of webhook server Tls certificate inside tls.certDir")
 	webhookKeyName      = managerFlags.String("webhook.keyName", "tls.key", "name of webhook server Tls key inside tls.certDir")
@@ -96,10 +103,38 @@ var (
 	clientBurst                   = managerFlags.Int("client.burst", 10, "defines K8s client burst")
 	wasCacheSynced                = uint32(0)
 	disableCacheForObjects        = managerFlags.String("controller.disableCacheFor", "", "disables client for cache for API resources. Supported objects - namespace,pod,secret,configmap,deployment,statefulset")
+	watchNamespaceSelector        = managerFlags.String("watchNamespaceSelector", "", "Optional label selector of namespaces watched by operator, e.g. team=observability. Namespaces are matched at runtime, so objects are reconciled after namespace labeling without operator restart. It cannot be used together with WATCH_NAMESPACE env var")
 	disableSecretKeySpaceTrim     = managerFlags.Bool("disableSecretKeySpaceTrim", false, "disables trim of space at Secret/Configmap value content. It's a common mistake to put new line to the base64 encoded secret value.")
 	version                       = managerFlags.Bool("version", false, "Show operator version")
+	platform                      = managerFlags.String("platform", k8stools.PlatformKubernetes, "Platform specific behaviour of operator. Supported values: kubernetes, openshift and auto. auto detects OpenShift by route.openshift.io and security.openshift.io API groups")
+	openShiftSCC                  = managerFlags.String("openshift.scc", "", "Optional name of OpenShift SecurityContextConstraints granted to ServiceAccounts of components with RoleBinding. Works only at OpenShift platform")
+	otelEndpoint                  = managerFlags.String("otel.endpoint", "", "Optional OTLP HTTP endpoint for export of reconcile traces, e.g. http://otel-collector:4318/v1/traces. Tracing is disabled if empty")
+	otelSamplingRatio             = managerFlags.Float64("otel.samplingRatio", 1, "Ratio of traced reconciles in range (0...1]. Works only with -otel.endpoint")
+	grafanaIntegration            = managerFlags.String("grafana.operatorIntegration", "", "Optional integration with Grafana. Operator provisions datasources for VMSingle, VMCluster vmselect and VMAuth. "+
+		"Supported values: grafana-operator - creates GrafanaDatasource and GrafanaDashboard objects, sidecar - creates ConfigMaps with grafana_datasource label. Disabled if empty")
+	grafanaInstanceSelector = managerFlags.String("grafana.instanceSelector", "", "Comma-separated list of key=value labels of grafana-operator Grafana instances used for instanceSelector. Works only with -grafana.operatorIntegration=grafana-operator")
+	defaultRulesEnable      = managerFlags.Bool("defaultRules.enable", false, "Whether to create VMRule with default alerting and recording rules for VMAgent, VMSingle, VMCluster and VMAlertmanager. "+
+		"Rules are created for all objects regardless of spec.defaultRules.enabled")
+	defaultRulesExcludeSelector = managerFlags.String("defaultRules.excludeSelector", "", "Optional label selector for default rules excluded from all VMRules created by operator, e.g. alertname in (TooHighChurnRate) or severity=warning. "+
+		"Rules are matched by labels with alertname or record label")
+	secretStoreBackend = managerFlags.String("secretStore.backend", secretstore.BackendKubernetes, "Backend for credentials generated by operator, such as VMUser passwords. "+
+		"Supported values: kubernetes - stores credentials at cluster Secrets, vault - stores credentials at HashiCorp Vault KV v2 secrets engine, aws - stores credentials at AWS Secrets Manager")
+	secretStoreVaultAddress    = managerFlags.String("secretStore.vault.address", "", "Address of HashiCorp Vault server, e.g. https://vault.vault.svc:8200. Works only with -secretStore.backend=vault")
+	secretStoreVaultMountPath  = managerFlags.String("secretStore.vault.mountPath", "secret", "Mount path of Vault KV v2 secrets engine. Works only with -secretStore.backend=vault")
+	secretStoreVaultPathPrefix = managerFlags.String("secretStore.vault.pathPrefix", "vm-operator", "Path prefix for credentials stored at Vault. Works only with -secretStore.backend=vault")
+	secretStoreVaultTokenFile  = managerFlags.String("secretStore.vault.tokenFile", "", "Path to file with Vault token. The file is re-read on each request. VAULT_TOKEN env var is used if empty. Works only with -secretStore.backend=vault")
+	secretStoreAWSRegion       = managerFlags.String("secretStore.aws.region", "", "AWS region of Secrets Manager. AWS_REGION env var is used if empty. Works only with -secretStore.backend=aws")
+	secretStoreAWSNamePrefix   = managerFlags.String("secretStore.aws.namePrefix", "vm-operator/", "Name prefix for credentials stored at AWS Secrets Manager. Works only with -secretStore.backend=aws")
+	secretStoreCacheTTL        = managerFlags.Duration("secretStore.cacheTTL", 5*time.Minute, "How long credentials read from external secret store are cached in memory. Credentials changed outside of operator are synced after cache expiration. Zero value disables cache")
+	vmagentScrapeTargetsStatus = managerFlags.Bool("vmagent.scrapeTargetsStatus", false, "Whether to report number of targets discovered by VMAgents at status.targets of scrape objects. "+
+		"Targets are fetched from /api/v1/targets of VMAgent pods at each VMAgent reconcile. See also -vmagent.podsTLSInsecureSkipVerify")
+	vmagentPodsTLSInsecureSkipVerify = managerFlags.Bool("vmagent.podsTLSInsecureSkipVerify", false, "Whether to skip TLS certificate verification for requests to VMAgent pods made for remoteWrite throughput calculation and -vmagent.scrapeTargetsStatus. "+
+		"VMAgent with TLS usually serves certificate issued for service name, which cannot be verified for requests made by pod IP. Requests don't send any credentials")
 )
 
+// +kubebuilder:rbac:groups=security.openshift.io,resources=securitycontextconstraints,verbs=use
+// +kubebuilder:rbac:groups=grafana.integreatly.org,resources=grafanadatasources;grafanadashboards,verbs=get;create;update;delete
+
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
@@ -126,6 +161,10 @@ func RunManager(ctx context.Context) error {
 		os.Exit(0)
 	}
 
+	cfgReloader, err := initConfigFile()
+	if err != nil {
+		return fmt.Errorf("cannot load operator configuration file: %w", err)
+	}
 	baseConfig := config.MustGetBaseConfig()
 	if *printDefaults {
 		err := baseConfig.PrintDefaults(*printFormat)
@@ -153,11 +192,34 @@ func RunManager(ctx context.Context) error {
 	ctrl.SetLogger(l)
 
 	setupLog.Info("starting VictoriaMetrics operator", "build version", buildinfo.Version, "short_version", versionRe.FindString(buildinfo.Version))
+	if *otelEndpoint != "" {
+		shutdownTracing, err := tracing.Init(ctx, *otelEndpoint, buildinfo.Version, *otelSamplingRatio)
+		if err != nil {
+			setupLog.Error(err, "cannot setup tracing")
+			return err
+		}
+		defer func() {
+			// flush buffered spans
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := shutdownTracing(shutdownCtx); err != nil {
+				setupLog.Error(err, "cannot flush reconcile traces")
+			}
+		}()
+		setupLog.Info("exporting reconcile traces", "endpoint", *otelEndpoint, "sampling_ratio", *otelSamplingRatio)
+	}
 	r := metrics.Registry
 	r.MustRegister(appVersion, uptime, startedAt)
 	setupRuntimeMetrics(r)
 	addRestClientMetrics(r)
 	setupLog.Info("Registering Components.")
+	if err := config.SetWatchNamespaceSelector(*watchNamespaceSelector); err != nil {
+		return err
+	}
+	if selector := config.GetWatchNamespaceSelector(); selector != nil {
+		setupLog.Info("operator configured with watching for namespaces matched by selector", "selector", selector.String())
+	}
+	config.SetFinalizersDisabled(*disableFinalizers)
 	var watchNsCacheByName map[string]cache.Config
 	watchNss := config.MustGetWatchNamespaces()
 	if len(watchNss) > 0 {
@@ -169,14 +231,63 @@ func RunManager(ctx context.Context) error {
 	}
 
 	reconcile.InitDeadlines(baseConfig.PodWaitReadyIntervalCheck, baseConfig.AppReadyTimeout, baseConfig.PodWaitReadyTimeout)
+	reconcile.InitParallelism(baseConfig.ParallelChildReconciles)
+	reconcile.InitDriftDetection(vmcontroller.IsDriftAutoRevertEnabled())
+	reconcile.InitDiffEvents(vmcontroller.IsChildDiffEventsEnabled())
+	reconcile.InitRevisionHistory(vmcontroller.RevisionHistoryLimit())
+	reconcile.InitConfigRevisionHistory(vmcontroller.ConfigRevisionHistoryLimit())
+
+	if err := vmcontroller.InitSharding(); err != nil {
+		return err
+	}
+	leaderElectionID := "57410f0d.victoriametrics.com"
+	if vmcontroller.IsShardingEnabled() {
+		setupLog.Info("namespaces of reconciled objects are split between operator replicas", "shard", vmcontroller.CurrentShard())
+		// replicas of the same shard compete for its own lease
+		leaderElectionID = fmt.Sprintf("shard-%d.%s", vmcontroller.CurrentShard(), leaderElectionID)
+	}
 
 	config := ctrl.GetConfigOrDie()
-	config.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(float32(*clientQPS), *clientBurst)
+	rl := newClientRateLimiter(*clientQPS, *clientBurst)
+	r.MustRegister(rl.qps, rl.burst)
+	config.RateLimiter = rl
 
 	co, err := getClientCacheOptions(*disableCacheForObjects)
 	if err != nil {
 		return fmt.Errorf("cannot build cache options for manager: %w", err)
 	}
+	if err := certmanager.Init(*certManagerEnable, *certManagerIssuerName, *certManagerIssuerKind); err != nil {
+		return fmt.Errorf("cannot configure cert-manager integration: %w", err)
+	}
+	if err := grafana.Init(*grafanaIntegration, *grafanaInstanceSelector); err != nil {
+		return fmt.Errorf("cannot configure grafana integration: %w", err)
+	}
+	if err := defaultrules.Init(*defaultRulesEnable, *defaultRulesExcludeSelector); err != nil {
+		return fmt.Errorf("cannot configure default rules: %w", err)
+	}
+	vmagent.Init(*vmagentPodsTLSInsecureSkipVerify, *vmagentScrapeTargetsStatus)
+	if err := secretstore.Init(*secretStoreBackend, *secretStoreCacheTTL, secretstore.VaultConfig{
+		Address:    *secretStoreVaultAddress,
+		MountPath:  *secretStoreVaultMountPath,
+		PathPrefix: *secretStoreVaultPathPrefix,
+		TokenFile:  *secretStoreVaultTokenFile,
+	}, secretstore.AWSConfig{
+		Region:     *secretStoreAWSRegion,
+		NamePrefix: *secretStoreAWSNamePrefix,
+	}); err != nil {
+		return fmt.Errorf("cannot configure secret store: %w", err)
+	}
+	var webhookTLSOpts []func(*tls.Config)
+	var webhookCerts *webhookCertWatcher
+	if *enableWebhooks && certmanager.IsEnabled() {
+		webhookCerts, err = newWebhookCertWatcher(*certManagerWebhookSvc)
+		if err != nil {
+			return err
+		}
+		webhookTLSOpts = append(webhookTLSOpts, func(c *tls.Config) {
+			c.GetCertificate = webhookCerts.GetCertificate
+		})
+	}
 	mgr, err := ctrl.NewManager(config, ctrl.Options{
 		Logger: ctrl.Log.WithName("manager"),
 		Scheme: scheme,
@@ -199,9 +310,10 @@ func RunManager(ctx context.Context) error {
 			CertDir:  *webhooksDir,
 			CertName: *webhookCertName,
 			KeyName:  *webhookKeyName,
+			TLSOpts:  webhookTLSOpts,
 		}),
 		LeaderElection:   *leaderElect,
-		LeaderElectionID: "57410f0d.victoriametrics.com",
+		LeaderElectionID: leaderElectionID,
 		Cache: cache.Options{
 			DefaultNamespaces: watchNsCacheByName,
 		},
@@ -213,6 +325,10 @@ func RunManager(ctx context.Context) error {
 		setupLog.Error(err, "unable to start manager")
 		return err
 	}
+	events.Init(mgr.GetEventRecorderFor("victoria-metrics-operator"))
+	// namespaces are watched by controllers with -watchNamespaceSelector,
+	// so informer cache is used even if client cache is disabled for namespaces with -controller.disableCacheFor
+	vmcontroller.InitWatchNamespaceSelector(mgr.GetCache())
 	if err := mgr.AddReadyzCheck("ready", func(req *http.Request) error {
 		wasSynced := atomic.LoadUint32(&wasCacheSynced)
 		// fast path
@@ -236,6 +352,68 @@ func RunManager(ctx context.Context) error {
 	}); err != nil {
 		return fmt.Errorf("cannot register health endpoint: %w", err)
 	}
+	if err := addDeepHealthChecks(mgr); err != nil {
+		return err
+	}
+	if err := addReconcileTrigger(mgr); err != nil {
+		return fmt.Errorf("cannot add reconcile trigger endpoint: %w", err)
+	}
+	if err := addClientAutoTune(mgr, rl); err != nil {
+		return fmt.Errorf("cannot add K8s client limits auto tune: %w", err)
+	}
+	if err := addEffectiveConfig(mgr, newEffectiveConfig(buildinfo.Version, baseConfig, managerFlags)); err != nil {
+		return fmt.Errorf("cannot add effective config endpoint: %w", err)
+	}
+	if err := addConfigFileReloader(mgr, cfgReloader); err != nil {
+		return fmt.Errorf("cannot add config file reloader: %w", err)
+	}
+	if err := addDebugConfig(mgr); err != nil {
+		return fmt.Errorf("cannot add debug config endpoint: %w", err)
+	}
+	if err := addSLIs(ctx, mgr); err != nil {
+		return fmt.Errorf("cannot add controller SLIs endpoint: %w", err)
+	}
+	if err := addSelfScrape(mgr); err != nil {
+		return fmt.Errorf("cannot add operator self scrape: %w", err)
+	}
+	if err := addGeneratedObjectsGC(mgr); err != nil {
+		return fmt.Errorf("cannot add generated objects garbage collector: %w", err)
+	}
+	if err := addKubeletService(mgr); err != nil {
+		return fmt.Errorf("cannot add kubelet service: %w", err)
+	}
+
+	// preflight-only mode must not change cluster state
+	if *crdInstall && !*preflightOnly {
+		crdC, err := client.New(mgr.GetConfig(), client.Options{Scheme: scheme})
+		if err != nil {
+			return err
+		}
+		if err := installEmbeddedCRDs(ctx, crdC, versionRe.FindString(buildinfo.Version)); err != nil {
+			return fmt.Errorf("cannot install operator CRDs: %w", err)
+		}
+	}
+
+	if *preflightEnable || *preflightOnly {
+		report, err := runPreflightChecks(ctx, config, watchNss)
+		if err != nil {
+			return fmt.Errorf("cannot run preflight checks: %w", err)
+		}
+		failures := report.failures()
+		if *preflightOnly {
+			if err := report.write(os.Stdout); err != nil {
+				return fmt.Errorf("cannot write preflight report: %w", err)
+			}
+			if len(failures) > 0 {
+				return fmt.Errorf("%d preflight checks failed", len(failures))
+			}
+			return nil
+		}
+		for _, res := range failures {
+			setupLog.Error(res.err, "preflight check failed", "check", res.check, "target", res.target)
+		}
+		setupLog.Info("finished preflight checks", "total", len(report.results), "failed", len(failures))
+	}
 
 	if !*disableCRDOwnership && len(watchNss) == 0 {
 		initC, err := client.New(mgr.GetConfig(), client.Options{Scheme: scheme})
@@ -249,7 +427,27 @@ func RunManager(ctx context.Context) error {
 		}
 	}
 
+	if vmcontroller.IsStateStoreEnabled() {
+		// manager cache is not started yet, so state is loaded with direct client
+		ssC, err := client.New(mgr.GetConfig(), client.Options{Scheme: scheme})
+		if err != nil {
+			return err
+		}
+		ssRunnable, err := vmcontroller.InitStateStore(ctx, ssC)
+		if err != nil {
+			return fmt.Errorf("cannot init operator state store: %w", err)
+		}
+		if err := mgr.Add(ssRunnable); err != nil {
+			return fmt.Errorf("cannot add operator state store: %w", err)
+		}
+	}
+
 	if *enableWebhooks {
+		if webhookCerts != nil {
+			if err := addWebhookCertificate(ctx, mgr, webhookCerts); err != nil {
+				return err
+			}
+		}
 		if err = addWebhooks(mgr); err != nil {
 			l.Error(err, "cannot register webhooks")
 			return err
@@ -300,6 +498,30 @@ func RunManager(ctx context.Context) error {
 		setupLog.Error(err, "unable to create controller", "controller", "VMRule")
 		return err
 	}
+	if err = (&vmcontroller.VMRuleTestReconciler{
+		Client:       mgr.GetClient(),
+		Log:          ctrl.Log.WithName("controller").WithName("VMRuleTest"),
+		OriginScheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "VMRuleTest")
+		return err
+	}
+	if err = (&vmcontroller.VMMaintenanceTaskReconciler{
+		Client:       mgr.GetClient(),
+		Log:          ctrl.Log.WithName("controller").WithName("VMMaintenanceTask"),
+		OriginScheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "VMMaintenanceTask")
+		return err
+	}
+	if err = (&vmcontroller.VMDataMigrationReconciler{
+		Client:       mgr.GetClient(),
+		Log:          ctrl.Log.WithName("controller").WithName("VMDataMigration"),
+		OriginScheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "VMDataMigration")
+		return err
+	}
 	if err = (&vmcontroller.VMServiceScrapeReconciler{
 		Client:       mgr.GetClient(),
 		Log:          ctrl.Log.WithName("controller").WithName("VMServiceScrape"),
@@ -367,6 +589,17 @@ func RunManager(ctx context.Context) error {
 		setupLog.Error(err, "unable to create controller", "controller", "VMScrapeConfig")
 		return err
 	}
+	// VMScrapeGlobalConfig is cluster-scoped and cannot be accessed with namespaced permissions
+	if len(watchNss) == 0 {
+		if err = (&vmcontroller.VMScrapeGlobalConfigReconciler{
+			Client:       mgr.GetClient(),
+			Log:          ctrl.Log.WithName("controller").WithName("VMScrapeGlobalConfig"),
+			OriginScheme: mgr.GetScheme(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "VMScrapeGlobalConfig")
+			return err
+		}
+	}
 
 	if err = (&vmcontroller.VMAuthReconciler{
 		Client:       mgr.GetClient(),
@@ -395,6 +628,35 @@ func RunManager(ctx context.Context) error {
 		setupLog.Error(err, "unable to create controller", "controller", "VMAlertmanager")
 		return err
 	}
+	if err = (&vmcontroller.VMAlertmanagerTemplateReconciler{
+		Client:       mgr.GetClient(),
+		Log:          ctrl.Log.WithName("controller").WithName("VMAlertmanagerTemplateReconciler"),
+		OriginScheme: mgr.GetScheme(),
+		BaseConf:     baseConfig,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "VMAlertmanagerTemplate")
+		return err
+	}
+	if baseConfig.EnabledPrometheusExportConverter.ServiceScrape {
+		if err = (&vmcontroller.VMServiceScrapeExportReconciler{
+			Client:       mgr.GetClient(),
+			Log:          ctrl.Log.WithName("controller").WithName("VMServiceScrapeExport"),
+			OriginScheme: mgr.GetScheme(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "VMServiceScrapeExport")
+			return err
+		}
+	}
+	if baseConfig.EnabledPrometheusExportConverter.Rule {
+		if err = (&vmcontroller.VMRuleExportReconciler{
+			Client:       mgr.GetClient(),
+			Log:          ctrl.Log.WithName("controller").WithName("VMRuleExport"),
+			OriginScheme: mgr.GetScheme(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "VMRuleExport")
+			return err
+		}
+	}
 	// +kubebuilder:scaffold:builder
 	setupLog.Info("starting vmconverter clients")
 
@@ -415,11 +677,20 @@ func RunManager(ctx context.Context) error {
 	}
 
 	setupLog.Info("using kubernetes server version", "version", k8sServerVersion.String())
+	if err := k8stools.SetPlatform(*platform, *openShiftSCC, baseClient.Discovery()); err != nil {
+		return fmt.Errorf("cannot configure platform: %w", err)
+	}
+	if k8stools.IsOpenShift() {
+		setupLog.Info("using OpenShift platform specific settings")
+	}
+	if err := addConsolePlugin(mgr); err != nil {
+		return fmt.Errorf("cannot add OpenShift console plugin: %w", err)
+	}
 	wc, err := client.NewWithWatch(mgr.GetConfig(), client.Options{Scheme: scheme})
 	if err != nil {
 		return fmt.Errorf("cannot setup watch client: %w", err)
 	}
-	converterController, err := vmcontroller.NewConverterController(ctx, baseClient, wc, *promCRDResyncPeriod, baseConfig)
+	converterController, err := vmcontroller.NewConverterController(ctx, baseClient, wc, *promCRDResyncPeriod)
 	if err != nil {
 		setupLog.Error(err, "cannot setup prometheus CRD converter: %w", err)
 		return err
@@ -450,17 +721,30 @@ func addWebhooks(mgr ctrl.Manager) error {
 		}
 		return nil
 	}
+	if err := addDryRunWebhooks(mgr); err != nil {
+		return err
+	}
+	if err := addReferenceWebhooks(mgr); err != nil {
+		return err
+	}
 	return f([]client.Object{
-		&vmv1beta1.VMAgent{},
-		&vmv1beta1.VMAlert{},
 		&vmv1beta1.VMSingle{},
 		&vmv1beta1.VMCluster{},
 		&vmv1beta1.VLogs{},
 		&vmv1beta1.VMAlertmanager{},
-		&vmv1beta1.VMAlertmanagerConfig{},
+		&vmv1beta1.VMAlertmanagerTemplate{},
+		&vmv1beta1.VMOperatorSettings{},
 		&vmv1beta1.VMAuth{},
-		&vmv1beta1.VMUser{},
 		&vmv1beta1.VMRule{},
+		&vmv1beta1.VMServiceScrape{},
+		&vmv1beta1.VMPodScrape{},
+		&vmv1beta1.VMScrapeConfig{},
+		&vmv1beta1.VMRuleTest{},
+		&vmv1beta1.VMMaintenanceTask{},
+		&vmv1beta1.VMScrapeGlobalConfig{},
+		&vmv1beta1.VMRemoteWriteTarget{},
+		&vmv1beta1.VMRelabelConfig{},
+		&vmv1beta1.VMDataMigration{},
 	})
 }
 
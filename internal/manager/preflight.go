@@ -0,0 +1,379 @@
+package manager
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/VictoriaMetrics/operator/config/crd/overlay"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/certmanager"
+)
+
+var (
+	preflightEnable = managerFlags.Bool("preflight.enable", true, "Whether to run preflight checks at operator start. "+
+		"It checks RBAC permissions of operator ServiceAccount, served versions of operator CRDs, webhook server certificate and availability of -metrics-bind-address. "+
+		"Failed checks are logged and exposed with operator_preflight_checks_failed metric, operator starts anyway")
+	preflightOnly = managerFlags.Bool("preflight-only", false, "Run preflight checks, print report to stdout and exit. "+
+		"Exit code is non-zero if any check failed. It's useful for validation of operator installation at CI")
+)
+
+// +kubebuilder:rbac:groups="authorization.k8s.io",resources=selfsubjectaccessreviews,verbs=create
+
+const (
+	preflightCheckRBAC        = "rbac"
+	preflightCheckCRD         = "crd"
+	preflightCheckWebhookCert = "webhook_cert"
+	preflightCheckMetricsPort = "metrics_port"
+)
+
+var preflightChecksFailed = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "operator_preflight_checks_failed",
+	Help: "Number of preflight checks failed at operator start by check type",
+}, []string{"check"})
+
+// preflightRBACRule defines verbs required by operator for the resource
+type preflightRBACRule struct {
+	group       string
+	resource    string
+	subresource string
+	verbs       []string
+}
+
+func (r preflightRBACRule) String() string {
+	resource := r.resource
+	if r.subresource != "" {
+		resource += "/" + r.subresource
+	}
+	if r.group == "" {
+		return resource
+	}
+	return r.group + "/" + resource
+}
+
+// preflightCoreRBACRules defines permissions for the most common children of operator objects
+var preflightCoreRBACRules = []preflightRBACRule{
+	{resource: "configmaps", verbs: []string{"get", "list", "watch", "create", "update", "delete"}},
+	{resource: "secrets", verbs: []string{"get", "list", "watch", "create", "update", "delete"}},
+	{resource: "services", verbs: []string{"get", "list", "watch", "create", "update", "delete"}},
+	{resource: "serviceaccounts", verbs: []string{"get", "list", "watch", "create", "update", "delete"}},
+	{resource: "events", verbs: []string{"create", "patch"}},
+	{group: "apps", resource: "deployments", verbs: []string{"get", "list", "watch", "create", "update", "delete"}},
+	{group: "apps", resource: "statefulsets", verbs: []string{"get", "list", "watch", "create", "update", "delete"}},
+	{group: "policy", resource: "poddisruptionbudgets", verbs: []string{"get", "create", "update", "delete"}},
+}
+
+// preflightRBACRules returns permissions checked at operator start
+// permissions for operator objects are built from embedded CRDs,
+// objects without status subresource are only read by operator
+func preflightRBACRules(crds []*unstructured.Unstructured, leaderElection bool) []preflightRBACRule {
+	var rules []preflightRBACRule
+	for _, crd := range crds {
+		group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+		plural, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "plural")
+		if !crdHasStatus(crd) {
+			rules = append(rules, preflightRBACRule{group: group, resource: plural, verbs: []string{"get", "list", "watch"}})
+			continue
+		}
+		rules = append(rules, preflightRBACRule{group: group, resource: plural, verbs: []string{"get", "list", "watch", "update"}})
+		rules = append(rules, preflightRBACRule{group: group, resource: plural, subresource: "status", verbs: []string{"update"}})
+	}
+	rules = append(rules, preflightCoreRBACRules...)
+	if leaderElection {
+		rules = append(rules, preflightRBACRule{group: "coordination.k8s.io", resource: "leases", verbs: []string{"get", "create", "update"}})
+	}
+	return rules
+}
+
+func crdHasStatus(crd *unstructured.Unstructured) bool {
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, v := range versions {
+		version, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		if _, ok, _ := unstructured.NestedMap(version, "subresources", "status"); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// preflightResult holds outcome of a single preflight check
+type preflightResult struct {
+	check  string
+	target string
+	err    error
+}
+
+// preflightReport holds outcomes of all executed preflight checks
+type preflightReport struct {
+	results []preflightResult
+}
+
+func (r *preflightReport) add(check, target string, err error) {
+	r.results = append(r.results, preflightResult{check: check, target: target, err: err})
+}
+
+// failures returns failed checks
+func (r *preflightReport) failures() []preflightResult {
+	var failed []preflightResult
+	for _, res := range r.results {
+		if res.err != nil {
+			failed = append(failed, res)
+		}
+	}
+	return failed
+}
+
+// countByCheck returns number of passed and failed checks by check type
+func (r *preflightReport) countByCheck() (checks []string, passed, failed map[string]int) {
+	passed = make(map[string]int)
+	failed = make(map[string]int)
+	for _, res := range r.results {
+		if passed[res.check]+failed[res.check] == 0 {
+			checks = append(checks, res.check)
+		}
+		if res.err != nil {
+			failed[res.check]++
+		} else {
+			passed[res.check]++
+		}
+	}
+	return checks, passed, failed
+}
+
+// write prints human-readable report with summary by check type and list of failed checks
+func (r *preflightReport) write(w io.Writer) error {
+	checks, passed, failed := r.countByCheck()
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHECK\tPASSED\tFAILED")
+	for _, check := range checks {
+		fmt.Fprintf(tw, "%s\t%d\t%d\n", check, passed[check], failed[check])
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	failures := r.failures()
+	if len(failures) == 0 {
+		_, err := fmt.Fprintln(w, "\nall preflight checks passed")
+		return err
+	}
+	fmt.Fprintf(w, "\n%d preflight checks failed:\n", len(failures))
+	for _, res := range failures {
+		fmt.Fprintf(w, "  [%s] %s: %s\n", res.check, res.target, res.err)
+	}
+	return nil
+}
+
+// updateMetrics exposes number of failed checks for each executed check type
+func (r *preflightReport) updateMetrics() {
+	checks, _, failed := r.countByCheck()
+	for _, check := range checks {
+		preflightChecksFailed.WithLabelValues(check).Set(float64(failed[check]))
+	}
+}
+
+// preflightChecker verifies that operator is able to run in the current environment
+type preflightChecker struct {
+	rclient   client.Client
+	discovery discovery.ServerResourcesInterface
+	crds      []*unstructured.Unstructured
+	rbacRules []preflightRBACRule
+	// namespaces defines where RBAC permissions are checked, empty value means cluster-wide
+	namespaces []string
+	// webhookCertFile and webhookKeyFile are checked only if set
+	webhookCertFile string
+	webhookKeyFile  string
+	// metricsAddr is checked only if set and not disabled with 0 value
+	metricsAddr string
+}
+
+func (pc *preflightChecker) run(ctx context.Context) *preflightReport {
+	var report preflightReport
+	pc.checkRBAC(ctx, &report)
+	pc.checkCRDs(&report)
+	pc.checkWebhookCert(&report)
+	pc.checkMetricsPort(&report)
+	return &report
+}
+
+// checkRBAC checks permissions of operator ServiceAccount with SelfSubjectAccessReview
+func (pc *preflightChecker) checkRBAC(ctx context.Context, report *preflightReport) {
+	for _, ns := range pc.namespaces {
+		for _, rule := range pc.rbacRules {
+			for _, verb := range rule.verbs {
+				target := fmt.Sprintf("%s %s", verb, rule)
+				if ns != "" {
+					target += fmt.Sprintf(" at namespace=%s", ns)
+				}
+				report.add(preflightCheckRBAC, target, pc.checkAccess(ctx, ns, verb, rule))
+			}
+		}
+	}
+}
+
+func (pc *preflightChecker) checkAccess(ctx context.Context, ns, verb string, rule preflightRBACRule) error {
+	sar := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   ns,
+				Verb:        verb,
+				Group:       rule.group,
+				Resource:    rule.resource,
+				Subresource: rule.subresource,
+			},
+		},
+	}
+	if err := pc.rclient.Create(ctx, sar); err != nil {
+		return fmt.Errorf("cannot perform access review: %w", err)
+	}
+	if sar.Status.Allowed {
+		return nil
+	}
+	if sar.Status.Reason != "" {
+		return fmt.Errorf("access denied: %s", sar.Status.Reason)
+	}
+	return fmt.Errorf("access denied")
+}
+
+// checkCRDs checks that all served versions of embedded CRDs are served by kubernetes API
+func (pc *preflightChecker) checkCRDs(report *preflightReport) {
+	servedResources := make(map[string]map[string]struct{})
+	discoveryErrs := make(map[string]error)
+	for _, crd := range pc.crds {
+		group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+		plural, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "plural")
+		versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+		for _, v := range versions {
+			version, ok := v.(map[string]any)
+			if !ok {
+				continue
+			}
+			if served, _, _ := unstructured.NestedBool(version, "served"); !served {
+				continue
+			}
+			name, _, _ := unstructured.NestedString(version, "name")
+			gv := group + "/" + name
+			resources, ok := servedResources[gv]
+			if !ok {
+				resources = make(map[string]struct{})
+				list, err := pc.discovery.ServerResourcesForGroupVersion(gv)
+				switch {
+				case err == nil:
+					for _, r := range list.APIResources {
+						resources[r.Name] = struct{}{}
+					}
+				case !k8serrors.IsNotFound(err):
+					discoveryErrs[gv] = fmt.Errorf("cannot discover resources of %s: %w", gv, err)
+				}
+				servedResources[gv] = resources
+			}
+			err := discoveryErrs[gv]
+			if _, ok := resources[plural]; !ok && err == nil {
+				err = fmt.Errorf("version is not served by kubernetes API, CRD must be installed or updated")
+			}
+			report.add(preflightCheckCRD, fmt.Sprintf("%s/%s", crd.GetName(), name), err)
+		}
+	}
+}
+
+// checkWebhookCert checks that webhook server certificate and key are readable and certificate is not expired
+func (pc *preflightChecker) checkWebhookCert(report *preflightReport) {
+	if pc.webhookCertFile == "" {
+		return
+	}
+	report.add(preflightCheckWebhookCert, pc.webhookCertFile, checkCertificate(pc.webhookCertFile, pc.webhookKeyFile, time.Now()))
+}
+
+func checkCertificate(certFile, keyFile string, now time.Time) error {
+	pair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return fmt.Errorf("cannot load certificate: %w", err)
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return fmt.Errorf("cannot parse certificate: %w", err)
+	}
+	if now.Before(cert.NotBefore) {
+		return fmt.Errorf("certificate is not valid before %s", cert.NotBefore.Format(time.RFC3339))
+	}
+	if now.After(cert.NotAfter) {
+		return fmt.Errorf("certificate expired at %s", cert.NotAfter.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// checkMetricsPort checks that metrics server address could be bound
+func (pc *preflightChecker) checkMetricsPort(report *preflightReport) {
+	if pc.metricsAddr == "" || pc.metricsAddr == "0" {
+		return
+	}
+	var err error
+	ln, lnErr := net.Listen("tcp", pc.metricsAddr)
+	if lnErr != nil {
+		err = fmt.Errorf("cannot bind address: %w", lnErr)
+	} else {
+		ln.Close()
+	}
+	report.add(preflightCheckMetricsPort, pc.metricsAddr, err)
+}
+
+// runPreflightChecks executes preflight checks with the given kubernetes API config
+// RBAC permissions are checked at the given namespaces or cluster-wide if namespaces are empty
+func runPreflightChecks(ctx context.Context, restCfg *rest.Config, namespaces []string) (*preflightReport, error) {
+	// checks perform many lightweight requests, which must not be throttled by operator client limits
+	cfg := rest.CopyConfig(restCfg)
+	cfg.RateLimiter = nil
+	cfg.QPS = 100
+	cfg.Burst = 200
+	rclient, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, fmt.Errorf("cannot build client for preflight checks: %w", err)
+	}
+	dc, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("cannot build discovery client for preflight checks: %w", err)
+	}
+	crds, err := loadEmbeddedCRDs(overlay.CRDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(namespaces) == 0 {
+		namespaces = []string{""}
+	}
+	namespaces = append([]string{}, namespaces...)
+	sort.Strings(namespaces)
+	pc := &preflightChecker{
+		rclient:     rclient,
+		discovery:   dc,
+		crds:        crds,
+		rbacRules:   preflightRBACRules(crds, *leaderElect),
+		namespaces:  namespaces,
+		metricsAddr: *metricsBindAddress,
+	}
+	// certificate issued by cert-manager is loaded from secret after start
+	if *enableWebhooks && !certmanager.IsEnabled() {
+		pc.webhookCertFile = filepath.Join(*webhooksDir, *webhookCertName)
+		pc.webhookKeyFile = filepath.Join(*webhooksDir, *webhookKeyName)
+	}
+	report := pc.run(ctx)
+	metrics.Registry.MustRegister(preflightChecksFailed)
+	report.updateMetrics()
+	return report, nil
+}
@@ -0,0 +1,248 @@
+package manager
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/VictoriaMetrics/operator/config/crd/overlay"
+)
+
+func TestPreflightChecker(t *testing.T) {
+	crds, err := loadEmbeddedCRDs(overlay.CRDs)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var checkedCRDs []*unstructured.Unstructured
+	for _, crd := range crds {
+		switch crd.GetName() {
+		case "vmagents.operator.victoriametrics.com", "vmsingles.operator.victoriametrics.com":
+			checkedCRDs = append(checkedCRDs, crd)
+		}
+	}
+	type opts struct {
+		namespaces []string
+		// denied holds denied permissions in verb/resource format
+		denied         []string
+		servedVersions []*metav1.APIResourceList
+		webhookCert    bool
+		metricsAddr    string
+		wantFailures   []string
+		wantCounts     map[string][2]int
+	}
+	f := func(o opts) {
+		t.Helper()
+		var reviews int
+		rclient := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				sar, ok := obj.(*authorizationv1.SelfSubjectAccessReview)
+				if !ok {
+					return c.Create(ctx, obj, opts...)
+				}
+				reviews++
+				attrs := sar.Spec.ResourceAttributes
+				resource := attrs.Resource
+				if attrs.Subresource != "" {
+					resource += "/" + attrs.Subresource
+				}
+				sar.Status.Allowed = true
+				for _, denied := range o.denied {
+					if denied == attrs.Verb+"/"+resource {
+						sar.Status.Allowed = false
+						sar.Status.Reason = "no RBAC policy matched"
+					}
+				}
+				return nil
+			},
+		}).Build()
+		pc := &preflightChecker{
+			rclient:     rclient,
+			discovery:   &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: o.servedVersions}},
+			crds:        checkedCRDs,
+			namespaces:  o.namespaces,
+			metricsAddr: o.metricsAddr,
+			rbacRules: []preflightRBACRule{
+				{group: "operator.victoriametrics.com", resource: "vmagents", verbs: []string{"list", "update"}},
+				{group: "operator.victoriametrics.com", resource: "vmagents", subresource: "status", verbs: []string{"update"}},
+				{resource: "secrets", verbs: []string{"create"}},
+			},
+		}
+		if o.webhookCert {
+			dir := t.TempDir()
+			data := newTestCert(t, "webhook")
+			pc.webhookCertFile = filepath.Join(dir, "tls.crt")
+			pc.webhookKeyFile = filepath.Join(dir, "tls.key")
+			if err := os.WriteFile(pc.webhookCertFile, data[corev1.TLSCertKey], 0o600); err != nil {
+				t.Fatalf("cannot write certificate: %s", err)
+			}
+			if err := os.WriteFile(pc.webhookKeyFile, data[corev1.TLSPrivateKeyKey], 0o600); err != nil {
+				t.Fatalf("cannot write key: %s", err)
+			}
+		}
+		report := pc.run(context.Background())
+		assert.Equal(t, 4*len(o.namespaces), reviews)
+
+		var gotFailures []string
+		for _, res := range report.failures() {
+			gotFailures = append(gotFailures, res.check+": "+res.target)
+		}
+		assert.Equal(t, o.wantFailures, gotFailures)
+		checks, passed, failed := report.countByCheck()
+		gotCounts := make(map[string][2]int)
+		for _, check := range checks {
+			gotCounts[check] = [2]int{passed[check], failed[check]}
+		}
+		assert.Equal(t, o.wantCounts, gotCounts)
+
+		var buf bytes.Buffer
+		if err := report.write(&buf); err != nil {
+			t.Fatalf("cannot write report: %s", err)
+		}
+		if len(o.wantFailures) == 0 {
+			assert.Contains(t, buf.String(), "all preflight checks passed")
+		} else {
+			assert.Contains(t, buf.String(), "preflight checks failed:")
+		}
+	}
+	served := []*metav1.APIResourceList{{
+		GroupVersion: "operator.victoriametrics.com/v1beta1",
+		APIResources: []metav1.APIResource{{Name: "vmagents"}, {Name: "vmsingles"}},
+	}}
+
+	// all checks passed
+	f(opts{
+		namespaces:     []string{""},
+		servedVersions: served,
+		webhookCert:    true,
+		wantCounts: map[string][2]int{
+			preflightCheckRBAC:        {4, 0},
+			preflightCheckCRD:         {2, 0},
+			preflightCheckWebhookCert: {1, 0},
+		},
+	})
+
+	// missing permissions and CRDs
+	f(opts{
+		namespaces: []string{"monitoring", "default"},
+		denied:     []string{"update/vmagents/status", "create/secrets"},
+		servedVersions: []*metav1.APIResourceList{{
+			GroupVersion: "operator.victoriametrics.com/v1beta1",
+			APIResources: []metav1.APIResource{{Name: "vmagents"}},
+		}},
+		wantFailures: []string{
+			"rbac: update operator.victoriametrics.com/vmagents/status at namespace=monitoring",
+			"rbac: create secrets at namespace=monitoring",
+			"rbac: update operator.victoriametrics.com/vmagents/status at namespace=default",
+			"rbac: create secrets at namespace=default",
+			"crd: vmsingles.operator.victoriametrics.com/v1beta1",
+		},
+		wantCounts: map[string][2]int{
+			preflightCheckRBAC: {4, 4},
+			preflightCheckCRD:  {1, 1},
+		},
+	})
+
+	// CRDs are not installed
+	f(opts{
+		namespaces: []string{""},
+		wantFailures: []string{
+			"crd: vmagents.operator.victoriametrics.com/v1beta1",
+			"crd: vmsingles.operator.victoriametrics.com/v1beta1",
+		},
+		wantCounts: map[string][2]int{
+			preflightCheckRBAC: {4, 0},
+			preflightCheckCRD:  {0, 2},
+		},
+	})
+
+	// metrics port is already in use
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("cannot start listener: %s", err)
+	}
+	defer ln.Close()
+	f(opts{
+		namespaces:     []string{""},
+		servedVersions: served,
+		metricsAddr:    ln.Addr().String(),
+		wantFailures:   []string{"metrics_port: " + ln.Addr().String()},
+		wantCounts: map[string][2]int{
+			preflightCheckRBAC:        {4, 0},
+			preflightCheckCRD:         {2, 0},
+			preflightCheckMetricsPort: {0, 1},
+		},
+	})
+}
+
+func TestPreflightRBACRules(t *testing.T) {
+	crds, err := loadEmbeddedCRDs(overlay.CRDs)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	got := make(map[string][]string)
+	for _, rule := range preflightRBACRules(crds, true) {
+		got[rule.String()] = rule.verbs
+	}
+	f := func(rule string, want []string) {
+		t.Helper()
+		assert.Equal(t, want, got[rule])
+	}
+
+	// object with status subresource
+	f("operator.victoriametrics.com/vmagents", []string{"get", "list", "watch", "update"})
+	f("operator.victoriametrics.com/vmagents/status", []string{"update"})
+
+	// read-only object without status subresource
+	f("operator.victoriametrics.com/vmremotewritetargets", []string{"get", "list", "watch"})
+	f("operator.victoriametrics.com/vmremotewritetargets/status", nil)
+
+	// leader election
+	f("coordination.k8s.io/leases", []string{"get", "create", "update"})
+}
+
+func TestCheckCertificate(t *testing.T) {
+	dir := t.TempDir()
+	data := newTestCert(t, "webhook")
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	if err := os.WriteFile(certFile, data[corev1.TLSCertKey], 0o600); err != nil {
+		t.Fatalf("cannot write certificate: %s", err)
+	}
+	if err := os.WriteFile(keyFile, data[corev1.TLSPrivateKeyKey], 0o600); err != nil {
+		t.Fatalf("cannot write key: %s", err)
+	}
+	f := func(certFile string, now time.Time, wantErr string) {
+		t.Helper()
+		err := checkCertificate(certFile, keyFile, now)
+		if wantErr == "" {
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			return
+		}
+		if err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Fatalf("unexpected error, got: %v, want: %q", err, wantErr)
+		}
+	}
+
+	f(certFile, time.Now().Add(time.Minute), "")
+	f(certFile, time.Now().Add(2*time.Hour), "certificate expired")
+	f(certFile, time.Now().Add(-time.Hour), "certificate is not valid before")
+	f(filepath.Join(dir, "missing.crt"), time.Now(), "cannot load certificate")
+}
@@ -0,0 +1,234 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/VictoriaMetrics/operator/api/client/reconcile"
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/events"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var reconcileTriggerEnable = managerFlags.Bool("reconcileTrigger.enable", false, "enables POST "+reconcile.Path+" endpoint at -metrics-bind-address. "+
+	"It forces reconcile of the given object or all objects of the given kind. Requests must contain kubernetes bearer token with patch permission for requested objects")
+
+// +kubebuilder:rbac:groups="authentication.k8s.io",resources=tokenreviews,verbs=create
+// +kubebuilder:rbac:groups="authorization.k8s.io",resources=subjectaccessreviews,verbs=create
+
+// addReconcileTrigger registers reconcile trigger endpoint at metrics server
+func addReconcileTrigger(mgr ctrl.Manager) error {
+	if !*reconcileTriggerEnable {
+		return nil
+	}
+	kclient, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("cannot build kubernetes client for reconcile trigger: %w", err)
+	}
+	rt := &reconcileTrigger{
+		rclient: mgr.GetClient(),
+		// use API reader in order to not start metadata informers for requested kinds
+		reader:  mgr.GetAPIReader(),
+		kclient: kclient,
+	}
+	return mgr.AddMetricsServerExtraHandler(reconcile.Path, rt)
+}
+
+// reconcileTrigger forces reconcile of operator objects by updating ReconcileRequestedAtAnnotation.
+// Principal of request is authenticated with TokenReview and must be allowed to patch requested objects.
+type reconcileTrigger struct {
+	rclient client.Client
+	reader  client.Reader
+	kclient kubernetes.Interface
+}
+
+// ServeHTTP implements http.Handler
+func (rt *reconcileTrigger) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "only POST method is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	ctx := r.Context()
+	params := r.URL.Query()
+	kind, namespace, name := params.Get("kind"), params.Get("namespace"), params.Get("name")
+	if name != "" && namespace == "" {
+		http.Error(w, "namespace must be set for named object", http.StatusBadRequest)
+		return
+	}
+	gvk, err := resolveOperatorKind(rt.rclient, kind)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	user, err := rt.authenticate(ctx, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	mapping, err := rt.rclient.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("cannot get resource for kind=%q: %s", gvk.Kind, err), http.StatusInternalServerError)
+		return
+	}
+	if err := rt.authorize(ctx, user, mapping.Resource, namespace, name); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	objects, err := rt.listObjects(ctx, gvk, namespace, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := reconcile.Response{
+		Kind:        gvk.Kind,
+		RequestedBy: user.Username,
+		Objects:     []reconcile.ObjectRef{},
+	}
+	requestedAt := time.Now().UTC().Format(time.RFC3339Nano)
+	for i := range objects {
+		obj := &objects[i]
+		if err := rt.requestReconcile(ctx, obj, user.Username, requestedAt); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp.Objects = append(resp.Objects, reconcile.ObjectRef{Namespace: obj.Namespace, Name: obj.Name})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		logger.WithContext(ctx).Error(err, "cannot write reconcile trigger response")
+	}
+}
+
+// resolveOperatorKind case-insensitively matches given kind with operator kinds
+func resolveOperatorKind(rclient client.Client, kind string) (schema.GroupVersionKind, error) {
+	if kind == "" {
+		return schema.GroupVersionKind{}, fmt.Errorf("kind must be set")
+	}
+	scheme := rclient.Scheme()
+	for k := range scheme.KnownTypes(vmv1beta1.SchemeGroupVersion) {
+		if !strings.EqualFold(k, kind) {
+			continue
+		}
+		gvk := vmv1beta1.SchemeGroupVersion.WithKind(k)
+		// skip meta types registered for group, such as ListOptions
+		if !scheme.Recognizes(vmv1beta1.SchemeGroupVersion.WithKind(k + "List")) {
+			break
+		}
+		return gvk, nil
+	}
+	return schema.GroupVersionKind{}, fmt.Errorf("unsupported kind=%q", kind)
+}
+
+func (rt *reconcileTrigger) authenticate(ctx context.Context, r *http.Request) (*authenticationv1.UserInfo, error) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return nil, fmt.Errorf("missing bearer token")
+	}
+	tr := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}
+	tr, err := rt.kclient.AuthenticationV1().TokenReviews().Create(ctx, tr, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot review token: %w", err)
+	}
+	if !tr.Status.Authenticated {
+		return nil, fmt.Errorf("token is not authenticated: %s", tr.Status.Error)
+	}
+	return &tr.Status.User, nil
+}
+
+func (rt *reconcileTrigger) authorize(ctx context.Context, user *authenticationv1.UserInfo, gvr schema.GroupVersionResource, namespace, name string) error {
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+	sar := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			Groups: user.Groups,
+			UID:    user.UID,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      "patch",
+				Group:     gvr.Group,
+				Version:   gvr.Version,
+				Resource:  gvr.Resource,
+				Name:      name,
+			},
+		},
+	}
+	sar, err := rt.kclient.AuthorizationV1().SubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot review access: %w", err)
+	}
+	if !sar.Status.Allowed {
+		return fmt.Errorf("user=%q is not allowed to patch %s: %s", user.Username, gvr.Resource, sar.Status.Reason)
+	}
+	return nil
+}
+
+func (rt *reconcileTrigger) listObjects(ctx context.Context, gvk schema.GroupVersionKind, namespace, name string) ([]metav1.PartialObjectMetadata, error) {
+	if name != "" {
+		var obj metav1.PartialObjectMetadata
+		obj.SetGroupVersionKind(gvk)
+		if err := rt.reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &obj); err != nil {
+			return nil, fmt.Errorf("cannot get %s=%s/%s: %w", gvk.Kind, namespace, name, err)
+		}
+		obj.SetGroupVersionKind(gvk)
+		return []metav1.PartialObjectMetadata{obj}, nil
+	}
+	var l metav1.PartialObjectMetadataList
+	l.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := rt.reader.List(ctx, &l, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("cannot list %s: %w", gvk.Kind, err)
+	}
+	for i := range l.Items {
+		l.Items[i].SetGroupVersionKind(gvk)
+	}
+	return l.Items, nil
+}
+
+// requestReconcile updates reconcile annotations of the given object, which triggers its reconcile
+// and records principal of request into object event
+func (rt *reconcileTrigger) requestReconcile(ctx context.Context, obj *metav1.PartialObjectMetadata, requestedBy, requestedAt string) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{
+				vmv1beta1.ReconcileRequestedAtAnnotation: requestedAt,
+				vmv1beta1.ReconcileRequestedByAnnotation: requestedBy,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("cannot build patch: %w", err)
+	}
+	gvk := obj.GroupVersionKind()
+	if err := rt.rclient.Patch(ctx, obj, client.RawPatch(types.MergePatchType, patch)); err != nil {
+		return fmt.Errorf("cannot patch %s=%s/%s: %w", gvk.Kind, obj.Namespace, obj.Name, err)
+	}
+	// event recorder requires type meta, which could be dropped by response decoding
+	obj.SetGroupVersionKind(gvk)
+	logger.WithContext(ctx).Info("reconcile was requested", "kind", gvk.Kind, "namespace", obj.Namespace, "name", obj.Name, "requested_by", requestedBy)
+	events.Normal(events.AddToContext(ctx, obj), events.ReasonReconcileRequested, "reconcile was requested by %q", requestedBy)
+	return nil
+}
@@ -0,0 +1,162 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/VictoriaMetrics/operator/api/client/reconcile"
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcileTrigger(t *testing.T) {
+	newKubeClient := func() *k8sfake.Clientset {
+		kc := k8sfake.NewSimpleClientset()
+		kc.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			tr := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+			switch tr.Spec.Token {
+			case "admin-token":
+				tr.Status = authenticationv1.TokenReviewStatus{Authenticated: true, User: authenticationv1.UserInfo{Username: "admin"}}
+			case "viewer-token":
+				tr.Status = authenticationv1.TokenReviewStatus{Authenticated: true, User: authenticationv1.UserInfo{Username: "viewer"}}
+			}
+			return true, tr, nil
+		})
+		kc.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			sar := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+			sar.Status.Allowed = sar.Spec.User == "admin" && sar.Spec.ResourceAttributes.Verb == "patch"
+			return true, sar, nil
+		})
+		return kc
+	}
+	type opts struct {
+		method            string
+		query             string
+		token             string
+		predefinedObjects []runtime.Object
+		wantCode          int
+		wantObjects       []reconcile.ObjectRef
+	}
+	f := func(o opts) {
+		t.Helper()
+		mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{vmv1beta1.SchemeGroupVersion})
+		mapper.Add(vmv1beta1.SchemeGroupVersion.WithKind("VMAgent"), meta.RESTScopeNamespace)
+		fclient := fake.NewClientBuilder().
+			WithScheme(k8stools.GetTestClientWithObjects(nil).Scheme()).
+			WithRESTMapper(mapper).
+			WithRuntimeObjects(o.predefinedObjects...).
+			Build()
+		rt := &reconcileTrigger{
+			rclient: fclient,
+			reader:  fclient,
+			kclient: newKubeClient(),
+		}
+		if o.method == "" {
+			o.method = http.MethodPost
+		}
+		req := httptest.NewRequest(o.method, reconcile.Path+"?"+o.query, nil)
+		if o.token != "" {
+			req.Header.Set("Authorization", "Bearer "+o.token)
+		}
+		rw := httptest.NewRecorder()
+		rt.ServeHTTP(rw, req)
+		if rw.Code != o.wantCode {
+			t.Fatalf("unexpected response code, got: %d, want: %d, body: %s", rw.Code, o.wantCode, rw.Body.String())
+		}
+		if o.wantCode != http.StatusOK {
+			return
+		}
+		var resp reconcile.Response
+		if err := json.Unmarshal(rw.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("cannot parse response: %s", err)
+		}
+		if resp.RequestedBy != "admin" {
+			t.Fatalf("unexpected requestedBy: %q", resp.RequestedBy)
+		}
+		if !reflect.DeepEqual(resp.Objects, o.wantObjects) {
+			t.Fatalf("unexpected objects\ngot:  %v\nwant: %v", resp.Objects, o.wantObjects)
+		}
+		for _, ref := range o.wantObjects {
+			var got vmv1beta1.VMAgent
+			if err := fclient.Get(context.Background(), types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, &got); err != nil {
+				t.Fatalf("cannot get object: %s", err)
+			}
+			if got.Annotations[vmv1beta1.ReconcileRequestedByAnnotation] != "admin" {
+				t.Fatalf("unexpected annotations: %v", got.Annotations)
+			}
+			if got.Annotations[vmv1beta1.ReconcileRequestedAtAnnotation] == "" {
+				t.Fatalf("missing %s annotation", vmv1beta1.ReconcileRequestedAtAnnotation)
+			}
+		}
+	}
+	agents := []runtime.Object{
+		&vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"}},
+		&vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: "extra", Namespace: "default"}},
+		&vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "monitoring"}},
+	}
+
+	// only POST is allowed
+	f(opts{method: http.MethodGet, query: "kind=vmagent", token: "admin-token", wantCode: http.StatusMethodNotAllowed})
+
+	// unknown kind
+	f(opts{query: "kind=deployment", token: "admin-token", wantCode: http.StatusBadRequest})
+	f(opts{query: "kind=ListOptions", token: "admin-token", wantCode: http.StatusBadRequest})
+
+	// name without namespace
+	f(opts{query: "kind=vmagent&name=main", token: "admin-token", wantCode: http.StatusBadRequest})
+
+	// missing and invalid token
+	f(opts{query: "kind=vmagent", wantCode: http.StatusUnauthorized})
+	f(opts{query: "kind=vmagent", token: "bad-token", wantCode: http.StatusUnauthorized})
+
+	// principal cannot patch objects
+	f(opts{query: "kind=vmagent", token: "viewer-token", wantCode: http.StatusForbidden})
+
+	// missing object
+	f(opts{query: "kind=vmagent&namespace=default&name=missing", token: "admin-token", wantCode: http.StatusNotFound})
+
+	// named object
+	f(opts{
+		query:             "kind=VMAgent&namespace=default&name=main",
+		token:             "admin-token",
+		predefinedObjects: agents,
+		wantCode:          http.StatusOK,
+		wantObjects:       []reconcile.ObjectRef{{Namespace: "default", Name: "main"}},
+	})
+
+	// all objects at namespace
+	f(opts{
+		query:             "kind=vmagent&namespace=default",
+		token:             "admin-token",
+		predefinedObjects: agents,
+		wantCode:          http.StatusOK,
+		wantObjects:       []reconcile.ObjectRef{{Namespace: "default", Name: "extra"}, {Namespace: "default", Name: "main"}},
+	})
+
+	// all objects at all namespaces
+	f(opts{
+		query:             "kind=vmagent",
+		token:             "admin-token",
+		predefinedObjects: agents,
+		wantCode:          http.StatusOK,
+		wantObjects: []reconcile.ObjectRef{
+			{Namespace: "default", Name: "extra"},
+			{Namespace: "default", Name: "main"},
+			{Namespace: "monitoring", Name: "main"},
+		},
+	})
+}
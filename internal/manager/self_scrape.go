@@ -0,0 +1,89 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/reconcile"
+)
+
+var (
+	selfScrapeEnable  = managerFlags.Bool("selfScrape.enable", false, "Whether to create VMServiceScrape for operator metrics at "+defaultMetricsPath+" and controller SLIs at "+slisPath+". Requires -selfScrape.service flag")
+	selfScrapeService = managerFlags.String("selfScrape.service", "", "namespace/name of operator metrics Service. VMServiceScrape with the same name is created at the Service namespace, if -selfScrape.enable flag is set")
+)
+
+const defaultMetricsPath = "/metrics"
+
+func addSelfScrape(mgr ctrl.Manager) error {
+	if !*selfScrapeEnable {
+		return nil
+	}
+	if *mtlsEnable {
+		return fmt.Errorf("-selfScrape.enable cannot be used with -mtls.enable flag, VMServiceScrape with client certificate must be created manually")
+	}
+	ns, name, ok := strings.Cut(*selfScrapeService, "/")
+	if !ok || ns == "" || name == "" {
+		return fmt.Errorf("-selfScrape.service=%q must be in namespace/name format", *selfScrapeService)
+	}
+	svcName := types.NamespacedName{Namespace: ns, Name: name}
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		rclient := mgr.GetClient()
+		var svc corev1.Service
+		if err := rclient.Get(ctx, svcName, &svc); err != nil {
+			return fmt.Errorf("cannot get operator metrics service=%s: %w", svcName, err)
+		}
+		vss, err := buildSelfServiceScrape(&svc, *tlsEnable)
+		if err != nil {
+			return err
+		}
+		if err := reconcile.VMServiceScrapeForCRD(ctx, rclient, vss); err != nil {
+			return fmt.Errorf("cannot reconcile operator VMServiceScrape: %w", err)
+		}
+		setupLog.Info("created VMServiceScrape for operator metrics", "name", vss.Name, "namespace", vss.Namespace)
+		return nil
+	}))
+}
+
+// buildSelfServiceScrape builds VMServiceScrape for operator metrics and SLIs served by the given Service
+func buildSelfServiceScrape(svc *corev1.Service, tlsEnabled bool) (*vmv1beta1.VMServiceScrape, error) {
+	if len(svc.Spec.Ports) == 0 {
+		return nil, fmt.Errorf("operator metrics service=%s/%s must have at least one port", svc.Namespace, svc.Name)
+	}
+	if len(svc.Labels) == 0 {
+		return nil, fmt.Errorf("operator metrics service=%s/%s must have labels for VMServiceScrape selector", svc.Namespace, svc.Name)
+	}
+	port := svc.Spec.Ports[0].Name
+	ep := vmv1beta1.Endpoint{Port: port}
+	if tlsEnabled {
+		ep.Scheme = "https"
+		ep.TLSConfig = &vmv1beta1.TLSConfig{InsecureSkipVerify: true}
+	}
+	metricsEP, slisEP := ep, ep
+	metricsEP.Path = defaultMetricsPath
+	slisEP.Path = slisPath
+	return &vmv1beta1.VMServiceScrape{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      svc.Name,
+			Namespace: svc.Namespace,
+			Labels:    svc.Labels,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "v1",
+				Kind:       "Service",
+				Name:       svc.Name,
+				UID:        svc.UID,
+			}},
+		},
+		Spec: vmv1beta1.VMServiceScrapeSpec{
+			Selector:  metav1.LabelSelector{MatchLabels: svc.Labels},
+			Endpoints: []vmv1beta1.Endpoint{metricsEP, slisEP},
+		},
+	}, nil
+}
@@ -0,0 +1,53 @@
+package manager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+func TestBuildSelfServiceScrape(t *testing.T) {
+	f := func(svc *corev1.Service, tlsEnabled bool, wantEndpoints []vmv1beta1.Endpoint, wantErr bool) {
+		t.Helper()
+		got, err := buildSelfServiceScrape(svc, tlsEnabled)
+		if wantErr {
+			assert.Error(t, err)
+			return
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, svc.Name, got.Name)
+		assert.Equal(t, svc.Namespace, got.Namespace)
+		assert.Equal(t, svc.Labels, got.Spec.Selector.MatchLabels)
+		if assert.Len(t, got.OwnerReferences, 1) {
+			assert.Equal(t, "Service", got.OwnerReferences[0].Kind)
+		}
+		assert.Equal(t, wantEndpoints, got.Spec.Endpoints)
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "vm-operator-metrics-service", Namespace: "vm", Labels: map[string]string{"app.kubernetes.io/name": "vm-operator"}},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 8080}}},
+	}
+
+	// without ports
+	f(&corev1.Service{ObjectMeta: svc.ObjectMeta}, false, nil, true)
+
+	// without labels
+	f(&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "operator", Namespace: "vm"}, Spec: svc.Spec}, false, nil, true)
+
+	// http
+	f(svc, false, []vmv1beta1.Endpoint{
+		{Port: "http", EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{Path: "/metrics"}},
+		{Port: "http", EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{Path: "/metrics/slis"}},
+	}, false)
+
+	// https
+	tlsConfig := &vmv1beta1.TLSConfig{InsecureSkipVerify: true}
+	f(svc, true, []vmv1beta1.Endpoint{
+		{Port: "http", EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{Path: "/metrics", Scheme: "https"}, EndpointAuth: vmv1beta1.EndpointAuth{TLSConfig: tlsConfig}},
+		{Port: "http", EndpointScrapeParams: vmv1beta1.EndpointScrapeParams{Path: "/metrics/slis", Scheme: "https"}, EndpointAuth: vmv1beta1.EndpointAuth{TLSConfig: tlsConfig}},
+	}, false)
+}
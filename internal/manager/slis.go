@@ -0,0 +1,188 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+)
+
+const (
+	slisPath = "/metrics/slis"
+	// sliQuantile is used for latency SLIs computed from histograms
+	sliQuantile = 0.99
+)
+
+var (
+	sliWorkqueueDepth = prometheus.NewDesc("vm_operator_sli_workqueue_depth",
+		"Current number of queued reconcile requests per controller", []string{"controller"}, nil)
+	sliReconcileDuration = prometheus.NewDesc("vm_operator_sli_reconcile_duration_seconds",
+		"p99 of reconcile duration per controller since operator start", []string{"controller", "quantile"}, nil)
+	sliConverterLag = prometheus.NewDesc("vm_operator_sli_converter_lag_seconds",
+		"p99 of prometheus objects conversion duration per informer since operator start", []string{"informer", "quantile"}, nil)
+	sliCacheSynced = prometheus.NewDesc("vm_operator_sli_cache_synced",
+		"Whether informer cache of the kind finished initial sync", []string{"kind"}, nil)
+	sliCacheLastEventAge = prometheus.NewDesc("vm_operator_sli_cache_last_event_age_seconds",
+		"Seconds since informer cache of the kind received the last object from kubernetes API", []string{"kind"}, nil)
+)
+
+// sliCacheObjects defines kinds of operator objects with tracked cache freshness
+var sliCacheObjects = []client.Object{
+	&vmv1beta1.VMAgent{},
+	&vmv1beta1.VMAlert{},
+	&vmv1beta1.VMAlertmanager{},
+	&vmv1beta1.VMAuth{},
+	&vmv1beta1.VMCluster{},
+	&vmv1beta1.VMSingle{},
+	&vmv1beta1.VMUser{},
+	&vmv1beta1.VMRule{},
+	&vmv1beta1.VMServiceScrape{},
+	&vmv1beta1.VMPodScrape{},
+}
+
+func addSLIs(ctx context.Context, mgr ctrl.Manager) error {
+	sc := &sliCollector{gatherer: metrics.Registry}
+	for _, obj := range sliCacheObjects {
+		gvk, err := apiutil.GVKForObject(obj, mgr.GetScheme())
+		if err != nil {
+			return fmt.Errorf("cannot get kind of %T: %w", obj, err)
+		}
+		inf, err := mgr.GetCache().GetInformer(ctx, obj)
+		if err != nil {
+			return fmt.Errorf("cannot get informer for kind=%s: %w", gvk.Kind, err)
+		}
+		cf := &cacheFreshness{kind: gvk.Kind}
+		if cf.registration, err = inf.AddEventHandler(cf); err != nil {
+			return fmt.Errorf("cannot add event handler for kind=%s: %w", gvk.Kind, err)
+		}
+		sc.caches = append(sc.caches, cf)
+	}
+	r := prometheus.NewRegistry()
+	if err := r.Register(sc); err != nil {
+		return fmt.Errorf("cannot register SLI collector: %w", err)
+	}
+	return mgr.AddMetricsServerExtraHandler(slisPath, promhttp.HandlerFor(r, promhttp.HandlerOpts{}))
+}
+
+// cacheFreshness tracks time of the last object received by informer cache
+type cacheFreshness struct {
+	kind         string
+	lastEventAt  atomic.Int64
+	registration toolscache.ResourceEventHandlerRegistration
+}
+
+func (cf *cacheFreshness) touch() {
+	cf.lastEventAt.Store(time.Now().UnixNano())
+}
+
+// OnAdd implements cache.ResourceEventHandler
+func (cf *cacheFreshness) OnAdd(_ interface{}, _ bool) { cf.touch() }
+
+// OnUpdate implements cache.ResourceEventHandler
+func (cf *cacheFreshness) OnUpdate(_, _ interface{}) { cf.touch() }
+
+// OnDelete implements cache.ResourceEventHandler
+func (cf *cacheFreshness) OnDelete(_ interface{}) { cf.touch() }
+
+// sliCollector computes controller SLIs from operator metrics
+type sliCollector struct {
+	gatherer prometheus.Gatherer
+	caches   []*cacheFreshness
+}
+
+// Describe implements prometheus.Collector
+func (sc *sliCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- sliWorkqueueDepth
+	ch <- sliReconcileDuration
+	ch <- sliConverterLag
+	ch <- sliCacheSynced
+	ch <- sliCacheLastEventAge
+}
+
+// Collect implements prometheus.Collector
+func (sc *sliCollector) Collect(ch chan<- prometheus.Metric) {
+	mfs, err := sc.gatherer.Gather()
+	if err != nil {
+		setupLog.Error(err, "cannot gather operator metrics for SLIs")
+	}
+	quantile := fmt.Sprintf("%g", sliQuantile)
+	for _, mf := range mfs {
+		switch mf.GetName() {
+		case "workqueue_depth":
+			for _, m := range mf.GetMetric() {
+				ch <- prometheus.MustNewConstMetric(sliWorkqueueDepth, prometheus.GaugeValue, m.GetGauge().GetValue(), labelValue(m, "name"))
+			}
+		case "controller_runtime_reconcile_time_seconds":
+			for _, m := range mf.GetMetric() {
+				if v, ok := histogramQuantile(sliQuantile, m.GetHistogram()); ok {
+					ch <- prometheus.MustNewConstMetric(sliReconcileDuration, prometheus.GaugeValue, v, labelValue(m, "controller"), quantile)
+				}
+			}
+		case "operator_prometheus_converter_event_duration_seconds":
+			for _, m := range mf.GetMetric() {
+				if v, ok := histogramQuantile(sliQuantile, m.GetHistogram()); ok {
+					ch <- prometheus.MustNewConstMetric(sliConverterLag, prometheus.GaugeValue, v, labelValue(m, "informer"), quantile)
+				}
+			}
+		}
+	}
+	now := time.Now()
+	for _, cf := range sc.caches {
+		var synced float64
+		if cf.registration.HasSynced() {
+			synced = 1
+		}
+		ch <- prometheus.MustNewConstMetric(sliCacheSynced, prometheus.GaugeValue, synced, cf.kind)
+		if ts := cf.lastEventAt.Load(); ts > 0 {
+			ch <- prometheus.MustNewConstMetric(sliCacheLastEventAge, prometheus.GaugeValue, now.Sub(time.Unix(0, ts)).Seconds(), cf.kind)
+		}
+	}
+}
+
+func labelValue(m *dto.Metric, name string) string {
+	for _, lp := range m.GetLabel() {
+		if lp.GetName() == name {
+			return lp.GetValue()
+		}
+	}
+	return ""
+}
+
+// histogramQuantile estimates quantile from cumulative histogram buckets with linear interpolation,
+// the same way as histogram_quantile function of PromQL.
+// false is returned for histogram without observations
+func histogramQuantile(q float64, h *dto.Histogram) (float64, bool) {
+	count := h.GetSampleCount()
+	if count == 0 {
+		return 0, false
+	}
+	rank := q * float64(count)
+	var lowerBound, lowerCount float64
+	for _, b := range h.GetBucket() {
+		upperBound, upperCount := b.GetUpperBound(), float64(b.GetCumulativeCount())
+		if math.IsInf(upperBound, 1) {
+			break
+		}
+		if upperCount >= rank {
+			if upperCount == lowerCount {
+				return upperBound, true
+			}
+			return lowerBound + (upperBound-lowerBound)*(rank-lowerCount)/(upperCount-lowerCount), true
+		}
+		lowerBound, lowerCount = upperBound, upperCount
+	}
+	// quantile falls into +Inf bucket, return the highest finite bound
+	return lowerBound, true
+}
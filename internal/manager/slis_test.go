@@ -0,0 +1,72 @@
+package manager
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+)
+
+func TestHistogramQuantile(t *testing.T) {
+	f := func(buckets map[float64]uint64, count uint64, want float64, wantOK bool) {
+		t.Helper()
+		h := &dto.Histogram{SampleCount: ptr.To(count)}
+		for _, ub := range []float64{0.1, 0.5, 1} {
+			if c, ok := buckets[ub]; ok {
+				h.Bucket = append(h.Bucket, &dto.Bucket{UpperBound: ptr.To(ub), CumulativeCount: ptr.To(c)})
+			}
+		}
+		got, ok := histogramQuantile(0.99, h)
+		assert.Equal(t, wantOK, ok)
+		assert.InDelta(t, want, got, 1e-9)
+	}
+
+	// no observations
+	f(map[float64]uint64{0.1: 0, 0.5: 0, 1: 0}, 0, 0, false)
+
+	// all observations at the first bucket
+	f(map[float64]uint64{0.1: 100, 0.5: 100, 1: 100}, 100, 0.099, true)
+
+	// interpolation inside bucket
+	f(map[float64]uint64{0.1: 90, 0.5: 98, 1: 100}, 100, 0.75, true)
+
+	// quantile at +Inf bucket
+	f(map[float64]uint64{0.1: 10, 0.5: 20, 1: 50}, 100, 1, true)
+}
+
+func TestSLICollector(t *testing.T) {
+	r := prometheus.NewRegistry()
+	depth := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "workqueue_depth"}, []string{"name"})
+	reconcileTime := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "controller_runtime_reconcile_time_seconds",
+		Buckets: []float64{0.1, 0.5, 1},
+	}, []string{"controller"})
+	converterDuration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "operator_prometheus_converter_event_duration_seconds",
+		Buckets: []float64{0.1, 0.5, 1},
+	}, []string{"informer"})
+	r.MustRegister(depth, reconcileTime, converterDuration)
+	depth.WithLabelValues("vmagent").Set(3)
+	depth.WithLabelValues("vmsingle").Set(0)
+	for i := 0; i < 100; i++ {
+		reconcileTime.WithLabelValues("vmagent").Observe(0.05)
+	}
+	// histogram without observations is skipped
+	converterDuration.WithLabelValues("service_monitor")
+
+	sc := &sliCollector{gatherer: r}
+	expected := `
+# HELP vm_operator_sli_reconcile_duration_seconds p99 of reconcile duration per controller since operator start
+# TYPE vm_operator_sli_reconcile_duration_seconds gauge
+vm_operator_sli_reconcile_duration_seconds{controller="vmagent",quantile="0.99"} 0.099
+# HELP vm_operator_sli_workqueue_depth Current number of queued reconcile requests per controller
+# TYPE vm_operator_sli_workqueue_depth gauge
+vm_operator_sli_workqueue_depth{controller="vmagent"} 3
+vm_operator_sli_workqueue_depth{controller="vmsingle"} 0
+`
+	assert.NoError(t, testutil.CollectAndCompare(sc, strings.NewReader(expected)))
+}
@@ -0,0 +1,127 @@
+package manager
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/certmanager"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/logger"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	certManagerEnable     = managerFlags.Bool("certmanager.enable", false, "enables integration with cert-manager. Operator issues webhook server certificate with cert-manager Certificate, if -webhook.enable is set, and issues certificates for components with certManager settings")
+	certManagerIssuerName = managerFlags.String("certmanager.issuerName", "", "name of cert-manager issuer used for certificates without issuerRef")
+	certManagerIssuerKind = managerFlags.String("certmanager.issuerKind", certmanager.ClusterIssuerKind, "kind of cert-manager issuer defined at -certmanager.issuerName. Supported values: Issuer, ClusterIssuer")
+	certManagerWebhookSvc = managerFlags.String("certmanager.webhookService", "", "namespace/name of operator webhook Service. "+
+		"It's required for webhook server certificate, if -certmanager.enable and -webhook.enable flags are set. Certificate is stored at <name>-tls secret")
+	certManagerWebhookCertRefreshInterval = managerFlags.Duration("certmanager.webhookCertRefreshInterval", time.Minute, "interval for reloading webhook server certificate from secret issued by cert-manager")
+)
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;create;update;delete
+
+// webhookCertWatcher serves webhook server certificate from secret issued by cert-manager
+// certificate is reloaded periodically, so rotated certificate is used without operator restart
+type webhookCertWatcher struct {
+	reader  client.Reader
+	service types.NamespacedName
+	secret  types.NamespacedName
+	cert    atomic.Pointer[tls.Certificate]
+	data    []byte
+}
+
+func newWebhookCertWatcher(service string) (*webhookCertWatcher, error) {
+	ns, name, ok := strings.Cut(service, "/")
+	if !ok || ns == "" || name == "" {
+		return nil, fmt.Errorf("-certmanager.webhookService=%q must be in namespace/name format", service)
+	}
+	return &webhookCertWatcher{
+		service: types.NamespacedName{Namespace: ns, Name: name},
+		secret:  types.NamespacedName{Namespace: ns, Name: name + "-tls"},
+	}, nil
+}
+
+// GetCertificate implements tls.Config GetCertificate
+func (w *webhookCertWatcher) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert := w.cert.Load()
+	if cert == nil {
+		return nil, fmt.Errorf("webhook certificate at secret=%s is not issued yet", w.secret)
+	}
+	return cert, nil
+}
+
+// reload loads certificate from secret if it was changed
+func (w *webhookCertWatcher) reload(ctx context.Context) error {
+	var s corev1.Secret
+	if err := w.reader.Get(ctx, w.secret, &s); err != nil {
+		return fmt.Errorf("cannot get webhook certificate secret=%s: %w", w.secret, err)
+	}
+	data := append(append([]byte{}, s.Data[corev1.TLSCertKey]...), s.Data[corev1.TLSPrivateKeyKey]...)
+	if bytes.Equal(data, w.data) {
+		return nil
+	}
+	cert, err := tls.X509KeyPair(s.Data[corev1.TLSCertKey], s.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return fmt.Errorf("cannot parse webhook certificate from secret=%s: %w", w.secret, err)
+	}
+	w.cert.Store(&cert)
+	w.data = data
+	logger.WithContext(ctx).Info("loaded webhook server certificate", "secret", w.secret.String())
+	return nil
+}
+
+// Start implements manager.Runnable
+func (w *webhookCertWatcher) Start(ctx context.Context) error {
+	t := time.NewTicker(*certManagerWebhookCertRefreshInterval)
+	defer t.Stop()
+	for {
+		if err := w.reload(ctx); err != nil {
+			logger.WithContext(ctx).Error(err, "cannot reload webhook server certificate")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable
+// webhook server is started at each operator replica
+func (w *webhookCertWatcher) NeedLeaderElection() bool {
+	return false
+}
+
+// addWebhookCertificate issues webhook server certificate with cert-manager and starts its watcher
+func addWebhookCertificate(ctx context.Context, mgr ctrl.Manager, w *webhookCertWatcher) error {
+	w.reader = mgr.GetAPIReader()
+	cert := &certmanager.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      w.service.Name,
+			Namespace: w.service.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/name":      "vm-operator",
+				"app.kubernetes.io/component": "webhook",
+				"managed-by":                  "vm-operator",
+			},
+		},
+		SecretName: w.secret.Name,
+		CommonName: fmt.Sprintf("%s.%s.svc", w.service.Name, w.service.Namespace),
+		DNSNames:   certmanager.ServiceDNSNames(w.service.Name, w.service.Namespace),
+		Usages:     []string{certmanager.UsageServerAuth, certmanager.UsageDigitalSignature, certmanager.UsageKeyEncipherment},
+	}
+	// manager cache is not started yet, unstructured objects are requested directly from API server
+	if err := certmanager.CreateOrUpdate(ctx, mgr.GetClient(), cert); err != nil {
+		return fmt.Errorf("cannot create webhook server certificate: %w", err)
+	}
+	return mgr.Add(w)
+}
@@ -0,0 +1,99 @@
+package manager
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWebhookCertWatcher(t *testing.T) {
+	if _, err := newWebhookCertWatcher("webhook-service"); err == nil {
+		t.Fatalf("expected error for service without namespace")
+	}
+	w, err := newWebhookCertWatcher("vm/webhook-service")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	ctx := context.Background()
+	rclient := fake.NewClientBuilder().Build()
+	w.reader = rclient
+
+	// certificate is not issued yet
+	if err := w.reload(ctx); err == nil {
+		t.Fatalf("expected error for missing secret")
+	}
+	if _, err := w.GetCertificate(nil); err == nil {
+		t.Fatalf("expected error for missing certificate")
+	}
+
+	s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "vm", Name: "webhook-service-tls"}, Data: newTestCert(t, "first")}
+	if err := rclient.Create(ctx, s); err != nil {
+		t.Fatalf("cannot create secret: %s", err)
+	}
+	if err := w.reload(ctx); err != nil {
+		t.Fatalf("cannot load certificate: %s", err)
+	}
+	assertCertCN(t, w, "first")
+
+	// rotated certificate
+	s.Data = newTestCert(t, "second")
+	if err := rclient.Update(ctx, s); err != nil {
+		t.Fatalf("cannot update secret: %s", err)
+	}
+	if err := w.reload(ctx); err != nil {
+		t.Fatalf("cannot reload certificate: %s", err)
+	}
+	assertCertCN(t, w, "second")
+}
+
+func assertCertCN(t *testing.T, w *webhookCertWatcher, want string) {
+	t.Helper()
+	cert, err := w.GetCertificate(nil)
+	if err != nil {
+		t.Fatalf("cannot get certificate: %s", err)
+	}
+	parsed, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatalf("cannot parse certificate: %s", err)
+	}
+	if parsed.Subject.CommonName != want {
+		t.Fatalf("unexpected certificate common name, got: %q, want: %q", parsed.Subject.CommonName, want)
+	}
+}
+
+func newTestCert(t *testing.T, cn string) map[string][]byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("cannot generate key: %s", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("cannot create certificate: %s", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("cannot marshal key: %s", err)
+	}
+	return map[string][]byte{
+		corev1.TLSCertKey:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		corev1.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}),
+	}
+}
@@ -0,0 +1,113 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/alertmanager"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/vmagent"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var webhookDryRunAllowMissingSecrets = managerFlags.Bool("webhook.dryRunAllowMissingSecrets", false, "Whether to accept VMAgent and VMAlertmanagerConfig objects referencing not existing secrets or keys during configuration render at admission webhook. "+
+	"It allows to create secrets after objects, configuration is rendered at reconcile once secrets are created")
+
+// addDryRunWebhooks registers webhooks for objects, which configuration is rendered in memory before object is accepted
+func addDryRunWebhooks(mgr ctrl.Manager) error {
+	rclient := mgr.GetClient()
+	if err := ctrl.NewWebhookManagedBy(mgr).For(&vmv1beta1.VMAgent{}).
+		WithValidator(newReferenceValidator(rclient, newDryRunValidator(rclient, vmagent.ValidateConfig), vmAgentReferenceWarnings)).
+		Complete(); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).For(&vmv1beta1.VMAlertmanagerConfig{}).
+		WithValidator(newDryRunValidator(rclient, alertmanager.ValidateConfig)).
+		Complete()
+}
+
+type dryRunObject interface {
+	client.Object
+	admission.Validator
+}
+
+// dryRunValidator performs validation of object with its own webhook validator
+// and renders configuration of object in memory in order to reject invalid objects at admission time
+type dryRunValidator[T dryRunObject] struct {
+	rclient             client.Client
+	allowMissingSecrets bool
+	render              func(ctx context.Context, rclient client.Client, obj T, allowMissingSecrets bool) error
+}
+
+func newDryRunValidator[T dryRunObject](rclient client.Client, render func(ctx context.Context, rclient client.Client, obj T, allowMissingSecrets bool) error) *dryRunValidator[T] {
+	return &dryRunValidator[T]{
+		rclient:             rclient,
+		allowMissingSecrets: *webhookDryRunAllowMissingSecrets,
+		render:              render,
+	}
+}
+
+func (v *dryRunValidator[T]) dryRun(ctx context.Context, obj T) error {
+	if obj.GetAnnotations()[vmv1beta1.SkipValidationAnnotation] == vmv1beta1.SkipValidationValue {
+		return nil
+	}
+	if err := v.render(ctx, v.rclient, obj, v.allowMissingSecrets); err != nil {
+		return fmt.Errorf("configuration dry-run failed: %w", err)
+	}
+	return nil
+}
+
+func (v *dryRunValidator[T]) castObject(obj runtime.Object) (T, error) {
+	o, ok := obj.(T)
+	if !ok {
+		return o, fmt.Errorf("BUG: unexpected object type=%T", obj)
+	}
+	return o, nil
+}
+
+// ValidateCreate implements admission.CustomValidator interface
+func (v *dryRunValidator[T]) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	o, err := v.castObject(obj)
+	if err != nil {
+		return nil, err
+	}
+	warnings, err := o.ValidateCreate()
+	if err != nil {
+		return warnings, err
+	}
+	return warnings, v.dryRun(ctx, o)
+}
+
+// ValidateUpdate implements admission.CustomValidator interface
+// configuration is rendered only if spec was changed, metadata updates are not rejected
+// it allows to remove finalizers from objects with broken configuration
+func (v *dryRunValidator[T]) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	o, err := v.castObject(newObj)
+	if err != nil {
+		return nil, err
+	}
+	warnings, err := o.ValidateUpdate(oldObj)
+	if err != nil {
+		return warnings, err
+	}
+	prev, err := v.castObject(oldObj)
+	if err != nil {
+		return warnings, err
+	}
+	if o.GetDeletionTimestamp() != nil || o.GetGeneration() == prev.GetGeneration() {
+		return warnings, nil
+	}
+	return warnings, v.dryRun(ctx, o)
+}
+
+// ValidateDelete implements admission.CustomValidator interface
+func (v *dryRunValidator[T]) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	o, err := v.castObject(obj)
+	if err != nil {
+		return nil, err
+	}
+	return o.ValidateDelete()
+}
@@ -0,0 +1,99 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestDryRunValidator(t *testing.T) {
+	var renderCalls int
+	v := &dryRunValidator[*vmv1beta1.VMAlertmanagerConfig]{
+		render: func(_ context.Context, _ client.Client, obj *vmv1beta1.VMAlertmanagerConfig, _ bool) error {
+			renderCalls++
+			if obj.Name == "broken" {
+				return fmt.Errorf("cannot render")
+			}
+			return nil
+		},
+	}
+	newCfg := func(name string, generation int64) *vmv1beta1.VMAlertmanagerConfig {
+		return &vmv1beta1.VMAlertmanagerConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Generation: generation},
+			Spec: vmv1beta1.VMAlertmanagerConfigSpec{
+				Route:     &vmv1beta1.Route{Receiver: "blackhole"},
+				Receivers: []vmv1beta1.Receiver{{Name: "blackhole"}},
+			},
+		}
+	}
+	ctx := context.Background()
+	f := func(validate func() error, wantErr bool, wantRenderCalls int) {
+		t.Helper()
+		renderCalls = 0
+		err := validate()
+		if (err != nil) != wantErr {
+			t.Fatalf("unexpected error: %v, wantErr: %v", err, wantErr)
+		}
+		if renderCalls != wantRenderCalls {
+			t.Fatalf("unexpected render calls: %d, want: %d", renderCalls, wantRenderCalls)
+		}
+	}
+
+	// valid object
+	f(func() error {
+		_, err := v.ValidateCreate(ctx, newCfg("ok", 1))
+		return err
+	}, false, 1)
+
+	// render error
+	f(func() error {
+		_, err := v.ValidateCreate(ctx, newCfg("broken", 1))
+		return err
+	}, true, 1)
+
+	// object validation error, render is not needed
+	f(func() error {
+		cfg := newCfg("broken", 1)
+		cfg.Spec.Route = nil
+		_, err := v.ValidateCreate(ctx, cfg)
+		return err
+	}, true, 0)
+
+	// validation is skipped with annotation
+	f(func() error {
+		cfg := newCfg("broken", 1)
+		cfg.Annotations = map[string]string{vmv1beta1.SkipValidationAnnotation: vmv1beta1.SkipValidationValue}
+		_, err := v.ValidateCreate(ctx, cfg)
+		return err
+	}, false, 0)
+
+	// spec update
+	f(func() error {
+		_, err := v.ValidateUpdate(ctx, newCfg("broken", 1), newCfg("broken", 2))
+		return err
+	}, true, 1)
+
+	// metadata update
+	f(func() error {
+		_, err := v.ValidateUpdate(ctx, newCfg("broken", 1), newCfg("broken", 1))
+		return err
+	}, false, 0)
+
+	// update of deleted object
+	f(func() error {
+		cfg := newCfg("broken", 2)
+		cfg.DeletionTimestamp = &metav1.Time{}
+		_, err := v.ValidateUpdate(ctx, newCfg("broken", 1), cfg)
+		return err
+	}, false, 0)
+
+	// unexpected object type
+	f(func() error {
+		_, err := v.ValidateCreate(ctx, &vmv1beta1.VMAgent{})
+		return err
+	}, true, 0)
+}
@@ -0,0 +1,287 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/config"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var webhookReferenceWarnings = managerFlags.Bool("webhook.referenceWarnings", true, "Whether to return admission warnings for VMAgent, VMAlert and VMUser objects referencing not existing objects. "+
+	"Such objects are accepted, since referenced objects could be created later")
+
+// addReferenceWebhooks registers webhooks for objects, which reference other objects by name or selector
+// VMAgent references are checked by dry-run webhook
+func addReferenceWebhooks(mgr ctrl.Manager) error {
+	rclient := mgr.GetClient()
+	if err := ctrl.NewWebhookManagedBy(mgr).For(&vmv1beta1.VMAlert{}).
+		WithValidator(newReferenceValidator(rclient, &objectValidator[*vmv1beta1.VMAlert]{}, vmAlertReferenceWarnings)).
+		Complete(); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).For(&vmv1beta1.VMUser{}).
+		WithValidator(newReferenceValidator(rclient, &objectValidator[*vmv1beta1.VMUser]{}, vmUserReferenceWarnings)).
+		Complete()
+}
+
+// referenceValidator adds admission warnings for references to missing objects to results of the wrapped validator
+// objects aren't rejected, since referenced objects could be created after the referencing object
+type referenceValidator[T dryRunObject] struct {
+	admission.CustomValidator
+	rclient  client.Client
+	enabled  bool
+	warnings func(ctx context.Context, rclient client.Client, obj T) []string
+}
+
+func newReferenceValidator[T dryRunObject](rclient client.Client, validator admission.CustomValidator, warnings func(ctx context.Context, rclient client.Client, obj T) []string) *referenceValidator[T] {
+	return &referenceValidator[T]{
+		CustomValidator: validator,
+		rclient:         rclient,
+		enabled:         *webhookReferenceWarnings,
+		warnings:        warnings,
+	}
+}
+
+func (v *referenceValidator[T]) referenceWarnings(ctx context.Context, obj runtime.Object) admission.Warnings {
+	o, ok := obj.(T)
+	if !ok || !v.enabled || o.GetAnnotations()[vmv1beta1.SkipValidationAnnotation] == vmv1beta1.SkipValidationValue {
+		return nil
+	}
+	return v.warnings(ctx, v.rclient, o)
+}
+
+// ValidateCreate implements admission.CustomValidator interface
+func (v *referenceValidator[T]) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	warnings, err := v.CustomValidator.ValidateCreate(ctx, obj)
+	if err != nil {
+		return warnings, err
+	}
+	return append(warnings, v.referenceWarnings(ctx, obj)...), nil
+}
+
+// ValidateUpdate implements admission.CustomValidator interface
+// references are checked only if spec was changed
+func (v *referenceValidator[T]) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	warnings, err := v.CustomValidator.ValidateUpdate(ctx, oldObj, newObj)
+	if err != nil {
+		return warnings, err
+	}
+	o, ok := newObj.(T)
+	if !ok {
+		return warnings, nil
+	}
+	prev, ok := oldObj.(T)
+	if !ok || o.GetDeletionTimestamp() != nil || o.GetGeneration() == prev.GetGeneration() {
+		return warnings, nil
+	}
+	return append(warnings, v.referenceWarnings(ctx, newObj)...), nil
+}
+
+// objectValidator performs validation of object with its own webhook validator
+type objectValidator[T dryRunObject] struct{}
+
+func (v *objectValidator[T]) castObject(obj runtime.Object) (T, error) {
+	o, ok := obj.(T)
+	if !ok {
+		return o, fmt.Errorf("BUG: unexpected object type=%T", obj)
+	}
+	return o, nil
+}
+
+// ValidateCreate implements admission.CustomValidator interface
+func (v *objectValidator[T]) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	o, err := v.castObject(obj)
+	if err != nil {
+		return nil, err
+	}
+	return o.ValidateCreate()
+}
+
+// ValidateUpdate implements admission.CustomValidator interface
+func (v *objectValidator[T]) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	o, err := v.castObject(newObj)
+	if err != nil {
+		return nil, err
+	}
+	return o.ValidateUpdate(oldObj)
+}
+
+// ValidateDelete implements admission.CustomValidator interface
+func (v *objectValidator[T]) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	o, err := v.castObject(obj)
+	if err != nil {
+		return nil, err
+	}
+	return o.ValidateDelete()
+}
+
+// vmUserReferenceWarnings checks that objects referenced by targetRefs.crd exist and have the referenced component
+func vmUserReferenceWarnings(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMUser) []string {
+	var warnings []string
+	for i, ref := range cr.Spec.TargetRefs {
+		if ref.CRD == nil {
+			continue
+		}
+		field := fmt.Sprintf("spec.targetRefs[%d].crd", i)
+		nsn := types.NamespacedName{Namespace: ref.CRD.Namespace, Name: ref.CRD.Name}
+		kind, component, _ := strings.Cut(ref.CRD.Kind, "/")
+		var obj client.Object
+		switch kind {
+		case "VMAgent":
+			obj = &vmv1beta1.VMAgent{}
+		case "VMAlert":
+			obj = &vmv1beta1.VMAlert{}
+		case "VMSingle":
+			obj = &vmv1beta1.VMSingle{}
+		case "VMAlertmanager", "VMAlertManager":
+			kind = "VMAlertmanager"
+			obj = &vmv1beta1.VMAlertmanager{}
+		case "VMCluster":
+			obj = &vmv1beta1.VMCluster{}
+		default:
+			continue
+		}
+		if w := missingObjectWarning(ctx, rclient, field, kind, nsn, obj); w != "" {
+			warnings = append(warnings, w)
+			continue
+		}
+		if vmc, ok := obj.(*vmv1beta1.VMCluster); ok {
+			if missing := missingVMClusterComponent(vmc, component, ref.CRD); missing != "" {
+				warnings = append(warnings, fmt.Sprintf("%s: VMCluster=%s doesn't have %s component", field, nsn, missing))
+			}
+		}
+	}
+	if cr.Spec.PasswordRef != nil {
+		if w := missingSecretKeyWarning(ctx, rclient, "spec.passwordRef", cr.Namespace, cr.Spec.PasswordRef); w != "" {
+			warnings = append(warnings, w)
+		}
+	}
+	return warnings
+}
+
+// missingVMClusterComponent returns name of VMCluster component required by the given crd reference and missing at cluster spec
+func missingVMClusterComponent(vmc *vmv1beta1.VMCluster, component string, ref *vmv1beta1.CRDRef) string {
+	needSelect := component == "vmselect" || (component == "" && !ref.WriteOnly)
+	needInsert := component == "vminsert" || (component == "" && !ref.ReadOnly)
+	switch {
+	case component == "vmstorage" && vmc.Spec.VMStorage == nil:
+		return "vmstorage"
+	case needSelect && vmc.Spec.VMSelect == nil:
+		return "vmselect"
+	case needInsert && vmc.Spec.VMInsert == nil:
+		return "vminsert"
+	}
+	return ""
+}
+
+// vmAlertReferenceWarnings checks that notifier selectors match at least one VMAlertmanager
+// and secret with notifier configuration exists
+func vmAlertReferenceWarnings(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAlert) []string {
+	var warnings []string
+	checkSelector := func(field string, n *vmv1beta1.VMAlertNotifierSpec) {
+		if n == nil || n.Selector == nil {
+			return
+		}
+		opts, err := n.Selector.AsListOptions()
+		if err != nil {
+			return
+		}
+		var found bool
+		if err := k8stools.ListObjectsByNamespace(ctx, rclient, config.MustGetWatchNamespaces(), func(objects *vmv1beta1.VMAlertmanagerList) {
+			for i := range objects.Items {
+				if n.Selector.Namespace == nil || n.Selector.Namespace.IsMatch(&objects.Items[i]) {
+					found = true
+				}
+			}
+		}, opts); err != nil {
+			return
+		}
+		if !found {
+			warnings = append(warnings, fmt.Sprintf("%s.selector doesn't match any VMAlertmanager, alerts will not be sent until matching VMAlertmanager is created", field))
+		}
+	}
+	for i := range cr.Spec.Notifiers {
+		checkSelector(fmt.Sprintf("spec.notifiers[%d]", i), &cr.Spec.Notifiers[i])
+	}
+	checkSelector("spec.notifier", cr.Spec.Notifier)
+	if cr.Spec.NotifierConfigRef != nil {
+		if w := missingSecretKeyWarning(ctx, rclient, "spec.notifierConfigRef", cr.Namespace, cr.Spec.NotifierConfigRef); w != "" {
+			warnings = append(warnings, w)
+		}
+	}
+	return warnings
+}
+
+// vmAgentReferenceWarnings checks that objects referenced by remoteWrite targets exist
+func vmAgentReferenceWarnings(ctx context.Context, rclient client.Client, cr *vmv1beta1.VMAgent) []string {
+	var warnings []string
+	add := func(w string) {
+		if w != "" {
+			warnings = append(warnings, w)
+		}
+	}
+	for i, rw := range cr.Spec.RemoteWrite {
+		field := fmt.Sprintf("spec.remoteWrite[%d]", i)
+		if rw.TargetRef != "" {
+			add(missingObjectWarning(ctx, rclient, field+".targetRef", "VMRemoteWriteTarget", types.NamespacedName{Name: rw.TargetRef}, &vmv1beta1.VMRemoteWriteTarget{}))
+		}
+		for j, name := range rw.UrlRelabelConfigRefs {
+			add(missingObjectWarning(ctx, rclient, fmt.Sprintf("%s.urlRelabelConfigRefs[%d]", field, j), "VMRelabelConfig", types.NamespacedName{Name: name}, &vmv1beta1.VMRelabelConfig{}))
+		}
+		if rw.BasicAuth != nil {
+			add(missingSecretKeyWarning(ctx, rclient, field+".basicAuth.username", cr.Namespace, &rw.BasicAuth.Username))
+			add(missingSecretKeyWarning(ctx, rclient, field+".basicAuth.password", cr.Namespace, &rw.BasicAuth.Password))
+		}
+		add(missingSecretKeyWarning(ctx, rclient, field+".bearerTokenSecret", cr.Namespace, rw.BearerTokenSecret))
+		if rw.OAuth2 != nil {
+			add(missingSecretKeyWarning(ctx, rclient, field+".oauth2.client_secret", cr.Namespace, rw.OAuth2.ClientSecret))
+		}
+		if rw.UrlRelabelConfig != nil && rw.UrlRelabelConfig.Name != "" && !ptr.Deref(rw.UrlRelabelConfig.Optional, false) {
+			add(missingObjectWarning(ctx, rclient, field+".urlRelabelConfig", "ConfigMap", types.NamespacedName{Namespace: cr.Namespace, Name: rw.UrlRelabelConfig.Name}, &corev1.ConfigMap{}))
+		}
+	}
+	return warnings
+}
+
+// missingObjectWarning returns warning if the given object doesn't exist
+// other errors are ignored, since operator may not have access to the referenced namespace
+func missingObjectWarning(ctx context.Context, rclient client.Client, field, kind string, nsn types.NamespacedName, obj client.Object) string {
+	if err := rclient.Get(ctx, nsn, obj); err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Sprintf("%s: %s=%s doesn't exist", field, kind, strings.TrimPrefix(nsn.String(), "/"))
+		}
+	}
+	return ""
+}
+
+// missingSecretKeyWarning returns warning if the given secret or its key doesn't exist
+func missingSecretKeyWarning(ctx context.Context, rclient client.Client, field, namespace string, sel *corev1.SecretKeySelector) string {
+	if sel == nil || sel.Name == "" || ptr.Deref(sel.Optional, false) {
+		return ""
+	}
+	nsn := types.NamespacedName{Namespace: namespace, Name: sel.Name}
+	var s corev1.Secret
+	if err := rclient.Get(ctx, nsn, &s); err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Sprintf("%s: Secret=%s doesn't exist", field, nsn)
+		}
+		return ""
+	}
+	if _, ok := s.Data[sel.Key]; !ok {
+		if _, ok := s.StringData[sel.Key]; !ok {
+			return fmt.Sprintf("%s: Secret=%s doesn't have key=%q", field, nsn, sel.Key)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,200 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	vmv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
+	"github.com/VictoriaMetrics/operator/internal/controller/operator/factory/k8stools"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestVMUserReferenceWarnings(t *testing.T) {
+	f := func(targetRefs []vmv1beta1.TargetRef, predefinedObjects []runtime.Object, want []string) {
+		t.Helper()
+		cr := &vmv1beta1.VMUser{
+			ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: "default"},
+			Spec: vmv1beta1.VMUserSpec{
+				TargetRefs:  targetRefs,
+				PasswordRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "user-password"}, Key: "password"},
+			},
+		}
+		predefinedObjects = append(predefinedObjects, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "user-password", Namespace: "default"},
+			Data:       map[string][]byte{"password": []byte("secret")},
+		})
+		fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		assert.Equal(t, want, vmUserReferenceWarnings(context.Background(), fclient, cr))
+	}
+
+	// existing objects
+	f([]vmv1beta1.TargetRef{
+		{CRD: &vmv1beta1.CRDRef{Kind: "VMAgent", Name: "agent", Namespace: "monitoring"}},
+		{CRD: &vmv1beta1.CRDRef{Kind: "VMCluster/vmselect", Name: "main", Namespace: "monitoring"}},
+		{Static: &vmv1beta1.StaticRef{URL: "http://vmsingle:8428"}},
+	}, []runtime.Object{
+		&vmv1beta1.VMAgent{ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "monitoring"}},
+		&vmv1beta1.VMCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "monitoring"},
+			Spec:       vmv1beta1.VMClusterSpec{VMSelect: &vmv1beta1.VMSelect{}},
+		},
+	}, nil)
+
+	// missing objects and components
+	f([]vmv1beta1.TargetRef{
+		{CRD: &vmv1beta1.CRDRef{Kind: "VMAlertManager", Name: "missing", Namespace: "monitoring"}},
+		{CRD: &vmv1beta1.CRDRef{Kind: "VMCluster", Name: "main", Namespace: "monitoring", TenantID: "0"}},
+		{CRD: &vmv1beta1.CRDRef{Kind: "VMCluster", Name: "main", Namespace: "monitoring", TenantID: "0", ReadOnly: true}},
+	}, []runtime.Object{
+		&vmv1beta1.VMCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "monitoring"},
+			Spec:       vmv1beta1.VMClusterSpec{VMSelect: &vmv1beta1.VMSelect{}},
+		},
+	}, []string{
+		"spec.targetRefs[0].crd: VMAlertmanager=monitoring/missing doesn't exist",
+		"spec.targetRefs[1].crd: VMCluster=monitoring/main doesn't have vminsert component",
+	})
+}
+
+func TestVMAlertReferenceWarnings(t *testing.T) {
+	f := func(spec vmv1beta1.VMAlertSpec, predefinedObjects []runtime.Object, want []string) {
+		t.Helper()
+		cr := &vmv1beta1.VMAlert{
+			ObjectMeta: metav1.ObjectMeta{Name: "alert", Namespace: "default"},
+			Spec:       spec,
+		}
+		fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		assert.Equal(t, want, vmAlertReferenceWarnings(context.Background(), fclient, cr))
+	}
+	am := &vmv1beta1.VMAlertmanager{ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "monitoring", Labels: map[string]string{"team": "infra"}}}
+	selector := func(team string) *vmv1beta1.DiscoverySelector {
+		return &vmv1beta1.DiscoverySelector{Labels: &metav1.LabelSelector{MatchLabels: map[string]string{"team": team}}}
+	}
+
+	// selector matches alertmanager
+	f(vmv1beta1.VMAlertSpec{
+		Notifiers: []vmv1beta1.VMAlertNotifierSpec{{URL: "http://alertmanager:9093"}, {Selector: selector("infra")}},
+	}, []runtime.Object{am}, nil)
+
+	// selector doesn't match alertmanager at namespace
+	f(vmv1beta1.VMAlertSpec{
+		Notifier: &vmv1beta1.VMAlertNotifierSpec{Selector: &vmv1beta1.DiscoverySelector{
+			Namespace: &vmv1beta1.NamespaceSelector{MatchNames: []string{"default"}},
+			Labels:    &metav1.LabelSelector{MatchLabels: map[string]string{"team": "infra"}},
+		}},
+	}, []runtime.Object{am}, []string{
+		"spec.notifier.selector doesn't match any VMAlertmanager, alerts will not be sent until matching VMAlertmanager is created",
+	})
+
+	// missing notifier config secret
+	f(vmv1beta1.VMAlertSpec{
+		NotifierConfigRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "notifiers"}, Key: "config.yaml"},
+	}, nil, []string{
+		"spec.notifierConfigRef: Secret=default/notifiers doesn't exist",
+	})
+}
+
+func TestVMAgentReferenceWarnings(t *testing.T) {
+	f := func(remoteWrites []vmv1beta1.VMAgentRemoteWriteSpec, predefinedObjects []runtime.Object, want []string) {
+		t.Helper()
+		cr := &vmv1beta1.VMAgent{
+			ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"},
+			Spec:       vmv1beta1.VMAgentSpec{RemoteWrite: remoteWrites},
+		}
+		fclient := k8stools.GetTestClientWithObjects(predefinedObjects)
+		assert.Equal(t, want, vmAgentReferenceWarnings(context.Background(), fclient, cr))
+	}
+	secretKey := func(name, key string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: name}, Key: key}
+	}
+
+	// existing objects
+	f([]vmv1beta1.VMAgentRemoteWriteSpec{
+		{URL: "http://vminsert:8480", BearerTokenSecret: secretKey("remote-auth", "token")},
+		{TargetRef: "shared", UrlRelabelConfigRefs: []string{"drop-heavy-metrics"}},
+	}, []runtime.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "remote-auth", Namespace: "default"},
+			Data:       map[string][]byte{"token": []byte("secret")},
+		},
+		&vmv1beta1.VMRemoteWriteTarget{ObjectMeta: metav1.ObjectMeta{Name: "shared"}},
+		&vmv1beta1.VMRelabelConfig{ObjectMeta: metav1.ObjectMeta{Name: "drop-heavy-metrics"}},
+	}, nil)
+
+	// missing objects
+	f([]vmv1beta1.VMAgentRemoteWriteSpec{
+		{
+			URL: "http://vminsert:8480",
+			BasicAuth: &vmv1beta1.BasicAuth{
+				Username: *secretKey("remote-auth", "username"),
+				Password: *secretKey("missing", "password"),
+			},
+			UrlRelabelConfig: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "relabeling"}, Key: "config.yaml"},
+		},
+		{TargetRef: "shared", UrlRelabelConfigRefs: []string{"drop-heavy-metrics"}},
+	}, []runtime.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "remote-auth", Namespace: "default"},
+			Data:       map[string][]byte{"token": []byte("secret")},
+		},
+	}, []string{
+		`spec.remoteWrite[0].basicAuth.username: Secret=default/remote-auth doesn't have key="username"`,
+		"spec.remoteWrite[0].basicAuth.password: Secret=default/missing doesn't exist",
+		"spec.remoteWrite[0].urlRelabelConfig: ConfigMap=default/relabeling doesn't exist",
+		"spec.remoteWrite[1].targetRef: VMRemoteWriteTarget=shared doesn't exist",
+		"spec.remoteWrite[1].urlRelabelConfigRefs[0]: VMRelabelConfig=drop-heavy-metrics doesn't exist",
+	})
+}
+
+func TestReferenceValidator(t *testing.T) {
+	v := &referenceValidator[*vmv1beta1.VMUser]{
+		CustomValidator: &objectValidator[*vmv1beta1.VMUser]{},
+		enabled:         true,
+		warnings: func(_ context.Context, _ client.Client, _ *vmv1beta1.VMUser) []string {
+			return []string{"missing reference"}
+		},
+	}
+	newUser := func(generation int64) *vmv1beta1.VMUser {
+		return &vmv1beta1.VMUser{
+			ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: "default", Generation: generation},
+			Spec: vmv1beta1.VMUserSpec{TargetRefs: []vmv1beta1.TargetRef{
+				{CRD: &vmv1beta1.CRDRef{Kind: "VMAgent", Name: "agent", Namespace: "default"}},
+			}},
+		}
+	}
+	ctx := context.Background()
+
+	// warnings are added to valid object
+	warnings, err := v.ValidateCreate(ctx, newUser(1))
+	assert.NoError(t, err)
+	assert.Equal(t, admission.Warnings{"missing reference"}, warnings)
+
+	// invalid object is rejected without reference checks
+	invalid := newUser(1)
+	invalid.Spec.TargetRefs = nil
+	warnings, err = v.ValidateCreate(ctx, invalid)
+	assert.Error(t, err)
+	assert.Empty(t, warnings)
+
+	// references are checked on spec update
+	warnings, err = v.ValidateUpdate(ctx, newUser(1), newUser(2))
+	assert.NoError(t, err)
+	assert.Equal(t, admission.Warnings{"missing reference"}, warnings)
+
+	// references aren't checked on metadata update
+	warnings, err = v.ValidateUpdate(ctx, newUser(1), newUser(1))
+	assert.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	// references aren't checked with skip validation annotation
+	skipped := newUser(1)
+	skipped.Annotations = map[string]string{vmv1beta1.SkipValidationAnnotation: vmv1beta1.SkipValidationValue}
+	warnings, err = v.ValidateCreate(ctx, skipped)
+	assert.NoError(t, err)
+	assert.Empty(t, warnings)
+}